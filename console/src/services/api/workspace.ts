@@ -51,6 +51,7 @@ export interface WorkspaceSettings {
   blog_settings?: BlogSettings
   default_language: string
   languages: string[]
+  notification_center_data_export_enabled?: boolean
 }
 
 export interface FileManagerSettings {
@@ -6,6 +6,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"sync"
@@ -24,6 +25,7 @@ import (
 	"github.com/Notifuse/notifuse/internal/service/queue"
 	"github.com/Notifuse/notifuse/pkg/cache"
 	pkgDatabase "github.com/Notifuse/notifuse/pkg/database"
+	"github.com/Notifuse/notifuse/pkg/emailverifier"
 	"github.com/Notifuse/notifuse/pkg/logger"
 	"github.com/Notifuse/notifuse/pkg/mailer"
 	"github.com/Notifuse/notifuse/pkg/ratelimiter"
@@ -100,6 +102,11 @@ type App struct {
 	authRepo                      domain.AuthRepository
 	settingRepo                   domain.SettingRepository
 	contactRepo                   domain.ContactRepository
+	suppressionRepo               domain.SuppressionRepository
+	emailVerificationRepo         domain.EmailVerificationRepository
+	contactTagRepo                domain.ContactTagRepository
+	translationRepo               domain.TranslationRepository
+	contactBulkOperationRepo      domain.ContactBulkOperationRepository
 	listRepo                      domain.ListRepository
 	contactListRepo               domain.ContactListRepository
 	templateRepo                  domain.TemplateRepository
@@ -117,6 +124,7 @@ type App struct {
 	blogPostRepo                  domain.BlogPostRepository
 	blogThemeRepo                 domain.BlogThemeRepository
 	customEventRepo               domain.CustomEventRepository
+	holdoutRepo                   domain.HoldoutRepository
 	webhookSubscriptionRepo       domain.WebhookSubscriptionRepository
 	webhookDeliveryRepo           domain.WebhookDeliveryRepository
 	automationRepo                domain.AutomationRepository
@@ -127,6 +135,12 @@ type App struct {
 	userService                      *service.UserService
 	workspaceService                 *service.WorkspaceService
 	contactService                   *service.ContactService
+	contactPrivacyService            *service.ContactPrivacyService
+	suppressionService               *service.SuppressionService
+	emailVerificationService         *service.EmailVerificationService
+	contactTagService                *service.ContactTagService
+	translationService               *service.TranslationService
+	contactBulkOperationService      *service.ContactBulkOperationService
 	listService                      *service.ListService
 	contactListService               *service.ContactListService
 	templateService                  *service.TemplateService
@@ -152,6 +166,7 @@ type App struct {
 	taskScheduler                    *service.TaskScheduler
 	dnsVerificationService           *service.DNSVerificationService
 	customEventService               *service.CustomEventService
+	holdoutService                   *service.HoldoutService
 	webhookSubscriptionService       *service.WebhookSubscriptionService
 	webhookDeliveryWorker            *service.WebhookDeliveryWorker
 	automationService                *service.AutomationService
@@ -412,6 +427,11 @@ func (a *App) InitRepositories() error {
 	a.settingRepo = repository.NewSQLSettingRepository(a.db)
 	a.workspaceRepo = repository.NewWorkspaceRepository(a.db, &a.config.Database, a.config.Security.SecretKey, connManager)
 	a.contactRepo = repository.NewContactRepository(a.workspaceRepo)
+	a.suppressionRepo = repository.NewSuppressionRepository(a.workspaceRepo)
+	a.emailVerificationRepo = repository.NewEmailVerificationRepository(a.workspaceRepo)
+	a.contactTagRepo = repository.NewContactTagRepository(a.workspaceRepo)
+	a.translationRepo = repository.NewTranslationRepository(a.workspaceRepo)
+	a.contactBulkOperationRepo = repository.NewContactBulkOperationRepository(a.workspaceRepo)
 	a.listRepo = repository.NewListRepository(a.workspaceRepo)
 	a.contactListRepo = repository.NewContactListRepository(a.workspaceRepo)
 	a.templateRepo = repository.NewTemplateRepository(a.workspaceRepo)
@@ -428,6 +448,7 @@ func (a *App) InitRepositories() error {
 	a.blogPostRepo = repository.NewBlogPostRepository(a.workspaceRepo)
 	a.blogThemeRepo = repository.NewBlogThemeRepository(a.workspaceRepo)
 	a.customEventRepo = repository.NewCustomEventRepository(a.workspaceRepo)
+	a.holdoutRepo = repository.NewHoldoutRepository(a.workspaceRepo)
 	a.webhookSubscriptionRepo = repository.NewWebhookSubscriptionRepository(a.workspaceRepo)
 	a.webhookDeliveryRepo = repository.NewWebhookDeliveryRepository(a.workspaceRepo)
 
@@ -577,6 +598,15 @@ func (a *App) InitServices() error {
 		a.logger,
 	)
 
+	// Initialize holdout service
+	a.holdoutService = service.NewHoldoutService(
+		a.holdoutRepo,
+		a.broadcastRepo,
+		a.automationRepo,
+		a.authService,
+		a.logger,
+	)
+
 	// Initialize http client
 	httpClient := &http.Client{
 		Timeout: 10 * time.Second,
@@ -606,6 +636,7 @@ func (a *App) InitServices() error {
 		a.templateRepo,
 		a.templateService,
 		a.messageHistoryRepo,
+		a.translationRepo,
 		httpClient,
 		a.config.WebhookEndpoint,
 		a.config.APIEndpoint,
@@ -662,6 +693,8 @@ func (a *App) InitServices() error {
 		a.authService,
 		a.logger,
 		a.workspaceRepo,
+		a.suppressionRepo,
+		a.translationRepo,
 		a.config.APIEndpoint,
 	)
 
@@ -672,6 +705,7 @@ func (a *App) InitServices() error {
 		a.workspaceRepo,
 		a.messageHistoryRepo,
 		a.contactRepo,
+		a.suppressionRepo,
 	)
 
 	// Initialize Supabase service (before workspace service)
@@ -706,6 +740,7 @@ func (a *App) InitServices() error {
 		a.eventBus,           // Pass the event bus
 		a.messageHistoryRepo, // Message history repository
 		a.emailQueueRepo,     // Email queue for mid-flight pause/resume/cancel
+		a.translationRepo,    // Translation catalog for test sends
 		a.listService,        // List service for web publication validation
 		a.dataFeedFetcher,    // Data feed fetcher for global/recipient data
 		a.config.APIEndpoint, // API endpoint for tracking URLs
@@ -726,6 +761,7 @@ func (a *App) InitServices() error {
 		a.taskRepo,
 		a.workspaceRepo,
 		a.emailQueueRepo,
+		a.translationRepo,
 		a.dataFeedFetcher,
 		a.logger,
 		broadcastConfig,
@@ -782,6 +818,9 @@ func (a *App) InitServices() error {
 		a.workspaceRepo,
 		a.listRepo,
 		a.templateRepo,
+		a.broadcastRepo,
+		a.messageHistoryRepo,
+		a.emailService,
 		a.authService,
 		a.blogCache,
 	)
@@ -807,6 +846,70 @@ func (a *App) InitServices() error {
 		a.blogService,
 	)
 
+	// Initialize contact privacy service
+	a.contactPrivacyService = service.NewContactPrivacyService(
+		a.contactRepo,
+		a.taskService,
+		a.authService,
+		a.logger,
+	)
+
+	// Initialize suppression service
+	a.suppressionService = service.NewSuppressionService(
+		a.suppressionRepo,
+		a.contactRepo,
+		a.authService,
+		a.logger,
+	)
+
+	// Initialize email verification service and its queue processor
+	a.emailVerificationService = service.NewEmailVerificationService(
+		a.emailVerificationRepo,
+		a.suppressionRepo,
+		a.workspaceRepo,
+		a.authService,
+		emailverifier.New(net.DefaultResolver, emailverifier.NewSMTPProber(a.config.SMTP.EHLOHostname)),
+		a.logger,
+	)
+	a.taskService.RegisterProcessor(service.NewEmailVerificationTaskProcessor(a.emailVerificationService, a.logger))
+
+	// Initialize contact tag service
+	a.contactTagService = service.NewContactTagService(a.contactTagRepo, a.segmentRepo, a.authService, a.logger)
+	a.translationService = service.NewTranslationService(a.translationRepo, a.workspaceRepo, a.authService, a.logger)
+
+	// Initialize and register contact erasure processor
+	contactErasureProcessor := service.NewContactErasureProcessor(
+		a.contactRepo,
+		a.taskRepo,
+		a.logger,
+	)
+	a.taskService.RegisterProcessor(contactErasureProcessor)
+
+	// Initialize contact bulk operation service and register its processor
+	a.contactBulkOperationService = service.NewContactBulkOperationService(
+		a.contactBulkOperationRepo,
+		a.segmentRepo,
+		a.listRepo,
+		a.workspaceRepo,
+		a.taskService,
+		a.authService,
+		a.logger,
+	)
+	a.taskService.RegisterProcessor(service.NewContactBulkOperationProcessor(a.contactBulkOperationRepo, a.taskRepo, a.logger))
+
+	// Initialize and register the processor spawning the instances of recurring broadcasts
+	a.taskService.RegisterProcessor(service.NewRecurringBroadcastProcessor(
+		a.broadcastRepo,
+		a.workspaceRepo,
+		a.listService,
+		a.templateService,
+		a.taskRepo,
+		a.eventBus,
+		a.dataFeedFetcher,
+		a.blogService,
+		a.logger,
+	))
+
 	// Initialize and register segment build processor
 	segmentBuildProcessor := service.NewSegmentBuildProcessor(
 		a.segmentRepo,
@@ -955,6 +1058,9 @@ func (a *App) InitServices() error {
 		ToolRegistry:  toolRegistry,
 	})
 
+	// Set the LLM service on the template service for template translations
+	a.templateService.SetLLMService(a.llmService)
+
 	// Initialize automation executor and scheduler
 	automationExecutor := service.NewAutomationExecutor(
 		a.automationRepo,
@@ -966,6 +1072,8 @@ func (a *App) InitServices() error {
 		a.emailQueueRepo,
 		a.messageHistoryRepo,
 		a.contactTimelineRepo,
+		a.suppressionRepo,
+		a.translationRepo,
 		a.logger,
 		a.config.APIEndpoint,
 	)
@@ -1099,6 +1207,12 @@ func (a *App) InitHandlers() error {
 		a.config.Security.SecretKey,
 	)
 	contactHandler := httpHandler.NewContactHandler(a.contactService, getJWTSecret, a.logger)
+	contactPrivacyHandler := httpHandler.NewContactPrivacyHandler(a.contactPrivacyService, getJWTSecret, a.logger)
+	suppressionHandler := httpHandler.NewSuppressionHandler(a.suppressionService, getJWTSecret, a.logger)
+	emailVerificationHandler := httpHandler.NewEmailVerificationHandler(a.emailVerificationService, getJWTSecret, a.logger)
+	contactTagHandler := httpHandler.NewContactTagHandler(a.contactTagService, getJWTSecret, a.logger)
+	translationHandler := httpHandler.NewTranslationHandler(a.translationService, getJWTSecret, a.logger)
+	contactBulkOperationHandler := httpHandler.NewContactBulkOperationHandler(a.contactBulkOperationService, getJWTSecret, a.logger)
 	listHandler := httpHandler.NewListHandler(a.listService, getJWTSecret, a.logger)
 	contactListHandler := httpHandler.NewContactListHandler(a.contactListService, getJWTSecret, a.logger)
 	templateHandler := httpHandler.NewTemplateHandler(a.templateService, getJWTSecret, a.logger)
@@ -1150,6 +1264,11 @@ func (a *App) InitHandlers() error {
 		getJWTSecret,
 		a.logger,
 	)
+	holdoutHandler := httpHandler.NewHoldoutHandler(
+		a.holdoutService,
+		getJWTSecret,
+		a.logger,
+	)
 	webhookSubscriptionHandler := httpHandler.NewWebhookSubscriptionHandler(
 		a.webhookSubscriptionService,
 		a.webhookDeliveryWorker,
@@ -1178,6 +1297,12 @@ func (a *App) InitHandlers() error {
 	workspaceHandler.RegisterRoutes(a.mux)
 	rootHandler.RegisterRoutes(a.mux)
 	contactHandler.RegisterRoutes(a.mux)
+	contactPrivacyHandler.RegisterRoutes(a.mux)
+	suppressionHandler.RegisterRoutes(a.mux)
+	emailVerificationHandler.RegisterRoutes(a.mux)
+	contactTagHandler.RegisterRoutes(a.mux)
+	translationHandler.RegisterRoutes(a.mux)
+	contactBulkOperationHandler.RegisterRoutes(a.mux)
 	listHandler.RegisterRoutes(a.mux)
 	contactListHandler.RegisterRoutes(a.mux)
 	templateHandler.RegisterRoutes(a.mux)
@@ -1197,6 +1322,7 @@ func (a *App) InitHandlers() error {
 	contactTimelineHandler.RegisterRoutes(a.mux)
 	segmentHandler.RegisterRoutes(a.mux)
 	customEventHandler.RegisterRoutes(a.mux)
+	holdoutHandler.RegisterRoutes(a.mux)
 	webhookSubscriptionHandler.RegisterRoutes(a.mux)
 	automationHandler.RegisterRoutes(a.mux)
 	llmHandler.RegisterRoutes(a.mux)
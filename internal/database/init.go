@@ -110,9 +110,11 @@ func InitializeWorkspaceDatabase(db *sql.DB) error {
 			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			db_created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
-			db_updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+			db_updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			attributes JSONB
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_contacts_external_id ON contacts(external_id)`,
+		`CREATE INDEX IF NOT EXISTS idx_contacts_attributes ON contacts USING GIN (attributes)`,
 		`CREATE TABLE IF NOT EXISTS lists (
 			id VARCHAR(32) PRIMARY KEY,
 			name VARCHAR(255) NOT NULL,
@@ -134,6 +136,64 @@ func InitializeWorkspaceDatabase(db *sql.DB) error {
 			PRIMARY KEY (email, list_id)
 		)`,
 		`CREATE INDEX IF NOT EXISTS idx_contact_lists_list_id ON contact_lists(list_id)`,
+		`CREATE TABLE IF NOT EXISTS contact_email_aliases (
+			alias VARCHAR(255) PRIMARY KEY,
+			email VARCHAR(255) NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_contact_email_aliases_email ON contact_email_aliases(email)`,
+		`CREATE TABLE IF NOT EXISTS erased_contacts (
+			email_hash VARCHAR(64) PRIMARY KEY,
+			erased_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS suppressions (
+			pattern VARCHAR(255) PRIMARY KEY,
+			kind VARCHAR(20) NOT NULL,
+			reason VARCHAR(20) NOT NULL,
+			source VARCHAR(50) NOT NULL,
+			details TEXT,
+			expires_at TIMESTAMP WITH TIME ZONE,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_suppressions_kind ON suppressions(kind)`,
+		`CREATE TABLE IF NOT EXISTS contact_email_verifications (
+			email VARCHAR(255) PRIMARY KEY,
+			status VARCHAR(20) NOT NULL,
+			reason VARCHAR(50),
+			suggestion VARCHAR(255),
+			checks JSONB NOT NULL DEFAULT '{}'::jsonb,
+			verified_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE TABLE IF NOT EXISTS email_verification_queue (
+			email VARCHAR(255) PRIMARY KEY,
+			queued_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_email_verification_queue_queued_at ON email_verification_queue(queued_at ASC)`,
+		`CREATE TABLE IF NOT EXISTS contact_tags (
+			email VARCHAR(255) NOT NULL,
+			tag VARCHAR(100) NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (email, tag)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_contact_tags_tag ON contact_tags(tag)`,
+		`CREATE TABLE IF NOT EXISTS contact_bulk_operations (
+			id VARCHAR(36) PRIMARY KEY,
+			operation VARCHAR(32) NOT NULL,
+			target JSONB NOT NULL,
+			params JSONB NOT NULL DEFAULT '{}'::jsonb,
+			status VARCHAR(20) NOT NULL,
+			total_count BIGINT NOT NULL DEFAULT 0,
+			processed_count BIGINT NOT NULL DEFAULT 0,
+			affected_count BIGINT NOT NULL DEFAULT 0,
+			task_id VARCHAR(36) NOT NULL,
+			error TEXT,
+			created_by VARCHAR(255) NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			completed_at TIMESTAMP WITH TIME ZONE
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_contact_bulk_operations_created_at ON contact_bulk_operations(created_at DESC)`,
 		`CREATE TABLE IF NOT EXISTS templates (
 			id VARCHAR(32) NOT NULL,
 			name VARCHAR(255) NOT NULL,
@@ -150,8 +210,20 @@ func InitializeWorkspaceDatabase(db *sql.DB) error {
 			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
 			deleted_at TIMESTAMP WITH TIME ZONE,
+			created_by VARCHAR(255),
+			published_at TIMESTAMP WITH TIME ZONE,
+			published_by VARCHAR(255),
 			PRIMARY KEY (id, version)
 		)`,
+		`CREATE TABLE IF NOT EXISTS translations (
+			key VARCHAR(255) NOT NULL,
+			language VARCHAR(10) NOT NULL,
+			value TEXT NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (key, language)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_translations_language ON translations(language)`,
 		`CREATE TABLE IF NOT EXISTS broadcasts (
 			id VARCHAR(255) NOT NULL,
 			workspace_id VARCHAR(32) NOT NULL,
@@ -176,6 +248,8 @@ func InitializeWorkspaceDatabase(db *sql.DB) error {
 			paused_at TIMESTAMP WITH TIME ZONE,
 			pause_reason TEXT,
 			data_feed JSONB,
+			parent_broadcast_id VARCHAR(255),
+			approval JSONB,
 			PRIMARY KEY (id)
 		)`,
 		`CREATE TABLE IF NOT EXISTS message_history (
@@ -242,6 +316,13 @@ func InitializeWorkspaceDatabase(db *sql.DB) error {
 		`CREATE INDEX IF NOT EXISTS inbound_webhook_events_timestamp_idx ON inbound_webhook_events (timestamp DESC)`,
 		`CREATE INDEX IF NOT EXISTS inbound_webhook_events_recipient_email_idx ON inbound_webhook_events (recipient_email)`,
 		`CREATE INDEX IF NOT EXISTS idx_broadcasts_status_testing ON broadcasts(status) WHERE status IN ('testing', 'test_completed', 'winner_selected')`,
+		`CREATE INDEX IF NOT EXISTS idx_broadcasts_parent_broadcast_id ON broadcasts(parent_broadcast_id, created_at DESC) WHERE parent_broadcast_id IS NOT NULL`,
+		`CREATE TABLE IF NOT EXISTS broadcast_holdout_contacts (
+			broadcast_id VARCHAR(255) NOT NULL,
+			email VARCHAR(255) NOT NULL,
+			assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (broadcast_id, email)
+		)`,
 		`CREATE TABLE IF NOT EXISTS contact_timeline (
 			id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
 			email VARCHAR(255) NOT NULL,
@@ -397,6 +478,7 @@ func InitializeWorkspaceDatabase(db *sql.DB) error {
 			root_node_id VARCHAR(36),
 			nodes JSONB DEFAULT '[]',
 			stats JSONB DEFAULT '{}',
+			holdout JSONB,
 			created_at TIMESTAMPTZ DEFAULT NOW(),
 			updated_at TIMESTAMPTZ DEFAULT NOW(),
 			deleted_at TIMESTAMPTZ
@@ -528,6 +610,7 @@ func InitializeWorkspaceDatabase(db *sql.DB) error {
 				IF OLD.custom_json_3 IS DISTINCT FROM NEW.custom_json_3 THEN changes_json := changes_json || jsonb_build_object('custom_json_3', jsonb_build_object('old', OLD.custom_json_3, 'new', NEW.custom_json_3)); END IF;
 				IF OLD.custom_json_4 IS DISTINCT FROM NEW.custom_json_4 THEN changes_json := changes_json || jsonb_build_object('custom_json_4', jsonb_build_object('old', OLD.custom_json_4, 'new', NEW.custom_json_4)); END IF;
 				IF OLD.custom_json_5 IS DISTINCT FROM NEW.custom_json_5 THEN changes_json := changes_json || jsonb_build_object('custom_json_5', jsonb_build_object('old', OLD.custom_json_5, 'new', NEW.custom_json_5)); END IF;
+				IF OLD.attributes IS DISTINCT FROM NEW.attributes THEN changes_json := changes_json || jsonb_build_object('attributes', jsonb_build_object('old', OLD.attributes, 'new', NEW.attributes)); END IF;
 				IF changes_json = '{}'::jsonb THEN RETURN NEW; END IF;
 			END IF;
 		IF TG_OP = 'INSERT' THEN
@@ -663,17 +746,25 @@ func InitializeWorkspaceDatabase(db *sql.DB) error {
 		DECLARE
 			changes_json JSONB := '{}'::jsonb;
 			entity_id_value VARCHAR(255);
+			contact_email VARCHAR(255);
 		BEGIN
 			-- Use message_id if available, otherwise use inbound webhook event id
 			entity_id_value := COALESCE(NEW.message_id, NEW.id::text);
 
+			-- Events for a former address belong to the contact that now owns it,
+			-- unless a contact has since been created with that address
+			SELECT a.email INTO contact_email FROM contact_email_aliases a
+			WHERE a.alias = NEW.recipient_email
+			AND NOT EXISTS (SELECT 1 FROM contacts c WHERE c.email = a.alias);
+			contact_email := COALESCE(contact_email, NEW.recipient_email);
+
 			changes_json := jsonb_build_object('type', jsonb_build_object('new', NEW.type), 'source', jsonb_build_object('new', NEW.source));
 			IF NEW.bounce_type IS NOT NULL AND NEW.bounce_type != '' THEN changes_json := changes_json || jsonb_build_object('bounce_type', jsonb_build_object('new', NEW.bounce_type)); END IF;
 			IF NEW.bounce_category IS NOT NULL AND NEW.bounce_category != '' THEN changes_json := changes_json || jsonb_build_object('bounce_category', jsonb_build_object('new', NEW.bounce_category)); END IF;
 			IF NEW.bounce_diagnostic IS NOT NULL AND NEW.bounce_diagnostic != '' THEN changes_json := changes_json || jsonb_build_object('bounce_diagnostic', jsonb_build_object('new', NEW.bounce_diagnostic)); END IF;
 			IF NEW.complaint_feedback_type IS NOT NULL AND NEW.complaint_feedback_type != '' THEN changes_json := changes_json || jsonb_build_object('complaint_feedback_type', jsonb_build_object('new', NEW.complaint_feedback_type)); END IF;
 			INSERT INTO contact_timeline (email, operation, entity_type, kind, entity_id, changes, created_at)
-			VALUES (NEW.recipient_email, 'insert', 'inbound_webhook_event', 'insert_inbound_webhook_event', entity_id_value, changes_json, CURRENT_TIMESTAMP);
+			VALUES (contact_email, 'insert', 'inbound_webhook_event', 'insert_inbound_webhook_event', entity_id_value, changes_json, CURRENT_TIMESTAMP);
 			RETURN NEW;
 		END;
 		$$ LANGUAGE plpgsql;`,
@@ -833,9 +924,55 @@ func InitializeWorkspaceDatabase(db *sql.DB) error {
 			RETURN NEW;
 		END;
 		$$ LANGUAGE plpgsql;`,
+		// Erased contacts trigger function
+		`CREATE OR REPLACE FUNCTION prevent_erased_contact_insert()
+		RETURNS TRIGGER AS $$
+		BEGIN
+			IF EXISTS (
+				SELECT 1 FROM erased_contacts
+				WHERE email_hash = encode(sha256(convert_to(NEW.email, 'UTF8')), 'hex')
+			) THEN
+				RAISE EXCEPTION 'contact was erased and cannot be recreated' USING ERRCODE = 'NTF01';
+			END IF;
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql;`,
+		// Email verification queue trigger function
+		`CREATE OR REPLACE FUNCTION queue_email_verification()
+		RETURNS TRIGGER AS $$
+		BEGIN
+			IF TG_OP = 'UPDATE' AND OLD.email = NEW.email THEN
+				RETURN NEW;
+			END IF;
+			INSERT INTO email_verification_queue (email, queued_at)
+			VALUES (NEW.email, CURRENT_TIMESTAMP)
+			ON CONFLICT (email) DO UPDATE SET queued_at = EXCLUDED.queued_at;
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql;`,
+		// Contact tag timeline trigger function
+		`CREATE OR REPLACE FUNCTION track_contact_tag_changes()
+		RETURNS TRIGGER AS $$
+		BEGIN
+			IF TG_OP = 'DELETE' THEN
+				INSERT INTO contact_timeline (email, operation, entity_type, kind, entity_id, changes, created_at)
+				VALUES (OLD.email, 'delete', 'contact_tag', 'contact.untagged', OLD.tag,
+					jsonb_build_object('tag', jsonb_build_object('old', OLD.tag)), CURRENT_TIMESTAMP);
+				RETURN OLD;
+			END IF;
+			INSERT INTO contact_timeline (email, operation, entity_type, kind, entity_id, changes, created_at)
+			VALUES (NEW.email, 'insert', 'contact_tag', 'contact.tagged', NEW.tag,
+				jsonb_build_object('tag', jsonb_build_object('new', NEW.tag)), CURRENT_TIMESTAMP);
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql;`,
 		// Create triggers
 		`DROP TRIGGER IF EXISTS contact_changes_trigger ON contacts`,
 		`CREATE TRIGGER contact_changes_trigger AFTER INSERT OR UPDATE ON contacts FOR EACH ROW EXECUTE FUNCTION track_contact_changes()`,
+		`DROP TRIGGER IF EXISTS prevent_erased_contact_insert ON contacts`,
+		`CREATE TRIGGER prevent_erased_contact_insert BEFORE INSERT ON contacts FOR EACH ROW EXECUTE FUNCTION prevent_erased_contact_insert()`,
+		`DROP TRIGGER IF EXISTS contact_email_verification_trigger ON contacts`,
+		`CREATE TRIGGER contact_email_verification_trigger AFTER INSERT OR UPDATE OF email ON contacts FOR EACH ROW EXECUTE FUNCTION queue_email_verification()`,
 		`DROP TRIGGER IF EXISTS contact_list_changes_trigger ON contact_lists`,
 		`CREATE TRIGGER contact_list_changes_trigger AFTER INSERT OR UPDATE ON contact_lists FOR EACH ROW EXECUTE FUNCTION track_contact_list_changes()`,
 		`DROP TRIGGER IF EXISTS message_history_changes_trigger ON message_history`,
@@ -844,6 +981,8 @@ func InitializeWorkspaceDatabase(db *sql.DB) error {
 		`CREATE TRIGGER inbound_webhook_event_changes_trigger AFTER INSERT ON inbound_webhook_events FOR EACH ROW EXECUTE FUNCTION track_inbound_webhook_event_changes()`,
 		`DROP TRIGGER IF EXISTS contact_segment_changes_trigger ON contact_segments`,
 		`CREATE TRIGGER contact_segment_changes_trigger AFTER INSERT OR DELETE ON contact_segments FOR EACH ROW EXECUTE FUNCTION track_contact_segment_changes()`,
+		`DROP TRIGGER IF EXISTS contact_tag_changes_trigger ON contact_tags`,
+		`CREATE TRIGGER contact_tag_changes_trigger AFTER INSERT OR DELETE ON contact_tags FOR EACH ROW EXECUTE FUNCTION track_contact_tag_changes()`,
 		`DROP TRIGGER IF EXISTS contact_timeline_queue_trigger ON contact_timeline`,
 		`CREATE TRIGGER contact_timeline_queue_trigger AFTER INSERT ON contact_timeline FOR EACH ROW EXECUTE FUNCTION queue_contact_for_segment_recomputation()`,
 		`DROP TRIGGER IF EXISTS message_history_status_trigger ON message_history`,
@@ -900,7 +1039,8 @@ func InitializeWorkspaceDatabase(db *sql.DB) error {
 				   NEW.custom_json_2 IS NOT DISTINCT FROM OLD.custom_json_2 AND
 				   NEW.custom_json_3 IS NOT DISTINCT FROM OLD.custom_json_3 AND
 				   NEW.custom_json_4 IS NOT DISTINCT FROM OLD.custom_json_4 AND
-				   NEW.custom_json_5 IS NOT DISTINCT FROM OLD.custom_json_5 THEN
+				   NEW.custom_json_5 IS NOT DISTINCT FROM OLD.custom_json_5 AND
+				   NEW.attributes IS NOT DISTINCT FROM OLD.attributes THEN
 					RETURN NEW;
 				END IF;
 			ELSIF TG_OP = 'DELETE' THEN
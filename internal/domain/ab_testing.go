@@ -0,0 +1,457 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// TestSignificanceMethod defines how an A/B test decides that a variation really wins
+type TestSignificanceMethod string
+
+const (
+	// TestSignificanceChiSquared tests that the rates of the variations differ (chi-squared test
+	// of independence). For goal revenue, the best variation is compared with each other one with
+	// Welch's test.
+	TestSignificanceChiSquared TestSignificanceMethod = "chi_squared"
+	// TestSignificanceBayesian estimates the probability of each variation to be the best
+	TestSignificanceBayesian TestSignificanceMethod = "bayesian"
+)
+
+// TestInconclusivePolicy defines the variation sent when an A/B test is inconclusive
+type TestInconclusivePolicy string
+
+const (
+	// TestInconclusiveSendDefault sends the first variation, the control
+	TestInconclusiveSendDefault TestInconclusivePolicy = "send_default"
+	// TestInconclusiveSendBest sends the variation with the best observed value anyway
+	TestInconclusiveSendBest TestInconclusivePolicy = "send_best"
+)
+
+const (
+	// DefaultTestConfidenceLevel is the confidence required to declare a winner
+	DefaultTestConfidenceLevel = 0.95
+	// DefaultTestMinSampleSize is the number of recipients each variation needs before a winner is declared
+	DefaultTestMinSampleSize = 100
+
+	// TestInconclusiveInsufficientSample is reported when a variation has too few recipients
+	TestInconclusiveInsufficientSample = "insufficient_sample"
+	// TestInconclusiveNotSignificant is reported when the difference may be due to chance
+	TestInconclusiveNotSignificant = "not_significant"
+
+	// bayesianDraws is the number of posterior draws used to estimate the probability to be best
+	bayesianDraws = 20000
+)
+
+// IsValid returns true if the metric is supported
+func (m TestWinnerMetric) IsValid() bool {
+	switch m {
+	case TestWinnerMetricOpenRate, TestWinnerMetricClickRate, TestWinnerMetricClickToOpenRate, TestWinnerMetricGoalRevenue:
+		return true
+	}
+	return false
+}
+
+// WinnerMetric returns the metric the variations are compared on, the click rate by default
+func (b *BroadcastTestSettings) WinnerMetric() TestWinnerMetric {
+	if b.AutoSendWinnerMetric == "" {
+		return TestWinnerMetricClickRate
+	}
+	return b.AutoSendWinnerMetric
+}
+
+// GetConfidenceLevel returns the configured confidence level or the default
+func (b *BroadcastTestSettings) GetConfidenceLevel() float64 {
+	if b.ConfidenceLevel <= 0 {
+		return DefaultTestConfidenceLevel
+	}
+	return b.ConfidenceLevel
+}
+
+// GetMinSampleSize returns the configured minimum sample size or the default
+func (b *BroadcastTestSettings) GetMinSampleSize() int {
+	if b.MinSampleSize <= 0 {
+		return DefaultTestMinSampleSize
+	}
+	return b.MinSampleSize
+}
+
+// GetInconclusivePolicy returns the configured policy, sending the control by default
+func (b *BroadcastTestSettings) GetInconclusivePolicy() TestInconclusivePolicy {
+	if b.InconclusivePolicy == "" {
+		return TestInconclusiveSendDefault
+	}
+	return b.InconclusivePolicy
+}
+
+// validateSignificance validates the settings of the winner significance test
+func (b *BroadcastTestSettings) validateSignificance() error {
+	switch b.SignificanceMethod {
+	case "", TestSignificanceChiSquared, TestSignificanceBayesian:
+	default:
+		return fmt.Errorf("invalid test significance method: %s", b.SignificanceMethod)
+	}
+
+	if b.ConfidenceLevel != 0 && (b.ConfidenceLevel < 0.8 || b.ConfidenceLevel > 0.999) {
+		return fmt.Errorf("test confidence level must be between 0.8 and 0.999")
+	}
+
+	if b.MinSampleSize < 0 {
+		return fmt.Errorf("test min sample size cannot be negative")
+	}
+
+	switch b.InconclusivePolicy {
+	case "", TestInconclusiveSendDefault, TestInconclusiveSendBest:
+	default:
+		return fmt.Errorf("invalid test inconclusive policy: %s", b.InconclusivePolicy)
+	}
+
+	return nil
+}
+
+// GoalRevenueSum holds the goal revenue attributed to the recipients of a broadcast variation
+type GoalRevenueSum struct {
+	Conversions    int     `json:"conversions"`     // recipients who reached a goal after the send
+	Revenue        float64 `json:"revenue"`         // sum of the goal values
+	RevenueSquares float64 `json:"revenue_squares"` // sum of the squared revenue of each recipient
+}
+
+// ABTestSample holds the observations of a variation for the winner metric
+type ABTestSample struct {
+	TemplateID string
+	Recipients int
+	// Rate metrics: Successes out of Trials (recipients, or opens for the click-to-open rate)
+	Trials    int
+	Successes int
+	// Goal revenue: sum and sum of squares of the revenue of each recipient
+	Revenue        float64
+	RevenueSquares float64
+}
+
+// NewABTestSample builds the sample of a variation from its message statistics and, for the
+// goal revenue metric, its attributed revenue. Rates use the delivered messages, or the sent
+// ones when the provider does not report deliveries.
+func NewABTestSample(templateID string, metric TestWinnerMetric, stats *MessageHistoryStatusSum, revenue *GoalRevenueSum) ABTestSample {
+	recipients := stats.TotalDelivered
+	if recipients == 0 {
+		recipients = stats.TotalSent
+	}
+
+	sample := ABTestSample{
+		TemplateID: templateID,
+		Recipients: recipients,
+		Trials:     recipients,
+	}
+
+	switch metric {
+	case TestWinnerMetricOpenRate:
+		sample.Successes = stats.TotalOpened
+	case TestWinnerMetricClickRate:
+		sample.Successes = stats.TotalClicked
+	case TestWinnerMetricClickToOpenRate:
+		sample.Trials = stats.TotalOpened
+		sample.Successes = stats.TotalClicked
+	case TestWinnerMetricGoalRevenue:
+		if revenue != nil {
+			sample.Revenue = revenue.Revenue
+			sample.RevenueSquares = revenue.RevenueSquares
+		}
+	}
+
+	return sample
+}
+
+// value returns the observed metric of the sample
+func (s ABTestSample) value(metric TestWinnerMetric) float64 {
+	if metric == TestWinnerMetricGoalRevenue {
+		if s.Recipients == 0 {
+			return 0
+		}
+		return s.Revenue / float64(s.Recipients)
+	}
+	if s.Trials == 0 {
+		return 0
+	}
+	return float64(s.Successes) / float64(s.Trials)
+}
+
+// standardError returns the standard error of the observed metric
+func (s ABTestSample) standardError(metric TestWinnerMetric) float64 {
+	if metric == TestWinnerMetricGoalRevenue {
+		if s.Recipients < 2 {
+			return 0
+		}
+		n := float64(s.Recipients)
+		mean := s.Revenue / n
+		variance := math.Max((s.RevenueSquares-n*mean*mean)/(n-1), 0)
+		return math.Sqrt(variance / n)
+	}
+	if s.Trials == 0 {
+		return 0
+	}
+	rate := s.value(metric)
+	return math.Sqrt(rate * (1 - rate) / float64(s.Trials))
+}
+
+// VariationStatistics holds the statistics of a variation for the winner metric
+type VariationStatistics struct {
+	Recipients      int     `json:"recipients"`
+	Value           float64 `json:"value"` // rate, or revenue per recipient
+	ConfidenceLower float64 `json:"confidence_lower"`
+	ConfidenceUpper float64 `json:"confidence_upper"`
+	// Bayesian method only
+	ProbabilityToBeBest *float64 `json:"probability_to_be_best,omitempty"`
+}
+
+// ABTestStatistics holds the outcome of the comparison of the variations of an A/B test
+type ABTestStatistics struct {
+	Metric             TestWinnerMetric                `json:"metric"`
+	SignificanceMethod TestSignificanceMethod          `json:"significance_method,omitempty"`
+	ConfidenceLevel    float64                         `json:"confidence_level"`
+	MinSampleSize      int                             `json:"min_sample_size"`
+	Variations         map[string]*VariationStatistics `json:"variations"`
+	// BestTemplateID has the best observed value
+	BestTemplateID string `json:"best_template_id,omitempty"`
+	// PValue of the chi-squared (or Welch) test
+	PValue             *float64 `json:"p_value,omitempty"`
+	Conclusive         bool     `json:"conclusive"`
+	InconclusiveReason string   `json:"inconclusive_reason,omitempty"`
+	// WinnerTemplateID is the variation to send: the best one when the test is conclusive,
+	// otherwise the one chosen by the inconclusive policy
+	WinnerTemplateID string `json:"winner_template_id,omitempty"`
+}
+
+// EvaluateABTest compares the samples of the variations. Without a significance method, the
+// variation with the best observed value wins, as before significance testing was available.
+func EvaluateABTest(settings *BroadcastTestSettings, samples []ABTestSample) *ABTestStatistics {
+	metric := settings.WinnerMetric()
+	result := &ABTestStatistics{
+		Metric:             metric,
+		SignificanceMethod: settings.SignificanceMethod,
+		ConfidenceLevel:    settings.GetConfidenceLevel(),
+		Variations:         make(map[string]*VariationStatistics, len(samples)),
+	}
+	if settings.SignificanceMethod != "" {
+		result.MinSampleSize = settings.GetMinSampleSize()
+	}
+	if len(samples) == 0 {
+		return result
+	}
+
+	z := math.Sqrt2 * math.Erfinv(result.ConfidenceLevel)
+	best := 0
+	for i, sample := range samples {
+		value := sample.value(metric)
+		se := sample.standardError(metric)
+		result.Variations[sample.TemplateID] = &VariationStatistics{
+			Recipients:      sample.Recipients,
+			Value:           value,
+			ConfidenceLower: value - z*se,
+			ConfidenceUpper: value + z*se,
+		}
+		if value > samples[best].value(metric) {
+			best = i
+		}
+	}
+	result.BestTemplateID = samples[best].TemplateID
+
+	switch settings.SignificanceMethod {
+	case "":
+		result.Conclusive = true
+	case TestSignificanceChiSquared:
+		var pValue float64
+		if metric == TestWinnerMetricGoalRevenue {
+			pValue = welchPValue(samples, best, metric)
+		} else {
+			pValue = chiSquaredPValue(samples)
+		}
+		result.PValue = &pValue
+		result.Conclusive = pValue < 1-result.ConfidenceLevel
+	case TestSignificanceBayesian:
+		probabilities := probabilitiesToBeBest(samples, metric)
+		for i, sample := range samples {
+			probability := probabilities[i]
+			result.Variations[sample.TemplateID].ProbabilityToBeBest = &probability
+		}
+		result.Conclusive = probabilities[best] >= result.ConfidenceLevel
+	}
+
+	if settings.SignificanceMethod != "" {
+		for _, sample := range samples {
+			if sample.Recipients < result.MinSampleSize {
+				result.Conclusive = false
+				result.InconclusiveReason = TestInconclusiveInsufficientSample
+				break
+			}
+		}
+		if !result.Conclusive && result.InconclusiveReason == "" {
+			result.InconclusiveReason = TestInconclusiveNotSignificant
+		}
+	}
+
+	result.WinnerTemplateID = result.BestTemplateID
+	if !result.Conclusive && settings.GetInconclusivePolicy() == TestInconclusiveSendDefault {
+		result.WinnerTemplateID = samples[0].TemplateID
+		if len(settings.Variations) > 0 {
+			result.WinnerTemplateID = settings.Variations[0].TemplateID
+		}
+	}
+
+	return result
+}
+
+// chiSquaredPValue tests the independence of the variation and the success of the trials
+func chiSquaredPValue(samples []ABTestSample) float64 {
+	successes, trials := 0, 0
+	for _, sample := range samples {
+		successes += sample.Successes
+		trials += sample.Trials
+	}
+	if trials == 0 || successes == 0 || successes == trials {
+		return 1
+	}
+
+	pooled := float64(successes) / float64(trials)
+	statistic := 0.0
+	groups := 0
+	for _, sample := range samples {
+		if sample.Trials == 0 {
+			continue
+		}
+		groups++
+		expectedSuccesses := float64(sample.Trials) * pooled
+		expectedFailures := float64(sample.Trials) - expectedSuccesses
+		failures := float64(sample.Trials - sample.Successes)
+		statistic += math.Pow(float64(sample.Successes)-expectedSuccesses, 2) / expectedSuccesses
+		statistic += math.Pow(failures-expectedFailures, 2) / expectedFailures
+	}
+	if groups < 2 {
+		return 1
+	}
+
+	return regularizedGammaQ(float64(groups-1)/2, statistic/2)
+}
+
+// welchPValue compares the best sample with each other one and returns the largest p-value,
+// so the best variation has to beat all the others
+func welchPValue(samples []ABTestSample, best int, metric TestWinnerMetric) float64 {
+	pValue := 0.0
+	for i, sample := range samples {
+		if i == best {
+			continue
+		}
+		se := math.Hypot(samples[best].standardError(metric), sample.standardError(metric))
+		if se == 0 {
+			return 1
+		}
+		z := math.Abs(samples[best].value(metric)-sample.value(metric)) / se
+		pValue = math.Max(pValue, math.Erfc(z/math.Sqrt2))
+	}
+	return pValue
+}
+
+// probabilitiesToBeBest estimates the probability of each sample to have the best metric by
+// drawing from the posteriors: Beta(1+successes, 1+failures) for rates and a normal
+// approximation of the mean for revenue. The draws are seeded so results are reproducible.
+func probabilitiesToBeBest(samples []ABTestSample, metric TestWinnerMetric) []float64 {
+	r := rand.New(rand.NewSource(1))
+	wins := make([]int, len(samples))
+	draws := make([]float64, len(samples))
+
+	for d := 0; d < bayesianDraws; d++ {
+		best := 0
+		for i, sample := range samples {
+			if metric == TestWinnerMetricGoalRevenue {
+				draws[i] = sample.value(metric) + r.NormFloat64()*sample.standardError(metric)
+			} else {
+				draws[i] = sampleBeta(r, float64(1+sample.Successes), float64(1+sample.Trials-sample.Successes))
+			}
+			if draws[i] > draws[best] {
+				best = i
+			}
+		}
+		wins[best]++
+	}
+
+	probabilities := make([]float64, len(samples))
+	for i, w := range wins {
+		probabilities[i] = float64(w) / bayesianDraws
+	}
+	return probabilities
+}
+
+// sampleBeta draws from a Beta(a, b) distribution, with a and b >= 1
+func sampleBeta(r *rand.Rand, a, b float64) float64 {
+	x := sampleGamma(r, a)
+	y := sampleGamma(r, b)
+	return x / (x + y)
+}
+
+// sampleGamma draws from a Gamma(shape, 1) distribution with shape >= 1 (Marsaglia and Tsang)
+func sampleGamma(r *rand.Rand, shape float64) float64 {
+	d := shape - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := r.NormFloat64()
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+		v = v * v * v
+		u := r.Float64()
+		if u < 1-0.0331*x*x*x*x || math.Log(u) < 0.5*x*x+d*(1-v+math.Log(v)) {
+			return d * v
+		}
+	}
+}
+
+// regularizedGammaQ returns the upper regularized incomplete gamma function Q(a, x), which is
+// the survival function of the chi-squared distribution with 2a degrees of freedom at 2x
+func regularizedGammaQ(a, x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+
+	lgamma, _ := math.Lgamma(a)
+	prefactor := math.Exp(-x + a*math.Log(x) - lgamma)
+
+	if x < a+1 {
+		// Series expansion of P(a, x)
+		term := 1 / a
+		sum := term
+		for n := 1; n < 1000; n++ {
+			term *= x / (a + float64(n))
+			sum += term
+			if math.Abs(term) < math.Abs(sum)*1e-15 {
+				break
+			}
+		}
+		return math.Max(1-sum*prefactor, 0)
+	}
+
+	// Continued fraction of Q(a, x), modified Lentz's method
+	const tiny = 1e-300
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for i := 1; i < 1000; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < 1e-15 {
+			break
+		}
+	}
+	return prefactor * h
+}
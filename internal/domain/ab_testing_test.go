@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegularizedGammaQ(t *testing.T) {
+	// Critical values of the chi-squared distribution at 5%
+	assert.InDelta(t, 0.05, regularizedGammaQ(0.5, 3.841/2), 1e-3)
+	assert.InDelta(t, 0.05, regularizedGammaQ(1, 5.991/2), 1e-3)
+	assert.InDelta(t, 0.05, regularizedGammaQ(3.5, 14.067/2), 1e-3)
+	assert.Equal(t, 1.0, regularizedGammaQ(1, 0))
+}
+
+func TestNewABTestSample(t *testing.T) {
+	stats := &MessageHistoryStatusSum{TotalSent: 120, TotalDelivered: 100, TotalOpened: 40, TotalClicked: 10}
+
+	sample := NewABTestSample("tplA", TestWinnerMetricClickToOpenRate, stats, nil)
+	assert.Equal(t, 100, sample.Recipients)
+	assert.Equal(t, 40, sample.Trials)
+	assert.Equal(t, 10, sample.Successes)
+
+	sample = NewABTestSample("tplA", TestWinnerMetricOpenRate, &MessageHistoryStatusSum{TotalSent: 120, TotalOpened: 40}, nil)
+	assert.Equal(t, 120, sample.Trials, "sent messages are used when deliveries are not reported")
+
+	sample = NewABTestSample("tplA", TestWinnerMetricGoalRevenue, stats, &GoalRevenueSum{Conversions: 2, Revenue: 80, RevenueSquares: 3400})
+	assert.Equal(t, 80.0, sample.Revenue)
+	assert.InDelta(t, 0.8, sample.value(TestWinnerMetricGoalRevenue), 1e-9)
+}
+
+func TestEvaluateABTest(t *testing.T) {
+	variations := []BroadcastVariation{{TemplateID: "tplA"}, {TemplateID: "tplB"}}
+
+	t.Run("no samples", func(t *testing.T) {
+		result := EvaluateABTest(&BroadcastTestSettings{Variations: variations}, nil)
+		assert.Empty(t, result.WinnerTemplateID)
+	})
+
+	t.Run("without significance method the best value wins", func(t *testing.T) {
+		settings := &BroadcastTestSettings{AutoSendWinnerMetric: TestWinnerMetricOpenRate, Variations: variations}
+		result := EvaluateABTest(settings, []ABTestSample{
+			{TemplateID: "tplA", Recipients: 10, Trials: 10, Successes: 3},
+			{TemplateID: "tplB", Recipients: 10, Trials: 10, Successes: 4},
+		})
+
+		assert.True(t, result.Conclusive)
+		assert.Nil(t, result.PValue)
+		assert.Equal(t, "tplB", result.WinnerTemplateID)
+		assert.InDelta(t, 0.4, result.Variations["tplB"].Value, 1e-9)
+	})
+
+	t.Run("chi-squared significant", func(t *testing.T) {
+		settings := &BroadcastTestSettings{SignificanceMethod: TestSignificanceChiSquared, Variations: variations}
+		result := EvaluateABTest(settings, []ABTestSample{
+			{TemplateID: "tplA", Recipients: 1000, Trials: 1000, Successes: 50},
+			{TemplateID: "tplB", Recipients: 1000, Trials: 1000, Successes: 90},
+		})
+
+		assert.Equal(t, TestWinnerMetricClickRate, result.Metric)
+		require.NotNil(t, result.PValue)
+		assert.Less(t, *result.PValue, 0.01)
+		assert.True(t, result.Conclusive)
+		assert.Equal(t, "tplB", result.WinnerTemplateID)
+	})
+
+	t.Run("chi-squared not significant sends the default", func(t *testing.T) {
+		settings := &BroadcastTestSettings{SignificanceMethod: TestSignificanceChiSquared, Variations: variations}
+		result := EvaluateABTest(settings, []ABTestSample{
+			{TemplateID: "tplA", Recipients: 1000, Trials: 1000, Successes: 50},
+			{TemplateID: "tplB", Recipients: 1000, Trials: 1000, Successes: 55},
+		})
+
+		assert.False(t, result.Conclusive)
+		assert.Equal(t, TestInconclusiveNotSignificant, result.InconclusiveReason)
+		assert.Equal(t, "tplB", result.BestTemplateID)
+		assert.Equal(t, "tplA", result.WinnerTemplateID)
+	})
+
+	t.Run("inconclusive test can send the best variation", func(t *testing.T) {
+		settings := &BroadcastTestSettings{
+			SignificanceMethod: TestSignificanceChiSquared,
+			InconclusivePolicy: TestInconclusiveSendBest,
+			Variations:         variations,
+		}
+		result := EvaluateABTest(settings, []ABTestSample{
+			{TemplateID: "tplA", Recipients: 1000, Trials: 1000, Successes: 50},
+			{TemplateID: "tplB", Recipients: 1000, Trials: 1000, Successes: 55},
+		})
+
+		assert.False(t, result.Conclusive)
+		assert.Equal(t, "tplB", result.WinnerTemplateID)
+	})
+
+	t.Run("insufficient sample", func(t *testing.T) {
+		settings := &BroadcastTestSettings{SignificanceMethod: TestSignificanceBayesian, MinSampleSize: 500, Variations: variations}
+		result := EvaluateABTest(settings, []ABTestSample{
+			{TemplateID: "tplA", Recipients: 400, Trials: 400, Successes: 10},
+			{TemplateID: "tplB", Recipients: 400, Trials: 400, Successes: 100},
+		})
+
+		assert.False(t, result.Conclusive)
+		assert.Equal(t, TestInconclusiveInsufficientSample, result.InconclusiveReason)
+		assert.Equal(t, "tplA", result.WinnerTemplateID)
+	})
+
+	t.Run("bayesian probability to be best", func(t *testing.T) {
+		settings := &BroadcastTestSettings{SignificanceMethod: TestSignificanceBayesian, Variations: variations}
+		samples := []ABTestSample{
+			{TemplateID: "tplA", Recipients: 1000, Trials: 1000, Successes: 100},
+			{TemplateID: "tplB", Recipients: 1000, Trials: 1000, Successes: 150},
+		}
+		result := EvaluateABTest(settings, samples)
+
+		require.NotNil(t, result.Variations["tplB"].ProbabilityToBeBest)
+		probabilityB := *result.Variations["tplB"].ProbabilityToBeBest
+		assert.Greater(t, probabilityB, 0.99)
+		assert.InDelta(t, 1, probabilityB+*result.Variations["tplA"].ProbabilityToBeBest, 1e-9)
+		assert.True(t, result.Conclusive)
+		assert.Equal(t, "tplB", result.WinnerTemplateID)
+
+		// The draws are seeded
+		assert.Equal(t, probabilityB, *EvaluateABTest(settings, samples).Variations["tplB"].ProbabilityToBeBest)
+	})
+
+	t.Run("goal revenue", func(t *testing.T) {
+		settings := &BroadcastTestSettings{
+			AutoSendWinnerMetric: TestWinnerMetricGoalRevenue,
+			SignificanceMethod:   TestSignificanceChiSquared,
+			Variations:           variations,
+		}
+		// 20 purchases of 50 against 60 purchases of 50 out of 1000 recipients each
+		result := EvaluateABTest(settings, []ABTestSample{
+			{TemplateID: "tplA", Recipients: 1000, Revenue: 1000, RevenueSquares: 50000},
+			{TemplateID: "tplB", Recipients: 1000, Revenue: 3000, RevenueSquares: 150000},
+		})
+
+		assert.InDelta(t, 3.0, result.Variations["tplB"].Value, 1e-9)
+		require.NotNil(t, result.PValue)
+		assert.Less(t, *result.PValue, 0.01)
+		assert.Equal(t, "tplB", result.WinnerTemplateID)
+	})
+}
+
+func TestBroadcastTestSettings_validateSignificance(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings BroadcastTestSettings
+		wantErr  string
+	}{
+		{name: "defaults", settings: BroadcastTestSettings{}},
+		{name: "valid", settings: BroadcastTestSettings{SignificanceMethod: TestSignificanceBayesian, ConfidenceLevel: 0.9, MinSampleSize: 200, InconclusivePolicy: TestInconclusiveSendBest}},
+		{name: "invalid method", settings: BroadcastTestSettings{SignificanceMethod: "t_test"}, wantErr: "significance method"},
+		{name: "confidence too low", settings: BroadcastTestSettings{ConfidenceLevel: 0.5}, wantErr: "confidence level"},
+		{name: "negative sample size", settings: BroadcastTestSettings{MinSampleSize: -1}, wantErr: "min sample size"},
+		{name: "invalid policy", settings: BroadcastTestSettings{InconclusivePolicy: "send_none"}, wantErr: "inconclusive policy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.validateSignificance()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -57,6 +57,8 @@ var ValidEventKinds = []string{
 	"list.bounced", "list.complained", "list.pending", "list.removed",
 	// Segment events (require segment_id)
 	"segment.joined", "segment.left",
+	// Tag events (optional tag)
+	"contact.tagged", "contact.untagged",
 	// Email events
 	"email.sent", "email.delivered", "email.opened", "email.clicked",
 	"email.bounced", "email.complained", "email.unsubscribed",
@@ -152,6 +154,7 @@ type TimelineTriggerConfig struct {
 	SegmentID       *string          `json:"segment_id,omitempty"`        // Required for segment.* events
 	CustomEventName *string          `json:"custom_event_name,omitempty"` // Required for custom_event
 	UpdatedFields   []string         `json:"updated_fields,omitempty"`    // For contact.updated: only trigger on these field changes
+	Tag             *string          `json:"tag,omitempty"`               // For contact.tagged/untagged: only trigger on this tag
 	Conditions      *TreeNode        `json:"conditions"`                  // Reuse segments condition system
 	Frequency       TriggerFrequency `json:"frequency"`
 }
@@ -184,6 +187,12 @@ func (c *TimelineTriggerConfig) Validate() error {
 		}
 	}
 
+	// An optional tag narrows contact.tagged / contact.untagged events
+	if c.Tag != nil {
+		tag := NormalizeTag(*c.Tag)
+		c.Tag = &tag
+	}
+
 	// custom_event requires custom_event_name
 	if c.EventKind == "custom_event" {
 		if c.CustomEventName == nil || *c.CustomEventName == "" {
@@ -224,6 +233,7 @@ type Automation struct {
 	RootNodeID  string                 `json:"root_node_id"`
 	Nodes       []*AutomationNode      `json:"nodes"` // Embedded workflow nodes
 	Stats       *AutomationStats       `json:"stats,omitempty"`
+	Holdout     *HoldoutSettings       `json:"holdout,omitempty"` // Share of the enrolled contacts that exit without any action
 	CreatedAt   time.Time              `json:"created_at"`
 	UpdatedAt   time.Time              `json:"updated_at"`
 	DeletedAt   *time.Time             `json:"deleted_at,omitempty"` // Soft-delete timestamp
@@ -272,6 +282,10 @@ func (a *Automation) Validate() error {
 		return err
 	}
 
+	if err := a.Holdout.Validate(); err != nil {
+		return err
+	}
+
 	// Validate embedded nodes
 	for i, node := range a.Nodes {
 		if node == nil {
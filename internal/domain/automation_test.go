@@ -149,6 +149,14 @@ func TestTimelineTriggerConfig_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid config - tag event without tag",
+			config: &TimelineTriggerConfig{
+				EventKind: "contact.tagged",
+				Frequency: TriggerFrequencyEveryTime,
+			},
+			wantErr: false,
+		},
 		{
 			name: "valid config - segment event with segment_id",
 			config: &TimelineTriggerConfig{
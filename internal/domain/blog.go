@@ -673,6 +673,9 @@ type BlogPostRepository interface {
 	// though they don't touch post rows. idsHash detects deletes/replacements
 	// that preserve the timestamp.
 	GetFeedFingerprint(ctx context.Context, categorySlug *string, limit int) (maxUpdatedAt time.Time, idsHash string, err error)
+	// ListPostsPublishedBetween returns the newest `limit` posts published in
+	// (since, until], optionally limited to a category, for blog digests.
+	ListPostsPublishedBetween(ctx context.Context, categoryID string, since, until time.Time, limit int) ([]*BlogPost, error)
 	PublishPost(ctx context.Context, id string, publishedAt *time.Time) error
 	UnpublishPost(ctx context.Context, id string) error
 
@@ -741,6 +744,17 @@ type BlogService interface {
 	// path. It does not render post bodies. The second return is a short hex
 	// ETag suitable for emitting in the HTTP response.
 	GetFeedFingerprint(ctx context.Context, workspaceID string, categorySlug *string) (maxUpdatedAt time.Time, etag string, err error)
+
+	// ListDigestPosts returns the posts published in (since, until] as feed
+	// items without body, for blog digest broadcasts.
+	ListDigestPosts(ctx context.Context, workspaceID string, settings *BlogDigestSettings, since, until time.Time) ([]BlogFeedItem, error)
+
+	// Newsletter archive (public, no auth) — past broadcasts of the lists of
+	// BlogSettings.ArchiveListIDs, rendered with the category and post
+	// templates of the theme and without personal data.
+	RenderArchivePage(ctx context.Context, workspaceID, listID string, page int, themeVersion *int) (string, error)
+	RenderArchiveIssuePage(ctx context.Context, workspaceID, listID, broadcastID string, themeVersion *int) (string, error)
+	BuildArchiveFeed(ctx context.Context, workspaceID, listID string) (*BlogFeed, error)
 }
 
 // NormalizeSlug normalizes a string to be a valid slug
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultBlogDigestMaxPosts is the number of posts included in a digest when not configured
+	DefaultBlogDigestMaxPosts = 10
+	// MaxBlogDigestMaxPosts caps the number of posts included in a digest
+	MaxBlogDigestMaxPosts = 50
+)
+
+// BlogDigestSettings turns a recurring broadcast into a digest of the blog posts
+// published since its previous send
+type BlogDigestSettings struct {
+	Enabled    bool   `json:"enabled"`
+	CategoryID string `json:"category_id,omitempty"` // empty = posts of every category
+	MaxPosts   int    `json:"max_posts,omitempty"`
+}
+
+// Validate validates the blog digest settings
+func (s *BlogDigestSettings) Validate() error {
+	if !s.Enabled {
+		return nil
+	}
+
+	if s.MaxPosts < 0 || s.MaxPosts > MaxBlogDigestMaxPosts {
+		return fmt.Errorf("max_posts must be between 0 and %d", MaxBlogDigestMaxPosts)
+	}
+
+	return nil
+}
+
+// GetMaxPosts returns the configured number of posts or the default
+func (s *BlogDigestSettings) GetMaxPosts() int {
+	if s.MaxPosts <= 0 {
+		return DefaultBlogDigestMaxPosts
+	}
+	return s.MaxPosts
+}
+
+// IsBlogDigest returns true if the data feed includes a blog digest
+func (d *DataFeedSettings) IsBlogDigest() bool {
+	return d != nil && d.BlogDigest != nil && d.BlogDigest.Enabled
+}
+
+// BuildBlogDigestPosts converts feed items into the `posts` array exposed to digest templates
+func BuildBlogDigestPosts(items []BlogFeedItem) []MapOfAny {
+	posts := make([]MapOfAny, 0, len(items))
+	for _, item := range items {
+		authors := make([]MapOfAny, len(item.Authors))
+		for i, author := range item.Authors {
+			authors[i] = MapOfAny{"name": author.Name}
+			if author.AvatarURL != "" {
+				authors[i]["avatar_url"] = author.AvatarURL
+			}
+		}
+
+		posts = append(posts, MapOfAny{
+			"id":                 item.GUID,
+			"title":              item.Title,
+			"url":                item.URL,
+			"excerpt":            item.Excerpt,
+			"featured_image_url": item.FeaturedImageURL,
+			"category_slug":      item.CategorySlug,
+			"category_name":      item.CategoryName,
+			"authors":            authors,
+			"published_at":       item.PublishedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return posts
+}
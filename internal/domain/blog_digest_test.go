@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlogDigestSettings_Validate(t *testing.T) {
+	assert.NoError(t, (&BlogDigestSettings{Enabled: true}).Validate())
+	assert.NoError(t, (&BlogDigestSettings{Enabled: false, MaxPosts: 500}).Validate())
+	assert.Error(t, (&BlogDigestSettings{Enabled: true, MaxPosts: MaxBlogDigestMaxPosts + 1}).Validate())
+
+	feed := &DataFeedSettings{BlogDigest: &BlogDigestSettings{Enabled: true, MaxPosts: -1}}
+	assert.ErrorContains(t, feed.Validate(), "blog digest")
+
+	assert.Equal(t, DefaultBlogDigestMaxPosts, (&BlogDigestSettings{}).GetMaxPosts())
+	assert.Equal(t, 3, (&BlogDigestSettings{MaxPosts: 3}).GetMaxPosts())
+}
+
+func TestDataFeedSettings_IsBlogDigest(t *testing.T) {
+	var nilFeed *DataFeedSettings
+	assert.False(t, nilFeed.IsBlogDigest())
+	assert.False(t, (&DataFeedSettings{}).IsBlogDigest())
+	assert.False(t, (&DataFeedSettings{BlogDigest: &BlogDigestSettings{}}).IsBlogDigest())
+	assert.True(t, (&DataFeedSettings{BlogDigest: &BlogDigestSettings{Enabled: true}}).IsBlogDigest())
+}
+
+func TestBuildBlogDigestPosts(t *testing.T) {
+	published := time.Date(2026, 10, 15, 8, 0, 0, 0, time.FixedZone("CEST", 7200))
+
+	posts := BuildBlogDigestPosts([]BlogFeedItem{{
+		GUID:         "p1",
+		Title:        "Hello",
+		URL:          "https://blog.example.com/tech/hello",
+		CategorySlug: "tech",
+		CategoryName: "Tech",
+		Authors:      []BlogAuthor{{Name: "Ada"}, {Name: "Alan", AvatarURL: "https://example.com/alan.png"}},
+		PublishedAt:  published,
+	}})
+
+	require.Len(t, posts, 1)
+	assert.Equal(t, "Hello", posts[0]["title"])
+	assert.Equal(t, "https://blog.example.com/tech/hello", posts[0]["url"])
+	assert.Equal(t, "Tech", posts[0]["category_name"])
+	assert.Equal(t, "2026-10-15T06:00:00Z", posts[0]["published_at"])
+	authors := posts[0]["authors"].([]MapOfAny)
+	assert.Equal(t, MapOfAny{"name": "Ada"}, authors[0])
+	assert.Equal(t, "https://example.com/alan.png", authors[1]["avatar_url"])
+
+	assert.NotNil(t, BuildBlogDigestPosts(nil))
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 )
 
@@ -19,24 +20,29 @@ import (
 type BroadcastStatus string
 
 const (
-	BroadcastStatusDraft          BroadcastStatus = "draft"
-	BroadcastStatusScheduled      BroadcastStatus = "scheduled"
-	BroadcastStatusProcessing     BroadcastStatus = "processing" // Orchestrator is enqueueing emails
-	BroadcastStatusPaused         BroadcastStatus = "paused"
-	BroadcastStatusProcessed      BroadcastStatus = "processed" // Enqueueing complete
-	BroadcastStatusCancelled      BroadcastStatus = "cancelled"
-	BroadcastStatusFailed         BroadcastStatus = "failed"
-	BroadcastStatusTesting        BroadcastStatus = "testing"         // A/B test in progress
-	BroadcastStatusTestCompleted  BroadcastStatus = "test_completed"  // Test done, awaiting winner selection
-	BroadcastStatusWinnerSelected BroadcastStatus = "winner_selected" // Winner chosen, enqueueing to remaining
+	BroadcastStatusDraft           BroadcastStatus = "draft"
+	BroadcastStatusScheduled       BroadcastStatus = "scheduled"
+	BroadcastStatusProcessing      BroadcastStatus = "processing" // Orchestrator is enqueueing emails
+	BroadcastStatusPaused          BroadcastStatus = "paused"
+	BroadcastStatusProcessed       BroadcastStatus = "processed" // Enqueueing complete
+	BroadcastStatusCancelled       BroadcastStatus = "cancelled"
+	BroadcastStatusFailed          BroadcastStatus = "failed"
+	BroadcastStatusTesting         BroadcastStatus = "testing"          // A/B test in progress
+	BroadcastStatusTestCompleted   BroadcastStatus = "test_completed"   // Test done, awaiting winner selection
+	BroadcastStatusWinnerSelected  BroadcastStatus = "winner_selected"  // Winner chosen, enqueueing to remaining
+	BroadcastStatusRecurring       BroadcastStatus = "recurring"        // Definition spawning an instance at each occurrence
+	BroadcastStatusPendingApproval BroadcastStatus = "pending_approval" // Submitted for review
+	BroadcastStatusApproved        BroadcastStatus = "approved"         // Reviewed, can be scheduled
 )
 
 // TestWinnerMetric defines the metric used to determine the winning A/B test variation
 type TestWinnerMetric string
 
 const (
-	TestWinnerMetricOpenRate  TestWinnerMetric = "open_rate"
-	TestWinnerMetricClickRate TestWinnerMetric = "click_rate"
+	TestWinnerMetricOpenRate        TestWinnerMetric = "open_rate"
+	TestWinnerMetricClickRate       TestWinnerMetric = "click_rate"
+	TestWinnerMetricClickToOpenRate TestWinnerMetric = "click_to_open_rate" // clicks / opens
+	TestWinnerMetricGoalRevenue     TestWinnerMetric = "goal_revenue"       // goal value per recipient, from custom events
 )
 
 // BroadcastTestSettings contains configuration for A/B testing
@@ -47,6 +53,12 @@ type BroadcastTestSettings struct {
 	AutoSendWinnerMetric TestWinnerMetric     `json:"auto_send_winner_metric,omitempty"`
 	TestDurationHours    int                  `json:"test_duration_hours,omitempty"`
 	Variations           []BroadcastVariation `json:"variations"`
+
+	// Significance testing of the winner, the best observed value wins when no method is set
+	SignificanceMethod TestSignificanceMethod `json:"significance_method,omitempty"`
+	ConfidenceLevel    float64                `json:"confidence_level,omitempty"`    // defaults to 0.95
+	MinSampleSize      int                    `json:"min_sample_size,omitempty"`     // recipients per variation, defaults to 100
+	InconclusivePolicy TestInconclusivePolicy `json:"inconclusive_policy,omitempty"` // defaults to send_default
 }
 
 // Value implements the driver.Valuer interface for database serialization
@@ -92,6 +104,7 @@ func (b *BroadcastTestSettings) Scan(value interface{}) error {
 type BroadcastVariation struct {
 	VariationName string            `json:"variation_name"`
 	TemplateID    string            `json:"template_id"`
+	Subject       string            `json:"subject,omitempty"` // overrides the subject of the template
 	Metrics       *VariationMetrics `json:"metrics,omitempty"`
 	// joined servers-side
 	Template *Template `json:"template,omitempty"`
@@ -153,6 +166,61 @@ type AudienceSettings struct {
 	List                string   `json:"list,omitempty"`
 	Segments            []string `json:"segments,omitempty"`
 	ExcludeUnsubscribed bool     `json:"exclude_unsubscribed"`
+	// Only recipients of this broadcast who neither opened nor clicked it, and did not
+	// bounce, complain or unsubscribe
+	NonOpenersOf string `json:"non_openers_of,omitempty"`
+	// Contacts of these lists or segments are never targeted, whatever their subscription status
+	ExcludeLists    []string `json:"exclude_lists,omitempty"`
+	ExcludeSegments []string `json:"exclude_segments,omitempty"`
+	// Contacts sent an email in the last N days are not targeted (0 = disabled)
+	ExcludeEmailedWithinDays int `json:"exclude_emailed_within_days,omitempty"`
+	// A random, deterministic share of the audience that receives nothing
+	Holdout *HoldoutSettings `json:"holdout,omitempty"`
+}
+
+// MaxExcludeEmailedWithinDays caps the look-back window of ExcludeEmailedWithinDays
+const MaxExcludeEmailedWithinDays = 365
+
+// HasExclusions returns true if the audience excludes lists, segments or recently emailed contacts
+func (a AudienceSettings) HasExclusions() bool {
+	return len(a.ExcludeLists) > 0 || len(a.ExcludeSegments) > 0 || a.ExcludeEmailedWithinDays > 0
+}
+
+// WithoutExclusions returns a copy of the audience without its exclusions
+func (a AudienceSettings) WithoutExclusions() AudienceSettings {
+	a.ExcludeLists = nil
+	a.ExcludeSegments = nil
+	a.ExcludeEmailedWithinDays = 0
+	return a
+}
+
+// ValidateExclusions validates the exclusions of the audience
+func (a AudienceSettings) ValidateExclusions() error {
+	for _, listID := range a.ExcludeLists {
+		if listID == "" {
+			return fmt.Errorf("exclude_lists cannot contain an empty list id")
+		}
+		if listID == a.List {
+			return fmt.Errorf("the audience list cannot be excluded")
+		}
+	}
+
+	for _, segmentID := range a.ExcludeSegments {
+		if segmentID == "" {
+			return fmt.Errorf("exclude_segments cannot contain an empty segment id")
+		}
+		for _, included := range a.Segments {
+			if segmentID == included {
+				return fmt.Errorf("segment %s cannot be both included and excluded", segmentID)
+			}
+		}
+	}
+
+	if a.ExcludeEmailedWithinDays < 0 || a.ExcludeEmailedWithinDays > MaxExcludeEmailedWithinDays {
+		return fmt.Errorf("exclude_emailed_within_days must be between 0 and %d", MaxExcludeEmailedWithinDays)
+	}
+
+	return nil
 }
 
 // Value implements the driver.Valuer interface for database serialization
@@ -182,6 +250,14 @@ type ScheduleSettings struct {
 	ScheduledTime        string `json:"scheduled_time,omitempty"` // Format: HH:mm
 	Timezone             string `json:"timezone,omitempty"`       // IANA timezone format, e.g. "America/New_York"
 	UseRecipientTimezone bool   `json:"use_recipient_timezone"`
+	// Recurrence is an RRULE ("FREQ=WEEKLY;BYDAY=MO") or a cron expression ("0 9 * * 1").
+	// A recurring broadcast is a definition: each occurrence is sent as a new broadcast.
+	Recurrence string `json:"recurrence,omitempty"`
+}
+
+// IsRecurring returns true when the schedule spawns a new broadcast at each occurrence
+func (s ScheduleSettings) IsRecurring() bool {
+	return s.Recurrence != ""
 }
 
 // Value implements the driver.Valuer interface for database serialization
@@ -283,9 +359,13 @@ type Broadcast struct {
 	CancelledAt               *time.Time            `json:"cancelled_at,omitempty"`
 	PausedAt                  *time.Time            `json:"paused_at,omitempty"`
 	PauseReason               *string               `json:"pause_reason,omitempty"`
+	ParentBroadcastID         *string               `json:"parent_broadcast_id,omitempty"` // Recurring broadcast this instance was spawned from
 
 	// Data feed settings (global and recipient feeds)
 	DataFeed *DataFeedSettings `json:"data_feed,omitempty"`
+
+	// Review of the broadcast when the workspace requires approvals
+	Approval *BroadcastApproval `json:"approval,omitempty"`
 }
 
 // UTMParameters contains UTM tracking parameters for the broadcast
@@ -317,6 +397,35 @@ func (u *UTMParameters) Scan(value interface{}) error {
 	return json.Unmarshal(cloned, u)
 }
 
+// RecipientAudience returns the audience used to select the recipients of the broadcast,
+// with the holdout assignment seeded by the broadcast id
+func (b *Broadcast) RecipientAudience() AudienceSettings {
+	audience := b.Audience
+	if audience.Holdout.IsEnabled() {
+		holdout := *audience.Holdout
+		holdout.Seed = b.ID
+		audience.Holdout = &holdout
+	}
+	return audience
+}
+
+// SuffixBroadcastName appends a suffix to the name of a broadcast derived from another one,
+// shortening the name to fit in 255 bytes without cutting a character in half
+func SuffixBroadcastName(name, suffix string) string {
+	limit := 255 - len(suffix)
+	if len(name) > limit {
+		cut := 0
+		for i := range name {
+			if i > limit {
+				break
+			}
+			cut = i
+		}
+		name = name[:cut]
+	}
+	return name + suffix
+}
+
 // Validate validates the broadcast struct
 func (b *Broadcast) Validate() error {
 	if b.WorkspaceID == "" {
@@ -336,7 +445,8 @@ func (b *Broadcast) Validate() error {
 	case BroadcastStatusDraft, BroadcastStatusScheduled, BroadcastStatusProcessing,
 		BroadcastStatusPaused, BroadcastStatusProcessed, BroadcastStatusCancelled,
 		BroadcastStatusFailed, BroadcastStatusTesting, BroadcastStatusTestCompleted,
-		BroadcastStatusWinnerSelected:
+		BroadcastStatusWinnerSelected, BroadcastStatusRecurring,
+		BroadcastStatusPendingApproval, BroadcastStatusApproved:
 		// Valid status
 	default:
 		return fmt.Errorf("invalid broadcast status: %s", b.Status)
@@ -370,14 +480,15 @@ func (b *Broadcast) Validate() error {
 				return fmt.Errorf("auto send winner metric must be specified when auto winner is enabled")
 			}
 
-			switch b.TestSettings.AutoSendWinnerMetric {
-			case TestWinnerMetricOpenRate, TestWinnerMetricClickRate:
-				// Valid metric
-			default:
+			if !b.TestSettings.AutoSendWinnerMetric.IsValid() {
 				return fmt.Errorf("invalid test winner metric: %s", b.TestSettings.AutoSendWinnerMetric)
 			}
 		}
 
+		if err := b.TestSettings.validateSignificance(); err != nil {
+			return err
+		}
+
 		// Validate variations
 		for i, variation := range b.TestSettings.Variations {
 			if variation.TemplateID == "" {
@@ -392,6 +503,14 @@ func (b *Broadcast) Validate() error {
 		return fmt.Errorf("list is required")
 	}
 
+	if err := b.Audience.ValidateExclusions(); err != nil {
+		return err
+	}
+
+	if err := b.Audience.Holdout.Validate(); err != nil {
+		return err
+	}
+
 	// Validate schedule settings
 	if b.Schedule.IsScheduled && (b.Schedule.ScheduledDate == "" || b.Schedule.ScheduledTime == "") {
 		return fmt.Errorf("scheduled date and time are required when not sending immediately")
@@ -417,6 +536,15 @@ func (b *Broadcast) Validate() error {
 		}
 	}
 
+	if b.Schedule.IsRecurring() {
+		if !b.Schedule.IsScheduled {
+			return fmt.Errorf("a recurring broadcast must be scheduled")
+		}
+		if _, err := parseRecurrence(b.Schedule.Recurrence); err != nil {
+			return err
+		}
+	}
+
 	// Validate data feed settings if present
 	if b.DataFeed != nil {
 		if err := b.DataFeed.Validate(); err != nil {
@@ -490,10 +618,21 @@ func (r *UpdateBroadcastRequest) Validate(existingBroadcast *Broadcast) (*Broadc
 	// Cannot update a broadcast that is not in draft or scheduled status
 	if existingBroadcast.Status != BroadcastStatusDraft &&
 		existingBroadcast.Status != BroadcastStatusScheduled &&
-		existingBroadcast.Status != BroadcastStatusPaused {
+		existingBroadcast.Status != BroadcastStatusPaused &&
+		existingBroadcast.Status != BroadcastStatusPendingApproval &&
+		existingBroadcast.Status != BroadcastStatusApproved {
 		return nil, fmt.Errorf("cannot update broadcast with status: %s", existingBroadcast.Status)
 	}
 
+	// Any edit invalidates the review, the broadcast has to be submitted again. This includes
+	// approved broadcasts that were since scheduled or paused, so they cannot send edited content.
+	if existingBroadcast.Approval != nil ||
+		existingBroadcast.Status == BroadcastStatusPendingApproval ||
+		existingBroadcast.Status == BroadcastStatusApproved {
+		existingBroadcast.Status = BroadcastStatusDraft
+		existingBroadcast.Approval = nil
+	}
+
 	// Update the existing broadcast
 	existingBroadcast.Name = r.Name
 	existingBroadcast.Audience = r.Audience
@@ -518,6 +657,9 @@ func (r *UpdateBroadcastRequest) Validate(existingBroadcast *Broadcast) (*Broadc
 			if r.DataFeed.RecipientFeed != nil {
 				existingBroadcast.DataFeed.RecipientFeed = r.DataFeed.RecipientFeed
 			}
+			if r.DataFeed.BlogDigest != nil {
+				existingBroadcast.DataFeed.BlogDigest = r.DataFeed.BlogDigest
+			}
 
 			// Restore preserved data
 			existingBroadcast.DataFeed.GlobalFeedData = existingGlobalFeedData
@@ -543,6 +685,7 @@ type ScheduleBroadcastRequest struct {
 	ScheduledTime        string `json:"scheduled_time,omitempty"`
 	Timezone             string `json:"timezone,omitempty"`
 	UseRecipientTimezone bool   `json:"use_recipient_timezone"`
+	Recurrence           string `json:"recurrence,omitempty"` // RRULE or cron expression, the scheduled date and time being the first possible occurrence
 }
 
 // Validate validates the schedule broadcast request
@@ -580,6 +723,15 @@ func (r *ScheduleBroadcastRequest) Validate() error {
 		}
 	}
 
+	if r.Recurrence != "" {
+		if r.SendNow {
+			return fmt.Errorf("a recurring broadcast cannot be sent now, scheduled_date and scheduled_time set its first occurrence")
+		}
+		if _, err := parseRecurrence(r.Recurrence); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -663,6 +815,8 @@ func (r *DeleteBroadcastRequest) Validate() error {
 type ListBroadcastsParams struct {
 	WorkspaceID   string
 	Status        BroadcastStatus
+	ParentID      string // Only instances spawned by this recurring broadcast
+	ListID        string // Only broadcasts sent to this list
 	Limit         int
 	Offset        int
 	WithTemplates bool // Whether to fetch and include template details for each variation
@@ -674,6 +828,76 @@ type BroadcastListResponse struct {
 	TotalCount int          `json:"total_count"`
 }
 
+// MaxResendDelayHours caps the delay of a resend to non-openers
+const MaxResendDelayHours = 720
+
+// ResendToNonOpenersRequest defines the request to send a follow-up of a processed broadcast
+// to its recipients who did not open it
+type ResendToNonOpenersRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	ID          string `json:"id"`
+	DelayHours  int    `json:"delay_hours"`           // counted from the completion of the broadcast
+	Name        string `json:"name,omitempty"`        // defaults to the name of the broadcast followed by "(resend)"
+	TemplateID  string `json:"template_id,omitempty"` // defaults to the winning or first template of the broadcast
+	Subject     string `json:"subject,omitempty"`     // defaults to the subject of the template
+}
+
+// Validate validates the resend to non-openers request
+func (r *ResendToNonOpenersRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+
+	if r.ID == "" {
+		return fmt.Errorf("broadcast id is required")
+	}
+
+	if r.DelayHours < 0 || r.DelayHours > MaxResendDelayHours {
+		return fmt.Errorf("delay_hours must be between 0 and %d", MaxResendDelayHours)
+	}
+
+	if len(r.Name) > 255 {
+		return fmt.Errorf("name must be less than 255 characters")
+	}
+
+	if len(r.Subject) > 255 {
+		return fmt.Errorf("subject must be less than 255 characters")
+	}
+
+	return nil
+}
+
+// PreviewAudienceRequest defines the request to preview the recipients of an audience
+type PreviewAudienceRequest struct {
+	WorkspaceID string           `json:"workspace_id"`
+	Audience    AudienceSettings `json:"audience"`
+}
+
+// Validate validates the preview audience request
+func (r *PreviewAudienceRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+
+	if r.Audience.List == "" {
+		return fmt.Errorf("list is required")
+	}
+
+	if err := r.Audience.ValidateExclusions(); err != nil {
+		return err
+	}
+
+	return r.Audience.Holdout.Validate()
+}
+
+// AudiencePreview summarizes the recipients of an audience
+type AudiencePreview struct {
+	TotalCount     int `json:"total_count"`     // contacts matching the list and segments
+	ExcludedCount  int `json:"excluded_count"`  // contacts removed by the exclusions
+	HoldoutCount   int `json:"holdout_count"`   // contacts held out, estimated from the holdout percentage
+	RecipientCount int `json:"recipient_count"` // contacts the broadcast would be sent to
+}
+
 // SendToIndividualRequest defines the request to send a broadcast to an individual
 type SendToIndividualRequest struct {
 	WorkspaceID    string `json:"workspace_id"`
@@ -703,6 +927,7 @@ func (r *SendToIndividualRequest) Validate() error {
 type GetBroadcastsRequest struct {
 	WorkspaceID   string `json:"workspace_id"`
 	Status        string `json:"status,omitempty"`
+	ParentID      string `json:"parent_id,omitempty"`
 	Limit         int    `json:"limit,omitempty"`
 	Offset        int    `json:"offset,omitempty"`
 	WithTemplates bool   `json:"with_templates,omitempty"`
@@ -739,6 +964,7 @@ func (r *GetBroadcastsRequest) FromURLParams(values url.Values) error {
 	}
 
 	r.Status = values.Get("status")
+	r.ParentID = values.Get("parent_id")
 
 	if limitStr := values.Get("limit"); limitStr != "" {
 		var err error
@@ -839,6 +1065,64 @@ func (r *GetTestResultsRequest) FromURLParams(values url.Values) error {
 	return nil
 }
 
+// LintBroadcastRequest defines the request to run the pre-send checks of a broadcast
+type LintBroadcastRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	ID          string `json:"id"`
+}
+
+// Validate validates the lint broadcast request
+func (r *LintBroadcastRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	if r.ID == "" {
+		return fmt.Errorf("broadcast id is required")
+	}
+	return nil
+}
+
+// FromURLParams parses URL parameters into the request
+func (r *LintBroadcastRequest) FromURLParams(values url.Values) error {
+	r.WorkspaceID = values.Get("workspace_id")
+	r.ID = values.Get("id")
+	return nil
+}
+
+// BroadcastLintReport gathers the lint reports of the templates a broadcast sends.
+// It passes only when every variation passes.
+type BroadcastLintReport struct {
+	BroadcastID string                `json:"broadcast_id"`
+	Passed      bool                  `json:"passed"`
+	Templates   []*TemplateLintReport `json:"templates"`
+}
+
+// ErrBroadcastLintFailed is returned when scheduling a broadcast whose templates do not pass
+// the pre-send checks
+type ErrBroadcastLintFailed struct {
+	Report *BroadcastLintReport
+}
+
+// Error lists the findings that block the send: the errors and the spam scores at the threshold
+func (e *ErrBroadcastLintFailed) Error() string {
+	var problems []string
+	for _, template := range e.Report.Templates {
+		if template.Passed {
+			continue
+		}
+		for _, finding := range template.Findings {
+			if finding.Severity == TemplateLintSeverityError {
+				problems = append(problems, fmt.Sprintf("template %s: %s", template.TemplateID, finding.Message))
+			}
+		}
+		if template.Score >= template.Threshold {
+			problems = append(problems, fmt.Sprintf("template %s: spam score %.1f reaches the threshold of %.1f",
+				template.TemplateID, template.Score, template.Threshold))
+		}
+	}
+	return "broadcast did not pass the pre-send checks: " + strings.Join(problems, "; ")
+}
+
 // VariationResult represents the results for a single A/B test variation
 type VariationResult struct {
 	TemplateID   string  `json:"template_id"`
@@ -861,6 +1145,7 @@ type TestResultsResponse struct {
 	RecommendedWinner string                      `json:"recommended_winner,omitempty"`
 	WinningTemplate   string                      `json:"winning_template,omitempty"`
 	IsAutoSendWinner  bool                        `json:"is_auto_send_winner"`
+	Statistics        *ABTestStatistics           `json:"statistics,omitempty"`
 }
 
 // RefreshGlobalFeedRequest defines the request to refresh global feed data
@@ -1020,6 +1305,21 @@ type BroadcastService interface {
 
 	// TestRecipientFeed tests the recipient feed configuration with a sample or specified contact
 	TestRecipientFeed(ctx context.Context, request *TestRecipientFeedRequest) (*TestRecipientFeedResponse, error)
+
+	// ResendToNonOpeners schedules a follow-up of a processed broadcast to its recipients who did not open it
+	ResendToNonOpeners(ctx context.Context, request *ResendToNonOpenersRequest) (*Broadcast, error)
+
+	// PreviewAudience counts the recipients of an audience and the contacts removed by its exclusions
+	PreviewAudience(ctx context.Context, request *PreviewAudienceRequest) (*AudiencePreview, error)
+
+	// RequestApproval submits a draft broadcast for review
+	RequestApproval(ctx context.Context, request *RequestBroadcastApprovalRequest) (*Broadcast, error)
+
+	// ReviewBroadcast approves or rejects a broadcast pending approval
+	ReviewBroadcast(ctx context.Context, request *ReviewBroadcastRequest) (*Broadcast, error)
+
+	// LintBroadcast runs the spam and deliverability checks on the templates of a broadcast
+	LintBroadcast(ctx context.Context, workspaceID, broadcastID string) (*BroadcastLintReport, error)
 }
 
 // BroadcastSender is a minimal interface needed for sending broadcasts,
@@ -1068,6 +1368,20 @@ func (e *ErrBroadcastNotFound) Error() string {
 	return fmt.Sprintf("Broadcast not found with ID: %s", e.ID)
 }
 
+// GetSubjectForTemplate returns the subject override of the variation using the template,
+// or an empty string when the template subject is used
+func (b *Broadcast) GetSubjectForTemplate(templateID string) string {
+	if b == nil {
+		return ""
+	}
+	for _, variation := range b.TestSettings.Variations {
+		if variation.TemplateID == templateID {
+			return variation.Subject
+		}
+	}
+	return ""
+}
+
 // SetTemplateForVariation assigns a template to a specific variation
 func (b *Broadcast) SetTemplateForVariation(variationIndex int, template *Template) {
 	if b == nil || variationIndex < 0 || variationIndex >= len(b.TestSettings.Variations) {
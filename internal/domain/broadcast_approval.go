@@ -0,0 +1,204 @@
+package domain
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultDualApprovalThreshold is the number of recipients above which a broadcast needs two approvers
+const DefaultDualApprovalThreshold = 10000
+
+// ErrBroadcastApprovalRequired is returned when scheduling a broadcast that was not approved
+var ErrBroadcastApprovalRequired = errors.New("broadcast must be approved before it can be scheduled")
+
+// BroadcastApprovalSettings configures the review gate of the broadcasts of a workspace
+type BroadcastApprovalSettings struct {
+	// Enabled requires broadcasts to be approved before they are scheduled
+	Enabled bool `json:"enabled"`
+	// DualApprovalThreshold is the number of recipients above which two approvers must sign off,
+	// 10000 by default
+	DualApprovalThreshold int `json:"dual_approval_threshold,omitempty"`
+}
+
+// IsRequired returns true if broadcasts must be approved before they are scheduled
+func (s *BroadcastApprovalSettings) IsRequired() bool {
+	return s != nil && s.Enabled
+}
+
+// GetDualApprovalThreshold returns the configured threshold or the default
+func (s *BroadcastApprovalSettings) GetDualApprovalThreshold() int {
+	if s == nil || s.DualApprovalThreshold <= 0 {
+		return DefaultDualApprovalThreshold
+	}
+	return s.DualApprovalThreshold
+}
+
+// RequiredApprovals returns the number of approvers a broadcast to recipients contacts needs
+func (s *BroadcastApprovalSettings) RequiredApprovals(recipients int) int {
+	if recipients > s.GetDualApprovalThreshold() {
+		return 2
+	}
+	return 1
+}
+
+// Validate validates the approval settings
+func (s *BroadcastApprovalSettings) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.DualApprovalThreshold < 0 {
+		return fmt.Errorf("broadcast approval dual_approval_threshold cannot be negative")
+	}
+	return nil
+}
+
+// BroadcastReviewDecision is the outcome of a review
+type BroadcastReviewDecision string
+
+const (
+	BroadcastReviewApproved BroadcastReviewDecision = "approved"
+	BroadcastReviewRejected BroadcastReviewDecision = "rejected"
+)
+
+// BroadcastReview is the decision of a reviewer with their comment
+type BroadcastReview struct {
+	UserID    string                  `json:"user_id"`
+	Decision  BroadcastReviewDecision `json:"decision"`
+	Comment   string                  `json:"comment,omitempty"`
+	CreatedAt time.Time               `json:"created_at"`
+}
+
+// BroadcastApproval tracks the review of a broadcast, from the request to the last decision.
+// It is cleared when the broadcast is edited.
+type BroadcastApproval struct {
+	RequestedBy string    `json:"requested_by"`
+	RequestedAt time.Time `json:"requested_at"`
+	Comment     string    `json:"comment,omitempty"`
+	// RecipientCount is the audience size when the review was requested, it sets RequiredApprovals
+	RecipientCount    int               `json:"recipient_count"`
+	RequiredApprovals int               `json:"required_approvals"`
+	Reviews           []BroadcastReview `json:"reviews"`
+	// TemplateVersions is the published version of each variation template when the review was
+	// requested. Once approved, these versions are sent even if newer ones are published.
+	TemplateVersions map[string]int64 `json:"template_versions,omitempty"`
+}
+
+// Value implements the driver.Valuer interface for database serialization
+func (a BroadcastApproval) Value() (driver.Value, error) {
+	return json.Marshal(a)
+}
+
+// Scan implements the sql.Scanner interface for database deserialization
+func (a *BroadcastApproval) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	v, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("type assertion to []byte failed")
+	}
+
+	cloned := bytes.Clone(v)
+	return json.Unmarshal(cloned, a)
+}
+
+// Approvals returns the number of approvals received
+func (a *BroadcastApproval) Approvals() int {
+	count := 0
+	for _, review := range a.Reviews {
+		if review.Decision == BroadcastReviewApproved {
+			count++
+		}
+	}
+	return count
+}
+
+// IsApproved returns true if the review collected the approvals it requires
+func (a *BroadcastApproval) IsApproved() bool {
+	return a != nil && a.Approvals() >= a.RequiredApprovals
+}
+
+// TemplateVersion returns the reviewed version of a template, 0 when the broadcast is not
+// approved and the published version is sent
+func (a *BroadcastApproval) TemplateVersion(templateID string) int64 {
+	if !a.IsApproved() {
+		return 0
+	}
+	return a.TemplateVersions[templateID]
+}
+
+// HasApproved returns true if the user already approved the broadcast
+func (a *BroadcastApproval) HasApproved(userID string) bool {
+	for _, review := range a.Reviews {
+		if review.UserID == userID && review.Decision == BroadcastReviewApproved {
+			return true
+		}
+	}
+	return false
+}
+
+// CanReview returns an error if the user cannot review the broadcast. The requester never
+// reviews their own broadcast and each approver signs off once.
+func (a *BroadcastApproval) CanReview(userID string) error {
+	if a.RequestedBy == userID {
+		return NewValidationError("a broadcast cannot be reviewed by the member who requested the approval")
+	}
+	if a.HasApproved(userID) {
+		return NewValidationError("you already approved this broadcast")
+	}
+	return nil
+}
+
+// RequestBroadcastApprovalRequest submits a draft broadcast for review
+type RequestBroadcastApprovalRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	ID          string `json:"id"`
+	Comment     string `json:"comment,omitempty"`
+}
+
+// Validate validates the request approval request
+func (r *RequestBroadcastApprovalRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return NewValidationError("workspace_id is required")
+	}
+	if r.ID == "" {
+		return NewValidationError("id is required")
+	}
+	return nil
+}
+
+// ReviewBroadcastRequest approves or rejects a broadcast pending approval
+type ReviewBroadcastRequest struct {
+	WorkspaceID string                  `json:"workspace_id"`
+	ID          string                  `json:"id"`
+	Decision    BroadcastReviewDecision `json:"decision"`
+	Comment     string                  `json:"comment,omitempty"`
+}
+
+// Validate validates the review request, a rejection must explain what to change
+func (r *ReviewBroadcastRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return NewValidationError("workspace_id is required")
+	}
+	if r.ID == "" {
+		return NewValidationError("id is required")
+	}
+	switch r.Decision {
+	case BroadcastReviewApproved:
+	case BroadcastReviewRejected:
+		if r.Comment == "" {
+			return NewValidationError("comment is required to reject a broadcast")
+		}
+	default:
+		return NewValidationError("decision must be approved or rejected")
+	}
+	if len(r.Comment) > 2000 {
+		return NewValidationError("comment must be less than 2000 characters")
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+func TestBroadcastApprovalSettings(t *testing.T) {
+	var disabled *domain.BroadcastApprovalSettings
+	assert.False(t, disabled.IsRequired())
+	assert.Equal(t, domain.DefaultDualApprovalThreshold, disabled.GetDualApprovalThreshold())
+	assert.NoError(t, disabled.Validate())
+
+	settings := &domain.BroadcastApprovalSettings{Enabled: true}
+	assert.True(t, settings.IsRequired())
+	assert.Equal(t, 1, settings.RequiredApprovals(10000))
+	assert.Equal(t, 2, settings.RequiredApprovals(10001))
+
+	settings.DualApprovalThreshold = 500
+	assert.Equal(t, 1, settings.RequiredApprovals(500))
+	assert.Equal(t, 2, settings.RequiredApprovals(501))
+
+	settings.DualApprovalThreshold = -1
+	assert.Error(t, settings.Validate())
+}
+
+func TestBroadcastApproval_CanReview(t *testing.T) {
+	approval := &domain.BroadcastApproval{
+		RequestedBy:       "author",
+		RequiredApprovals: 2,
+		Reviews: []domain.BroadcastReview{
+			{UserID: "reviewer1", Decision: domain.BroadcastReviewApproved},
+			{UserID: "reviewer2", Decision: domain.BroadcastReviewRejected, Comment: "Typo"},
+		},
+	}
+
+	assert.Equal(t, 1, approval.Approvals())
+	assert.True(t, approval.HasApproved("reviewer1"))
+	assert.False(t, approval.HasApproved("reviewer2"))
+
+	assert.Error(t, approval.CanReview("author"))
+	assert.Error(t, approval.CanReview("reviewer1"))
+	assert.NoError(t, approval.CanReview("reviewer2"))
+	assert.NoError(t, approval.CanReview("reviewer3"))
+}
+
+func TestBroadcastApproval_IsApproved(t *testing.T) {
+	approval := &domain.BroadcastApproval{
+		RequiredApprovals: 2,
+		Reviews: []domain.BroadcastReview{
+			{UserID: "reviewer1", Decision: domain.BroadcastReviewApproved},
+		},
+	}
+	assert.False(t, approval.IsApproved())
+
+	approval.Reviews = append(approval.Reviews, domain.BroadcastReview{UserID: "reviewer2", Decision: domain.BroadcastReviewApproved})
+	assert.True(t, approval.IsApproved())
+
+	var missing *domain.BroadcastApproval
+	assert.False(t, missing.IsApproved())
+}
+
+func TestBroadcastApproval_TemplateVersion(t *testing.T) {
+	approval := &domain.BroadcastApproval{
+		RequiredApprovals: 1,
+		Reviews:           []domain.BroadcastReview{},
+		TemplateVersions:  map[string]int64{"tpl1": 3},
+	}
+	// Pending approvals do not pin anything yet
+	assert.Equal(t, int64(0), approval.TemplateVersion("tpl1"))
+
+	approval.Reviews = append(approval.Reviews, domain.BroadcastReview{UserID: "reviewer1", Decision: domain.BroadcastReviewApproved})
+	assert.Equal(t, int64(3), approval.TemplateVersion("tpl1"))
+	assert.Equal(t, int64(0), approval.TemplateVersion("tpl2"))
+
+	var missing *domain.BroadcastApproval
+	assert.Equal(t, int64(0), missing.TemplateVersion("tpl1"))
+}
+
+func TestBroadcastApproval_ValueScan(t *testing.T) {
+	approval := domain.BroadcastApproval{
+		RequestedBy:       "author",
+		RecipientCount:    12000,
+		RequiredApprovals: 2,
+		Reviews:           []domain.BroadcastReview{{UserID: "reviewer1", Decision: domain.BroadcastReviewApproved}},
+		TemplateVersions:  map[string]int64{"tpl1": 3},
+	}
+
+	value, err := approval.Value()
+	require.NoError(t, err)
+
+	var scanned domain.BroadcastApproval
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, approval.RequestedBy, scanned.RequestedBy)
+	assert.Equal(t, approval.RecipientCount, scanned.RecipientCount)
+	assert.Equal(t, 1, scanned.Approvals())
+	assert.Equal(t, approval.TemplateVersions, scanned.TemplateVersions)
+
+	assert.NoError(t, scanned.Scan(nil))
+	assert.Error(t, scanned.Scan("not bytes"))
+}
+
+func TestReviewBroadcastRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		request domain.ReviewBroadcastRequest
+		wantErr string
+	}{
+		{
+			name:    "approval without comment",
+			request: domain.ReviewBroadcastRequest{WorkspaceID: "w1", ID: "b1", Decision: domain.BroadcastReviewApproved},
+		},
+		{
+			name:    "rejection with comment",
+			request: domain.ReviewBroadcastRequest{WorkspaceID: "w1", ID: "b1", Decision: domain.BroadcastReviewRejected, Comment: "Fix the footer"},
+		},
+		{
+			name:    "rejection without comment",
+			request: domain.ReviewBroadcastRequest{WorkspaceID: "w1", ID: "b1", Decision: domain.BroadcastReviewRejected},
+			wantErr: "comment is required",
+		},
+		{
+			name:    "unknown decision",
+			request: domain.ReviewBroadcastRequest{WorkspaceID: "w1", ID: "b1", Decision: "maybe"},
+			wantErr: "decision must be approved or rejected",
+		},
+		{
+			name:    "comment too long",
+			request: domain.ReviewBroadcastRequest{WorkspaceID: "w1", ID: "b1", Decision: domain.BroadcastReviewApproved, Comment: strings.Repeat("a", 2001)},
+			wantErr: "comment must be less than 2000 characters",
+		},
+		{
+			name:    "missing id",
+			request: domain.ReviewBroadcastRequest{WorkspaceID: "w1", Decision: domain.BroadcastReviewApproved},
+			wantErr: "id is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestUpdateBroadcastRequest_Validate_InvalidatesApproval(t *testing.T) {
+	for _, status := range []domain.BroadcastStatus{
+		domain.BroadcastStatusPendingApproval,
+		domain.BroadcastStatusApproved,
+		domain.BroadcastStatusScheduled,
+		domain.BroadcastStatusPaused,
+	} {
+		t.Run(string(status), func(t *testing.T) {
+			existing := createValidBroadcast()
+			existing.Status = status
+			existing.Approval = &domain.BroadcastApproval{RequestedBy: "author", RequiredApprovals: 1}
+
+			request := domain.UpdateBroadcastRequest{
+				WorkspaceID:  existing.WorkspaceID,
+				ID:           existing.ID,
+				Name:         "Edited after review",
+				Audience:     existing.Audience,
+				Schedule:     existing.Schedule,
+				TestSettings: existing.TestSettings,
+			}
+
+			updated, err := request.Validate(&existing)
+			require.NoError(t, err)
+			assert.Equal(t, domain.BroadcastStatusDraft, updated.Status)
+			assert.Nil(t, updated.Approval)
+		})
+	}
+}
+
+func TestUpdateBroadcastRequest_Validate_KeepsStatusWithoutApproval(t *testing.T) {
+	existing := createValidBroadcast()
+	existing.Status = domain.BroadcastStatusScheduled
+
+	request := domain.UpdateBroadcastRequest{
+		WorkspaceID:  existing.WorkspaceID,
+		ID:           existing.ID,
+		Name:         "Edited while scheduled",
+		Audience:     existing.Audience,
+		Schedule:     existing.Schedule,
+		TestSettings: existing.TestSettings,
+	}
+
+	updated, err := request.Validate(&existing)
+	require.NoError(t, err)
+	assert.Equal(t, domain.BroadcastStatusScheduled, updated.Status)
+}
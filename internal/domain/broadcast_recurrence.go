@@ -0,0 +1,501 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskTypeRecurringBroadcast spawns the broadcast due at an occurrence of a recurring broadcast
+const TaskTypeRecurringBroadcast = "recurring_broadcast"
+
+// RecurringBroadcastState contains state specific to recurring broadcast tasks
+type RecurringBroadcastState struct {
+	BroadcastID string    `json:"broadcast_id"`
+	Occurrence  time.Time `json:"occurrence"`
+}
+
+// NewRecurringBroadcastTask creates the task spawning the instance of a recurring broadcast due
+// at the given occurrence. The task ID is derived from both, so that scheduling the same
+// occurrence twice is detected instead of sending it twice.
+func NewRecurringBroadcastTask(workspaceID, broadcastID string, occurrence time.Time) *Task {
+	occurrence = occurrence.UTC()
+	return &Task{
+		ID:           uuid.NewSHA1(uuid.NameSpaceURL, []byte(fmt.Sprintf("%s/%s/%s/%d", TaskTypeRecurringBroadcast, workspaceID, broadcastID, occurrence.Unix()))).String(),
+		WorkspaceID:  workspaceID,
+		Type:         TaskTypeRecurringBroadcast,
+		Status:       TaskStatusPending,
+		NextRunAfter: &occurrence,
+		State: &TaskState{
+			Message: fmt.Sprintf("Next occurrence on %s", occurrence.Format(time.RFC3339)),
+			RecurringBroadcast: &RecurringBroadcastState{
+				BroadcastID: broadcastID,
+				Occurrence:  occurrence,
+			},
+		},
+		MaxRuntime:    50, // 50 seconds
+		MaxRetries:    3,
+		RetryInterval: 300, // 5 minutes
+	}
+}
+
+// RecurringInstanceID returns the ID of the broadcast sent at an occurrence of a recurring broadcast
+func RecurringInstanceID(broadcastID string, occurrence time.Time) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%d", broadcastID, occurrence.Unix())))
+	return hex.EncodeToString(sum[:])[:32]
+}
+
+// maxRecurrenceIterations bounds the search for the next occurrence of a rule
+// that can no longer match (e.g. BYMONTHDAY=31 with FREQ=MONTHLY;INTERVAL=2 from February)
+const maxRecurrenceIterations = 100000
+
+// recurrence computes the occurrences of a recurring broadcast. start is the
+// first scheduled date and time of the broadcast, in the schedule timezone.
+type recurrence interface {
+	// next returns the first occurrence strictly after after, or the zero time
+	// when the rule has no more occurrences
+	next(start, after time.Time) time.Time
+}
+
+// parseRecurrence parses an RFC 5545 RRULE ("FREQ=WEEKLY;BYDAY=MO") or a
+// 5-field cron expression ("0 9 * * 1"). Both are limited to one occurrence
+// per day at most, recurring broadcasts are not meant to be sent more often.
+func parseRecurrence(rule string) (recurrence, error) {
+	rule = strings.TrimSpace(rule)
+	if rule == "" {
+		return nil, fmt.Errorf("recurrence is empty")
+	}
+
+	// Cron expressions never contain "="
+	upper := strings.ToUpper(rule)
+	if strings.HasPrefix(upper, "RRULE:") || strings.Contains(upper, "=") {
+		return parseRRule(strings.TrimPrefix(upper, "RRULE:"))
+	}
+	return parseCron(rule)
+}
+
+// rruleWeekday is a BYDAY entry, Ordinal is 0 when the rule applies to every such weekday
+type rruleWeekday struct {
+	Weekday time.Weekday
+	Ordinal int
+}
+
+// rrule is the supported subset of RFC 5545 recurrence rules
+type rrule struct {
+	Freq       string
+	Interval   int
+	ByDay      []rruleWeekday
+	ByMonthDay []int
+	Count      int
+	Until      time.Time
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+func parseRRule(rule string) (*rrule, error) {
+	r := &rrule{Interval: 1}
+
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+		key, value, found := strings.Cut(part, "=")
+		if !found || value == "" {
+			return nil, fmt.Errorf("invalid RRULE part: %s", part)
+		}
+
+		switch key {
+		case "FREQ":
+			switch value {
+			case "DAILY", "WEEKLY", "MONTHLY":
+				r.Freq = value
+			default:
+				return nil, fmt.Errorf("unsupported RRULE frequency: %s (expected DAILY, WEEKLY or MONTHLY)", value)
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval < 1 {
+				return nil, fmt.Errorf("invalid RRULE interval: %s", value)
+			}
+			r.Interval = interval
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("invalid RRULE count: %s", value)
+			}
+			r.Count = count
+		case "UNTIL":
+			until, err := parseRRuleUntil(value)
+			if err != nil {
+				return nil, err
+			}
+			r.Until = until
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday, err := parseRRuleWeekday(day)
+				if err != nil {
+					return nil, err
+				}
+				r.ByDay = append(r.ByDay, weekday)
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(value, ",") {
+				monthDay, err := strconv.Atoi(day)
+				if err != nil || monthDay == 0 || monthDay < -31 || monthDay > 31 {
+					return nil, fmt.Errorf("invalid RRULE month day: %s", day)
+				}
+				r.ByMonthDay = append(r.ByMonthDay, monthDay)
+			}
+		case "WKST":
+			if value != "MO" {
+				return nil, fmt.Errorf("unsupported RRULE week start: %s (only MO is supported)", value)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported RRULE part: %s", key)
+		}
+	}
+
+	if r.Freq == "" {
+		return nil, fmt.Errorf("RRULE FREQ is required")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return nil, fmt.Errorf("RRULE COUNT and UNTIL cannot be used together")
+	}
+	if len(r.ByMonthDay) > 0 && r.Freq != "MONTHLY" {
+		return nil, fmt.Errorf("RRULE BYMONTHDAY is only supported with FREQ=MONTHLY")
+	}
+	for _, day := range r.ByDay {
+		if day.Ordinal != 0 && r.Freq != "MONTHLY" {
+			return nil, fmt.Errorf("RRULE BYDAY ordinals are only supported with FREQ=MONTHLY")
+		}
+	}
+
+	return r, nil
+}
+
+func parseRRuleWeekday(value string) (rruleWeekday, error) {
+	if len(value) < 2 {
+		return rruleWeekday{}, fmt.Errorf("invalid RRULE weekday: %s", value)
+	}
+
+	weekday, ok := rruleWeekdays[value[len(value)-2:]]
+	if !ok {
+		return rruleWeekday{}, fmt.Errorf("invalid RRULE weekday: %s", value)
+	}
+
+	ordinal := 0
+	if prefix := value[:len(value)-2]; prefix != "" {
+		var err error
+		ordinal, err = strconv.Atoi(prefix)
+		if err != nil || ordinal == 0 || ordinal < -5 || ordinal > 5 {
+			return rruleWeekday{}, fmt.Errorf("invalid RRULE weekday: %s", value)
+		}
+	}
+
+	return rruleWeekday{Weekday: weekday, Ordinal: ordinal}, nil
+}
+
+func parseRRuleUntil(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102"} {
+		if until, err := time.Parse(layout, value); err == nil {
+			if layout == "20060102" {
+				// A date-only UNTIL includes the whole day
+				until = until.Add(24*time.Hour - time.Second)
+			}
+			return until, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid RRULE until: %s (expected YYYYMMDD or YYYYMMDDTHHMMSSZ)", value)
+}
+
+func (r *rrule) next(start, after time.Time) time.Time {
+	count := 0
+	for period := 0; period < maxRecurrenceIterations; period++ {
+		for _, occurrence := range r.periodOccurrences(start, period) {
+			if occurrence.Before(start) {
+				continue
+			}
+			count++
+			if r.Count > 0 && count > r.Count {
+				return time.Time{}
+			}
+			if !r.Until.IsZero() && occurrence.After(r.Until) {
+				return time.Time{}
+			}
+			if occurrence.After(after) {
+				return occurrence
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// periodOccurrences returns the sorted occurrences of the nth period (day, week or month) of the rule
+func (r *rrule) periodOccurrences(start time.Time, period int) []time.Time {
+	hour, minute := start.Hour(), start.Minute()
+	loc := start.Location()
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, loc)
+	}
+
+	switch r.Freq {
+	case "DAILY":
+		day := at(start.Year(), start.Month(), start.Day()+period*r.Interval)
+		if len(r.ByDay) > 0 && !r.matchesWeekday(day.Weekday()) {
+			return nil
+		}
+		return []time.Time{day}
+
+	case "WEEKLY":
+		// Weeks start on Monday
+		offset := (int(start.Weekday()) + 6) % 7
+		monday := at(start.Year(), start.Month(), start.Day()-offset+period*r.Interval*7)
+		if len(r.ByDay) == 0 {
+			return []time.Time{at(monday.Year(), monday.Month(), monday.Day()+offset)}
+		}
+		var occurrences []time.Time
+		for i := 0; i < 7; i++ {
+			day := at(monday.Year(), monday.Month(), monday.Day()+i)
+			if r.matchesWeekday(day.Weekday()) {
+				occurrences = append(occurrences, day)
+			}
+		}
+		return occurrences
+
+	default: // MONTHLY
+		first := at(start.Year(), start.Month()+time.Month(period*r.Interval), 1)
+		daysInMonth := at(first.Year(), first.Month()+1, 0).Day()
+
+		var days []int
+		for day := 1; day <= daysInMonth; day++ {
+			if r.matchesMonthDay(day, daysInMonth, start.Day()) &&
+				r.matchesMonthWeekday(at(first.Year(), first.Month(), day), daysInMonth) {
+				days = append(days, day)
+			}
+		}
+
+		occurrences := make([]time.Time, 0, len(days))
+		for _, day := range days {
+			occurrences = append(occurrences, at(first.Year(), first.Month(), day))
+		}
+		return occurrences
+	}
+}
+
+func (r *rrule) matchesWeekday(weekday time.Weekday) bool {
+	for _, day := range r.ByDay {
+		if day.Weekday == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *rrule) matchesMonthDay(day, daysInMonth, startDay int) bool {
+	if len(r.ByMonthDay) == 0 {
+		// Without BYDAY either, the rule repeats on the day of month of the first occurrence
+		return len(r.ByDay) > 0 || day == startDay
+	}
+	for _, monthDay := range r.ByMonthDay {
+		if monthDay == day || (monthDay < 0 && daysInMonth+monthDay+1 == day) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *rrule) matchesMonthWeekday(date time.Time, daysInMonth int) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, day := range r.ByDay {
+		if day.Weekday != date.Weekday() {
+			continue
+		}
+		switch {
+		case day.Ordinal == 0:
+			return true
+		case day.Ordinal > 0 && (date.Day()-1)/7+1 == day.Ordinal:
+			return true
+		case day.Ordinal < 0 && (daysInMonth-date.Day())/7+1 == -day.Ordinal:
+			return true
+		}
+	}
+	return false
+}
+
+// cronSchedule is a standard 5-field cron expression: minute hour day-of-month month day-of-week
+type cronSchedule struct {
+	Minute     int
+	Hour       int
+	MonthDays  map[int]bool
+	Months     map[int]bool
+	Weekdays   map[int]bool
+	AnyDay     bool
+	AnyWeekday bool
+}
+
+func parseCron(expression string) (*cronSchedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid recurrence: expected an RRULE or a 5-field cron expression")
+	}
+
+	minutes, err := parseCronField(fields[0], 0, 59)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron minute: %w", err)
+	}
+	hours, err := parseCronField(fields[1], 0, 23)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron hour: %w", err)
+	}
+	if len(minutes) != 1 || len(hours) != 1 {
+		return nil, fmt.Errorf("cron minute and hour must be single values, a recurring broadcast is sent at most once a day")
+	}
+
+	monthDays, err := parseCronField(fields[2], 1, 31)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron day of month: %w", err)
+	}
+	months, err := parseCronField(fields[3], 1, 12)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron month: %w", err)
+	}
+	weekdays, err := parseCronField(fields[4], 0, 7)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron day of week: %w", err)
+	}
+	if weekdays[7] {
+		// 7 is an alias for Sunday
+		weekdays[0] = true
+		delete(weekdays, 7)
+	}
+
+	schedule := &cronSchedule{
+		MonthDays:  monthDays,
+		Months:     months,
+		Weekdays:   weekdays,
+		AnyDay:     strings.HasPrefix(fields[2], "*"),
+		AnyWeekday: strings.HasPrefix(fields[4], "*"),
+	}
+	for minute := range minutes {
+		schedule.Minute = minute
+	}
+	for hour := range hours {
+		schedule.Hour = hour
+	}
+	return schedule, nil
+}
+
+// parseCronField parses a comma-separated list of values, ranges (a-b) and steps (*/n, a-b/n)
+func parseCronField(field string, min, max int) (map[int]bool, error) {
+	values := make(map[int]bool)
+
+	for _, item := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step < 1 {
+				return nil, fmt.Errorf("invalid step: %s", item)
+			}
+		}
+
+		low, high := min, max
+		if rangePart != "*" {
+			lowPart, highPart, isRange := strings.Cut(rangePart, "-")
+			var err error
+			if low, err = strconv.Atoi(lowPart); err != nil {
+				return nil, fmt.Errorf("invalid value: %s", item)
+			}
+			high = low
+			if isRange {
+				if high, err = strconv.Atoi(highPart); err != nil {
+					return nil, fmt.Errorf("invalid value: %s", item)
+				}
+			} else if hasStep {
+				high = max
+			}
+		}
+		if low < min || high > max || low > high {
+			return nil, fmt.Errorf("value out of range %d-%d: %s", min, max, item)
+		}
+
+		for value := low; value <= high; value += step {
+			values[value] = true
+		}
+	}
+
+	return values, nil
+}
+
+func (c *cronSchedule) next(start, after time.Time) time.Time {
+	if after.Before(start) {
+		after = start.Add(-time.Minute)
+	}
+
+	loc := start.Location()
+	after = after.In(loc)
+	for i := 0; i < maxRecurrenceIterations; i++ {
+		day := time.Date(after.Year(), after.Month(), after.Day()+i, c.Hour, c.Minute, 0, 0, loc)
+		if c.matchesDay(day) && day.After(after) {
+			return day
+		}
+	}
+	return time.Time{}
+}
+
+// matchesDay follows the cron convention: when both the day of month and the
+// day of week are restricted, a day matching either of them matches
+func (c *cronSchedule) matchesDay(day time.Time) bool {
+	if !c.Months[int(day.Month())] {
+		return false
+	}
+
+	monthDay := c.MonthDays[day.Day()]
+	weekday := c.Weekdays[int(day.Weekday())]
+	switch {
+	case c.AnyDay && c.AnyWeekday:
+		return true
+	case c.AnyDay:
+		return weekday
+	case c.AnyWeekday:
+		return monthDay
+	default:
+		return monthDay || weekday
+	}
+}
+
+// NextOccurrence returns the first occurrence of the schedule recurrence after the given time,
+// or the zero time when the recurrence has ended. Occurrences are computed in the schedule
+// timezone from the scheduled date and time, so that a weekly send stays at the same local hour.
+func (s *ScheduleSettings) NextOccurrence(after time.Time) (time.Time, error) {
+	rule, err := parseRecurrence(s.Recurrence)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	start, err := s.ParseScheduledDateTime()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if start.IsZero() {
+		return time.Time{}, fmt.Errorf("scheduled date and time are required for a recurring broadcast")
+	}
+
+	return rule.next(start, after), nil
+}
@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleSettings_NextOccurrence(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		schedule   ScheduleSettings
+		after      time.Time
+		wantNext   []time.Time
+		wantEnding bool
+	}{
+		{
+			name:     "weekly on the scheduled weekday",
+			schedule: ScheduleSettings{ScheduledDate: "2026-10-19", ScheduledTime: "09:00", Timezone: "Europe/Paris", Recurrence: "FREQ=WEEKLY"},
+			after:    time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			wantNext: []time.Time{
+				time.Date(2026, 10, 19, 9, 0, 0, 0, paris),
+				time.Date(2026, 10, 26, 9, 0, 0, 0, paris), // stays at 9:00 across the DST change
+				time.Date(2026, 11, 2, 9, 0, 0, 0, paris),
+			},
+		},
+		{
+			name:     "every other week on two days",
+			schedule: ScheduleSettings{ScheduledDate: "2026-10-21", ScheduledTime: "08:30", Recurrence: "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE"},
+			after:    time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			wantNext: []time.Time{
+				time.Date(2026, 10, 21, 8, 30, 0, 0, time.UTC),
+				time.Date(2026, 11, 2, 8, 30, 0, 0, time.UTC),
+				time.Date(2026, 11, 4, 8, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "monthly on the last day",
+			schedule: ScheduleSettings{ScheduledDate: "2027-01-01", ScheduledTime: "10:00", Recurrence: "FREQ=MONTHLY;BYMONTHDAY=-1"},
+			after:    time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC),
+			wantNext: []time.Time{
+				time.Date(2027, 1, 31, 10, 0, 0, 0, time.UTC),
+				time.Date(2027, 2, 28, 10, 0, 0, 0, time.UTC),
+				time.Date(2027, 3, 31, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "monthly on the first Monday",
+			schedule: ScheduleSettings{ScheduledDate: "2026-11-01", ScheduledTime: "07:00", Recurrence: "FREQ=MONTHLY;BYDAY=1MO"},
+			after:    time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			wantNext: []time.Time{
+				time.Date(2026, 11, 2, 7, 0, 0, 0, time.UTC),
+				time.Date(2026, 12, 7, 7, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "monthly skips months without the day",
+			schedule: ScheduleSettings{ScheduledDate: "2027-01-31", ScheduledTime: "07:00", Recurrence: "FREQ=MONTHLY"},
+			after:    time.Date(2027, 1, 31, 7, 0, 0, 0, time.UTC),
+			wantNext: []time.Time{
+				time.Date(2027, 3, 31, 7, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "weekdays with a count",
+			schedule: ScheduleSettings{ScheduledDate: "2026-10-23", ScheduledTime: "12:00", Recurrence: "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR;COUNT=2"},
+			after:    time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			wantNext: []time.Time{
+				time.Date(2026, 10, 23, 12, 0, 0, 0, time.UTC),
+				time.Date(2026, 10, 26, 12, 0, 0, 0, time.UTC),
+			},
+			wantEnding: true,
+		},
+		{
+			name:     "daily until a date",
+			schedule: ScheduleSettings{ScheduledDate: "2026-10-20", ScheduledTime: "18:00", Recurrence: "FREQ=DAILY;UNTIL=20261021"},
+			after:    time.Date(2026, 10, 20, 18, 0, 0, 0, time.UTC),
+			wantNext: []time.Time{
+				time.Date(2026, 10, 21, 18, 0, 0, 0, time.UTC),
+			},
+			wantEnding: true,
+		},
+		{
+			name:     "cron every Monday",
+			schedule: ScheduleSettings{ScheduledDate: "2026-10-18", ScheduledTime: "00:00", Timezone: "Europe/Paris", Recurrence: "30 9 * * 1"},
+			after:    time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			wantNext: []time.Time{
+				time.Date(2026, 10, 19, 9, 30, 0, 0, paris),
+				time.Date(2026, 10, 26, 9, 30, 0, 0, paris),
+			},
+		},
+		{
+			name:     "cron on the first of the month or on Sundays",
+			schedule: ScheduleSettings{ScheduledDate: "2026-10-28", ScheduledTime: "00:00", Recurrence: "0 8 1 * 7"},
+			after:    time.Date(2026, 10, 28, 12, 0, 0, 0, time.UTC),
+			wantNext: []time.Time{
+				time.Date(2026, 11, 1, 8, 0, 0, 0, time.UTC),
+				time.Date(2026, 11, 8, 8, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after := tt.after
+			for _, want := range tt.wantNext {
+				next, err := tt.schedule.NextOccurrence(after)
+				require.NoError(t, err)
+				assert.True(t, want.Equal(next), "want %s, got %s", want, next)
+				after = next
+			}
+
+			if tt.wantEnding {
+				next, err := tt.schedule.NextOccurrence(after)
+				require.NoError(t, err)
+				assert.True(t, next.IsZero(), "want no more occurrences, got %s", next)
+			}
+		})
+	}
+}
+
+func TestParseRecurrence_Invalid(t *testing.T) {
+	tests := []struct {
+		rule    string
+		wantErr string
+	}{
+		{"FREQ=HOURLY", "unsupported RRULE frequency"},
+		{"INTERVAL=2", "FREQ is required"},
+		{"FREQ=WEEKLY;INTERVAL=0", "invalid RRULE interval"},
+		{"FREQ=WEEKLY;BYDAY=XX", "invalid RRULE weekday"},
+		{"FREQ=WEEKLY;BYDAY=1MO", "ordinals are only supported with FREQ=MONTHLY"},
+		{"FREQ=WEEKLY;BYMONTHDAY=1", "only supported with FREQ=MONTHLY"},
+		{"FREQ=MONTHLY;BYMONTHDAY=32", "invalid RRULE month day"},
+		{"FREQ=DAILY;COUNT=2;UNTIL=20270101", "cannot be used together"},
+		{"FREQ=DAILY;UNTIL=tomorrow", "invalid RRULE until"},
+		{"FREQ=DAILY;BYHOUR=9", "unsupported RRULE part"},
+		{"0 9 * *", "5-field cron expression"},
+		{"*/15 9 * * 1", "at most once a day"},
+		{"0 24 * * 1", "invalid cron hour"},
+		{"0 9 1-40 * *", "invalid cron day of month"},
+		{"0 9 * * MON", "invalid cron day of week"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			_, err := parseRecurrence(tt.rule)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestScheduleBroadcastRequest_Validate_Recurrence(t *testing.T) {
+	req := ScheduleBroadcastRequest{WorkspaceID: "ws1", ID: "b1", ScheduledDate: "2026-10-19", ScheduledTime: "09:00", Recurrence: "FREQ=WEEKLY"}
+	assert.NoError(t, req.Validate())
+
+	req.Recurrence = "FREQ=YEARLY"
+	assert.Error(t, req.Validate())
+
+	req = ScheduleBroadcastRequest{WorkspaceID: "ws1", ID: "b1", SendNow: true, Recurrence: "FREQ=WEEKLY"}
+	assert.ErrorContains(t, req.Validate(), "cannot be sent now")
+}
+
+func TestNewRecurringBroadcastTask(t *testing.T) {
+	occurrence := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+
+	task := NewRecurringBroadcastTask("ws1", "b1", occurrence)
+	assert.Equal(t, TaskTypeRecurringBroadcast, task.Type)
+	assert.True(t, occurrence.Equal(*task.NextRunAfter))
+	assert.Equal(t, "b1", task.State.RecurringBroadcast.BroadcastID)
+	assert.Nil(t, task.BroadcastID)
+
+	// The same occurrence always maps to the same task and instance
+	assert.Equal(t, task.ID, NewRecurringBroadcastTask("ws1", "b1", occurrence.In(time.FixedZone("x", 3600))).ID)
+	assert.NotEqual(t, task.ID, NewRecurringBroadcastTask("ws1", "b1", occurrence.AddDate(0, 0, 7)).ID)
+	assert.Equal(t, RecurringInstanceID("b1", occurrence), RecurringInstanceID("b1", occurrence))
+	assert.Len(t, RecurringInstanceID("b1", occurrence), 32)
+	assert.NotEqual(t, RecurringInstanceID("b1", occurrence), RecurringInstanceID("b2", occurrence))
+}
@@ -2,8 +2,10 @@ package domain_test
 
 import (
 	"net/url"
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -98,6 +100,16 @@ func createValidBroadcastWithTest() domain.Broadcast {
 	return broadcast
 }
 
+func TestSuffixBroadcastName(t *testing.T) {
+	assert.Equal(t, "Weekly (resend)", domain.SuffixBroadcastName("Weekly", " (resend)"))
+
+	// Names are cut on a character boundary to fit in 255 bytes
+	name := domain.SuffixBroadcastName(strings.Repeat("é", 130), " (2026-10-18)")
+	assert.LessOrEqual(t, len(name), 255)
+	assert.True(t, utf8.ValidString(name))
+	assert.Equal(t, strings.Repeat("é", 121)+" (2026-10-18)", name)
+}
+
 func TestBroadcast_Validate(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -1256,6 +1268,80 @@ func TestAudienceSettings_ValueScan(t *testing.T) {
 	assert.Contains(t, err.Error(), "type assertion to []byte failed")
 }
 
+func TestAudienceSettings_ValidateExclusions(t *testing.T) {
+	tests := []struct {
+		name     string
+		audience domain.AudienceSettings
+		wantErr  string
+	}{
+		{
+			name: "valid exclusions",
+			audience: domain.AudienceSettings{
+				List:                     "newsletter",
+				Segments:                 []string{"active"},
+				ExcludeLists:             []string{"customers"},
+				ExcludeSegments:          []string{"churned"},
+				ExcludeEmailedWithinDays: 3,
+			},
+		},
+		{
+			name:     "audience list excluded",
+			audience: domain.AudienceSettings{List: "newsletter", ExcludeLists: []string{"newsletter"}},
+			wantErr:  "the audience list cannot be excluded",
+		},
+		{
+			name:     "empty excluded list",
+			audience: domain.AudienceSettings{List: "newsletter", ExcludeLists: []string{""}},
+			wantErr:  "exclude_lists cannot contain an empty list id",
+		},
+		{
+			name:     "segment included and excluded",
+			audience: domain.AudienceSettings{List: "newsletter", Segments: []string{"vip"}, ExcludeSegments: []string{"vip"}},
+			wantErr:  "segment vip cannot be both included and excluded",
+		},
+		{
+			name:     "negative look-back window",
+			audience: domain.AudienceSettings{List: "newsletter", ExcludeEmailedWithinDays: -1},
+			wantErr:  "exclude_emailed_within_days must be between 0 and 365",
+		},
+		{
+			name:     "look-back window too long",
+			audience: domain.AudienceSettings{List: "newsletter", ExcludeEmailedWithinDays: 366},
+			wantErr:  "exclude_emailed_within_days must be between 0 and 365",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.audience.ValidateExclusions()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.wantErr, err.Error())
+		})
+	}
+}
+
+func TestAudienceSettings_WithoutExclusions(t *testing.T) {
+	audience := domain.AudienceSettings{
+		List:                     "newsletter",
+		ExcludeUnsubscribed:      true,
+		ExcludeLists:             []string{"customers"},
+		ExcludeSegments:          []string{"churned"},
+		ExcludeEmailedWithinDays: 3,
+	}
+	assert.True(t, audience.HasExclusions())
+
+	base := audience.WithoutExclusions()
+	assert.False(t, base.HasExclusions())
+	assert.Equal(t, "newsletter", base.List)
+	assert.True(t, base.ExcludeUnsubscribed)
+	// The original audience is left untouched
+	assert.Equal(t, []string{"customers"}, audience.ExcludeLists)
+}
+
 // TestScheduleSettings_SetScheduledDateTime tests the SetScheduledDateTime method
 func TestScheduleSettings_SetScheduledDateTime(t *testing.T) {
 	tests := []struct {
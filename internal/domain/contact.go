@@ -19,6 +19,8 @@ import (
 
 var (
 	ErrContactNotFound = errors.New("contact not found")
+	// ErrContactEmailTaken is returned when changing a contact's email to an address another contact already uses
+	ErrContactEmailTaken = errors.New("email is already used by another contact")
 )
 
 //go:generate mockgen -destination mocks/mock_contact_service.go -package mocks github.com/Notifuse/notifuse/internal/domain ContactService
@@ -69,6 +71,14 @@ type Contact struct {
 	CustomJSON4 *NullableJSON `json:"custom_json_4,omitempty" valid:"optional"`
 	CustomJSON5 *NullableJSON `json:"custom_json_5,omitempty" valid:"optional"`
 
+	// Workspace-defined typed attributes, see ContactAttributeDefinition.
+	// On upsert, keys are merged into the stored attributes; a null value removes the key.
+	Attributes MapOfAny `json:"attributes,omitempty" valid:"optional"`
+
+	// Free-form tags, stored in contact_tags.
+	// On upsert, tags are added to the existing ones; ContactTagService removes them.
+	Tags []string `json:"tags,omitempty" valid:"optional"`
+
 	// Timestamps
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
@@ -159,6 +169,8 @@ type dbContact struct {
 	UpdatedAt   time.Time
 	DBCreatedAt time.Time
 	DBUpdatedAt time.Time
+
+	Attributes []byte
 }
 
 // ScanContact scans a contact from the database
@@ -207,6 +219,7 @@ func ScanContact(scanner interface {
 		&dbc.UpdatedAt,
 		&dbc.DBCreatedAt,
 		&dbc.DBUpdatedAt,
+		&dbc.Attributes,
 	)
 
 	if err != nil {
@@ -364,6 +377,13 @@ func ScanContact(scanner interface {
 		}
 	}
 
+	if len(dbc.Attributes) > 0 && string(dbc.Attributes) != "null" {
+		var attributes MapOfAny
+		if err := json.Unmarshal(dbc.Attributes, &attributes); err == nil && len(attributes) > 0 {
+			c.Attributes = attributes
+		}
+	}
+
 	return c, nil
 }
 
@@ -601,6 +621,18 @@ type ContactService interface {
 
 	// CountContacts returns the total number of contacts in a workspace
 	CountContacts(ctx context.Context, workspaceID string) (int, error)
+
+	// MigrateCustomFieldsToAttributes copies legacy custom_* columns into typed attributes
+	MigrateCustomFieldsToAttributes(ctx context.Context, req *MigrateCustomFieldsRequest) (*MigrateCustomFieldsResponse, error)
+
+	// MergeContacts merges a source contact into a target contact and deletes the source
+	MergeContacts(ctx context.Context, req *MergeContactsRequest) (*MergeContactsResponse, error)
+
+	// FindDuplicateContacts suggests merges for contacts sharing an external_id or normalized email
+	FindDuplicateContacts(ctx context.Context, req *FindDuplicateContactsRequest) (*FindDuplicateContactsResponse, error)
+
+	// ChangeContactEmail moves a contact and its history to a new email address
+	ChangeContactEmail(ctx context.Context, req *ChangeContactEmailRequest) (*ChangeContactEmailResponse, error)
 }
 
 // ContactRepository is the interface for contact operations
@@ -636,6 +668,10 @@ type ContactRepository interface {
 	// CountContactsForBroadcast counts contacts based on broadcast audience settings
 	CountContactsForBroadcast(ctx context.Context, workspaceID string, audience AudienceSettings) (int, error)
 
+	// SaveBroadcastHoldout records the contacts of the audience held out of a broadcast, so that
+	// their conversions can be compared with the recipients. The audience holdout must be seeded.
+	SaveBroadcastHoldout(ctx context.Context, workspaceID string, broadcastID string, audience AudienceSettings) (int, error)
+
 	// Count returns the total number of contacts in a workspace
 	Count(ctx context.Context, workspaceID string) (int, error)
 
@@ -647,6 +683,38 @@ type ContactRepository interface {
 	// 'complained', or has been soft-deleted. The track_contact_list_changes
 	// trigger emits the corresponding list.bounced timeline rows.
 	MarkEmailsAsBounced(ctx context.Context, workspaceID string, emails []string, at time.Time) error
+
+	// MigrateCustomFieldsToAttributes copies each mapped custom_* column into
+	// contacts.attributes under the target attribute name, optionally nulling
+	// the legacy column. Returns the number of contacts rewritten.
+	MigrateCustomFieldsToAttributes(ctx context.Context, workspaceID string, mapping map[string]string, clearLegacyFields bool) (int64, error)
+
+	// MergeContacts merges the source contact into the target in a single
+	// transaction: fields are combined per precedence, list memberships are
+	// unioned, history is reassigned to the target and the source is deleted.
+	// Returns the merged target contact.
+	MergeContacts(ctx context.Context, workspaceID string, sourceEmail, targetEmail string, precedence ContactMergePrecedence) (*Contact, error)
+
+	// FindDuplicateContacts returns up to limit groups of contacts sharing an
+	// external_id or a normalized email
+	FindDuplicateContacts(ctx context.Context, workspaceID string, limit int) ([]*ContactDuplicateGroup, error)
+
+	// ChangeEmail rewrites a contact's email across every workspace table in a
+	// single transaction and records the previous address as an alias, so
+	// provider events for it still resolve to the contact.
+	// Returns the updated contact.
+	ChangeEmail(ctx context.Context, workspaceID string, currentEmail, newEmail string) (*Contact, error)
+
+	// ExportContactData returns every row stored about a contact, grouped by table
+	ExportContactData(ctx context.Context, workspaceID string, email string) (*ContactDataExport, error)
+
+	// EraseContact hard-deletes a contact with all its history and records the
+	// hash of its email in erased_contacts so it cannot be recreated.
+	// Returns whether a contact existed.
+	EraseContact(ctx context.Context, workspaceID string, email string) (bool, error)
+
+	// FilterErasedEmails returns the given emails that belong to erased contacts
+	FilterErasedEmails(ctx context.Context, workspaceID string, emails []string) ([]string, error)
 }
 
 // FromJSON parses JSON data into a Contact struct
@@ -816,6 +884,39 @@ func FromJSON(data interface{}) (*Contact, error) {
 		}
 	}
 
+	// Parse typed attributes; values are checked against the workspace schema in the service layer
+	if value := jsonResult.Get("attributes"); value.Exists() && value.Type != gjson.Null {
+		if !value.IsObject() {
+			return nil, fmt.Errorf("invalid type for attributes: expected object, got %s", value.Type)
+		}
+		attributes, ok := value.Value().(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid attributes object")
+		}
+		contact.Attributes = MapOfAny(attributes)
+	}
+
+	// Parse tags
+	if value := jsonResult.Get("tags"); value.Exists() && value.Type != gjson.Null {
+		if !value.IsArray() {
+			return nil, fmt.Errorf("invalid type for tags: expected array, got %s", value.Type)
+		}
+		var tags []string
+		for _, item := range value.Array() {
+			if item.Type != gjson.String {
+				return nil, fmt.Errorf("invalid type for tag: expected string, got %s", item.Type)
+			}
+			tags = append(tags, item.String())
+		}
+		normalized, err := NormalizeTags(tags)
+		if err != nil {
+			return nil, err
+		}
+		if len(normalized) > 0 {
+			contact.Tags = normalized
+		}
+	}
+
 	return contact, nil
 }
 
@@ -999,6 +1100,34 @@ func (c *Contact) Merge(other *Contact) {
 		c.CustomJSON5 = other.CustomJSON5
 	}
 
+	// Attributes merge key by key, a nil value removes the key
+	if len(other.Attributes) > 0 {
+		if c.Attributes == nil {
+			c.Attributes = MapOfAny{}
+		}
+		for name, value := range other.Attributes {
+			if value == nil {
+				delete(c.Attributes, name)
+			} else {
+				c.Attributes[name] = value
+			}
+		}
+	}
+
+	// Tags are added, never removed
+	for _, tag := range other.Tags {
+		found := false
+		for _, existing := range c.Tags {
+			if existing == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			c.Tags = append(c.Tags, tag)
+		}
+	}
+
 	// Update timestamps
 	if !other.CreatedAt.IsZero() {
 		c.CreatedAt = other.CreatedAt
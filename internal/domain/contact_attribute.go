@@ -0,0 +1,326 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Notifuse/notifuse/pkg/analytics"
+)
+
+// ContactAttributeType defines the value type of a workspace-defined contact attribute
+type ContactAttributeType string
+
+const (
+	ContactAttributeTypeString   ContactAttributeType = "string"
+	ContactAttributeTypeNumber   ContactAttributeType = "number"
+	ContactAttributeTypeBoolean  ContactAttributeType = "boolean"
+	ContactAttributeTypeDatetime ContactAttributeType = "datetime"
+	ContactAttributeTypeJSON     ContactAttributeType = "json"
+)
+
+// MaxContactAttributes caps the number of attribute definitions per workspace
+const MaxContactAttributes = 200
+
+// contactAttributeNameRegex restricts attribute names to snake_case identifiers.
+// Names are interpolated into JSONB path expressions and analytics column
+// aliases, so this pattern is also what keeps them SQL-safe.
+var contactAttributeNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+
+// ContactAttributeDefinition describes one typed attribute stored in contacts.attributes
+type ContactAttributeDefinition struct {
+	Name        string               `json:"name"`
+	Type        ContactAttributeType `json:"type"`
+	Label       string               `json:"label"`
+	Description string               `json:"description,omitempty"`
+	// Validation rules, all optional
+	Options   []string `json:"options,omitempty"`    // allowed values for string attributes
+	Pattern   string   `json:"pattern,omitempty"`    // regular expression for string attributes
+	MaxLength int      `json:"max_length,omitempty"` // max length for string attributes
+	Min       *float64 `json:"min,omitempty"`        // lower bound for number attributes
+	Max       *float64 `json:"max,omitempty"`        // upper bound for number attributes
+}
+
+// Validate validates the attribute definition itself
+func (d *ContactAttributeDefinition) Validate() error {
+	if !contactAttributeNameRegex.MatchString(d.Name) {
+		return fmt.Errorf("invalid attribute name '%s': must be snake_case, start with a letter and be at most 63 characters", d.Name)
+	}
+
+	switch d.Type {
+	case ContactAttributeTypeString, ContactAttributeTypeNumber, ContactAttributeTypeBoolean,
+		ContactAttributeTypeDatetime, ContactAttributeTypeJSON:
+	default:
+		return fmt.Errorf("attribute '%s': invalid type '%s'", d.Name, d.Type)
+	}
+
+	if d.Label == "" {
+		return fmt.Errorf("attribute '%s': label is required", d.Name)
+	}
+	if len(d.Label) > 100 {
+		return fmt.Errorf("attribute '%s': label exceeds maximum length of 100 characters", d.Name)
+	}
+
+	if d.Type != ContactAttributeTypeString && (len(d.Options) > 0 || d.Pattern != "" || d.MaxLength != 0) {
+		return fmt.Errorf("attribute '%s': options, pattern and max_length are only allowed on string attributes", d.Name)
+	}
+	if d.Type != ContactAttributeTypeNumber && (d.Min != nil || d.Max != nil) {
+		return fmt.Errorf("attribute '%s': min and max are only allowed on number attributes", d.Name)
+	}
+	if d.Pattern != "" {
+		if _, err := regexp.Compile(d.Pattern); err != nil {
+			return fmt.Errorf("attribute '%s': invalid pattern: %w", d.Name, err)
+		}
+	}
+	if d.MaxLength < 0 {
+		return fmt.Errorf("attribute '%s': max_length cannot be negative", d.Name)
+	}
+	if d.Min != nil && d.Max != nil && *d.Min > *d.Max {
+		return fmt.Errorf("attribute '%s': min cannot be greater than max", d.Name)
+	}
+
+	return nil
+}
+
+// ValidateValue checks a single attribute value against the definition and
+// returns the normalized value to persist. A nil value is always accepted
+// and means "remove the attribute from the contact".
+func (d *ContactAttributeDefinition) ValidateValue(value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch d.Type {
+	case ContactAttributeTypeString:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("attribute '%s': expected string, got %T", d.Name, value)
+		}
+		s = trimUnicodeSpace(s)
+		if d.MaxLength > 0 && len(s) > d.MaxLength {
+			return nil, fmt.Errorf("attribute '%s': value exceeds maximum length of %d", d.Name, d.MaxLength)
+		}
+		if len(d.Options) > 0 {
+			found := false
+			for _, option := range d.Options {
+				if option == s {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("attribute '%s': value '%s' is not one of %s", d.Name, s, strings.Join(d.Options, ", "))
+			}
+		}
+		if d.Pattern != "" {
+			re, err := regexp.Compile(d.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("attribute '%s': invalid pattern: %w", d.Name, err)
+			}
+			if !re.MatchString(s) {
+				return nil, fmt.Errorf("attribute '%s': value does not match pattern", d.Name)
+			}
+		}
+		return s, nil
+
+	case ContactAttributeTypeNumber:
+		var n float64
+		switch v := value.(type) {
+		case float64:
+			n = v
+		case float32:
+			n = float64(v)
+		case int:
+			n = float64(v)
+		case int64:
+			n = float64(v)
+		default:
+			return nil, fmt.Errorf("attribute '%s': expected number, got %T", d.Name, value)
+		}
+		if d.Min != nil && n < *d.Min {
+			return nil, fmt.Errorf("attribute '%s': value must be >= %v", d.Name, *d.Min)
+		}
+		if d.Max != nil && n > *d.Max {
+			return nil, fmt.Errorf("attribute '%s': value must be <= %v", d.Name, *d.Max)
+		}
+		return n, nil
+
+	case ContactAttributeTypeBoolean:
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("attribute '%s': expected boolean, got %T", d.Name, value)
+		}
+		return b, nil
+
+	case ContactAttributeTypeDatetime:
+		switch v := value.(type) {
+		case time.Time:
+			return v.UTC().Format(time.RFC3339), nil
+		case string:
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, fmt.Errorf("attribute '%s': invalid datetime, expected RFC3339: %w", d.Name, err)
+			}
+			return t.UTC().Format(time.RFC3339), nil
+		default:
+			return nil, fmt.Errorf("attribute '%s': expected RFC3339 datetime string, got %T", d.Name, value)
+		}
+
+	case ContactAttributeTypeJSON:
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return value, nil
+		default:
+			return nil, fmt.Errorf("attribute '%s': expected JSON object or array, got %T", d.Name, value)
+		}
+	}
+
+	return nil, fmt.Errorf("attribute '%s': unsupported type '%s'", d.Name, d.Type)
+}
+
+// ContactAttributeDefinitions is the workspace-level attribute schema
+type ContactAttributeDefinitions []ContactAttributeDefinition
+
+// Validate validates every definition and rejects duplicates
+func (defs ContactAttributeDefinitions) Validate() error {
+	if len(defs) > MaxContactAttributes {
+		return fmt.Errorf("too many contact attributes: maximum is %d", MaxContactAttributes)
+	}
+
+	seen := make(map[string]bool, len(defs))
+	for i := range defs {
+		if err := defs[i].Validate(); err != nil {
+			return err
+		}
+		if seen[defs[i].Name] {
+			return fmt.Errorf("duplicate attribute name: %s", defs[i].Name)
+		}
+		seen[defs[i].Name] = true
+	}
+	return nil
+}
+
+// Get returns the definition with the given name
+func (defs ContactAttributeDefinitions) Get(name string) (*ContactAttributeDefinition, bool) {
+	for i := range defs {
+		if defs[i].Name == name {
+			return &defs[i], true
+		}
+	}
+	return nil, false
+}
+
+// ValidateAttributes validates a contact's attribute values against the schema
+// and normalizes them in place. Unknown attribute names are rejected.
+func (defs ContactAttributeDefinitions) ValidateAttributes(attributes MapOfAny) error {
+	// Iterate in a stable order so the first reported error is deterministic
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		def, ok := defs.Get(name)
+		if !ok {
+			return fmt.Errorf("unknown contact attribute: %s", name)
+		}
+		normalized, err := def.ValidateValue(attributes[name])
+		if err != nil {
+			return err
+		}
+		attributes[name] = normalized
+	}
+	return nil
+}
+
+// AnalyticsDimensions exposes each scalar attribute as a dimension of the
+// contacts analytics schema, keyed "attribute_<name>".
+func (defs ContactAttributeDefinitions) AnalyticsDimensions() map[string]analytics.DimensionDefinition {
+	dimensions := make(map[string]analytics.DimensionDefinition, len(defs))
+	for _, def := range defs {
+		var dimension analytics.DimensionDefinition
+		switch def.Type {
+		case ContactAttributeTypeString, ContactAttributeTypeBoolean:
+			dimension = analytics.DimensionDefinition{
+				Type: "string",
+				SQL:  fmt.Sprintf("(attributes->>'%s')", def.Name),
+			}
+		case ContactAttributeTypeNumber:
+			dimension = analytics.DimensionDefinition{
+				Type: "number",
+				SQL:  fmt.Sprintf("((attributes->>'%s')::numeric)", def.Name),
+			}
+		case ContactAttributeTypeDatetime:
+			dimension = analytics.DimensionDefinition{
+				Type: "time",
+				SQL:  fmt.Sprintf("((attributes->>'%s')::timestamptz)", def.Name),
+			}
+		default:
+			// JSON attributes have no meaningful scalar grouping
+			continue
+		}
+		dimension.Title = def.Label
+		dimension.Description = def.Description
+		dimensions["attribute_"+def.Name] = dimension
+	}
+	return dimensions
+}
+
+// LegacyCustomFieldTypes maps each legacy custom_* column to the attribute type it can migrate to
+var LegacyCustomFieldTypes = func() map[string]ContactAttributeType {
+	fields := make(map[string]ContactAttributeType, 20)
+	for i := 1; i <= 5; i++ {
+		fields[fmt.Sprintf("custom_string_%d", i)] = ContactAttributeTypeString
+		fields[fmt.Sprintf("custom_number_%d", i)] = ContactAttributeTypeNumber
+		fields[fmt.Sprintf("custom_datetime_%d", i)] = ContactAttributeTypeDatetime
+		fields[fmt.Sprintf("custom_json_%d", i)] = ContactAttributeTypeJSON
+	}
+	return fields
+}()
+
+// MigrateCustomFieldsRequest copies legacy custom_* columns into typed attributes
+type MigrateCustomFieldsRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	// Mapping from legacy column (e.g. "custom_string_1") to attribute name (e.g. "plan")
+	Mapping map[string]string `json:"mapping"`
+	// ClearLegacyFields nulls the legacy columns once copied
+	ClearLegacyFields bool `json:"clear_legacy_fields,omitempty"`
+}
+
+// Validate checks the mapping against the workspace attribute schema
+func (r *MigrateCustomFieldsRequest) Validate(defs ContactAttributeDefinitions) error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	if len(r.Mapping) == 0 {
+		return fmt.Errorf("mapping is required")
+	}
+
+	targets := make(map[string]string, len(r.Mapping))
+	for field, attribute := range r.Mapping {
+		fieldType, ok := LegacyCustomFieldTypes[field]
+		if !ok {
+			return fmt.Errorf("invalid custom field key: %s", field)
+		}
+		def, ok := defs.Get(attribute)
+		if !ok {
+			return fmt.Errorf("unknown contact attribute: %s", attribute)
+		}
+		if def.Type != fieldType {
+			return fmt.Errorf("cannot map %s to attribute '%s': type %s does not match %s", field, attribute, fieldType, def.Type)
+		}
+		if previous, dup := targets[attribute]; dup {
+			return fmt.Errorf("attribute '%s' is mapped from both %s and %s", attribute, previous, field)
+		}
+		targets[attribute] = field
+	}
+	return nil
+}
+
+// MigrateCustomFieldsResponse reports how many contacts were rewritten
+type MigrateCustomFieldsResponse struct {
+	UpdatedContacts int64 `json:"updated_contacts"`
+}
@@ -0,0 +1,270 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
+func TestContactAttributeDefinition_Validate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		def     ContactAttributeDefinition
+		wantErr string
+	}{
+		{
+			name: "valid string attribute",
+			def:  ContactAttributeDefinition{Name: "plan", Type: ContactAttributeTypeString, Label: "Plan", Options: []string{"free", "pro"}},
+		},
+		{
+			name: "valid number attribute with bounds",
+			def:  ContactAttributeDefinition{Name: "seats", Type: ContactAttributeTypeNumber, Label: "Seats", Min: floatPtr(1), Max: floatPtr(100)},
+		},
+		{
+			name:    "invalid name",
+			def:     ContactAttributeDefinition{Name: "Plan-Name", Type: ContactAttributeTypeString, Label: "Plan"},
+			wantErr: "invalid attribute name",
+		},
+		{
+			name:    "name starting with digit",
+			def:     ContactAttributeDefinition{Name: "1plan", Type: ContactAttributeTypeString, Label: "Plan"},
+			wantErr: "invalid attribute name",
+		},
+		{
+			name:    "invalid type",
+			def:     ContactAttributeDefinition{Name: "plan", Type: "enum", Label: "Plan"},
+			wantErr: "invalid type",
+		},
+		{
+			name:    "missing label",
+			def:     ContactAttributeDefinition{Name: "plan", Type: ContactAttributeTypeString},
+			wantErr: "label is required",
+		},
+		{
+			name:    "options on number attribute",
+			def:     ContactAttributeDefinition{Name: "seats", Type: ContactAttributeTypeNumber, Label: "Seats", Options: []string{"1"}},
+			wantErr: "only allowed on string attributes",
+		},
+		{
+			name:    "min on string attribute",
+			def:     ContactAttributeDefinition{Name: "plan", Type: ContactAttributeTypeString, Label: "Plan", Min: floatPtr(1)},
+			wantErr: "only allowed on number attributes",
+		},
+		{
+			name:    "invalid pattern",
+			def:     ContactAttributeDefinition{Name: "code", Type: ContactAttributeTypeString, Label: "Code", Pattern: "("},
+			wantErr: "invalid pattern",
+		},
+		{
+			name:    "min greater than max",
+			def:     ContactAttributeDefinition{Name: "seats", Type: ContactAttributeTypeNumber, Label: "Seats", Min: floatPtr(10), Max: floatPtr(1)},
+			wantErr: "min cannot be greater than max",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.def.Validate()
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestContactAttributeDefinition_ValidateValue(t *testing.T) {
+	plan := ContactAttributeDefinition{Name: "plan", Type: ContactAttributeTypeString, Label: "Plan", Options: []string{"free", "pro"}}
+	code := ContactAttributeDefinition{Name: "code", Type: ContactAttributeTypeString, Label: "Code", Pattern: "^[A-Z]{3}$", MaxLength: 3}
+	seats := ContactAttributeDefinition{Name: "seats", Type: ContactAttributeTypeNumber, Label: "Seats", Min: floatPtr(1), Max: floatPtr(100)}
+	active := ContactAttributeDefinition{Name: "active", Type: ContactAttributeTypeBoolean, Label: "Active"}
+	renewal := ContactAttributeDefinition{Name: "renewal", Type: ContactAttributeTypeDatetime, Label: "Renewal"}
+	prefs := ContactAttributeDefinition{Name: "prefs", Type: ContactAttributeTypeJSON, Label: "Preferences"}
+
+	testCases := []struct {
+		name    string
+		def     ContactAttributeDefinition
+		value   interface{}
+		want    interface{}
+		wantErr string
+	}{
+		{name: "nil is always accepted", def: seats, value: nil, want: nil},
+		{name: "string option", def: plan, value: " pro ", want: "pro"},
+		{name: "string not in options", def: plan, value: "enterprise", wantErr: "is not one of"},
+		{name: "string wrong type", def: plan, value: 42.0, wantErr: "expected string"},
+		{name: "string matches pattern", def: code, value: "ABC", want: "ABC"},
+		{name: "string does not match pattern", def: code, value: "abc", wantErr: "does not match pattern"},
+		{name: "string too long", def: code, value: "ABCD", wantErr: "exceeds maximum length"},
+		{name: "number within bounds", def: seats, value: 10.0, want: 10.0},
+		{name: "number from int", def: seats, value: 5, want: 5.0},
+		{name: "number below min", def: seats, value: 0.0, wantErr: "must be >="},
+		{name: "number above max", def: seats, value: 101.0, wantErr: "must be <="},
+		{name: "number wrong type", def: seats, value: "10", wantErr: "expected number"},
+		{name: "boolean", def: active, value: true, want: true},
+		{name: "boolean wrong type", def: active, value: "true", wantErr: "expected boolean"},
+		{name: "datetime normalized to UTC", def: renewal, value: "2026-03-01T10:00:00+02:00", want: "2026-03-01T08:00:00Z"},
+		{name: "datetime from time.Time", def: renewal, value: time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC), want: "2026-03-01T08:00:00Z"},
+		{name: "datetime invalid", def: renewal, value: "tomorrow", wantErr: "invalid datetime"},
+		{name: "json object", def: prefs, value: map[string]interface{}{"theme": "dark"}, want: map[string]interface{}{"theme": "dark"}},
+		{name: "json array", def: prefs, value: []interface{}{"a", "b"}, want: []interface{}{"a", "b"}},
+		{name: "json scalar rejected", def: prefs, value: "dark", wantErr: "expected JSON object or array"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.def.ValidateValue(tc.value)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestContactAttributeDefinitions_Validate(t *testing.T) {
+	t.Run("valid definitions", func(t *testing.T) {
+		defs := ContactAttributeDefinitions{
+			{Name: "plan", Type: ContactAttributeTypeString, Label: "Plan"},
+			{Name: "seats", Type: ContactAttributeTypeNumber, Label: "Seats"},
+		}
+		assert.NoError(t, defs.Validate())
+	})
+
+	t.Run("duplicate names", func(t *testing.T) {
+		defs := ContactAttributeDefinitions{
+			{Name: "plan", Type: ContactAttributeTypeString, Label: "Plan"},
+			{Name: "plan", Type: ContactAttributeTypeNumber, Label: "Plan number"},
+		}
+		err := defs.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicate attribute name")
+	})
+
+	t.Run("too many definitions", func(t *testing.T) {
+		defs := make(ContactAttributeDefinitions, MaxContactAttributes+1)
+		err := defs.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "too many contact attributes")
+	})
+}
+
+func TestContactAttributeDefinitions_ValidateAttributes(t *testing.T) {
+	defs := ContactAttributeDefinitions{
+		{Name: "plan", Type: ContactAttributeTypeString, Label: "Plan"},
+		{Name: "renewal", Type: ContactAttributeTypeDatetime, Label: "Renewal"},
+	}
+
+	t.Run("normalizes values in place", func(t *testing.T) {
+		attributes := MapOfAny{"plan": " pro ", "renewal": "2026-03-01T10:00:00+02:00"}
+		require.NoError(t, defs.ValidateAttributes(attributes))
+		assert.Equal(t, "pro", attributes["plan"])
+		assert.Equal(t, "2026-03-01T08:00:00Z", attributes["renewal"])
+	})
+
+	t.Run("rejects unknown attributes", func(t *testing.T) {
+		err := defs.ValidateAttributes(MapOfAny{"unknown": "x"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown contact attribute: unknown")
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		err := defs.ValidateAttributes(MapOfAny{"plan": 1.0})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "expected string")
+	})
+}
+
+func TestContactAttributeDefinitions_AnalyticsDimensions(t *testing.T) {
+	defs := ContactAttributeDefinitions{
+		{Name: "plan", Type: ContactAttributeTypeString, Label: "Plan", Description: "Billing plan"},
+		{Name: "seats", Type: ContactAttributeTypeNumber, Label: "Seats"},
+		{Name: "active", Type: ContactAttributeTypeBoolean, Label: "Active"},
+		{Name: "renewal", Type: ContactAttributeTypeDatetime, Label: "Renewal"},
+		{Name: "prefs", Type: ContactAttributeTypeJSON, Label: "Preferences"},
+	}
+
+	dimensions := defs.AnalyticsDimensions()
+
+	require.Len(t, dimensions, 4)
+	assert.Equal(t, "string", dimensions["attribute_plan"].Type)
+	assert.Equal(t, "(attributes->>'plan')", dimensions["attribute_plan"].SQL)
+	assert.Equal(t, "Plan", dimensions["attribute_plan"].Title)
+	assert.Equal(t, "Billing plan", dimensions["attribute_plan"].Description)
+	assert.Equal(t, "number", dimensions["attribute_seats"].Type)
+	assert.Equal(t, "((attributes->>'seats')::numeric)", dimensions["attribute_seats"].SQL)
+	assert.Equal(t, "string", dimensions["attribute_active"].Type)
+	assert.Equal(t, "time", dimensions["attribute_renewal"].Type)
+	assert.Equal(t, "((attributes->>'renewal')::timestamptz)", dimensions["attribute_renewal"].SQL)
+	assert.NotContains(t, dimensions, "attribute_prefs")
+}
+
+func TestMigrateCustomFieldsRequest_Validate(t *testing.T) {
+	defs := ContactAttributeDefinitions{
+		{Name: "plan", Type: ContactAttributeTypeString, Label: "Plan"},
+		{Name: "seats", Type: ContactAttributeTypeNumber, Label: "Seats"},
+	}
+
+	testCases := []struct {
+		name    string
+		req     MigrateCustomFieldsRequest
+		wantErr string
+	}{
+		{
+			name: "valid mapping",
+			req:  MigrateCustomFieldsRequest{WorkspaceID: "ws1", Mapping: map[string]string{"custom_string_1": "plan", "custom_number_2": "seats"}},
+		},
+		{
+			name:    "missing workspace",
+			req:     MigrateCustomFieldsRequest{Mapping: map[string]string{"custom_string_1": "plan"}},
+			wantErr: "workspace_id is required",
+		},
+		{
+			name:    "empty mapping",
+			req:     MigrateCustomFieldsRequest{WorkspaceID: "ws1"},
+			wantErr: "mapping is required",
+		},
+		{
+			name:    "invalid custom field",
+			req:     MigrateCustomFieldsRequest{WorkspaceID: "ws1", Mapping: map[string]string{"first_name": "plan"}},
+			wantErr: "invalid custom field key",
+		},
+		{
+			name:    "unknown attribute",
+			req:     MigrateCustomFieldsRequest{WorkspaceID: "ws1", Mapping: map[string]string{"custom_string_1": "tier"}},
+			wantErr: "unknown contact attribute",
+		},
+		{
+			name:    "type mismatch",
+			req:     MigrateCustomFieldsRequest{WorkspaceID: "ws1", Mapping: map[string]string{"custom_string_1": "seats"}},
+			wantErr: "does not match",
+		},
+		{
+			name:    "duplicate target",
+			req:     MigrateCustomFieldsRequest{WorkspaceID: "ws1", Mapping: map[string]string{"custom_string_1": "plan", "custom_string_2": "plan"}},
+			wantErr: "is mapped from both",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.req.Validate(defs)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
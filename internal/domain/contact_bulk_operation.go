@@ -0,0 +1,406 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:generate mockgen -destination mocks/mock_contact_bulk_operation_repository.go -package mocks github.com/Notifuse/notifuse/internal/domain ContactBulkOperationRepository
+//go:generate mockgen -destination mocks/mock_contact_bulk_operation_service.go -package mocks github.com/Notifuse/notifuse/internal/domain ContactBulkOperationService
+
+// TaskTypeBulkContactOperation is the task type running contact bulk operations
+const TaskTypeBulkContactOperation = "bulk_contact_operation"
+
+// ErrBulkOperationNotFound is returned when a bulk operation does not exist
+var ErrBulkOperationNotFound = errors.New("bulk operation not found")
+
+const (
+	// BulkOperationBatchSize is the number of contacts updated per transaction
+	BulkOperationBatchSize = 500
+	// MaxBulkOperationsListLimit bounds the operations returned by a list request
+	MaxBulkOperationsListLimit = 100
+	// BulkOperationAttributePrefix prefixes update_field fields targeting a typed attribute
+	BulkOperationAttributePrefix = "attributes."
+)
+
+// BulkOperationType is the action applied to every targeted contact
+type BulkOperationType string
+
+const (
+	BulkOperationAddToList      BulkOperationType = "add_to_list"
+	BulkOperationRemoveFromList BulkOperationType = "remove_from_list"
+	BulkOperationUpdateField    BulkOperationType = "update_field"
+	BulkOperationDelete         BulkOperationType = "delete"
+	BulkOperationAddTags        BulkOperationType = "add_tags"
+	BulkOperationRemoveTags     BulkOperationType = "remove_tags"
+)
+
+// BulkOperationStatus is the lifecycle status of a bulk operation
+type BulkOperationStatus string
+
+const (
+	BulkOperationStatusPending   BulkOperationStatus = "pending"
+	BulkOperationStatusRunning   BulkOperationStatus = "running"
+	BulkOperationStatusCompleted BulkOperationStatus = "completed"
+	BulkOperationStatusFailed    BulkOperationStatus = "failed"
+)
+
+// bulkOperationFieldTypes lists the contact columns update_field can set, with their value type
+var bulkOperationFieldTypes = map[string]ContactAttributeType{
+	"external_id":    ContactAttributeTypeString,
+	"timezone":       ContactAttributeTypeString,
+	"language":       ContactAttributeTypeString,
+	"first_name":     ContactAttributeTypeString,
+	"last_name":      ContactAttributeTypeString,
+	"full_name":      ContactAttributeTypeString,
+	"phone":          ContactAttributeTypeString,
+	"address_line_1": ContactAttributeTypeString,
+	"address_line_2": ContactAttributeTypeString,
+	"country":        ContactAttributeTypeString,
+	"postcode":       ContactAttributeTypeString,
+	"state":          ContactAttributeTypeString,
+	"job_title":      ContactAttributeTypeString,
+}
+
+func init() {
+	for i := 1; i <= 5; i++ {
+		bulkOperationFieldTypes["custom_string_"+strconv.Itoa(i)] = ContactAttributeTypeString
+		bulkOperationFieldTypes["custom_number_"+strconv.Itoa(i)] = ContactAttributeTypeNumber
+		bulkOperationFieldTypes["custom_datetime_"+strconv.Itoa(i)] = ContactAttributeTypeDatetime
+		bulkOperationFieldTypes["custom_json_"+strconv.Itoa(i)] = ContactAttributeTypeJSON
+	}
+}
+
+// BulkOperationFieldType returns the value type of a contact column update_field can set
+func BulkOperationFieldType(field string) (ContactAttributeType, bool) {
+	fieldType, ok := bulkOperationFieldTypes[field]
+	return fieldType, ok
+}
+
+// BulkOperationTarget selects the contacts of a bulk operation.
+// Exactly one of SegmentID or Filter is set.
+type BulkOperationTarget struct {
+	SegmentID string `json:"segment_id,omitempty"`
+	// Filter uses the filters of contacts.list, its pagination fields are ignored
+	Filter *GetContactsRequest `json:"filter,omitempty"`
+}
+
+// Value implements the driver.Valuer interface for database serialization
+func (t BulkOperationTarget) Value() (driver.Value, error) {
+	return json.Marshal(t)
+}
+
+// Scan implements the sql.Scanner interface for database deserialization
+func (t *BulkOperationTarget) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", value)
+	}
+	return json.Unmarshal(bytes.Clone(b), t)
+}
+
+// Validate checks that a single target is set and that a filter is not empty,
+// so that an operation never targets every contact by mistake
+func (t *BulkOperationTarget) Validate() error {
+	if (t.SegmentID == "") == (t.Filter == nil) {
+		return fmt.Errorf("exactly one of target.segment_id or target.filter is required")
+	}
+	if t.Filter == nil {
+		return nil
+	}
+
+	f := t.Filter
+	if f.Email == "" && f.ExternalID == "" && f.FirstName == "" && f.LastName == "" && f.FullName == "" &&
+		f.Phone == "" && f.Country == "" && f.Language == "" && f.ListID == "" && f.ContactListStatus == "" &&
+		len(f.Segments) == 0 {
+		return fmt.Errorf("target.filter must set at least one filter")
+	}
+	// Only the filters are kept
+	f.WorkspaceID = ""
+	f.WithContactLists = false
+	f.Limit = 0
+	f.Cursor = ""
+	return nil
+}
+
+// BulkOperationParams holds the arguments of the operation
+type BulkOperationParams struct {
+	// ListID is the list of add_to_list and remove_from_list
+	ListID string `json:"list_id,omitempty"`
+	// Status is the subscription status set by add_to_list, active by default
+	Status ContactListStatus `json:"status,omitempty"`
+	// Field is the contact column, or "attributes.<name>" for a typed attribute, set by update_field
+	Field string `json:"field,omitempty"`
+	// FieldValue is the value set by update_field, null clears the field
+	FieldValue interface{} `json:"value,omitempty"`
+	// Tags are the tags of add_tags and remove_tags
+	Tags []string `json:"tags,omitempty"`
+}
+
+// Value implements the driver.Valuer interface for database serialization
+func (p BulkOperationParams) Value() (driver.Value, error) {
+	return json.Marshal(p)
+}
+
+// Scan implements the sql.Scanner interface for database deserialization
+func (p *BulkOperationParams) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", value)
+	}
+	return json.Unmarshal(bytes.Clone(b), p)
+}
+
+// AttributeName returns the attribute set by update_field, if the field is an attribute
+func (p *BulkOperationParams) AttributeName() (string, bool) {
+	if !strings.HasPrefix(p.Field, BulkOperationAttributePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(p.Field, BulkOperationAttributePrefix), true
+}
+
+// validate checks the params required by the operation and normalizes them.
+// Attribute values are checked by the service against the workspace definitions.
+func (p *BulkOperationParams) validate(operation BulkOperationType) error {
+	switch operation {
+	case BulkOperationAddToList, BulkOperationRemoveFromList:
+		if p.ListID == "" {
+			return fmt.Errorf("params.list_id is required")
+		}
+		if operation == BulkOperationRemoveFromList {
+			return nil
+		}
+		if p.Status == "" {
+			p.Status = ContactListStatusActive
+		}
+		if p.Status != ContactListStatusActive && p.Status != ContactListStatusPending && p.Status != ContactListStatusUnsubscribed {
+			return fmt.Errorf("params.status must be active, pending or unsubscribed")
+		}
+
+	case BulkOperationUpdateField:
+		if p.Field == "" {
+			return fmt.Errorf("params.field is required")
+		}
+		if name, ok := p.AttributeName(); ok {
+			if name == "" {
+				return fmt.Errorf("params.field is missing the attribute name")
+			}
+			return nil
+		}
+		fieldType, ok := BulkOperationFieldType(p.Field)
+		if !ok {
+			return fmt.Errorf("params.field %q cannot be updated", p.Field)
+		}
+		value, err := normalizeBulkFieldValue(p.Field, fieldType, p.FieldValue)
+		if err != nil {
+			return err
+		}
+		p.FieldValue = value
+
+	case BulkOperationAddTags, BulkOperationRemoveTags:
+		if len(p.Tags) == 0 {
+			return fmt.Errorf("params.tags is required")
+		}
+		if len(p.Tags) > MaxTagsPerRequest {
+			return fmt.Errorf("cannot update more than %d tags per operation", MaxTagsPerRequest)
+		}
+		tags, err := NormalizeTags(p.Tags)
+		if err != nil {
+			return err
+		}
+		p.Tags = tags
+
+	case BulkOperationDelete:
+
+	default:
+		return fmt.Errorf("invalid operation: %s", operation)
+	}
+	return nil
+}
+
+// normalizeBulkFieldValue checks a column value against its type
+func normalizeBulkFieldValue(field string, fieldType ContactAttributeType, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch fieldType {
+	case ContactAttributeTypeString:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected string, got %T", field, value)
+		}
+		return strings.TrimSpace(s), nil
+
+	case ContactAttributeTypeNumber:
+		n, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected number, got %T", field, value)
+		}
+		return n, nil
+
+	case ContactAttributeTypeDatetime:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected an RFC3339 datetime, got %T", field, value)
+		}
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: expected an RFC3339 datetime: %w", field, err)
+		}
+		return t.UTC().Format(time.RFC3339Nano), nil
+	}
+
+	// JSON columns take any value
+	return value, nil
+}
+
+// ContactBulkOperation is the audit record of a bulk operation, updated as its task progresses
+type ContactBulkOperation struct {
+	ID        string              `json:"id"`
+	Operation BulkOperationType   `json:"operation"`
+	Target    BulkOperationTarget `json:"target"`
+	Params    BulkOperationParams `json:"params"`
+	Status    BulkOperationStatus `json:"status"`
+	// TotalCount is the number of targeted contacts when the operation was created
+	TotalCount     int64 `json:"total_count"`
+	ProcessedCount int64 `json:"processed_count"`
+	// AffectedCount is the number of contacts actually changed
+	AffectedCount int64      `json:"affected_count"`
+	TaskID        string     `json:"task_id,omitempty"`
+	Error         *string    `json:"error,omitempty"`
+	CreatedBy     string     `json:"created_by"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+}
+
+// CreateBulkOperationRequest starts a bulk operation, or counts its targets when DryRun is set
+type CreateBulkOperationRequest struct {
+	WorkspaceID string              `json:"workspace_id"`
+	Operation   BulkOperationType   `json:"operation"`
+	Target      BulkOperationTarget `json:"target"`
+	Params      BulkOperationParams `json:"params"`
+	DryRun      bool                `json:"dry_run,omitempty"`
+}
+
+// Validate validates and normalizes the request
+func (r *CreateBulkOperationRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	if r.Operation == "" {
+		return fmt.Errorf("operation is required")
+	}
+	if err := r.Params.validate(r.Operation); err != nil {
+		return err
+	}
+	return r.Target.Validate()
+}
+
+// CreateBulkOperationResponse returns the number of targeted contacts,
+// and the created operation unless the request was a dry run
+type CreateBulkOperationResponse struct {
+	Count     int64                 `json:"count"`
+	Operation *ContactBulkOperation `json:"operation,omitempty"`
+}
+
+// GetBulkOperationRequest fetches a bulk operation
+type GetBulkOperationRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	ID          string `json:"id"`
+}
+
+// FromURLParams parses query parameters into a GetBulkOperationRequest
+func (r *GetBulkOperationRequest) FromURLParams(values url.Values) error {
+	r.WorkspaceID = values.Get("workspace_id")
+	r.ID = values.Get("id")
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	if r.ID == "" {
+		return fmt.Errorf("id is required")
+	}
+	return nil
+}
+
+// ListBulkOperationsRequest lists the latest bulk operations of a workspace
+type ListBulkOperationsRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	Limit       int    `json:"limit,omitempty"`
+}
+
+// FromURLParams parses query parameters into a ListBulkOperationsRequest
+func (r *ListBulkOperationsRequest) FromURLParams(values url.Values) error {
+	r.WorkspaceID = values.Get("workspace_id")
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+
+	r.Limit = 20
+	if limit := values.Get("limit"); limit != "" {
+		parsed, err := strconv.Atoi(limit)
+		if err != nil || parsed < 1 || parsed > MaxBulkOperationsListLimit {
+			return fmt.Errorf("limit must be between 1 and %d", MaxBulkOperationsListLimit)
+		}
+		r.Limit = parsed
+	}
+	return nil
+}
+
+// ListBulkOperationsResponse lists bulk operations, most recent first
+type ListBulkOperationsResponse struct {
+	Operations []*ContactBulkOperation `json:"operations"`
+}
+
+// BulkContactOperationState is the state of a bulk_contact_operation task
+type BulkContactOperationState struct {
+	OperationID string `json:"operation_id"`
+	// Cursor is the last processed email, contacts are processed in email order
+	Cursor         string `json:"cursor,omitempty"`
+	TotalCount     int64  `json:"total_count"`
+	ProcessedCount int64  `json:"processed_count"`
+	AffectedCount  int64  `json:"affected_count"`
+}
+
+// ContactBulkOperationRepository stores bulk operations and applies them to batches of contacts
+type ContactBulkOperationRepository interface {
+	Create(ctx context.Context, workspaceID string, operation *ContactBulkOperation) error
+	Get(ctx context.Context, workspaceID string, id string) (*ContactBulkOperation, error)
+	List(ctx context.Context, workspaceID string, limit int) ([]*ContactBulkOperation, error)
+
+	// Update saves the status, counters, task and error of an operation
+	Update(ctx context.Context, workspaceID string, operation *ContactBulkOperation) error
+
+	// CountTargets counts the contacts selected by a target
+	CountTargets(ctx context.Context, workspaceID string, target *BulkOperationTarget) (int64, error)
+
+	// NextBatch returns up to limit emails of targeted contacts sorted after afterEmail
+	NextBatch(ctx context.Context, workspaceID string, target *BulkOperationTarget, afterEmail string, limit int) ([]string, error)
+
+	// ApplyBatch applies the operation to the given contacts in a single transaction
+	// and returns the number of contacts changed
+	ApplyBatch(ctx context.Context, workspaceID string, operation *ContactBulkOperation, emails []string) (int64, error)
+}
+
+// ContactBulkOperationService runs bulk operations on contacts
+type ContactBulkOperationService interface {
+	// CreateOperation schedules a bulk operation, or only counts its targets on a dry run
+	CreateOperation(ctx context.Context, req *CreateBulkOperationRequest) (*CreateBulkOperationResponse, error)
+	GetOperation(ctx context.Context, req *GetBulkOperationRequest) (*ContactBulkOperation, error)
+	ListOperations(ctx context.Context, req *ListBulkOperationsRequest) (*ListBulkOperationsResponse, error)
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBulkOperationRequest_Validate(t *testing.T) {
+	segmentTarget := BulkOperationTarget{SegmentID: "seg1"}
+
+	tests := []struct {
+		name    string
+		req     CreateBulkOperationRequest
+		wantErr string
+	}{
+		{"missing workspace", CreateBulkOperationRequest{Operation: BulkOperationDelete, Target: segmentTarget}, "workspace_id is required"},
+		{"missing operation", CreateBulkOperationRequest{WorkspaceID: "ws1", Target: segmentTarget}, "operation is required"},
+		{"unknown operation", CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: "unsubscribe", Target: segmentTarget}, "invalid operation"},
+		{"no target", CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: BulkOperationDelete}, "exactly one of target.segment_id or target.filter"},
+		{"two targets", CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: BulkOperationDelete,
+			Target: BulkOperationTarget{SegmentID: "seg1", Filter: &GetContactsRequest{Country: "FR"}}}, "exactly one of target.segment_id or target.filter"},
+		{"empty filter", CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: BulkOperationDelete,
+			Target: BulkOperationTarget{Filter: &GetContactsRequest{Limit: 10}}}, "target.filter must set at least one filter"},
+		{"list without id", CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: BulkOperationAddToList, Target: segmentTarget}, "params.list_id is required"},
+		{"terminal list status", CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: BulkOperationAddToList, Target: segmentTarget,
+			Params: BulkOperationParams{ListID: "news", Status: ContactListStatusBounced}}, "params.status must be"},
+		{"field without name", CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: BulkOperationUpdateField, Target: segmentTarget}, "params.field is required"},
+		{"email cannot be updated", CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: BulkOperationUpdateField, Target: segmentTarget,
+			Params: BulkOperationParams{Field: "email", FieldValue: "a@example.com"}}, `params.field "email" cannot be updated`},
+		{"number field with a string", CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: BulkOperationUpdateField, Target: segmentTarget,
+			Params: BulkOperationParams{Field: "custom_number_1", FieldValue: "12"}}, "custom_number_1: expected number"},
+		{"invalid datetime", CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: BulkOperationUpdateField, Target: segmentTarget,
+			Params: BulkOperationParams{Field: "custom_datetime_1", FieldValue: "tomorrow"}}, "expected an RFC3339 datetime"},
+		{"attribute without name", CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: BulkOperationUpdateField, Target: segmentTarget,
+			Params: BulkOperationParams{Field: "attributes."}}, "missing the attribute name"},
+		{"tags missing", CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: BulkOperationAddTags, Target: segmentTarget}, "params.tags is required"},
+		{"empty tag", CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: BulkOperationRemoveTags, Target: segmentTarget,
+			Params: BulkOperationParams{Tags: []string{" "}}}, "tags cannot be empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	t.Run("normalizes the request", func(t *testing.T) {
+		req := CreateBulkOperationRequest{
+			WorkspaceID: "ws1",
+			Operation:   BulkOperationUpdateField,
+			Target:      BulkOperationTarget{Filter: &GetContactsRequest{WorkspaceID: "ws1", Country: "FR", Limit: 50, Cursor: "abc"}},
+			Params:      BulkOperationParams{Field: "custom_datetime_2", FieldValue: "2026-01-02T10:00:00+02:00"},
+		}
+		require.NoError(t, req.Validate())
+		assert.Equal(t, "2026-01-02T08:00:00Z", req.Params.FieldValue)
+		assert.Equal(t, GetContactsRequest{Country: "FR"}, *req.Target.Filter)
+	})
+
+	t.Run("defaults the list status and normalizes tags", func(t *testing.T) {
+		req := CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: BulkOperationAddToList, Target: segmentTarget, Params: BulkOperationParams{ListID: "news"}}
+		require.NoError(t, req.Validate())
+		assert.Equal(t, ContactListStatusActive, req.Params.Status)
+
+		req = CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: BulkOperationAddTags, Target: segmentTarget, Params: BulkOperationParams{Tags: []string{"VIP", "vip "}}}
+		require.NoError(t, req.Validate())
+		assert.Equal(t, []string{"vip"}, req.Params.Tags)
+	})
+
+	t.Run("null clears a field", func(t *testing.T) {
+		req := CreateBulkOperationRequest{WorkspaceID: "ws1", Operation: BulkOperationUpdateField, Target: segmentTarget, Params: BulkOperationParams{Field: "custom_number_3"}}
+		require.NoError(t, req.Validate())
+		assert.Nil(t, req.Params.FieldValue)
+	})
+}
+
+func TestBulkOperationTarget_ValueScan(t *testing.T) {
+	target := BulkOperationTarget{Filter: &GetContactsRequest{ListID: "news", Segments: []string{"seg1"}}}
+
+	value, err := target.Value()
+	require.NoError(t, err)
+
+	var scanned BulkOperationTarget
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, target, scanned)
+
+	assert.Error(t, scanned.Scan("not bytes"))
+}
+
+func TestListBulkOperationsRequest_FromURLParams(t *testing.T) {
+	var req ListBulkOperationsRequest
+	require.NoError(t, req.FromURLParams(url.Values{"workspace_id": {"ws1"}}))
+	assert.Equal(t, 20, req.Limit)
+
+	require.NoError(t, req.FromURLParams(url.Values{"workspace_id": {"ws1"}, "limit": {"5"}}))
+	assert.Equal(t, 5, req.Limit)
+
+	assert.Error(t, req.FromURLParams(url.Values{"workspace_id": {"ws1"}, "limit": {"500"}}))
+	assert.Error(t, req.FromURLParams(url.Values{}))
+}
+
+func TestGetBulkOperationRequest_FromURLParams(t *testing.T) {
+	var req GetBulkOperationRequest
+	require.NoError(t, req.FromURLParams(url.Values{"workspace_id": {"ws1"}, "id": {"op1"}}))
+	assert.Equal(t, "op1", req.ID)
+
+	assert.Error(t, req.FromURLParams(url.Values{"workspace_id": {"ws1"}}))
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/asaskevich/govalidator"
+)
+
+// ChangeContactEmailRequest moves a contact to a new email address
+type ChangeContactEmailRequest struct {
+	WorkspaceID  string `json:"workspace_id"`
+	CurrentEmail string `json:"current_email"`
+	NewEmail     string `json:"new_email"`
+}
+
+// Validate normalizes both emails and checks the new one is a different, valid address
+func (r *ChangeContactEmailRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	if r.CurrentEmail == "" {
+		return fmt.Errorf("current_email is required")
+	}
+	if r.NewEmail == "" {
+		return fmt.Errorf("new_email is required")
+	}
+
+	r.CurrentEmail = NormalizeEmail(r.CurrentEmail)
+	r.NewEmail = NormalizeEmail(r.NewEmail)
+	if !govalidator.IsEmail(r.NewEmail) {
+		return fmt.Errorf("invalid new_email")
+	}
+	if r.CurrentEmail == r.NewEmail {
+		return fmt.Errorf("new_email must be different from current_email")
+	}
+
+	return nil
+}
+
+// ChangeContactEmailResponse returns the contact under its new email
+type ChangeContactEmailResponse struct {
+	Contact       *Contact `json:"contact"`
+	PreviousEmail string   `json:"previous_email"`
+}
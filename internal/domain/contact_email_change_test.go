@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeContactEmailRequest_Validate(t *testing.T) {
+	t.Run("normalizes emails", func(t *testing.T) {
+		req := &ChangeContactEmailRequest{WorkspaceID: "ws1", CurrentEmail: " Old@Example.com ", NewEmail: "NEW@example.com"}
+		require.NoError(t, req.Validate())
+		assert.Equal(t, "old@example.com", req.CurrentEmail)
+		assert.Equal(t, "new@example.com", req.NewEmail)
+	})
+
+	testCases := []struct {
+		name    string
+		req     ChangeContactEmailRequest
+		wantErr string
+	}{
+		{"missing workspace", ChangeContactEmailRequest{CurrentEmail: "a@example.com", NewEmail: "b@example.com"}, "workspace_id is required"},
+		{"missing current email", ChangeContactEmailRequest{WorkspaceID: "ws1", NewEmail: "b@example.com"}, "current_email is required"},
+		{"missing new email", ChangeContactEmailRequest{WorkspaceID: "ws1", CurrentEmail: "a@example.com"}, "new_email is required"},
+		{"invalid new email", ChangeContactEmailRequest{WorkspaceID: "ws1", CurrentEmail: "a@example.com", NewEmail: "not-an-email"}, "invalid new_email"},
+		{"same email", ChangeContactEmailRequest{WorkspaceID: "ws1", CurrentEmail: "A@example.com", NewEmail: "a@example.com"}, "must be different"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.req.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
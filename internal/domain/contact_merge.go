@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// ContactMergePrecedence decides which contact's fields win when both have a value
+type ContactMergePrecedence string
+
+const (
+	// ContactMergePrecedenceTarget keeps the target's values and only fills its empty fields from the source
+	ContactMergePrecedenceTarget ContactMergePrecedence = "target"
+	// ContactMergePrecedenceSource overwrites the target's values with every non-empty source field
+	ContactMergePrecedenceSource ContactMergePrecedence = "source"
+)
+
+// contactListStatusPrivilege ranks list statuses when both contacts belong to the same list.
+// The most privileged status, i.e. the one that allows the most sending, wins.
+var contactListStatusPrivilege = map[ContactListStatus]int{
+	ContactListStatusActive:       5,
+	ContactListStatusPending:      4,
+	ContactListStatusUnsubscribed: 3,
+	ContactListStatusBounced:      2,
+	ContactListStatusComplained:   1,
+}
+
+// MostPrivilegedContactListStatus returns the status to keep when two memberships of the same list are merged
+func MostPrivilegedContactListStatus(a, b ContactListStatus) ContactListStatus {
+	if contactListStatusPrivilege[b] > contactListStatusPrivilege[a] {
+		return b
+	}
+	return a
+}
+
+// MergeContactFields returns the contact resulting from merging source into target.
+// The result keeps the target's email and the earliest created_at of both contacts.
+func MergeContactFields(target, source *Contact, precedence ContactMergePrecedence) *Contact {
+	base, winner := source, target
+	if precedence == ContactMergePrecedenceSource {
+		base, winner = target, source
+	}
+
+	merged := *base
+	merged.ContactLists = nil
+	merged.ContactSegments = nil
+	if base.Attributes != nil {
+		merged.Attributes = make(MapOfAny, len(base.Attributes))
+		for name, value := range base.Attributes {
+			merged.Attributes[name] = value
+		}
+	}
+	merged.Merge(winner)
+
+	merged.Email = target.Email
+	merged.CreatedAt = target.CreatedAt
+	if !source.CreatedAt.IsZero() && source.CreatedAt.Before(target.CreatedAt) {
+		merged.CreatedAt = source.CreatedAt
+	}
+	merged.DBCreatedAt = target.DBCreatedAt
+
+	return &merged
+}
+
+// MergeContactsRequest merges a source contact into a target contact
+type MergeContactsRequest struct {
+	WorkspaceID string                 `json:"workspace_id"`
+	SourceEmail string                 `json:"source_email"`
+	TargetEmail string                 `json:"target_email"`
+	Precedence  ContactMergePrecedence `json:"precedence,omitempty"`
+}
+
+// Validate normalizes the emails and applies the default precedence
+func (r *MergeContactsRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	if r.SourceEmail == "" {
+		return fmt.Errorf("source_email is required")
+	}
+	if r.TargetEmail == "" {
+		return fmt.Errorf("target_email is required")
+	}
+
+	r.SourceEmail = NormalizeEmail(r.SourceEmail)
+	r.TargetEmail = NormalizeEmail(r.TargetEmail)
+	if r.SourceEmail == r.TargetEmail {
+		return fmt.Errorf("source_email and target_email must be different")
+	}
+
+	switch r.Precedence {
+	case "":
+		r.Precedence = ContactMergePrecedenceTarget
+	case ContactMergePrecedenceTarget, ContactMergePrecedenceSource:
+	default:
+		return fmt.Errorf("invalid precedence: %s", r.Precedence)
+	}
+
+	return nil
+}
+
+// MergeContactsResponse returns the merged target contact
+type MergeContactsResponse struct {
+	Contact    *Contact `json:"contact"`
+	MergedFrom string   `json:"merged_from"`
+}
+
+// ContactDuplicateReason explains why contacts were grouped as duplicates
+type ContactDuplicateReason string
+
+const (
+	ContactDuplicateReasonExternalID      ContactDuplicateReason = "external_id"
+	ContactDuplicateReasonNormalizedEmail ContactDuplicateReason = "normalized_email"
+)
+
+// ContactDuplicateGroup is a set of contacts suggested for merging
+type ContactDuplicateGroup struct {
+	Reason ContactDuplicateReason `json:"reason"`
+	// Key is the shared external_id or normalized email
+	Key string `json:"key"`
+	// Emails are ordered from most to least recently created
+	Emails []string `json:"emails"`
+	// SuggestedTarget is the most recently created contact, the one a changed email most likely points to
+	SuggestedTarget string `json:"suggested_target"`
+}
+
+// FindDuplicateContactsRequest lists duplicate contact groups
+type FindDuplicateContactsRequest struct {
+	WorkspaceID string
+	Limit       int
+}
+
+// FromQuery parses query parameters into a FindDuplicateContactsRequest
+func (r *FindDuplicateContactsRequest) FromQuery(query url.Values) error {
+	r.WorkspaceID = query.Get("workspace_id")
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+
+	r.Limit = 50
+	if limitStr := query.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil {
+			return fmt.Errorf("invalid limit parameter: must be an integer")
+		}
+		if limit < 1 || limit > 500 {
+			return fmt.Errorf("limit must be between 1 and 500")
+		}
+		r.Limit = limit
+	}
+
+	return nil
+}
+
+// FindDuplicateContactsResponse returns the duplicate groups found
+type FindDuplicateContactsResponse struct {
+	Duplicates []*ContactDuplicateGroup `json:"duplicates"`
+}
@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMostPrivilegedContactListStatus(t *testing.T) {
+	testCases := []struct {
+		a, b     ContactListStatus
+		expected ContactListStatus
+	}{
+		{ContactListStatusActive, ContactListStatusUnsubscribed, ContactListStatusActive},
+		{ContactListStatusUnsubscribed, ContactListStatusActive, ContactListStatusActive},
+		{ContactListStatusPending, ContactListStatusActive, ContactListStatusActive},
+		{ContactListStatusUnsubscribed, ContactListStatusPending, ContactListStatusPending},
+		{ContactListStatusComplained, ContactListStatusBounced, ContactListStatusBounced},
+		{ContactListStatusBounced, ContactListStatusUnsubscribed, ContactListStatusUnsubscribed},
+		{ContactListStatusActive, ContactListStatusActive, ContactListStatusActive},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.a)+"_"+string(tc.b), func(t *testing.T) {
+			assert.Equal(t, tc.expected, MostPrivilegedContactListStatus(tc.a, tc.b))
+		})
+	}
+}
+
+func TestMergeContactFields(t *testing.T) {
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newTarget := func() *Contact {
+		return &Contact{
+			Email:      "new@example.com",
+			FirstName:  &NullableString{String: "Johnny"},
+			Country:    &NullableString{String: "FR"},
+			Attributes: MapOfAny{"plan": "pro"},
+			CreatedAt:  newer,
+		}
+	}
+	newSource := func() *Contact {
+		return &Contact{
+			Email:      "old@example.com",
+			ExternalID: &NullableString{String: "user-1"},
+			FirstName:  &NullableString{String: "John"},
+			Phone:      &NullableString{String: "+33600000000"},
+			Attributes: MapOfAny{"plan": "free", "seats": 3.0},
+			CreatedAt:  older,
+			ContactLists: []*ContactList{
+				{ListID: "newsletter"},
+			},
+		}
+	}
+
+	t.Run("target precedence keeps target values and fills gaps", func(t *testing.T) {
+		merged := MergeContactFields(newTarget(), newSource(), ContactMergePrecedenceTarget)
+
+		assert.Equal(t, "new@example.com", merged.Email)
+		assert.Equal(t, "Johnny", merged.FirstName.String)
+		assert.Equal(t, "FR", merged.Country.String)
+		assert.Equal(t, "user-1", merged.ExternalID.String)
+		assert.Equal(t, "+33600000000", merged.Phone.String)
+		assert.Equal(t, MapOfAny{"plan": "pro", "seats": 3.0}, merged.Attributes)
+		assert.Equal(t, older, merged.CreatedAt)
+		assert.Nil(t, merged.ContactLists)
+	})
+
+	t.Run("source precedence overwrites target values", func(t *testing.T) {
+		merged := MergeContactFields(newTarget(), newSource(), ContactMergePrecedenceSource)
+
+		assert.Equal(t, "new@example.com", merged.Email)
+		assert.Equal(t, "John", merged.FirstName.String)
+		assert.Equal(t, "FR", merged.Country.String)
+		assert.Equal(t, MapOfAny{"plan": "free", "seats": 3.0}, merged.Attributes)
+	})
+
+	t.Run("inputs are not modified", func(t *testing.T) {
+		target, source := newTarget(), newSource()
+		MergeContactFields(target, source, ContactMergePrecedenceTarget)
+
+		assert.Equal(t, MapOfAny{"plan": "free", "seats": 3.0}, source.Attributes)
+		assert.Equal(t, MapOfAny{"plan": "pro"}, target.Attributes)
+		assert.Equal(t, "old@example.com", source.Email)
+	})
+}
+
+func TestMergeContactsRequest_Validate(t *testing.T) {
+	t.Run("normalizes emails and defaults precedence", func(t *testing.T) {
+		req := &MergeContactsRequest{WorkspaceID: "ws1", SourceEmail: " Old@Example.com ", TargetEmail: "NEW@example.com"}
+		require.NoError(t, req.Validate())
+		assert.Equal(t, "old@example.com", req.SourceEmail)
+		assert.Equal(t, "new@example.com", req.TargetEmail)
+		assert.Equal(t, ContactMergePrecedenceTarget, req.Precedence)
+	})
+
+	testCases := []struct {
+		name    string
+		req     MergeContactsRequest
+		wantErr string
+	}{
+		{"missing workspace", MergeContactsRequest{SourceEmail: "a@example.com", TargetEmail: "b@example.com"}, "workspace_id is required"},
+		{"missing source", MergeContactsRequest{WorkspaceID: "ws1", TargetEmail: "b@example.com"}, "source_email is required"},
+		{"missing target", MergeContactsRequest{WorkspaceID: "ws1", SourceEmail: "a@example.com"}, "target_email is required"},
+		{"same contact", MergeContactsRequest{WorkspaceID: "ws1", SourceEmail: "A@example.com", TargetEmail: "a@example.com"}, "must be different"},
+		{"invalid precedence", MergeContactsRequest{WorkspaceID: "ws1", SourceEmail: "a@example.com", TargetEmail: "b@example.com", Precedence: "newest"}, "invalid precedence"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.req.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
+
+func TestFindDuplicateContactsRequest_FromQuery(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		req := &FindDuplicateContactsRequest{}
+		require.NoError(t, req.FromQuery(url.Values{"workspace_id": {"ws1"}}))
+		assert.Equal(t, "ws1", req.WorkspaceID)
+		assert.Equal(t, 50, req.Limit)
+	})
+
+	t.Run("custom limit", func(t *testing.T) {
+		req := &FindDuplicateContactsRequest{}
+		require.NoError(t, req.FromQuery(url.Values{"workspace_id": {"ws1"}, "limit": {"10"}}))
+		assert.Equal(t, 10, req.Limit)
+	})
+
+	t.Run("missing workspace", func(t *testing.T) {
+		req := &FindDuplicateContactsRequest{}
+		assert.Error(t, req.FromQuery(url.Values{}))
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		req := &FindDuplicateContactsRequest{}
+		assert.Error(t, req.FromQuery(url.Values{"workspace_id": {"ws1"}, "limit": {"abc"}}))
+		assert.Error(t, req.FromQuery(url.Values{"workspace_id": {"ws1"}, "limit": {"1000"}}))
+	})
+}
@@ -0,0 +1,195 @@
+package domain
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+)
+
+//go:generate mockgen -destination mocks/mock_contact_privacy_service.go -package mocks github.com/Notifuse/notifuse/internal/domain ContactPrivacyService
+
+// ErrContactErased is returned when creating a contact whose email was hard-erased
+var ErrContactErased = errors.New("contact was erased and cannot be recreated")
+
+// TaskTypeEraseContacts is the task type of GDPR erasure tasks
+const TaskTypeEraseContacts = "erase_contacts"
+
+// MaxEraseContactsPerRequest bounds the emails accepted by a single erasure task
+const MaxEraseContactsPerRequest = 1000
+
+// HashErasedEmail returns the hex SHA-256 of a normalized email, the only trace kept of an erased contact.
+// It matches encode(sha256(convert_to(email, 'UTF8')), 'hex') in Postgres.
+func HashErasedEmail(email string) string {
+	sum := sha256.Sum256([]byte(NormalizeEmail(email)))
+	return hex.EncodeToString(sum[:])
+}
+
+// ContactDataExport holds everything stored about one contact.
+// Each section is the JSON array of the raw table rows so that new columns are exported without code changes.
+type ContactDataExport struct {
+	Email              string          `json:"email"`
+	ExportedAt         time.Time       `json:"exported_at"`
+	Contact            json.RawMessage `json:"contact"`
+	ContactLists       json.RawMessage `json:"contact_lists"`
+	ContactSegments    json.RawMessage `json:"contact_segments"`
+	ContactTags        json.RawMessage `json:"contact_tags"`
+	Timeline           json.RawMessage `json:"timeline"`
+	MessageHistory     json.RawMessage `json:"message_history"`
+	CustomEvents       json.RawMessage `json:"custom_events"`
+	AutomationJourneys json.RawMessage `json:"automation_journeys"`
+	ProviderEvents     json.RawMessage `json:"provider_events"`
+	BroadcastHoldouts  json.RawMessage `json:"broadcast_holdouts"`
+}
+
+// WriteZip writes the export as a ZIP archive with one JSON file per section
+func (e *ContactDataExport) WriteZip(w io.Writer) error {
+	archive := zip.NewWriter(w)
+
+	summary, err := json.MarshalIndent(map[string]interface{}{
+		"email":       e.Email,
+		"exported_at": e.ExportedAt,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal export summary: %w", err)
+	}
+
+	files := []struct {
+		name string
+		data []byte
+	}{
+		{"export.json", summary},
+		{"contact.json", e.Contact},
+		{"contact_lists.json", e.ContactLists},
+		{"contact_segments.json", e.ContactSegments},
+		{"contact_tags.json", e.ContactTags},
+		{"timeline.json", e.Timeline},
+		{"message_history.json", e.MessageHistory},
+		{"custom_events.json", e.CustomEvents},
+		{"automation_journeys.json", e.AutomationJourneys},
+		{"provider_events.json", e.ProviderEvents},
+		{"broadcast_holdouts.json", e.BroadcastHoldouts},
+	}
+	for _, file := range files {
+		f, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     file.name,
+			Method:   zip.Deflate,
+			Modified: e.ExportedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add %s to archive: %w", file.name, err)
+		}
+		data := file.data
+		if len(data) == 0 {
+			data = []byte("null")
+		}
+		if _, err := f.Write(data); err != nil {
+			return fmt.Errorf("failed to write %s: %w", file.name, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("failed to close archive: %w", err)
+	}
+	return nil
+}
+
+// ContactDataExportFormat is the output format of a data export
+type ContactDataExportFormat string
+
+const (
+	ContactDataExportFormatJSON ContactDataExportFormat = "json"
+	ContactDataExportFormatZIP  ContactDataExportFormat = "zip"
+)
+
+// ExportContactDataRequest exports all the data held on one contact
+type ExportContactDataRequest struct {
+	WorkspaceID string
+	Email       string
+	Format      ContactDataExportFormat
+}
+
+// FromQuery parses query parameters into an ExportContactDataRequest
+func (r *ExportContactDataRequest) FromQuery(query url.Values) error {
+	r.WorkspaceID = query.Get("workspace_id")
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+
+	r.Email = NormalizeEmail(query.Get("email"))
+	if r.Email == "" {
+		return fmt.Errorf("email is required")
+	}
+
+	r.Format = ContactDataExportFormat(query.Get("format"))
+	switch r.Format {
+	case "":
+		r.Format = ContactDataExportFormatJSON
+	case ContactDataExportFormatJSON, ContactDataExportFormatZIP:
+	default:
+		return fmt.Errorf("invalid format: %s", r.Format)
+	}
+
+	return nil
+}
+
+// EraseContactsRequest hard-erases contacts in a background task
+type EraseContactsRequest struct {
+	WorkspaceID string   `json:"workspace_id"`
+	Emails      []string `json:"emails"`
+}
+
+// Validate normalizes and deduplicates the emails
+func (r *EraseContactsRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	if len(r.Emails) == 0 {
+		return fmt.Errorf("emails is required")
+	}
+
+	seen := make(map[string]bool, len(r.Emails))
+	emails := make([]string, 0, len(r.Emails))
+	for _, email := range r.Emails {
+		email = NormalizeEmail(email)
+		if email == "" {
+			return fmt.Errorf("emails cannot contain an empty value")
+		}
+		if seen[email] {
+			continue
+		}
+		seen[email] = true
+		emails = append(emails, email)
+	}
+	if len(emails) > MaxEraseContactsPerRequest {
+		return fmt.Errorf("cannot erase more than %d contacts per request", MaxEraseContactsPerRequest)
+	}
+	r.Emails = emails
+
+	return nil
+}
+
+// EraseContactsState is the state of an erase_contacts task
+type EraseContactsState struct {
+	Emails []string `json:"emails"`
+	// Position is the index of the next email to erase
+	Position    int `json:"position"`
+	ErasedCount int `json:"erased_count"`
+	// NotFoundCount counts emails without a contact; they are still tombstoned
+	NotFoundCount int `json:"not_found_count"`
+}
+
+// ContactPrivacyService handles data subject access and erasure requests
+type ContactPrivacyService interface {
+	// ExportContactData returns everything stored about a contact
+	ExportContactData(ctx context.Context, req *ExportContactDataRequest) (*ContactDataExport, error)
+
+	// EraseContacts schedules a task that hard-erases the given contacts
+	EraseContacts(ctx context.Context, req *EraseContactsRequest) (*Task, error)
+}
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashErasedEmail(t *testing.T) {
+	// sha256("john@example.com")
+	expected := "855f96e983f1f8e8be944692b6f719fd54329826cb62e98015efee8e2e071dd4"
+	assert.Equal(t, expected, HashErasedEmail("john@example.com"))
+	assert.Equal(t, expected, HashErasedEmail("  John@Example.COM "))
+	assert.NotEqual(t, expected, HashErasedEmail("jane@example.com"))
+}
+
+func TestContactDataExport_WriteZip(t *testing.T) {
+	export := &ContactDataExport{
+		Email:          "john@example.com",
+		ExportedAt:     time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		Contact:        json.RawMessage(`{"email":"john@example.com"}`),
+		ContactLists:   json.RawMessage(`[{"list_id":"news"}]`),
+		MessageHistory: json.RawMessage(`[]`),
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, export.WriteZip(&buf))
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := map[string]string{}
+	for _, f := range reader.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		_ = rc.Close()
+		files[f.Name] = string(data)
+	}
+
+	assert.Len(t, files, 11)
+	assert.Contains(t, files["export.json"], `"email": "john@example.com"`)
+	assert.JSONEq(t, `{"email":"john@example.com"}`, files["contact.json"])
+	assert.JSONEq(t, `[{"list_id":"news"}]`, files["contact_lists.json"])
+	assert.Equal(t, "[]", files["message_history.json"])
+	// Sections without data are written as JSON null
+	assert.Equal(t, "null", files["custom_events.json"])
+}
+
+func TestExportContactDataRequest_FromQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   url.Values
+		want    ExportContactDataRequest
+		wantErr string
+	}{
+		{
+			name:  "defaults to json",
+			query: url.Values{"workspace_id": {"ws1"}, "email": {" John@Example.com"}},
+			want:  ExportContactDataRequest{WorkspaceID: "ws1", Email: "john@example.com", Format: ContactDataExportFormatJSON},
+		},
+		{
+			name:  "zip format",
+			query: url.Values{"workspace_id": {"ws1"}, "email": {"john@example.com"}, "format": {"zip"}},
+			want:  ExportContactDataRequest{WorkspaceID: "ws1", Email: "john@example.com", Format: ContactDataExportFormatZIP},
+		},
+		{
+			name:    "missing workspace",
+			query:   url.Values{"email": {"john@example.com"}},
+			wantErr: "workspace_id is required",
+		},
+		{
+			name:    "missing email",
+			query:   url.Values{"workspace_id": {"ws1"}},
+			wantErr: "email is required",
+		},
+		{
+			name:    "invalid format",
+			query:   url.Values{"workspace_id": {"ws1"}, "email": {"john@example.com"}, "format": {"csv"}},
+			wantErr: "invalid format: csv",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req ExportContactDataRequest
+			err := req.FromQuery(tt.query)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, req)
+		})
+	}
+}
+
+func TestEraseContactsRequest_Validate(t *testing.T) {
+	t.Run("normalizes and deduplicates emails", func(t *testing.T) {
+		req := EraseContactsRequest{
+			WorkspaceID: "ws1",
+			Emails:      []string{"John@Example.com", "john@example.com ", "jane@example.com"},
+		}
+		require.NoError(t, req.Validate())
+		assert.Equal(t, []string{"john@example.com", "jane@example.com"}, req.Emails)
+	})
+
+	t.Run("requires a workspace", func(t *testing.T) {
+		req := EraseContactsRequest{Emails: []string{"john@example.com"}}
+		assert.EqualError(t, req.Validate(), "workspace_id is required")
+	})
+
+	t.Run("requires emails", func(t *testing.T) {
+		req := EraseContactsRequest{WorkspaceID: "ws1"}
+		assert.EqualError(t, req.Validate(), "emails is required")
+	})
+
+	t.Run("rejects empty values", func(t *testing.T) {
+		req := EraseContactsRequest{WorkspaceID: "ws1", Emails: []string{"john@example.com", "  "}}
+		assert.EqualError(t, req.Validate(), "emails cannot contain an empty value")
+	})
+
+	t.Run("limits the batch size", func(t *testing.T) {
+		emails := make([]string, MaxEraseContactsPerRequest+1)
+		for i := range emails {
+			emails[i] = strings.Repeat("a", i+1) + "@example.com"
+		}
+		req := EraseContactsRequest{WorkspaceID: "ws1", Emails: emails}
+		assert.EqualError(t, req.Validate(), "cannot erase more than 1000 contacts per request")
+	})
+}
@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+//go:generate mockgen -destination mocks/mock_contact_tag_repository.go -package mocks github.com/Notifuse/notifuse/internal/domain ContactTagRepository
+//go:generate mockgen -destination mocks/mock_contact_tag_service.go -package mocks github.com/Notifuse/notifuse/internal/domain ContactTagService
+
+// ErrTagNotFound is returned when deleting a tag that no contact carries
+var ErrTagNotFound = errors.New("tag not found")
+
+const (
+	// MaxTagLength bounds the length of a tag, in characters
+	MaxTagLength = 100
+	// MaxTagsPerRequest bounds the tags added or removed by a single request
+	MaxTagsPerRequest = 50
+	// MaxEmailsPerTagRequest bounds the emails listed in a single tag request,
+	// larger audiences should be selected with a segment or a filter
+	MaxEmailsPerTagRequest = 10000
+)
+
+// NormalizeTag trims and lowercases a tag so that "VIP " and "vip" are the same tag
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// NormalizeTags normalizes and deduplicates tags, keeping their order.
+// It fails on empty or too long tags.
+func NormalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if tag == "" {
+			return nil, fmt.Errorf("tags cannot be empty")
+		}
+		if utf8.RuneCountInString(tag) > MaxTagLength {
+			return nil, fmt.Errorf("tag %q is longer than %d characters", tag, MaxTagLength)
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized, nil
+}
+
+// TagCount is a tag with the number of contacts carrying it
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int64  `json:"count"`
+}
+
+// ListTagsRequest lists the tags used in a workspace
+type ListTagsRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+}
+
+// FromURLParams parses query parameters into a ListTagsRequest
+func (r *ListTagsRequest) FromURLParams(values url.Values) error {
+	r.WorkspaceID = values.Get("workspace_id")
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	return nil
+}
+
+// ListTagsResponse lists the tags of a workspace ordered by name
+type ListTagsResponse struct {
+	Tags []TagCount `json:"tags"`
+}
+
+// UpdateContactTagsRequest adds or removes tags on a set of contacts.
+// Exactly one of Emails, SegmentID or Filter selects the contacts.
+type UpdateContactTagsRequest struct {
+	WorkspaceID string    `json:"workspace_id"`
+	Tags        []string  `json:"tags"`
+	Emails      []string  `json:"emails,omitempty"`
+	SegmentID   string    `json:"segment_id,omitempty"`
+	Filter      *TreeNode `json:"filter,omitempty"`
+}
+
+// Validate normalizes the tags and emails and checks that a single target is set
+func (r *UpdateContactTagsRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	if len(r.Tags) == 0 {
+		return fmt.Errorf("tags is required")
+	}
+	if len(r.Tags) > MaxTagsPerRequest {
+		return fmt.Errorf("cannot update more than %d tags per request", MaxTagsPerRequest)
+	}
+	tags, err := NormalizeTags(r.Tags)
+	if err != nil {
+		return err
+	}
+	r.Tags = tags
+
+	targets := 0
+	if len(r.Emails) > 0 {
+		targets++
+	}
+	if r.SegmentID != "" {
+		targets++
+	}
+	if r.Filter != nil {
+		targets++
+	}
+	if targets != 1 {
+		return fmt.Errorf("exactly one of emails, segment_id or filter is required")
+	}
+
+	if len(r.Emails) > MaxEmailsPerTagRequest {
+		return fmt.Errorf("cannot list more than %d emails per request, use a segment or a filter", MaxEmailsPerTagRequest)
+	}
+	for i, email := range r.Emails {
+		r.Emails[i] = NormalizeEmail(email)
+		if r.Emails[i] == "" {
+			return fmt.Errorf("email at index %d is empty", i)
+		}
+	}
+
+	if r.Filter != nil {
+		if err := r.Filter.Validate(); err != nil {
+			return fmt.Errorf("invalid filter: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateContactTagsResponse reports how many contact tags were added or removed
+type UpdateContactTagsResponse struct {
+	Affected int64 `json:"affected"`
+}
+
+// DeleteTagRequest removes a tag from every contact
+type DeleteTagRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	Tag         string `json:"tag"`
+}
+
+// Validate normalizes the tag
+func (r *DeleteTagRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	r.Tag = NormalizeTag(r.Tag)
+	if r.Tag == "" {
+		return fmt.Errorf("tag is required")
+	}
+	return nil
+}
+
+// ContactTagRepository stores the tags of contacts
+type ContactTagRepository interface {
+	// AddTags tags the contacts returned by emailsQuery, a parameterized
+	// "SELECT email ..." query, and returns the number of tags added
+	AddTags(ctx context.Context, workspaceID string, tags []string, emailsQuery string, args []interface{}) (int64, error)
+
+	// RemoveTags untags the contacts returned by emailsQuery and returns the number of tags removed
+	RemoveTags(ctx context.Context, workspaceID string, tags []string, emailsQuery string, args []interface{}) (int64, error)
+
+	// ListTags returns every tag of the workspace with its contact count
+	ListTags(ctx context.Context, workspaceID string) ([]TagCount, error)
+
+	// DeleteTag removes a tag from every contact, returning ErrTagNotFound if no contact carries it
+	DeleteTag(ctx context.Context, workspaceID string, tag string) (int64, error)
+}
+
+// ContactTagService manages contact tags
+type ContactTagService interface {
+	ListTags(ctx context.Context, req *ListTagsRequest) (*ListTagsResponse, error)
+	AddTags(ctx context.Context, req *UpdateContactTagsRequest) (*UpdateContactTagsResponse, error)
+	RemoveTags(ctx context.Context, req *UpdateContactTagsRequest) (*UpdateContactTagsResponse, error)
+	DeleteTag(ctx context.Context, req *DeleteTagRequest) (*UpdateContactTagsResponse, error)
+}
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	t.Run("normalizes and deduplicates", func(t *testing.T) {
+		tags, err := NormalizeTags([]string{" VIP", "beta", "vip ", "Beta"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"vip", "beta"}, tags)
+	})
+
+	t.Run("rejects empty tags", func(t *testing.T) {
+		_, err := NormalizeTags([]string{"vip", "  "})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "tags cannot be empty")
+	})
+
+	t.Run("rejects long tags", func(t *testing.T) {
+		_, err := NormalizeTags([]string{strings.Repeat("é", MaxTagLength+1)})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "longer than 100 characters")
+
+		tags, err := NormalizeTags([]string{strings.Repeat("é", MaxTagLength)})
+		require.NoError(t, err)
+		assert.Len(t, tags, 1)
+	})
+}
+
+func TestUpdateContactTagsRequest_Validate(t *testing.T) {
+	filter := &TreeNode{
+		Kind: "leaf",
+		Leaf: &TreeNodeLeaf{
+			Source:      "contact_lists",
+			ContactList: &ContactListCondition{Operator: "in", ListID: "news"},
+		},
+	}
+
+	t.Run("emails target", func(t *testing.T) {
+		req := &UpdateContactTagsRequest{WorkspaceID: "ws1", Tags: []string{"VIP"}, Emails: []string{" John@Example.com"}}
+		require.NoError(t, req.Validate())
+		assert.Equal(t, []string{"vip"}, req.Tags)
+		assert.Equal(t, []string{"john@example.com"}, req.Emails)
+	})
+
+	t.Run("filter target", func(t *testing.T) {
+		req := &UpdateContactTagsRequest{WorkspaceID: "ws1", Tags: []string{"vip"}, Filter: filter}
+		assert.NoError(t, req.Validate())
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		req := &UpdateContactTagsRequest{WorkspaceID: "ws1", Tags: []string{"vip"}, Filter: &TreeNode{Kind: "leaf"}}
+		err := req.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid filter")
+	})
+
+	t.Run("requires exactly one target", func(t *testing.T) {
+		for _, req := range []*UpdateContactTagsRequest{
+			{WorkspaceID: "ws1", Tags: []string{"vip"}},
+			{WorkspaceID: "ws1", Tags: []string{"vip"}, SegmentID: "seg1", Filter: filter},
+			{WorkspaceID: "ws1", Tags: []string{"vip"}, SegmentID: "seg1", Emails: []string{"a@example.com"}},
+		} {
+			err := req.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "exactly one of emails, segment_id or filter is required")
+		}
+	})
+
+	t.Run("requires tags", func(t *testing.T) {
+		req := &UpdateContactTagsRequest{WorkspaceID: "ws1", SegmentID: "seg1"}
+		err := req.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "tags is required")
+	})
+
+	t.Run("requires workspace", func(t *testing.T) {
+		req := &UpdateContactTagsRequest{Tags: []string{"vip"}, SegmentID: "seg1"}
+		err := req.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "workspace_id is required")
+	})
+}
+
+func TestDeleteTagRequest_Validate(t *testing.T) {
+	req := &DeleteTagRequest{WorkspaceID: "ws1", Tag: " VIP "}
+	require.NoError(t, req.Validate())
+	assert.Equal(t, "vip", req.Tag)
+
+	req = &DeleteTagRequest{WorkspaceID: "ws1"}
+	assert.Error(t, req.Validate())
+}
+
+func TestListTagsRequest_FromURLParams(t *testing.T) {
+	var req ListTagsRequest
+	require.NoError(t, req.FromURLParams(url.Values{"workspace_id": {"ws1"}}))
+	assert.Equal(t, "ws1", req.WorkspaceID)
+
+	assert.Error(t, req.FromURLParams(url.Values{}))
+}
+
+func TestContact_Tags(t *testing.T) {
+	t.Run("parsed and normalized from JSON", func(t *testing.T) {
+		contact, err := FromJSON(`{"email":"john@example.com","tags":["VIP","beta","vip"]}`)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"vip", "beta"}, contact.Tags)
+	})
+
+	t.Run("rejects non string tags", func(t *testing.T) {
+		_, err := FromJSON(`{"email":"john@example.com","tags":["vip",1]}`)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid type for tag")
+
+		_, err = FromJSON(`{"email":"john@example.com","tags":"vip"}`)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid type for tags")
+	})
+
+	t.Run("merge adds tags", func(t *testing.T) {
+		contact := &Contact{Email: "john@example.com", Tags: []string{"vip"}}
+		contact.Merge(&Contact{Email: "john@example.com", Tags: []string{"beta", "vip"}})
+		assert.Equal(t, []string{"vip", "beta"}, contact.Tags)
+	})
+}
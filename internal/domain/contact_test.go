@@ -2883,3 +2883,41 @@ func TestTrimUnicodeSpace(t *testing.T) {
 		})
 	}
 }
+
+func TestFromJSON_Attributes(t *testing.T) {
+	t.Run("parses attributes object", func(t *testing.T) {
+		contact, err := FromJSON([]byte(`{"email":"a@example.com","attributes":{"plan":"pro","seats":3,"churned_at":null}}`))
+		require.NoError(t, err)
+		assert.Equal(t, MapOfAny{"plan": "pro", "seats": 3.0, "churned_at": nil}, contact.Attributes)
+	})
+
+	t.Run("null attributes are ignored", func(t *testing.T) {
+		contact, err := FromJSON([]byte(`{"email":"a@example.com","attributes":null}`))
+		require.NoError(t, err)
+		assert.Nil(t, contact.Attributes)
+	})
+
+	t.Run("non-object attributes are rejected", func(t *testing.T) {
+		_, err := FromJSON([]byte(`{"email":"a@example.com","attributes":["pro"]}`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid type for attributes")
+	})
+}
+
+func TestContact_Merge_Attributes(t *testing.T) {
+	contact := &Contact{
+		Email:      "a@example.com",
+		Attributes: MapOfAny{"plan": "free", "seats": 1.0},
+	}
+
+	contact.Merge(&Contact{
+		Email:      "a@example.com",
+		Attributes: MapOfAny{"plan": "pro", "seats": nil, "region": "eu"},
+	})
+
+	assert.Equal(t, MapOfAny{"plan": "pro", "region": "eu"}, contact.Attributes)
+
+	// Merging a contact without attributes leaves them untouched
+	contact.Merge(&Contact{Email: "a@example.com"})
+	assert.Equal(t, MapOfAny{"plan": "pro", "region": "eu"}, contact.Attributes)
+}
@@ -316,6 +316,15 @@ type DataFeedSettings struct {
 
 	// Per-recipient feed configuration
 	RecipientFeed *RecipientFeedSettings `json:"recipient_feed,omitempty"`
+
+	// Blog digest configuration, for recurring broadcasts
+	BlogDigest *BlogDigestSettings `json:"blog_digest,omitempty"`
+
+	// Posts of the digest (persisted on each sent occurrence)
+	BlogDigestPosts []MapOfAny `json:"blog_digest_posts,omitempty"`
+
+	// Posts published up to this time are included in the digest
+	BlogDigestUntil *time.Time `json:"blog_digest_until,omitempty"`
 }
 
 // Value implements the driver.Valuer interface for database serialization
@@ -364,6 +373,12 @@ func (d *DataFeedSettings) Validate() error {
 		}
 	}
 
+	if d.BlogDigest != nil {
+		if err := d.BlogDigest.Validate(); err != nil {
+			return fmt.Errorf("blog digest: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -357,6 +357,7 @@ type SendEmailProviderRequest struct {
 	To            string         `validate:"required"`
 	Subject       string         `validate:"required"`
 	Content       string         `validate:"required"`
+	Text          string         // Optional text/plain alternative of Content
 	Provider      *EmailProvider `validate:"required"`
 	EmailOptions  EmailOptions
 }
@@ -444,6 +445,10 @@ type EmailServiceInterface interface {
 	SendEmailForTemplate(ctx context.Context, request SendEmailRequest) error
 	VisitLink(ctx context.Context, messageID string, workspaceID string) error
 	OpenEmail(ctx context.Context, messageID string, workspaceID string) error
+	// RenderWebView re-renders the email a contact received, from the signature of its web view link
+	RenderWebView(ctx context.Context, workspaceID string, messageID string, signature string) (*WebView, error)
+	// RenderPublicBroadcast renders a broadcast without personal data, when public broadcasts are enabled
+	RenderPublicBroadcast(ctx context.Context, workspaceID string, broadcastID string) (*WebView, error)
 }
 
 type EmailProviderService interface {
@@ -69,6 +69,7 @@ type EmailQueuePayload struct {
 	FromName    string `json:"from_name"`
 	Subject     string `json:"subject"`
 	HTMLContent string `json:"html_content"`
+	TextContent string `json:"text_content,omitempty"`
 
 	// Options
 	EmailOptions EmailOptions `json:"email_options"`
@@ -97,6 +98,7 @@ func (p *EmailQueuePayload) ToSendEmailProviderRequest(workspaceID, integrationI
 		To:            toEmail,
 		Subject:       p.Subject,
 		Content:       p.HTMLContent,
+		Text:          p.TextContent,
 		Provider:      provider,
 		EmailOptions:  p.EmailOptions,
 	}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/Notifuse/notifuse/pkg/emailverifier"
+)
+
+//go:generate mockgen -destination mocks/mock_email_verification_repository.go -package mocks github.com/Notifuse/notifuse/internal/domain EmailVerificationRepository
+//go:generate mockgen -destination mocks/mock_email_verification_service.go -package mocks github.com/Notifuse/notifuse/internal/domain EmailVerificationService
+
+// ErrEmailVerificationNotFound is returned when an address has not been verified yet
+var ErrEmailVerificationNotFound = errors.New("email verification not found")
+
+// TaskTypeProcessEmailVerificationQueue is the permanent task verifying newly added contact addresses
+const TaskTypeProcessEmailVerificationQueue = "process_email_verification_queue"
+
+// EmailVerificationSettings configures the verification of contact addresses on ingest
+type EmailVerificationSettings struct {
+	// Enabled verifies every address added to the contacts table
+	Enabled bool `json:"enabled"`
+	// SMTPProbe asks the recipient's mail server whether the mailbox exists.
+	// It requires outbound port 25 and can be slow or blocked by some providers.
+	SMTPProbe bool `json:"smtp_probe"`
+	// BlockInvalid adds invalid addresses to the suppression list so no email is sent to them
+	BlockInvalid bool `json:"block_invalid"`
+}
+
+// EmailVerification is the stored outcome of verifying a contact address
+type EmailVerification struct {
+	Email      string               `json:"email"`
+	Status     emailverifier.Status `json:"status"`
+	Reason     string               `json:"reason,omitempty"`
+	Suggestion string               `json:"suggestion,omitempty"`
+	Checks     emailverifier.Checks `json:"checks"`
+	VerifiedAt time.Time            `json:"verified_at"`
+}
+
+// NewEmailVerification builds the stored form of a verifier result
+func NewEmailVerification(result *emailverifier.Result, verifiedAt time.Time) *EmailVerification {
+	return &EmailVerification{
+		Email:      result.Email,
+		Status:     result.Status,
+		Reason:     result.Reason,
+		Suggestion: result.Suggestion,
+		Checks:     result.Checks,
+		VerifiedAt: verifiedAt,
+	}
+}
+
+// GetEmailVerificationRequest fetches the verification of one address
+type GetEmailVerificationRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	Email       string `json:"email"`
+}
+
+// FromURLParams parses the request from query parameters
+func (r *GetEmailVerificationRequest) FromURLParams(values url.Values) error {
+	r.WorkspaceID = values.Get("workspace_id")
+	r.Email = values.Get("email")
+	return r.Validate()
+}
+
+// Validate validates the request and normalizes the email
+func (r *GetEmailVerificationRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return NewValidationError("workspace_id is required")
+	}
+	if r.Email == "" {
+		return NewValidationError("email is required")
+	}
+	r.Email = NormalizeEmail(r.Email)
+	return nil
+}
+
+// VerifyEmailRequest runs the verification of one address right away
+type VerifyEmailRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	Email       string `json:"email"`
+}
+
+// Validate validates the request and normalizes the email
+func (r *VerifyEmailRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return NewValidationError("workspace_id is required")
+	}
+	if r.Email == "" {
+		return NewValidationError("email is required")
+	}
+	r.Email = NormalizeEmail(r.Email)
+	if len(r.Email) > 255 {
+		return NewValidationError("email must be at most 255 characters")
+	}
+	return nil
+}
+
+// EmailVerificationRepository stores verification results and the queue of addresses to verify
+type EmailVerificationRepository interface {
+	// Get returns the verification of an address, or ErrEmailVerificationNotFound
+	Get(ctx context.Context, workspaceID string, email string) (*EmailVerification, error)
+
+	// Upsert stores a verification, replacing the previous one for the same address
+	Upsert(ctx context.Context, workspaceID string, verification *EmailVerification) error
+
+	// Enqueue queues addresses for verification. Contacts are queued automatically
+	// by a database trigger when they are created or their email changes.
+	Enqueue(ctx context.Context, workspaceID string, emails []string) error
+
+	// Dequeue removes and returns up to limit of the oldest queued addresses
+	Dequeue(ctx context.Context, workspaceID string, limit int) ([]string, error)
+
+	// ClearQueue drops every queued address
+	ClearQueue(ctx context.Context, workspaceID string) error
+
+	// QueueSize returns the number of queued addresses
+	QueueSize(ctx context.Context, workspaceID string) (int, error)
+}
+
+// EmailVerificationService exposes email verification results
+type EmailVerificationService interface {
+	// GetVerification returns the last verification of an address
+	GetVerification(ctx context.Context, req *GetEmailVerificationRequest) (*EmailVerification, error)
+	// VerifyEmail verifies an address synchronously and stores the result
+	VerifyEmail(ctx context.Context, req *VerifyEmailRequest) (*EmailVerification, error)
+}
@@ -0,0 +1,264 @@
+package domain
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"net/url"
+)
+
+//go:generate mockgen -destination mocks/mock_holdout_repository.go -package mocks github.com/Notifuse/notifuse/internal/domain HoldoutRepository
+//go:generate mockgen -destination mocks/mock_holdout_service.go -package mocks github.com/Notifuse/notifuse/internal/domain HoldoutService
+
+const (
+	// MaxHoldoutPercentage caps the share of an audience that can be held out
+	MaxHoldoutPercentage = 50
+	// DefaultHoldoutConversionWindowDays is the number of days a goal is attributed after the send
+	DefaultHoldoutConversionWindowDays = 7
+	// MaxHoldoutConversionWindowDays caps the attribution window
+	MaxHoldoutConversionWindowDays = 90
+
+	// holdoutBuckets is the resolution of the holdout assignment (0.01%)
+	holdoutBuckets = 10000
+
+	// HoldoutExitReason is the exit reason of the contacts held out of an automation
+	HoldoutExitReason = "holdout"
+)
+
+// HoldoutSourceType identifies what a holdout group was reserved from
+type HoldoutSourceType string
+
+const (
+	HoldoutSourceBroadcast  HoldoutSourceType = "broadcast"
+	HoldoutSourceAutomation HoldoutSourceType = "automation"
+)
+
+// HoldoutSettings reserves a random, deterministic share of an audience that receives nothing,
+// to measure the goal conversions the messages actually caused
+type HoldoutSettings struct {
+	Enabled              bool `json:"enabled"`
+	Percentage           int  `json:"percentage"`                       // 1 to 50
+	ConversionWindowDays int  `json:"conversion_window_days,omitempty"` // defaults to 7, up to 90
+
+	// Seed makes the assignment specific to a broadcast, it is set from the broadcast id
+	// when selecting recipients and never stored
+	Seed string `json:"-"`
+}
+
+// IsEnabled returns true if the settings hold out part of the audience
+func (h *HoldoutSettings) IsEnabled() bool {
+	return h != nil && h.Enabled && h.Percentage > 0
+}
+
+// Validate validates the holdout settings
+func (h *HoldoutSettings) Validate() error {
+	if h == nil || !h.Enabled {
+		return nil
+	}
+
+	if h.Percentage < 1 || h.Percentage > MaxHoldoutPercentage {
+		return fmt.Errorf("holdout percentage must be between 1 and %d", MaxHoldoutPercentage)
+	}
+
+	if h.ConversionWindowDays < 0 || h.ConversionWindowDays > MaxHoldoutConversionWindowDays {
+		return fmt.Errorf("holdout conversion_window_days must be between 0 and %d", MaxHoldoutConversionWindowDays)
+	}
+
+	return nil
+}
+
+// GetConversionWindowDays returns the configured attribution window or the default
+func (h *HoldoutSettings) GetConversionWindowDays() int {
+	if h == nil || h.ConversionWindowDays <= 0 {
+		return DefaultHoldoutConversionWindowDays
+	}
+	return h.ConversionWindowDays
+}
+
+// Threshold returns the bucket below which a contact is held out
+func (h *HoldoutSettings) Threshold() int {
+	return h.Percentage * holdoutBuckets / 100
+}
+
+// Includes returns true if the contact is held out. The assignment only depends on the
+// seed and the email, so a contact stays in the same group across retries and re-entries.
+func (h *HoldoutSettings) Includes(seed, email string) bool {
+	if !h.IsEnabled() {
+		return false
+	}
+	return HoldoutBucket(seed, email) < h.Threshold()
+}
+
+// HoldoutBucket maps a contact to a bucket between 0 and 9999. It matches the SQL expression
+// ('x' || substr(md5(seed || ':' || email), 1, 8))::bit(32)::bigint % 10000
+func HoldoutBucket(seed, email string) int {
+	sum := md5.Sum([]byte(seed + ":" + email))
+	return int(binary.BigEndian.Uint32(sum[:4]) % holdoutBuckets)
+}
+
+// HoldoutGroupStats holds the goal conversions of a treated or holdout group
+type HoldoutGroupStats struct {
+	Contacts       int     `json:"contacts"`
+	Converted      int     `json:"converted"`
+	ConversionRate float64 `json:"conversion_rate"`
+	Revenue        float64 `json:"revenue"`
+}
+
+// ProportionComparison compares the rates of two groups
+type ProportionComparison struct {
+	Difference float64 `json:"difference"` // absolute difference of the rates
+	// Relative lift of the first group over the second, nil when the second rate is zero
+	Lift *float64 `json:"lift,omitempty"`
+	// 95% confidence interval of the absolute difference
+	ConfidenceLower float64 `json:"confidence_lower"`
+	ConfidenceUpper float64 `json:"confidence_upper"`
+	// Two-sided p-value of the pooled two-proportion z-test
+	PValue      float64 `json:"p_value"`
+	Significant bool    `json:"significant"` // the confidence interval excludes zero
+}
+
+// z95 is the two-sided 95% quantile of the normal distribution
+const z95 = 1.959964
+
+// CompareProportions compares the success rate of group A (successA/totalA) with group B
+func CompareProportions(successA, totalA, successB, totalB int) ProportionComparison {
+	var comparison ProportionComparison
+	if totalA == 0 || totalB == 0 {
+		comparison.PValue = 1
+		return comparison
+	}
+
+	rateA := float64(successA) / float64(totalA)
+	rateB := float64(successB) / float64(totalB)
+	comparison.Difference = rateA - rateB
+	if rateB > 0 {
+		lift := comparison.Difference / rateB
+		comparison.Lift = &lift
+	}
+
+	// Unpooled standard error for the confidence interval
+	se := math.Sqrt(rateA*(1-rateA)/float64(totalA) + rateB*(1-rateB)/float64(totalB))
+	comparison.ConfidenceLower = comparison.Difference - z95*se
+	comparison.ConfidenceUpper = comparison.Difference + z95*se
+	comparison.Significant = se > 0 && (comparison.ConfidenceLower > 0 || comparison.ConfidenceUpper < 0)
+
+	// Pooled standard error for the hypothesis test
+	pooled := float64(successA+successB) / float64(totalA+totalB)
+	pooledSE := math.Sqrt(pooled * (1 - pooled) * (1/float64(totalA) + 1/float64(totalB)))
+	comparison.PValue = 1
+	if pooledSE > 0 {
+		z := math.Abs(comparison.Difference) / pooledSE
+		comparison.PValue = math.Erfc(z / math.Sqrt2)
+	}
+
+	return comparison
+}
+
+// HoldoutReport compares the goal conversions of the treated and holdout groups
+type HoldoutReport struct {
+	SourceType           HoldoutSourceType    `json:"source_type"`
+	SourceID             string               `json:"source_id"`
+	Percentage           int                  `json:"percentage"`
+	ConversionWindowDays int                  `json:"conversion_window_days"`
+	GoalType             string               `json:"goal_type,omitempty"` // empty = every goal
+	Treated              HoldoutGroupStats    `json:"treated"`
+	Holdout              HoldoutGroupStats    `json:"holdout"`
+	Comparison           ProportionComparison `json:"comparison"`
+	// Conversions caused by the messages: treated conversions above the holdout rate
+	IncrementalConversions float64 `json:"incremental_conversions"`
+}
+
+// NewHoldoutReport computes the rates and the comparison of the groups
+func NewHoldoutReport(sourceType HoldoutSourceType, sourceID string, settings *HoldoutSettings, goalType string, treated, holdout HoldoutGroupStats) *HoldoutReport {
+	treated.ConversionRate = conversionRate(treated)
+	holdout.ConversionRate = conversionRate(holdout)
+
+	report := &HoldoutReport{
+		SourceType:           sourceType,
+		SourceID:             sourceID,
+		ConversionWindowDays: settings.GetConversionWindowDays(),
+		GoalType:             goalType,
+		Treated:              treated,
+		Holdout:              holdout,
+		Comparison:           CompareProportions(treated.Converted, treated.Contacts, holdout.Converted, holdout.Contacts),
+	}
+	if settings != nil {
+		report.Percentage = settings.Percentage
+	}
+	if holdout.Contacts > 0 {
+		report.IncrementalConversions = float64(treated.Converted) - holdout.ConversionRate*float64(treated.Contacts)
+	}
+	return report
+}
+
+func conversionRate(stats HoldoutGroupStats) float64 {
+	if stats.Contacts == 0 {
+		return 0
+	}
+	return float64(stats.Converted) / float64(stats.Contacts)
+}
+
+// GetHoldoutReportRequest defines the request to compare the conversions of a holdout group
+type GetHoldoutReportRequest struct {
+	WorkspaceID string            `json:"workspace_id"`
+	SourceType  HoldoutSourceType `json:"source_type"`
+	SourceID    string            `json:"source_id"`
+	GoalType    string            `json:"goal_type,omitempty"`
+}
+
+// FromURLParams parses the request from query parameters
+func (r *GetHoldoutReportRequest) FromURLParams(values url.Values) error {
+	r.WorkspaceID = values.Get("workspace_id")
+	r.SourceType = HoldoutSourceType(values.Get("source_type"))
+	r.SourceID = values.Get("source_id")
+	r.GoalType = values.Get("goal_type")
+	return r.Validate()
+}
+
+// Validate validates the holdout report request
+func (r *GetHoldoutReportRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+
+	switch r.SourceType {
+	case HoldoutSourceBroadcast, HoldoutSourceAutomation:
+	default:
+		return fmt.Errorf("source_type must be broadcast or automation")
+	}
+
+	if r.SourceID == "" {
+		return fmt.Errorf("source_id is required")
+	}
+
+	if r.GoalType != "" && !isValidGoalType(r.GoalType) {
+		return fmt.Errorf("invalid goal_type: %s", r.GoalType)
+	}
+
+	return nil
+}
+
+func isValidGoalType(goalType string) bool {
+	for _, valid := range ValidGoalTypes {
+		if goalType == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// HoldoutRepository defines the data access for holdout groups
+type HoldoutRepository interface {
+	// GetGroupConversions returns the goal conversions of the treated and holdout groups of a
+	// broadcast or automation, counting the goals reached within windowDays of each contact's
+	// send (treated) or assignment (holdout). An empty goalType counts every goal.
+	GetGroupConversions(ctx context.Context, workspaceID string, sourceType HoldoutSourceType, sourceID string, windowDays int, goalType string) (treated *HoldoutGroupStats, holdout *HoldoutGroupStats, err error)
+}
+
+// HoldoutService defines the business logic of holdout groups
+type HoldoutService interface {
+	// GetReport compares the goal conversions of the treated and holdout groups
+	GetReport(ctx context.Context, request *GetHoldoutReportRequest) (*HoldoutReport, error)
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHoldoutSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *HoldoutSettings
+		wantErr  string
+	}{
+		{name: "nil", settings: nil},
+		{name: "disabled with invalid values", settings: &HoldoutSettings{Enabled: false, Percentage: 90}},
+		{name: "valid", settings: &HoldoutSettings{Enabled: true, Percentage: 10, ConversionWindowDays: 14}},
+		{name: "percentage too low", settings: &HoldoutSettings{Enabled: true, Percentage: 0}, wantErr: "holdout percentage"},
+		{name: "percentage too high", settings: &HoldoutSettings{Enabled: true, Percentage: 51}, wantErr: "holdout percentage"},
+		{name: "window too long", settings: &HoldoutSettings{Enabled: true, Percentage: 10, ConversionWindowDays: 91}, wantErr: "conversion_window_days"},
+		{name: "negative window", settings: &HoldoutSettings{Enabled: true, Percentage: 10, ConversionWindowDays: -1}, wantErr: "conversion_window_days"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestHoldoutSettings_GetConversionWindowDays(t *testing.T) {
+	var nilSettings *HoldoutSettings
+	assert.Equal(t, DefaultHoldoutConversionWindowDays, nilSettings.GetConversionWindowDays())
+	assert.Equal(t, DefaultHoldoutConversionWindowDays, (&HoldoutSettings{}).GetConversionWindowDays())
+	assert.Equal(t, 30, (&HoldoutSettings{ConversionWindowDays: 30}).GetConversionWindowDays())
+}
+
+func TestHoldoutSettings_Includes(t *testing.T) {
+	t.Run("disabled settings hold out nobody", func(t *testing.T) {
+		var nilSettings *HoldoutSettings
+		assert.False(t, nilSettings.Includes("b1", "john@example.com"))
+		assert.False(t, (&HoldoutSettings{Percentage: 50}).Includes("b1", "john@example.com"))
+	})
+
+	t.Run("assignment is deterministic", func(t *testing.T) {
+		settings := &HoldoutSettings{Enabled: true, Percentage: 20}
+		for i := 0; i < 100; i++ {
+			email := fmt.Sprintf("contact%d@example.com", i)
+			assert.Equal(t, settings.Includes("b1", email), settings.Includes("b1", email))
+		}
+	})
+
+	t.Run("share of the audience matches the percentage", func(t *testing.T) {
+		settings := &HoldoutSettings{Enabled: true, Percentage: 20}
+		heldOut := 0
+		for i := 0; i < 10000; i++ {
+			if settings.Includes("b1", fmt.Sprintf("contact%d@example.com", i)) {
+				heldOut++
+			}
+		}
+		assert.InDelta(t, 2000, heldOut, 200)
+	})
+
+	t.Run("seed changes the assignment", func(t *testing.T) {
+		different := false
+		for i := 0; i < 100 && !different; i++ {
+			email := fmt.Sprintf("contact%d@example.com", i)
+			different = HoldoutBucket("b1", email) != HoldoutBucket("b2", email)
+		}
+		assert.True(t, different)
+	})
+}
+
+func TestHoldoutBucket(t *testing.T) {
+	// md5("b1:john@example.com") starts with 6a7b3bc8, the bytes the SQL expression reads
+	assert.Equal(t, 0x6a7b3bc8%holdoutBuckets, HoldoutBucket("b1", "john@example.com"))
+}
+
+func TestCompareProportions(t *testing.T) {
+	t.Run("empty group", func(t *testing.T) {
+		comparison := CompareProportions(10, 100, 0, 0)
+		assert.Equal(t, 1.0, comparison.PValue)
+		assert.False(t, comparison.Significant)
+		assert.Nil(t, comparison.Lift)
+	})
+
+	t.Run("significant difference", func(t *testing.T) {
+		comparison := CompareProportions(200, 1000, 100, 1000)
+
+		assert.InDelta(t, 0.1, comparison.Difference, 1e-9)
+		require.NotNil(t, comparison.Lift)
+		assert.InDelta(t, 1.0, *comparison.Lift, 1e-9)
+		assert.True(t, comparison.Significant)
+		assert.Less(t, comparison.PValue, 0.001)
+		assert.Greater(t, comparison.ConfidenceLower, 0.0)
+		assert.Greater(t, comparison.ConfidenceUpper, comparison.Difference)
+	})
+
+	t.Run("not significant difference", func(t *testing.T) {
+		comparison := CompareProportions(11, 100, 10, 100)
+
+		assert.False(t, comparison.Significant)
+		assert.Greater(t, comparison.PValue, 0.05)
+		assert.Less(t, comparison.ConfidenceLower, 0.0)
+	})
+
+	t.Run("no conversions in the second group", func(t *testing.T) {
+		comparison := CompareProportions(5, 100, 0, 100)
+
+		assert.Nil(t, comparison.Lift)
+		assert.InDelta(t, 0.05, comparison.Difference, 1e-9)
+	})
+}
+
+func TestNewHoldoutReport(t *testing.T) {
+	settings := &HoldoutSettings{Enabled: true, Percentage: 10}
+	report := NewHoldoutReport(HoldoutSourceBroadcast, "b1", settings, GoalTypePurchase,
+		HoldoutGroupStats{Contacts: 900, Converted: 90, Revenue: 1000},
+		HoldoutGroupStats{Contacts: 100, Converted: 5, Revenue: 50},
+	)
+
+	assert.Equal(t, HoldoutSourceBroadcast, report.SourceType)
+	assert.Equal(t, "b1", report.SourceID)
+	assert.Equal(t, 10, report.Percentage)
+	assert.Equal(t, DefaultHoldoutConversionWindowDays, report.ConversionWindowDays)
+	assert.InDelta(t, 0.1, report.Treated.ConversionRate, 1e-9)
+	assert.InDelta(t, 0.05, report.Holdout.ConversionRate, 1e-9)
+	assert.InDelta(t, 45, report.IncrementalConversions, 1e-9)
+	assert.InDelta(t, 0.05, report.Comparison.Difference, 1e-9)
+}
+
+func TestGetHoldoutReportRequest_FromURLParams(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		var req GetHoldoutReportRequest
+		err := req.FromURLParams(url.Values{
+			"workspace_id": {"w1"},
+			"source_type":  {"automation"},
+			"source_id":    {"a1"},
+			"goal_type":    {GoalTypePurchase},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, HoldoutSourceAutomation, req.SourceType)
+		assert.Equal(t, "a1", req.SourceID)
+		assert.Equal(t, GoalTypePurchase, req.GoalType)
+	})
+
+	tests := []struct {
+		name    string
+		values  url.Values
+		wantErr string
+	}{
+		{name: "missing workspace", values: url.Values{"source_type": {"broadcast"}, "source_id": {"b1"}}, wantErr: "workspace_id"},
+		{name: "invalid source type", values: url.Values{"workspace_id": {"w1"}, "source_type": {"list"}, "source_id": {"b1"}}, wantErr: "source_type"},
+		{name: "missing source id", values: url.Values{"workspace_id": {"w1"}, "source_type": {"broadcast"}}, wantErr: "source_id"},
+		{name: "invalid goal type", values: url.Values{"workspace_id": {"w1"}, "source_type": {"broadcast"}, "source_id": {"b1"}, "goal_type": {"unknown"}}, wantErr: "goal_type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req GetHoldoutReportRequest
+			err := req.FromURLParams(tt.values)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -184,9 +184,18 @@ type MessageHistoryRepository interface {
 	// GetBroadcastStats retrieves statistics for a broadcast
 	GetBroadcastStats(ctx context.Context, workspaceID, broadcastID string) (*MessageHistoryStatusSum, error)
 
+	// GetCombinedBroadcastStats retrieves statistics for a broadcast and the broadcasts linked to it
+	GetCombinedBroadcastStats(ctx context.Context, workspaceID, broadcastID string) (*MessageHistoryStatusSum, error)
+
 	// GetBroadcastVariationStats retrieves statistics for a specific variation of a broadcast
 	GetBroadcastVariationStats(ctx context.Context, workspaceID, broadcastID, templateID string) (*MessageHistoryStatusSum, error)
 
+	// GetBroadcastVariationGoalRevenue sums the goal values reached by the recipients of a variation after their send
+	GetBroadcastVariationGoalRevenue(ctx context.Context, workspaceID, broadcastID, templateID string) (*GoalRevenueSum, error)
+
+	// GetBroadcastTemplateVersion returns the template version a variation of a broadcast was sent with
+	GetBroadcastTemplateVersion(ctx context.Context, workspaceID, broadcastID, templateID string) (int64, error)
+
 	// DeleteForEmail deletes all message history records for a specific email
 	DeleteForEmail(ctx context.Context, workspaceID, email string) error
 }
@@ -199,6 +208,9 @@ type MessageHistoryService interface {
 	// GetBroadcastStats retrieves statistics for a broadcast
 	GetBroadcastStats(ctx context.Context, workspaceID, broadcastID string) (*MessageHistoryStatusSum, error)
 
+	// GetCombinedBroadcastStats retrieves statistics for a broadcast and the broadcasts linked to it
+	GetCombinedBroadcastStats(ctx context.Context, workspaceID, broadcastID string) (*MessageHistoryStatusSum, error)
+
 	// GetBroadcastVariationStats retrieves statistics for a specific variation of a broadcast
 	GetBroadcastVariationStats(ctx context.Context, workspaceID, broadcastID, templateID string) (*MessageHistoryStatusSum, error)
 }
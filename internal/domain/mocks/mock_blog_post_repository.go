@@ -229,6 +229,21 @@ func (mr *MockBlogPostRepositoryMockRecorder) ListPosts(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPosts", reflect.TypeOf((*MockBlogPostRepository)(nil).ListPosts), arg0, arg1)
 }
 
+// ListPostsPublishedBetween mocks base method.
+func (m *MockBlogPostRepository) ListPostsPublishedBetween(arg0 context.Context, arg1 string, arg2, arg3 time.Time, arg4 int) ([]*domain.BlogPost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPostsPublishedBetween", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*domain.BlogPost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPostsPublishedBetween indicates an expected call of ListPostsPublishedBetween.
+func (mr *MockBlogPostRepositoryMockRecorder) ListPostsPublishedBetween(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPostsPublishedBetween", reflect.TypeOf((*MockBlogPostRepository)(nil).ListPostsPublishedBetween), arg0, arg1, arg2, arg3, arg4)
+}
+
 // PublishPost mocks base method.
 func (m *MockBlogPostRepository) PublishPost(arg0 context.Context, arg1 string, arg2 *time.Time) error {
 	m.ctrl.T.Helper()
@@ -36,6 +36,21 @@ func (m *MockBlogService) EXPECT() *MockBlogServiceMockRecorder {
 	return m.recorder
 }
 
+// BuildArchiveFeed mocks base method.
+func (m *MockBlogService) BuildArchiveFeed(arg0 context.Context, arg1, arg2 string) (*domain.BlogFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildArchiveFeed", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.BlogFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildArchiveFeed indicates an expected call of BuildArchiveFeed.
+func (mr *MockBlogServiceMockRecorder) BuildArchiveFeed(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildArchiveFeed", reflect.TypeOf((*MockBlogService)(nil).BuildArchiveFeed), arg0, arg1, arg2)
+}
+
 // BuildFeed mocks base method.
 func (m *MockBlogService) BuildFeed(arg0 context.Context, arg1 string, arg2 *string) (*domain.BlogFeed, error) {
 	m.ctrl.T.Helper()
@@ -290,6 +305,21 @@ func (mr *MockBlogServiceMockRecorder) ListCategories(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCategories", reflect.TypeOf((*MockBlogService)(nil).ListCategories), arg0)
 }
 
+// ListDigestPosts mocks base method.
+func (m *MockBlogService) ListDigestPosts(arg0 context.Context, arg1 string, arg2 *domain.BlogDigestSettings, arg3, arg4 time.Time) ([]domain.BlogFeedItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDigestPosts", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]domain.BlogFeedItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDigestPosts indicates an expected call of ListDigestPosts.
+func (mr *MockBlogServiceMockRecorder) ListDigestPosts(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDigestPosts", reflect.TypeOf((*MockBlogService)(nil).ListDigestPosts), arg0, arg1, arg2, arg3, arg4)
+}
+
 // ListPosts mocks base method.
 func (m *MockBlogService) ListPosts(arg0 context.Context, arg1 *domain.ListBlogPostsRequest) (*domain.BlogPostListResponse, error) {
 	m.ctrl.T.Helper()
@@ -363,6 +393,36 @@ func (mr *MockBlogServiceMockRecorder) PublishTheme(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishTheme", reflect.TypeOf((*MockBlogService)(nil).PublishTheme), arg0, arg1)
 }
 
+// RenderArchiveIssuePage mocks base method.
+func (m *MockBlogService) RenderArchiveIssuePage(arg0 context.Context, arg1, arg2, arg3 string, arg4 *int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderArchiveIssuePage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderArchiveIssuePage indicates an expected call of RenderArchiveIssuePage.
+func (mr *MockBlogServiceMockRecorder) RenderArchiveIssuePage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderArchiveIssuePage", reflect.TypeOf((*MockBlogService)(nil).RenderArchiveIssuePage), arg0, arg1, arg2, arg3, arg4)
+}
+
+// RenderArchivePage mocks base method.
+func (m *MockBlogService) RenderArchivePage(arg0 context.Context, arg1, arg2 string, arg3 int, arg4 *int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderArchivePage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderArchivePage indicates an expected call of RenderArchivePage.
+func (mr *MockBlogServiceMockRecorder) RenderArchivePage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderArchivePage", reflect.TypeOf((*MockBlogService)(nil).RenderArchivePage), arg0, arg1, arg2, arg3, arg4)
+}
+
 // RenderCategoryPage mocks base method.
 func (m *MockBlogService) RenderCategoryPage(arg0 context.Context, arg1, arg2 string, arg3 int, arg4 *int) (string, error) {
 	m.ctrl.T.Helper()
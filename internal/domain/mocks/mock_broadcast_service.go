@@ -108,6 +108,21 @@ func (mr *MockBroadcastServiceMockRecorder) GetTestResults(arg0, arg1, arg2 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTestResults", reflect.TypeOf((*MockBroadcastService)(nil).GetTestResults), arg0, arg1, arg2)
 }
 
+// LintBroadcast mocks base method.
+func (m *MockBroadcastService) LintBroadcast(arg0 context.Context, arg1, arg2 string) (*domain.BroadcastLintReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LintBroadcast", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.BroadcastLintReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LintBroadcast indicates an expected call of LintBroadcast.
+func (mr *MockBroadcastServiceMockRecorder) LintBroadcast(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LintBroadcast", reflect.TypeOf((*MockBroadcastService)(nil).LintBroadcast), arg0, arg1, arg2)
+}
+
 // ListBroadcasts mocks base method.
 func (m *MockBroadcastService) ListBroadcasts(arg0 context.Context, arg1 domain.ListBroadcastsParams) (*domain.BroadcastListResponse, error) {
 	m.ctrl.T.Helper()
@@ -137,6 +152,21 @@ func (mr *MockBroadcastServiceMockRecorder) PauseBroadcast(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseBroadcast", reflect.TypeOf((*MockBroadcastService)(nil).PauseBroadcast), arg0, arg1)
 }
 
+// PreviewAudience mocks base method.
+func (m *MockBroadcastService) PreviewAudience(arg0 context.Context, arg1 *domain.PreviewAudienceRequest) (*domain.AudiencePreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewAudience", arg0, arg1)
+	ret0, _ := ret[0].(*domain.AudiencePreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewAudience indicates an expected call of PreviewAudience.
+func (mr *MockBroadcastServiceMockRecorder) PreviewAudience(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewAudience", reflect.TypeOf((*MockBroadcastService)(nil).PreviewAudience), arg0, arg1)
+}
+
 // RefreshGlobalFeed mocks base method.
 func (m *MockBroadcastService) RefreshGlobalFeed(arg0 context.Context, arg1 *domain.RefreshGlobalFeedRequest) (*domain.RefreshGlobalFeedResponse, error) {
 	m.ctrl.T.Helper()
@@ -152,6 +182,36 @@ func (mr *MockBroadcastServiceMockRecorder) RefreshGlobalFeed(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshGlobalFeed", reflect.TypeOf((*MockBroadcastService)(nil).RefreshGlobalFeed), arg0, arg1)
 }
 
+// RequestApproval mocks base method.
+func (m *MockBroadcastService) RequestApproval(arg0 context.Context, arg1 *domain.RequestBroadcastApprovalRequest) (*domain.Broadcast, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestApproval", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Broadcast)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestApproval indicates an expected call of RequestApproval.
+func (mr *MockBroadcastServiceMockRecorder) RequestApproval(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestApproval", reflect.TypeOf((*MockBroadcastService)(nil).RequestApproval), arg0, arg1)
+}
+
+// ResendToNonOpeners mocks base method.
+func (m *MockBroadcastService) ResendToNonOpeners(arg0 context.Context, arg1 *domain.ResendToNonOpenersRequest) (*domain.Broadcast, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendToNonOpeners", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Broadcast)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResendToNonOpeners indicates an expected call of ResendToNonOpeners.
+func (mr *MockBroadcastServiceMockRecorder) ResendToNonOpeners(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendToNonOpeners", reflect.TypeOf((*MockBroadcastService)(nil).ResendToNonOpeners), arg0, arg1)
+}
+
 // ResumeBroadcast mocks base method.
 func (m *MockBroadcastService) ResumeBroadcast(arg0 context.Context, arg1 *domain.ResumeBroadcastRequest) error {
 	m.ctrl.T.Helper()
@@ -166,6 +226,21 @@ func (mr *MockBroadcastServiceMockRecorder) ResumeBroadcast(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeBroadcast", reflect.TypeOf((*MockBroadcastService)(nil).ResumeBroadcast), arg0, arg1)
 }
 
+// ReviewBroadcast mocks base method.
+func (m *MockBroadcastService) ReviewBroadcast(arg0 context.Context, arg1 *domain.ReviewBroadcastRequest) (*domain.Broadcast, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewBroadcast", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Broadcast)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewBroadcast indicates an expected call of ReviewBroadcast.
+func (mr *MockBroadcastServiceMockRecorder) ReviewBroadcast(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewBroadcast", reflect.TypeOf((*MockBroadcastService)(nil).ReviewBroadcast), arg0, arg1)
+}
+
 // ScheduleBroadcast mocks base method.
 func (m *MockBroadcastService) ScheduleBroadcast(arg0 context.Context, arg1 *domain.ScheduleBroadcastRequest) error {
 	m.ctrl.T.Helper()
@@ -51,6 +51,21 @@ func (mr *MockContactRepositoryMockRecorder) BulkUpsertContacts(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsertContacts", reflect.TypeOf((*MockContactRepository)(nil).BulkUpsertContacts), arg0, arg1, arg2)
 }
 
+// ChangeEmail mocks base method.
+func (m *MockContactRepository) ChangeEmail(arg0 context.Context, arg1, arg2, arg3 string) (*domain.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeEmail", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeEmail indicates an expected call of ChangeEmail.
+func (mr *MockContactRepositoryMockRecorder) ChangeEmail(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeEmail", reflect.TypeOf((*MockContactRepository)(nil).ChangeEmail), arg0, arg1, arg2, arg3)
+}
+
 // Count mocks base method.
 func (m *MockContactRepository) Count(arg0 context.Context, arg1 string) (int, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +110,66 @@ func (mr *MockContactRepositoryMockRecorder) DeleteContact(arg0, arg1, arg2 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContact", reflect.TypeOf((*MockContactRepository)(nil).DeleteContact), arg0, arg1, arg2)
 }
 
+// EraseContact mocks base method.
+func (m *MockContactRepository) EraseContact(arg0 context.Context, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EraseContact", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EraseContact indicates an expected call of EraseContact.
+func (mr *MockContactRepositoryMockRecorder) EraseContact(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EraseContact", reflect.TypeOf((*MockContactRepository)(nil).EraseContact), arg0, arg1, arg2)
+}
+
+// ExportContactData mocks base method.
+func (m *MockContactRepository) ExportContactData(arg0 context.Context, arg1, arg2 string) (*domain.ContactDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportContactData", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.ContactDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportContactData indicates an expected call of ExportContactData.
+func (mr *MockContactRepositoryMockRecorder) ExportContactData(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportContactData", reflect.TypeOf((*MockContactRepository)(nil).ExportContactData), arg0, arg1, arg2)
+}
+
+// FilterErasedEmails mocks base method.
+func (m *MockContactRepository) FilterErasedEmails(arg0 context.Context, arg1 string, arg2 []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterErasedEmails", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterErasedEmails indicates an expected call of FilterErasedEmails.
+func (mr *MockContactRepositoryMockRecorder) FilterErasedEmails(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterErasedEmails", reflect.TypeOf((*MockContactRepository)(nil).FilterErasedEmails), arg0, arg1, arg2)
+}
+
+// FindDuplicateContacts mocks base method.
+func (m *MockContactRepository) FindDuplicateContacts(arg0 context.Context, arg1 string, arg2 int) ([]*domain.ContactDuplicateGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDuplicateContacts", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.ContactDuplicateGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDuplicateContacts indicates an expected call of FindDuplicateContacts.
+func (mr *MockContactRepositoryMockRecorder) FindDuplicateContacts(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicateContacts", reflect.TypeOf((*MockContactRepository)(nil).FindDuplicateContacts), arg0, arg1, arg2)
+}
+
 // GetBatchForSegment mocks base method.
 func (m *MockContactRepository) GetBatchForSegment(arg0 context.Context, arg1 string, arg2 int64, arg3 int) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +259,51 @@ func (mr *MockContactRepositoryMockRecorder) MarkEmailsAsBounced(arg0, arg1, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEmailsAsBounced", reflect.TypeOf((*MockContactRepository)(nil).MarkEmailsAsBounced), arg0, arg1, arg2, arg3)
 }
 
+// MergeContacts mocks base method.
+func (m *MockContactRepository) MergeContacts(arg0 context.Context, arg1, arg2, arg3 string, arg4 domain.ContactMergePrecedence) (*domain.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeContacts", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*domain.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeContacts indicates an expected call of MergeContacts.
+func (mr *MockContactRepositoryMockRecorder) MergeContacts(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeContacts", reflect.TypeOf((*MockContactRepository)(nil).MergeContacts), arg0, arg1, arg2, arg3, arg4)
+}
+
+// MigrateCustomFieldsToAttributes mocks base method.
+func (m *MockContactRepository) MigrateCustomFieldsToAttributes(arg0 context.Context, arg1 string, arg2 map[string]string, arg3 bool) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateCustomFieldsToAttributes", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateCustomFieldsToAttributes indicates an expected call of MigrateCustomFieldsToAttributes.
+func (mr *MockContactRepositoryMockRecorder) MigrateCustomFieldsToAttributes(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateCustomFieldsToAttributes", reflect.TypeOf((*MockContactRepository)(nil).MigrateCustomFieldsToAttributes), arg0, arg1, arg2, arg3)
+}
+
+// SaveBroadcastHoldout mocks base method.
+func (m *MockContactRepository) SaveBroadcastHoldout(arg0 context.Context, arg1, arg2 string, arg3 domain.AudienceSettings) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBroadcastHoldout", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveBroadcastHoldout indicates an expected call of SaveBroadcastHoldout.
+func (mr *MockContactRepositoryMockRecorder) SaveBroadcastHoldout(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBroadcastHoldout", reflect.TypeOf((*MockContactRepository)(nil).SaveBroadcastHoldout), arg0, arg1, arg2, arg3)
+}
+
 // UpsertContact mocks base method.
 func (m *MockContactRepository) UpsertContact(arg0 context.Context, arg1 string, arg2 *domain.Contact) (bool, error) {
 	m.ctrl.T.Helper()
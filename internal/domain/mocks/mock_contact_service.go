@@ -49,6 +49,21 @@ func (mr *MockContactServiceMockRecorder) BatchImportContacts(arg0, arg1, arg2,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchImportContacts", reflect.TypeOf((*MockContactService)(nil).BatchImportContacts), arg0, arg1, arg2, arg3)
 }
 
+// ChangeContactEmail mocks base method.
+func (m *MockContactService) ChangeContactEmail(arg0 context.Context, arg1 *domain.ChangeContactEmailRequest) (*domain.ChangeContactEmailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeContactEmail", arg0, arg1)
+	ret0, _ := ret[0].(*domain.ChangeContactEmailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeContactEmail indicates an expected call of ChangeContactEmail.
+func (mr *MockContactServiceMockRecorder) ChangeContactEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeContactEmail", reflect.TypeOf((*MockContactService)(nil).ChangeContactEmail), arg0, arg1)
+}
+
 // CountContacts mocks base method.
 func (m *MockContactService) CountContacts(arg0 context.Context, arg1 string) (int, error) {
 	m.ctrl.T.Helper()
@@ -78,6 +93,21 @@ func (mr *MockContactServiceMockRecorder) DeleteContact(arg0, arg1, arg2 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContact", reflect.TypeOf((*MockContactService)(nil).DeleteContact), arg0, arg1, arg2)
 }
 
+// FindDuplicateContacts mocks base method.
+func (m *MockContactService) FindDuplicateContacts(arg0 context.Context, arg1 *domain.FindDuplicateContactsRequest) (*domain.FindDuplicateContactsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDuplicateContacts", arg0, arg1)
+	ret0, _ := ret[0].(*domain.FindDuplicateContactsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDuplicateContacts indicates an expected call of FindDuplicateContacts.
+func (mr *MockContactServiceMockRecorder) FindDuplicateContacts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicateContacts", reflect.TypeOf((*MockContactService)(nil).FindDuplicateContacts), arg0, arg1)
+}
+
 // GetContactByEmail mocks base method.
 func (m *MockContactService) GetContactByEmail(arg0 context.Context, arg1, arg2 string) (*domain.Contact, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +153,36 @@ func (mr *MockContactServiceMockRecorder) GetContacts(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContacts", reflect.TypeOf((*MockContactService)(nil).GetContacts), arg0, arg1)
 }
 
+// MergeContacts mocks base method.
+func (m *MockContactService) MergeContacts(arg0 context.Context, arg1 *domain.MergeContactsRequest) (*domain.MergeContactsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeContacts", arg0, arg1)
+	ret0, _ := ret[0].(*domain.MergeContactsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeContacts indicates an expected call of MergeContacts.
+func (mr *MockContactServiceMockRecorder) MergeContacts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeContacts", reflect.TypeOf((*MockContactService)(nil).MergeContacts), arg0, arg1)
+}
+
+// MigrateCustomFieldsToAttributes mocks base method.
+func (m *MockContactService) MigrateCustomFieldsToAttributes(arg0 context.Context, arg1 *domain.MigrateCustomFieldsRequest) (*domain.MigrateCustomFieldsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateCustomFieldsToAttributes", arg0, arg1)
+	ret0, _ := ret[0].(*domain.MigrateCustomFieldsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateCustomFieldsToAttributes indicates an expected call of MigrateCustomFieldsToAttributes.
+func (mr *MockContactServiceMockRecorder) MigrateCustomFieldsToAttributes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateCustomFieldsToAttributes", reflect.TypeOf((*MockContactService)(nil).MigrateCustomFieldsToAttributes), arg0, arg1)
+}
+
 // UpsertContact mocks base method.
 func (m *MockContactService) UpsertContact(arg0 context.Context, arg1 string, arg2 *domain.Contact) domain.UpsertContactOperation {
 	m.ctrl.T.Helper()
@@ -49,6 +49,36 @@ func (mr *MockEmailServiceInterfaceMockRecorder) OpenEmail(arg0, arg1, arg2 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenEmail", reflect.TypeOf((*MockEmailServiceInterface)(nil).OpenEmail), arg0, arg1, arg2)
 }
 
+// RenderPublicBroadcast mocks base method.
+func (m *MockEmailServiceInterface) RenderPublicBroadcast(arg0 context.Context, arg1, arg2 string) (*domain.WebView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderPublicBroadcast", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.WebView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderPublicBroadcast indicates an expected call of RenderPublicBroadcast.
+func (mr *MockEmailServiceInterfaceMockRecorder) RenderPublicBroadcast(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderPublicBroadcast", reflect.TypeOf((*MockEmailServiceInterface)(nil).RenderPublicBroadcast), arg0, arg1, arg2)
+}
+
+// RenderWebView mocks base method.
+func (m *MockEmailServiceInterface) RenderWebView(arg0 context.Context, arg1, arg2, arg3 string) (*domain.WebView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderWebView", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.WebView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderWebView indicates an expected call of RenderWebView.
+func (mr *MockEmailServiceInterfaceMockRecorder) RenderWebView(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderWebView", reflect.TypeOf((*MockEmailServiceInterface)(nil).RenderWebView), arg0, arg1, arg2, arg3)
+}
+
 // SendEmail mocks base method.
 func (m *MockEmailServiceInterface) SendEmail(arg0 context.Context, arg1 domain.SendEmailProviderRequest, arg2 bool) error {
 	m.ctrl.T.Helper()
@@ -94,6 +94,36 @@ func (mr *MockMessageHistoryRepositoryMockRecorder) GetBroadcastStats(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBroadcastStats", reflect.TypeOf((*MockMessageHistoryRepository)(nil).GetBroadcastStats), arg0, arg1, arg2)
 }
 
+// GetBroadcastTemplateVersion mocks base method.
+func (m *MockMessageHistoryRepository) GetBroadcastTemplateVersion(arg0 context.Context, arg1, arg2, arg3 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBroadcastTemplateVersion", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBroadcastTemplateVersion indicates an expected call of GetBroadcastTemplateVersion.
+func (mr *MockMessageHistoryRepositoryMockRecorder) GetBroadcastTemplateVersion(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBroadcastTemplateVersion", reflect.TypeOf((*MockMessageHistoryRepository)(nil).GetBroadcastTemplateVersion), arg0, arg1, arg2, arg3)
+}
+
+// GetBroadcastVariationGoalRevenue mocks base method.
+func (m *MockMessageHistoryRepository) GetBroadcastVariationGoalRevenue(arg0 context.Context, arg1, arg2, arg3 string) (*domain.GoalRevenueSum, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBroadcastVariationGoalRevenue", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.GoalRevenueSum)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBroadcastVariationGoalRevenue indicates an expected call of GetBroadcastVariationGoalRevenue.
+func (mr *MockMessageHistoryRepositoryMockRecorder) GetBroadcastVariationGoalRevenue(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBroadcastVariationGoalRevenue", reflect.TypeOf((*MockMessageHistoryRepository)(nil).GetBroadcastVariationGoalRevenue), arg0, arg1, arg2, arg3)
+}
+
 // GetBroadcastVariationStats mocks base method.
 func (m *MockMessageHistoryRepository) GetBroadcastVariationStats(arg0 context.Context, arg1, arg2, arg3 string) (*domain.MessageHistoryStatusSum, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +186,21 @@ func (mr *MockMessageHistoryRepositoryMockRecorder) GetByExternalID(arg0, arg1,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByExternalID", reflect.TypeOf((*MockMessageHistoryRepository)(nil).GetByExternalID), arg0, arg1, arg2, arg3)
 }
 
+// GetCombinedBroadcastStats mocks base method.
+func (m *MockMessageHistoryRepository) GetCombinedBroadcastStats(arg0 context.Context, arg1, arg2 string) (*domain.MessageHistoryStatusSum, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCombinedBroadcastStats", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.MessageHistoryStatusSum)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCombinedBroadcastStats indicates an expected call of GetCombinedBroadcastStats.
+func (mr *MockMessageHistoryRepositoryMockRecorder) GetCombinedBroadcastStats(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCombinedBroadcastStats", reflect.TypeOf((*MockMessageHistoryRepository)(nil).GetCombinedBroadcastStats), arg0, arg1, arg2)
+}
+
 // ListMessages mocks base method.
 func (m *MockMessageHistoryRepository) ListMessages(arg0 context.Context, arg1, arg2 string, arg3 domain.MessageListParams) ([]*domain.MessageHistory, string, error) {
 	m.ctrl.T.Helper()
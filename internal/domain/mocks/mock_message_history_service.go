@@ -65,6 +65,21 @@ func (mr *MockMessageHistoryServiceMockRecorder) GetBroadcastVariationStats(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBroadcastVariationStats", reflect.TypeOf((*MockMessageHistoryService)(nil).GetBroadcastVariationStats), arg0, arg1, arg2, arg3)
 }
 
+// GetCombinedBroadcastStats mocks base method.
+func (m *MockMessageHistoryService) GetCombinedBroadcastStats(arg0 context.Context, arg1, arg2 string) (*domain.MessageHistoryStatusSum, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCombinedBroadcastStats", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.MessageHistoryStatusSum)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCombinedBroadcastStats indicates an expected call of GetCombinedBroadcastStats.
+func (mr *MockMessageHistoryServiceMockRecorder) GetCombinedBroadcastStats(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCombinedBroadcastStats", reflect.TypeOf((*MockMessageHistoryService)(nil).GetCombinedBroadcastStats), arg0, arg1, arg2)
+}
+
 // ListMessages mocks base method.
 func (m *MockMessageHistoryService) ListMessages(arg0 context.Context, arg1 string, arg2 domain.MessageListParams) (*domain.MessageListResult, error) {
 	m.ctrl.T.Helper()
@@ -35,6 +35,21 @@ func (m *MockNotificationCenterService) EXPECT() *MockNotificationCenterServiceM
 	return m.recorder
 }
 
+// ExportContactData mocks base method.
+func (m *MockNotificationCenterService) ExportContactData(arg0 context.Context, arg1, arg2, arg3 string) (*domain.ContactDataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportContactData", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.ContactDataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportContactData indicates an expected call of ExportContactData.
+func (mr *MockNotificationCenterServiceMockRecorder) ExportContactData(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportContactData", reflect.TypeOf((*MockNotificationCenterService)(nil).ExportContactData), arg0, arg1, arg2, arg3)
+}
+
 // GetContactPreferences mocks base method.
 func (m *MockNotificationCenterService) GetContactPreferences(arg0 context.Context, arg1, arg2, arg3 string) (*domain.ContactPreferencesResponse, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +63,21 @@ func (mr *MockTemplateRepositoryMockRecorder) DeleteTemplate(arg0, arg1, arg2 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockTemplateRepository)(nil).DeleteTemplate), arg0, arg1, arg2)
 }
 
+// GetPublishedTemplate mocks base method.
+func (m *MockTemplateRepository) GetPublishedTemplate(arg0 context.Context, arg1, arg2 string) (*domain.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublishedTemplate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublishedTemplate indicates an expected call of GetPublishedTemplate.
+func (mr *MockTemplateRepositoryMockRecorder) GetPublishedTemplate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishedTemplate", reflect.TypeOf((*MockTemplateRepository)(nil).GetPublishedTemplate), arg0, arg1, arg2)
+}
+
 // GetTemplateByID mocks base method.
 func (m *MockTemplateRepository) GetTemplateByID(arg0 context.Context, arg1, arg2 string, arg3 int64) (*domain.Template, error) {
 	m.ctrl.T.Helper()
@@ -93,6 +108,21 @@ func (mr *MockTemplateRepositoryMockRecorder) GetTemplateLatestVersion(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateLatestVersion", reflect.TypeOf((*MockTemplateRepository)(nil).GetTemplateLatestVersion), arg0, arg1, arg2)
 }
 
+// GetTemplateVersions mocks base method.
+func (m *MockTemplateRepository) GetTemplateVersions(arg0 context.Context, arg1, arg2 string) ([]*domain.TemplateVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateVersions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.TemplateVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateVersions indicates an expected call of GetTemplateVersions.
+func (mr *MockTemplateRepositoryMockRecorder) GetTemplateVersions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersions", reflect.TypeOf((*MockTemplateRepository)(nil).GetTemplateVersions), arg0, arg1, arg2)
+}
+
 // GetTemplates mocks base method.
 func (m *MockTemplateRepository) GetTemplates(arg0 context.Context, arg1, arg2, arg3 string) ([]*domain.Template, error) {
 	m.ctrl.T.Helper()
@@ -108,6 +138,20 @@ func (mr *MockTemplateRepositoryMockRecorder) GetTemplates(arg0, arg1, arg2, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplates", reflect.TypeOf((*MockTemplateRepository)(nil).GetTemplates), arg0, arg1, arg2, arg3)
 }
 
+// PublishTemplate mocks base method.
+func (m *MockTemplateRepository) PublishTemplate(arg0 context.Context, arg1, arg2 string, arg3 int64, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishTemplate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishTemplate indicates an expected call of PublishTemplate.
+func (mr *MockTemplateRepositoryMockRecorder) PublishTemplate(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishTemplate", reflect.TypeOf((*MockTemplateRepository)(nil).PublishTemplate), arg0, arg1, arg2, arg3, arg4)
+}
+
 // UpdateTemplate mocks base method.
 func (m *MockTemplateRepository) UpdateTemplate(arg0 context.Context, arg1 string, arg2 *domain.Template) error {
 	m.ctrl.T.Helper()
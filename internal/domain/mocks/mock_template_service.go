@@ -79,6 +79,36 @@ func (mr *MockTemplateServiceMockRecorder) DeleteTemplate(arg0, arg1, arg2 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockTemplateService)(nil).DeleteTemplate), arg0, arg1, arg2)
 }
 
+// DiffTemplateVersions mocks base method.
+func (m *MockTemplateService) DiffTemplateVersions(arg0 context.Context, arg1, arg2 string, arg3, arg4 int64) (*domain.TemplateVersionDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffTemplateVersions", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*domain.TemplateVersionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffTemplateVersions indicates an expected call of DiffTemplateVersions.
+func (mr *MockTemplateServiceMockRecorder) DiffTemplateVersions(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffTemplateVersions", reflect.TypeOf((*MockTemplateService)(nil).DiffTemplateVersions), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetPublishedTemplate mocks base method.
+func (m *MockTemplateService) GetPublishedTemplate(arg0 context.Context, arg1, arg2 string) (*domain.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublishedTemplate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublishedTemplate indicates an expected call of GetPublishedTemplate.
+func (mr *MockTemplateServiceMockRecorder) GetPublishedTemplate(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublishedTemplate", reflect.TypeOf((*MockTemplateService)(nil).GetPublishedTemplate), arg0, arg1, arg2)
+}
+
 // GetTemplateByID mocks base method.
 func (m *MockTemplateService) GetTemplateByID(arg0 context.Context, arg1, arg2 string, arg3 int64) (*domain.Template, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +124,21 @@ func (mr *MockTemplateServiceMockRecorder) GetTemplateByID(arg0, arg1, arg2, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByID", reflect.TypeOf((*MockTemplateService)(nil).GetTemplateByID), arg0, arg1, arg2, arg3)
 }
 
+// GetTemplateVersions mocks base method.
+func (m *MockTemplateService) GetTemplateVersions(arg0 context.Context, arg1, arg2 string) ([]*domain.TemplateVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateVersions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.TemplateVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateVersions indicates an expected call of GetTemplateVersions.
+func (mr *MockTemplateServiceMockRecorder) GetTemplateVersions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersions", reflect.TypeOf((*MockTemplateService)(nil).GetTemplateVersions), arg0, arg1, arg2)
+}
+
 // GetTemplates mocks base method.
 func (m *MockTemplateService) GetTemplates(arg0 context.Context, arg1, arg2, arg3 string) ([]*domain.Template, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +154,66 @@ func (mr *MockTemplateServiceMockRecorder) GetTemplates(arg0, arg1, arg2, arg3 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplates", reflect.TypeOf((*MockTemplateService)(nil).GetTemplates), arg0, arg1, arg2, arg3)
 }
 
+// LintTemplate mocks base method.
+func (m *MockTemplateService) LintTemplate(arg0 context.Context, arg1, arg2 string, arg3 int64) (*domain.TemplateLintReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LintTemplate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.TemplateLintReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LintTemplate indicates an expected call of LintTemplate.
+func (mr *MockTemplateServiceMockRecorder) LintTemplate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LintTemplate", reflect.TypeOf((*MockTemplateService)(nil).LintTemplate), arg0, arg1, arg2, arg3)
+}
+
+// PublishTemplate mocks base method.
+func (m *MockTemplateService) PublishTemplate(arg0 context.Context, arg1, arg2 string, arg3 int64) (*domain.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishTemplate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishTemplate indicates an expected call of PublishTemplate.
+func (mr *MockTemplateServiceMockRecorder) PublishTemplate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishTemplate", reflect.TypeOf((*MockTemplateService)(nil).PublishTemplate), arg0, arg1, arg2, arg3)
+}
+
+// RollbackTemplate mocks base method.
+func (m *MockTemplateService) RollbackTemplate(arg0 context.Context, arg1, arg2 string, arg3 int64) (*domain.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackTemplate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackTemplate indicates an expected call of RollbackTemplate.
+func (mr *MockTemplateServiceMockRecorder) RollbackTemplate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTemplate", reflect.TypeOf((*MockTemplateService)(nil).RollbackTemplate), arg0, arg1, arg2, arg3)
+}
+
+// TranslateTemplate mocks base method.
+func (m *MockTemplateService) TranslateTemplate(arg0 context.Context, arg1 *domain.TranslateTemplateRequest) (*domain.TranslateTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TranslateTemplate", arg0, arg1)
+	ret0, _ := ret[0].(*domain.TranslateTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TranslateTemplate indicates an expected call of TranslateTemplate.
+func (mr *MockTemplateServiceMockRecorder) TranslateTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslateTemplate", reflect.TypeOf((*MockTemplateService)(nil).TranslateTemplate), arg0, arg1)
+}
+
 // UpdateTemplate mocks base method.
 func (m *MockTemplateService) UpdateTemplate(arg0 context.Context, arg1 string, arg2 *domain.Template) error {
 	m.ctrl.T.Helper()
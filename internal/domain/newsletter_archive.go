@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -destination mocks/mock_broadcast_web_view_renderer.go -package mocks github.com/Notifuse/notifuse/internal/domain BroadcastWebViewRenderer
+
+// NewsletterArchivePath is the blog path under which the archives of lists are published:
+// /archive/{listID} lists the past broadcasts of a list and /archive/{listID}/{broadcastID}
+// shows one of them
+const NewsletterArchivePath = "archive"
+
+// BroadcastWebViewRenderer renders the web version of a broadcast without personal data
+type BroadcastWebViewRenderer interface {
+	RenderBroadcastWebView(ctx context.Context, workspace *Workspace, broadcastID string) (*WebView, error)
+}
+
+// NewsletterArchiveSlug returns the slug of the archive of a list. Archives are rendered with the
+// category and post templates of the blog theme, this slug being the category slug so that the
+// /{{ post.category_slug }}/{{ post.slug }} links of themes lead to the archived broadcasts.
+func NewsletterArchiveSlug(listID string) string {
+	return NewsletterArchivePath + "/" + listID
+}
+
+// NewNewsletterArchiveCategory returns the blog category standing for the archive of a list
+func NewNewsletterArchiveCategory(list *List) *BlogCategory {
+	return &BlogCategory{
+		ID:   list.ID,
+		Slug: NewsletterArchiveSlug(list.ID),
+		Settings: BlogCategorySettings{
+			Name:        list.Name,
+			Description: list.Description,
+		},
+		CreatedAt: list.CreatedAt,
+		UpdatedAt: list.UpdatedAt,
+	}
+}
+
+// NewNewsletterArchivePost returns the blog post standing for an archived broadcast of a list
+func NewNewsletterArchivePost(listID string, broadcast *Broadcast, title, excerpt string) *BlogPost {
+	sentAt := BroadcastSentAt(broadcast)
+	return &BlogPost{
+		ID:         broadcast.ID,
+		CategoryID: listID,
+		Slug:       broadcast.ID,
+		Settings: BlogPostSettings{
+			Title:   title,
+			Excerpt: excerpt,
+			Authors: []BlogAuthor{},
+		},
+		PublishedAt: &sentAt,
+		CreatedAt:   broadcast.CreatedAt,
+		UpdatedAt:   broadcast.UpdatedAt,
+	}
+}
+
+// BroadcastSentAt returns when a broadcast was sent: its completion, its start or its creation
+func BroadcastSentAt(broadcast *Broadcast) time.Time {
+	switch {
+	case broadcast.CompletedAt != nil:
+		return *broadcast.CompletedAt
+	case broadcast.StartedAt != nil:
+		return *broadcast.StartedAt
+	default:
+		return broadcast.CreatedAt
+	}
+}
+
+// BroadcastArchiveVariation returns the variation most recipients received: the winner of an
+// A/B test, or the first variation
+func BroadcastArchiveVariation(broadcast *Broadcast) *BroadcastVariation {
+	variations := broadcast.TestSettings.Variations
+	if broadcast.WinningTemplate != nil {
+		for i := range variations {
+			if variations[i].TemplateID == *broadcast.WinningTemplate {
+				return &variations[i]
+			}
+		}
+	}
+	if len(variations) == 0 {
+		return nil
+	}
+	return &variations[0]
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlogSettings_IsListArchived(t *testing.T) {
+	var nilSettings *BlogSettings
+	assert.False(t, nilSettings.IsListArchived("newsletter"))
+
+	settings := &BlogSettings{ArchiveListIDs: []string{"newsletter"}}
+	assert.True(t, settings.IsListArchived("newsletter"))
+	assert.False(t, settings.IsListArchived("customers"))
+	assert.False(t, settings.IsListArchived(""))
+}
+
+func TestNewNewsletterArchiveCategory(t *testing.T) {
+	category := NewNewsletterArchiveCategory(&List{ID: "newsletter", Name: "Weekly", Description: "Our weekly news"})
+
+	assert.Equal(t, "newsletter", category.ID)
+	assert.Equal(t, "archive/newsletter", category.Slug)
+	assert.Equal(t, "Weekly", category.Settings.Name)
+	assert.Equal(t, "Our weekly news", category.Settings.Description)
+}
+
+func TestNewNewsletterArchivePost(t *testing.T) {
+	createdAt := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	completedAt := createdAt.Add(2 * time.Hour)
+	broadcast := &Broadcast{ID: "bc1", CreatedAt: createdAt, UpdatedAt: completedAt, CompletedAt: &completedAt}
+
+	post := NewNewsletterArchivePost("newsletter", broadcast, "October news", "What happened")
+
+	assert.Equal(t, "bc1", post.ID)
+	assert.Equal(t, "bc1", post.Slug)
+	assert.Equal(t, "newsletter", post.CategoryID)
+	assert.Equal(t, "October news", post.Settings.Title)
+	assert.Equal(t, "What happened", post.Settings.Excerpt)
+	require.NotNil(t, post.PublishedAt)
+	assert.Equal(t, completedAt, *post.PublishedAt)
+	assert.True(t, post.IsPublished())
+}
+
+func TestBroadcastSentAt(t *testing.T) {
+	createdAt := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	startedAt := createdAt.Add(time.Hour)
+	completedAt := createdAt.Add(2 * time.Hour)
+
+	assert.Equal(t, createdAt, BroadcastSentAt(&Broadcast{CreatedAt: createdAt}))
+	assert.Equal(t, startedAt, BroadcastSentAt(&Broadcast{CreatedAt: createdAt, StartedAt: &startedAt}))
+	assert.Equal(t, completedAt, BroadcastSentAt(&Broadcast{CreatedAt: createdAt, StartedAt: &startedAt, CompletedAt: &completedAt}))
+}
+
+func TestBroadcastArchiveVariation(t *testing.T) {
+	variations := []BroadcastVariation{{VariationName: "A", TemplateID: "tplA"}, {VariationName: "B", TemplateID: "tplB"}}
+
+	assert.Nil(t, BroadcastArchiveVariation(&Broadcast{}))
+
+	broadcast := &Broadcast{TestSettings: BroadcastTestSettings{Variations: variations}}
+	assert.Equal(t, "tplA", BroadcastArchiveVariation(broadcast).TemplateID)
+
+	winner := "tplB"
+	broadcast.WinningTemplate = &winner
+	assert.Equal(t, "tplB", BroadcastArchiveVariation(broadcast).TemplateID)
+}
@@ -14,8 +14,13 @@ type NotificationCenterService interface {
 	GetContactPreferences(ctx context.Context, workspaceID string, email string, emailHMAC string) (*ContactPreferencesResponse, error)
 	// UpdateContactPreferences updates a contact's language and/or timezone
 	UpdateContactPreferences(ctx context.Context, req *UpdateContactPreferencesRequest) error
+	// ExportContactData returns everything stored about a contact when the workspace allows self-service exports
+	ExportContactData(ctx context.Context, workspaceID string, email string, emailHMAC string) (*ContactDataExport, error)
 }
 
+// ErrDataExportDisabled is returned when the workspace has not enabled self-service data exports
+var ErrDataExportDisabled = errors.New("data export is disabled for this workspace")
+
 type NotificationCenterRequest struct {
 	Email       string `json:"email"`
 	EmailHMAC   string `json:"email_hmac"`
@@ -55,6 +60,8 @@ type ContactPreferencesResponse struct {
 	ContactLists []*ContactList `json:"contact_lists"`
 	LogoURL      string         `json:"logo_url"`
 	WebsiteURL   string         `json:"website_url"`
+	// DataExportEnabled tells the notification center to show the "download my data" link
+	DataExportEnabled bool `json:"data_export_enabled"`
 }
 
 // UpdateContactPreferencesRequest represents a request to update a contact's language/timezone
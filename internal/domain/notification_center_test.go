@@ -502,3 +502,7 @@ func (m *mockNotificationCenter) GetContactPreferences(_ context.Context, _ stri
 func (m *mockNotificationCenter) UpdateContactPreferences(_ context.Context, _ *UpdateContactPreferencesRequest) error {
 	return nil
 }
+
+func (m *mockNotificationCenter) ExportContactData(_ context.Context, _ string, _ string, _ string) (*ContactDataExport, error) {
+	return nil, nil
+}
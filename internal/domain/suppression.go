@@ -0,0 +1,349 @@
+package domain
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/asaskevich/govalidator"
+)
+
+//go:generate mockgen -destination mocks/mock_suppression_repository.go -package mocks github.com/Notifuse/notifuse/internal/domain SuppressionRepository
+//go:generate mockgen -destination mocks/mock_suppression_service.go -package mocks github.com/Notifuse/notifuse/internal/domain SuppressionService
+
+var (
+	// ErrEmailSuppressed is returned when sending to an address on the suppression list
+	ErrEmailSuppressed = errors.New("email is suppressed")
+	// ErrSuppressionNotFound is returned when deleting a pattern that is not on the suppression list
+	ErrSuppressionNotFound = errors.New("suppression not found")
+)
+
+// MaxSuppressionsPerImport bounds the entries accepted by a single import request
+const MaxSuppressionsPerImport = 10000
+
+// DefaultSoftBounceSuppressionTTL is how long a soft-bouncing address is suppressed
+// before sends are retried, unless it reaches the soft bounce threshold first
+const DefaultSoftBounceSuppressionTTL = 72 * time.Hour
+
+// SuppressionKind tells how a suppression pattern matches recipient addresses
+type SuppressionKind string
+
+const (
+	// SuppressionKindEmail matches one address exactly
+	SuppressionKindEmail SuppressionKind = "email"
+	// SuppressionKindDomain matches every address of a domain
+	SuppressionKindDomain SuppressionKind = "domain"
+	// SuppressionKindWildcard matches addresses against a pattern where * stands for any characters
+	SuppressionKindWildcard SuppressionKind = "wildcard"
+)
+
+// SuppressionReason tells why addresses are suppressed
+type SuppressionReason string
+
+const (
+	SuppressionReasonHardBounce     SuppressionReason = "hard_bounce"
+	SuppressionReasonSoftBounce     SuppressionReason = "soft_bounce"
+	SuppressionReasonComplaint      SuppressionReason = "complaint"
+	SuppressionReasonManual         SuppressionReason = "manual"
+	SuppressionReasonInvalidAddress SuppressionReason = "invalid_address"
+)
+
+// IsValid returns whether the reason is one of the known reasons
+func (r SuppressionReason) IsValid() bool {
+	switch r {
+	case SuppressionReasonHardBounce, SuppressionReasonSoftBounce, SuppressionReasonComplaint, SuppressionReasonManual,
+		SuppressionReasonInvalidAddress:
+		return true
+	}
+	return false
+}
+
+// SuppressionSource tells how an entry was added to the suppression list
+type SuppressionSource string
+
+const (
+	SuppressionSourceAPI          SuppressionSource = "api"
+	SuppressionSourceImport       SuppressionSource = "import"
+	SuppressionSourceProvider     SuppressionSource = "provider"
+	SuppressionSourceVerification SuppressionSource = "verification"
+)
+
+// Suppression blocks every send to the addresses matching its pattern.
+// Entries with an expiry stop applying once it has passed.
+type Suppression struct {
+	Pattern   string            `json:"pattern"`
+	Kind      SuppressionKind   `json:"kind"`
+	Reason    SuppressionReason `json:"reason"`
+	Source    SuppressionSource `json:"source"`
+	Details   string            `json:"details,omitempty"`
+	ExpiresAt *time.Time        `json:"expires_at,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+// IsActive returns whether the suppression applies at the given time
+func (s *Suppression) IsActive(at time.Time) bool {
+	return s.ExpiresAt == nil || s.ExpiresAt.After(at)
+}
+
+// Normalize lowercases the pattern and derives its kind
+func (s *Suppression) Normalize() {
+	s.Pattern = NormalizeEmail(s.Pattern)
+	switch {
+	case strings.Contains(s.Pattern, "*"):
+		s.Kind = SuppressionKindWildcard
+	case strings.Contains(s.Pattern, "@"):
+		s.Kind = SuppressionKindEmail
+	default:
+		s.Kind = SuppressionKindDomain
+	}
+}
+
+// Validate normalizes the entry and checks its pattern and reason
+func (s *Suppression) Validate() error {
+	s.Normalize()
+	if s.Pattern == "" {
+		return fmt.Errorf("pattern is required")
+	}
+	if len(s.Pattern) > 255 {
+		return fmt.Errorf("pattern must be at most 255 characters")
+	}
+
+	switch s.Kind {
+	case SuppressionKindEmail:
+		if !govalidator.IsEmail(s.Pattern) {
+			return fmt.Errorf("invalid email: %s", s.Pattern)
+		}
+	case SuppressionKindDomain:
+		if !govalidator.IsDNSName(s.Pattern) || !strings.Contains(s.Pattern, ".") {
+			return fmt.Errorf("invalid domain: %s", s.Pattern)
+		}
+	case SuppressionKindWildcard:
+		at := strings.LastIndex(s.Pattern, "@")
+		if at < 0 {
+			return fmt.Errorf("wildcard pattern must contain @: %s", s.Pattern)
+		}
+		// A wildcard domain must keep a literal part so one entry cannot block every address
+		if strings.Trim(s.Pattern[at+1:], "*.") == "" {
+			return fmt.Errorf("wildcard pattern must include a domain: %s", s.Pattern)
+		}
+	}
+
+	if s.Reason == "" {
+		s.Reason = SuppressionReasonManual
+	}
+	if !s.Reason.IsValid() {
+		return fmt.Errorf("invalid reason: %s", s.Reason)
+	}
+	if len(s.Details) > 1000 {
+		return fmt.Errorf("details must be at most 1000 characters")
+	}
+
+	return nil
+}
+
+// Matches returns whether the normalized email is blocked by the pattern, ignoring expiry
+func (s *Suppression) Matches(email string) bool {
+	switch s.Kind {
+	case SuppressionKindEmail:
+		return s.Pattern == email
+	case SuppressionKindDomain:
+		at := strings.LastIndex(email, "@")
+		return at >= 0 && email[at+1:] == s.Pattern
+	case SuppressionKindWildcard:
+		return matchWildcard(s.Pattern, email)
+	}
+	return false
+}
+
+// matchWildcard matches value against pattern where * stands for any run of characters
+func matchWildcard(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(value, part)
+		if i < 0 {
+			return false
+		}
+		value = value[i+len(part):]
+	}
+	return len(parts) > 1 && strings.HasSuffix(value, last) || len(parts) == 1 && value == ""
+}
+
+// ListSuppressionsRequest lists the suppression list with optional filters
+type ListSuppressionsRequest struct {
+	WorkspaceID string            `json:"workspace_id"`
+	Kind        SuppressionKind   `json:"kind,omitempty"`
+	Reason      SuppressionReason `json:"reason,omitempty"`
+	Search      string            `json:"search,omitempty"`
+	Limit       int               `json:"limit,omitempty"`
+	Offset      int               `json:"offset,omitempty"`
+}
+
+// FromURLParams parses query parameters into a ListSuppressionsRequest
+func (r *ListSuppressionsRequest) FromURLParams(values url.Values) error {
+	r.WorkspaceID = values.Get("workspace_id")
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	r.Kind = SuppressionKind(values.Get("kind"))
+	r.Reason = SuppressionReason(values.Get("reason"))
+	if r.Reason != "" && !r.Reason.IsValid() {
+		return fmt.Errorf("invalid reason: %s", r.Reason)
+	}
+	r.Search = strings.TrimSpace(values.Get("search"))
+
+	r.Limit = 50
+	if limit := values.Get("limit"); limit != "" {
+		parsed, err := strconv.Atoi(limit)
+		if err != nil || parsed < 1 || parsed > 1000 {
+			return fmt.Errorf("limit must be between 1 and 1000")
+		}
+		r.Limit = parsed
+	}
+	if offset := values.Get("offset"); offset != "" {
+		parsed, err := strconv.Atoi(offset)
+		if err != nil || parsed < 0 {
+			return fmt.Errorf("offset must be a positive number")
+		}
+		r.Offset = parsed
+	}
+
+	return nil
+}
+
+// ListSuppressionsResponse is a page of the suppression list
+type ListSuppressionsResponse struct {
+	Suppressions []*Suppression `json:"suppressions"`
+	TotalCount   int            `json:"total_count"`
+}
+
+// ImportSuppressionsRequest adds or replaces entries of the suppression list
+type ImportSuppressionsRequest struct {
+	WorkspaceID  string         `json:"workspace_id"`
+	Suppressions []*Suppression `json:"suppressions"`
+}
+
+// Validate validates every entry and tags it with the import source
+func (r *ImportSuppressionsRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	if len(r.Suppressions) == 0 {
+		return fmt.Errorf("suppressions is required")
+	}
+	if len(r.Suppressions) > MaxSuppressionsPerImport {
+		return fmt.Errorf("cannot import more than %d suppressions per request", MaxSuppressionsPerImport)
+	}
+
+	for i, suppression := range r.Suppressions {
+		if suppression == nil {
+			return fmt.Errorf("suppression at index %d is empty", i)
+		}
+		if err := suppression.Validate(); err != nil {
+			return fmt.Errorf("invalid suppression at index %d: %w", i, err)
+		}
+		suppression.Source = SuppressionSourceImport
+	}
+
+	return nil
+}
+
+// DeleteSuppressionRequest removes a pattern from the suppression list
+type DeleteSuppressionRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	Pattern     string `json:"pattern"`
+}
+
+// Validate normalizes the pattern
+func (r *DeleteSuppressionRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	r.Pattern = NormalizeEmail(r.Pattern)
+	if r.Pattern == "" {
+		return fmt.Errorf("pattern is required")
+	}
+	return nil
+}
+
+// CheckSuppressionResponse explains why an address is blocked
+type CheckSuppressionResponse struct {
+	Email      string `json:"email"`
+	Suppressed bool   `json:"suppressed"`
+	// Matches lists the active entries blocking the address
+	Matches []*Suppression `json:"matches"`
+	// Erased is true when the contact was erased and can no longer be recreated
+	Erased bool `json:"erased"`
+}
+
+// suppressionCSVHeader is the header row of suppression exports
+var suppressionCSVHeader = []string{"pattern", "kind", "reason", "source", "details", "expires_at", "created_at"}
+
+// WriteSuppressionsCSV writes suppressions as CSV with a header row
+func WriteSuppressionsCSV(w io.Writer, suppressions []*Suppression) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(suppressionCSVHeader); err != nil {
+		return fmt.Errorf("failed to write csv header: %w", err)
+	}
+	for _, s := range suppressions {
+		expiresAt := ""
+		if s.ExpiresAt != nil {
+			expiresAt = s.ExpiresAt.UTC().Format(time.RFC3339)
+		}
+		record := []string{s.Pattern, string(s.Kind), string(s.Reason), string(s.Source), s.Details, expiresAt, s.CreatedAt.UTC().Format(time.RFC3339)}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write csv record: %w", err)
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// DescribeSuppressions summarizes matching entries for error messages
+func DescribeSuppressions(suppressions []*Suppression) string {
+	descriptions := make([]string, len(suppressions))
+	for i, s := range suppressions {
+		descriptions[i] = fmt.Sprintf("%s (%s)", s.Pattern, s.Reason)
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// SuppressionRepository stores the workspace suppression list
+type SuppressionRepository interface {
+	// Upsert adds entries or replaces entries with the same pattern.
+	// A temporary entry never replaces a permanent one.
+	Upsert(ctx context.Context, workspaceID string, suppressions []*Suppression) error
+
+	// Delete removes a pattern, returning ErrSuppressionNotFound if it is not listed
+	Delete(ctx context.Context, workspaceID string, pattern string) error
+
+	// List returns a page of entries ordered by creation date, and the total count.
+	// A zero limit returns every entry.
+	List(ctx context.Context, req *ListSuppressionsRequest) ([]*Suppression, int, error)
+
+	// FindMatches returns the active entries blocking each of the given normalized emails.
+	// Emails without a match are absent from the result.
+	FindMatches(ctx context.Context, workspaceID string, emails []string) (map[string][]*Suppression, error)
+}
+
+// SuppressionService manages the workspace suppression list
+type SuppressionService interface {
+	ListSuppressions(ctx context.Context, req *ListSuppressionsRequest) (*ListSuppressionsResponse, error)
+	ImportSuppressions(ctx context.Context, req *ImportSuppressionsRequest) error
+	DeleteSuppression(ctx context.Context, req *DeleteSuppressionRequest) error
+	// ExportSuppressions writes the whole suppression list as CSV
+	ExportSuppressions(ctx context.Context, workspaceID string, w io.Writer) error
+	// CheckEmail explains why an address is blocked
+	CheckEmail(ctx context.Context, workspaceID string, email string) (*CheckSuppressionResponse, error)
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuppression_Validate(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		wantKind SuppressionKind
+		wantErr  string
+	}{
+		{" John@Example.COM ", SuppressionKindEmail, ""},
+		{"example.com", SuppressionKindDomain, ""},
+		{"*@example.com", SuppressionKindWildcard, ""},
+		{"bounce-*@*.example.com", SuppressionKindWildcard, ""},
+		{"", "", "pattern is required"},
+		{"not an email@", SuppressionKindEmail, "invalid email"},
+		{"localhost", SuppressionKindDomain, "invalid domain"},
+		{"john*", SuppressionKindWildcard, "must contain @"},
+		{"*@*", SuppressionKindWildcard, "must include a domain"},
+		{"*@*.*", SuppressionKindWildcard, "must include a domain"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.pattern, func(t *testing.T) {
+			s := &Suppression{Pattern: tc.pattern}
+			err := s.Validate()
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantKind, s.Kind)
+			assert.Equal(t, strings.ToLower(strings.TrimSpace(tc.pattern)), s.Pattern)
+			assert.Equal(t, SuppressionReasonManual, s.Reason)
+		})
+	}
+
+	t.Run("rejects unknown reasons", func(t *testing.T) {
+		s := &Suppression{Pattern: "john@example.com", Reason: "spam"}
+		assert.ErrorContains(t, s.Validate(), "invalid reason")
+	})
+}
+
+func TestSuppression_Matches(t *testing.T) {
+	tests := []struct {
+		pattern string
+		email   string
+		want    bool
+	}{
+		{"john@example.com", "john@example.com", true},
+		{"john@example.com", "jane@example.com", false},
+		{"example.com", "john@example.com", true},
+		{"example.com", "john@sub.example.com", false},
+		{"*@example.com", "john@example.com", true},
+		{"*@example.com", "john@example.org", false},
+		{"bounce-*@*.example.com", "bounce-42@mail.example.com", true},
+		{"bounce-*@*.example.com", "bounce-42@example.com", false},
+		{"john_*@example.com", "johnx@example.com", false},
+	}
+
+	for _, tc := range tests {
+		s := &Suppression{Pattern: tc.pattern}
+		s.Normalize()
+		assert.Equal(t, tc.want, s.Matches(tc.email), "%s ~ %s", tc.pattern, tc.email)
+	}
+}
+
+func TestSuppression_IsActive(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	assert.True(t, (&Suppression{}).IsActive(now))
+	assert.True(t, (&Suppression{ExpiresAt: &future}).IsActive(now))
+	assert.False(t, (&Suppression{ExpiresAt: &past}).IsActive(now))
+}
+
+func TestListSuppressionsRequest_FromURLParams(t *testing.T) {
+	var req ListSuppressionsRequest
+	require.NoError(t, req.FromURLParams(url.Values{
+		"workspace_id": {"ws1"},
+		"reason":       {"complaint"},
+		"search":       {" example "},
+		"limit":        {"20"},
+		"offset":       {"40"},
+	}))
+	assert.Equal(t, ListSuppressionsRequest{WorkspaceID: "ws1", Reason: SuppressionReasonComplaint, Search: "example", Limit: 20, Offset: 40}, req)
+
+	req = ListSuppressionsRequest{}
+	require.NoError(t, req.FromURLParams(url.Values{"workspace_id": {"ws1"}}))
+	assert.Equal(t, 50, req.Limit)
+
+	assert.Error(t, (&ListSuppressionsRequest{}).FromURLParams(url.Values{}))
+	assert.Error(t, (&ListSuppressionsRequest{}).FromURLParams(url.Values{"workspace_id": {"ws1"}, "reason": {"spam"}}))
+	assert.Error(t, (&ListSuppressionsRequest{}).FromURLParams(url.Values{"workspace_id": {"ws1"}, "limit": {"5000"}}))
+}
+
+func TestImportSuppressionsRequest_Validate(t *testing.T) {
+	req := &ImportSuppressionsRequest{
+		WorkspaceID: "ws1",
+		Suppressions: []*Suppression{
+			{Pattern: "John@Example.com", Reason: SuppressionReasonComplaint, Source: SuppressionSourceAPI},
+		},
+	}
+	require.NoError(t, req.Validate())
+	assert.Equal(t, "john@example.com", req.Suppressions[0].Pattern)
+	assert.Equal(t, SuppressionSourceImport, req.Suppressions[0].Source)
+
+	req.Suppressions = append(req.Suppressions, &Suppression{Pattern: "localhost"})
+	assert.ErrorContains(t, req.Validate(), "invalid suppression at index 1")
+
+	assert.ErrorContains(t, (&ImportSuppressionsRequest{WorkspaceID: "ws1"}).Validate(), "suppressions is required")
+}
+
+func TestWriteSuppressionsCSV(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiresAt := createdAt.Add(DefaultSoftBounceSuppressionTTL)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteSuppressionsCSV(&buf, []*Suppression{
+		{Pattern: "john@example.com", Kind: SuppressionKindEmail, Reason: SuppressionReasonSoftBounce, Source: SuppressionSourceProvider, Details: "mailbox full, retry", ExpiresAt: &expiresAt, CreatedAt: createdAt},
+	}))
+
+	assert.Equal(t, "pattern,kind,reason,source,details,expires_at,created_at\n"+
+		"john@example.com,email,soft_bounce,provider,\"mailbox full, retry\",2024-01-04T00:00:00Z,2024-01-01T00:00:00Z\n", buf.String())
+}
@@ -59,9 +59,12 @@ type TaskState struct {
 	Message  string  `json:"message,omitempty"`
 
 	// Specialized states for different task types - only one will be used based on task type
-	SendBroadcast   *SendBroadcastState   `json:"send_broadcast,omitempty"`
-	BuildSegment    *BuildSegmentState    `json:"build_segment,omitempty"`
-	IntegrationSync *IntegrationSyncState `json:"integration_sync,omitempty"`
+	SendBroadcast        *SendBroadcastState        `json:"send_broadcast,omitempty"`
+	BuildSegment         *BuildSegmentState         `json:"build_segment,omitempty"`
+	IntegrationSync      *IntegrationSyncState      `json:"integration_sync,omitempty"`
+	EraseContacts        *EraseContactsState        `json:"erase_contacts,omitempty"`
+	BulkContactOperation *BulkContactOperationState `json:"bulk_contact_operation,omitempty"`
+	RecurringBroadcast   *RecurringBroadcastState   `json:"recurring_broadcast,omitempty"`
 }
 
 // Value implements the driver.Valuer interface for TaskState
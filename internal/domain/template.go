@@ -127,6 +127,11 @@ type Template struct {
 	CreatedAt       time.Time                      `json:"created_at"`
 	UpdatedAt       time.Time                      `json:"updated_at"`
 	DeletedAt       *time.Time                     `json:"deleted_at,omitempty"`
+	// CreatedBy is the member who saved this version
+	CreatedBy *string `json:"created_by,omitempty"`
+	// PublishedAt is set when this version was published, sends use the last published version
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	PublishedBy *string    `json:"published_by,omitempty"`
 }
 
 // ResolveEmailContent returns the EmailTemplate for the given contact language.
@@ -757,9 +762,24 @@ type TemplateService interface {
 	// CreateTemplate creates a new template
 	CreateTemplate(ctx context.Context, workspaceID string, template *Template) error
 
-	// GetTemplateByID retrieves a template by ID and optional version
+	// GetTemplateByID retrieves a template by ID and optional version, the latest draft by default
 	GetTemplateByID(ctx context.Context, workspaceID string, id string, version int64) (*Template, error)
 
+	// GetPublishedTemplate retrieves the version of a template used for sends
+	GetPublishedTemplate(ctx context.Context, workspaceID string, id string) (*Template, error)
+
+	// GetTemplateVersions lists the saved versions of a template, newest first
+	GetTemplateVersions(ctx context.Context, workspaceID string, id string) ([]*TemplateVersion, error)
+
+	// DiffTemplateVersions compares two versions of a template
+	DiffTemplateVersions(ctx context.Context, workspaceID string, id string, from int64, to int64) (*TemplateVersionDiff, error)
+
+	// PublishTemplate makes a version of a template live, the latest one when version is 0
+	PublishTemplate(ctx context.Context, workspaceID string, id string, version int64) (*Template, error)
+
+	// RollbackTemplate saves a prior version as a new version and publishes it
+	RollbackTemplate(ctx context.Context, workspaceID string, id string, version int64) (*Template, error)
+
 	// GetTemplates retrieves all templates
 	GetTemplates(ctx context.Context, workspaceID string, category string, channel string) ([]*Template, error)
 
@@ -771,11 +791,17 @@ type TemplateService interface {
 
 	// CompileTemplate compiles a visual editor tree to MJML and HTML
 	CompileTemplate(ctx context.Context, payload CompileTemplateRequest) (*CompileTemplateResponse, error) // Use notifuse_mjml.EmailBlock
+
+	// LintTemplate runs the spam and deliverability checks over a template version, the latest one when version is 0
+	LintTemplate(ctx context.Context, workspaceID string, id string, version int64) (*TemplateLintReport, error)
+
+	// TranslateTemplate proposes LLM translations of a template version for review, without saving them
+	TranslateTemplate(ctx context.Context, req *TranslateTemplateRequest) (*TranslateTemplateResponse, error)
 }
 
 // TemplateRepository provides database operations for templates
 type TemplateRepository interface {
-	// CreateTemplate creates a new template in the database
+	// CreateTemplate creates a new template in the database, its first version is published
 	CreateTemplate(ctx context.Context, workspaceID string, template *Template) error
 
 	// GetTemplateByID retrieves a template by its ID and optional version
@@ -790,6 +816,15 @@ type TemplateRepository interface {
 	// UpdateTemplate updates an existing template, creating a new version
 	UpdateTemplate(ctx context.Context, workspaceID string, template *Template) error
 
+	// GetPublishedTemplate retrieves the last published version of a template
+	GetPublishedTemplate(ctx context.Context, workspaceID string, id string) (*Template, error)
+
+	// GetTemplateVersions lists the versions of a template, newest first
+	GetTemplateVersions(ctx context.Context, workspaceID string, id string) ([]*TemplateVersion, error)
+
+	// PublishTemplate marks a version of a template as published
+	PublishTemplate(ctx context.Context, workspaceID string, id string, version int64, publishedBy string) error
+
 	// DeleteTemplate deletes a template
 	DeleteTemplate(ctx context.Context, workspaceID string, id string) error
 }
@@ -822,6 +857,7 @@ type TemplateDataRequest struct {
 	ProvidedData        MapOfAny                       `json:"provided_data,omitempty"`
 	TrackingSettings    notifuse_mjml.TrackingSettings `json:"tracking_settings"`
 	Broadcast           *Broadcast                     `json:"broadcast,omitempty"`
+	TemplateSecrets     TemplateSecrets                `json:"-"`
 }
 
 // Validate ensures that the template data request has all required fields
@@ -961,6 +997,11 @@ func BuildTemplateData(req TemplateDataRequest) (MapOfAny, error) {
 		templateData["global_feed"] = req.Broadcast.DataFeed.GlobalFeedData
 	}
 
+	// Add the posts of a blog digest broadcast
+	if req.Broadcast != nil && req.Broadcast.DataFeed != nil && req.Broadcast.DataFeed.BlogDigestPosts != nil {
+		templateData["posts"] = req.Broadcast.DataFeed.BlogDigestPosts
+	}
+
 	// Expose workspace URLs for composing links from relative paths. Trailing slashes are
 	// trimmed so templates can write "{{ workspace.base_url }}/path".
 	//   - base_url: the tracking endpoint (resolved CustomEndpointURL, or API endpoint fallback),
@@ -988,5 +1029,11 @@ func BuildTemplateData(req TemplateDataRequest) (MapOfAny, error) {
 
 	templateData["tracking_opens_url"] = trackingPixelURL
 
+	// Signed link to the hosted web version of the message
+	templateData["web_view_url"] = BuildWebViewURL(req.TrackingSettings.Endpoint, req.WorkspaceID, req.MessageID, req.WorkspaceSecretKey)
+
+	// Signing keys of the hmac and sign_url filters
+	req.TemplateSecrets.Apply(templateData)
+
 	return templateData, nil
 }
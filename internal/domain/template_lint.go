@@ -0,0 +1,767 @@
+package domain
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/Notifuse/notifuse/pkg/notifuse_mjml"
+	"golang.org/x/net/html"
+)
+
+// TemplateLintThreshold is the score from which an email is considered likely to be
+// filtered as spam, like the default required_score of SpamAssassin
+const TemplateLintThreshold = 5.0
+
+// GmailClippingSize is the HTML size above which Gmail clips messages behind a
+// "View entire message" link, hiding the content and the open tracking pixel
+const GmailClippingSize = 102 * 1024
+
+// gmailClippingWarningSize leaves room for the tracked links and the per contact data
+const gmailClippingWarningSize = 90 * 1024
+
+type TemplateLintSeverity string
+
+const (
+	TemplateLintSeverityError   TemplateLintSeverity = "error"
+	TemplateLintSeverityWarning TemplateLintSeverity = "warning"
+	TemplateLintSeverityInfo    TemplateLintSeverity = "info"
+)
+
+// Lint rules
+const (
+	TemplateLintRuleCompileError         = "compile_error"
+	TemplateLintRuleSpamPhrase           = "spam_phrase"
+	TemplateLintRuleSubjectAllCaps       = "subject_all_caps"
+	TemplateLintRuleSubjectExclamation   = "subject_exclamation"
+	TemplateLintRuleExcessiveCaps        = "excessive_caps"
+	TemplateLintRuleExcessivePunctuation = "excessive_punctuation"
+	TemplateLintRuleImageTextRatio       = "image_text_ratio"
+	TemplateLintRuleMissingAlt           = "missing_alt"
+	TemplateLintRuleBrokenLink           = "broken_link"
+	TemplateLintRuleUnsafeLink           = "unsafe_link"
+	TemplateLintRuleInsecureLink         = "insecure_link"
+	TemplateLintRuleNumericIPLink        = "numeric_ip_link"
+	TemplateLintRuleURLShortener         = "url_shortener"
+	TemplateLintRuleMismatchedLink       = "mismatched_link"
+	TemplateLintRuleGmailClipping        = "gmail_clipping"
+	TemplateLintRuleMissingUnsubscribe   = "missing_unsubscribe"
+	TemplateLintRuleUndefinedVariable    = "undefined_variable"
+)
+
+// TemplateLintFinding is a problem found in a template. BlockID points to the block of the
+// visual editor tree it was found in, Field to a field of the template otherwise.
+type TemplateLintFinding struct {
+	Rule     string               `json:"rule"`
+	Severity TemplateLintSeverity `json:"severity"`
+	Score    float64              `json:"score"`
+	Message  string               `json:"message"`
+	BlockID  string               `json:"block_id,omitempty"`
+	Field    string               `json:"field,omitempty"`
+}
+
+// TemplateLintReport sums the scores of the findings. An email passes when it has no error
+// and scores below the threshold.
+type TemplateLintReport struct {
+	TemplateID string                `json:"template_id"`
+	Version    int64                 `json:"version"`
+	Score      float64               `json:"score"`
+	Threshold  float64               `json:"threshold"`
+	Passed     bool                  `json:"passed"`
+	HTMLSize   int                   `json:"html_size"`
+	Findings   []TemplateLintFinding `json:"findings"`
+}
+
+// templateLintSpamPhrases are phrases commonly weighted by content filters
+var templateLintSpamPhrases = map[string]float64{
+	"100% free": 1.5, "act now": 1.0, "apply now": 0.5, "as seen on": 1.0, "buy now": 1.0,
+	"call now": 1.0, "cash bonus": 1.5, "click below": 0.5, "click here": 0.5, "congratulations": 0.5,
+	"double your": 1.5, "earn money": 1.5, "extra cash": 1.5, "free gift": 1.0, "free money": 2.0,
+	"guaranteed": 0.5, "limited time": 0.5, "lowest price": 1.0, "make money": 1.5, "miracle": 1.0,
+	"no credit check": 2.0, "no risk": 1.0, "once in a lifetime": 1.0, "order now": 1.0,
+	"risk-free": 1.0, "risk free": 1.0, "urgent": 0.5, "winner": 1.0, "you have been selected": 1.5,
+	"100% satisfied": 1.0, "million dollars": 2.0, "work from home": 1.5, "$$$": 1.5,
+}
+
+var templateLintSpamPhraseRegexps = func() map[string]*regexp.Regexp {
+	regexps := make(map[string]*regexp.Regexp, len(templateLintSpamPhrases))
+	for phrase := range templateLintSpamPhrases {
+		pattern := regexp.QuoteMeta(phrase)
+		if unicode.IsLetter(rune(phrase[0])) || unicode.IsDigit(rune(phrase[0])) {
+			pattern = `\b` + pattern
+		}
+		if last := rune(phrase[len(phrase)-1]); unicode.IsLetter(last) || unicode.IsDigit(last) {
+			pattern += `\b`
+		}
+		regexps[phrase] = regexp.MustCompile(`(?i)` + pattern)
+	}
+	return regexps
+}()
+
+// templateLintURLShorteners hide the destination of links and are often blocklisted
+var templateLintURLShorteners = map[string]bool{
+	"bit.ly": true, "tinyurl.com": true, "goo.gl": true, "t.co": true, "ow.ly": true,
+	"is.gd": true, "buff.ly": true, "rebrand.ly": true, "cutt.ly": true, "shorturl.at": true,
+}
+
+// templateLintPlatformVariables are the Liquid variables provided at send time, see BuildTemplateData
+var templateLintPlatformVariables = map[string]bool{
+	"contact": true, "broadcast": true, "list": true, "workspace": true, "message_id": true,
+	"unsubscribe_url": true, "oneclick_unsubscribe_url": true, "confirm_subscription_url": true,
+	"notification_center_url": true, "tracking_opens_url": true, "web_view_url": true, "global_feed": true, "posts": true,
+	"utm_source": true, "utm_medium": true, "utm_campaign": true, "utm_term": true, "utm_content": true,
+	"forloop": true, "tablerowloop": true,
+}
+
+var templateLintLiquidKeywords = map[string]bool{
+	"and": true, "or": true, "not": true, "contains": true, "in": true, "true": true, "false": true,
+	"nil": true, "null": true, "empty": true, "blank": true, "reversed": true, "limit": true,
+	"offset": true, "with": true, "as": true, "by": true, "cols": true,
+}
+
+var (
+	liquidCommentRegexp  = regexp.MustCompile(`(?s)\{%-?\s*(comment|raw)\s*-?%\}.*?\{%-?\s*end(comment|raw)\s*-?%\}`)
+	liquidTagRegexp      = regexp.MustCompile(`(?s)\{\{-?(.*?)-?\}\}|\{%-?(.*?)-?%\}`)
+	liquidStringRegexp   = regexp.MustCompile(`"[^"]*"|'[^']*'`)
+	liquidVariableRegexp = regexp.MustCompile(`[A-Za-z_][\w-]*(?:\.[A-Za-z_][\w-]*|\[[^\]]*\])*`)
+	liquidIndexRegexp    = regexp.MustCompile(`\[([^\]]*)\]`)
+	unsubscribeRegexp    = regexp.MustCompile(`(?i)unsubscribe|notification_center_url`)
+)
+
+type templateLinter struct {
+	report   *TemplateLintReport
+	testData MapOfAny
+	locals   map[string]bool
+	seen     map[string]bool
+}
+
+// LintTemplate runs the lint rules over a template and its compilation: content heuristics,
+// image to text ratio, alt text, links, Gmail clipping, unsubscribe link in marketing emails
+// and Liquid variables missing from the test data.
+func LintTemplate(template *Template, compiled *CompileTemplateResponse) *TemplateLintReport {
+	compiledHTML := ""
+	if compiled != nil && compiled.HTML != nil {
+		compiledHTML = *compiled.HTML
+	}
+
+	l := &templateLinter{
+		report: &TemplateLintReport{
+			TemplateID: template.ID,
+			Version:    template.Version,
+			Threshold:  TemplateLintThreshold,
+			HTMLSize:   len(compiledHTML),
+			Findings:   []TemplateLintFinding{},
+		},
+		testData: template.TestData,
+		locals:   map[string]bool{},
+		seen:     map[string]bool{},
+	}
+
+	if email := template.Email; email != nil {
+		sources := l.collectSources(email)
+		for _, source := range sources {
+			collectLiquidLocals(source.value, l.locals)
+		}
+		for _, source := range sources {
+			l.lintLiquid(source.value, source.blockID, source.field)
+		}
+
+		l.lintSubject(email.Subject)
+		if email.SubjectPreview != nil {
+			l.lintText(*email.SubjectPreview, "", "email.subject_preview")
+		}
+		if mjmlSource := email.GetCodeModeMjmlSource(); mjmlSource != nil {
+			l.lintMarkup(*mjmlSource, "", "email.mjml_source")
+		} else if email.VisualEditorTree != nil {
+			l.lintBlock(email.VisualEditorTree)
+		}
+
+		l.lintUnsubscribe(template.Category, sources)
+	}
+
+	if compiled != nil && !compiled.Success {
+		message := "The template does not compile"
+		if compiled.Error != nil {
+			message = compiled.Error.Message
+		}
+		l.add(TemplateLintRuleCompileError, TemplateLintSeverityError, 0, "", "", message)
+	}
+	if compiledHTML != "" {
+		l.lintCompiledHTML(compiledHTML)
+	}
+
+	for _, finding := range l.report.Findings {
+		l.report.Score += finding.Score
+	}
+	l.report.Passed = l.report.Score < TemplateLintThreshold
+	for _, finding := range l.report.Findings {
+		if finding.Severity == TemplateLintSeverityError {
+			l.report.Passed = false
+		}
+	}
+
+	return l.report
+}
+
+// AddFinding records a finding once per rule, location and message
+func (r *TemplateLintReport) AddFinding(finding TemplateLintFinding) {
+	for _, existing := range r.Findings {
+		if existing.Rule == finding.Rule && existing.BlockID == finding.BlockID &&
+			existing.Field == finding.Field && existing.Message == finding.Message {
+			return
+		}
+	}
+	r.Findings = append(r.Findings, finding)
+}
+
+func (l *templateLinter) add(rule string, severity TemplateLintSeverity, score float64, blockID, field, message string) {
+	l.report.AddFinding(TemplateLintFinding{
+		Rule:     rule,
+		Severity: severity,
+		Score:    score,
+		Message:  message,
+		BlockID:  blockID,
+		Field:    field,
+	})
+}
+
+type templateLintSource struct {
+	value   string
+	blockID string
+	field   string
+}
+
+// collectSources lists the strings of the email that may contain Liquid
+func (l *templateLinter) collectSources(email *EmailTemplate) []templateLintSource {
+	sources := []templateLintSource{{value: email.Subject, field: "email.subject"}}
+	if email.SubjectPreview != nil {
+		sources = append(sources, templateLintSource{value: *email.SubjectPreview, field: "email.subject_preview"})
+	}
+	if email.Text != nil {
+		sources = append(sources, templateLintSource{value: *email.Text, field: "email.text"})
+	}
+	if mjmlSource := email.GetCodeModeMjmlSource(); mjmlSource != nil {
+		return append(sources, templateLintSource{value: *mjmlSource, field: "email.mjml_source"})
+	}
+
+	var walk func(block notifuse_mjml.EmailBlock)
+	walk = func(block notifuse_mjml.EmailBlock) {
+		if block == nil {
+			return
+		}
+		if content := block.GetContent(); content != nil {
+			sources = append(sources, templateLintSource{value: *content, blockID: block.GetID()})
+		}
+		keys := make([]string, 0, len(block.GetAttributes()))
+		for key := range block.GetAttributes() {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if value, ok := block.GetAttributes()[key].(string); ok {
+				sources = append(sources, templateLintSource{value: value, blockID: block.GetID()})
+			}
+		}
+		for _, child := range block.GetChildren() {
+			walk(child)
+		}
+	}
+	walk(email.VisualEditorTree)
+	return sources
+}
+
+func (l *templateLinter) lintSubject(subject string) {
+	letters, upper := 0, 0
+	for _, r := range subject {
+		if unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+	if letters >= 10 && upper == letters {
+		l.add(TemplateLintRuleSubjectAllCaps, TemplateLintSeverityWarning, 1.5, "", "email.subject",
+			"Subject is written in capital letters")
+	}
+	if strings.Count(subject, "!") > 1 {
+		l.add(TemplateLintRuleSubjectExclamation, TemplateLintSeverityWarning, 1.0, "", "email.subject",
+			"Subject contains several exclamation marks")
+	}
+	l.lintText(subject, "", "email.subject")
+}
+
+// lintText applies the content heuristics to visible text
+func (l *templateLinter) lintText(text, blockID, field string) {
+	phrases := make([]string, 0, len(templateLintSpamPhrases))
+	for phrase := range templateLintSpamPhrases {
+		phrases = append(phrases, phrase)
+	}
+	sort.Strings(phrases)
+	for _, phrase := range phrases {
+		if templateLintSpamPhraseRegexps[phrase].MatchString(text) {
+			l.add(TemplateLintRuleSpamPhrase, TemplateLintSeverityWarning, templateLintSpamPhrases[phrase], blockID, field,
+				fmt.Sprintf("Contains the spam trigger phrase %q", phrase))
+		}
+	}
+
+	if strings.Contains(text, "!!!") || strings.Contains(text, "???") {
+		l.add(TemplateLintRuleExcessivePunctuation, TemplateLintSeverityWarning, 0.5, blockID, field,
+			"Contains repeated exclamation or question marks")
+	}
+
+	words, capsWords := 0, 0
+	for _, word := range strings.FieldsFunc(text, func(r rune) bool { return !unicode.IsLetter(r) }) {
+		if len([]rune(word)) < 4 {
+			continue
+		}
+		words++
+		if strings.ToUpper(word) == word && strings.ToLower(word) != word {
+			capsWords++
+		}
+	}
+	if words >= 10 && capsWords*10 > words*3 {
+		l.add(TemplateLintRuleExcessiveCaps, TemplateLintSeverityWarning, 1.0, blockID, field,
+			fmt.Sprintf("%d of %d words are written in capital letters", capsWords, words))
+	}
+}
+
+// lintBlock checks the blocks of the visual editor tree
+func (l *templateLinter) lintBlock(block notifuse_mjml.EmailBlock) {
+	if block == nil {
+		return
+	}
+	id := block.GetID()
+	attributes := block.GetAttributes()
+	attribute := func(key string) (string, bool) {
+		value, ok := attributes[key].(string)
+		return strings.TrimSpace(value), ok
+	}
+
+	switch block.GetType() {
+	case notifuse_mjml.MJMLComponentMjImage:
+		if alt, _ := attribute("alt"); alt == "" {
+			l.add(TemplateLintRuleMissingAlt, TemplateLintSeverityWarning, 0.5, id, "",
+				"Image has no alt text, shown when images are blocked and read by screen readers")
+		}
+		if href, ok := attribute("href"); ok && href != "" {
+			l.lintLink(href, "", id, "")
+		}
+	case notifuse_mjml.MJMLComponentMjButton, notifuse_mjml.MJMLComponentMjSocialElement:
+		if href, ok := attribute("href"); ok {
+			text := ""
+			if content := block.GetContent(); content != nil {
+				text = htmlVisibleText(*content)
+			}
+			l.lintLink(href, text, id, "")
+		}
+	}
+
+	if content := block.GetContent(); content != nil && *content != "" {
+		switch block.GetType() {
+		case notifuse_mjml.MJMLComponentMjPreview:
+			l.lintText(*content, id, "")
+		case notifuse_mjml.MJMLComponentMjStyle, notifuse_mjml.MJMLComponentMjAttributes:
+		default:
+			l.lintMarkup(*content, id, "")
+		}
+	}
+
+	for _, child := range block.GetChildren() {
+		l.lintBlock(child)
+	}
+}
+
+// lintMarkup checks the text, images and links of HTML or MJML markup
+func (l *templateLinter) lintMarkup(markup, blockID, field string) {
+	doc, err := html.Parse(strings.NewReader(markup))
+	if err != nil {
+		return
+	}
+
+	var text strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		switch n.Type {
+		case html.TextNode:
+			text.WriteString(n.Data)
+			text.WriteByte(' ')
+		case html.ElementNode:
+			switch n.Data {
+			case "style", "script", "mj-style", "mj-attributes":
+				return
+			case "img", "mj-image":
+				if alt, ok := nodeAttr(n, "alt"); !ok || strings.TrimSpace(alt) == "" {
+					l.add(TemplateLintRuleMissingAlt, TemplateLintSeverityWarning, 0.5, blockID, field,
+						fmt.Sprintf("Image %s has no alt text, shown when images are blocked and read by screen readers", nodeAttrValue(n, "src")))
+				}
+			}
+			if href, ok := nodeAttr(n, "href"); ok && (n.Data == "a" || strings.HasPrefix(n.Data, "mj-")) {
+				l.lintLink(href, htmlNodeText(n), blockID, field)
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	l.lintText(text.String(), blockID, field)
+}
+
+// lintLink checks the scheme and destination of a link. Liquid placeholders are resolved at send time.
+func (l *templateLinter) lintLink(href, text, blockID, field string) {
+	href = strings.TrimSpace(href)
+	if strings.Contains(href, "{{") || strings.Contains(href, "{%") {
+		return
+	}
+	if href == "" || href == "#" {
+		l.add(TemplateLintRuleBrokenLink, TemplateLintSeverityError, 1.0, blockID, field, "Link has no destination")
+		return
+	}
+	if strings.HasPrefix(href, "#") {
+		return
+	}
+
+	parsed, err := url.Parse(href)
+	if err != nil {
+		l.add(TemplateLintRuleBrokenLink, TemplateLintSeverityError, 1.0, blockID, field,
+			fmt.Sprintf("Link %q is not a valid URL", href))
+		return
+	}
+
+	switch strings.ToLower(parsed.Scheme) {
+	case "https":
+	case "http":
+		l.add(TemplateLintRuleInsecureLink, TemplateLintSeverityInfo, 0.1, blockID, field,
+			fmt.Sprintf("Link %s does not use HTTPS", href))
+	case "mailto", "tel", "sms":
+		return
+	case "javascript", "data", "vbscript", "file":
+		l.add(TemplateLintRuleUnsafeLink, TemplateLintSeverityError, 2.5, blockID, field,
+			fmt.Sprintf("Link uses the unsafe %s: scheme, blocked by email clients", strings.ToLower(parsed.Scheme)))
+		return
+	case "":
+		l.add(TemplateLintRuleBrokenLink, TemplateLintSeverityError, 1.0, blockID, field,
+			fmt.Sprintf("Link %q is relative, it needs an absolute URL in an email", href))
+		return
+	default:
+		return
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	if host == "" {
+		l.add(TemplateLintRuleBrokenLink, TemplateLintSeverityError, 1.0, blockID, field,
+			fmt.Sprintf("Link %q has no host", href))
+		return
+	}
+	if net.ParseIP(host) != nil {
+		l.add(TemplateLintRuleNumericIPLink, TemplateLintSeverityWarning, 2.0, blockID, field,
+			fmt.Sprintf("Link %s points to an IP address instead of a domain", href))
+	}
+	if templateLintURLShorteners[strings.TrimPrefix(host, "www.")] {
+		l.add(TemplateLintRuleURLShortener, TemplateLintSeverityWarning, 1.5, blockID, field,
+			fmt.Sprintf("Link %s uses the URL shortener %s", href, host))
+	}
+
+	// A link whose text shows another domain than its destination looks like phishing
+	text = strings.TrimSpace(text)
+	if text != "" && !strings.ContainsAny(text, " \t\n") && strings.Contains(text, ".") {
+		shown := text
+		if !strings.Contains(shown, "://") {
+			shown = "https://" + shown
+		}
+		if shownURL, err := url.Parse(shown); err == nil && strings.Contains(shownURL.Hostname(), ".") {
+			shownHost := strings.TrimPrefix(strings.ToLower(shownURL.Hostname()), "www.")
+			if shownHost != strings.TrimPrefix(host, "www.") && !strings.HasSuffix(host, "."+shownHost) {
+				l.add(TemplateLintRuleMismatchedLink, TemplateLintSeverityWarning, 2.0, blockID, field,
+					fmt.Sprintf("Link text shows %s but the link goes to %s", shownHost, host))
+			}
+		}
+	}
+}
+
+// lintUnsubscribe requires marketing emails to offer a way to unsubscribe
+func (l *templateLinter) lintUnsubscribe(category string, sources []templateLintSource) {
+	if category != string(TemplateCategoryMarketing) && category != string(TemplateCategoryBlog) {
+		return
+	}
+	for _, source := range sources {
+		if unsubscribeRegexp.MatchString(source.value) {
+			return
+		}
+	}
+	l.add(TemplateLintRuleMissingUnsubscribe, TemplateLintSeverityError, 2.0, "", "",
+		"Marketing emails must contain an unsubscribe link, such as {{ unsubscribe_url }}")
+}
+
+// lintCompiledHTML checks the size and the image to text ratio of the email sent
+func (l *templateLinter) lintCompiledHTML(compiledHTML string) {
+	size := len(compiledHTML)
+	switch {
+	case size > GmailClippingSize:
+		l.add(TemplateLintRuleGmailClipping, TemplateLintSeverityError, 1.0, "", "",
+			fmt.Sprintf("The HTML weighs %d KB, Gmail clips messages above 102 KB", size/1024))
+	case size > gmailClippingWarningSize:
+		l.add(TemplateLintRuleGmailClipping, TemplateLintSeverityWarning, 0.5, "", "",
+			fmt.Sprintf("The HTML weighs %d KB, close to the 102 KB above which Gmail clips messages", size/1024))
+	}
+
+	doc, err := html.Parse(strings.NewReader(compiledHTML))
+	if err != nil {
+		return
+	}
+	images := 0
+	var text strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "head", "style", "script":
+				return
+			case "img":
+				images++
+			}
+			if style := strings.ReplaceAll(strings.ToLower(nodeAttrValue(n, "style")), " ", ""); strings.Contains(style, "display:none") {
+				return
+			}
+		}
+		if n.Type == html.TextNode {
+			text.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	textLength := len([]rune(strings.Join(strings.Fields(text.String()), " ")))
+	switch {
+	case images > 0 && textLength < 100:
+		l.add(TemplateLintRuleImageTextRatio, TemplateLintSeverityWarning, 2.0, "", "",
+			fmt.Sprintf("The email is mostly images: %d images for %d characters of text", images, textLength))
+	case images > 0 && textLength < images*200:
+		l.add(TemplateLintRuleImageTextRatio, TemplateLintSeverityWarning, 1.0, "", "",
+			fmt.Sprintf("Low text to image ratio: %d images for %d characters of text, aim for at least 200 characters per image", images, textLength))
+	}
+}
+
+// lintLiquid reports the variables of a Liquid source missing from the test data
+func (l *templateLinter) lintLiquid(source, blockID, field string) {
+	for _, path := range liquidVariables(source) {
+		root := strings.SplitN(strings.SplitN(path, "[", 2)[0], ".", 2)[0]
+		if templateLintPlatformVariables[root] || l.locals[root] || resolveLiquidPath(l.testData, path) {
+			continue
+		}
+		key := blockID + "|" + field + "|" + path
+		if l.seen[key] {
+			continue
+		}
+		l.seen[key] = true
+		l.add(TemplateLintRuleUndefinedVariable, TemplateLintSeverityWarning, 0, blockID, field,
+			fmt.Sprintf("Variable %q is not defined in the test data", path))
+	}
+}
+
+// liquidVariables lists the variable paths read by the outputs and tags of a Liquid source
+func liquidVariables(source string) []string {
+	source = liquidCommentRegexp.ReplaceAllString(source, "")
+	var variables []string
+	for _, match := range liquidTagRegexp.FindAllStringSubmatch(source, -1) {
+		if match[1] != "" || strings.HasPrefix(match[0], "{{") {
+			variables = append(variables, liquidExpressionVariables(match[1])...)
+			continue
+		}
+		fields := strings.Fields(match[2])
+		if len(fields) == 0 {
+			continue
+		}
+		rest := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(match[2]), fields[0]))
+		switch fields[0] {
+		case "if", "elsif", "unless", "case", "when", "echo":
+			variables = append(variables, liquidExpressionVariables(rest)...)
+		case "for", "tablerow":
+			// for item in collection
+			if parts := strings.SplitN(rest, " in ", 2); len(parts) == 2 {
+				variables = append(variables, liquidExpressionVariables(parts[1])...)
+			}
+		case "assign":
+			if parts := strings.SplitN(rest, "=", 2); len(parts) == 2 {
+				variables = append(variables, liquidExpressionVariables(parts[1])...)
+			}
+		}
+	}
+	return variables
+}
+
+// liquidExpressionVariables lists the variables of an expression and of its filter arguments
+func liquidExpressionVariables(expression string) []string {
+	expression = liquidStringRegexp.ReplaceAllString(expression, `""`)
+	parts := strings.Split(expression, "|")
+
+	candidates := []string{parts[0]}
+	for _, filter := range parts[1:] {
+		// Skip the filter name, its arguments may be variables
+		if i := strings.Index(filter, ":"); i >= 0 {
+			candidates = append(candidates, filter[i+1:])
+		}
+	}
+
+	var variables []string
+	for _, candidate := range candidates {
+		for _, loc := range liquidVariableRegexp.FindAllStringIndex(candidate, -1) {
+			name := candidate[loc[0]:loc[1]]
+			// Named arguments such as "limit: 3" are not variables
+			if rest := strings.TrimSpace(candidate[loc[1]:]); strings.HasPrefix(rest, ":") {
+				continue
+			}
+			if loc[0] > 0 && (candidate[loc[0]-1] == '.' || unicode.IsDigit(rune(candidate[loc[0]-1]))) {
+				continue
+			}
+			if templateLintLiquidKeywords[name] {
+				continue
+			}
+			variables = append(variables, name)
+		}
+	}
+	return variables
+}
+
+// collectLiquidLocals adds the variables defined by the template itself
+func collectLiquidLocals(source string, locals map[string]bool) {
+	for _, match := range liquidTagRegexp.FindAllStringSubmatch(source, -1) {
+		fields := strings.Fields(strings.TrimSpace(match[2]))
+		if len(fields) < 2 {
+			continue
+		}
+		switch fields[0] {
+		case "for", "tablerow", "capture", "increment", "decrement":
+			locals[fields[1]] = true
+		case "assign":
+			locals[strings.TrimSpace(strings.SplitN(strings.Join(fields[1:], " "), "=", 2)[0])] = true
+		}
+	}
+}
+
+// resolveLiquidPath reports whether a variable path exists in the data. Sizes and
+// first/last items of arrays are resolved like Liquid does.
+func resolveLiquidPath(data MapOfAny, path string) bool {
+	path = liquidIndexRegexp.ReplaceAllStringFunc(path, func(index string) string {
+		return "." + strings.Trim(index[1:len(index)-1], `"' `)
+	})
+
+	var current interface{} = map[string]interface{}(data)
+	for _, segment := range strings.Split(path, ".") {
+		switch value := current.(type) {
+		case map[string]interface{}:
+			next, ok := value[segment]
+			if !ok {
+				return segment == "size"
+			}
+			current = next
+		case MapOfAny:
+			next, ok := value[segment]
+			if !ok {
+				return segment == "size"
+			}
+			current = next
+		case []interface{}:
+			switch segment {
+			case "size":
+				return true
+			case "first", "last":
+				if len(value) == 0 {
+					return true
+				}
+				current = value[0]
+				if segment == "last" {
+					current = value[len(value)-1]
+				}
+			default:
+				index, err := strconv.Atoi(segment)
+				if err != nil {
+					// Items indexed by a variable are not resolved statically
+					return true
+				}
+				if index < 0 || index >= len(value) {
+					return false
+				}
+				current = value[index]
+			}
+		default:
+			return segment == "size"
+		}
+	}
+	return true
+}
+
+func nodeAttr(n *html.Node, key string) (string, bool) {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val, true
+		}
+	}
+	return "", false
+}
+
+func nodeAttrValue(n *html.Node, key string) string {
+	value, _ := nodeAttr(n, key)
+	return value
+}
+
+func htmlNodeText(n *html.Node) string {
+	var text strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			text.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return strings.Join(strings.Fields(text.String()), " ")
+}
+
+func htmlVisibleText(markup string) string {
+	doc, err := html.Parse(strings.NewReader(markup))
+	if err != nil {
+		return markup
+	}
+	return htmlNodeText(doc)
+}
+
+// LintTemplateRequest lints a template version, the latest one when Version is 0
+type LintTemplateRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	ID          string `json:"id"`
+	Version     int64  `json:"version,omitempty"`
+}
+
+func (r *LintTemplateRequest) FromURLParams(queryParams url.Values) error {
+	r.WorkspaceID = queryParams.Get("workspace_id")
+	r.ID = queryParams.Get("id")
+
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("invalid lint template request: workspace_id is required")
+	}
+	if err := validateTemplateID(r.ID); err != nil {
+		return fmt.Errorf("invalid lint template request: %w", err)
+	}
+
+	if versionStr := queryParams.Get("version"); versionStr != "" {
+		version, err := strconv.ParseInt(versionStr, 10, 64)
+		if err != nil || version < 0 {
+			return fmt.Errorf("invalid lint template request: version must be a positive number")
+		}
+		r.Version = version
+	}
+
+	return nil
+}
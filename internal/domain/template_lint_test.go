@@ -0,0 +1,321 @@
+package domain
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/Notifuse/notifuse/pkg/notifuse_mjml"
+	"github.com/preslavrachev/gomjml/mjml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLintCodeTemplate(subject, source string) *Template {
+	return &Template{
+		ID:       "welcome",
+		Version:  3,
+		Channel:  "email",
+		Category: string(TemplateCategoryTransactional),
+		Email: &EmailTemplate{
+			EditorMode: EditorModeCode,
+			MjmlSource: &source,
+			Subject:    subject,
+		},
+	}
+}
+
+func lintFindings(report *TemplateLintReport, rule string) []TemplateLintFinding {
+	var findings []TemplateLintFinding
+	for _, finding := range report.Findings {
+		if finding.Rule == rule {
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+func compiledHTML(html string) *CompileTemplateResponse {
+	return &CompileTemplateResponse{Success: true, HTML: &html}
+}
+
+func TestLintTemplate_CleanTemplatePasses(t *testing.T) {
+	tmpl := newLintCodeTemplate("Your order is on its way",
+		`<mjml><mj-body><mj-section><mj-column><mj-text>Hello, your order has shipped.</mj-text>`+
+			`<mj-button href="https://example.com/orders">View order</mj-button></mj-column></mj-section></mj-body></mjml>`)
+
+	report := LintTemplate(tmpl, compiledHTML("<html><body><p>Hello, your order has shipped.</p></body></html>"))
+
+	assert.Equal(t, "welcome", report.TemplateID)
+	assert.Equal(t, int64(3), report.Version)
+	assert.Equal(t, TemplateLintThreshold, report.Threshold)
+	assert.Empty(t, report.Findings)
+	assert.Zero(t, report.Score)
+	assert.True(t, report.Passed)
+}
+
+func TestLintTemplate_Subject(t *testing.T) {
+	tmpl := newLintCodeTemplate("ACT NOW TO CLAIM YOUR FREE GIFT!!", `<mjml><mj-body></mj-body></mjml>`)
+
+	report := LintTemplate(tmpl, nil)
+
+	assert.Len(t, lintFindings(report, TemplateLintRuleSubjectAllCaps), 1)
+	assert.Len(t, lintFindings(report, TemplateLintRuleSubjectExclamation), 1)
+	spam := lintFindings(report, TemplateLintRuleSpamPhrase)
+	require.Len(t, spam, 2)
+	assert.Equal(t, "email.subject", spam[0].Field)
+	assert.Equal(t, `Contains the spam trigger phrase "act now"`, spam[0].Message)
+	assert.Equal(t, `Contains the spam trigger phrase "free gift"`, spam[1].Message)
+}
+
+func TestLintTemplate_SpamPhrasesMatchWholeWords(t *testing.T) {
+	tmpl := newLintCodeTemplate("Our winners list", `<mjml><mj-body><mj-text>The spinner is urgently needed</mj-text></mj-body></mjml>`)
+
+	report := LintTemplate(tmpl, nil)
+
+	assert.Empty(t, lintFindings(report, TemplateLintRuleSpamPhrase))
+}
+
+func TestLintTemplate_Links(t *testing.T) {
+	tests := []struct {
+		name     string
+		link     string
+		rule     string
+		severity TemplateLintSeverity
+	}{
+		{"empty href", `<a href="">Shop</a>`, TemplateLintRuleBrokenLink, TemplateLintSeverityError},
+		{"hash only", `<a href="#">Shop</a>`, TemplateLintRuleBrokenLink, TemplateLintSeverityError},
+		{"relative", `<a href="/shop">Shop</a>`, TemplateLintRuleBrokenLink, TemplateLintSeverityError},
+		{"javascript", `<a href="javascript:alert(1)">Shop</a>`, TemplateLintRuleUnsafeLink, TemplateLintSeverityError},
+		{"http", `<a href="http://example.com">Shop</a>`, TemplateLintRuleInsecureLink, TemplateLintSeverityInfo},
+		{"ip address", `<a href="https://192.168.1.10/login">Shop</a>`, TemplateLintRuleNumericIPLink, TemplateLintSeverityWarning},
+		{"shortener", `<a href="https://bit.ly/abc">Shop</a>`, TemplateLintRuleURLShortener, TemplateLintSeverityWarning},
+		{"mismatched text", `<a href="https://evil.example.net/login">www.mybank.com</a>`, TemplateLintRuleMismatchedLink, TemplateLintSeverityWarning},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := newLintCodeTemplate("Hello", `<mjml><mj-body><mj-text>`+tt.link+`</mj-text></mj-body></mjml>`)
+
+			report := LintTemplate(tmpl, nil)
+
+			findings := lintFindings(report, tt.rule)
+			require.Len(t, findings, 1, "findings: %+v", report.Findings)
+			assert.Equal(t, tt.severity, findings[0].Severity)
+			assert.Equal(t, "email.mjml_source", findings[0].Field)
+			if tt.severity == TemplateLintSeverityError {
+				assert.False(t, report.Passed)
+			}
+		})
+	}
+}
+
+func TestLintTemplate_LinksSkipped(t *testing.T) {
+	links := []string{
+		`<a href="{{ unsubscribe_url }}">Unsubscribe</a>`,
+		`<a href="mailto:support@example.com">support@example.com</a>`,
+		`<a href="#top">Top</a>`,
+		`<a href="https://www.example.com/shop">example.com</a>`,
+		`<a href="https://shop.example.com">example.com</a>`,
+	}
+	for _, link := range links {
+		tmpl := newLintCodeTemplate("Hello", `<mjml><mj-body><mj-text>`+link+`</mj-text></mj-body></mjml>`)
+
+		report := LintTemplate(tmpl, nil)
+
+		assert.Empty(t, report.Findings, link)
+	}
+}
+
+func TestLintTemplate_VisualEditorTree(t *testing.T) {
+	image := &notifuse_mjml.MJImageBlock{BaseBlock: notifuse_mjml.NewBaseBlock("image-1", notifuse_mjml.MJMLComponentMjImage)}
+	image.Attributes["src"] = "https://example.com/banner.png"
+	image.Attributes["alt"] = ""
+
+	button := &notifuse_mjml.MJButtonBlock{BaseBlock: notifuse_mjml.NewBaseBlock("button-1", notifuse_mjml.MJMLComponentMjButton)}
+	button.Attributes["href"] = "https://tinyurl.com/xyz"
+	buttonContent := "Open"
+	button.Content = &buttonContent
+
+	column := notifuse_mjml.NewBaseBlock("column-1", notifuse_mjml.MJMLComponentMjColumn)
+	column.Children = []notifuse_mjml.EmailBlock{image, button}
+	section := notifuse_mjml.NewBaseBlock("section-1", notifuse_mjml.MJMLComponentMjSection)
+	section.Children = []notifuse_mjml.EmailBlock{column}
+	body := notifuse_mjml.NewBaseBlock("body-1", notifuse_mjml.MJMLComponentMjBody)
+	body.Children = []notifuse_mjml.EmailBlock{section}
+
+	tmpl := &Template{
+		ID:    "visual",
+		Email: &EmailTemplate{Subject: "News", VisualEditorTree: body},
+	}
+
+	report := LintTemplate(tmpl, nil)
+
+	alt := lintFindings(report, TemplateLintRuleMissingAlt)
+	require.Len(t, alt, 1)
+	assert.Equal(t, "image-1", alt[0].BlockID)
+	shortener := lintFindings(report, TemplateLintRuleURLShortener)
+	require.Len(t, shortener, 1)
+	assert.Equal(t, "button-1", shortener[0].BlockID)
+}
+
+func TestLintTemplate_MissingUnsubscribe(t *testing.T) {
+	source := `<mjml><mj-body><mj-text>Our spring collection is here.</mj-text></mj-body></mjml>`
+
+	transactional := newLintCodeTemplate("Spring", source)
+	assert.Empty(t, lintFindings(LintTemplate(transactional, nil), TemplateLintRuleMissingUnsubscribe))
+
+	marketing := newLintCodeTemplate("Spring", source)
+	marketing.Category = string(TemplateCategoryMarketing)
+	report := LintTemplate(marketing, nil)
+	assert.Len(t, lintFindings(report, TemplateLintRuleMissingUnsubscribe), 1)
+	assert.False(t, report.Passed)
+
+	withLink := newLintCodeTemplate("Spring", strings.Replace(source, "here.", `here. <a href="{{ unsubscribe_url }}">Unsubscribe</a>`, 1))
+	withLink.Category = string(TemplateCategoryMarketing)
+	assert.Empty(t, lintFindings(LintTemplate(withLink, nil), TemplateLintRuleMissingUnsubscribe))
+}
+
+func TestLintTemplate_CompiledHTML(t *testing.T) {
+	tmpl := newLintCodeTemplate("Hello", `<mjml><mj-body></mj-body></mjml>`)
+
+	t.Run("gmail clipping", func(t *testing.T) {
+		html := "<html><body><p>" + strings.Repeat("Lorem ipsum dolor sit amet. ", 4000) + "</p></body></html>"
+
+		report := LintTemplate(tmpl, compiledHTML(html))
+
+		clipping := lintFindings(report, TemplateLintRuleGmailClipping)
+		require.Len(t, clipping, 1)
+		assert.Equal(t, TemplateLintSeverityError, clipping[0].Severity)
+		assert.Equal(t, len(html), report.HTMLSize)
+		assert.False(t, report.Passed)
+	})
+
+	t.Run("image only", func(t *testing.T) {
+		html := `<html><head><style>p { color: red }</style></head><body>` +
+			`<div style="display: none">Preview text that is not counted</div><img src="https://example.com/a.png" alt="Sale"></body></html>`
+
+		report := LintTemplate(tmpl, compiledHTML(html))
+
+		ratio := lintFindings(report, TemplateLintRuleImageTextRatio)
+		require.Len(t, ratio, 1)
+		assert.Equal(t, 2.0, ratio[0].Score)
+	})
+
+	t.Run("compile error", func(t *testing.T) {
+		report := LintTemplate(tmpl, &CompileTemplateResponse{
+			Success: false,
+			Error:   &mjml.Error{Message: "unclosed tag"},
+		})
+
+		compileErrors := lintFindings(report, TemplateLintRuleCompileError)
+		require.Len(t, compileErrors, 1)
+		assert.Equal(t, "unclosed tag", compileErrors[0].Message)
+		assert.False(t, report.Passed)
+	})
+}
+
+func TestLintTemplate_ScoreThreshold(t *testing.T) {
+	// Warnings alone fail the report once their scores reach the threshold
+	tmpl := newLintCodeTemplate("Hello",
+		`<mjml><mj-body><mj-text>Make money and earn money, no credit check, free money</mj-text></mj-body></mjml>`)
+
+	report := LintTemplate(tmpl, nil)
+
+	for _, finding := range report.Findings {
+		assert.NotEqual(t, TemplateLintSeverityError, finding.Severity)
+	}
+	assert.Equal(t, 7.0, report.Score)
+	assert.False(t, report.Passed)
+}
+
+func TestLintTemplate_UndefinedVariables(t *testing.T) {
+	tmpl := newLintCodeTemplate("Hi {{ contact.first_name }}, {{ order.number }}",
+		`<mjml><mj-body><mj-text>`+
+			`{% assign total = order.total | plus: 1 %}{{ total }}`+
+			`{% for item in order.items %}{{ item.name }} {{ forloop.index }}{% endfor %}`+
+			`{{ coupon.code | default: "none" }} {{ "literal" | upcase }}`+
+			`{% if customer.vip and order.total > 10 %}VIP{% endif %}`+
+			`{% comment %}{{ ignored }}{% endcomment %}`+
+			`</mj-text></mj-body></mjml>`)
+	tmpl.TestData = MapOfAny{
+		"order": map[string]interface{}{
+			"number": "A-1",
+			"total":  12,
+			"items":  []interface{}{map[string]interface{}{"name": "Shoes"}},
+		},
+	}
+
+	report := LintTemplate(tmpl, nil)
+
+	undefined := lintFindings(report, TemplateLintRuleUndefinedVariable)
+	var messages []string
+	for _, finding := range undefined {
+		messages = append(messages, finding.Message)
+		assert.Equal(t, TemplateLintSeverityWarning, finding.Severity)
+	}
+	require.Len(t, undefined, 2, "findings: %v", messages)
+	assert.Contains(t, messages[0], "coupon.code")
+	assert.Contains(t, messages[1], "customer.vip")
+}
+
+func TestTemplateLintReport_AddFinding(t *testing.T) {
+	report := &TemplateLintReport{}
+	finding := TemplateLintFinding{Rule: TemplateLintRuleMissingAlt, BlockID: "image-1", Message: "Image has no alt text"}
+
+	report.AddFinding(finding)
+	report.AddFinding(finding)
+	finding.BlockID = "image-2"
+	report.AddFinding(finding)
+
+	assert.Len(t, report.Findings, 2)
+}
+
+func TestLintTemplateRequest_FromURLParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  url.Values
+		want    LintTemplateRequest
+		wantErr string
+	}{
+		{
+			name:   "latest version",
+			params: url.Values{"workspace_id": {"ws1"}, "id": {"welcome"}},
+			want:   LintTemplateRequest{WorkspaceID: "ws1", ID: "welcome"},
+		},
+		{
+			name:   "given version",
+			params: url.Values{"workspace_id": {"ws1"}, "id": {"welcome"}, "version": {"4"}},
+			want:   LintTemplateRequest{WorkspaceID: "ws1", ID: "welcome", Version: 4},
+		},
+		{
+			name:    "missing workspace",
+			params:  url.Values{"id": {"welcome"}},
+			wantErr: "workspace_id is required",
+		},
+		{
+			name:    "missing id",
+			params:  url.Values{"workspace_id": {"ws1"}},
+			wantErr: "invalid lint template request",
+		},
+		{
+			name:    "invalid version",
+			params:  url.Values{"workspace_id": {"ws1"}, "id": {"welcome"}, "version": {"-1"}},
+			wantErr: "version must be a positive number",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req LintTemplateRequest
+			err := req.FromURLParams(tt.params)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, req)
+		})
+	}
+}
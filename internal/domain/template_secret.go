@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/Notifuse/notifuse/pkg/crypto"
+	"github.com/Notifuse/notifuse/pkg/liquid"
+)
+
+var templateSecretNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// TemplateSecret is a signing key of the hmac and sign_url Liquid filters. Templates refer to
+// it by name, so the key itself is never written in a template.
+type TemplateSecret struct {
+	Name           string `json:"name"`
+	EncryptedValue string `json:"encrypted_value,omitempty"`
+
+	// decoded value, not stored in the database
+	Value string `json:"value,omitempty"`
+}
+
+// TemplateSecrets are the signing keys of a workspace
+type TemplateSecrets []TemplateSecret
+
+// Validate validates the names and values of the secrets
+func (s TemplateSecrets) Validate() error {
+	seen := make(map[string]bool, len(s))
+	for i, secret := range s {
+		if !templateSecretNameRegexp.MatchString(secret.Name) {
+			return fmt.Errorf("template secret at index %d: name must start with a lowercase letter and contain only lowercase letters, digits and underscores (max 64)", i)
+		}
+		if seen[secret.Name] {
+			return fmt.Errorf("duplicate template secret: %s", secret.Name)
+		}
+		seen[secret.Name] = true
+		if secret.Value == "" && secret.EncryptedValue == "" {
+			return fmt.Errorf("template secret %s: value is required", secret.Name)
+		}
+	}
+	return nil
+}
+
+// KeepValues fills the secrets sent without a value with the value they had before, so that
+// a settings update does not need to send the keys again
+func (s TemplateSecrets) KeepValues(previous TemplateSecrets) {
+	for i := range s {
+		if s[i].Value != "" {
+			continue
+		}
+		for _, secret := range previous {
+			if secret.Name == s[i].Name {
+				s[i].Value = secret.Value
+				s[i].EncryptedValue = secret.EncryptedValue
+				break
+			}
+		}
+	}
+}
+
+// Encrypt encrypts the values and clears them
+func (s TemplateSecrets) Encrypt(passphrase string) error {
+	for i := range s {
+		if s[i].Value == "" {
+			continue
+		}
+		encrypted, err := crypto.EncryptString(s[i].Value, passphrase)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt template secret %s: %w", s[i].Name, err)
+		}
+		s[i].EncryptedValue = encrypted
+		s[i].Value = ""
+	}
+	return nil
+}
+
+// Decrypt decrypts the values
+func (s TemplateSecrets) Decrypt(passphrase string) error {
+	for i := range s {
+		if s[i].EncryptedValue == "" {
+			continue
+		}
+		value, err := crypto.DecryptFromHexString(s[i].EncryptedValue, passphrase)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt template secret %s: %w", s[i].Name, err)
+		}
+		s[i].Value = value
+	}
+	return nil
+}
+
+// Keys returns the decoded values by name, as the Liquid filters look them up
+func (s TemplateSecrets) Keys() map[string]string {
+	keys := make(map[string]string, len(s))
+	for _, secret := range s {
+		keys[secret.Name] = secret.Value
+	}
+	return keys
+}
+
+// Apply sets the secrets into template data, where the hmac and sign_url filters read them.
+// They render as an empty string and are left out of the data stored with the messages.
+func (s TemplateSecrets) Apply(data MapOfAny) {
+	if data == nil || len(s) == 0 {
+		return
+	}
+	data[liquid.SecretsKey] = liquid.NewSecrets(s.Keys())
+}
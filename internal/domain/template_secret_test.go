@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Notifuse/notifuse/pkg/liquid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateSecrets_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		secrets TemplateSecrets
+		wantErr string
+	}{
+		{"empty", nil, ""},
+		{"valid", TemplateSecrets{{Name: "app_key", Value: "secret"}, {Name: "crm2", EncryptedValue: "abcd"}}, ""},
+		{"invalid name", TemplateSecrets{{Name: "App Key", Value: "secret"}}, "name must start with a lowercase letter"},
+		{"duplicate name", TemplateSecrets{{Name: "app_key", Value: "a"}, {Name: "app_key", Value: "b"}}, "duplicate template secret: app_key"},
+		{"missing value", TemplateSecrets{{Name: "app_key"}}, "template secret app_key: value is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.secrets.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestTemplateSecrets_EncryptDecrypt(t *testing.T) {
+	passphrase := "test-passphrase"
+	secrets := TemplateSecrets{{Name: "app_key", Value: "secret"}}
+
+	require.NoError(t, secrets.Encrypt(passphrase))
+	assert.Empty(t, secrets[0].Value)
+	assert.NotEmpty(t, secrets[0].EncryptedValue)
+
+	require.NoError(t, secrets.Decrypt(passphrase))
+	assert.Equal(t, "secret", secrets[0].Value)
+	assert.Equal(t, map[string]string{"app_key": "secret"}, secrets.Keys())
+}
+
+func TestTemplateSecrets_KeepValues(t *testing.T) {
+	previous := TemplateSecrets{{Name: "app_key", Value: "secret", EncryptedValue: "enc"}}
+	updated := TemplateSecrets{{Name: "app_key"}, {Name: "crm", Value: "new"}, {Name: "other"}}
+
+	updated.KeepValues(previous)
+
+	assert.Equal(t, TemplateSecret{Name: "app_key", Value: "secret", EncryptedValue: "enc"}, updated[0])
+	assert.Equal(t, "new", updated[1].Value)
+	assert.Empty(t, updated[2].Value)
+	assert.Error(t, updated.Validate())
+}
+
+func TestBuildTemplateData_TemplateSecrets(t *testing.T) {
+	req := TemplateDataRequest{
+		WorkspaceID:        "ws-123",
+		WorkspaceSecretKey: "workspace-secret",
+		MessageID:          "msg-456",
+		TemplateSecrets:    TemplateSecrets{{Name: "app_key", Value: "secret"}},
+	}
+
+	data, err := BuildTemplateData(req)
+	require.NoError(t, err)
+	assert.IsType(t, &liquid.Secrets{}, data[liquid.SecretsKey])
+
+	// The keys never reach the data stored with the message
+	stored, err := json.Marshal(data)
+	require.NoError(t, err)
+	assert.NotContains(t, string(stored), `"secret"`)
+
+	req.TemplateSecrets = nil
+	data, err = BuildTemplateData(req)
+	require.NoError(t, err)
+	assert.NotContains(t, data, liquid.SecretsKey)
+}
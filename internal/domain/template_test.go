@@ -1750,6 +1750,13 @@ func TestBuildTemplateData(t *testing.T) {
 		assert.Contains(t, trackingPixelURL, "mid=msg-456")
 		assert.Contains(t, trackingPixelURL, "wid=ws-123")
 
+		// Check web view URL
+		webViewURL, ok := data["web_view_url"].(string)
+		assert.True(t, ok)
+		assert.Contains(t, webViewURL, "https://api.example.com/w/msg-456?")
+		assert.Contains(t, webViewURL, "wid=ws-123")
+		assert.Contains(t, webViewURL, "sig=")
+
 		// Check confirm subscription URL
 		confirmURL, ok := data["confirm_subscription_url"].(string)
 		assert.True(t, ok)
@@ -0,0 +1,289 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/Notifuse/notifuse/pkg/notifuse_mjml"
+)
+
+// maxTranslateLanguages bounds the number of languages translated by a single request,
+// every language being a separate LLM conversation
+const maxTranslateLanguages = 10
+
+// translationProtectedPattern matches what a translation must keep verbatim: the head and
+// styles of MJML sources, comments, HTML and MJML tags (with their attributes and links),
+// Liquid tags and outputs, bare URLs and HTML entities
+var translationProtectedPattern = regexp.MustCompile(`(?s)<mj-head\b.*?</mj-head>|<mj-style\b.*?</mj-style>|<style\b.*?</style>|<mj-raw\b.*?</mj-raw>|<!--.*?-->|\{%.*?%\}|\{\{.*?\}\}|<[^>]*>|https?://[^\s<>"']+|&#?[a-zA-Z0-9]+;`)
+
+// translationPlaceholderPattern matches the placeholders standing for protected parts
+var translationPlaceholderPattern = regexp.MustCompile(`\[\[(\d+)\]\]`)
+
+var mjPreviewPattern = regexp.MustCompile(`(?s)(<mj-preview\b[^>]*>).*?(</mj-preview>)`)
+
+// TranslateTemplateRequest asks an LLM integration to translate a template version, the
+// latest one when Version is 0, into other languages. IntegrationID defaults to the first
+// LLM integration of the workspace.
+type TranslateTemplateRequest struct {
+	WorkspaceID   string   `json:"workspace_id"`
+	ID            string   `json:"id"`
+	Version       int64    `json:"version,omitempty"`
+	IntegrationID string   `json:"integration_id,omitempty"`
+	Languages     []string `json:"languages"`
+}
+
+func (r *TranslateTemplateRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("invalid translate template request: workspace_id is required")
+	}
+	if err := validateTemplateID(r.ID); err != nil {
+		return fmt.Errorf("invalid translate template request: %w", err)
+	}
+	if r.Version < 0 {
+		return fmt.Errorf("invalid translate template request: version cannot be negative")
+	}
+	if len(r.Languages) == 0 {
+		return fmt.Errorf("invalid translate template request: languages is required")
+	}
+	if len(r.Languages) > maxTranslateLanguages {
+		return fmt.Errorf("invalid translate template request: at most %d languages can be translated at once", maxTranslateLanguages)
+	}
+	seen := map[string]bool{}
+	for _, language := range r.Languages {
+		if !IsValidLanguage(language) {
+			return fmt.Errorf("invalid translate template request: invalid language: %s", language)
+		}
+		if seen[language] {
+			return fmt.Errorf("invalid translate template request: duplicate language: %s", language)
+		}
+		seen[language] = true
+	}
+	return nil
+}
+
+// TemplateReviewStatus tells whether a proposed change was accepted by a human
+type TemplateReviewStatus string
+
+const (
+	TemplateReviewPending TemplateReviewStatus = "pending"
+)
+
+// TemplateTranslationChange is a change of a proposed translation, to be reviewed before
+// the translation is saved as a new version of the template
+type TemplateTranslationChange struct {
+	TemplateChange
+	Review TemplateReviewStatus `json:"review"`
+}
+
+// TemplateTranslationIssue reports a text that was left untranslated
+type TemplateTranslationIssue struct {
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// TemplateTranslationProposal is the machine translation of a template into one language.
+// Changes are relative to the current translation of that language, or to the source
+// content when the template has none.
+type TemplateTranslationProposal struct {
+	Language    string                      `json:"language"`
+	Translation TemplateTranslation         `json:"translation"`
+	Changes     []TemplateTranslationChange `json:"changes"`
+	Issues      []TemplateTranslationIssue  `json:"issues,omitempty"`
+}
+
+// TranslateTemplateResponse holds the proposals of a translation request. Nothing is saved:
+// accepted proposals are stored in the translations of the template with templates.update.
+type TranslateTemplateResponse struct {
+	TemplateID string                        `json:"template_id"`
+	Version    int64                         `json:"version"`
+	Proposals  []TemplateTranslationProposal `json:"proposals"`
+}
+
+// TranslationSegment is a text of a template to translate. Text has its tags, links and
+// Liquid replaced by [[N]] placeholders that the translation must keep.
+type TranslationSegment struct {
+	Path         string
+	Text         string
+	placeholders []string
+}
+
+// newTranslationSegment masks the protected parts of a text. It returns nil when nothing
+// is left to translate.
+func newTranslationSegment(path, text string) *TranslationSegment {
+	segment := &TranslationSegment{Path: path}
+	segment.Text = translationProtectedPattern.ReplaceAllStringFunc(text, func(match string) string {
+		segment.placeholders = append(segment.placeholders, match)
+		return "[[" + strconv.Itoa(len(segment.placeholders)) + "]]"
+	})
+
+	hasLetters := strings.IndexFunc(translationPlaceholderPattern.ReplaceAllString(segment.Text, ""), unicode.IsLetter) >= 0
+	if !hasLetters {
+		return nil
+	}
+	return segment
+}
+
+// Unmask restores the protected parts of a translated text. Every placeholder must be
+// kept exactly once, otherwise the tags or Liquid of the source would be lost.
+func (s *TranslationSegment) Unmask(translated string) (string, error) {
+	counts := make([]int, len(s.placeholders))
+	var unknown string
+	result := translationPlaceholderPattern.ReplaceAllStringFunc(translated, func(match string) string {
+		index, _ := strconv.Atoi(translationPlaceholderPattern.FindStringSubmatch(match)[1])
+		if index < 1 || index > len(s.placeholders) {
+			unknown = match
+			return match
+		}
+		counts[index-1]++
+		return s.placeholders[index-1]
+	})
+
+	if unknown != "" {
+		return "", fmt.Errorf("unknown placeholder %s", unknown)
+	}
+	for i, count := range counts {
+		if count != 1 {
+			return "", fmt.Errorf("placeholder [[%d]] appears %d times instead of once", i+1, count)
+		}
+	}
+	if strings.TrimSpace(result) == "" {
+		return "", fmt.Errorf("translation is empty")
+	}
+	return result, nil
+}
+
+// ExtractTranslationSegments lists the texts of an email template to translate: subject,
+// preview, plain text and either the MJML source of code mode templates or the text,
+// button and social blocks and image alternative texts of visual ones.
+// Paths are those of TemplateChange.
+func ExtractTranslationSegments(email *EmailTemplate) []*TranslationSegment {
+	if email == nil {
+		return nil
+	}
+
+	var segments []*TranslationSegment
+	add := func(path, text string) {
+		if segment := newTranslationSegment(path, text); segment != nil {
+			segments = append(segments, segment)
+		}
+	}
+
+	add("email.subject", email.Subject)
+	add("email.subject_preview", stringValue(email.SubjectPreview))
+	add("email.text", stringValue(email.Text))
+
+	if source := email.GetCodeModeMjmlSource(); source != nil {
+		add("email.mjml_source", *source)
+		return segments
+	}
+
+	var walk func(block notifuse_mjml.EmailBlock)
+	walk = func(block notifuse_mjml.EmailBlock) {
+		if block == nil {
+			return
+		}
+		switch block.GetType() {
+		case notifuse_mjml.MJMLComponentMjText, notifuse_mjml.MJMLComponentMjButton, notifuse_mjml.MJMLComponentMjSocialElement:
+			add("blocks."+block.GetID()+".content", stringValue(block.GetContent()))
+		case notifuse_mjml.MJMLComponentMjImage:
+			if alt, ok := block.GetAttributes()["alt"].(string); ok {
+				add("blocks."+block.GetID()+".attributes.alt", alt)
+			}
+		}
+		for _, child := range block.GetChildren() {
+			walk(child)
+		}
+	}
+	walk(email.VisualEditorTree)
+
+	return segments
+}
+
+// ApplyTranslationSegments returns a copy of an email template with the translated texts,
+// keyed by segment path. The preview block follows the translated preview and the compiled
+// preview is cleared to be compiled again when the translation is saved.
+func ApplyTranslationSegments(source *EmailTemplate, translated map[string]string) (*EmailTemplate, error) {
+	data, err := json.Marshal(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy email template: %w", err)
+	}
+	email := &EmailTemplate{}
+	if err := json.Unmarshal(data, email); err != nil {
+		return nil, fmt.Errorf("failed to copy email template: %w", err)
+	}
+	email.CompiledPreview = ""
+
+	if text, ok := translated["email.subject"]; ok {
+		email.Subject = text
+	}
+	if text, ok := translated["email.subject_preview"]; ok {
+		email.SubjectPreview = &text
+	}
+	if text, ok := translated["email.text"]; ok {
+		email.Text = &text
+	}
+	if text, ok := translated["email.mjml_source"]; ok && email.MjmlSource != nil {
+		if preview, ok := translated["email.subject_preview"]; ok {
+			text = mjPreviewPattern.ReplaceAllStringFunc(text, func(match string) string {
+				parts := mjPreviewPattern.FindStringSubmatch(match)
+				return parts[1] + escapeTranslatedPreview(preview) + parts[2]
+			})
+		}
+		email.MjmlSource = &text
+	}
+
+	var walk func(block notifuse_mjml.EmailBlock)
+	walk = func(block notifuse_mjml.EmailBlock) {
+		if block == nil {
+			return
+		}
+		if text, ok := translated["blocks."+block.GetID()+".content"]; ok {
+			block.SetContent(&text)
+		}
+		if text, ok := translated["blocks."+block.GetID()+".attributes.alt"]; ok {
+			if attributes := block.GetAttributes(); attributes != nil {
+				attributes["alt"] = text
+			}
+		}
+		if preview, ok := translated["email.subject_preview"]; ok && block.GetType() == notifuse_mjml.MJMLComponentMjPreview {
+			block.SetContent(&preview)
+		}
+		for _, child := range block.GetChildren() {
+			walk(child)
+		}
+	}
+	walk(email.VisualEditorTree)
+
+	return email, nil
+}
+
+func escapeTranslatedPreview(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+// NewTemplateTranslationProposal builds the proposal of a language, with the changes from
+// the current translation of the template (or from its source content) pending review
+func NewTemplateTranslationProposal(template *Template, language string, email *EmailTemplate, issues []TemplateTranslationIssue) TemplateTranslationProposal {
+	current := template.Email
+	if translation, ok := template.Translations[language]; ok && translation.Email != nil {
+		current = translation.Email
+	}
+
+	diff := &TemplateVersionDiff{}
+	diff.addEmail("email", current, email)
+
+	proposal := TemplateTranslationProposal{
+		Language:    language,
+		Translation: TemplateTranslation{Email: email},
+		Changes:     make([]TemplateTranslationChange, 0, len(diff.Changes)),
+		Issues:      issues,
+	}
+	for _, change := range diff.Changes {
+		proposal.Changes = append(proposal.Changes, TemplateTranslationChange{TemplateChange: change, Review: TemplateReviewPending})
+	}
+	return proposal
+}
@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/pkg/notifuse_mjml"
+)
+
+func translateTestImage(id, alt string) notifuse_mjml.EmailBlock {
+	base := notifuse_mjml.NewBaseBlock(id, notifuse_mjml.MJMLComponentMjImage)
+	base.Attributes["alt"] = alt
+	base.Attributes["src"] = "https://example.com/logo.png"
+	return &notifuse_mjml.MJImageBlock{BaseBlock: base}
+}
+
+func TestTranslateTemplateRequest_Validate(t *testing.T) {
+	valid := func() TranslateTemplateRequest {
+		return TranslateTemplateRequest{WorkspaceID: "ws1", ID: "welcome", Languages: []string{"fr", "de"}}
+	}
+
+	req := valid()
+	assert.NoError(t, req.Validate())
+
+	tests := map[string]func(r *TranslateTemplateRequest){
+		"missing workspace":  func(r *TranslateTemplateRequest) { r.WorkspaceID = "" },
+		"invalid id":         func(r *TranslateTemplateRequest) { r.ID = "bad id" },
+		"negative version":   func(r *TranslateTemplateRequest) { r.Version = -1 },
+		"no languages":       func(r *TranslateTemplateRequest) { r.Languages = nil },
+		"unknown language":   func(r *TranslateTemplateRequest) { r.Languages = []string{"xx"} },
+		"duplicate language": func(r *TranslateTemplateRequest) { r.Languages = []string{"fr", "fr"} },
+		"too many languages": func(r *TranslateTemplateRequest) {
+			r.Languages = []string{"fr", "de", "es", "it", "pt", "nl", "ja", "ko", "ru", "pl", "sv"}
+		},
+	}
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := valid()
+			mutate(&req)
+			assert.Error(t, req.Validate())
+		})
+	}
+}
+
+func TestTranslationSegment_Unmask(t *testing.T) {
+	segment := newTranslationSegment("email.subject", `Hi {{ contact.first_name }}, <a href="{{ url }}">read more</a> &amp; see https://example.com/a`)
+	require.NotNil(t, segment)
+	assert.Equal(t, "Hi [[1]], [[2]]read more[[3]] [[4]] see [[5]]", segment.Text)
+
+	t.Run("restores the placeholders, in any order", func(t *testing.T) {
+		text, err := segment.Unmask("Bonjour [[1]], [[4]] voir [[5]] : [[2]]lire la suite[[3]]")
+		require.NoError(t, err)
+		assert.Equal(t, `Bonjour {{ contact.first_name }}, &amp; voir https://example.com/a : <a href="{{ url }}">lire la suite</a>`, text)
+	})
+
+	t.Run("rejects missing placeholders", func(t *testing.T) {
+		_, err := segment.Unmask("Bonjour [[1]], lire la suite")
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects duplicated placeholders", func(t *testing.T) {
+		_, err := segment.Unmask("[[1]] [[1]] [[2]][[3]] [[4]] [[5]]")
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects unknown placeholders", func(t *testing.T) {
+		_, err := segment.Unmask("[[1]] [[2]][[3]] [[4]] [[5]] [[6]]")
+		assert.Error(t, err)
+	})
+
+	t.Run("skips texts without words", func(t *testing.T) {
+		assert.Nil(t, newTranslationSegment("blocks.b1.content", "<p>{{ unsubscribe_url }}</p> 2026"))
+	})
+}
+
+func TestExtractTranslationSegments(t *testing.T) {
+	t.Run("visual mode", func(t *testing.T) {
+		preview := "Our news"
+		email := &EmailTemplate{
+			Subject:        "Hello {{ name }}",
+			SubjectPreview: &preview,
+			VisualEditorTree: diffTestTree(map[string][]notifuse_mjml.EmailBlock{
+				"col": {
+					diffTestText("t1", "<p>Welcome aboard</p>", nil),
+					diffTestText("t2", "{% raw %}{{ x }}{% endraw %}", nil),
+					translateTestImage("img", "Company logo"),
+				},
+			}, "col"),
+		}
+
+		paths := []string{}
+		for _, segment := range ExtractTranslationSegments(email) {
+			paths = append(paths, segment.Path)
+		}
+		assert.Equal(t, []string{"email.subject", "email.subject_preview", "blocks.t1.content", "blocks.img.attributes.alt"}, paths)
+	})
+
+	t.Run("code mode keeps the head", func(t *testing.T) {
+		source := "<mjml><mj-head><mj-title>Title</mj-title></mj-head><mj-body><mj-text>Hello</mj-text></mj-body></mjml>"
+		email := &EmailTemplate{Subject: "Hello", EditorMode: EditorModeCode, MjmlSource: &source}
+
+		segments := ExtractTranslationSegments(email)
+		require.Len(t, segments, 2)
+		assert.Equal(t, "email.mjml_source", segments[1].Path)
+		assert.Equal(t, "[[1]][[2]][[3]][[4]]Hello[[5]][[6]][[7]]", segments[1].Text)
+	})
+}
+
+func TestApplyTranslationSegments(t *testing.T) {
+	t.Run("visual mode", func(t *testing.T) {
+		previewBase := notifuse_mjml.NewBaseBlock("preview", notifuse_mjml.MJMLComponentMjPreview)
+		previewText := "Our news"
+		previewBase.Content = &previewText
+		tree := diffTestTree(map[string][]notifuse_mjml.EmailBlock{
+			"col": {diffTestText("t1", "<p>Welcome</p>", nil), translateTestImage("img", "Logo")},
+		}, "col")
+		tree.SetChildren(append([]notifuse_mjml.EmailBlock{&notifuse_mjml.MJPreviewBlock{BaseBlock: previewBase}}, tree.GetChildren()...))
+		source := &EmailTemplate{Subject: "Hello", SubjectPreview: &previewText, CompiledPreview: "<html></html>", VisualEditorTree: tree}
+
+		email, err := ApplyTranslationSegments(source, map[string]string{
+			"email.subject":             "Bonjour",
+			"email.subject_preview":     "Nos nouvelles",
+			"blocks.t1.content":         "<p>Bienvenue</p>",
+			"blocks.img.attributes.alt": "Logo de la société",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "Bonjour", email.Subject)
+		assert.Equal(t, "Nos nouvelles", *email.SubjectPreview)
+		assert.Empty(t, email.CompiledPreview)
+
+		nodes, _ := flattenTree(email.VisualEditorTree)
+		assert.Equal(t, "<p>Bienvenue</p>", *nodes["t1"].block.GetContent())
+		assert.Equal(t, "Logo de la société", nodes["img"].block.GetAttributes()["alt"])
+		assert.Equal(t, "Nos nouvelles", *nodes["preview"].block.GetContent())
+
+		// The source is left untouched
+		sourceNodes, _ := flattenTree(source.VisualEditorTree)
+		assert.Equal(t, "<p>Welcome</p>", *sourceNodes["t1"].block.GetContent())
+		assert.Equal(t, "Hello", source.Subject)
+	})
+
+	t.Run("code mode updates the preview tag", func(t *testing.T) {
+		source := "<mjml><mj-head><mj-preview>News</mj-preview></mj-head><mj-body><mj-text>Hello</mj-text></mj-body></mjml>"
+		translated := "<mjml><mj-head><mj-preview>News</mj-preview></mj-head><mj-body><mj-text>Bonjour</mj-text></mj-body></mjml>"
+
+		email, err := ApplyTranslationSegments(&EmailTemplate{Subject: "Hello", EditorMode: EditorModeCode, MjmlSource: &source},
+			map[string]string{"email.mjml_source": translated, "email.subject_preview": "Nouvelles & co"})
+		require.NoError(t, err)
+		assert.Equal(t, "<mjml><mj-head><mj-preview>Nouvelles &amp; co</mj-preview></mj-head><mj-body><mj-text>Bonjour</mj-text></mj-body></mjml>", *email.MjmlSource)
+	})
+}
+
+func TestNewTemplateTranslationProposal(t *testing.T) {
+	source := &EmailTemplate{Subject: "Hello", VisualEditorTree: diffTestTree(map[string][]notifuse_mjml.EmailBlock{
+		"col": {diffTestText("t1", "Welcome", nil)},
+	}, "col")}
+	template := &Template{ID: "welcome", Version: 3, Email: source}
+
+	translated, err := ApplyTranslationSegments(source, map[string]string{"blocks.t1.content": "Bienvenue"})
+	require.NoError(t, err)
+
+	issues := []TemplateTranslationIssue{{Path: "email.subject", Message: "placeholder [[1]] is missing"}}
+	proposal := NewTemplateTranslationProposal(template, "fr", translated, issues)
+
+	assert.Equal(t, "fr", proposal.Language)
+	assert.Same(t, translated, proposal.Translation.Email)
+	assert.Equal(t, issues, proposal.Issues)
+	require.Len(t, proposal.Changes, 1)
+	assert.Equal(t, "blocks.t1.content", proposal.Changes[0].Path)
+	assert.Equal(t, "Welcome", proposal.Changes[0].From)
+	assert.Equal(t, "Bienvenue", proposal.Changes[0].To)
+	assert.Equal(t, TemplateReviewPending, proposal.Changes[0].Review)
+
+	t.Run("compares with the current translation", func(t *testing.T) {
+		current, err := ApplyTranslationSegments(source, map[string]string{"blocks.t1.content": "Bienvenue !"})
+		require.NoError(t, err)
+		template.Translations = map[string]TemplateTranslation{"fr": {Email: current}}
+
+		proposal := NewTemplateTranslationProposal(template, "fr", translated, nil)
+		require.Len(t, proposal.Changes, 1)
+		assert.Equal(t, "Bienvenue !", proposal.Changes[0].From)
+	})
+}
@@ -0,0 +1,449 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Notifuse/notifuse/pkg/notifuse_mjml"
+)
+
+// maxSourceDiffCells bounds the line diff of MJML sources (lines of the old version times lines of
+// the new one). Larger sources are reported as a single modification.
+const maxSourceDiffCells = 4_000_000
+
+// TemplateVersion summarizes a saved version of a template for the history
+type TemplateVersion struct {
+	Version     int64      `json:"version"`
+	Name        string     `json:"name"`
+	CreatedBy   *string    `json:"created_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	PublishedAt *time.Time `json:"published_at,omitempty"`
+	PublishedBy *string    `json:"published_by,omitempty"`
+	// Live is true for the version used by broadcasts, automations and transactional notifications
+	Live bool `json:"live"`
+}
+
+// TemplateChangeType describes how an element changed between two versions
+type TemplateChangeType string
+
+const (
+	TemplateChangeAdded    TemplateChangeType = "added"
+	TemplateChangeRemoved  TemplateChangeType = "removed"
+	TemplateChangeModified TemplateChangeType = "modified"
+	TemplateChangeMoved    TemplateChangeType = "moved"
+)
+
+// TemplateChange is a single difference between two versions of a template.
+// Path is "name", "email.subject"... for fields, "blocks.<id>" for visual editor blocks
+// and "email.mjml_source" for the lines of code mode templates.
+type TemplateChange struct {
+	Path      string             `json:"path"`
+	Type      TemplateChangeType `json:"type"`
+	BlockID   string             `json:"block_id,omitempty"`
+	BlockType string             `json:"block_type,omitempty"`
+	Line      int                `json:"line,omitempty"`
+	From      interface{}        `json:"from,omitempty"`
+	To        interface{}        `json:"to,omitempty"`
+}
+
+// TemplateVersionDiff lists the changes from one version of a template to another
+type TemplateVersionDiff struct {
+	TemplateID  string           `json:"template_id"`
+	FromVersion int64            `json:"from_version"`
+	ToVersion   int64            `json:"to_version"`
+	Changes     []TemplateChange `json:"changes"`
+}
+
+// DiffTemplates computes the structural diff between two versions of a template
+func DiffTemplates(from, to *Template) *TemplateVersionDiff {
+	diff := &TemplateVersionDiff{
+		TemplateID:  to.ID,
+		FromVersion: from.Version,
+		ToVersion:   to.Version,
+		Changes:     []TemplateChange{},
+	}
+
+	diff.addField("name", from.Name, to.Name)
+	diff.addField("category", from.Category, to.Category)
+	diff.addField("test_data", from.TestData, to.TestData)
+	diff.addField("settings", from.Settings, to.Settings)
+
+	diff.addEmail("email", from.Email, to.Email)
+	if from.Web != nil || to.Web != nil {
+		diff.addField("web.content", webContent(from.Web), webContent(to.Web))
+	}
+
+	// Translations are compared as a whole per language
+	languages := map[string]bool{}
+	for lang := range from.Translations {
+		languages[lang] = true
+	}
+	for lang := range to.Translations {
+		languages[lang] = true
+	}
+	sortedLanguages := make([]string, 0, len(languages))
+	for lang := range languages {
+		sortedLanguages = append(sortedLanguages, lang)
+	}
+	sort.Strings(sortedLanguages)
+	for _, lang := range sortedLanguages {
+		oldTranslation, inFrom := from.Translations[lang]
+		newTranslation, inTo := to.Translations[lang]
+		path := "translations." + lang
+		switch {
+		case !inFrom:
+			diff.Changes = append(diff.Changes, TemplateChange{Path: path, Type: TemplateChangeAdded})
+		case !inTo:
+			diff.Changes = append(diff.Changes, TemplateChange{Path: path, Type: TemplateChangeRemoved})
+		case !sameJSON(oldTranslation, newTranslation):
+			diff.Changes = append(diff.Changes, TemplateChange{Path: path, Type: TemplateChangeModified})
+		}
+	}
+
+	return diff
+}
+
+func webContent(w *WebTemplate) MapOfAny {
+	if w == nil {
+		return nil
+	}
+	return w.Content
+}
+
+func (d *TemplateVersionDiff) addField(path string, from, to interface{}) {
+	if sameJSON(from, to) {
+		return
+	}
+	d.Changes = append(d.Changes, TemplateChange{Path: path, Type: TemplateChangeModified, From: from, To: to})
+}
+
+func (d *TemplateVersionDiff) addEmail(prefix string, from, to *EmailTemplate) {
+	if from == nil && to == nil {
+		return
+	}
+	if from == nil {
+		from = &EmailTemplate{}
+	}
+	if to == nil {
+		to = &EmailTemplate{}
+	}
+
+	d.addField(prefix+".subject", from.Subject, to.Subject)
+	d.addField(prefix+".subject_preview", stringValue(from.SubjectPreview), stringValue(to.SubjectPreview))
+	d.addField(prefix+".sender_id", from.SenderID, to.SenderID)
+	d.addField(prefix+".reply_to", from.ReplyTo, to.ReplyTo)
+	d.addField(prefix+".editor_mode", from.EditorMode, to.EditorMode)
+	d.addField(prefix+".text", stringValue(from.Text), stringValue(to.Text))
+
+	d.addSource(prefix+".mjml_source", stringValue(from.MjmlSource), stringValue(to.MjmlSource))
+	d.addBlocks(from.VisualEditorTree, to.VisualEditorTree)
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// addSource adds the lines removed from and added to an MJML source, numbered in their own version
+func (d *TemplateVersionDiff) addSource(path, from, to string) {
+	if from == to {
+		return
+	}
+	oldLines := splitLines(from)
+	newLines := splitLines(to)
+	if len(oldLines)*len(newLines) > maxSourceDiffCells {
+		d.Changes = append(d.Changes, TemplateChange{Path: path, Type: TemplateChangeModified})
+		return
+	}
+
+	// Longest common subsequence of lines
+	lcs := make([][]int, len(oldLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newLines)+1)
+	}
+	for i := len(oldLines) - 1; i >= 0; i-- {
+		for j := len(newLines) - 1; j >= 0; j-- {
+			if oldLines[i] == newLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(oldLines) || j < len(newLines) {
+		switch {
+		case i < len(oldLines) && j < len(newLines) && oldLines[i] == newLines[j]:
+			i++
+			j++
+		case j < len(newLines) && (i == len(oldLines) || lcs[i][j+1] >= lcs[i+1][j]):
+			d.Changes = append(d.Changes, TemplateChange{Path: path, Type: TemplateChangeAdded, Line: j + 1, To: newLines[j]})
+			j++
+		default:
+			d.Changes = append(d.Changes, TemplateChange{Path: path, Type: TemplateChangeRemoved, Line: i + 1, From: oldLines[i]})
+			i++
+		}
+	}
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// treeNode is a block of a visual editor tree with its position
+type treeNode struct {
+	block    notifuse_mjml.EmailBlock
+	parentID string
+	order    []string // IDs of the children, in order
+}
+
+func flattenTree(root notifuse_mjml.EmailBlock) (map[string]*treeNode, []string) {
+	nodes := map[string]*treeNode{}
+	var ids []string
+	var walk func(block notifuse_mjml.EmailBlock, parentID string)
+	walk = func(block notifuse_mjml.EmailBlock, parentID string) {
+		if block == nil {
+			return
+		}
+		node := &treeNode{block: block, parentID: parentID}
+		nodes[block.GetID()] = node
+		ids = append(ids, block.GetID())
+		for _, child := range block.GetChildren() {
+			if child == nil {
+				continue
+			}
+			node.order = append(node.order, child.GetID())
+			walk(child, block.GetID())
+		}
+	}
+	walk(root, "")
+	return nodes, ids
+}
+
+// siblingIndex returns the position of a block among the siblings present in both versions,
+// so that inserting or removing a block does not report its siblings as moved
+func siblingIndex(nodes map[string]*treeNode, id string, shared map[string]bool) int {
+	parent, ok := nodes[nodes[id].parentID]
+	if !ok {
+		return 0
+	}
+	index := 0
+	for _, siblingID := range parent.order {
+		if siblingID == id {
+			return index
+		}
+		if shared[siblingID] {
+			index++
+		}
+	}
+	return index
+}
+
+// addBlocks matches the blocks of two visual editor trees by ID
+func (d *TemplateVersionDiff) addBlocks(from, to notifuse_mjml.EmailBlock) {
+	oldNodes, oldIDs := flattenTree(from)
+	newNodes, newIDs := flattenTree(to)
+
+	shared := map[string]bool{}
+	for id := range newNodes {
+		if _, ok := oldNodes[id]; ok {
+			shared[id] = true
+		}
+	}
+
+	for _, id := range newIDs {
+		newNode := newNodes[id]
+		change := TemplateChange{Path: "blocks." + id, BlockID: id, BlockType: string(newNode.block.GetType())}
+
+		oldNode, ok := oldNodes[id]
+		if !ok {
+			change.Type = TemplateChangeAdded
+			change.To = newNode.block
+			d.Changes = append(d.Changes, change)
+			continue
+		}
+
+		oldIndex := siblingIndex(oldNodes, id, shared)
+		newIndex := siblingIndex(newNodes, id, shared)
+		if oldNode.parentID != newNode.parentID || oldIndex != newIndex {
+			moved := change
+			moved.Type = TemplateChangeMoved
+			moved.From = map[string]interface{}{"parent_id": oldNode.parentID, "index": oldIndex}
+			moved.To = map[string]interface{}{"parent_id": newNode.parentID, "index": newIndex}
+			d.Changes = append(d.Changes, moved)
+		}
+
+		if !sameJSON(oldNode.block.GetContent(), newNode.block.GetContent()) {
+			modified := change
+			modified.Path += ".content"
+			modified.Type = TemplateChangeModified
+			modified.From = stringValue(oldNode.block.GetContent())
+			modified.To = stringValue(newNode.block.GetContent())
+			d.Changes = append(d.Changes, modified)
+		}
+
+		oldAttributes := oldNode.block.GetAttributes()
+		newAttributes := newNode.block.GetAttributes()
+		keys := map[string]bool{}
+		for key := range oldAttributes {
+			keys[key] = true
+		}
+		for key := range newAttributes {
+			keys[key] = true
+		}
+		sortedKeys := make([]string, 0, len(keys))
+		for key := range keys {
+			sortedKeys = append(sortedKeys, key)
+		}
+		sort.Strings(sortedKeys)
+		for _, key := range sortedKeys {
+			if sameJSON(oldAttributes[key], newAttributes[key]) {
+				continue
+			}
+			modified := change
+			modified.Path += ".attributes." + key
+			modified.Type = TemplateChangeModified
+			modified.From = oldAttributes[key]
+			modified.To = newAttributes[key]
+			d.Changes = append(d.Changes, modified)
+		}
+	}
+
+	for _, id := range oldIDs {
+		if _, ok := newNodes[id]; ok {
+			continue
+		}
+		oldNode := oldNodes[id]
+		d.Changes = append(d.Changes, TemplateChange{
+			Path:      "blocks." + id,
+			Type:      TemplateChangeRemoved,
+			BlockID:   id,
+			BlockType: string(oldNode.block.GetType()),
+			From:      oldNode.block,
+		})
+	}
+}
+
+// sameJSON compares two values by their JSON encoding, which ignores map ordering and
+// the concrete types of decoded numbers
+func sameJSON(a, b interface{}) bool {
+	aJSON, errA := json.Marshal(a)
+	bJSON, errB := json.Marshal(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	if string(aJSON) == string(bJSON) {
+		return true
+	}
+	// Empty values are equivalent
+	return isEmptyJSON(aJSON) && isEmptyJSON(bJSON)
+}
+
+func isEmptyJSON(data []byte) bool {
+	switch string(data) {
+	case "null", "{}", "[]", `""`:
+		return true
+	}
+	return false
+}
+
+type GetTemplateVersionsRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	ID          string `json:"id"`
+}
+
+func (r *GetTemplateVersionsRequest) FromURLParams(queryParams url.Values) error {
+	r.WorkspaceID = queryParams.Get("workspace_id")
+	r.ID = queryParams.Get("id")
+
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("invalid get template versions request: workspace_id is required")
+	}
+	if err := validateTemplateID(r.ID); err != nil {
+		return fmt.Errorf("invalid get template versions request: %w", err)
+	}
+
+	return nil
+}
+
+type DiffTemplateVersionsRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	ID          string `json:"id"`
+	From        int64  `json:"from"`
+	To          int64  `json:"to"`
+}
+
+func (r *DiffTemplateVersionsRequest) FromURLParams(queryParams url.Values) error {
+	r.WorkspaceID = queryParams.Get("workspace_id")
+	r.ID = queryParams.Get("id")
+
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("invalid diff template versions request: workspace_id is required")
+	}
+	if err := validateTemplateID(r.ID); err != nil {
+		return fmt.Errorf("invalid diff template versions request: %w", err)
+	}
+
+	var err error
+	if r.From, err = strconv.ParseInt(queryParams.Get("from"), 10, 64); err != nil || r.From <= 0 {
+		return fmt.Errorf("invalid diff template versions request: from must be a positive version")
+	}
+	if r.To, err = strconv.ParseInt(queryParams.Get("to"), 10, 64); err != nil || r.To <= 0 {
+		return fmt.Errorf("invalid diff template versions request: to must be a positive version")
+	}
+
+	return nil
+}
+
+// PublishTemplateRequest makes a version live, the latest one when Version is 0
+type PublishTemplateRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	ID          string `json:"id"`
+	Version     int64  `json:"version,omitempty"`
+}
+
+func (r *PublishTemplateRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("invalid publish template request: workspace_id is required")
+	}
+	if err := validateTemplateID(r.ID); err != nil {
+		return fmt.Errorf("invalid publish template request: %w", err)
+	}
+	if r.Version < 0 {
+		return fmt.Errorf("invalid publish template request: version cannot be negative")
+	}
+	return nil
+}
+
+// RollbackTemplateRequest restores a prior version as a new published version
+type RollbackTemplateRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	ID          string `json:"id"`
+	Version     int64  `json:"version"`
+}
+
+func (r *RollbackTemplateRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("invalid rollback template request: workspace_id is required")
+	}
+	if err := validateTemplateID(r.ID); err != nil {
+		return fmt.Errorf("invalid rollback template request: %w", err)
+	}
+	if r.Version <= 0 {
+		return fmt.Errorf("invalid rollback template request: version is required")
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/Notifuse/notifuse/pkg/notifuse_mjml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func diffTestText(id, content string, attributes map[string]interface{}) notifuse_mjml.EmailBlock {
+	base := notifuse_mjml.NewBaseBlock(id, notifuse_mjml.MJMLComponentMjText)
+	base.Content = &content
+	for key, value := range attributes {
+		base.Attributes[key] = value
+	}
+	return &notifuse_mjml.MJTextBlock{BaseBlock: base}
+}
+
+func diffTestTree(columns map[string][]notifuse_mjml.EmailBlock, order ...string) notifuse_mjml.EmailBlock {
+	var children []notifuse_mjml.EmailBlock
+	for _, id := range order {
+		column := notifuse_mjml.NewBaseBlock(id, notifuse_mjml.MJMLComponentMjColumn)
+		column.Children = columns[id]
+		children = append(children, &notifuse_mjml.MJColumnBlock{BaseBlock: column})
+	}
+	section := notifuse_mjml.NewBaseBlock("section", notifuse_mjml.MJMLComponentMjSection)
+	section.Children = children
+	root := notifuse_mjml.NewBaseBlock("root", notifuse_mjml.MJMLComponentMjml)
+	root.Children = []notifuse_mjml.EmailBlock{&notifuse_mjml.MJSectionBlock{BaseBlock: section}}
+	return &notifuse_mjml.MJMLBlock{BaseBlock: root}
+}
+
+func changesByPath(diff *TemplateVersionDiff) map[string]TemplateChange {
+	changes := map[string]TemplateChange{}
+	for _, change := range diff.Changes {
+		changes[change.Path+"#"+string(change.Type)] = change
+	}
+	return changes
+}
+
+func TestDiffTemplates_Fields(t *testing.T) {
+	preview := "Preview"
+	from := &Template{ID: "welcome", Version: 1, Name: "Welcome", Email: &EmailTemplate{Subject: "Hello"}}
+	to := &Template{ID: "welcome", Version: 2, Name: "Welcome", Email: &EmailTemplate{Subject: "Hi {{ name }}", SubjectPreview: &preview}}
+
+	diff := DiffTemplates(from, to)
+	assert.Equal(t, int64(1), diff.FromVersion)
+	assert.Equal(t, int64(2), diff.ToVersion)
+
+	changes := changesByPath(diff)
+	require.Len(t, changes, 2)
+	assert.Equal(t, "Hello", changes["email.subject#modified"].From)
+	assert.Equal(t, "Hi {{ name }}", changes["email.subject#modified"].To)
+	assert.Equal(t, "Preview", changes["email.subject_preview#modified"].To)
+}
+
+func TestDiffTemplates_NoChanges(t *testing.T) {
+	tree := diffTestTree(map[string][]notifuse_mjml.EmailBlock{"col1": {diffTestText("t1", "Hello", nil)}}, "col1")
+	from := &Template{ID: "welcome", Version: 1, Email: &EmailTemplate{Subject: "Hello", VisualEditorTree: tree}, TestData: MapOfAny{}}
+	to := &Template{ID: "welcome", Version: 2, Email: &EmailTemplate{Subject: "Hello", VisualEditorTree: tree}}
+
+	assert.Empty(t, DiffTemplates(from, to).Changes)
+}
+
+func TestDiffTemplates_Blocks(t *testing.T) {
+	from := &Template{ID: "welcome", Version: 1, Email: &EmailTemplate{
+		VisualEditorTree: diffTestTree(map[string][]notifuse_mjml.EmailBlock{
+			"col1": {diffTestText("t1", "Hello", map[string]interface{}{"color": "#000000"}), diffTestText("t2", "Bye", nil)},
+			"col2": {diffTestText("t3", "Removed", nil)},
+		}, "col1", "col2"),
+	}}
+	to := &Template{ID: "welcome", Version: 2, Email: &EmailTemplate{
+		VisualEditorTree: diffTestTree(map[string][]notifuse_mjml.EmailBlock{
+			// t4 is inserted before t1, which must not report t1 as moved
+			"col1": {diffTestText("t4", "New", nil), diffTestText("t1", "Hello!", map[string]interface{}{"color": "#ff0000"})},
+			"col2": {diffTestText("t2", "Bye", nil)},
+		}, "col1", "col2"),
+	}}
+
+	changes := changesByPath(DiffTemplates(from, to))
+
+	assert.Contains(t, changes, "blocks.t4#added")
+	assert.Equal(t, "mj-text", changes["blocks.t4#added"].BlockType)
+	assert.Contains(t, changes, "blocks.t3#removed")
+	assert.Equal(t, "Hello", changes["blocks.t1.content#modified"].From)
+	assert.Equal(t, "Hello!", changes["blocks.t1.content#modified"].To)
+	assert.Equal(t, "#ff0000", changes["blocks.t1.attributes.color#modified"].To)
+	assert.NotContains(t, changes, "blocks.t1#moved")
+
+	moved, ok := changes["blocks.t2#moved"]
+	require.True(t, ok)
+	assert.Equal(t, "col1", moved.From.(map[string]interface{})["parent_id"])
+	assert.Equal(t, "col2", moved.To.(map[string]interface{})["parent_id"])
+	assert.Len(t, changes, 5)
+}
+
+func TestDiffTemplates_Reorder(t *testing.T) {
+	columns := map[string][]notifuse_mjml.EmailBlock{"col1": nil, "col2": nil}
+	from := &Template{Version: 1, Email: &EmailTemplate{VisualEditorTree: diffTestTree(columns, "col1", "col2")}}
+	to := &Template{Version: 2, Email: &EmailTemplate{VisualEditorTree: diffTestTree(columns, "col2", "col1")}}
+
+	changes := changesByPath(DiffTemplates(from, to))
+	assert.Contains(t, changes, "blocks.col1#moved")
+	assert.Contains(t, changes, "blocks.col2#moved")
+}
+
+func TestDiffTemplates_MjmlSource(t *testing.T) {
+	oldSource := "<mjml>\n<mj-body>\n<mj-text>Hello</mj-text>\n</mj-body>\n</mjml>"
+	newSource := "<mjml>\n<mj-body>\n<mj-text>Hi</mj-text>\n<mj-divider />\n</mj-body>\n</mjml>"
+	from := &Template{Version: 1, Email: &EmailTemplate{EditorMode: EditorModeCode, MjmlSource: &oldSource}}
+	to := &Template{Version: 2, Email: &EmailTemplate{EditorMode: EditorModeCode, MjmlSource: &newSource}}
+
+	diff := DiffTemplates(from, to)
+	require.Len(t, diff.Changes, 3)
+	assert.Equal(t, TemplateChange{Path: "email.mjml_source", Type: TemplateChangeAdded, Line: 3, To: "<mj-text>Hi</mj-text>"}, diff.Changes[0])
+	assert.Equal(t, TemplateChange{Path: "email.mjml_source", Type: TemplateChangeAdded, Line: 4, To: "<mj-divider />"}, diff.Changes[1])
+	assert.Equal(t, TemplateChange{Path: "email.mjml_source", Type: TemplateChangeRemoved, Line: 3, From: "<mj-text>Hello</mj-text>"}, diff.Changes[2])
+}
+
+func TestDiffTemplates_Translations(t *testing.T) {
+	from := &Template{Version: 1, Translations: map[string]TemplateTranslation{
+		"fr": {Email: &EmailTemplate{Subject: "Bonjour"}},
+		"de": {Email: &EmailTemplate{Subject: "Hallo"}},
+	}}
+	to := &Template{Version: 2, Translations: map[string]TemplateTranslation{
+		"fr": {Email: &EmailTemplate{Subject: "Salut"}},
+		"es": {Email: &EmailTemplate{Subject: "Hola"}},
+	}}
+
+	changes := changesByPath(DiffTemplates(from, to))
+	assert.Contains(t, changes, "translations.de#removed")
+	assert.Contains(t, changes, "translations.es#added")
+	assert.Contains(t, changes, "translations.fr#modified")
+}
+
+func TestDiffTemplateVersionsRequest_FromURLParams(t *testing.T) {
+	var req DiffTemplateVersionsRequest
+	err := req.FromURLParams(url.Values{"workspace_id": {"ws1"}, "id": {"welcome"}, "from": {"1"}, "to": {"3"}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), req.From)
+	assert.Equal(t, int64(3), req.To)
+
+	err = req.FromURLParams(url.Values{"workspace_id": {"ws1"}, "id": {"welcome"}, "from": {"1"}})
+	assert.ErrorContains(t, err, "to must be a positive version")
+
+	err = req.FromURLParams(url.Values{"id": {"welcome"}, "from": {"1"}, "to": {"2"}})
+	assert.ErrorContains(t, err, "workspace_id is required")
+}
+
+func TestPublishAndRollbackTemplateRequests_Validate(t *testing.T) {
+	assert.NoError(t, (&PublishTemplateRequest{WorkspaceID: "ws1", ID: "welcome"}).Validate())
+	assert.Error(t, (&PublishTemplateRequest{WorkspaceID: "ws1", ID: "welcome", Version: -1}).Validate())
+	assert.Error(t, (&PublishTemplateRequest{ID: "welcome"}).Validate())
+
+	assert.NoError(t, (&RollbackTemplateRequest{WorkspaceID: "ws1", ID: "welcome", Version: 2}).Validate())
+	assert.ErrorContains(t, (&RollbackTemplateRequest{WorkspaceID: "ws1", ID: "welcome"}).Validate(), "version is required")
+}
@@ -0,0 +1,478 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Notifuse/notifuse/pkg/liquid"
+)
+
+//go:generate mockgen -destination mocks/mock_translation_repository.go -package mocks github.com/Notifuse/notifuse/internal/domain TranslationRepository
+//go:generate mockgen -destination mocks/mock_translation_service.go -package mocks github.com/Notifuse/notifuse/internal/domain TranslationService
+
+// ErrTranslationNotFound is returned when deleting a key that has no translation
+var ErrTranslationNotFound = errors.New("translation not found")
+
+const (
+	// MaxTranslationKeyLength bounds the length of a translation key
+	MaxTranslationKeyLength = 255
+	// MaxTranslationValueLength bounds the length of a translated string, in characters
+	MaxTranslationValueLength = 10000
+	// MaxTranslationsPerRequest bounds the strings upserted or imported by a single request
+	MaxTranslationsPerRequest = 10000
+	// MaxTranslationImportSize bounds the size of an imported file
+	MaxTranslationImportSize = 5 * 1024 * 1024
+)
+
+// translationKeyRegexp accepts dotted keys such as "cart.items.one"
+var translationKeyRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+(\.[A-Za-z0-9_-]+)*$`)
+
+// translationFilterRegexp finds the literal keys passed to the t filter, such as {{ "cart.title" | t }}.
+// Quotes may be escaped when the template is serialized as JSON.
+var translationFilterRegexp = regexp.MustCompile(`\\?["']([A-Za-z0-9_.-]+)\\?["']\s*\|\s*t\b`)
+
+// translationPluralForms are the sub-keys the t filter picks from with a count
+var translationPluralForms = []string{"zero", "one", "other"}
+
+// Translation is the string of a key in one language
+type Translation struct {
+	Key       string    `json:"key"`
+	Language  string    `json:"language"`
+	Value     string    `json:"value"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ValidateTranslationKey checks that a key is made of dot separated segments of letters, digits, "_" and "-"
+func ValidateTranslationKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("key is required")
+	}
+	if len(key) > MaxTranslationKeyLength {
+		return fmt.Errorf("key %q is longer than %d characters", key, MaxTranslationKeyLength)
+	}
+	if !translationKeyRegexp.MatchString(key) {
+		return fmt.Errorf("key %q must be made of letters, digits, \"_\" and \"-\" separated by dots", key)
+	}
+	return nil
+}
+
+// validateTranslationStrings checks the keys and values of a set of strings of one language
+func validateTranslationStrings(translations map[string]string) error {
+	if len(translations) == 0 {
+		return fmt.Errorf("translations is required")
+	}
+	if len(translations) > MaxTranslationsPerRequest {
+		return fmt.Errorf("cannot store more than %d translations per request", MaxTranslationsPerRequest)
+	}
+	for key, value := range translations {
+		if err := ValidateTranslationKey(key); err != nil {
+			return err
+		}
+		if value == "" {
+			return fmt.Errorf("translation of %q is empty", key)
+		}
+		if utf8.RuneCountInString(value) > MaxTranslationValueLength {
+			return fmt.Errorf("translation of %q is longer than %d characters", key, MaxTranslationValueLength)
+		}
+	}
+	return nil
+}
+
+// TranslationFallbackLanguages returns the languages looked up for a contact language, in order:
+// the language itself, its base language ("pt" for "pt-BR") and the workspace default language
+func TranslationFallbackLanguages(language string, defaultLanguage string) []string {
+	languages := make([]string, 0, 3)
+	add := func(lang string) {
+		if lang == "" {
+			return
+		}
+		for _, existing := range languages {
+			if existing == lang {
+				return
+			}
+		}
+		languages = append(languages, lang)
+	}
+
+	add(language)
+	if base, _, found := strings.Cut(language, "-"); found {
+		add(base)
+	}
+	add(defaultLanguage)
+	return languages
+}
+
+// TranslationKeys returns the literal keys passed to the t filter in the given template sources
+func TranslationKeys(sources ...string) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, source := range sources {
+		for _, match := range translationFilterRegexp.FindAllStringSubmatch(source, -1) {
+			if !seen[match[1]] {
+				seen[match[1]] = true
+				keys = append(keys, match[1])
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// TranslationKeys returns the keys the email translates with the t filter,
+// in its subject, preview, plain text, MJML source or visual editor tree
+func (e *EmailTemplate) TranslationKeys() []string {
+	if e == nil {
+		return nil
+	}
+	sources := []string{e.Subject}
+	if e.SubjectPreview != nil {
+		sources = append(sources, *e.SubjectPreview)
+	}
+	if e.Text != nil {
+		sources = append(sources, *e.Text)
+	}
+	if mjml := e.GetCodeModeMjmlSource(); mjml != nil {
+		sources = append(sources, *mjml)
+	} else if e.VisualEditorTree != nil {
+		if tree, err := json.Marshal(e.VisualEditorTree); err == nil {
+			sources = append(sources, string(tree))
+		}
+	}
+	return TranslationKeys(sources...)
+}
+
+// TranslationKeys returns the keys the email content of the template and of its
+// language variants translate with the t filter
+func (t *Template) TranslationKeys() []string {
+	all := t.Email.TranslationKeys()
+	for _, translation := range t.Translations {
+		all = append(all, translation.Email.TranslationKeys()...)
+	}
+	seen := make(map[string]bool, len(all))
+	keys := make([]string, 0, len(all))
+	for _, key := range all {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// TranslationLookupKeys expands keys with their plural forms, which is what a
+// catalog needs to load to render them
+func TranslationLookupKeys(keys []string) []string {
+	lookup := make([]string, 0, len(keys)*(len(translationPluralForms)+1))
+	for _, key := range keys {
+		lookup = append(lookup, key)
+		for _, form := range translationPluralForms {
+			lookup = append(lookup, key+"."+form)
+		}
+	}
+	return lookup
+}
+
+// TranslationCatalog holds the strings of a workspace by language
+type TranslationCatalog struct {
+	DefaultLanguage string
+	values          map[string]map[string]string
+}
+
+// NewTranslationCatalog indexes translations by language, falling back to defaultLanguage
+func NewTranslationCatalog(defaultLanguage string, translations []*Translation) *TranslationCatalog {
+	catalog := &TranslationCatalog{
+		DefaultLanguage: defaultLanguage,
+		values:          make(map[string]map[string]string),
+	}
+	for _, translation := range translations {
+		if catalog.values[translation.Language] == nil {
+			catalog.values[translation.Language] = make(map[string]string)
+		}
+		catalog.values[translation.Language][translation.Key] = translation.Value
+	}
+	return catalog
+}
+
+// Lookup returns the string of a key for a language, following the fallback languages
+func (c *TranslationCatalog) Lookup(language string, key string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	for _, lang := range TranslationFallbackLanguages(language, c.DefaultLanguage) {
+		if value, ok := c.values[lang][key]; ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// Resolve returns the strings of the catalog for a language, following the fallback languages,
+// nested by the segments of their keys so that the t filter and {{ translations.cart.title }}
+// can read them. A key holding a string and sub-keys at once, like "cart" and "cart.title",
+// keeps the sub-keys. Returns nil for an empty catalog.
+func (c *TranslationCatalog) Resolve(language string) MapOfAny {
+	if c == nil || len(c.values) == 0 {
+		return nil
+	}
+
+	// Merge from the last fallback so that more specific languages win
+	merged := make(map[string]string)
+	fallbacks := TranslationFallbackLanguages(language, c.DefaultLanguage)
+	for i := len(fallbacks) - 1; i >= 0; i-- {
+		for key, value := range c.values[fallbacks[i]] {
+			merged[key] = value
+		}
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+
+	// Sorted keys put "cart" before "cart.title", so sub-keys replace the string
+	keys := make([]string, 0, len(merged))
+	for key := range merged {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	resolved := MapOfAny{}
+	for _, key := range keys {
+		segments := strings.Split(key, ".")
+		node := resolved
+		for _, segment := range segments[:len(segments)-1] {
+			child, ok := node[segment].(MapOfAny)
+			if !ok {
+				child = MapOfAny{}
+				node[segment] = child
+			}
+			node = child
+		}
+		last := segments[len(segments)-1]
+		if _, isMap := node[last].(MapOfAny); !isMap {
+			node[last] = merged[key]
+		}
+	}
+	return resolved
+}
+
+// Apply sets the strings of a language into template data, where the t filter reads them.
+// Data is left untouched when the catalog holds nothing for the language.
+func (c *TranslationCatalog) Apply(data MapOfAny, language string) {
+	if data == nil {
+		return
+	}
+	if resolved := c.Resolve(language); resolved != nil {
+		data[liquid.TranslationsKey] = resolved
+	}
+}
+
+// TranslationFormat is a file format exchanged with localization vendors
+type TranslationFormat string
+
+const (
+	// TranslationFormatJSON is an object of keys and strings, nested objects are joined with dots
+	TranslationFormatJSON TranslationFormat = "json"
+	// TranslationFormatXLIFF is an XLIFF 1.2 document pairing the default language with the target
+	TranslationFormatXLIFF TranslationFormat = "xliff"
+	// TranslationFormatPO is a gettext catalog where msgctxt holds the key
+	TranslationFormatPO TranslationFormat = "po"
+)
+
+// IsValid returns whether the format is supported
+func (f TranslationFormat) IsValid() bool {
+	switch f {
+	case TranslationFormatJSON, TranslationFormatXLIFF, TranslationFormatPO:
+		return true
+	}
+	return false
+}
+
+// ListTranslationsRequest lists the strings of a workspace, optionally of a single language
+type ListTranslationsRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	Language    string `json:"language,omitempty"`
+}
+
+// FromURLParams parses query parameters into a ListTranslationsRequest
+func (r *ListTranslationsRequest) FromURLParams(values url.Values) error {
+	r.WorkspaceID = values.Get("workspace_id")
+	r.Language = values.Get("language")
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	if r.Language != "" && !IsValidLanguage(r.Language) {
+		return fmt.Errorf("invalid language: %s", r.Language)
+	}
+	return nil
+}
+
+// ListTranslationsResponse lists strings ordered by key and language
+type ListTranslationsResponse struct {
+	Translations    []*Translation `json:"translations"`
+	DefaultLanguage string         `json:"default_language"`
+}
+
+// UpsertTranslationsRequest creates or replaces strings of one language
+type UpsertTranslationsRequest struct {
+	WorkspaceID  string            `json:"workspace_id"`
+	Language     string            `json:"language"`
+	Translations map[string]string `json:"translations"`
+}
+
+// Validate checks the language, keys and values
+func (r *UpsertTranslationsRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	if !IsValidLanguage(r.Language) {
+		return fmt.Errorf("invalid language: %s", r.Language)
+	}
+	return validateTranslationStrings(r.Translations)
+}
+
+// UpsertTranslationsResponse reports how many strings were stored
+type UpsertTranslationsResponse struct {
+	Language string `json:"language"`
+	Count    int    `json:"count"`
+}
+
+// DeleteTranslationRequest deletes a key in one language, or in every language when Language is empty
+type DeleteTranslationRequest struct {
+	WorkspaceID string `json:"workspace_id"`
+	Key         string `json:"key"`
+	Language    string `json:"language,omitempty"`
+}
+
+// Validate checks the key and the optional language
+func (r *DeleteTranslationRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	if err := ValidateTranslationKey(r.Key); err != nil {
+		return err
+	}
+	if r.Language != "" && !IsValidLanguage(r.Language) {
+		return fmt.Errorf("invalid language: %s", r.Language)
+	}
+	return nil
+}
+
+// ImportTranslationsRequest stores the strings of a file returned by a localization vendor.
+// The language is read from XLIFF and PO files when it is not given.
+type ImportTranslationsRequest struct {
+	WorkspaceID string            `json:"workspace_id"`
+	Language    string            `json:"language,omitempty"`
+	Format      TranslationFormat `json:"format"`
+	Content     string            `json:"content"`
+}
+
+// Validate checks the format, the size of the content and the optional language
+func (r *ImportTranslationsRequest) Validate() error {
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	if !r.Format.IsValid() {
+		return fmt.Errorf("invalid format: %s (expected json, xliff or po)", r.Format)
+	}
+	if strings.TrimSpace(r.Content) == "" {
+		return fmt.Errorf("content is required")
+	}
+	if len(r.Content) > MaxTranslationImportSize {
+		return fmt.Errorf("content exceeds maximum allowed size (%d bytes)", MaxTranslationImportSize)
+	}
+	if r.Language != "" && !IsValidLanguage(r.Language) {
+		return fmt.Errorf("invalid language: %s", r.Language)
+	}
+	return nil
+}
+
+// ExportTranslationsRequest exports the strings of a language for a localization vendor
+type ExportTranslationsRequest struct {
+	WorkspaceID string            `json:"workspace_id"`
+	Language    string            `json:"language"`
+	Format      TranslationFormat `json:"format"`
+}
+
+// FromURLParams parses query parameters into an ExportTranslationsRequest
+func (r *ExportTranslationsRequest) FromURLParams(values url.Values) error {
+	r.WorkspaceID = values.Get("workspace_id")
+	r.Language = values.Get("language")
+	r.Format = TranslationFormat(values.Get("format"))
+	if r.WorkspaceID == "" {
+		return fmt.Errorf("workspace_id is required")
+	}
+	if !IsValidLanguage(r.Language) {
+		return fmt.Errorf("invalid language: %s", r.Language)
+	}
+	if r.Format == "" {
+		r.Format = TranslationFormatJSON
+	}
+	if !r.Format.IsValid() {
+		return fmt.Errorf("invalid format: %s (expected json, xliff or po)", r.Format)
+	}
+	return nil
+}
+
+// TranslationExport is an exported file
+type TranslationExport struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// TranslationRepository stores the strings of a workspace
+type TranslationRepository interface {
+	// List returns the strings of the given languages and keys ordered by key and language,
+	// an empty filter returning every string
+	List(ctx context.Context, workspaceID string, languages []string, keys []string) ([]*Translation, error)
+
+	// Upsert creates or replaces strings of one language
+	Upsert(ctx context.Context, workspaceID string, language string, translations map[string]string) error
+
+	// Delete removes a key in one language, or in every language when language is empty,
+	// returning ErrTranslationNotFound when nothing was removed
+	Delete(ctx context.Context, workspaceID string, key string, language string) error
+}
+
+// TranslationService manages the translation catalogs of workspaces
+type TranslationService interface {
+	ListTranslations(ctx context.Context, req *ListTranslationsRequest) (*ListTranslationsResponse, error)
+	UpsertTranslations(ctx context.Context, req *UpsertTranslationsRequest) (*UpsertTranslationsResponse, error)
+	DeleteTranslation(ctx context.Context, req *DeleteTranslationRequest) error
+	ImportTranslations(ctx context.Context, req *ImportTranslationsRequest) (*UpsertTranslationsResponse, error)
+	ExportTranslations(ctx context.Context, req *ExportTranslationsRequest) (*TranslationExport, error)
+}
+
+// LoadTranslationCatalog loads the strings a set of keys need to render in the given languages,
+// all languages when empty. Returns nil without keys or without a repository.
+func LoadTranslationCatalog(ctx context.Context, repo TranslationRepository, workspaceID string, defaultLanguage string, languages []string, keys []string) (*TranslationCatalog, error) {
+	if repo == nil || len(keys) == 0 {
+		return nil, nil
+	}
+	translations, err := repo.List(ctx, workspaceID, languages, TranslationLookupKeys(keys))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load translations: %w", err)
+	}
+	return NewTranslationCatalog(defaultLanguage, translations), nil
+}
+
+// ApplyTranslations sets into data the strings the email content translates with the t filter,
+// in the contact language with its fallbacks
+func ApplyTranslations(ctx context.Context, repo TranslationRepository, workspaceID string, defaultLanguage string, language string, content *EmailTemplate, data MapOfAny) error {
+	catalog, err := LoadTranslationCatalog(ctx, repo, workspaceID, defaultLanguage,
+		TranslationFallbackLanguages(language, defaultLanguage), content.TranslationKeys())
+	if err != nil {
+		return err
+	}
+	catalog.Apply(data, language)
+	return nil
+}
@@ -0,0 +1,362 @@
+package domain
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseTranslations reads the strings of a file exchanged with a localization vendor.
+// It returns the language declared by XLIFF and PO files, empty for JSON.
+func ParseTranslations(format TranslationFormat, content string) (string, map[string]string, error) {
+	switch format {
+	case TranslationFormatJSON:
+		translations, err := parseTranslationsJSON(content)
+		return "", translations, err
+	case TranslationFormatXLIFF:
+		return parseTranslationsXLIFF(content)
+	case TranslationFormatPO:
+		return parseTranslationsPO(content)
+	}
+	return "", nil, fmt.Errorf("invalid format: %s", format)
+}
+
+// FormatTranslations writes the strings of a target language for a localization vendor.
+// XLIFF and PO files pair every key of the source language with its target string,
+// empty when not translated yet. JSON files only hold the target strings.
+func FormatTranslations(format TranslationFormat, sourceLanguage string, targetLanguage string, source map[string]string, target map[string]string) ([]byte, error) {
+	switch format {
+	case TranslationFormatJSON:
+		content, err := json.MarshalIndent(target, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode translations: %w", err)
+		}
+		return append(content, '\n'), nil
+	case TranslationFormatXLIFF:
+		return formatTranslationsXLIFF(sourceLanguage, targetLanguage, source, target)
+	case TranslationFormatPO:
+		return formatTranslationsPO(targetLanguage, source, target), nil
+	}
+	return nil, fmt.Errorf("invalid format: %s", format)
+}
+
+// TranslationFileExtension returns the extension of exported files
+func TranslationFileExtension(format TranslationFormat) string {
+	if format == TranslationFormatXLIFF {
+		return "xlf"
+	}
+	return string(format)
+}
+
+// TranslationContentType returns the MIME type of exported files
+func TranslationContentType(format TranslationFormat) string {
+	switch format {
+	case TranslationFormatXLIFF:
+		return "application/x-xliff+xml"
+	case TranslationFormatPO:
+		return "text/x-gettext-translation"
+	}
+	return "application/json"
+}
+
+// sortedTranslationKeys returns the keys of both sets of strings, sorted
+func sortedTranslationKeys(source map[string]string, target map[string]string) []string {
+	keys := make([]string, 0, len(source)+len(target))
+	for key := range source {
+		keys = append(keys, key)
+	}
+	for key := range target {
+		if _, ok := source[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// parseTranslationsJSON reads an object of strings, joining the keys of nested objects with dots
+func parseTranslationsJSON(content string) (map[string]string, error) {
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(content), &document); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	translations := make(map[string]string)
+	var flatten func(prefix string, node map[string]interface{}) error
+	flatten = func(prefix string, node map[string]interface{}) error {
+		for name, value := range node {
+			key := name
+			if prefix != "" {
+				key = prefix + "." + name
+			}
+			switch v := value.(type) {
+			case string:
+				translations[key] = v
+			case map[string]interface{}:
+				if err := flatten(key, v); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("value of %q must be a string or an object", key)
+			}
+		}
+		return nil
+	}
+	if err := flatten("", document); err != nil {
+		return nil, err
+	}
+	return translations, nil
+}
+
+type xliffDocument struct {
+	XMLName xml.Name  `xml:"urn:oasis:names:tc:xliff:document:1.2 xliff"`
+	Version string    `xml:"version,attr"`
+	File    xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	Original       string      `xml:"original,attr"`
+	SourceLanguage string      `xml:"source-language,attr"`
+	TargetLanguage string      `xml:"target-language,attr"`
+	Datatype       string      `xml:"datatype,attr"`
+	Units          []xliffUnit `xml:"body>trans-unit"`
+}
+
+type xliffUnit struct {
+	ID      string `xml:"id,attr"`
+	Resname string `xml:"resname,attr"`
+	Source  string `xml:"source"`
+	Target  string `xml:"target"`
+}
+
+func formatTranslationsXLIFF(sourceLanguage string, targetLanguage string, source map[string]string, target map[string]string) ([]byte, error) {
+	document := xliffDocument{
+		Version: "1.2",
+		File: xliffFile{
+			Original:       "notifuse",
+			SourceLanguage: sourceLanguage,
+			TargetLanguage: targetLanguage,
+			Datatype:       "plaintext",
+		},
+	}
+	for _, key := range sortedTranslationKeys(source, target) {
+		document.File.Units = append(document.File.Units, xliffUnit{
+			ID:      key,
+			Resname: key,
+			Source:  source[key],
+			Target:  target[key],
+		})
+	}
+
+	content, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode XLIFF: %w", err)
+	}
+	return append([]byte(xml.Header), append(content, '\n')...), nil
+}
+
+// parseTranslationsXLIFF reads the targets of XLIFF 1.2 trans-units and XLIFF 2 units, keyed by
+// resname or id. Inline markup inside targets is flattened to its text. Units without a target
+// are skipped.
+func parseTranslationsXLIFF(content string) (string, map[string]string, error) {
+	decoder := xml.NewDecoder(strings.NewReader(content))
+	translations := make(map[string]string)
+	language := ""
+	unitKey := ""
+	var target *strings.Builder
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid XLIFF: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "xliff":
+				// XLIFF 2 declares the languages on the root element
+				if lang := xmlAttribute(t, "trgLang"); lang != "" {
+					language = lang
+				}
+			case "file":
+				if lang := xmlAttribute(t, "target-language"); lang != "" {
+					language = lang
+				}
+			case "trans-unit", "unit":
+				unitKey = xmlAttribute(t, "resname")
+				if unitKey == "" {
+					unitKey = xmlAttribute(t, "id")
+				}
+			case "target":
+				if unitKey != "" {
+					target = &strings.Builder{}
+				}
+			}
+		case xml.CharData:
+			if target != nil {
+				target.Write(t)
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "target":
+				if target != nil && target.Len() > 0 {
+					// XLIFF 2 units may hold several segments
+					translations[unitKey] += target.String()
+				}
+				target = nil
+			case "trans-unit", "unit":
+				unitKey = ""
+			}
+		}
+	}
+
+	if unitKey != "" || target != nil {
+		return "", nil, fmt.Errorf("invalid XLIFF: unexpected end of document")
+	}
+	return language, translations, nil
+}
+
+func xmlAttribute(element xml.StartElement, name string) string {
+	for _, attr := range element.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+func formatTranslationsPO(targetLanguage string, source map[string]string, target map[string]string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("msgid \"\"\nmsgstr \"\"\n")
+	buf.WriteString("\"Content-Type: text/plain; charset=UTF-8\\n\"\n")
+	buf.WriteString("\"Language: " + poEscape(targetLanguage) + "\\n\"\n")
+
+	for _, key := range sortedTranslationKeys(source, target) {
+		// msgid cannot be empty outside the header, the key stands in for missing sources
+		msgid := source[key]
+		if msgid == "" {
+			msgid = key
+		}
+		buf.WriteString("\nmsgctxt \"" + poEscape(key) + "\"\n")
+		buf.WriteString("msgid \"" + poEscape(msgid) + "\"\n")
+		buf.WriteString("msgstr \"" + poEscape(target[key]) + "\"\n")
+	}
+	return buf.Bytes()
+}
+
+// parseTranslationsPO reads a gettext catalog. Entries are keyed by msgctxt, or by msgid when they
+// have no context. Fuzzy and untranslated entries are skipped, as are plural entries.
+func parseTranslationsPO(content string) (string, map[string]string, error) {
+	translations := make(map[string]string)
+	language := ""
+
+	type entry struct {
+		context, id, str *string
+		plural, fuzzy    bool
+	}
+	var current entry
+	var field *string
+	lineNumber := 0
+
+	flush := func() {
+		switch {
+		case current.id == nil:
+		case current.context == nil && *current.id == "":
+			// The header holds the language of the catalog
+			if current.str != nil {
+				for _, line := range strings.Split(*current.str, "\n") {
+					if name, value, found := strings.Cut(line, ":"); found && strings.TrimSpace(name) == "Language" {
+						language = strings.TrimSpace(value)
+					}
+				}
+			}
+		case current.fuzzy || current.plural || current.str == nil || *current.str == "":
+		default:
+			key := *current.id
+			if current.context != nil {
+				key = *current.context
+			}
+			translations[key] = *current.str
+		}
+		current = entry{}
+		field = nil
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	scanner.Buffer(make([]byte, 64*1024), MaxTranslationImportSize)
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "#,"):
+			if current.id != nil {
+				flush()
+			}
+			current.fuzzy = strings.Contains(line, "fuzzy")
+		case strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "\""):
+			if field == nil {
+				return "", nil, fmt.Errorf("invalid PO: unexpected string on line %d", lineNumber)
+			}
+			value, err := strconv.Unquote(line)
+			if err != nil {
+				return "", nil, fmt.Errorf("invalid PO: malformed string on line %d", lineNumber)
+			}
+			*field += value
+		default:
+			keyword, rest, _ := strings.Cut(line, " ")
+			value, err := strconv.Unquote(strings.TrimSpace(rest))
+			if err != nil {
+				return "", nil, fmt.Errorf("invalid PO: malformed string on line %d", lineNumber)
+			}
+			switch {
+			case keyword == "msgctxt":
+				if current.id != nil {
+					flush()
+				}
+				current.context = &value
+				field = current.context
+			case keyword == "msgid":
+				if current.id != nil {
+					flush()
+				}
+				current.id = &value
+				field = current.id
+			case keyword == "msgid_plural", strings.HasPrefix(keyword, "msgstr["):
+				current.plural = true
+				field = &value
+			case keyword == "msgstr":
+				current.str = &value
+				field = current.str
+			default:
+				return "", nil, fmt.Errorf("invalid PO: unknown keyword %q on line %d", keyword, lineNumber)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", nil, fmt.Errorf("invalid PO: %w", err)
+	}
+	flush()
+
+	return language, translations, nil
+}
+
+// poEscape escapes a string for a PO file
+func poEscape(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", `\r`)
+	return replacer.Replace(value)
+}
@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	formatSource = map[string]string{
+		"welcome":    "Welcome {{ name }}!",
+		"cart.title": "Your \"cart\"",
+		"footer":     "Line one\nLine two",
+	}
+	formatTarget = map[string]string{
+		"welcome":    "Bienvenue {{ name }} !",
+		"cart.title": "Votre « panier » & plus",
+		"only.fr":    "Seulement en français",
+	}
+)
+
+func TestTranslationFormats_RoundTrip(t *testing.T) {
+	for _, format := range []TranslationFormat{TranslationFormatJSON, TranslationFormatXLIFF, TranslationFormatPO} {
+		t.Run(string(format), func(t *testing.T) {
+			content, err := FormatTranslations(format, "en", "fr", formatSource, formatTarget)
+			require.NoError(t, err)
+
+			language, translations, err := ParseTranslations(format, string(content))
+			require.NoError(t, err)
+			assert.Equal(t, formatTarget, translations)
+			if format != TranslationFormatJSON {
+				assert.Equal(t, "fr", language)
+			}
+		})
+	}
+}
+
+func TestFormatTranslations_XLIFF(t *testing.T) {
+	content, err := FormatTranslations(TranslationFormatXLIFF, "en", "fr", formatSource, formatTarget)
+	require.NoError(t, err)
+
+	xliff := string(content)
+	assert.True(t, strings.HasPrefix(xliff, `<?xml version="1.0" encoding="UTF-8"?>`))
+	assert.Contains(t, xliff, `<xliff xmlns="urn:oasis:names:tc:xliff:document:1.2" version="1.2">`)
+	assert.Contains(t, xliff, `source-language="en" target-language="fr"`)
+	// Untranslated keys of the source language are listed with an empty target
+	assert.Contains(t, xliff, `<trans-unit id="footer" resname="footer">`)
+	assert.Contains(t, xliff, `<target></target>`)
+	assert.Contains(t, xliff, `<target>Votre « panier » &amp; plus</target>`)
+}
+
+func TestFormatTranslations_PO(t *testing.T) {
+	content, err := FormatTranslations(TranslationFormatPO, "en", "fr", formatSource, formatTarget)
+	require.NoError(t, err)
+
+	po := string(content)
+	assert.True(t, strings.HasPrefix(po, "msgid \"\"\nmsgstr \"\"\n\"Content-Type: text/plain; charset=UTF-8\\n\"\n\"Language: fr\\n\"\n"))
+	assert.Contains(t, po, "msgctxt \"cart.title\"\nmsgid \"Your \\\"cart\\\"\"\nmsgstr \"Votre « panier » & plus\"\n")
+	assert.Contains(t, po, "msgctxt \"footer\"\nmsgid \"Line one\\nLine two\"\nmsgstr \"\"\n")
+	// Keys missing from the source use the key as msgid
+	assert.Contains(t, po, "msgctxt \"only.fr\"\nmsgid \"only.fr\"\n")
+}
+
+func TestFormatTranslations_JSON(t *testing.T) {
+	content, err := FormatTranslations(TranslationFormatJSON, "en", "fr", formatSource, formatTarget)
+	require.NoError(t, err)
+
+	var decoded map[string]string
+	require.NoError(t, json.Unmarshal(content, &decoded))
+	assert.Equal(t, formatTarget, decoded)
+}
+
+func TestParseTranslations_JSON(t *testing.T) {
+	_, translations, err := ParseTranslations(TranslationFormatJSON, `{"welcome": "Hallo", "cart": {"title": "Warenkorb", "items": {"one": "1 Artikel"}}}`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"welcome": "Hallo", "cart.title": "Warenkorb", "cart.items.one": "1 Artikel"}, translations)
+
+	_, _, err = ParseTranslations(TranslationFormatJSON, `{"count": 3}`)
+	assert.ErrorContains(t, err, `value of "count" must be a string or an object`)
+
+	_, _, err = ParseTranslations(TranslationFormatJSON, `["welcome"]`)
+	assert.ErrorContains(t, err, "invalid JSON")
+}
+
+func TestParseTranslations_XLIFF2(t *testing.T) {
+	content := `<?xml version="1.0" encoding="UTF-8"?>
+<xliff xmlns="urn:oasis:names:tc:xliff:document:2.0" version="2.0" srcLang="en" trgLang="de">
+  <file id="f1">
+    <unit id="welcome">
+      <segment><source>Welcome</source><target>Willkommen</target></segment>
+    </unit>
+    <unit id="cart.title">
+      <segment><source>Your </source><target>Ihr </target></segment>
+      <segment><source><pc id="1">cart</pc></source><target><pc id="1">Warenkorb</pc></target></segment>
+    </unit>
+    <unit id="untranslated">
+      <segment><source>Later</source></segment>
+    </unit>
+  </file>
+</xliff>`
+
+	language, translations, err := ParseTranslations(TranslationFormatXLIFF, content)
+	require.NoError(t, err)
+	assert.Equal(t, "de", language)
+	assert.Equal(t, map[string]string{"welcome": "Willkommen", "cart.title": "Ihr Warenkorb"}, translations)
+
+	_, _, err = ParseTranslations(TranslationFormatXLIFF, `<xliff><file><body><trans-unit id="a"><target>x`)
+	assert.ErrorContains(t, err, "invalid XLIFF")
+}
+
+func TestParseTranslations_PO(t *testing.T) {
+	content := `# Translation of the Notifuse catalog
+msgid ""
+msgstr ""
+"Project-Id-Version: notifuse\n"
+"Language: es\n"
+
+#: welcome email
+msgctxt "welcome"
+msgid "Welcome"
+msgstr "Bienvenido"
+
+#, fuzzy
+msgctxt "cart.title"
+msgid "Your cart"
+msgstr "Tu cesta"
+
+msgctxt "footer"
+msgid ""
+"Line one\n"
+"Line two"
+msgstr ""
+"Línea uno\n"
+"Línea dos"
+
+msgid "Untranslated"
+msgstr ""
+
+msgid "Plain"
+msgstr "Sencillo"
+
+msgid "item"
+msgid_plural "items"
+msgstr[0] "artículo"
+msgstr[1] "artículos"
+`
+
+	language, translations, err := ParseTranslations(TranslationFormatPO, content)
+	require.NoError(t, err)
+	assert.Equal(t, "es", language)
+	assert.Equal(t, map[string]string{
+		"welcome": "Bienvenido",
+		"footer":  "Línea uno\nLínea dos",
+		"Plain":   "Sencillo",
+	}, translations)
+
+	_, _, err = ParseTranslations(TranslationFormatPO, "msgid \"a\"\nmsgstr \"b")
+	assert.ErrorContains(t, err, "malformed string on line 2")
+
+	_, _, err = ParseTranslations(TranslationFormatPO, "msgfoo \"a\"")
+	assert.ErrorContains(t, err, "unknown keyword")
+}
+
+func TestTranslationFileHelpers(t *testing.T) {
+	assert.Equal(t, "xlf", TranslationFileExtension(TranslationFormatXLIFF))
+	assert.Equal(t, "po", TranslationFileExtension(TranslationFormatPO))
+	assert.Equal(t, "json", TranslationFileExtension(TranslationFormatJSON))
+	assert.Equal(t, "application/x-xliff+xml", TranslationContentType(TranslationFormatXLIFF))
+	assert.Equal(t, "text/x-gettext-translation", TranslationContentType(TranslationFormatPO))
+	assert.Equal(t, "application/json", TranslationContentType(TranslationFormatJSON))
+}
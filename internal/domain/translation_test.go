@@ -0,0 +1,246 @@
+package domain
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTranslationKey(t *testing.T) {
+	for _, key := range []string{"welcome", "cart.items.one", "footer_links.privacy-policy", "a1.B2"} {
+		assert.NoError(t, ValidateTranslationKey(key), key)
+	}
+	for _, key := range []string{"", "cart.", ".cart", "cart..title", "cart title", "cart/title", strings.Repeat("a", 256)} {
+		assert.Error(t, ValidateTranslationKey(key), key)
+	}
+}
+
+func TestTranslationFallbackLanguages(t *testing.T) {
+	assert.Equal(t, []string{"pt-BR", "pt", "en"}, TranslationFallbackLanguages("pt-BR", "en"))
+	assert.Equal(t, []string{"fr", "en"}, TranslationFallbackLanguages("fr", "en"))
+	assert.Equal(t, []string{"en"}, TranslationFallbackLanguages("en", "en"))
+	assert.Equal(t, []string{"en"}, TranslationFallbackLanguages("", "en"))
+	assert.Equal(t, []string{"zh-TW", "zh"}, TranslationFallbackLanguages("zh-TW", "zh"))
+}
+
+func TestTranslationKeys(t *testing.T) {
+	keys := TranslationKeys(
+		`{{ "welcome" | t: "name", contact.first_name }} {{ 'cart.items'|t: "count", 2 }}`,
+		`{{ "welcome" | t }} {{ "not_translated" | upcase }} {{ "trim" | truncate: 3 }}`,
+		`{"content":"{{ \"footer.unsubscribe\" | t }}"}`,
+	)
+	assert.Equal(t, []string{"cart.items", "footer.unsubscribe", "welcome"}, keys)
+	assert.Empty(t, TranslationKeys("Hello {{ contact.first_name }}"))
+}
+
+func TestEmailTemplate_TranslationKeys(t *testing.T) {
+	preview := `{{ "preview" | t }}`
+	text := `{{ "text.body" | t }}`
+	mjml := `<mjml><mj-body><mj-text>{{ "mjml.body" | t }}</mj-text></mj-body></mjml>`
+
+	code := &EmailTemplate{
+		EditorMode:     EditorModeCode,
+		Subject:        `{{ "subject" | t }}`,
+		SubjectPreview: &preview,
+		Text:           &text,
+		MjmlSource:     &mjml,
+	}
+	assert.Equal(t, []string{"mjml.body", "preview", "subject", "text.body"}, code.TranslationKeys())
+
+	visual := &EmailTemplate{Subject: "Hello", VisualEditorTree: diffTestText("text-1", `{{ "visual.body" | t }}`, nil)}
+	assert.Equal(t, []string{"visual.body"}, visual.TranslationKeys())
+
+	var missing *EmailTemplate
+	assert.Nil(t, missing.TranslationKeys())
+}
+
+func TestTemplate_TranslationKeys(t *testing.T) {
+	template := &Template{
+		Email: &EmailTemplate{Subject: `{{ "subject" | t }}`},
+		Translations: map[string]TemplateTranslation{
+			"fr": {Email: &EmailTemplate{Subject: `{{ "subject" | t }} {{ "greeting" | t }}`}},
+			"de": {},
+		},
+	}
+	assert.Equal(t, []string{"greeting", "subject"}, template.TranslationKeys())
+	assert.Empty(t, (&Template{}).TranslationKeys())
+}
+
+func TestTranslationLookupKeys(t *testing.T) {
+	assert.Equal(t, []string{"cart", "cart.zero", "cart.one", "cart.other"}, TranslationLookupKeys([]string{"cart"}))
+}
+
+func TestTranslationCatalog(t *testing.T) {
+	catalog := NewTranslationCatalog("en", []*Translation{
+		{Key: "welcome", Language: "en", Value: "Welcome"},
+		{Key: "welcome", Language: "pt", Value: "Bem-vindo"},
+		{Key: "goodbye", Language: "en", Value: "Goodbye"},
+		{Key: "cart.title", Language: "en", Value: "Your cart"},
+		{Key: "cart.title", Language: "pt-BR", Value: "Seu carrinho"},
+		{Key: "cart.items.one", Language: "en", Value: "{{ count }} item"},
+		{Key: "cart.items.other", Language: "en", Value: "{{ count }} items"},
+		{Key: "cart", Language: "en", Value: "Cart"},
+	})
+
+	t.Run("lookup follows the fallback languages", func(t *testing.T) {
+		value, ok := catalog.Lookup("pt-BR", "welcome")
+		assert.True(t, ok)
+		assert.Equal(t, "Bem-vindo", value)
+
+		value, ok = catalog.Lookup("pt-BR", "goodbye")
+		assert.True(t, ok)
+		assert.Equal(t, "Goodbye", value)
+
+		_, ok = catalog.Lookup("fr", "missing")
+		assert.False(t, ok)
+	})
+
+	t.Run("resolve nests the keys", func(t *testing.T) {
+		resolved := catalog.Resolve("pt-BR")
+		assert.Equal(t, MapOfAny{
+			"welcome": "Bem-vindo",
+			"goodbye": "Goodbye",
+			"cart": MapOfAny{
+				"title": "Seu carrinho",
+				"items": MapOfAny{"one": "{{ count }} item", "other": "{{ count }} items"},
+			},
+		}, resolved)
+
+		assert.Equal(t, "Welcome", catalog.Resolve("")["welcome"])
+	})
+
+	t.Run("empty catalogs resolve to nil", func(t *testing.T) {
+		var missing *TranslationCatalog
+		assert.Nil(t, missing.Resolve("fr"))
+		assert.Nil(t, NewTranslationCatalog("en", nil).Resolve("fr"))
+		assert.Nil(t, NewTranslationCatalog("en", []*Translation{{Key: "a", Language: "de", Value: "A"}}).Resolve("fr"))
+	})
+
+	t.Run("apply sets the translations of the language", func(t *testing.T) {
+		data := MapOfAny{"contact": MapOfAny{"email": "a@example.com"}}
+		catalog.Apply(data, "pt")
+		assert.Equal(t, "Bem-vindo", data["translations"].(MapOfAny)["welcome"])
+
+		untouched := MapOfAny{}
+		NewTranslationCatalog("en", nil).Apply(untouched, "fr")
+		assert.NotContains(t, untouched, "translations")
+	})
+}
+
+type stubTranslationRepository struct {
+	TranslationRepository
+	languages, keys []string
+	translations    []*Translation
+}
+
+func (r *stubTranslationRepository) List(ctx context.Context, workspaceID string, languages []string, keys []string) ([]*Translation, error) {
+	r.languages, r.keys = languages, keys
+	return r.translations, nil
+}
+
+func TestLoadTranslationCatalog(t *testing.T) {
+	repo := &stubTranslationRepository{translations: []*Translation{{Key: "welcome", Language: "en", Value: "Welcome"}}}
+
+	catalog, err := LoadTranslationCatalog(context.Background(), repo, "ws1", "en", []string{"fr", "en"}, []string{"welcome"})
+	require.NoError(t, err)
+	assert.Equal(t, "Welcome", catalog.Resolve("fr")["welcome"])
+	assert.Equal(t, []string{"fr", "en"}, repo.languages)
+	assert.Equal(t, TranslationLookupKeys([]string{"welcome"}), repo.keys)
+
+	catalog, err = LoadTranslationCatalog(context.Background(), repo, "ws1", "en", nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, catalog)
+
+	catalog, err = LoadTranslationCatalog(context.Background(), nil, "ws1", "en", nil, []string{"welcome"})
+	require.NoError(t, err)
+	assert.Nil(t, catalog)
+}
+
+func TestApplyTranslations(t *testing.T) {
+	repo := &stubTranslationRepository{translations: []*Translation{{Key: "welcome", Language: "fr", Value: "Bienvenue"}}}
+	content := &EmailTemplate{Subject: `{{ "welcome" | t }}`}
+
+	data := MapOfAny{}
+	require.NoError(t, ApplyTranslations(context.Background(), repo, "ws1", "en", "fr-CA", content, data))
+	assert.Equal(t, MapOfAny{"welcome": "Bienvenue"}, data["translations"])
+	assert.Equal(t, []string{"fr-CA", "fr", "en"}, repo.languages)
+
+	untouched := MapOfAny{}
+	require.NoError(t, ApplyTranslations(context.Background(), nil, "ws1", "en", "fr", content, untouched))
+	assert.Empty(t, untouched)
+}
+
+func TestUpsertTranslationsRequest_Validate(t *testing.T) {
+	valid := UpsertTranslationsRequest{WorkspaceID: "ws1", Language: "fr", Translations: map[string]string{"welcome": "Bienvenue"}}
+	assert.NoError(t, valid.Validate())
+
+	tests := []struct {
+		name   string
+		modify func(r *UpsertTranslationsRequest)
+		errMsg string
+	}{
+		{"missing workspace", func(r *UpsertTranslationsRequest) { r.WorkspaceID = "" }, "workspace_id is required"},
+		{"invalid language", func(r *UpsertTranslationsRequest) { r.Language = "xx" }, "invalid language"},
+		{"no translations", func(r *UpsertTranslationsRequest) { r.Translations = nil }, "translations is required"},
+		{"invalid key", func(r *UpsertTranslationsRequest) { r.Translations = map[string]string{"a b": "x"} }, "key \"a b\""},
+		{"empty value", func(r *UpsertTranslationsRequest) { r.Translations = map[string]string{"a": ""} }, "is empty"},
+		{"value too long", func(r *UpsertTranslationsRequest) {
+			r.Translations = map[string]string{"a": strings.Repeat("é", MaxTranslationValueLength+1)}
+		}, "longer than"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := valid
+			tt.modify(&req)
+			err := req.Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestDeleteTranslationRequest_Validate(t *testing.T) {
+	assert.NoError(t, (&DeleteTranslationRequest{WorkspaceID: "ws1", Key: "welcome"}).Validate())
+	assert.NoError(t, (&DeleteTranslationRequest{WorkspaceID: "ws1", Key: "welcome", Language: "fr"}).Validate())
+	assert.Error(t, (&DeleteTranslationRequest{WorkspaceID: "ws1", Key: ""}).Validate())
+	assert.Error(t, (&DeleteTranslationRequest{WorkspaceID: "ws1", Key: "welcome", Language: "xx"}).Validate())
+	assert.Error(t, (&DeleteTranslationRequest{Key: "welcome"}).Validate())
+}
+
+func TestImportTranslationsRequest_Validate(t *testing.T) {
+	assert.NoError(t, (&ImportTranslationsRequest{WorkspaceID: "ws1", Format: TranslationFormatPO, Content: "msgid \"\""}).Validate())
+	assert.NoError(t, (&ImportTranslationsRequest{WorkspaceID: "ws1", Language: "de", Format: TranslationFormatJSON, Content: "{}"}).Validate())
+
+	assert.ErrorContains(t, (&ImportTranslationsRequest{WorkspaceID: "ws1", Format: "csv", Content: "{}"}).Validate(), "invalid format")
+	assert.ErrorContains(t, (&ImportTranslationsRequest{WorkspaceID: "ws1", Format: TranslationFormatJSON, Content: " "}).Validate(), "content is required")
+	assert.ErrorContains(t, (&ImportTranslationsRequest{WorkspaceID: "ws1", Language: "xx", Format: TranslationFormatJSON, Content: "{}"}).Validate(), "invalid language")
+	assert.ErrorContains(t, (&ImportTranslationsRequest{WorkspaceID: "ws1", Format: TranslationFormatJSON,
+		Content: strings.Repeat(" ", MaxTranslationImportSize) + "{}"}).Validate(), "maximum allowed size")
+}
+
+func TestExportTranslationsRequest_FromURLParams(t *testing.T) {
+	var req ExportTranslationsRequest
+	require.NoError(t, req.FromURLParams(url.Values{"workspace_id": {"ws1"}, "language": {"fr"}}))
+	assert.Equal(t, TranslationFormatJSON, req.Format)
+
+	require.NoError(t, req.FromURLParams(url.Values{"workspace_id": {"ws1"}, "language": {"fr"}, "format": {"xliff"}}))
+	assert.Equal(t, TranslationFormatXLIFF, req.Format)
+
+	assert.Error(t, req.FromURLParams(url.Values{"workspace_id": {"ws1"}, "language": {"fr"}, "format": {"csv"}}))
+	assert.Error(t, req.FromURLParams(url.Values{"workspace_id": {"ws1"}}))
+	assert.Error(t, req.FromURLParams(url.Values{"language": {"fr"}}))
+}
+
+func TestListTranslationsRequest_FromURLParams(t *testing.T) {
+	var req ListTranslationsRequest
+	require.NoError(t, req.FromURLParams(url.Values{"workspace_id": {"ws1"}}))
+	assert.Empty(t, req.Language)
+	require.NoError(t, req.FromURLParams(url.Values{"workspace_id": {"ws1"}, "language": {"pt-BR"}}))
+	assert.Equal(t, "pt-BR", req.Language)
+	assert.Error(t, req.FromURLParams(url.Values{"workspace_id": {"ws1"}, "language": {"xx"}}))
+
+}
@@ -21,9 +21,10 @@ type TreeNodeBranch struct {
 
 // TreeNodeLeaf represents an actual condition on a data source
 type TreeNodeLeaf struct {
-	Source           string                     `json:"source"` // "contacts", "contact_lists", "contact_timeline", "custom_events_goals"
+	Source           string                     `json:"source"` // "contacts", "contact_lists", "contact_tags", "contact_timeline", "custom_events_goals"
 	Contact          *ContactCondition          `json:"contact,omitempty"`
 	ContactList      *ContactListCondition      `json:"contact_list,omitempty"`
+	ContactTag       *ContactTagCondition       `json:"contact_tag,omitempty"`
 	ContactTimeline  *ContactTimelineCondition  `json:"contact_timeline,omitempty"`
 	CustomEventsGoal *CustomEventsGoalCondition `json:"custom_events_goal,omitempty"`
 }
@@ -40,6 +41,12 @@ type ContactListCondition struct {
 	Status   *string `json:"status,omitempty"`
 }
 
+// ContactTagCondition represents whether contacts carry a tag
+type ContactTagCondition struct {
+	Operator string `json:"operator"` // "in" or "not_in"
+	Tag      string `json:"tag"`
+}
+
 // ContactTimelineCondition represents conditions on contact timeline events
 type ContactTimelineCondition struct {
 	Kind              string             `json:"kind"`           // Timeline event kind
@@ -139,6 +146,11 @@ func (l *TreeNodeLeaf) Validate() error {
 			return fmt.Errorf("leaf with source 'contact_lists' must have 'contact_list' field")
 		}
 		return l.ContactList.Validate()
+	case "contact_tags":
+		if l.ContactTag == nil {
+			return fmt.Errorf("leaf with source 'contact_tags' must have 'contact_tag' field")
+		}
+		return l.ContactTag.Validate()
 	case "contact_timeline":
 		if l.ContactTimeline == nil {
 			return fmt.Errorf("leaf with source 'contact_timeline' must have 'contact_timeline' field")
@@ -150,7 +162,7 @@ func (l *TreeNodeLeaf) Validate() error {
 		}
 		return l.CustomEventsGoal.Validate()
 	default:
-		return fmt.Errorf("invalid source: %s (must be 'contacts', 'contact_lists', 'contact_timeline', or 'custom_events_goals')", l.Source)
+		return fmt.Errorf("invalid source: %s (must be 'contacts', 'contact_lists', 'contact_tags', 'contact_timeline', or 'custom_events_goals')", l.Source)
 	}
 }
 
@@ -185,6 +197,20 @@ func (c *ContactListCondition) Validate() error {
 	return nil
 }
 
+// Validate validates contact tag conditions and normalizes the tag
+func (c *ContactTagCondition) Validate() error {
+	if c.Operator != "in" && c.Operator != "not_in" {
+		return fmt.Errorf("invalid contact_tag operator: %s (must be 'in' or 'not_in')", c.Operator)
+	}
+
+	c.Tag = NormalizeTag(c.Tag)
+	if c.Tag == "" {
+		return fmt.Errorf("contact_tag condition must have 'tag'")
+	}
+
+	return nil
+}
+
 // Validate validates contact timeline conditions
 func (c *ContactTimelineCondition) Validate() error {
 	if c.Kind == "" {
@@ -323,16 +349,17 @@ func (f *DimensionFilter) Validate() error {
 
 	// Validate JSONPath usage
 	if len(f.JSONPath) > 0 {
-		// JSONPath can only be used with custom_json fields
+		// JSONPath can only be used with custom_json fields and typed attributes
 		validJSONFields := map[string]bool{
 			"custom_json_1": true,
 			"custom_json_2": true,
 			"custom_json_3": true,
 			"custom_json_4": true,
 			"custom_json_5": true,
+			"attributes":    true,
 		}
 		if !validJSONFields[f.FieldName] {
-			return fmt.Errorf("json_path can only be used with custom_json fields (custom_json_1 through custom_json_5)")
+			return fmt.Errorf("json_path can only be used with custom_json fields (custom_json_1 through custom_json_5) or attributes")
 		}
 
 		// Validate each path segment is non-empty
@@ -350,16 +377,17 @@ func (f *DimensionFilter) Validate() error {
 
 	// Special validation for json field_type (when field_type is explicitly "json")
 	if f.FieldType == "json" {
-		// json field_type can only be used with custom_json fields
+		// json field_type can only be used with custom_json fields and typed attributes
 		validJSONFields := map[string]bool{
 			"custom_json_1": true,
 			"custom_json_2": true,
 			"custom_json_3": true,
 			"custom_json_4": true,
 			"custom_json_5": true,
+			"attributes":    true,
 		}
 		if !validJSONFields[f.FieldName] {
-			return fmt.Errorf("field_type 'json' can only be used with custom_json fields")
+			return fmt.Errorf("field_type 'json' can only be used with custom_json fields or attributes")
 		}
 
 		// JSONPath is required for json field type (except for existence checks on the root)
@@ -922,6 +922,35 @@ func TestContactListCondition_Validate(t *testing.T) {
 	}
 }
 
+func TestContactTagCondition_Validate(t *testing.T) {
+	t.Run("normalizes the tag", func(t *testing.T) {
+		cond := ContactTagCondition{Operator: "not_in", Tag: " VIP "}
+		require.NoError(t, cond.Validate())
+		assert.Equal(t, "vip", cond.Tag)
+	})
+
+	t.Run("invalid operator", func(t *testing.T) {
+		cond := ContactTagCondition{Operator: "equals", Tag: "vip"}
+		err := cond.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid contact_tag operator")
+	})
+
+	t.Run("missing tag", func(t *testing.T) {
+		cond := ContactTagCondition{Operator: "in", Tag: "  "}
+		err := cond.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "contact_tag condition must have 'tag'")
+	})
+
+	t.Run("leaf requires the condition", func(t *testing.T) {
+		leaf := TreeNodeLeaf{Source: "contact_tags"}
+		err := leaf.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must have 'contact_tag' field")
+	})
+}
+
 func TestContactTimelineCondition_Validate(t *testing.T) {
 	// Test ContactTimelineCondition.Validate - this was at 0% coverage
 	tests := []struct {
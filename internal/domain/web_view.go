@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Notifuse/notifuse/pkg/crypto"
+)
+
+// DefaultWebViewRetentionDays is how long the web version of a message stays available when
+// the workspace does not configure it
+const DefaultWebViewRetentionDays = 90
+
+// maxWebViewRetentionDays bounds the retention window to ten years
+const maxWebViewRetentionDays = 3650
+
+var (
+	// ErrWebViewNotFound is returned for unknown messages and invalid signatures alike,
+	// so that web view URLs cannot be guessed
+	ErrWebViewNotFound = errors.New("web view not found")
+	// ErrWebViewExpired is returned when the message is older than the retention window
+	ErrWebViewExpired = errors.New("web view expired")
+)
+
+// WebViewSettings configures the hosted web version of emails
+type WebViewSettings struct {
+	// RetentionDays is how long after sending a message its web version can be viewed,
+	// 90 days by default
+	RetentionDays int `json:"retention_days,omitempty"`
+	// PublicBroadcasts serves the web version of broadcasts, without personal data, to anyone
+	// with the link and regardless of the retention window
+	PublicBroadcasts bool `json:"public_broadcasts"`
+}
+
+// GetRetention returns the configured retention window or the default
+func (s *WebViewSettings) GetRetention() time.Duration {
+	days := DefaultWebViewRetentionDays
+	if s != nil && s.RetentionDays > 0 {
+		days = s.RetentionDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// ArePublicBroadcastsEnabled returns true if broadcast web versions are public
+func (s *WebViewSettings) ArePublicBroadcastsEnabled() bool {
+	return s != nil && s.PublicBroadcasts
+}
+
+// Validate validates the web view settings
+func (s *WebViewSettings) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.RetentionDays < 0 || s.RetentionDays > maxWebViewRetentionDays {
+		return fmt.Errorf("web view retention_days must be between 0 and %d", maxWebViewRetentionDays)
+	}
+	return nil
+}
+
+// ComputeWebViewSignature signs a message of a workspace with the workspace secret key
+func ComputeWebViewSignature(workspaceID, messageID, secretKey string) string {
+	return crypto.ComputeHMAC256([]byte(workspaceID+"\n"+messageID), secretKey)
+}
+
+// VerifyWebViewSignature checks a web view signature in constant time
+func VerifyWebViewSignature(workspaceID, messageID, secretKey, signature string) bool {
+	expected := ComputeWebViewSignature(workspaceID, messageID, secretKey)
+	return hmac.Equal([]byte(expected), []byte(strings.ToLower(signature)))
+}
+
+// BuildWebViewURL returns the signed URL of the web version of a message:
+// {endpoint}/w/{messageID}?wid={workspaceID}&sig={signature}
+func BuildWebViewURL(endpoint, workspaceID, messageID, secretKey string) string {
+	params := url.Values{}
+	params.Set("wid", workspaceID)
+	params.Set("sig", ComputeWebViewSignature(workspaceID, messageID, secretKey))
+	return fmt.Sprintf("%s/w/%s?%s", strings.TrimRight(endpoint, "/"), url.PathEscape(messageID), params.Encode())
+}
+
+// BuildPublicBroadcastURL returns the URL of the public web version of a broadcast:
+// {endpoint}/w/b/{broadcastID}?wid={workspaceID}
+func BuildPublicBroadcastURL(endpoint, workspaceID, broadcastID string) string {
+	params := url.Values{}
+	params.Set("wid", workspaceID)
+	return fmt.Sprintf("%s/w/b/%s?%s", strings.TrimRight(endpoint, "/"), url.PathEscape(broadcastID), params.Encode())
+}
+
+// publicTemplateDataKeys are the template data that hold no personal data and are kept when
+// a message is rendered for the public
+var publicTemplateDataKeys = []string{
+	"broadcast", "list", "global_feed", "posts", "workspace",
+	"utm_source", "utm_medium", "utm_campaign", "utm_term", "utm_content",
+}
+
+// PublicTemplateData strips the personal data from the template data of a message: the contact,
+// its feed, the message ID and the links signed for the contact. Links are replaced by "#".
+func PublicTemplateData(data MapOfAny) MapOfAny {
+	public := MapOfAny{"contact": MapOfAny{}}
+	for _, key := range publicTemplateDataKeys {
+		if value, ok := data[key]; ok {
+			public[key] = value
+		}
+	}
+	for _, key := range []string{"unsubscribe_url", "oneclick_unsubscribe_url", "confirm_subscription_url",
+		"notification_center_url", "web_view_url"} {
+		public[key] = "#"
+	}
+	return public
+}
+
+// WebView is the rendered web version of an email
+type WebView struct {
+	Subject string `json:"subject"`
+	HTML    string `json:"html"`
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebViewSettings(t *testing.T) {
+	t.Run("defaults when nil", func(t *testing.T) {
+		var settings *WebViewSettings
+		assert.Equal(t, DefaultWebViewRetentionDays*24*time.Hour, settings.GetRetention())
+		assert.False(t, settings.ArePublicBroadcastsEnabled())
+		assert.NoError(t, settings.Validate())
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		settings := &WebViewSettings{RetentionDays: 30, PublicBroadcasts: true}
+		assert.Equal(t, 30*24*time.Hour, settings.GetRetention())
+		assert.True(t, settings.ArePublicBroadcastsEnabled())
+		assert.NoError(t, settings.Validate())
+	})
+
+	t.Run("invalid retention", func(t *testing.T) {
+		assert.Error(t, (&WebViewSettings{RetentionDays: -1}).Validate())
+		assert.Error(t, (&WebViewSettings{RetentionDays: maxWebViewRetentionDays + 1}).Validate())
+	})
+}
+
+func TestBuildWebViewURL(t *testing.T) {
+	webViewURL := BuildWebViewURL("https://api.example.com/", "ws-123", "msg-456", "secret")
+
+	parsed, err := url.Parse(webViewURL)
+	require.NoError(t, err)
+	assert.Equal(t, "/w/msg-456", parsed.Path)
+	assert.Equal(t, "ws-123", parsed.Query().Get("wid"))
+
+	signature := parsed.Query().Get("sig")
+	assert.True(t, VerifyWebViewSignature("ws-123", "msg-456", "secret", signature))
+	assert.False(t, VerifyWebViewSignature("ws-123", "msg-789", "secret", signature))
+	assert.False(t, VerifyWebViewSignature("ws-999", "msg-456", "secret", signature))
+	assert.False(t, VerifyWebViewSignature("ws-123", "msg-456", "other-secret", signature))
+	assert.False(t, VerifyWebViewSignature("ws-123", "msg-456", "secret", ""))
+}
+
+func TestBuildPublicBroadcastURL(t *testing.T) {
+	assert.Equal(t, "https://api.example.com/w/b/bc-1?wid=ws-123", BuildPublicBroadcastURL("https://api.example.com", "ws-123", "bc-1"))
+}
+
+func TestPublicTemplateData(t *testing.T) {
+	data := MapOfAny{
+		"contact":         MapOfAny{"email": "john@example.com"},
+		"message_id":      "msg-456",
+		"broadcast":       MapOfAny{"id": "bc-1"},
+		"list":            MapOfAny{"id": "newsletter"},
+		"workspace":       MapOfAny{"base_url": "https://api.example.com"},
+		"utm_source":      "newsletter",
+		"unsubscribe_url": "https://api.example.com/notification-center?email=john%40example.com",
+		"web_view_url":    "https://api.example.com/w/msg-456?sig=abc",
+	}
+
+	public := PublicTemplateData(data)
+
+	assert.Equal(t, MapOfAny{}, public["contact"])
+	assert.NotContains(t, public, "message_id")
+	assert.Equal(t, data["broadcast"], public["broadcast"])
+	assert.Equal(t, data["list"], public["list"])
+	assert.Equal(t, data["workspace"], public["workspace"])
+	assert.Equal(t, "newsletter", public["utm_source"])
+	assert.Equal(t, "#", public["unsubscribe_url"])
+	assert.Equal(t, "#", public["web_view_url"])
+	assert.Equal(t, "#", public["notification_center_url"])
+}
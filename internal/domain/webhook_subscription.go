@@ -81,6 +81,7 @@ var WebhookEventTypes = []string{
 	"contact.created",
 	"contact.updated",
 	"contact.deleted",
+	"contact.merged",
 	// List events
 	"list.subscribed",
 	"list.unsubscribed",
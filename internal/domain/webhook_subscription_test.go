@@ -14,6 +14,7 @@ func TestWebhookEventTypes_ContainsExpectedEvents(t *testing.T) {
 		"contact.created",
 		"contact.updated",
 		"contact.deleted",
+		"contact.merged",
 		// List events
 		"list.subscribed",
 		"list.unsubscribed",
@@ -322,7 +323,7 @@ func TestWebhookEventTypes_GroupedByCategory(t *testing.T) {
 	}
 
 	// Verify expected categories
-	assert.Equal(t, 3, categories["contact"], "Should have 3 contact events")
+	assert.Equal(t, 4, categories["contact"], "Should have 4 contact events")
 	assert.Equal(t, 8, categories["list"], "Should have 8 list events")
 	assert.Equal(t, 2, categories["segment"], "Should have 2 segment events")
 	assert.Equal(t, 7, categories["email"], "Should have 7 email events")
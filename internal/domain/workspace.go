@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -29,6 +30,8 @@ const (
 	PermissionResourceBlog           PermissionResource = "blog"
 	PermissionResourceAutomations    PermissionResource = "automations"
 	PermissionResourceLLM            PermissionResource = "llm"
+	// Write access to broadcast approvals allows to approve or reject the broadcasts of other members
+	PermissionResourceBroadcastApprovals PermissionResource = "broadcast_approvals"
 )
 
 // PermissionType defines the types of permissions (read/write)
@@ -40,16 +43,17 @@ const (
 )
 
 var FullPermissions = UserPermissions{
-	PermissionResourceContacts:       ResourcePermissions{Read: true, Write: true},
-	PermissionResourceLists:          ResourcePermissions{Read: true, Write: true},
-	PermissionResourceTemplates:      ResourcePermissions{Read: true, Write: true},
-	PermissionResourceBroadcasts:     ResourcePermissions{Read: true, Write: true},
-	PermissionResourceTransactional:  ResourcePermissions{Read: true, Write: true},
-	PermissionResourceWorkspace:      ResourcePermissions{Read: true, Write: true},
-	PermissionResourceMessageHistory: ResourcePermissions{Read: true, Write: true},
-	PermissionResourceBlog:           ResourcePermissions{Read: true, Write: true},
-	PermissionResourceAutomations:    ResourcePermissions{Read: true, Write: true},
-	PermissionResourceLLM:            ResourcePermissions{Read: true, Write: true},
+	PermissionResourceContacts:           ResourcePermissions{Read: true, Write: true},
+	PermissionResourceLists:              ResourcePermissions{Read: true, Write: true},
+	PermissionResourceTemplates:          ResourcePermissions{Read: true, Write: true},
+	PermissionResourceBroadcasts:         ResourcePermissions{Read: true, Write: true},
+	PermissionResourceTransactional:      ResourcePermissions{Read: true, Write: true},
+	PermissionResourceWorkspace:          ResourcePermissions{Read: true, Write: true},
+	PermissionResourceMessageHistory:     ResourcePermissions{Read: true, Write: true},
+	PermissionResourceBlog:               ResourcePermissions{Read: true, Write: true},
+	PermissionResourceAutomations:        ResourcePermissions{Read: true, Write: true},
+	PermissionResourceLLM:                ResourcePermissions{Read: true, Write: true},
+	PermissionResourceBroadcastApprovals: ResourcePermissions{Read: true, Write: true},
 }
 
 // ResourcePermissions defines read/write permissions for a specific resource
@@ -282,6 +286,7 @@ type BlogSettings struct {
 	CategoryPageSize int          `json:"category_page_size,omitempty"` // Posts per page on category (default: 20)
 	FeedSummaryOnly  bool         `json:"feed_summary_only,omitempty"`  // When true, RSS/JSON feeds emit excerpt instead of full HTML
 	FeedMaxItems     int          `json:"feed_max_items,omitempty"`     // Items per RSS/JSON feed (default and cap: 20)
+	ArchiveListIDs   []string     `json:"archive_list_ids,omitempty"`   // Lists whose past broadcasts are published under /archive
 }
 
 // GetHomePageSize returns the home page size with validation and default
@@ -308,6 +313,11 @@ func (bs *BlogSettings) GetFeedMaxItems() int {
 	return bs.FeedMaxItems
 }
 
+// IsListArchived returns true if the broadcasts of a list are published in the newsletter archive
+func (bs *BlogSettings) IsListArchived(listID string) bool {
+	return bs != nil && listID != "" && slices.Contains(bs.ArchiveListIDs, listID)
+}
+
 // Value implements the driver.Valuer interface for database serialization
 func (b BlogSettings) Value() (driver.Value, error) {
 	return json.Marshal(b)
@@ -330,22 +340,38 @@ func (b *BlogSettings) Scan(value interface{}) error {
 
 // WorkspaceSettings contains configurable workspace settings
 type WorkspaceSettings struct {
-	WebsiteURL                   string              `json:"website_url,omitempty"`
-	LogoURL                      string              `json:"logo_url,omitempty"`
-	CoverURL                     string              `json:"cover_url,omitempty"`
-	Timezone                     string              `json:"timezone"`
-	FileManager                  FileManagerSettings `json:"file_manager,omitempty"`
-	TransactionalEmailProviderID string              `json:"transactional_email_provider_id,omitempty"`
-	MarketingEmailProviderID     string              `json:"marketing_email_provider_id,omitempty"`
-	EncryptedSecretKey           string              `json:"encrypted_secret_key,omitempty"`
-	EmailTrackingEnabled         bool                `json:"email_tracking_enabled"`
-	TemplateBlocks               []TemplateBlock     `json:"template_blocks,omitempty"`
-	CustomEndpointURL            *string             `json:"custom_endpoint_url,omitempty"`
-	CustomFieldLabels            map[string]string   `json:"custom_field_labels,omitempty"`
-	BlogEnabled                  bool                `json:"blog_enabled"`            // Enable blog feature at workspace level
-	BlogSettings                 *BlogSettings       `json:"blog_settings,omitempty"` // Blog styling and SEO settings
-	DefaultLanguage              string              `json:"default_language"`
-	Languages                    []string            `json:"languages"`
+	WebsiteURL                   string                      `json:"website_url,omitempty"`
+	LogoURL                      string                      `json:"logo_url,omitempty"`
+	CoverURL                     string                      `json:"cover_url,omitempty"`
+	Timezone                     string                      `json:"timezone"`
+	FileManager                  FileManagerSettings         `json:"file_manager,omitempty"`
+	TransactionalEmailProviderID string                      `json:"transactional_email_provider_id,omitempty"`
+	MarketingEmailProviderID     string                      `json:"marketing_email_provider_id,omitempty"`
+	EncryptedSecretKey           string                      `json:"encrypted_secret_key,omitempty"`
+	EmailTrackingEnabled         bool                        `json:"email_tracking_enabled"`
+	TemplateBlocks               []TemplateBlock             `json:"template_blocks,omitempty"`
+	CustomEndpointURL            *string                     `json:"custom_endpoint_url,omitempty"`
+	CustomFieldLabels            map[string]string           `json:"custom_field_labels,omitempty"`
+	ContactAttributes            ContactAttributeDefinitions `json:"contact_attributes,omitempty"`
+	BlogEnabled                  bool                        `json:"blog_enabled"`            // Enable blog feature at workspace level
+	BlogSettings                 *BlogSettings               `json:"blog_settings,omitempty"` // Blog styling and SEO settings
+	DefaultLanguage              string                      `json:"default_language"`
+	Languages                    []string                    `json:"languages"`
+
+	// NotificationCenterDataExportEnabled lets contacts download their data from the notification center
+	NotificationCenterDataExportEnabled bool `json:"notification_center_data_export_enabled"`
+
+	// EmailVerification verifies contact addresses when they are added
+	EmailVerification *EmailVerificationSettings `json:"email_verification,omitempty"`
+
+	// BroadcastApproval requires broadcasts to be approved before they are scheduled
+	BroadcastApproval *BroadcastApprovalSettings `json:"broadcast_approval,omitempty"`
+
+	// WebView configures the hosted web version of emails linked by {{ web_view_url }}
+	WebView *WebViewSettings `json:"web_view,omitempty"`
+
+	// TemplateSecrets are the keys the hmac and sign_url Liquid filters sign with, by name
+	TemplateSecrets TemplateSecrets `json:"template_secrets,omitempty"`
 
 	// decoded secret key, not stored in the database
 	SecretKey string `json:"-"`
@@ -408,6 +434,23 @@ func (ws *WorkspaceSettings) Validate(passphrase string) error {
 		return fmt.Errorf("invalid custom field labels: %w", err)
 	}
 
+	// Validate the contact attribute schema if any attributes are defined
+	if err := ws.ContactAttributes.Validate(); err != nil {
+		return fmt.Errorf("invalid contact attributes: %w", err)
+	}
+
+	if err := ws.BroadcastApproval.Validate(); err != nil {
+		return err
+	}
+
+	if err := ws.WebView.Validate(); err != nil {
+		return err
+	}
+
+	if err := ws.TemplateSecrets.Validate(); err != nil {
+		return err
+	}
+
 	// Validate default language is set
 	if ws.DefaultLanguage == "" {
 		return fmt.Errorf("default language is required")
@@ -563,6 +606,10 @@ func (w *Workspace) BeforeSave(globalSecretKey string) error {
 		w.Settings.FileManager.SecretKey = ""
 	}
 
+	if err := w.Settings.TemplateSecrets.Encrypt(globalSecretKey); err != nil {
+		return err
+	}
+
 	if w.Settings.SecretKey == "" {
 		return fmt.Errorf("workspace secret key is missing")
 	}
@@ -599,6 +646,10 @@ func (w *Workspace) AfterLoad(globalSecretKey string) error {
 	}
 	w.Settings.SecretKey = decryptedSecretKey
 
+	if err := w.Settings.TemplateSecrets.Decrypt(globalSecretKey); err != nil {
+		return err
+	}
+
 	// Process all integrations
 	for i := range w.Integrations {
 		if err := w.Integrations[i].AfterLoad(globalSecretKey); err != nil {
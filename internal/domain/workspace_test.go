@@ -2883,6 +2883,31 @@ func TestWorkspace_BeforeSave(t *testing.T) {
 		assert.Empty(t, workspace.Integrations[0].EmailProvider.SMTP.Password, "Password should be cleared after encryption")
 		assert.NotEmpty(t, workspace.Integrations[0].EmailProvider.SMTP.EncryptedPassword, "Encrypted password should not be empty")
 	})
+
+	t.Run("with template secrets", func(t *testing.T) {
+		workspace := &Workspace{
+			ID:   "test-workspace",
+			Name: "Test Workspace",
+			Settings: WorkspaceSettings{
+				Timezone:        "UTC",
+				DefaultLanguage: "en",
+				Languages:       []string{"en"},
+				SecretKey:       "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+				TemplateSecrets: TemplateSecrets{{Name: "app_key", Value: "secret"}},
+			},
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+
+		err := workspace.BeforeSave(passphrase)
+		assert.NoError(t, err)
+		assert.Empty(t, workspace.Settings.TemplateSecrets[0].Value, "Template secret should be cleared after encryption")
+		assert.NotEmpty(t, workspace.Settings.TemplateSecrets[0].EncryptedValue)
+
+		err = workspace.AfterLoad(passphrase)
+		assert.NoError(t, err)
+		assert.Equal(t, "secret", workspace.Settings.TemplateSecrets[0].Value)
+	})
 }
 
 func TestWorkspace_AfterLoad(t *testing.T) {
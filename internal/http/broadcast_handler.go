@@ -2,6 +2,7 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -51,11 +52,17 @@ func (h *BroadcastHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.Handle("/api/broadcasts.create", requireAuth(http.HandlerFunc(h.HandleCreate)))
 	mux.Handle("/api/broadcasts.update", requireAuth(http.HandlerFunc(h.HandleUpdate)))
 	mux.Handle("/api/broadcasts.schedule", restrictedInDemo(requireAuth(http.HandlerFunc(h.HandleSchedule))))
+	mux.Handle("/api/broadcasts.requestApproval", requireAuth(http.HandlerFunc(h.HandleRequestApproval)))
+	mux.Handle("/api/broadcasts.approve", requireAuth(http.HandlerFunc(h.HandleApprove)))
+	mux.Handle("/api/broadcasts.reject", requireAuth(http.HandlerFunc(h.HandleReject)))
 	mux.Handle("/api/broadcasts.pause", requireAuth(http.HandlerFunc(h.HandlePause)))
 	mux.Handle("/api/broadcasts.resume", requireAuth(http.HandlerFunc(h.HandleResume)))
 	mux.Handle("/api/broadcasts.cancel", requireAuth(http.HandlerFunc(h.HandleCancel)))
 	mux.Handle("/api/broadcasts.sendToIndividual", requireAuth(http.HandlerFunc(h.HandleSendToIndividual)))
 	mux.Handle("/api/broadcasts.delete", requireAuth(http.HandlerFunc(h.HandleDelete)))
+	mux.Handle("/api/broadcasts.previewAudience", requireAuth(http.HandlerFunc(h.HandlePreviewAudience)))
+	mux.Handle("/api/broadcasts.lint", requireAuth(http.HandlerFunc(h.HandleLint)))
+	mux.Handle("/api/broadcasts.resendToNonOpeners", restrictedInDemo(requireAuth(http.HandlerFunc(h.HandleResendToNonOpeners))))
 	// A/B Testing endpoints
 	mux.Handle("/api/broadcasts.getTestResults", requireAuth(http.HandlerFunc(h.HandleGetTestResults)))
 	mux.Handle("/api/broadcasts.selectWinner", restrictedInDemo(requireAuth(http.HandlerFunc(h.HandleSelectWinner))))
@@ -81,6 +88,7 @@ func (h *BroadcastHandler) HandleList(w http.ResponseWriter, r *http.Request) {
 	params := domain.ListBroadcastsParams{
 		WorkspaceID:   req.WorkspaceID,
 		Status:        domain.BroadcastStatus(req.Status),
+		ParentID:      req.ParentID,
 		Limit:         req.Limit,
 		Offset:        req.Offset,
 		WithTemplates: req.WithTemplates,
@@ -253,6 +261,18 @@ func (h *BroadcastHandler) HandleSchedule(w http.ResponseWriter, r *http.Request
 			WriteJSONError(w, "Broadcast not found", http.StatusNotFound)
 			return
 		}
+		if errors.Is(err, domain.ErrBroadcastApprovalRequired) {
+			WriteJSONError(w, err.Error(), http.StatusConflict)
+			return
+		}
+		var lintErr *domain.ErrBroadcastLintFailed
+		if errors.As(err, &lintErr) {
+			writeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{
+				"error": lintErr.Error(),
+				"lint":  lintErr.Report,
+			})
+			return
+		}
 		h.logger.WithField("error", err.Error()).Error("Failed to schedule broadcast")
 		WriteJSONError(w, "Failed to schedule broadcast", http.StatusInternalServerError)
 		return
@@ -263,6 +283,95 @@ func (h *BroadcastHandler) HandleSchedule(w http.ResponseWriter, r *http.Request
 	})
 }
 
+// HandleRequestApproval handles the request to submit a draft broadcast for review
+func (h *BroadcastHandler) HandleRequestApproval(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.RequestBroadcastApprovalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithField("error", err.Error()).Error("Failed to decode request body")
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	broadcast, err := h.service.RequestApproval(r.Context(), &req)
+	if err != nil {
+		h.writeApprovalError(w, err, "Failed to request broadcast approval")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"broadcast": broadcast,
+	})
+}
+
+// HandleApprove handles the approval of a broadcast pending approval
+func (h *BroadcastHandler) HandleApprove(w http.ResponseWriter, r *http.Request) {
+	h.handleReview(w, r, domain.BroadcastReviewApproved)
+}
+
+// HandleReject handles the rejection of a broadcast pending approval
+func (h *BroadcastHandler) HandleReject(w http.ResponseWriter, r *http.Request) {
+	h.handleReview(w, r, domain.BroadcastReviewRejected)
+}
+
+func (h *BroadcastHandler) handleReview(w http.ResponseWriter, r *http.Request, decision domain.BroadcastReviewDecision) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.ReviewBroadcastRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithField("error", err.Error()).Error("Failed to decode request body")
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	// The decision is set by the endpoint
+	req.Decision = decision
+
+	if err := req.Validate(); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	broadcast, err := h.service.ReviewBroadcast(r.Context(), &req)
+	if err != nil {
+		h.writeApprovalError(w, err, "Failed to review broadcast")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"broadcast": broadcast,
+	})
+}
+
+// writeApprovalError maps the errors of the approval workflow to their status codes
+func (h *BroadcastHandler) writeApprovalError(w http.ResponseWriter, err error, message string) {
+	if _, ok := err.(*domain.ErrBroadcastNotFound); ok {
+		WriteJSONError(w, "Broadcast not found", http.StatusNotFound)
+		return
+	}
+	if _, ok := err.(*domain.PermissionError); ok {
+		WriteJSONError(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	if _, ok := err.(domain.ValidationError); ok {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.logger.WithField("error", err.Error()).Error(message)
+	WriteJSONError(w, message, http.StatusInternalServerError)
+}
+
 // HandlePause handles the broadcast pause request
 func (h *BroadcastHandler) HandlePause(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -368,6 +477,78 @@ func (h *BroadcastHandler) HandleCancel(w http.ResponseWriter, r *http.Request)
 	})
 }
 
+// HandleResendToNonOpeners handles the request to resend a broadcast to its non-openers
+func (h *BroadcastHandler) HandleResendToNonOpeners(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.ResendToNonOpenersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithField("error", err.Error()).Error("Failed to decode request body")
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	broadcast, err := h.service.ResendToNonOpeners(r.Context(), &req)
+	if err != nil {
+		if _, ok := err.(*domain.ErrBroadcastNotFound); ok {
+			WriteJSONError(w, "Broadcast not found", http.StatusNotFound)
+			return
+		}
+		var lintErr *domain.ErrBroadcastLintFailed
+		if errors.As(err, &lintErr) {
+			writeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{
+				"error": lintErr.Error(),
+				"lint":  lintErr.Report,
+			})
+			return
+		}
+		h.logger.WithField("error", err.Error()).Error("Failed to resend broadcast to non-openers")
+		WriteJSONError(w, "Failed to resend broadcast to non-openers", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{
+		"broadcast": broadcast,
+	})
+}
+
+// HandlePreviewAudience handles the request to count the recipients of an audience
+func (h *BroadcastHandler) HandlePreviewAudience(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.PreviewAudienceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithField("error", err.Error()).Error("Failed to decode request body")
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	preview, err := h.service.PreviewAudience(r.Context(), &req)
+	if err != nil {
+		h.logger.WithField("error", err.Error()).Error("Failed to preview audience")
+		WriteJSONError(w, "Failed to preview audience", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, preview)
+}
+
 // HandleSendToIndividual handles the broadcast send to individual request
 func (h *BroadcastHandler) HandleSendToIndividual(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -470,6 +651,38 @@ func (h *BroadcastHandler) HandleGetTestResults(w http.ResponseWriter, r *http.R
 	writeJSON(w, http.StatusOK, results)
 }
 
+// HandleLint handles the pre-send spam and deliverability checks of a broadcast
+func (h *BroadcastHandler) HandleLint(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.LintBroadcastRequest
+	if err := req.FromURLParams(r.URL.Query()); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.service.LintBroadcast(r.Context(), req.WorkspaceID, req.ID)
+	if err != nil {
+		h.logger.WithFields(map[string]interface{}{
+			"workspace_id": req.WorkspaceID,
+			"broadcast_id": req.ID,
+			"error":        err.Error(),
+		}).Error("Failed to lint broadcast")
+		WriteJSONError(w, "Failed to lint broadcast", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, report)
+}
+
 // HandleSelectWinner handles the winner selection request
 func (h *BroadcastHandler) HandleSelectWinner(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
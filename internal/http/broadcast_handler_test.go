@@ -2244,3 +2244,419 @@ func TestMissingParameterError_Error(t *testing.T) {
 		assert.Equal(t, expected, err.Error())
 	})
 }
+
+func TestHandleResendToNonOpeners(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		handler, mockService, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		request := &domain.ResendToNonOpenersRequest{
+			WorkspaceID: "workspace123",
+			ID:          "broadcast123",
+			DelayHours:  48,
+			Subject:     "In case you missed it",
+		}
+
+		parentID := "broadcast123"
+		mockService.EXPECT().
+			ResendToNonOpeners(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *domain.ResendToNonOpenersRequest) (*domain.Broadcast, error) {
+				assert.Equal(t, 48, req.DelayHours)
+				assert.Equal(t, "In case you missed it", req.Subject)
+				return &domain.Broadcast{ID: "followup1", ParentBroadcastID: &parentID}, nil
+			})
+
+		requestBody, _ := json.Marshal(request)
+		req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.resendToNonOpeners", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		handler.HandleResendToNonOpeners(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var response map[string]map[string]interface{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "followup1", response["broadcast"]["id"])
+		assert.Equal(t, "broadcast123", response["broadcast"]["parent_broadcast_id"])
+	})
+
+	t.Run("ValidationError", func(t *testing.T) {
+		handler, _, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		requestBody, _ := json.Marshal(&domain.ResendToNonOpenersRequest{WorkspaceID: "workspace123", ID: "broadcast123", DelayHours: -1})
+		req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.resendToNonOpeners", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		handler.HandleResendToNonOpeners(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("BroadcastNotFound", func(t *testing.T) {
+		handler, mockService, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		mockService.EXPECT().
+			ResendToNonOpeners(gomock.Any(), gomock.Any()).
+			Return(nil, &domain.ErrBroadcastNotFound{ID: "nonexistent"})
+
+		requestBody, _ := json.Marshal(&domain.ResendToNonOpenersRequest{WorkspaceID: "workspace123", ID: "nonexistent"})
+		req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.resendToNonOpeners", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		handler.HandleResendToNonOpeners(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("LintFailed", func(t *testing.T) {
+		handler, mockService, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		mockService.EXPECT().
+			ResendToNonOpeners(gomock.Any(), gomock.Any()).
+			Return(nil, &domain.ErrBroadcastLintFailed{Report: &domain.BroadcastLintReport{BroadcastID: "followup1"}})
+
+		requestBody, _ := json.Marshal(&domain.ResendToNonOpenersRequest{WorkspaceID: "workspace123", ID: "broadcast123", TemplateID: "tpl2"})
+		req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.resendToNonOpeners", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		handler.HandleResendToNonOpeners(w, req)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		var response map[string]interface{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Contains(t, response, "lint")
+	})
+
+	t.Run("MethodNotAllowed", func(t *testing.T) {
+		handler, _, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/broadcasts.resendToNonOpeners", nil)
+		w := httptest.NewRecorder()
+
+		handler.HandleResendToNonOpeners(w, req)
+
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
+
+func TestHandlePreviewAudience(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		handler, mockService, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		request := &domain.PreviewAudienceRequest{
+			WorkspaceID: "workspace123",
+			Audience: domain.AudienceSettings{
+				List:                     "newsletter",
+				ExcludeLists:             []string{"customers"},
+				ExcludeEmailedWithinDays: 3,
+			},
+		}
+
+		mockService.EXPECT().
+			PreviewAudience(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *domain.PreviewAudienceRequest) (*domain.AudiencePreview, error) {
+				assert.Equal(t, []string{"customers"}, req.Audience.ExcludeLists)
+				assert.Equal(t, 3, req.Audience.ExcludeEmailedWithinDays)
+				return &domain.AudiencePreview{TotalCount: 100, ExcludedCount: 30, RecipientCount: 70}, nil
+			})
+
+		requestBody, _ := json.Marshal(request)
+		req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.previewAudience", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		handler.HandlePreviewAudience(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response domain.AudiencePreview
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, 30, response.ExcludedCount)
+		assert.Equal(t, 70, response.RecipientCount)
+	})
+
+	t.Run("ValidationError", func(t *testing.T) {
+		handler, _, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		requestBody, _ := json.Marshal(&domain.PreviewAudienceRequest{
+			WorkspaceID: "workspace123",
+			Audience:    domain.AudienceSettings{List: "newsletter", ExcludeEmailedWithinDays: 1000},
+		})
+		req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.previewAudience", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		handler.HandlePreviewAudience(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("MethodNotAllowed", func(t *testing.T) {
+		handler, _, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/broadcasts.previewAudience", nil)
+		w := httptest.NewRecorder()
+
+		handler.HandlePreviewAudience(w, req)
+
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
+
+func TestHandleRequestApproval(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		handler, mockService, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		mockService.EXPECT().
+			RequestApproval(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *domain.RequestBroadcastApprovalRequest) (*domain.Broadcast, error) {
+				assert.Equal(t, "Ready to go", req.Comment)
+				return &domain.Broadcast{
+					ID:       "broadcast123",
+					Status:   domain.BroadcastStatusPendingApproval,
+					Approval: &domain.BroadcastApproval{RequestedBy: "user1", RequiredApprovals: 2},
+				}, nil
+			})
+
+		requestBody, _ := json.Marshal(&domain.RequestBroadcastApprovalRequest{WorkspaceID: "workspace123", ID: "broadcast123", Comment: "Ready to go"})
+		req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.requestApproval", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		handler.HandleRequestApproval(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response map[string]map[string]interface{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "pending_approval", response["broadcast"]["status"])
+	})
+
+	t.Run("NotADraft", func(t *testing.T) {
+		handler, mockService, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		mockService.EXPECT().
+			RequestApproval(gomock.Any(), gomock.Any()).
+			Return(nil, domain.NewValidationError("only draft broadcasts can be submitted for approval"))
+
+		requestBody, _ := json.Marshal(&domain.RequestBroadcastApprovalRequest{WorkspaceID: "workspace123", ID: "broadcast123"})
+		req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.requestApproval", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		handler.HandleRequestApproval(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("MethodNotAllowed", func(t *testing.T) {
+		handler, _, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/broadcasts.requestApproval", nil)
+		w := httptest.NewRecorder()
+
+		handler.HandleRequestApproval(w, req)
+
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
+
+func TestHandleReviewBroadcast(t *testing.T) {
+	t.Run("Approve", func(t *testing.T) {
+		handler, mockService, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		mockService.EXPECT().
+			ReviewBroadcast(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *domain.ReviewBroadcastRequest) (*domain.Broadcast, error) {
+				assert.Equal(t, domain.BroadcastReviewApproved, req.Decision)
+				return &domain.Broadcast{ID: "broadcast123", Status: domain.BroadcastStatusApproved}, nil
+			})
+
+		// The decision of the body is ignored, the endpoint sets it
+		requestBody, _ := json.Marshal(map[string]string{"workspace_id": "workspace123", "id": "broadcast123", "decision": "rejected"})
+		req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.approve", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		handler.HandleApprove(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("RejectWithComment", func(t *testing.T) {
+		handler, mockService, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		mockService.EXPECT().
+			ReviewBroadcast(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *domain.ReviewBroadcastRequest) (*domain.Broadcast, error) {
+				assert.Equal(t, domain.BroadcastReviewRejected, req.Decision)
+				assert.Equal(t, "Wrong segment", req.Comment)
+				return &domain.Broadcast{ID: "broadcast123", Status: domain.BroadcastStatusDraft}, nil
+			})
+
+		requestBody, _ := json.Marshal(&domain.ReviewBroadcastRequest{WorkspaceID: "workspace123", ID: "broadcast123", Comment: "Wrong segment"})
+		req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.reject", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		handler.HandleReject(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("RejectWithoutComment", func(t *testing.T) {
+		handler, _, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		requestBody, _ := json.Marshal(&domain.ReviewBroadcastRequest{WorkspaceID: "workspace123", ID: "broadcast123"})
+		req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.reject", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		handler.HandleReject(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		handler, mockService, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		mockService.EXPECT().
+			ReviewBroadcast(gomock.Any(), gomock.Any()).
+			Return(nil, domain.NewPermissionError(domain.PermissionResourceBroadcastApprovals, domain.PermissionTypeWrite, "Insufficient permissions"))
+
+		requestBody, _ := json.Marshal(&domain.ReviewBroadcastRequest{WorkspaceID: "workspace123", ID: "broadcast123"})
+		req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.approve", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		handler.HandleApprove(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("BroadcastNotFound", func(t *testing.T) {
+		handler, mockService, _, _, ctrl := setupBroadcastHandler(t)
+		defer ctrl.Finish()
+
+		mockService.EXPECT().
+			ReviewBroadcast(gomock.Any(), gomock.Any()).
+			Return(nil, &domain.ErrBroadcastNotFound{ID: "nonexistent"})
+
+		requestBody, _ := json.Marshal(&domain.ReviewBroadcastRequest{WorkspaceID: "workspace123", ID: "nonexistent"})
+		req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.approve", bytes.NewBuffer(requestBody))
+		w := httptest.NewRecorder()
+
+		handler.HandleApprove(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestHandleSchedule_ApprovalRequired(t *testing.T) {
+	handler, mockService, _, _, ctrl := setupBroadcastHandler(t)
+	defer ctrl.Finish()
+
+	mockService.EXPECT().
+		ScheduleBroadcast(gomock.Any(), gomock.Any()).
+		Return(domain.ErrBroadcastApprovalRequired)
+
+	requestBody, _ := json.Marshal(&domain.ScheduleBroadcastRequest{WorkspaceID: "workspace123", ID: "broadcast123", SendNow: true})
+	req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.schedule", bytes.NewBuffer(requestBody))
+	w := httptest.NewRecorder()
+
+	handler.HandleSchedule(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestHandleSchedule_LintFailed(t *testing.T) {
+	handler, mockService, _, _, ctrl := setupBroadcastHandler(t)
+	defer ctrl.Finish()
+
+	report := &domain.BroadcastLintReport{
+		BroadcastID: "broadcast123",
+		Templates: []*domain.TemplateLintReport{{
+			TemplateID: "tpl1",
+			Threshold:  domain.TemplateLintThreshold,
+			Findings: []domain.TemplateLintFinding{{
+				Rule:     domain.TemplateLintRuleMissingUnsubscribe,
+				Severity: domain.TemplateLintSeverityError,
+				Message:  "marketing emails must contain an unsubscribe link",
+			}},
+		}},
+	}
+	mockService.EXPECT().
+		ScheduleBroadcast(gomock.Any(), gomock.Any()).
+		Return(&domain.ErrBroadcastLintFailed{Report: report})
+
+	requestBody, _ := json.Marshal(&domain.ScheduleBroadcastRequest{WorkspaceID: "workspace123", ID: "broadcast123", SendNow: true})
+	req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.schedule", bytes.NewBuffer(requestBody))
+	w := httptest.NewRecorder()
+
+	handler.HandleSchedule(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Contains(t, response["error"], "template tpl1: marketing emails must contain an unsubscribe link")
+	assert.Contains(t, response, "lint")
+}
+
+func TestHandleLint(t *testing.T) {
+	handler, mockService, _, mockLogger, ctrl := setupBroadcastHandler(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		report := &domain.BroadcastLintReport{
+			BroadcastID: "broadcast123",
+			Passed:      false,
+			Templates: []*domain.TemplateLintReport{
+				{TemplateID: "templateA", Score: 6, Threshold: domain.TemplateLintThreshold},
+			},
+		}
+		mockService.EXPECT().LintBroadcast(gomock.Any(), "workspace123", "broadcast123").Return(report, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/broadcasts.lint?workspace_id=workspace123&id=broadcast123", nil)
+		w := httptest.NewRecorder()
+		handler.HandleLint(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var body domain.BroadcastLintReport
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, "broadcast123", body.BroadcastID)
+		assert.False(t, body.Passed)
+		if assert.Len(t, body.Templates, 1) {
+			assert.Equal(t, "templateA", body.Templates[0].TemplateID)
+		}
+	})
+
+	t.Run("ValidationError", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/broadcasts.lint?workspace_id=workspace123", nil)
+		w := httptest.NewRecorder()
+		handler.HandleLint(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("ServiceError", func(t *testing.T) {
+		withFields := pkgmocks.NewMockLogger(ctrl)
+		mockLogger.EXPECT().WithFields(gomock.Any()).Return(withFields)
+		withFields.EXPECT().Error("Failed to lint broadcast")
+
+		mockService.EXPECT().LintBroadcast(gomock.Any(), "workspace123", "broadcast123").Return(nil, errors.New("db error"))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/broadcasts.lint?workspace_id=workspace123&id=broadcast123", nil)
+		w := httptest.NewRecorder()
+		handler.HandleLint(w, req)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("MethodNotAllowed", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/broadcasts.lint?workspace_id=workspace123&id=broadcast123", nil)
+		w := httptest.NewRecorder()
+		handler.HandleLint(w, req)
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
@@ -0,0 +1,105 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/http/middleware"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// ContactBulkOperationHandler exposes bulk operations on contacts
+type ContactBulkOperationHandler struct {
+	service      domain.ContactBulkOperationService
+	logger       logger.Logger
+	getJWTSecret func() ([]byte, error)
+}
+
+// NewContactBulkOperationHandler creates a new contact bulk operation handler
+func NewContactBulkOperationHandler(service domain.ContactBulkOperationService, getJWTSecret func() ([]byte, error), logger logger.Logger) *ContactBulkOperationHandler {
+	return &ContactBulkOperationHandler{
+		service:      service,
+		getJWTSecret: getJWTSecret,
+		logger:       logger,
+	}
+}
+
+func (h *ContactBulkOperationHandler) RegisterRoutes(mux *http.ServeMux) {
+	// Create auth middleware
+	authMiddleware := middleware.NewAuthMiddleware(h.getJWTSecret)
+	requireAuth := authMiddleware.RequireAuth()
+
+	mux.Handle("/api/contactBulkOperations.create", requireAuth(http.HandlerFunc(h.handleCreate)))
+	mux.Handle("/api/contactBulkOperations.get", requireAuth(http.HandlerFunc(h.handleGet)))
+	mux.Handle("/api/contactBulkOperations.list", requireAuth(http.HandlerFunc(h.handleList)))
+}
+
+func (h *ContactBulkOperationHandler) handleCreate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.CreateBulkOperationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	response, err := h.service.CreateOperation(r.Context(), &req)
+	if err != nil {
+		writeServiceError(w, h.logger, err, "Failed to create bulk operation")
+		return
+	}
+
+	status := http.StatusCreated
+	if req.DryRun {
+		status = http.StatusOK
+	}
+	writeJSON(w, status, response)
+}
+
+func (h *ContactBulkOperationHandler) handleGet(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.GetBulkOperationRequest
+	if err := req.FromURLParams(r.URL.Query()); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	operation, err := h.service.GetOperation(r.Context(), &req)
+	if err != nil {
+		writeServiceError(w, h.logger, err, "Failed to get bulk operation")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"operation": operation,
+	})
+}
+
+func (h *ContactBulkOperationHandler) handleList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.ListBulkOperationsRequest
+	if err := req.FromURLParams(r.URL.Query()); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := h.service.ListOperations(r.Context(), &req)
+	if err != nil {
+		writeServiceError(w, h.logger, err, "Failed to list bulk operations")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
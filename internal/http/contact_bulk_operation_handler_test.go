@@ -0,0 +1,181 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+)
+
+func setupContactBulkOperationHandlerTest(t *testing.T) (*mocks.MockContactBulkOperationService, *ContactBulkOperationHandler) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	mockService := mocks.NewMockContactBulkOperationService(ctrl)
+	mockLogger := pkgmocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().WithField(gomock.Any(), gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any()).AnyTimes()
+
+	jwtSecret := []byte("test-jwt-secret-key-for-testing-32bytes")
+	handler := NewContactBulkOperationHandler(mockService, func() ([]byte, error) { return jwtSecret, nil }, mockLogger)
+	return mockService, handler
+}
+
+func TestContactBulkOperationHandler_RegisterRoutes(t *testing.T) {
+	_, handler := setupContactBulkOperationHandlerTest(t)
+	mux := http.NewServeMux()
+	handler.RegisterRoutes(mux)
+
+	for _, endpoint := range []string{"/api/contactBulkOperations.create", "/api/contactBulkOperations.get", "/api/contactBulkOperations.list"} {
+		_, pattern := mux.Handler(&http.Request{URL: &url.URL{Path: endpoint}})
+		assert.Equal(t, endpoint, pattern)
+	}
+}
+
+func TestContactBulkOperationHandler_HandleCreate(t *testing.T) {
+	t.Run("dry run", func(t *testing.T) {
+		mockService, handler := setupContactBulkOperationHandlerTest(t)
+		mockService.EXPECT().CreateOperation(gomock.Any(), &domain.CreateBulkOperationRequest{
+			WorkspaceID: "ws1",
+			Operation:   domain.BulkOperationDelete,
+			Target:      domain.BulkOperationTarget{SegmentID: "seg1"},
+			DryRun:      true,
+		}).Return(&domain.CreateBulkOperationResponse{Count: 1200}, nil)
+
+		rr := httptest.NewRecorder()
+		body := `{"workspace_id":"ws1","operation":"delete","target":{"segment_id":"seg1"},"dry_run":true}`
+		handler.handleCreate(rr, httptest.NewRequest(http.MethodPost, "/api/contactBulkOperations.create", strings.NewReader(body)))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"count":1200}`, rr.Body.String())
+	})
+
+	t.Run("creates the operation", func(t *testing.T) {
+		mockService, handler := setupContactBulkOperationHandlerTest(t)
+		mockService.EXPECT().CreateOperation(gomock.Any(), gomock.Any()).
+			Return(&domain.CreateBulkOperationResponse{Count: 3, Operation: &domain.ContactBulkOperation{ID: "op1"}}, nil)
+
+		rr := httptest.NewRecorder()
+		body := `{"workspace_id":"ws1","operation":"add_tags","target":{"filter":{"country":"FR"}},"params":{"tags":["vip"]}}`
+		handler.handleCreate(rr, httptest.NewRequest(http.MethodPost, "/api/contactBulkOperations.create", strings.NewReader(body)))
+
+		assert.Equal(t, http.StatusCreated, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"id":"op1"`)
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		mockService, handler := setupContactBulkOperationHandlerTest(t)
+		mockService.EXPECT().CreateOperation(gomock.Any(), gomock.Any()).
+			Return(nil, domain.NewValidationError("target.filter must set at least one filter"))
+
+		rr := httptest.NewRecorder()
+		handler.handleCreate(rr, httptest.NewRequest(http.MethodPost, "/api/contactBulkOperations.create", strings.NewReader(`{"workspace_id":"ws1"}`)))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("unknown list", func(t *testing.T) {
+		mockService, handler := setupContactBulkOperationHandlerTest(t)
+		mockService.EXPECT().CreateOperation(gomock.Any(), gomock.Any()).Return(nil, &domain.ErrListNotFound{Message: "list not found"})
+
+		rr := httptest.NewRecorder()
+		handler.handleCreate(rr, httptest.NewRequest(http.MethodPost, "/api/contactBulkOperations.create", strings.NewReader(`{"workspace_id":"ws1"}`)))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		mockService, handler := setupContactBulkOperationHandlerTest(t)
+		mockService.EXPECT().CreateOperation(gomock.Any(), gomock.Any()).
+			Return(nil, domain.NewPermissionError(domain.PermissionResourceContacts, domain.PermissionTypeWrite, "Insufficient permissions"))
+
+		rr := httptest.NewRecorder()
+		handler.handleCreate(rr, httptest.NewRequest(http.MethodPost, "/api/contactBulkOperations.create", strings.NewReader(`{"workspace_id":"ws1"}`)))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		_, handler := setupContactBulkOperationHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleCreate(rr, httptest.NewRequest(http.MethodPost, "/api/contactBulkOperations.create", strings.NewReader("{")))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, handler := setupContactBulkOperationHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleCreate(rr, httptest.NewRequest(http.MethodGet, "/api/contactBulkOperations.create", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
+
+func TestContactBulkOperationHandler_HandleGet(t *testing.T) {
+	t.Run("returns the operation", func(t *testing.T) {
+		mockService, handler := setupContactBulkOperationHandlerTest(t)
+		mockService.EXPECT().GetOperation(gomock.Any(), &domain.GetBulkOperationRequest{WorkspaceID: "ws1", ID: "op1"}).
+			Return(&domain.ContactBulkOperation{ID: "op1", Status: domain.BulkOperationStatusRunning}, nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleGet(rr, httptest.NewRequest(http.MethodGet, "/api/contactBulkOperations.get?workspace_id=ws1&id=op1", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"status":"running"`)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockService, handler := setupContactBulkOperationHandlerTest(t)
+		mockService.EXPECT().GetOperation(gomock.Any(), gomock.Any()).Return(nil, domain.ErrBulkOperationNotFound)
+
+		rr := httptest.NewRecorder()
+		handler.handleGet(rr, httptest.NewRequest(http.MethodGet, "/api/contactBulkOperations.get?workspace_id=ws1&id=op1", nil))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("requires an id", func(t *testing.T) {
+		_, handler := setupContactBulkOperationHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleGet(rr, httptest.NewRequest(http.MethodGet, "/api/contactBulkOperations.get?workspace_id=ws1", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestContactBulkOperationHandler_HandleList(t *testing.T) {
+	t.Run("lists the operations", func(t *testing.T) {
+		mockService, handler := setupContactBulkOperationHandlerTest(t)
+		mockService.EXPECT().ListOperations(gomock.Any(), &domain.ListBulkOperationsRequest{WorkspaceID: "ws1", Limit: 20}).
+			Return(&domain.ListBulkOperationsResponse{Operations: []*domain.ContactBulkOperation{}}, nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleList(rr, httptest.NewRequest(http.MethodGet, "/api/contactBulkOperations.list?workspace_id=ws1", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"operations":[]}`, rr.Body.String())
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		mockService, handler := setupContactBulkOperationHandlerTest(t)
+		mockService.EXPECT().ListOperations(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		rr := httptest.NewRecorder()
+		handler.handleList(rr, httptest.NewRequest(http.MethodGet, "/api/contactBulkOperations.list?workspace_id=ws1", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
@@ -2,6 +2,7 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -39,6 +40,10 @@ func (h *ContactHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.Handle("/api/contacts.delete", requireAuth(http.HandlerFunc(h.handleDelete)))
 	mux.Handle("/api/contacts.import", requireAuth(http.HandlerFunc(h.handleImport)))
 	mux.Handle("/api/contacts.upsert", requireAuth(http.HandlerFunc(h.handleUpsert)))
+	mux.Handle("/api/contacts.migrateCustomFields", requireAuth(http.HandlerFunc(h.handleMigrateCustomFields)))
+	mux.Handle("/api/contacts.merge", requireAuth(http.HandlerFunc(h.handleMerge)))
+	mux.Handle("/api/contacts.duplicates", requireAuth(http.HandlerFunc(h.handleDuplicates)))
+	mux.Handle("/api/contacts.changeEmail", requireAuth(http.HandlerFunc(h.handleChangeEmail)))
 }
 
 func (h *ContactHandler) handleList(w http.ResponseWriter, r *http.Request) {
@@ -290,3 +295,143 @@ func (h *ContactHandler) handleUpsert(w http.ResponseWriter, r *http.Request) {
 
 	writeJSON(w, http.StatusOK, result)
 }
+
+func (h *ContactHandler) handleMigrateCustomFields(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.MigrateCustomFieldsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if req.WorkspaceID == "" {
+		WriteJSONError(w, "workspace_id is required", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.service.MigrateCustomFieldsToAttributes(r.Context(), &req)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			WriteJSONError(w, validationErr.Message, http.StatusBadRequest)
+			return
+		}
+		var permissionErr *domain.PermissionError
+		if errors.As(err, &permissionErr) {
+			WriteJSONError(w, permissionErr.Message, http.StatusForbidden)
+			return
+		}
+		h.logger.WithField("error", err.Error()).Error("Failed to migrate custom fields")
+		WriteJSONError(w, "Failed to migrate custom fields", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *ContactHandler) handleMerge(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.MergeContactsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.service.MergeContacts(r.Context(), &req)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			WriteJSONError(w, validationErr.Message, http.StatusBadRequest)
+			return
+		}
+		var permissionErr *domain.PermissionError
+		if errors.As(err, &permissionErr) {
+			WriteJSONError(w, permissionErr.Message, http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, domain.ErrContactNotFound) {
+			WriteJSONError(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		h.logger.WithField("error", err.Error()).Error("Failed to merge contacts")
+		WriteJSONError(w, "Failed to merge contacts", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *ContactHandler) handleDuplicates(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.FindDuplicateContactsRequest
+	if err := req.FromQuery(r.URL.Query()); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.service.FindDuplicateContacts(r.Context(), &req)
+	if err != nil {
+		var permissionErr *domain.PermissionError
+		if errors.As(err, &permissionErr) {
+			WriteJSONError(w, permissionErr.Message, http.StatusForbidden)
+			return
+		}
+		h.logger.WithField("error", err.Error()).Error("Failed to find duplicate contacts")
+		WriteJSONError(w, "Failed to find duplicate contacts", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *ContactHandler) handleChangeEmail(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.ChangeContactEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.service.ChangeContactEmail(r.Context(), &req)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			WriteJSONError(w, validationErr.Message, http.StatusBadRequest)
+			return
+		}
+		var permissionErr *domain.PermissionError
+		if errors.As(err, &permissionErr) {
+			WriteJSONError(w, permissionErr.Message, http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, domain.ErrContactNotFound) {
+			WriteJSONError(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, domain.ErrContactEmailTaken) {
+			WriteJSONError(w, err.Error(), http.StatusConflict)
+			return
+		}
+		h.logger.WithField("error", err.Error()).Error("Failed to change contact email")
+		WriteJSONError(w, "Failed to change contact email", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
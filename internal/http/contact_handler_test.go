@@ -18,6 +18,7 @@ import (
 	"github.com/golang/mock/gomock"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // setupContactHandlerTest prepares test dependencies and creates a contact handler
@@ -58,6 +59,10 @@ func TestContactHandler_RegisterRoutes(t *testing.T) {
 		"/api/contacts.delete",
 		"/api/contacts.import",
 		"/api/contacts.upsert",
+		"/api/contacts.migrateCustomFields",
+		"/api/contacts.merge",
+		"/api/contacts.duplicates",
+		"/api/contacts.changeEmail",
 	}
 
 	for _, endpoint := range endpoints {
@@ -902,3 +907,439 @@ func TestContactHandler_HandleUpsert(t *testing.T) {
 		})
 	}
 }
+
+func TestContactHandler_HandleMigrateCustomFields(t *testing.T) {
+	testCases := []struct {
+		name           string
+		method         string
+		reqBody        interface{}
+		setupMock      func(*mocks.MockContactService)
+		expectedStatus int
+	}{
+		{
+			name:   "Success",
+			method: http.MethodPost,
+			reqBody: map[string]interface{}{
+				"workspace_id":        "workspace123",
+				"mapping":             map[string]string{"custom_string_1": "plan"},
+				"clear_legacy_fields": true,
+			},
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().
+					MigrateCustomFieldsToAttributes(gomock.Any(), &domain.MigrateCustomFieldsRequest{
+						WorkspaceID:       "workspace123",
+						Mapping:           map[string]string{"custom_string_1": "plan"},
+						ClearLegacyFields: true,
+					}).
+					Return(&domain.MigrateCustomFieldsResponse{UpdatedContacts: 42}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Method Not Allowed",
+			method:         http.MethodGet,
+			setupMock:      func(m *mocks.MockContactService) {},
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "Invalid Request Body",
+			method:         http.MethodPost,
+			reqBody:        "not json",
+			setupMock:      func(m *mocks.MockContactService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Missing Workspace ID",
+			method:         http.MethodPost,
+			reqBody:        map[string]interface{}{"mapping": map[string]string{"custom_string_1": "plan"}},
+			setupMock:      func(m *mocks.MockContactService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Validation Error",
+			method:  http.MethodPost,
+			reqBody: map[string]interface{}{"workspace_id": "workspace123", "mapping": map[string]string{"custom_number_1": "plan"}},
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().
+					MigrateCustomFieldsToAttributes(gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewValidationError("type mismatch"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Permission Error",
+			method:  http.MethodPost,
+			reqBody: map[string]interface{}{"workspace_id": "workspace123", "mapping": map[string]string{"custom_string_1": "plan"}},
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().
+					MigrateCustomFieldsToAttributes(gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewPermissionError(domain.PermissionResourceContacts, domain.PermissionTypeWrite, "Insufficient permissions"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:    "Service Error",
+			method:  http.MethodPost,
+			reqBody: map[string]interface{}{"workspace_id": "workspace123", "mapping": map[string]string{"custom_string_1": "plan"}},
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().
+					MigrateCustomFieldsToAttributes(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, _, handler := setupContactHandlerTest(t)
+			tc.setupMock(mockService)
+
+			var reqBody bytes.Buffer
+			if tc.reqBody != nil {
+				if str, ok := tc.reqBody.(string); ok {
+					reqBody = *bytes.NewBufferString(str)
+				} else if err := json.NewEncoder(&reqBody).Encode(tc.reqBody); err != nil {
+					t.Fatalf("Failed to encode request body: %v", err)
+				}
+			}
+
+			req := httptest.NewRequest(tc.method, "/api/contacts.migrateCustomFields", &reqBody)
+			rr := httptest.NewRecorder()
+			handler.handleMigrateCustomFields(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+
+			if tc.expectedStatus == http.StatusOK {
+				var response domain.MigrateCustomFieldsResponse
+				err := json.Unmarshal(rr.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, int64(42), response.UpdatedContacts)
+			}
+		})
+	}
+}
+
+func TestContactHandler_HandleMerge(t *testing.T) {
+	testCases := []struct {
+		name           string
+		method         string
+		reqBody        interface{}
+		setupMock      func(*mocks.MockContactService)
+		expectedStatus int
+	}{
+		{
+			name:   "Success",
+			method: http.MethodPost,
+			reqBody: map[string]interface{}{
+				"workspace_id": "workspace123",
+				"source_email": "old@example.com",
+				"target_email": "new@example.com",
+			},
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().
+					MergeContacts(gomock.Any(), &domain.MergeContactsRequest{
+						WorkspaceID: "workspace123",
+						SourceEmail: "old@example.com",
+						TargetEmail: "new@example.com",
+					}).
+					Return(&domain.MergeContactsResponse{
+						Contact:    &domain.Contact{Email: "new@example.com"},
+						MergedFrom: "old@example.com",
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Method Not Allowed",
+			method:         http.MethodGet,
+			setupMock:      func(m *mocks.MockContactService) {},
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "Invalid Request Body",
+			method:         http.MethodPost,
+			reqBody:        "not json",
+			setupMock:      func(m *mocks.MockContactService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Validation Error",
+			method:  http.MethodPost,
+			reqBody: map[string]interface{}{"workspace_id": "workspace123"},
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().MergeContacts(gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewValidationError("source_email is required"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Permission Error",
+			method:  http.MethodPost,
+			reqBody: map[string]interface{}{"workspace_id": "workspace123", "source_email": "a@example.com", "target_email": "b@example.com"},
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().MergeContacts(gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewPermissionError(domain.PermissionResourceContacts, domain.PermissionTypeWrite, "Insufficient permissions"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:    "Contact Not Found",
+			method:  http.MethodPost,
+			reqBody: map[string]interface{}{"workspace_id": "workspace123", "source_email": "a@example.com", "target_email": "b@example.com"},
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().MergeContacts(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("source %w: a@example.com", domain.ErrContactNotFound))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:    "Service Error",
+			method:  http.MethodPost,
+			reqBody: map[string]interface{}{"workspace_id": "workspace123", "source_email": "a@example.com", "target_email": "b@example.com"},
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().MergeContacts(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, _, handler := setupContactHandlerTest(t)
+			tc.setupMock(mockService)
+
+			var reqBody bytes.Buffer
+			if tc.reqBody != nil {
+				if str, ok := tc.reqBody.(string); ok {
+					reqBody = *bytes.NewBufferString(str)
+				} else if err := json.NewEncoder(&reqBody).Encode(tc.reqBody); err != nil {
+					t.Fatalf("Failed to encode request body: %v", err)
+				}
+			}
+
+			req := httptest.NewRequest(tc.method, "/api/contacts.merge", &reqBody)
+			rr := httptest.NewRecorder()
+			handler.handleMerge(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+
+			if tc.expectedStatus == http.StatusOK {
+				var response domain.MergeContactsResponse
+				err := json.Unmarshal(rr.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "new@example.com", response.Contact.Email)
+				assert.Equal(t, "old@example.com", response.MergedFrom)
+			}
+		})
+	}
+}
+
+func TestContactHandler_HandleDuplicates(t *testing.T) {
+	testCases := []struct {
+		name           string
+		method         string
+		query          string
+		setupMock      func(*mocks.MockContactService)
+		expectedStatus int
+	}{
+		{
+			name:   "Success",
+			method: http.MethodGet,
+			query:  "workspace_id=workspace123&limit=10",
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().
+					FindDuplicateContacts(gomock.Any(), &domain.FindDuplicateContactsRequest{WorkspaceID: "workspace123", Limit: 10}).
+					Return(&domain.FindDuplicateContactsResponse{
+						Duplicates: []*domain.ContactDuplicateGroup{
+							{Reason: domain.ContactDuplicateReasonExternalID, Key: "user-1", Emails: []string{"new@example.com", "old@example.com"}, SuggestedTarget: "new@example.com"},
+						},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Method Not Allowed",
+			method:         http.MethodPost,
+			query:          "workspace_id=workspace123",
+			setupMock:      func(m *mocks.MockContactService) {},
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "Missing Workspace ID",
+			method:         http.MethodGet,
+			setupMock:      func(m *mocks.MockContactService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Permission Error",
+			method: http.MethodGet,
+			query:  "workspace_id=workspace123",
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().FindDuplicateContacts(gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewPermissionError(domain.PermissionResourceContacts, domain.PermissionTypeRead, "Insufficient permissions"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "Service Error",
+			method: http.MethodGet,
+			query:  "workspace_id=workspace123",
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().FindDuplicateContacts(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, _, handler := setupContactHandlerTest(t)
+			tc.setupMock(mockService)
+
+			req := httptest.NewRequest(tc.method, "/api/contacts.duplicates?"+tc.query, nil)
+			rr := httptest.NewRecorder()
+			handler.handleDuplicates(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+
+			if tc.expectedStatus == http.StatusOK {
+				var response domain.FindDuplicateContactsResponse
+				err := json.Unmarshal(rr.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				require.Len(t, response.Duplicates, 1)
+				assert.Equal(t, "new@example.com", response.Duplicates[0].SuggestedTarget)
+			}
+		})
+	}
+}
+
+func TestContactHandler_HandleChangeEmail(t *testing.T) {
+	validBody := map[string]interface{}{
+		"workspace_id":  "workspace123",
+		"current_email": "old@example.com",
+		"new_email":     "new@example.com",
+	}
+
+	testCases := []struct {
+		name           string
+		method         string
+		reqBody        interface{}
+		setupMock      func(*mocks.MockContactService)
+		expectedStatus int
+	}{
+		{
+			name:    "Success",
+			method:  http.MethodPost,
+			reqBody: validBody,
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().
+					ChangeContactEmail(gomock.Any(), &domain.ChangeContactEmailRequest{
+						WorkspaceID:  "workspace123",
+						CurrentEmail: "old@example.com",
+						NewEmail:     "new@example.com",
+					}).
+					Return(&domain.ChangeContactEmailResponse{
+						Contact:       &domain.Contact{Email: "new@example.com"},
+						PreviousEmail: "old@example.com",
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Method Not Allowed",
+			method:         http.MethodGet,
+			setupMock:      func(m *mocks.MockContactService) {},
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "Invalid Request Body",
+			method:         http.MethodPost,
+			reqBody:        "not json",
+			setupMock:      func(m *mocks.MockContactService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Validation Error",
+			method:  http.MethodPost,
+			reqBody: validBody,
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().ChangeContactEmail(gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewValidationError("invalid new_email"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Permission Error",
+			method:  http.MethodPost,
+			reqBody: validBody,
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().ChangeContactEmail(gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewPermissionError(domain.PermissionResourceContacts, domain.PermissionTypeWrite, "Insufficient permissions"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:    "Contact Not Found",
+			method:  http.MethodPost,
+			reqBody: validBody,
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().ChangeContactEmail(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("%w: old@example.com", domain.ErrContactNotFound))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:    "Email Taken",
+			method:  http.MethodPost,
+			reqBody: validBody,
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().ChangeContactEmail(gomock.Any(), gomock.Any()).
+					Return(nil, fmt.Errorf("%w: new@example.com", domain.ErrContactEmailTaken))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:    "Service Error",
+			method:  http.MethodPost,
+			reqBody: validBody,
+			setupMock: func(m *mocks.MockContactService) {
+				m.EXPECT().ChangeContactEmail(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, _, handler := setupContactHandlerTest(t)
+			tc.setupMock(mockService)
+
+			var reqBody bytes.Buffer
+			if tc.reqBody != nil {
+				if str, ok := tc.reqBody.(string); ok {
+					reqBody = *bytes.NewBufferString(str)
+				} else if err := json.NewEncoder(&reqBody).Encode(tc.reqBody); err != nil {
+					t.Fatalf("Failed to encode request body: %v", err)
+				}
+			}
+
+			req := httptest.NewRequest(tc.method, "/api/contacts.changeEmail", &reqBody)
+			rr := httptest.NewRecorder()
+			handler.handleChangeEmail(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+
+			if tc.expectedStatus == http.StatusOK {
+				var response domain.ChangeContactEmailResponse
+				err := json.Unmarshal(rr.Body.Bytes(), &response)
+				assert.NoError(t, err)
+				assert.Equal(t, "new@example.com", response.Contact.Email)
+				assert.Equal(t, "old@example.com", response.PreviousEmail)
+			}
+		})
+	}
+}
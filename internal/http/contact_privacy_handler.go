@@ -0,0 +1,118 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/http/middleware"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// ContactPrivacyHandler exposes GDPR data export and erasure endpoints
+type ContactPrivacyHandler struct {
+	service      domain.ContactPrivacyService
+	logger       logger.Logger
+	getJWTSecret func() ([]byte, error)
+}
+
+// NewContactPrivacyHandler creates a new contact privacy handler
+func NewContactPrivacyHandler(service domain.ContactPrivacyService, getJWTSecret func() ([]byte, error), logger logger.Logger) *ContactPrivacyHandler {
+	return &ContactPrivacyHandler{
+		service:      service,
+		getJWTSecret: getJWTSecret,
+		logger:       logger,
+	}
+}
+
+func (h *ContactPrivacyHandler) RegisterRoutes(mux *http.ServeMux) {
+	// Create auth middleware
+	authMiddleware := middleware.NewAuthMiddleware(h.getJWTSecret)
+	requireAuth := authMiddleware.RequireAuth()
+
+	mux.Handle("/api/contacts.exportData", requireAuth(http.HandlerFunc(h.handleExportData)))
+	mux.Handle("/api/contacts.erase", requireAuth(http.HandlerFunc(h.handleErase)))
+}
+
+func (h *ContactPrivacyHandler) handleExportData(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.ExportContactDataRequest
+	if err := req.FromQuery(r.URL.Query()); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	export, err := h.service.ExportContactData(r.Context(), &req)
+	if err != nil {
+		var permissionErr *domain.PermissionError
+		if errors.As(err, &permissionErr) {
+			WriteJSONError(w, permissionErr.Message, http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, domain.ErrContactNotFound) {
+			WriteJSONError(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		h.logger.WithField("error", err.Error()).Error("Failed to export contact data")
+		WriteJSONError(w, "Failed to export contact data", http.StatusInternalServerError)
+		return
+	}
+
+	if req.Format == domain.ContactDataExportFormatZIP {
+		writeContactDataZip(w, export, h.logger)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, export)
+}
+
+func (h *ContactPrivacyHandler) handleErase(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.EraseContactsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	task, err := h.service.EraseContacts(r.Context(), &req)
+	if err != nil {
+		var validationErr domain.ValidationError
+		if errors.As(err, &validationErr) {
+			WriteJSONError(w, validationErr.Message, http.StatusBadRequest)
+			return
+		}
+		var permissionErr *domain.PermissionError
+		if errors.As(err, &permissionErr) {
+			WriteJSONError(w, permissionErr.Message, http.StatusForbidden)
+			return
+		}
+		h.logger.WithField("error", err.Error()).Error("Failed to schedule contact erasure")
+		WriteJSONError(w, "Failed to schedule contact erasure", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{
+		"task": task,
+	})
+}
+
+// writeContactDataZip streams a contact data export as a ZIP attachment
+func writeContactDataZip(w http.ResponseWriter, export *domain.ContactDataExport, logger logger.Logger) {
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="contact-data-%s.zip"`, export.ExportedAt.Format("20060102-150405")))
+	w.WriteHeader(http.StatusOK)
+	if err := export.WriteZip(w); err != nil {
+		// Headers are already sent, the client receives a truncated archive
+		logger.WithField("error", err.Error()).Error("Failed to write contact data archive")
+	}
+}
@@ -0,0 +1,176 @@
+package http
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+)
+
+func setupContactPrivacyHandlerTest(t *testing.T) (*mocks.MockContactPrivacyService, *ContactPrivacyHandler) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	mockService := mocks.NewMockContactPrivacyService(ctrl)
+	mockLogger := pkgmocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().WithField(gomock.Any(), gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any()).AnyTimes()
+
+	jwtSecret := []byte("test-jwt-secret-key-for-testing-32bytes")
+	handler := NewContactPrivacyHandler(mockService, func() ([]byte, error) { return jwtSecret, nil }, mockLogger)
+	return mockService, handler
+}
+
+func TestContactPrivacyHandler_RegisterRoutes(t *testing.T) {
+	_, handler := setupContactPrivacyHandlerTest(t)
+	mux := http.NewServeMux()
+	handler.RegisterRoutes(mux)
+
+	for _, endpoint := range []string{"/api/contacts.exportData", "/api/contacts.erase"} {
+		_, pattern := mux.Handler(&http.Request{URL: &url.URL{Path: endpoint}})
+		assert.Equal(t, endpoint, pattern)
+	}
+}
+
+func TestContactPrivacyHandler_HandleExportData(t *testing.T) {
+	export := &domain.ContactDataExport{
+		Email:      "john@example.com",
+		ExportedAt: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		Contact:    json.RawMessage(`{"email":"john@example.com"}`),
+	}
+	expectedReq := func(format domain.ContactDataExportFormat) *domain.ExportContactDataRequest {
+		return &domain.ExportContactDataRequest{WorkspaceID: "ws1", Email: "john@example.com", Format: format}
+	}
+
+	t.Run("returns json", func(t *testing.T) {
+		mockService, handler := setupContactPrivacyHandlerTest(t)
+		mockService.EXPECT().ExportContactData(gomock.Any(), expectedReq(domain.ContactDataExportFormatJSON)).Return(export, nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleExportData(rr, httptest.NewRequest(http.MethodGet, "/api/contacts.exportData?workspace_id=ws1&email=john@example.com", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &body))
+		assert.Equal(t, "john@example.com", body["email"])
+	})
+
+	t.Run("returns a zip attachment", func(t *testing.T) {
+		mockService, handler := setupContactPrivacyHandlerTest(t)
+		mockService.EXPECT().ExportContactData(gomock.Any(), expectedReq(domain.ContactDataExportFormatZIP)).Return(export, nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleExportData(rr, httptest.NewRequest(http.MethodGet, "/api/contacts.exportData?workspace_id=ws1&email=john@example.com&format=zip", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/zip", rr.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="contact-data-20240501-100000.zip"`, rr.Header().Get("Content-Disposition"))
+		reader, err := zip.NewReader(bytes.NewReader(rr.Body.Bytes()), int64(rr.Body.Len()))
+		require.NoError(t, err)
+		assert.Equal(t, "export.json", reader.File[0].Name)
+	})
+
+	errorCases := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"permission denied", domain.NewPermissionError(domain.PermissionResourceContacts, domain.PermissionTypeRead, "denied"), http.StatusForbidden},
+		{"not found", fmt.Errorf("%w: john@example.com", domain.ErrContactNotFound), http.StatusNotFound},
+		{"internal error", errors.New("db error"), http.StatusInternalServerError},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, handler := setupContactPrivacyHandlerTest(t)
+			mockService.EXPECT().ExportContactData(gomock.Any(), gomock.Any()).Return(nil, tc.err)
+
+			rr := httptest.NewRecorder()
+			handler.handleExportData(rr, httptest.NewRequest(http.MethodGet, "/api/contacts.exportData?workspace_id=ws1&email=john@example.com", nil))
+
+			assert.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+
+	t.Run("rejects invalid query", func(t *testing.T) {
+		_, handler := setupContactPrivacyHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleExportData(rr, httptest.NewRequest(http.MethodGet, "/api/contacts.exportData?workspace_id=ws1", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("rejects other methods", func(t *testing.T) {
+		_, handler := setupContactPrivacyHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleExportData(rr, httptest.NewRequest(http.MethodPost, "/api/contacts.exportData", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
+
+func TestContactPrivacyHandler_HandleErase(t *testing.T) {
+	body := `{"workspace_id":"ws1","emails":["john@example.com"]}`
+
+	t.Run("schedules the erasure", func(t *testing.T) {
+		mockService, handler := setupContactPrivacyHandlerTest(t)
+		mockService.EXPECT().EraseContacts(gomock.Any(), &domain.EraseContactsRequest{
+			WorkspaceID: "ws1",
+			Emails:      []string{"john@example.com"},
+		}).Return(&domain.Task{ID: "task1", Type: domain.TaskTypeEraseContacts}, nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleErase(rr, httptest.NewRequest(http.MethodPost, "/api/contacts.erase", strings.NewReader(body)))
+
+		assert.Equal(t, http.StatusAccepted, rr.Code)
+		var response map[string]map[string]interface{}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, "task1", response["task"]["id"])
+	})
+
+	errorCases := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"validation error", domain.NewValidationError("emails is required"), http.StatusBadRequest},
+		{"permission denied", domain.NewPermissionError(domain.PermissionResourceContacts, domain.PermissionTypeWrite, "denied"), http.StatusForbidden},
+		{"internal error", errors.New("db error"), http.StatusInternalServerError},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, handler := setupContactPrivacyHandlerTest(t)
+			mockService.EXPECT().EraseContacts(gomock.Any(), gomock.Any()).Return(nil, tc.err)
+
+			rr := httptest.NewRecorder()
+			handler.handleErase(rr, httptest.NewRequest(http.MethodPost, "/api/contacts.erase", strings.NewReader(body)))
+
+			assert.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+
+	t.Run("rejects invalid body", func(t *testing.T) {
+		_, handler := setupContactPrivacyHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleErase(rr, httptest.NewRequest(http.MethodPost, "/api/contacts.erase", strings.NewReader("{")))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
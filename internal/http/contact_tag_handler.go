@@ -0,0 +1,121 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/http/middleware"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// ContactTagHandler exposes contact tags
+type ContactTagHandler struct {
+	service      domain.ContactTagService
+	logger       logger.Logger
+	getJWTSecret func() ([]byte, error)
+}
+
+// NewContactTagHandler creates a new contact tag handler
+func NewContactTagHandler(service domain.ContactTagService, getJWTSecret func() ([]byte, error), logger logger.Logger) *ContactTagHandler {
+	return &ContactTagHandler{
+		service:      service,
+		getJWTSecret: getJWTSecret,
+		logger:       logger,
+	}
+}
+
+func (h *ContactTagHandler) RegisterRoutes(mux *http.ServeMux) {
+	// Create auth middleware
+	authMiddleware := middleware.NewAuthMiddleware(h.getJWTSecret)
+	requireAuth := authMiddleware.RequireAuth()
+
+	mux.Handle("/api/tags.list", requireAuth(http.HandlerFunc(h.handleList)))
+	mux.Handle("/api/tags.add", requireAuth(http.HandlerFunc(h.handleAdd)))
+	mux.Handle("/api/tags.remove", requireAuth(http.HandlerFunc(h.handleRemove)))
+	mux.Handle("/api/tags.delete", requireAuth(http.HandlerFunc(h.handleDelete)))
+}
+
+func (h *ContactTagHandler) handleList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.ListTagsRequest
+	if err := req.FromURLParams(r.URL.Query()); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := h.service.ListTags(r.Context(), &req)
+	if err != nil {
+		writeServiceError(w, h.logger, err, "Failed to list tags")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (h *ContactTagHandler) handleAdd(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.UpdateContactTagsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	response, err := h.service.AddTags(r.Context(), &req)
+	if err != nil {
+		writeServiceError(w, h.logger, err, "Failed to add tags")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (h *ContactTagHandler) handleRemove(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.UpdateContactTagsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	response, err := h.service.RemoveTags(r.Context(), &req)
+	if err != nil {
+		writeServiceError(w, h.logger, err, "Failed to remove tags")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (h *ContactTagHandler) handleDelete(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.DeleteTagRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	response, err := h.service.DeleteTag(r.Context(), &req)
+	if err != nil {
+		writeServiceError(w, h.logger, err, "Failed to delete tag")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,166 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+)
+
+func setupContactTagHandlerTest(t *testing.T) (*mocks.MockContactTagService, *ContactTagHandler) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	mockService := mocks.NewMockContactTagService(ctrl)
+	mockLogger := pkgmocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().WithField(gomock.Any(), gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any()).AnyTimes()
+
+	jwtSecret := []byte("test-jwt-secret-key-for-testing-32bytes")
+	handler := NewContactTagHandler(mockService, func() ([]byte, error) { return jwtSecret, nil }, mockLogger)
+	return mockService, handler
+}
+
+func TestContactTagHandler_RegisterRoutes(t *testing.T) {
+	_, handler := setupContactTagHandlerTest(t)
+	mux := http.NewServeMux()
+	handler.RegisterRoutes(mux)
+
+	for _, endpoint := range []string{"/api/tags.list", "/api/tags.add", "/api/tags.remove", "/api/tags.delete"} {
+		_, pattern := mux.Handler(&http.Request{URL: &url.URL{Path: endpoint}})
+		assert.Equal(t, endpoint, pattern)
+	}
+}
+
+func TestContactTagHandler_HandleList(t *testing.T) {
+	t.Run("returns the tags", func(t *testing.T) {
+		mockService, handler := setupContactTagHandlerTest(t)
+		mockService.EXPECT().ListTags(gomock.Any(), &domain.ListTagsRequest{WorkspaceID: "ws1"}).
+			Return(&domain.ListTagsResponse{Tags: []domain.TagCount{{Tag: "vip", Count: 2}}}, nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleList(rr, httptest.NewRequest(http.MethodGet, "/api/tags.list?workspace_id=ws1", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"tags":[{"tag":"vip","count":2}]}`, rr.Body.String())
+	})
+
+	t.Run("requires a workspace", func(t *testing.T) {
+		_, handler := setupContactTagHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleList(rr, httptest.NewRequest(http.MethodGet, "/api/tags.list", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, handler := setupContactTagHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleList(rr, httptest.NewRequest(http.MethodPost, "/api/tags.list", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
+
+func TestContactTagHandler_HandleAdd(t *testing.T) {
+	t.Run("adds tags", func(t *testing.T) {
+		mockService, handler := setupContactTagHandlerTest(t)
+		mockService.EXPECT().AddTags(gomock.Any(), &domain.UpdateContactTagsRequest{WorkspaceID: "ws1", Tags: []string{"vip"}, SegmentID: "seg1"}).
+			Return(&domain.UpdateContactTagsResponse{Affected: 3}, nil)
+
+		rr := httptest.NewRecorder()
+		body := `{"workspace_id":"ws1","tags":["vip"],"segment_id":"seg1"}`
+		handler.handleAdd(rr, httptest.NewRequest(http.MethodPost, "/api/tags.add", strings.NewReader(body)))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"affected":3}`, rr.Body.String())
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		mockService, handler := setupContactTagHandlerTest(t)
+		mockService.EXPECT().AddTags(gomock.Any(), gomock.Any()).
+			Return(nil, domain.NewValidationError("exactly one of emails, segment_id or filter is required"))
+
+		rr := httptest.NewRecorder()
+		handler.handleAdd(rr, httptest.NewRequest(http.MethodPost, "/api/tags.add", strings.NewReader(`{"workspace_id":"ws1","tags":["vip"]}`)))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("unknown segment", func(t *testing.T) {
+		mockService, handler := setupContactTagHandlerTest(t)
+		mockService.EXPECT().AddTags(gomock.Any(), gomock.Any()).
+			Return(nil, &domain.ErrSegmentNotFound{Message: "segment not found: seg1"})
+
+		rr := httptest.NewRecorder()
+		handler.handleAdd(rr, httptest.NewRequest(http.MethodPost, "/api/tags.add", strings.NewReader(`{"workspace_id":"ws1","tags":["vip"],"segment_id":"seg1"}`)))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		_, handler := setupContactTagHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleAdd(rr, httptest.NewRequest(http.MethodPost, "/api/tags.add", strings.NewReader("{")))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestContactTagHandler_HandleRemove(t *testing.T) {
+	t.Run("removes tags", func(t *testing.T) {
+		mockService, handler := setupContactTagHandlerTest(t)
+		mockService.EXPECT().RemoveTags(gomock.Any(), gomock.Any()).Return(&domain.UpdateContactTagsResponse{Affected: 1}, nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleRemove(rr, httptest.NewRequest(http.MethodPost, "/api/tags.remove", strings.NewReader(`{"workspace_id":"ws1","tags":["vip"],"emails":["a@example.com"]}`)))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		mockService, handler := setupContactTagHandlerTest(t)
+		mockService.EXPECT().RemoveTags(gomock.Any(), gomock.Any()).
+			Return(nil, domain.NewPermissionError(domain.PermissionResourceContacts, domain.PermissionTypeWrite, "Insufficient permissions"))
+
+		rr := httptest.NewRecorder()
+		handler.handleRemove(rr, httptest.NewRequest(http.MethodPost, "/api/tags.remove", strings.NewReader(`{"workspace_id":"ws1"}`)))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestContactTagHandler_HandleDelete(t *testing.T) {
+	t.Run("unknown tag", func(t *testing.T) {
+		mockService, handler := setupContactTagHandlerTest(t)
+		mockService.EXPECT().DeleteTag(gomock.Any(), &domain.DeleteTagRequest{WorkspaceID: "ws1", Tag: "vip"}).
+			Return(nil, domain.ErrTagNotFound)
+
+		rr := httptest.NewRecorder()
+		handler.handleDelete(rr, httptest.NewRequest(http.MethodPost, "/api/tags.delete", strings.NewReader(`{"workspace_id":"ws1","tag":"vip"}`)))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		mockService, handler := setupContactTagHandlerTest(t)
+		mockService.EXPECT().DeleteTag(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		rr := httptest.NewRecorder()
+		handler.handleDelete(rr, httptest.NewRequest(http.MethodPost, "/api/tags.delete", strings.NewReader(`{"workspace_id":"ws1","tag":"vip"}`)))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
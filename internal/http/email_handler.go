@@ -2,6 +2,8 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
+	"html"
 	"net/http"
 	"strconv"
 	"strings"
@@ -104,6 +106,9 @@ func (h *EmailHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.Handle("/t/", http.HandlerFunc(h.handleEncryptedOpen))
 	mux.Handle("/r/", http.HandlerFunc(h.handleEncryptedClick))
 
+	// Hosted web version of emails
+	mux.Handle("/w/", http.HandlerFunc(h.handleWebView))
+
 	// Legacy tracking endpoints (backward compat for already-sent emails)
 	mux.Handle("/visit", http.HandlerFunc(h.handleClickRedirection))
 	mux.Handle("/opens", http.HandlerFunc(h.handleOpens))
@@ -373,3 +378,66 @@ func (h *EmailHandler) handleEncryptedClick(w http.ResponseWriter, r *http.Reque
 
 	http.Redirect(w, r, redirectTo, http.StatusSeeOther)
 }
+
+// handleWebView serves the hosted web version of an email: GET /w/{messageID}?wid=...&sig=...
+// for the message of a contact, or GET /w/b/{broadcastID}?wid=... for the public version of a broadcast
+func (h *EmailHandler) handleWebView(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/w/")
+	workspaceID := r.URL.Query().Get("wid")
+	if path == "" {
+		writeWebViewError(w, http.StatusNotFound, "This email could not be found.")
+		return
+	}
+
+	var view *domain.WebView
+	var err error
+	broadcastID, public := strings.CutPrefix(path, "b/")
+	if public {
+		view, err = h.emailService.RenderPublicBroadcast(r.Context(), workspaceID, broadcastID)
+	} else {
+		view, err = h.emailService.RenderWebView(r.Context(), workspaceID, path, r.URL.Query().Get("sig"))
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrWebViewNotFound):
+		writeWebViewError(w, http.StatusNotFound, "This email could not be found.")
+		return
+	case errors.Is(err, domain.ErrWebViewExpired):
+		writeWebViewError(w, http.StatusGone, "This email is no longer available online.")
+		return
+	case err != nil:
+		h.logger.WithField("error", err.Error()).Error("Failed to render web view")
+		writeWebViewError(w, http.StatusInternalServerError, "This email could not be displayed.")
+		return
+	}
+
+	if public {
+		w.Header().Set("Cache-Control", "public, max-age=300")
+	} else {
+		// Opening the web version counts as an open of the message
+		if !botdetection.IsBotUserAgent(r.Header.Get("User-Agent")) {
+			_ = h.emailService.OpenEmail(r.Context(), path, workspaceID)
+		}
+		// The page holds personal data and its URL a signature
+		w.Header().Set("Cache-Control", "private, no-store")
+		w.Header().Set("X-Robots-Tag", "noindex, nofollow")
+		w.Header().Set("Referrer-Policy", "no-referrer")
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(view.HTML))
+}
+
+// writeWebViewError writes a minimal HTML page for web view errors
+func writeWebViewError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("X-Robots-Tag", "noindex, nofollow")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte("<!DOCTYPE html><html><head><meta charset=\"utf-8\"><title>" + html.EscapeString(message) +
+		"</title></head><body style=\"font-family:sans-serif;text-align:center;padding:48px\"><p>" + html.EscapeString(message) + "</p></body></html>"))
+}
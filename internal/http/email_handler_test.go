@@ -447,3 +447,125 @@ func TestEmailHandler_HandleOpens(t *testing.T) {
 		})
 	}
 }
+
+func TestEmailHandler_HandleWebView(t *testing.T) {
+	browserUserAgent := "Mozilla/5.0 (Windows NT 10.0; Win64; x64) Chrome/120.0.0.0"
+	view := &domain.WebView{Subject: "Hello", HTML: "<html><body>Hello</body></html>"}
+
+	tests := []struct {
+		name               string
+		method             string
+		target             string
+		userAgent          string
+		setupExpectations  func(*mocks.MockEmailServiceInterface)
+		expectedStatusCode int
+		expectedCache      string
+		expectedRobots     string
+	}{
+		{
+			name:      "Message of a contact",
+			method:    http.MethodGet,
+			target:    "/w/message-123?wid=workspace-123&sig=abc",
+			userAgent: browserUserAgent,
+			setupExpectations: func(m *mocks.MockEmailServiceInterface) {
+				m.EXPECT().RenderWebView(gomock.Any(), "workspace-123", "message-123", "abc").Return(view, nil)
+				m.EXPECT().OpenEmail(gomock.Any(), "message-123", "workspace-123").Return(nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedCache:      "private, no-store",
+			expectedRobots:     "noindex, nofollow",
+		},
+		{
+			name:      "Bots do not count as opens",
+			method:    http.MethodGet,
+			target:    "/w/message-123?wid=workspace-123&sig=abc",
+			userAgent: "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			setupExpectations: func(m *mocks.MockEmailServiceInterface) {
+				m.EXPECT().RenderWebView(gomock.Any(), "workspace-123", "message-123", "abc").Return(view, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedCache:      "private, no-store",
+			expectedRobots:     "noindex, nofollow",
+		},
+		{
+			name:      "Public broadcast",
+			method:    http.MethodGet,
+			target:    "/w/b/broadcast-123?wid=workspace-123",
+			userAgent: browserUserAgent,
+			setupExpectations: func(m *mocks.MockEmailServiceInterface) {
+				m.EXPECT().RenderPublicBroadcast(gomock.Any(), "workspace-123", "broadcast-123").Return(view, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedCache:      "public, max-age=300",
+		},
+		{
+			name:      "Invalid signature",
+			method:    http.MethodGet,
+			target:    "/w/message-123?wid=workspace-123&sig=bad",
+			userAgent: browserUserAgent,
+			setupExpectations: func(m *mocks.MockEmailServiceInterface) {
+				m.EXPECT().RenderWebView(gomock.Any(), "workspace-123", "message-123", "bad").Return(nil, domain.ErrWebViewNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedRobots:     "noindex, nofollow",
+		},
+		{
+			name:      "Expired message",
+			method:    http.MethodGet,
+			target:    "/w/message-123?wid=workspace-123&sig=abc",
+			userAgent: browserUserAgent,
+			setupExpectations: func(m *mocks.MockEmailServiceInterface) {
+				m.EXPECT().RenderWebView(gomock.Any(), "workspace-123", "message-123", "abc").Return(nil, domain.ErrWebViewExpired)
+			},
+			expectedStatusCode: http.StatusGone,
+			expectedRobots:     "noindex, nofollow",
+		},
+		{
+			name:      "Rendering error",
+			method:    http.MethodGet,
+			target:    "/w/b/broadcast-123?wid=workspace-123",
+			userAgent: browserUserAgent,
+			setupExpectations: func(m *mocks.MockEmailServiceInterface) {
+				m.EXPECT().RenderPublicBroadcast(gomock.Any(), "workspace-123", "broadcast-123").Return(nil, errors.New("compilation failed"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedRobots:     "noindex, nofollow",
+		},
+		{
+			name:               "Missing message ID",
+			method:             http.MethodGet,
+			target:             "/w/?wid=workspace-123",
+			setupExpectations:  func(*mocks.MockEmailServiceInterface) {},
+			expectedStatusCode: http.StatusNotFound,
+			expectedRobots:     "noindex, nofollow",
+		},
+		{
+			name:               "Method not allowed",
+			method:             http.MethodPost,
+			target:             "/w/message-123?wid=workspace-123&sig=abc",
+			setupExpectations:  func(*mocks.MockEmailServiceInterface) {},
+			expectedStatusCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockEmailService, _, handler, _ := setupEmailHandlerTest(t)
+			tt.setupExpectations(mockEmailService)
+
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			req.Header.Set("User-Agent", tt.userAgent)
+			w := httptest.NewRecorder()
+
+			handler.handleWebView(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			assert.Equal(t, tt.expectedCache, w.Header().Get("Cache-Control"))
+			assert.Equal(t, tt.expectedRobots, w.Header().Get("X-Robots-Tag"))
+			if tt.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+				assert.Equal(t, view.HTML, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/http/middleware"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// EmailVerificationHandler exposes contact email verification results
+type EmailVerificationHandler struct {
+	service      domain.EmailVerificationService
+	logger       logger.Logger
+	getJWTSecret func() ([]byte, error)
+}
+
+// NewEmailVerificationHandler creates a new email verification handler
+func NewEmailVerificationHandler(service domain.EmailVerificationService, getJWTSecret func() ([]byte, error), logger logger.Logger) *EmailVerificationHandler {
+	return &EmailVerificationHandler{
+		service:      service,
+		getJWTSecret: getJWTSecret,
+		logger:       logger,
+	}
+}
+
+func (h *EmailVerificationHandler) RegisterRoutes(mux *http.ServeMux) {
+	// Create auth middleware
+	authMiddleware := middleware.NewAuthMiddleware(h.getJWTSecret)
+	requireAuth := authMiddleware.RequireAuth()
+
+	mux.Handle("/api/emailVerifications.get", requireAuth(http.HandlerFunc(h.handleGet)))
+	mux.Handle("/api/emailVerifications.verify", requireAuth(http.HandlerFunc(h.handleVerify)))
+}
+
+func (h *EmailVerificationHandler) handleGet(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.GetEmailVerificationRequest
+	if err := req.FromURLParams(r.URL.Query()); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	verification, err := h.service.GetVerification(r.Context(), &req)
+	if err != nil {
+		writeServiceError(w, h.logger, err, "Failed to get email verification")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, verification)
+}
+
+func (h *EmailVerificationHandler) handleVerify(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	verification, err := h.service.VerifyEmail(r.Context(), &req)
+	if err != nil {
+		writeServiceError(w, h.logger, err, "Failed to verify email")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, verification)
+}
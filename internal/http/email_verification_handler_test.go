@@ -0,0 +1,136 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	"github.com/Notifuse/notifuse/pkg/emailverifier"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+)
+
+func setupEmailVerificationHandlerTest(t *testing.T) (*mocks.MockEmailVerificationService, *EmailVerificationHandler) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	mockService := mocks.NewMockEmailVerificationService(ctrl)
+	mockLogger := pkgmocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().WithField(gomock.Any(), gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any()).AnyTimes()
+
+	jwtSecret := []byte("test-jwt-secret-key-for-testing-32bytes")
+	handler := NewEmailVerificationHandler(mockService, func() ([]byte, error) { return jwtSecret, nil }, mockLogger)
+	return mockService, handler
+}
+
+func TestEmailVerificationHandler_RegisterRoutes(t *testing.T) {
+	_, handler := setupEmailVerificationHandlerTest(t)
+	mux := http.NewServeMux()
+	handler.RegisterRoutes(mux)
+
+	for _, endpoint := range []string{"/api/emailVerifications.get", "/api/emailVerifications.verify"} {
+		_, pattern := mux.Handler(&http.Request{URL: &url.URL{Path: endpoint}})
+		assert.Equal(t, endpoint, pattern)
+	}
+}
+
+func TestEmailVerificationHandler_HandleGet(t *testing.T) {
+	t.Run("returns the verification", func(t *testing.T) {
+		mockService, handler := setupEmailVerificationHandlerTest(t)
+		mockService.EXPECT().GetVerification(gomock.Any(), &domain.GetEmailVerificationRequest{WorkspaceID: "ws1", Email: "john@example.com"}).
+			Return(&domain.EmailVerification{Email: "john@example.com", Status: emailverifier.StatusValid}, nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleGet(rr, httptest.NewRequest(http.MethodGet, "/api/emailVerifications.get?workspace_id=ws1&email=John@example.com", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var response domain.EmailVerification
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, emailverifier.StatusValid, response.Status)
+	})
+
+	t.Run("not verified yet", func(t *testing.T) {
+		mockService, handler := setupEmailVerificationHandlerTest(t)
+		mockService.EXPECT().GetVerification(gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("%w: john@example.com", domain.ErrEmailVerificationNotFound))
+
+		rr := httptest.NewRecorder()
+		handler.handleGet(rr, httptest.NewRequest(http.MethodGet, "/api/emailVerifications.get?workspace_id=ws1&email=john@example.com", nil))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("requires an email", func(t *testing.T) {
+		_, handler := setupEmailVerificationHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleGet(rr, httptest.NewRequest(http.MethodGet, "/api/emailVerifications.get?workspace_id=ws1", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, handler := setupEmailVerificationHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleGet(rr, httptest.NewRequest(http.MethodPost, "/api/emailVerifications.get", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
+
+func TestEmailVerificationHandler_HandleVerify(t *testing.T) {
+	t.Run("verifies the email", func(t *testing.T) {
+		mockService, handler := setupEmailVerificationHandlerTest(t)
+		mockService.EXPECT().VerifyEmail(gomock.Any(), &domain.VerifyEmailRequest{WorkspaceID: "ws1", Email: "john@gmial.com"}).
+			Return(&domain.EmailVerification{Email: "john@gmial.com", Status: emailverifier.StatusRisky, Suggestion: "john@gmail.com"}, nil)
+
+		rr := httptest.NewRecorder()
+		body := `{"workspace_id":"ws1","email":"john@gmial.com"}`
+		handler.handleVerify(rr, httptest.NewRequest(http.MethodPost, "/api/emailVerifications.verify", strings.NewReader(body)))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Contains(t, rr.Body.String(), `"suggestion":"john@gmail.com"`)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		mockService, handler := setupEmailVerificationHandlerTest(t)
+		mockService.EXPECT().VerifyEmail(gomock.Any(), gomock.Any()).
+			Return(nil, domain.NewPermissionError(domain.PermissionResourceContacts, domain.PermissionTypeWrite, "Insufficient permissions"))
+
+		rr := httptest.NewRecorder()
+		handler.handleVerify(rr, httptest.NewRequest(http.MethodPost, "/api/emailVerifications.verify", strings.NewReader(`{"workspace_id":"ws1","email":"a@b.com"}`)))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		mockService, handler := setupEmailVerificationHandlerTest(t)
+		mockService.EXPECT().VerifyEmail(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		rr := httptest.NewRecorder()
+		handler.handleVerify(rr, httptest.NewRequest(http.MethodPost, "/api/emailVerifications.verify", strings.NewReader(`{"workspace_id":"ws1","email":"a@b.com"}`)))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		_, handler := setupEmailVerificationHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleVerify(rr, httptest.NewRequest(http.MethodPost, "/api/emailVerifications.verify", strings.NewReader("{")))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,69 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/http/middleware"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+type HoldoutHandler struct {
+	service      domain.HoldoutService
+	logger       logger.Logger
+	getJWTSecret func() ([]byte, error)
+}
+
+func NewHoldoutHandler(service domain.HoldoutService, getJWTSecret func() ([]byte, error), logger logger.Logger) *HoldoutHandler {
+	return &HoldoutHandler{
+		service:      service,
+		getJWTSecret: getJWTSecret,
+		logger:       logger,
+	}
+}
+
+// RegisterRoutes registers the holdout HTTP endpoints
+func (h *HoldoutHandler) RegisterRoutes(mux *http.ServeMux) {
+	// Create auth middleware
+	authMiddleware := middleware.NewAuthMiddleware(h.getJWTSecret)
+	requireAuth := authMiddleware.RequireAuth()
+
+	// Register RPC-style endpoints with dot notation
+	mux.Handle("/api/holdouts.report", requireAuth(http.HandlerFunc(h.GetReport)))
+}
+
+// GET /api/holdouts.report - compares the goal conversions of the treated and holdout groups
+// of a broadcast or automation
+func (h *HoldoutHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.GetHoldoutReportRequest
+	if err := req.FromURLParams(r.URL.Query()); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.service.GetReport(r.Context(), &req)
+	if err != nil {
+		h.logger.WithField("error", err.Error()).Error("Failed to get holdout report")
+		if _, ok := err.(*domain.PermissionError); ok {
+			WriteJSONError(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		if _, ok := err.(domain.ValidationError); ok {
+			WriteJSONError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		WriteJSONError(w, "Failed to get holdout report", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"report": report,
+	})
+}
@@ -0,0 +1,127 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func setupHoldoutHandlerTest(t *testing.T) (*mocks.MockHoldoutService, *HoldoutHandler) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	mockService := mocks.NewMockHoldoutService(ctrl)
+	mockLogger := pkgmocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().WithField(gomock.Any(), gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any()).AnyTimes()
+
+	jwtSecret := []byte("test-jwt-secret-key-for-testing-32bytes")
+	handler := NewHoldoutHandler(mockService, func() ([]byte, error) { return jwtSecret, nil }, mockLogger)
+	return mockService, handler
+}
+
+func TestHoldoutHandler_RegisterRoutes(t *testing.T) {
+	_, handler := setupHoldoutHandlerTest(t)
+	mux := http.NewServeMux()
+	handler.RegisterRoutes(mux)
+
+	_, pattern := mux.Handler(&http.Request{URL: &url.URL{Path: "/api/holdouts.report"}})
+	assert.Equal(t, "/api/holdouts.report", pattern)
+}
+
+func TestHoldoutHandler_GetReport(t *testing.T) {
+	testCases := []struct {
+		name           string
+		method         string
+		queryParams    string
+		setupMock      func(*mocks.MockHoldoutService)
+		expectedStatus int
+	}{
+		{
+			name:        "success",
+			method:      http.MethodGet,
+			queryParams: "workspace_id=w1&source_type=broadcast&source_id=b1&goal_type=purchase",
+			setupMock: func(m *mocks.MockHoldoutService) {
+				m.EXPECT().GetReport(gomock.Any(), &domain.GetHoldoutReportRequest{
+					WorkspaceID: "w1",
+					SourceType:  domain.HoldoutSourceBroadcast,
+					SourceID:    "b1",
+					GoalType:    domain.GoalTypePurchase,
+				}).Return(&domain.HoldoutReport{SourceType: domain.HoldoutSourceBroadcast, SourceID: "b1", Percentage: 10}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodPost,
+			queryParams:    "workspace_id=w1&source_type=broadcast&source_id=b1",
+			setupMock:      func(m *mocks.MockHoldoutService) {},
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "invalid parameters",
+			method:         http.MethodGet,
+			queryParams:    "workspace_id=w1&source_type=list&source_id=b1",
+			setupMock:      func(m *mocks.MockHoldoutService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "holdout not enabled",
+			method:      http.MethodGet,
+			queryParams: "workspace_id=w1&source_type=automation&source_id=a1",
+			setupMock: func(m *mocks.MockHoldoutService) {
+				m.EXPECT().GetReport(gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewValidationError("holdout is not enabled for this automation"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "permission denied",
+			method:      http.MethodGet,
+			queryParams: "workspace_id=w1&source_type=automation&source_id=a1",
+			setupMock: func(m *mocks.MockHoldoutService) {
+				m.EXPECT().GetReport(gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewPermissionError(domain.PermissionResourceAutomations, domain.PermissionTypeRead, "denied"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:        "service error",
+			method:      http.MethodGet,
+			queryParams: "workspace_id=w1&source_type=broadcast&source_id=b1",
+			setupMock: func(m *mocks.MockHoldoutService) {
+				m.EXPECT().GetReport(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, handler := setupHoldoutHandlerTest(t)
+			tc.setupMock(mockService)
+
+			req := httptest.NewRequest(tc.method, "/api/holdouts.report?"+tc.queryParams, nil)
+			w := httptest.NewRecorder()
+
+			handler.GetReport(w, req)
+
+			assert.Equal(t, tc.expectedStatus, w.Code)
+			if tc.expectedStatus == http.StatusOK {
+				var response map[string]map[string]interface{}
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+				assert.Equal(t, "b1", response["report"]["source_id"])
+			}
+		})
+	}
+}
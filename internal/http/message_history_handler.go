@@ -151,7 +151,14 @@ func (h *MessageHistoryHandler) handleBroadcastStats(w http.ResponseWriter, r *h
 		return
 	}
 
-	stats, err := h.service.GetBroadcastStats(ctx, workspaceID, broadcastID)
+	// combined=true adds the stats of the resends and recurring occurrences of the broadcast
+	var stats *domain.MessageHistoryStatusSum
+	var err error
+	if r.URL.Query().Get("combined") == "true" {
+		stats, err = h.service.GetCombinedBroadcastStats(ctx, workspaceID, broadcastID)
+	} else {
+		stats, err = h.service.GetBroadcastStats(ctx, workspaceID, broadcastID)
+	}
 	if err != nil {
 		h.logger.WithField("error", err.Error()).Error("Failed to get stats")
 		WriteJSONError(w, "Failed to get stats", http.StatusInternalServerError)
@@ -3,6 +3,7 @@ package http
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -52,6 +53,8 @@ type FaviconResponse struct {
 func (h *NotificationCenterHandler) RegisterRoutes(mux *http.ServeMux) {
 	// Register public routes
 	mux.HandleFunc("/preferences", h.handlePreferences)
+	// self-service "download my data" link
+	mux.HandleFunc("/preferences/data-export", h.handleDataExport)
 	mux.HandleFunc("/subscribe", h.handleSubscribe)
 	// one-click unsubscribe for GMAIL header link
 	mux.HandleFunc("/unsubscribe-oneclick", h.handleUnsubscribeOneClick)
@@ -104,6 +107,55 @@ func (h *NotificationCenterHandler) handleGetPreferences(w http.ResponseWriter,
 	writeJSON(w, http.StatusOK, response)
 }
 
+func (h *NotificationCenterHandler) handleDataExport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.NotificationCenterRequest
+	if err := req.FromURLValues(r.URL.Query()); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Exports are expensive, so they share the preferences rate limits
+	if h.rateLimiter != nil && !h.rateLimiter.Allow("preferences:email", req.Email) {
+		retryAfter := h.rateLimiter.GetRemainingWindow("preferences:email", req.Email)
+		w.Header().Set("Retry-After", fmt.Sprintf("%d", retryAfter))
+		WriteJSONError(w, "Too many requests. Please try again later.", http.StatusTooManyRequests)
+		return
+	}
+	clientIP := getClientIP(r)
+	if h.rateLimiter != nil && !h.rateLimiter.Allow("preferences:ip", clientIP) {
+		retryAfter := h.rateLimiter.GetRemainingWindow("preferences:ip", clientIP)
+		w.Header().Set("Retry-After", fmt.Sprintf("%d", retryAfter))
+		WriteJSONError(w, "Too many requests. Please try again later.", http.StatusTooManyRequests)
+		return
+	}
+
+	export, err := h.service.ExportContactData(r.Context(), req.WorkspaceID, req.Email, req.EmailHMAC)
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid email verification") {
+			WriteJSONError(w, "Unauthorized: invalid verification", http.StatusUnauthorized)
+			return
+		}
+		if errors.Is(err, domain.ErrDataExportDisabled) {
+			WriteJSONError(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, domain.ErrContactNotFound) {
+			WriteJSONError(w, "Contact not found", http.StatusNotFound)
+			return
+		}
+		h.logger.WithField("error", err.Error()).Error("Failed to export contact data")
+		WriteJSONError(w, "Failed to export contact data", http.StatusInternalServerError)
+		return
+	}
+
+	writeContactDataZip(w, export, h.logger)
+}
+
 func (h *NotificationCenterHandler) handleUpdatePreferences(w http.ResponseWriter, r *http.Request) {
 	var req domain.UpdateContactPreferencesRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
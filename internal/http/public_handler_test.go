@@ -624,6 +624,102 @@ func TestNotificationCenterHandler_handleUpdatePreferences_RateLimiting(t *testi
 	assert.NotEmpty(t, rec.Header().Get("Retry-After"))
 }
 
+func TestNotificationCenterHandler_handleDataExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockNotificationCenterService(ctrl)
+	mockListService := mocks.NewMockListService(ctrl)
+	mockLogger := &mockLogger{}
+	handler := NewNotificationCenterHandler(mockService, mockListService, mockLogger, nil)
+
+	validURL := "/preferences/data-export?workspace_id=ws123&email=test@example.com&email_hmac=valid"
+
+	tests := []struct {
+		name               string
+		method             string
+		url                string
+		setupMock          func()
+		expectedStatusCode int
+	}{
+		{
+			name:               "method not allowed",
+			method:             http.MethodPost,
+			url:                validURL,
+			setupMock:          func() {},
+			expectedStatusCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name:               "missing email_hmac",
+			method:             http.MethodGet,
+			url:                "/preferences/data-export?workspace_id=ws123&email=test@example.com",
+			setupMock:          func() {},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:   "invalid HMAC",
+			method: http.MethodGet,
+			url:    validURL,
+			setupMock: func() {
+				mockService.EXPECT().
+					ExportContactData(gomock.Any(), "ws123", "test@example.com", "valid").
+					Return(nil, errors.New("invalid email verification"))
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:   "export disabled",
+			method: http.MethodGet,
+			url:    validURL,
+			setupMock: func() {
+				mockService.EXPECT().
+					ExportContactData(gomock.Any(), "ws123", "test@example.com", "valid").
+					Return(nil, domain.ErrDataExportDisabled)
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:   "contact not found",
+			method: http.MethodGet,
+			url:    validURL,
+			setupMock: func() {
+				mockService.EXPECT().
+					ExportContactData(gomock.Any(), "ws123", "test@example.com", "valid").
+					Return(nil, domain.ErrContactNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:   "successful export",
+			method: http.MethodGet,
+			url:    validURL,
+			setupMock: func() {
+				mockService.EXPECT().
+					ExportContactData(gomock.Any(), "ws123", "test@example.com", "valid").
+					Return(&domain.ContactDataExport{Email: "test@example.com", ExportedAt: time.Now()}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			req := httptest.NewRequest(tc.method, tc.url, nil)
+			rec := httptest.NewRecorder()
+
+			handler.handleDataExport(rec, req)
+
+			assert.Equal(t, tc.expectedStatusCode, rec.Code)
+			if tc.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, "application/zip", rec.Header().Get("Content-Type"))
+				assert.Contains(t, rec.Header().Get("Content-Disposition"), "attachment")
+			}
+		})
+	}
+}
+
 func TestNotificationCenterHandler_handleHealth(t *testing.T) {
 	// Test NotificationCenterHandler.handleHealth - this was at 0% coverage
 	ctrl := gomock.NewController(t)
@@ -240,6 +240,23 @@ func (h *RootHandler) serveBlog(w http.ResponseWriter, r *http.Request, workspac
 	// Try to parse URL parts
 	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
 
+	// Handle /archive/{list-id}, /archive/{list-id}/{broadcast-id} and the feeds of archived lists
+	if len(parts) >= 2 && len(parts) <= 3 && parts[0] == domain.NewsletterArchivePath &&
+		workspace.Settings.BlogSettings.IsListArchived(parts[1]) {
+		listID := parts[1]
+		switch {
+		case len(parts) == 2:
+			h.serveArchive(w, r, workspace, listID)
+		case parts[2] == "feed.xml":
+			h.serveArchiveFeed(w, r, workspace, listID, feedFormatRSS)
+		case parts[2] == "feed.json":
+			h.serveArchiveFeed(w, r, workspace, listID, feedFormatJSON)
+		default:
+			h.serveArchiveIssue(w, r, workspace, listID, parts[2])
+		}
+		return
+	}
+
 	// Handle /{category-slug}/feed.xml or /{category-slug}/feed.json
 	if len(parts) == 2 && (parts[1] == "feed.xml" || parts[1] == "feed.json") {
 		slug := parts[0]
@@ -643,7 +660,12 @@ func (h *RootHandler) serveBlogFeed(w http.ResponseWriter, r *http.Request, work
 		return
 	}
 
-	// BuildFeed defaults SelfURL/FeedURL to /feed.xml. Fix up for JSON.
+	h.writeBlogFeed(w, r, feed, format, etag, lastModified)
+}
+
+// writeBlogFeed serializes a feed in the requested format and writes it with its validators
+func (h *RootHandler) writeBlogFeed(w http.ResponseWriter, r *http.Request, feed *domain.BlogFeed, format feedFormat, etag, lastModified string) {
+	// Feeds default SelfURL/FeedURL to feed.xml. Fix up for JSON.
 	if format == feedFormatJSON {
 		jsonSelf := strings.Replace(feed.Meta.SelfURL, "/feed.xml", "/feed.json", 1)
 		feed.Meta.SelfURL = jsonSelf
@@ -652,6 +674,7 @@ func (h *RootHandler) serveBlogFeed(w http.ResponseWriter, r *http.Request, work
 
 	var body []byte
 	var contentType string
+	var err error
 	switch format {
 	case feedFormatJSON:
 		body, err = blogfeed.RenderJSON(feed)
@@ -686,6 +709,138 @@ func (h *RootHandler) serveBlogFeed(w http.ResponseWriter, r *http.Request, work
 	_, _ = w.Write(body)
 }
 
+// serveArchive serves the newsletter archive of a list, a page of its past broadcasts
+func (h *RootHandler) serveArchive(w http.ResponseWriter, r *http.Request, workspace *domain.Workspace, listID string) {
+	archivePath := "/" + domain.NewsletterArchiveSlug(listID)
+
+	pageStr := r.URL.Query().Get("page")
+	page := 1
+	if pageStr != "" {
+		var err error
+		page, err = strconv.Atoi(pageStr)
+		if err != nil || page < 1 {
+			http.Redirect(w, r, archivePath, http.StatusFound)
+			return
+		}
+	}
+
+	// Redirect ?page=1 to base URL to avoid duplicate content
+	if page == 1 && pageStr != "" {
+		http.Redirect(w, r, archivePath, http.StatusMovedPermanently)
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), domain.WorkspaceIDKey, workspace.ID)
+	themeVersion := previewThemeVersion(r)
+	cacheKey := fmt.Sprintf("%s:%s?page=%d", r.Host, archivePath, page)
+	h.serveCachedBlogPage(w, cacheKey, themeVersion, func() (string, error) {
+		return h.blogService.RenderArchivePage(ctx, workspace.ID, listID, page, themeVersion)
+	})
+}
+
+// serveArchiveIssue serves a past broadcast of an archived list
+func (h *RootHandler) serveArchiveIssue(w http.ResponseWriter, r *http.Request, workspace *domain.Workspace, listID, broadcastID string) {
+	ctx := context.WithValue(r.Context(), domain.WorkspaceIDKey, workspace.ID)
+	themeVersion := previewThemeVersion(r)
+	cacheKey := fmt.Sprintf("%s:/%s/%s", r.Host, domain.NewsletterArchiveSlug(listID), broadcastID)
+	h.serveCachedBlogPage(w, cacheKey, themeVersion, func() (string, error) {
+		return h.blogService.RenderArchiveIssuePage(ctx, workspace.ID, listID, broadcastID, themeVersion)
+	})
+}
+
+// serveArchiveFeed serves the RSS or JSON Feed of an archived list. Building the feed renders
+// every broadcast, so feeds are cached like pages and revalidated with their ETag.
+func (h *RootHandler) serveArchiveFeed(w http.ResponseWriter, r *http.Request, workspace *domain.Workspace, listID string, format feedFormat) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var feed *domain.BlogFeed
+	cacheKey := fmt.Sprintf("%s:%s", r.Host, r.URL.Path)
+	if h.cache != nil {
+		if cached, found := h.cache.Get(cacheKey); found {
+			feed, _ = cached.(*domain.BlogFeed)
+		}
+	}
+	if feed == nil {
+		var err error
+		feed, err = h.blogService.BuildArchiveFeed(r.Context(), workspace.ID, listID)
+		if err != nil {
+			if blogErr, ok := err.(*domain.BlogRenderError); ok {
+				h.handleBlogRenderError(w, blogErr)
+				return
+			}
+			h.logger.WithField("error", err.Error()).Error("Archive feed: build failed")
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+		if h.cache != nil {
+			h.cache.Set(cacheKey, feed, domain.BlogCacheTTL)
+		}
+	}
+
+	lastModified := feed.Meta.UpdatedAt.UTC().Format(http.TimeFormat)
+	if match := r.Header.Get("If-None-Match"); match != "" && match == feed.Meta.ETag {
+		w.Header().Set("ETag", feed.Meta.ETag)
+		w.Header().Set("Last-Modified", lastModified)
+		w.Header().Set("Cache-Control", "public, max-age=0, s-maxage=300, must-revalidate")
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	h.writeBlogFeed(w, r, feed, format, feed.Meta.ETag, lastModified)
+}
+
+// serveCachedBlogPage serves a rendered blog page from the cache, rendering and caching it on
+// a miss. Previews of theme versions bypass the cache.
+func (h *RootHandler) serveCachedBlogPage(w http.ResponseWriter, cacheKey string, themeVersion *int, render func() (string, error)) {
+	if h.cache != nil && themeVersion == nil {
+		if cached, found := h.cache.Get(cacheKey); found {
+			if html, ok := cached.(string); ok {
+				w.Header().Set("Content-Type", "text/html; charset=utf-8")
+				w.Header().Set("X-Cache", "HIT")
+				_, _ = w.Write([]byte(html))
+				return
+			}
+		}
+	}
+
+	html, err := render()
+	if err != nil {
+		if blogErr, ok := err.(*domain.BlogRenderError); ok {
+			h.handleBlogRenderError(w, blogErr)
+			return
+		}
+		h.logger.WithField("error", err.Error()).Error("Failed to render blog page")
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	if h.cache != nil && themeVersion == nil {
+		h.cache.Set(cacheKey, html, domain.BlogCacheTTL)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if themeVersion != nil {
+		w.Header().Set("Cache-Control", "no-store, no-cache, must-revalidate")
+		w.Header().Set("X-Cache", "BYPASS")
+	} else {
+		w.Header().Set("X-Cache", "MISS")
+	}
+	_, _ = w.Write([]byte(html))
+}
+
+// previewThemeVersion returns the theme version requested with preview_theme_version, if any
+func previewThemeVersion(r *http.Request) *int {
+	if versionStr := r.URL.Query().Get("preview_theme_version"); versionStr != "" {
+		if v, err := strconv.Atoi(versionStr); err == nil {
+			return &v
+		}
+	}
+	return nil
+}
+
 // serveBlog404 serves a 404 page for blog
 func (h *RootHandler) serveBlog404(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotFound)
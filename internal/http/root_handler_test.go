@@ -1795,3 +1795,193 @@ func TestRootHandler_serveBlogFeed(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
 }
+
+func TestRootHandler_serveArchive(t *testing.T) {
+	archivedWorkspace := func(workspace *domain.Workspace) *domain.Workspace {
+		workspace.Settings.BlogSettings.ArchiveListIDs = []string{"newsletter"}
+		return workspace
+	}
+
+	t.Run("routing to the archive of a list", func(t *testing.T) {
+		mockBlogService, _, _, workspace, handler := setupBlogHandlerTest(t)
+		archivedWorkspace(workspace)
+
+		mockBlogService.EXPECT().
+			RenderArchivePage(gomock.Any(), workspace.ID, "newsletter", 2, nil).
+			Return("<html><body>Archive</body></html>", nil)
+
+		req := httptest.NewRequest("GET", "/archive/newsletter?page=2", nil)
+		req.Host = "example.com"
+		w := httptest.NewRecorder()
+
+		handler.serveBlog(w, req, workspace)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "MISS", w.Header().Get("X-Cache"))
+		assert.Contains(t, w.Body.String(), "Archive")
+	})
+
+	t.Run("page=1 redirects", func(t *testing.T) {
+		_, _, _, workspace, handler := setupBlogHandlerTest(t)
+		archivedWorkspace(workspace)
+
+		req := httptest.NewRequest("GET", "/archive/newsletter?page=1", nil)
+		req.Host = "example.com"
+		w := httptest.NewRecorder()
+
+		handler.serveBlog(w, req, workspace)
+
+		assert.Equal(t, http.StatusMovedPermanently, w.Code)
+		assert.Equal(t, "/archive/newsletter", w.Header().Get("Location"))
+	})
+
+	t.Run("routing to a broadcast of the archive", func(t *testing.T) {
+		mockBlogService, _, _, workspace, handler := setupBlogHandlerTest(t)
+		archivedWorkspace(workspace)
+
+		mockBlogService.EXPECT().
+			RenderArchiveIssuePage(gomock.Any(), workspace.ID, "newsletter", "bc1", nil).
+			Return("<html><body>Issue</body></html>", nil).
+			Times(1)
+
+		for _, expectedCache := range []string{"MISS", "HIT"} {
+			req := httptest.NewRequest("GET", "/archive/newsletter/bc1", nil)
+			req.Host = "example.com"
+			w := httptest.NewRecorder()
+
+			handler.serveBlog(w, req, workspace)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, expectedCache, w.Header().Get("X-Cache"))
+			assert.Contains(t, w.Body.String(), "Issue")
+		}
+	})
+
+	t.Run("unknown broadcast returns 404", func(t *testing.T) {
+		mockBlogService, _, _, workspace, handler := setupBlogHandlerTest(t)
+		archivedWorkspace(workspace)
+
+		mockBlogService.EXPECT().
+			RenderArchiveIssuePage(gomock.Any(), workspace.ID, "newsletter", "missing", nil).
+			Return("", &domain.BlogRenderError{Code: domain.ErrCodePostNotFound, Message: "broadcast not found"})
+
+		req := httptest.NewRequest("GET", "/archive/newsletter/missing", nil)
+		req.Host = "example.com"
+		w := httptest.NewRecorder()
+
+		handler.serveBlog(w, req, workspace)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("lists that are not archived are not routed to the archive", func(t *testing.T) {
+		_, _, _, workspace, handler := setupBlogHandlerTest(t)
+		archivedWorkspace(workspace)
+
+		req := httptest.NewRequest("GET", "/archive/customers/bc1", nil)
+		req.Host = "example.com"
+		w := httptest.NewRecorder()
+
+		handler.serveBlog(w, req, workspace)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestRootHandler_serveArchiveFeed(t *testing.T) {
+	now := time.Now().UTC()
+	etag := `W/"archive123"`
+
+	newFeed := func() *domain.BlogFeed {
+		return &domain.BlogFeed{
+			Meta: domain.BlogFeedMeta{
+				Title:     "Test Blog — Weekly",
+				SiteURL:   "https://example.com/archive/newsletter",
+				FeedURL:   "https://example.com/archive/newsletter/feed.xml",
+				SelfURL:   "https://example.com/archive/newsletter/feed.xml",
+				Language:  "en",
+				UpdatedAt: now,
+				ETag:      etag,
+			},
+			Items: []domain.BlogFeedItem{
+				{
+					GUID:        "bc1",
+					Title:       "October news",
+					URL:         "https://example.com/archive/newsletter/bc1",
+					ContentHTML: "<p>Hello</p>",
+					PublishedAt: now,
+					UpdatedAt:   now,
+				},
+			},
+		}
+	}
+
+	t.Run("200 RSS, cached for the next requests", func(t *testing.T) {
+		mockBlogService, _, _, workspace, handler := setupBlogHandlerTest(t)
+
+		mockBlogService.EXPECT().BuildArchiveFeed(gomock.Any(), workspace.ID, "newsletter").Return(newFeed(), nil).Times(1)
+
+		for i := 0; i < 2; i++ {
+			req := httptest.NewRequest("GET", "/archive/newsletter/feed.xml", nil)
+			req.Host = "example.com"
+			w := httptest.NewRecorder()
+			handler.serveArchiveFeed(w, req, workspace, "newsletter", feedFormatRSS)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, "application/rss+xml; charset=utf-8", w.Header().Get("Content-Type"))
+			assert.Equal(t, etag, w.Header().Get("ETag"))
+			assert.Contains(t, w.Body.String(), "<title>October news</title>")
+		}
+	})
+
+	t.Run("200 JSON Feed", func(t *testing.T) {
+		mockBlogService, _, _, workspace, handler := setupBlogHandlerTest(t)
+
+		mockBlogService.EXPECT().BuildArchiveFeed(gomock.Any(), workspace.ID, "newsletter").Return(newFeed(), nil)
+
+		req := httptest.NewRequest("GET", "/archive/newsletter/feed.json", nil)
+		req.Host = "example.com"
+		w := httptest.NewRecorder()
+		handler.serveArchiveFeed(w, req, workspace, "newsletter", feedFormatJSON)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/feed+json; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), `"feed_url": "https://example.com/archive/newsletter/feed.json"`)
+	})
+
+	t.Run("304 on matching ETag", func(t *testing.T) {
+		mockBlogService, _, _, workspace, handler := setupBlogHandlerTest(t)
+
+		mockBlogService.EXPECT().BuildArchiveFeed(gomock.Any(), workspace.ID, "newsletter").Return(newFeed(), nil)
+
+		req := httptest.NewRequest("GET", "/archive/newsletter/feed.xml", nil)
+		req.Header.Set("If-None-Match", etag)
+		w := httptest.NewRecorder()
+		handler.serveArchiveFeed(w, req, workspace, "newsletter", feedFormatRSS)
+
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+
+	t.Run("405 on POST", func(t *testing.T) {
+		_, _, _, workspace, handler := setupBlogHandlerTest(t)
+
+		req := httptest.NewRequest("POST", "/archive/newsletter/feed.xml", nil)
+		w := httptest.NewRecorder()
+		handler.serveArchiveFeed(w, req, workspace, "newsletter", feedFormatRSS)
+
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("500 on build error", func(t *testing.T) {
+		mockBlogService, _, _, workspace, handler := setupBlogHandlerTest(t)
+
+		mockBlogService.EXPECT().BuildArchiveFeed(gomock.Any(), workspace.ID, "newsletter").Return(nil, assert.AnError)
+
+		req := httptest.NewRequest("GET", "/archive/newsletter/feed.xml", nil)
+		w := httptest.NewRecorder()
+		handler.serveArchiveFeed(w, req, workspace, "newsletter", feedFormatRSS)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
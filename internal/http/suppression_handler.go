@@ -0,0 +1,153 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/http/middleware"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// SuppressionHandler exposes the workspace suppression list
+type SuppressionHandler struct {
+	service      domain.SuppressionService
+	logger       logger.Logger
+	getJWTSecret func() ([]byte, error)
+}
+
+// NewSuppressionHandler creates a new suppression handler
+func NewSuppressionHandler(service domain.SuppressionService, getJWTSecret func() ([]byte, error), logger logger.Logger) *SuppressionHandler {
+	return &SuppressionHandler{
+		service:      service,
+		getJWTSecret: getJWTSecret,
+		logger:       logger,
+	}
+}
+
+func (h *SuppressionHandler) RegisterRoutes(mux *http.ServeMux) {
+	// Create auth middleware
+	authMiddleware := middleware.NewAuthMiddleware(h.getJWTSecret)
+	requireAuth := authMiddleware.RequireAuth()
+
+	mux.Handle("/api/suppressions.list", requireAuth(http.HandlerFunc(h.handleList)))
+	mux.Handle("/api/suppressions.import", requireAuth(http.HandlerFunc(h.handleImport)))
+	mux.Handle("/api/suppressions.export", requireAuth(http.HandlerFunc(h.handleExport)))
+	mux.Handle("/api/suppressions.delete", requireAuth(http.HandlerFunc(h.handleDelete)))
+	mux.Handle("/api/suppressions.check", requireAuth(http.HandlerFunc(h.handleCheck)))
+}
+
+func (h *SuppressionHandler) handleList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.ListSuppressionsRequest
+	if err := req.FromURLParams(r.URL.Query()); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := h.service.ListSuppressions(r.Context(), &req)
+	if err != nil {
+		writeServiceError(w, h.logger, err, "Failed to list suppressions")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (h *SuppressionHandler) handleImport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.ImportSuppressionsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.ImportSuppressions(r.Context(), &req); err != nil {
+		writeServiceError(w, h.logger, err, "Failed to import suppressions")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"success": true,
+		"count":   len(req.Suppressions),
+	})
+}
+
+func (h *SuppressionHandler) handleExport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	workspaceID := r.URL.Query().Get("workspace_id")
+	if workspaceID == "" {
+		WriteJSONError(w, "workspace_id is required", http.StatusBadRequest)
+		return
+	}
+
+	// Buffer the CSV so errors can still be reported with a proper status
+	var buf bytes.Buffer
+	if err := h.service.ExportSuppressions(r.Context(), workspaceID, &buf); err != nil {
+		writeServiceError(w, h.logger, err, "Failed to export suppressions")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="suppressions-%s.csv"`, time.Now().UTC().Format("20060102-150405")))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+func (h *SuppressionHandler) handleDelete(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.DeleteSuppressionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.DeleteSuppression(r.Context(), &req); err != nil {
+		writeServiceError(w, h.logger, err, "Failed to delete suppression")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]bool{
+		"success": true,
+	})
+}
+
+func (h *SuppressionHandler) handleCheck(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	workspaceID := r.URL.Query().Get("workspace_id")
+	if workspaceID == "" {
+		WriteJSONError(w, "workspace_id is required", http.StatusBadRequest)
+		return
+	}
+
+	response, err := h.service.CheckEmail(r.Context(), workspaceID, r.URL.Query().Get("email"))
+	if err != nil {
+		writeServiceError(w, h.logger, err, "Failed to check suppressions")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
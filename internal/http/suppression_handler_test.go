@@ -0,0 +1,211 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+)
+
+func setupSuppressionHandlerTest(t *testing.T) (*mocks.MockSuppressionService, *SuppressionHandler) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	mockService := mocks.NewMockSuppressionService(ctrl)
+	mockLogger := pkgmocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().WithField(gomock.Any(), gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any()).AnyTimes()
+
+	jwtSecret := []byte("test-jwt-secret-key-for-testing-32bytes")
+	handler := NewSuppressionHandler(mockService, func() ([]byte, error) { return jwtSecret, nil }, mockLogger)
+	return mockService, handler
+}
+
+func TestSuppressionHandler_RegisterRoutes(t *testing.T) {
+	_, handler := setupSuppressionHandlerTest(t)
+	mux := http.NewServeMux()
+	handler.RegisterRoutes(mux)
+
+	endpoints := []string{
+		"/api/suppressions.list",
+		"/api/suppressions.import",
+		"/api/suppressions.export",
+		"/api/suppressions.delete",
+		"/api/suppressions.check",
+	}
+	for _, endpoint := range endpoints {
+		_, pattern := mux.Handler(&http.Request{URL: &url.URL{Path: endpoint}})
+		assert.Equal(t, endpoint, pattern)
+	}
+}
+
+func TestSuppressionHandler_HandleList(t *testing.T) {
+	t.Run("returns a page", func(t *testing.T) {
+		mockService, handler := setupSuppressionHandlerTest(t)
+		mockService.EXPECT().ListSuppressions(gomock.Any(), &domain.ListSuppressionsRequest{WorkspaceID: "ws1", Kind: domain.SuppressionKindDomain, Limit: 50}).
+			Return(&domain.ListSuppressionsResponse{Suppressions: []*domain.Suppression{{Pattern: "example.com"}}, TotalCount: 1}, nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleList(rr, httptest.NewRequest(http.MethodGet, "/api/suppressions.list?workspace_id=ws1&kind=domain", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var response domain.ListSuppressionsResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, 1, response.TotalCount)
+	})
+
+	t.Run("rejects invalid query", func(t *testing.T) {
+		_, handler := setupSuppressionHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleList(rr, httptest.NewRequest(http.MethodGet, "/api/suppressions.list", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestSuppressionHandler_HandleImport(t *testing.T) {
+	body := `{"workspace_id":"ws1","suppressions":[{"pattern":"example.com","reason":"manual"}]}`
+
+	t.Run("imports entries", func(t *testing.T) {
+		mockService, handler := setupSuppressionHandlerTest(t)
+		mockService.EXPECT().ImportSuppressions(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *domain.ImportSuppressionsRequest) error {
+				assert.Equal(t, "example.com", req.Suppressions[0].Pattern)
+				return nil
+			})
+
+		rr := httptest.NewRecorder()
+		handler.handleImport(rr, httptest.NewRequest(http.MethodPost, "/api/suppressions.import", strings.NewReader(body)))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"success":true,"count":1}`, rr.Body.String())
+	})
+
+	errorCases := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"validation error", domain.NewValidationError("invalid domain"), http.StatusBadRequest},
+		{"permission denied", domain.NewPermissionError(domain.PermissionResourceContacts, domain.PermissionTypeWrite, "denied"), http.StatusForbidden},
+		{"internal error", errors.New("db error"), http.StatusInternalServerError},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService, handler := setupSuppressionHandlerTest(t)
+			mockService.EXPECT().ImportSuppressions(gomock.Any(), gomock.Any()).Return(tc.err)
+
+			rr := httptest.NewRecorder()
+			handler.handleImport(rr, httptest.NewRequest(http.MethodPost, "/api/suppressions.import", strings.NewReader(body)))
+
+			assert.Equal(t, tc.wantStatus, rr.Code)
+		})
+	}
+}
+
+func TestSuppressionHandler_HandleExport(t *testing.T) {
+	t.Run("returns a csv attachment", func(t *testing.T) {
+		mockService, handler := setupSuppressionHandlerTest(t)
+		mockService.EXPECT().ExportSuppressions(gomock.Any(), "ws1", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, w io.Writer) error {
+				_, err := io.WriteString(w, "pattern\nexample.com\n")
+				return err
+			})
+
+		rr := httptest.NewRecorder()
+		handler.handleExport(rr, httptest.NewRequest(http.MethodGet, "/api/suppressions.export?workspace_id=ws1", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "text/csv", rr.Header().Get("Content-Type"))
+		assert.Contains(t, rr.Header().Get("Content-Disposition"), `attachment; filename="suppressions-`)
+		assert.Equal(t, "pattern\nexample.com\n", rr.Body.String())
+	})
+
+	t.Run("reports errors as json", func(t *testing.T) {
+		mockService, handler := setupSuppressionHandlerTest(t)
+		mockService.EXPECT().ExportSuppressions(gomock.Any(), "ws1", gomock.Any()).
+			Return(domain.NewPermissionError(domain.PermissionResourceContacts, domain.PermissionTypeRead, "denied"))
+
+		rr := httptest.NewRecorder()
+		handler.handleExport(rr, httptest.NewRequest(http.MethodGet, "/api/suppressions.export?workspace_id=ws1", nil))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestSuppressionHandler_HandleDelete(t *testing.T) {
+	body := `{"workspace_id":"ws1","pattern":"example.com"}`
+
+	t.Run("deletes the pattern", func(t *testing.T) {
+		mockService, handler := setupSuppressionHandlerTest(t)
+		mockService.EXPECT().DeleteSuppression(gomock.Any(), &domain.DeleteSuppressionRequest{WorkspaceID: "ws1", Pattern: "example.com"}).Return(nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleDelete(rr, httptest.NewRequest(http.MethodPost, "/api/suppressions.delete", strings.NewReader(body)))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("returns not found", func(t *testing.T) {
+		mockService, handler := setupSuppressionHandlerTest(t)
+		mockService.EXPECT().DeleteSuppression(gomock.Any(), gomock.Any()).Return(fmt.Errorf("%w: example.com", domain.ErrSuppressionNotFound))
+
+		rr := httptest.NewRecorder()
+		handler.handleDelete(rr, httptest.NewRequest(http.MethodPost, "/api/suppressions.delete", strings.NewReader(body)))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("rejects other methods", func(t *testing.T) {
+		_, handler := setupSuppressionHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleDelete(rr, httptest.NewRequest(http.MethodGet, "/api/suppressions.delete", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
+
+func TestSuppressionHandler_HandleCheck(t *testing.T) {
+	t.Run("returns the matches", func(t *testing.T) {
+		mockService, handler := setupSuppressionHandlerTest(t)
+		mockService.EXPECT().CheckEmail(gomock.Any(), "ws1", "john@example.com").Return(&domain.CheckSuppressionResponse{
+			Email:      "john@example.com",
+			Suppressed: true,
+			Matches:    []*domain.Suppression{{Pattern: "example.com", Kind: domain.SuppressionKindDomain}},
+		}, nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleCheck(rr, httptest.NewRequest(http.MethodGet, "/api/suppressions.check?workspace_id=ws1&email=john@example.com", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var response domain.CheckSuppressionResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.True(t, response.Suppressed)
+		assert.Equal(t, "example.com", response.Matches[0].Pattern)
+	})
+
+	t.Run("requires a workspace", func(t *testing.T) {
+		_, handler := setupSuppressionHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleCheck(rr, httptest.NewRequest(http.MethodGet, "/api/suppressions.check?email=john@example.com", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -2,6 +2,7 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -38,6 +39,12 @@ func (h *TemplateHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.Handle("/api/templates.update", requireAuth(http.HandlerFunc(h.handleUpdate)))
 	mux.Handle("/api/templates.delete", requireAuth(http.HandlerFunc(h.handleDelete)))
 	mux.Handle("/api/templates.compile", requireAuth(http.HandlerFunc(h.handleCompile)))
+	mux.Handle("/api/templates.versions", requireAuth(http.HandlerFunc(h.handleVersions)))
+	mux.Handle("/api/templates.diff", requireAuth(http.HandlerFunc(h.handleDiff)))
+	mux.Handle("/api/templates.publish", requireAuth(http.HandlerFunc(h.handlePublish)))
+	mux.Handle("/api/templates.rollback", requireAuth(http.HandlerFunc(h.handleRollback)))
+	mux.Handle("/api/templates.lint", requireAuth(http.HandlerFunc(h.handleLint)))
+	mux.Handle("/api/templates.translate", requireAuth(http.HandlerFunc(h.handleTranslate)))
 }
 
 func (h *TemplateHandler) handleList(w http.ResponseWriter, r *http.Request) {
@@ -227,3 +234,197 @@ func (h *TemplateHandler) handleCompile(w http.ResponseWriter, r *http.Request)
 
 	writeJSON(w, http.StatusOK, resp)
 }
+
+func (h *TemplateHandler) handleVersions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.GetTemplateVersionsRequest
+	if err := req.FromURLParams(r.URL.Query()); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	versions, err := h.service.GetTemplateVersions(r.Context(), req.WorkspaceID, req.ID)
+	if err != nil {
+		if _, ok := err.(*domain.ErrTemplateNotFound); ok {
+			WriteJSONError(w, "Template not found", http.StatusNotFound)
+			return
+		}
+		h.logger.WithField("error", err.Error()).Error("Failed to get template versions")
+		WriteJSONError(w, "Failed to get template versions", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"versions": versions,
+	})
+}
+
+func (h *TemplateHandler) handleDiff(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.DiffTemplateVersionsRequest
+	if err := req.FromURLParams(r.URL.Query()); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	diff, err := h.service.DiffTemplateVersions(r.Context(), req.WorkspaceID, req.ID, req.From, req.To)
+	if err != nil {
+		if _, ok := err.(*domain.ErrTemplateNotFound); ok {
+			WriteJSONError(w, "Template version not found", http.StatusNotFound)
+			return
+		}
+		h.logger.WithField("error", err.Error()).Error("Failed to diff template versions")
+		WriteJSONError(w, "Failed to diff template versions", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"diff": diff,
+	})
+}
+
+func (h *TemplateHandler) handlePublish(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.PublishTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithField("error", err.Error()).Error("Failed to decode request body")
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	template, err := h.service.PublishTemplate(r.Context(), req.WorkspaceID, req.ID, req.Version)
+	if err != nil {
+		if _, ok := err.(*domain.ErrTemplateNotFound); ok {
+			WriteJSONError(w, "Template not found", http.StatusNotFound)
+			return
+		}
+		h.logger.WithField("error", err.Error()).Error("Failed to publish template")
+		WriteJSONError(w, "Failed to publish template", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"template": template,
+	})
+}
+
+func (h *TemplateHandler) handleRollback(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.RollbackTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithField("error", err.Error()).Error("Failed to decode request body")
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	template, err := h.service.RollbackTemplate(r.Context(), req.WorkspaceID, req.ID, req.Version)
+	if err != nil {
+		if _, ok := err.(*domain.ErrTemplateNotFound); ok {
+			WriteJSONError(w, "Template version not found", http.StatusNotFound)
+			return
+		}
+		h.logger.WithField("error", err.Error()).Error("Failed to roll back template")
+		WriteJSONError(w, "Failed to roll back template", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"template": template,
+	})
+}
+
+func (h *TemplateHandler) handleLint(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.LintTemplateRequest
+	if err := req.FromURLParams(r.URL.Query()); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.service.LintTemplate(r.Context(), req.WorkspaceID, req.ID, req.Version)
+	if err != nil {
+		if _, ok := err.(*domain.ErrTemplateNotFound); ok {
+			WriteJSONError(w, "Template not found", http.StatusNotFound)
+			return
+		}
+		h.logger.WithField("error", err.Error()).Error("Failed to lint template")
+		WriteJSONError(w, "Failed to lint template", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"report": report,
+	})
+}
+
+func (h *TemplateHandler) handleTranslate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.TranslateTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.WithField("error", err.Error()).Error("Failed to decode request body")
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := h.service.TranslateTemplate(r.Context(), &req)
+	if err != nil {
+		var validationErr domain.ValidationError
+		var permissionErr *domain.PermissionError
+		switch {
+		case errors.As(err, &validationErr):
+			WriteJSONError(w, validationErr.Message, http.StatusBadRequest)
+			return
+		case errors.As(err, &permissionErr):
+			WriteJSONError(w, permissionErr.Message, http.StatusForbidden)
+			return
+		}
+		if _, ok := err.(*domain.ErrTemplateNotFound); ok {
+			WriteJSONError(w, "Template not found", http.StatusNotFound)
+			return
+		}
+		h.logger.WithField("error", err.Error()).Error("Failed to translate template")
+		WriteJSONError(w, "Failed to translate template", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
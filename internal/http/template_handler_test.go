@@ -894,3 +894,204 @@ func TestHandleUpdate_CodeModeTemplate(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+func TestTemplateHandler_HandleVersions(t *testing.T) {
+	mockService, _, serverURL, secretKey, cleanup := setupTemplateHandlerTest(t)
+	defer cleanup()
+	token := createTestToken(secretKey)
+
+	createdBy := "user-1"
+	versions := []*domain.TemplateVersion{
+		{Version: 2, Name: "Welcome", CreatedBy: &createdBy, CreatedAt: time.Now().UTC()},
+		{Version: 1, Name: "Welcome", CreatedAt: time.Now().UTC(), Live: true},
+	}
+	mockService.EXPECT().GetTemplateVersions(gomock.Any(), "workspace123", "template1").Return(versions, nil)
+
+	resp := sendRequest(t, http.MethodGet, serverURL+"/api/templates.versions?workspace_id=workspace123&id=template1", token, nil)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Versions []*domain.TemplateVersion `json:"versions"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Versions, 2)
+	assert.Equal(t, "user-1", *body.Versions[0].CreatedBy)
+	assert.True(t, body.Versions[1].Live)
+
+	mockService.EXPECT().GetTemplateVersions(gomock.Any(), "workspace123", "missing").Return(nil, &domain.ErrTemplateNotFound{Message: "template not found"})
+	resp = sendRequest(t, http.MethodGet, serverURL+"/api/templates.versions?workspace_id=workspace123&id=missing", token, nil)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestTemplateHandler_HandleDiff(t *testing.T) {
+	mockService, _, serverURL, secretKey, cleanup := setupTemplateHandlerTest(t)
+	defer cleanup()
+	token := createTestToken(secretKey)
+
+	mockService.EXPECT().DiffTemplateVersions(gomock.Any(), "workspace123", "template1", int64(1), int64(2)).Return(&domain.TemplateVersionDiff{
+		TemplateID:  "template1",
+		FromVersion: 1,
+		ToVersion:   2,
+		Changes:     []domain.TemplateChange{{Path: "email.subject", Type: domain.TemplateChangeModified, From: "Hello", To: "Hi"}},
+	}, nil)
+
+	resp := sendRequest(t, http.MethodGet, serverURL+"/api/templates.diff?workspace_id=workspace123&id=template1&from=1&to=2", token, nil)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Diff domain.TemplateVersionDiff `json:"diff"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Diff.Changes, 1)
+	assert.Equal(t, "email.subject", body.Diff.Changes[0].Path)
+
+	resp = sendRequest(t, http.MethodGet, serverURL+"/api/templates.diff?workspace_id=workspace123&id=template1&from=1", token, nil)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestTemplateHandler_HandlePublish(t *testing.T) {
+	mockService, _, serverURL, secretKey, cleanup := setupTemplateHandlerTest(t)
+	defer cleanup()
+	token := createTestToken(secretKey)
+
+	publishedAt := time.Now().UTC()
+	mockService.EXPECT().PublishTemplate(gomock.Any(), "workspace123", "template1", int64(0)).Return(&domain.Template{ID: "template1", Version: 4, PublishedAt: &publishedAt}, nil)
+
+	resp := sendRequest(t, http.MethodPost, serverURL+"/api/templates.publish", token, domain.PublishTemplateRequest{WorkspaceID: "workspace123", ID: "template1"})
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = sendRequest(t, http.MethodGet, serverURL+"/api/templates.publish", token, nil)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	mockService.EXPECT().PublishTemplate(gomock.Any(), "workspace123", "template1", int64(9)).Return(nil, &domain.ErrTemplateNotFound{Message: "template not found"})
+	resp = sendRequest(t, http.MethodPost, serverURL+"/api/templates.publish", token, domain.PublishTemplateRequest{WorkspaceID: "workspace123", ID: "template1", Version: 9})
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestTemplateHandler_HandleRollback(t *testing.T) {
+	mockService, _, serverURL, secretKey, cleanup := setupTemplateHandlerTest(t)
+	defer cleanup()
+	token := createTestToken(secretKey)
+
+	mockService.EXPECT().RollbackTemplate(gomock.Any(), "workspace123", "template1", int64(2)).Return(&domain.Template{ID: "template1", Version: 5}, nil)
+
+	resp := sendRequest(t, http.MethodPost, serverURL+"/api/templates.rollback", token, domain.RollbackTemplateRequest{WorkspaceID: "workspace123", ID: "template1", Version: 2})
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Template domain.Template `json:"template"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, int64(5), body.Template.Version)
+
+	// The version to restore is required
+	resp = sendRequest(t, http.MethodPost, serverURL+"/api/templates.rollback", token, domain.RollbackTemplateRequest{WorkspaceID: "workspace123", ID: "template1"})
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestTemplateHandler_HandleLint(t *testing.T) {
+	mockService, _, serverURL, secretKey, cleanup := setupTemplateHandlerTest(t)
+	defer cleanup()
+	token := createTestToken(secretKey)
+
+	mockService.EXPECT().LintTemplate(gomock.Any(), "workspace123", "template1", int64(2)).Return(&domain.TemplateLintReport{
+		TemplateID: "template1",
+		Version:    2,
+		Score:      1.5,
+		Threshold:  domain.TemplateLintThreshold,
+		Passed:     true,
+		Findings: []domain.TemplateLintFinding{
+			{Rule: domain.TemplateLintRuleURLShortener, Severity: domain.TemplateLintSeverityWarning, Score: 1.5, BlockID: "button-1"},
+		},
+	}, nil)
+
+	resp := sendRequest(t, http.MethodGet, serverURL+"/api/templates.lint?workspace_id=workspace123&id=template1&version=2", token, nil)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Report *domain.TemplateLintReport `json:"report"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.NotNil(t, body.Report)
+	assert.True(t, body.Report.Passed)
+	require.Len(t, body.Report.Findings, 1)
+	assert.Equal(t, "button-1", body.Report.Findings[0].BlockID)
+
+	mockService.EXPECT().LintTemplate(gomock.Any(), "workspace123", "missing", int64(0)).Return(nil, &domain.ErrTemplateNotFound{Message: "template not found"})
+	resp = sendRequest(t, http.MethodGet, serverURL+"/api/templates.lint?workspace_id=workspace123&id=missing", token, nil)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp = sendRequest(t, http.MethodGet, serverURL+"/api/templates.lint?workspace_id=workspace123", token, nil)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = sendRequest(t, http.MethodPost, serverURL+"/api/templates.lint?workspace_id=workspace123&id=template1", token, nil)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
+
+func TestTemplateHandler_HandleTranslate(t *testing.T) {
+	mockService, _, serverURL, secretKey, cleanup := setupTemplateHandlerTest(t)
+	defer cleanup()
+	token := createTestToken(secretKey)
+
+	request := domain.TranslateTemplateRequest{WorkspaceID: "workspace123", ID: "template1", Languages: []string{"fr"}}
+	mockService.EXPECT().TranslateTemplate(gomock.Any(), &request).Return(&domain.TranslateTemplateResponse{
+		TemplateID: "template1",
+		Version:    3,
+		Proposals: []domain.TemplateTranslationProposal{{
+			Language:    "fr",
+			Translation: domain.TemplateTranslation{Email: &domain.EmailTemplate{Subject: "Bonjour"}},
+			Changes: []domain.TemplateTranslationChange{{
+				TemplateChange: domain.TemplateChange{Path: "email.subject", Type: domain.TemplateChangeModified, From: "Hello", To: "Bonjour"},
+				Review:         domain.TemplateReviewPending,
+			}},
+		}},
+	}, nil)
+
+	resp := sendRequest(t, http.MethodPost, serverURL+"/api/templates.translate", token, request)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body domain.TranslateTemplateResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	require.Len(t, body.Proposals, 1)
+	require.Len(t, body.Proposals[0].Changes, 1)
+	assert.Equal(t, "email.subject", body.Proposals[0].Changes[0].Path)
+	assert.Equal(t, domain.TemplateReviewPending, body.Proposals[0].Changes[0].Review)
+
+	mockService.EXPECT().TranslateTemplate(gomock.Any(), gomock.Any()).Return(nil, domain.NewValidationError("no LLM integration is configured for this workspace"))
+	resp = sendRequest(t, http.MethodPost, serverURL+"/api/templates.translate", token, request)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	mockService.EXPECT().TranslateTemplate(gomock.Any(), gomock.Any()).Return(nil, &domain.ErrTemplateNotFound{Message: "template not found"})
+	resp = sendRequest(t, http.MethodPost, serverURL+"/api/templates.translate", token, request)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	mockService.EXPECT().TranslateTemplate(gomock.Any(), gomock.Any()).Return(nil, errors.New("llm unavailable"))
+	resp = sendRequest(t, http.MethodPost, serverURL+"/api/templates.translate", token, request)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+	resp = sendRequest(t, http.MethodPost, serverURL+"/api/templates.translate", token,
+		domain.TranslateTemplateRequest{WorkspaceID: "workspace123", ID: "template1", Languages: []string{"xx"}})
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = sendRequest(t, http.MethodGet, serverURL+"/api/templates.translate", token, nil)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
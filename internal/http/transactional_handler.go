@@ -2,6 +2,7 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -238,6 +239,12 @@ func (h *TransactionalNotificationHandler) handleSend(w http.ResponseWriter, r *
 
 	messageID, err := h.service.SendNotification(r.Context(), req.WorkspaceID, req.Notification)
 	if err != nil {
+		// Suppressed recipients are an expected outcome, not a failure
+		if errors.Is(err, domain.ErrEmailSuppressed) {
+			WriteJSONError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
 		h.logger.WithField("error", err.Error()).Error("Failed to send transactional notification")
 
 		if strings.Contains(err.Error(), "not found") ||
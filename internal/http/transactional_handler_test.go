@@ -992,6 +992,18 @@ func TestTransactionalNotificationHandler_HandleSend(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 			checkResponse:  nil,
 		},
+		{
+			name:        "suppressed recipient",
+			method:      http.MethodPost,
+			requestBody: validReqBody,
+			setupMock: func() {
+				mockService.EXPECT().
+					SendNotification(gomock.Any(), gomock.Eq(workspaceID), gomock.Any()).
+					Return("", fmt.Errorf("%w: test@example.com blocked by example.com (manual)", domain.ErrEmailSuppressed))
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse:  nil,
+		},
 		{
 			name:        "successful send",
 			method:      http.MethodPost,
@@ -0,0 +1,148 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/http/middleware"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// TranslationHandler exposes the translation catalogs of workspaces
+type TranslationHandler struct {
+	service      domain.TranslationService
+	logger       logger.Logger
+	getJWTSecret func() ([]byte, error)
+}
+
+// NewTranslationHandler creates a new translation handler
+func NewTranslationHandler(service domain.TranslationService, getJWTSecret func() ([]byte, error), logger logger.Logger) *TranslationHandler {
+	return &TranslationHandler{
+		service:      service,
+		getJWTSecret: getJWTSecret,
+		logger:       logger,
+	}
+}
+
+func (h *TranslationHandler) RegisterRoutes(mux *http.ServeMux) {
+	// Create auth middleware
+	authMiddleware := middleware.NewAuthMiddleware(h.getJWTSecret)
+	requireAuth := authMiddleware.RequireAuth()
+
+	mux.Handle("/api/translations.list", requireAuth(http.HandlerFunc(h.handleList)))
+	mux.Handle("/api/translations.upsert", requireAuth(http.HandlerFunc(h.handleUpsert)))
+	mux.Handle("/api/translations.delete", requireAuth(http.HandlerFunc(h.handleDelete)))
+	mux.Handle("/api/translations.import", requireAuth(http.HandlerFunc(h.handleImport)))
+	mux.Handle("/api/translations.export", requireAuth(http.HandlerFunc(h.handleExport)))
+}
+
+func (h *TranslationHandler) handleList(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.ListTranslationsRequest
+	if err := req.FromURLParams(r.URL.Query()); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := h.service.ListTranslations(r.Context(), &req)
+	if err != nil {
+		writeServiceError(w, h.logger, err, "Failed to list translations")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (h *TranslationHandler) handleUpsert(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.UpsertTranslationsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	response, err := h.service.UpsertTranslations(r.Context(), &req)
+	if err != nil {
+		writeServiceError(w, h.logger, err, "Failed to upsert translations")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (h *TranslationHandler) handleDelete(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.DeleteTranslationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.service.DeleteTranslation(r.Context(), &req); err != nil {
+		writeServiceError(w, h.logger, err, "Failed to delete translation")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]bool{
+		"success": true,
+	})
+}
+
+func (h *TranslationHandler) handleImport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.ImportTranslationsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		WriteJSONError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	response, err := h.service.ImportTranslations(r.Context(), &req)
+	if err != nil {
+		writeServiceError(w, h.logger, err, "Failed to import translations")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (h *TranslationHandler) handleExport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		WriteJSONError(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req domain.ExportTranslationsRequest
+	if err := req.FromURLParams(r.URL.Query()); err != nil {
+		WriteJSONError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	export, err := h.service.ExportTranslations(r.Context(), &req)
+	if err != nil {
+		writeServiceError(w, h.logger, err, "Failed to export translations")
+		return
+	}
+
+	w.Header().Set("Content-Type", export.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, export.Filename))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(export.Content)
+}
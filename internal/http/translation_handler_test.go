@@ -0,0 +1,194 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+)
+
+func setupTranslationHandlerTest(t *testing.T) (*mocks.MockTranslationService, *TranslationHandler) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	mockService := mocks.NewMockTranslationService(ctrl)
+	mockLogger := pkgmocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().WithField(gomock.Any(), gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any()).AnyTimes()
+
+	jwtSecret := []byte("test-jwt-secret-key-for-testing-32bytes")
+	handler := NewTranslationHandler(mockService, func() ([]byte, error) { return jwtSecret, nil }, mockLogger)
+	return mockService, handler
+}
+
+func TestTranslationHandler_RegisterRoutes(t *testing.T) {
+	_, handler := setupTranslationHandlerTest(t)
+	mux := http.NewServeMux()
+	handler.RegisterRoutes(mux)
+
+	for _, endpoint := range []string{"/api/translations.list", "/api/translations.upsert", "/api/translations.delete",
+		"/api/translations.import", "/api/translations.export"} {
+		_, pattern := mux.Handler(&http.Request{URL: &url.URL{Path: endpoint}})
+		assert.Equal(t, endpoint, pattern)
+	}
+}
+
+func TestTranslationHandler_HandleList(t *testing.T) {
+	t.Run("returns the strings", func(t *testing.T) {
+		mockService, handler := setupTranslationHandlerTest(t)
+		mockService.EXPECT().ListTranslations(gomock.Any(), &domain.ListTranslationsRequest{WorkspaceID: "ws1", Language: "fr"}).
+			Return(&domain.ListTranslationsResponse{Translations: []*domain.Translation{}, DefaultLanguage: "en"}, nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleList(rr, httptest.NewRequest(http.MethodGet, "/api/translations.list?workspace_id=ws1&language=fr", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"translations":[],"default_language":"en"}`, rr.Body.String())
+	})
+
+	t.Run("rejects unknown languages", func(t *testing.T) {
+		_, handler := setupTranslationHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleList(rr, httptest.NewRequest(http.MethodGet, "/api/translations.list?workspace_id=ws1&language=xx", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		_, handler := setupTranslationHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleList(rr, httptest.NewRequest(http.MethodPost, "/api/translations.list", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
+
+func TestTranslationHandler_HandleUpsert(t *testing.T) {
+	t.Run("stores the strings", func(t *testing.T) {
+		mockService, handler := setupTranslationHandlerTest(t)
+		mockService.EXPECT().UpsertTranslations(gomock.Any(), &domain.UpsertTranslationsRequest{
+			WorkspaceID: "ws1", Language: "fr", Translations: map[string]string{"welcome": "Bienvenue"},
+		}).Return(&domain.UpsertTranslationsResponse{Language: "fr", Count: 1}, nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleUpsert(rr, httptest.NewRequest(http.MethodPost, "/api/translations.upsert",
+			strings.NewReader(`{"workspace_id":"ws1","language":"fr","translations":{"welcome":"Bienvenue"}}`)))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"language":"fr","count":1}`, rr.Body.String())
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		mockService, handler := setupTranslationHandlerTest(t)
+		mockService.EXPECT().UpsertTranslations(gomock.Any(), gomock.Any()).Return(nil, domain.NewValidationError("translations is required"))
+
+		rr := httptest.NewRecorder()
+		handler.handleUpsert(rr, httptest.NewRequest(http.MethodPost, "/api/translations.upsert", strings.NewReader(`{"workspace_id":"ws1"}`)))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		_, handler := setupTranslationHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleUpsert(rr, httptest.NewRequest(http.MethodPost, "/api/translations.upsert", strings.NewReader(`{`)))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
+
+func TestTranslationHandler_HandleDelete(t *testing.T) {
+	t.Run("deletes a key", func(t *testing.T) {
+		mockService, handler := setupTranslationHandlerTest(t)
+		mockService.EXPECT().DeleteTranslation(gomock.Any(), &domain.DeleteTranslationRequest{WorkspaceID: "ws1", Key: "welcome"}).Return(nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleDelete(rr, httptest.NewRequest(http.MethodPost, "/api/translations.delete", strings.NewReader(`{"workspace_id":"ws1","key":"welcome"}`)))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		mockService, handler := setupTranslationHandlerTest(t)
+		mockService.EXPECT().DeleteTranslation(gomock.Any(), gomock.Any()).Return(domain.ErrTranslationNotFound)
+
+		rr := httptest.NewRecorder()
+		handler.handleDelete(rr, httptest.NewRequest(http.MethodPost, "/api/translations.delete", strings.NewReader(`{"workspace_id":"ws1","key":"missing"}`)))
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+func TestTranslationHandler_HandleImport(t *testing.T) {
+	t.Run("imports a file", func(t *testing.T) {
+		mockService, handler := setupTranslationHandlerTest(t)
+		mockService.EXPECT().ImportTranslations(gomock.Any(), &domain.ImportTranslationsRequest{
+			WorkspaceID: "ws1", Format: domain.TranslationFormatJSON, Language: "de", Content: `{"welcome":"Willkommen"}`,
+		}).Return(&domain.UpsertTranslationsResponse{Language: "de", Count: 1}, nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleImport(rr, httptest.NewRequest(http.MethodPost, "/api/translations.import",
+			strings.NewReader(`{"workspace_id":"ws1","language":"de","format":"json","content":"{\"welcome\":\"Willkommen\"}"}`)))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"language":"de","count":1}`, rr.Body.String())
+	})
+
+	t.Run("permission error", func(t *testing.T) {
+		mockService, handler := setupTranslationHandlerTest(t)
+		mockService.EXPECT().ImportTranslations(gomock.Any(), gomock.Any()).
+			Return(nil, domain.NewPermissionError(domain.PermissionResourceTemplates, domain.PermissionTypeWrite, "Insufficient permissions"))
+
+		rr := httptest.NewRecorder()
+		handler.handleImport(rr, httptest.NewRequest(http.MethodPost, "/api/translations.import", strings.NewReader(`{"workspace_id":"ws1"}`)))
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+	})
+}
+
+func TestTranslationHandler_HandleExport(t *testing.T) {
+	t.Run("downloads the file", func(t *testing.T) {
+		mockService, handler := setupTranslationHandlerTest(t)
+		mockService.EXPECT().ExportTranslations(gomock.Any(), &domain.ExportTranslationsRequest{WorkspaceID: "ws1", Language: "fr", Format: domain.TranslationFormatXLIFF}).
+			Return(&domain.TranslationExport{Filename: "translations-fr.xlf", ContentType: "application/x-xliff+xml", Content: []byte("<xliff/>")}, nil)
+
+		rr := httptest.NewRecorder()
+		handler.handleExport(rr, httptest.NewRequest(http.MethodGet, "/api/translations.export?workspace_id=ws1&language=fr&format=xliff", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/x-xliff+xml", rr.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="translations-fr.xlf"`, rr.Header().Get("Content-Disposition"))
+		assert.Equal(t, "<xliff/>", rr.Body.String())
+	})
+
+	t.Run("requires a language", func(t *testing.T) {
+		_, handler := setupTranslationHandlerTest(t)
+
+		rr := httptest.NewRecorder()
+		handler.handleExport(rr, httptest.NewRequest(http.MethodGet, "/api/translations.export?workspace_id=ws1", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		mockService, handler := setupTranslationHandlerTest(t)
+		mockService.EXPECT().ExportTranslations(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		rr := httptest.NewRecorder()
+		handler.handleExport(rr, httptest.NewRequest(http.MethodGet, "/api/translations.export?workspace_id=ws1&language=fr", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
@@ -2,7 +2,11 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/pkg/logger"
 )
 
 // WriteJSONError writes a JSON error response with the given message and status code.
@@ -23,3 +27,38 @@ func writeJSON(w http.ResponseWriter, status int, v interface{}) {
 	w.WriteHeader(status)
 	_ = json.NewEncoder(w).Encode(v)
 }
+
+// writeServiceError maps a service error to an HTTP status: validation errors give 400,
+// permission errors 403 and not found errors 404. Any other error is logged and answered
+// with a 500 carrying the given message.
+func writeServiceError(w http.ResponseWriter, log logger.Logger, err error, message string) {
+	var validationErr domain.ValidationError
+	if errors.As(err, &validationErr) {
+		WriteJSONError(w, validationErr.Message, http.StatusBadRequest)
+		return
+	}
+	var permissionErr *domain.PermissionError
+	if errors.As(err, &permissionErr) {
+		WriteJSONError(w, permissionErr.Message, http.StatusForbidden)
+		return
+	}
+	if isNotFoundError(err) {
+		WriteJSONError(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	log.WithField("error", err.Error()).Error(message)
+	WriteJSONError(w, message, http.StatusInternalServerError)
+}
+
+// isNotFoundError tells whether a service error reports a missing resource
+func isNotFoundError(err error) bool {
+	var segmentNotFound *domain.ErrSegmentNotFound
+	var listNotFound *domain.ErrListNotFound
+	return errors.Is(err, domain.ErrSuppressionNotFound) ||
+		errors.Is(err, domain.ErrEmailVerificationNotFound) ||
+		errors.Is(err, domain.ErrTagNotFound) ||
+		errors.Is(err, domain.ErrBulkOperationNotFound) ||
+		errors.Is(err, domain.ErrTranslationNotFound) ||
+		errors.As(err, &segmentNotFound) ||
+		errors.As(err, &listNotFound)
+}
@@ -0,0 +1,100 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+func TestV33Migration_GetMajorVersion(t *testing.T) {
+	m := &V33Migration{}
+	assert.Equal(t, 33.0, m.GetMajorVersion())
+}
+
+func TestV33Migration_HasSystemUpdate(t *testing.T) {
+	m := &V33Migration{}
+	assert.False(t, m.HasSystemUpdate())
+}
+
+func TestV33Migration_HasWorkspaceUpdate(t *testing.T) {
+	m := &V33Migration{}
+	assert.True(t, m.HasWorkspaceUpdate())
+}
+
+func TestV33Migration_ShouldRestartServer(t *testing.T) {
+	m := &V33Migration{}
+	assert.False(t, m.ShouldRestartServer())
+}
+
+func TestV33Migration_UpdateSystem_NoOp(t *testing.T) {
+	m := &V33Migration{}
+	assert.NoError(t, m.UpdateSystem(context.Background(), &config.Config{}, nil))
+}
+
+func TestV33Migration_UpdateWorkspace_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`ALTER TABLE contacts ADD COLUMN IF NOT EXISTS attributes JSONB`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE INDEX IF NOT EXISTS idx_contacts_attributes`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE OR REPLACE FUNCTION track_contact_changes\(\)(.|\n)*OLD\.attributes IS DISTINCT FROM NEW\.attributes`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE OR REPLACE FUNCTION webhook_contacts_trigger\(\)(.|\n)*NEW\.attributes IS NOT DISTINCT FROM OLD\.attributes`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	m := &V33Migration{}
+	err = m.UpdateWorkspace(context.Background(), &config.Config{},
+		&domain.Workspace{ID: "ws_test"}, db)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestV33Migration_UpdateWorkspace_Errors(t *testing.T) {
+	steps := []struct {
+		name    string
+		pattern string
+		errMsg  string
+	}{
+		{"add column", `ALTER TABLE contacts`, "failed to add attributes column"},
+		{"create index", `CREATE INDEX IF NOT EXISTS idx_contacts_attributes`, "failed to create attributes index"},
+		{"track_contact_changes", `CREATE OR REPLACE FUNCTION track_contact_changes`, "failed to update track_contact_changes"},
+		{"webhook_contacts_trigger", `CREATE OR REPLACE FUNCTION webhook_contacts_trigger`, "failed to update webhook_contacts_trigger"},
+	}
+
+	for failAt, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			for i := 0; i < failAt; i++ {
+				mock.ExpectExec(steps[i].pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+			}
+			mock.ExpectExec(step.pattern).WillReturnError(assert.AnError)
+
+			m := &V33Migration{}
+			err = m.UpdateWorkspace(context.Background(), &config.Config{},
+				&domain.Workspace{ID: "ws_test"}, db)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), step.errMsg)
+		})
+	}
+}
+
+func TestV33Migration_Registered(t *testing.T) {
+	for _, m := range GetRegisteredMigrations() {
+		if m.GetMajorVersion() == 33.0 {
+			return
+		}
+	}
+	t.Fatal("V33Migration not registered")
+}
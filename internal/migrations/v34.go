@@ -0,0 +1,99 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+// V34Migration adds contact email aliases so a contact can change its email
+// without losing provider events sent to the previous address.
+//
+// This migration adds:
+//   - Workspace: contact_email_aliases table mapping a former address to the
+//     contact's current email
+//   - Workspace: track_inbound_webhook_event_changes resolves the recipient
+//     through the aliases so bounces and complaints on an old address land in
+//     the contact's timeline
+type V34Migration struct{}
+
+func (m *V34Migration) GetMajorVersion() float64 {
+	return 34.0
+}
+
+func (m *V34Migration) HasSystemUpdate() bool {
+	return false
+}
+
+func (m *V34Migration) HasWorkspaceUpdate() bool {
+	return true
+}
+
+func (m *V34Migration) ShouldRestartServer() bool {
+	return false
+}
+
+func (m *V34Migration) UpdateSystem(ctx context.Context, cfg *config.Config, db DBExecutor) error {
+	return nil
+}
+
+func (m *V34Migration) UpdateWorkspace(ctx context.Context, cfg *config.Config, workspace *domain.Workspace, db DBExecutor) error {
+	_, err := db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS contact_email_aliases (
+			alias VARCHAR(255) PRIMARY KEY,
+			email VARCHAR(255) NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create contact_email_aliases table: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE INDEX IF NOT EXISTS idx_contact_email_aliases_email ON contact_email_aliases(email)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create contact_email_aliases index: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE OR REPLACE FUNCTION track_inbound_webhook_event_changes()
+		RETURNS TRIGGER AS $$
+		DECLARE
+			changes_json JSONB := '{}'::jsonb;
+			entity_id_value VARCHAR(255);
+			contact_email VARCHAR(255);
+		BEGIN
+			-- Use message_id if available, otherwise use inbound webhook event id
+			entity_id_value := COALESCE(NEW.message_id, NEW.id::text);
+
+			-- Events for a former address belong to the contact that now owns it,
+			-- unless a contact has since been created with that address
+			SELECT a.email INTO contact_email FROM contact_email_aliases a
+			WHERE a.alias = NEW.recipient_email
+			AND NOT EXISTS (SELECT 1 FROM contacts c WHERE c.email = a.alias);
+			contact_email := COALESCE(contact_email, NEW.recipient_email);
+
+			changes_json := jsonb_build_object('type', jsonb_build_object('new', NEW.type), 'source', jsonb_build_object('new', NEW.source));
+			IF NEW.bounce_type IS NOT NULL AND NEW.bounce_type != '' THEN changes_json := changes_json || jsonb_build_object('bounce_type', jsonb_build_object('new', NEW.bounce_type)); END IF;
+			IF NEW.bounce_category IS NOT NULL AND NEW.bounce_category != '' THEN changes_json := changes_json || jsonb_build_object('bounce_category', jsonb_build_object('new', NEW.bounce_category)); END IF;
+			IF NEW.bounce_diagnostic IS NOT NULL AND NEW.bounce_diagnostic != '' THEN changes_json := changes_json || jsonb_build_object('bounce_diagnostic', jsonb_build_object('new', NEW.bounce_diagnostic)); END IF;
+			IF NEW.complaint_feedback_type IS NOT NULL AND NEW.complaint_feedback_type != '' THEN changes_json := changes_json || jsonb_build_object('complaint_feedback_type', jsonb_build_object('new', NEW.complaint_feedback_type)); END IF;
+			INSERT INTO contact_timeline (email, operation, entity_type, kind, entity_id, changes, created_at)
+			VALUES (contact_email, 'insert', 'inbound_webhook_event', 'insert_inbound_webhook_event', entity_id_value, changes_json, CURRENT_TIMESTAMP);
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql;
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to update track_inbound_webhook_event_changes function: %w", err)
+	}
+
+	return nil
+}
+
+func init() {
+	Register(&V34Migration{})
+}
@@ -0,0 +1,97 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+func TestV34Migration_GetMajorVersion(t *testing.T) {
+	m := &V34Migration{}
+	assert.Equal(t, 34.0, m.GetMajorVersion())
+}
+
+func TestV34Migration_HasSystemUpdate(t *testing.T) {
+	m := &V34Migration{}
+	assert.False(t, m.HasSystemUpdate())
+}
+
+func TestV34Migration_HasWorkspaceUpdate(t *testing.T) {
+	m := &V34Migration{}
+	assert.True(t, m.HasWorkspaceUpdate())
+}
+
+func TestV34Migration_ShouldRestartServer(t *testing.T) {
+	m := &V34Migration{}
+	assert.False(t, m.ShouldRestartServer())
+}
+
+func TestV34Migration_UpdateSystem_NoOp(t *testing.T) {
+	m := &V34Migration{}
+	assert.NoError(t, m.UpdateSystem(context.Background(), &config.Config{}, nil))
+}
+
+func TestV34Migration_UpdateWorkspace_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`CREATE TABLE IF NOT EXISTS contact_email_aliases`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE INDEX IF NOT EXISTS idx_contact_email_aliases_email`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE OR REPLACE FUNCTION track_inbound_webhook_event_changes\(\)(.|\n)*FROM contact_email_aliases a\s+WHERE a\.alias = NEW\.recipient_email`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	m := &V34Migration{}
+	err = m.UpdateWorkspace(context.Background(), &config.Config{},
+		&domain.Workspace{ID: "ws_test"}, db)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestV34Migration_UpdateWorkspace_Errors(t *testing.T) {
+	steps := []struct {
+		name    string
+		pattern string
+		errMsg  string
+	}{
+		{"create table", `CREATE TABLE IF NOT EXISTS contact_email_aliases`, "failed to create contact_email_aliases table"},
+		{"create index", `CREATE INDEX IF NOT EXISTS idx_contact_email_aliases_email`, "failed to create contact_email_aliases index"},
+		{"track_inbound_webhook_event_changes", `CREATE OR REPLACE FUNCTION track_inbound_webhook_event_changes`, "failed to update track_inbound_webhook_event_changes"},
+	}
+
+	for failAt, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			for i := 0; i < failAt; i++ {
+				mock.ExpectExec(steps[i].pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+			}
+			mock.ExpectExec(step.pattern).WillReturnError(assert.AnError)
+
+			m := &V34Migration{}
+			err = m.UpdateWorkspace(context.Background(), &config.Config{},
+				&domain.Workspace{ID: "ws_test"}, db)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), step.errMsg)
+		})
+	}
+}
+
+func TestV34Migration_Registered(t *testing.T) {
+	for _, m := range GetRegisteredMigrations() {
+		if m.GetMajorVersion() == 34.0 {
+			return
+		}
+	}
+	t.Fatal("V34Migration not registered")
+}
@@ -0,0 +1,83 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+// V35Migration adds GDPR erasure tombstones.
+//
+// This migration adds:
+//   - Workspace: erased_contacts table holding the SHA-256 hash of every
+//     hard-erased email, never the address itself
+//   - Workspace: prevent_erased_contact_insert trigger refusing to recreate a
+//     contact whose email hash is in erased_contacts, whichever path inserts it
+type V35Migration struct{}
+
+func (m *V35Migration) GetMajorVersion() float64 {
+	return 35.0
+}
+
+func (m *V35Migration) HasSystemUpdate() bool {
+	return false
+}
+
+func (m *V35Migration) HasWorkspaceUpdate() bool {
+	return true
+}
+
+func (m *V35Migration) ShouldRestartServer() bool {
+	return false
+}
+
+func (m *V35Migration) UpdateSystem(ctx context.Context, cfg *config.Config, db DBExecutor) error {
+	return nil
+}
+
+func (m *V35Migration) UpdateWorkspace(ctx context.Context, cfg *config.Config, workspace *domain.Workspace, db DBExecutor) error {
+	_, err := db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS erased_contacts (
+			email_hash VARCHAR(64) PRIMARY KEY,
+			erased_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create erased_contacts table: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE OR REPLACE FUNCTION prevent_erased_contact_insert()
+		RETURNS TRIGGER AS $$
+		BEGIN
+			IF EXISTS (
+				SELECT 1 FROM erased_contacts
+				WHERE email_hash = encode(sha256(convert_to(NEW.email, 'UTF8')), 'hex')
+			) THEN
+				RAISE EXCEPTION 'contact was erased and cannot be recreated' USING ERRCODE = 'NTF01';
+			END IF;
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql;
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create prevent_erased_contact_insert function: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		DROP TRIGGER IF EXISTS prevent_erased_contact_insert ON contacts;
+		CREATE TRIGGER prevent_erased_contact_insert BEFORE INSERT ON contacts
+		FOR EACH ROW EXECUTE FUNCTION prevent_erased_contact_insert();
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create prevent_erased_contact_insert trigger: %w", err)
+	}
+
+	return nil
+}
+
+func init() {
+	Register(&V35Migration{})
+}
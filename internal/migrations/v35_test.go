@@ -0,0 +1,97 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+func TestV35Migration_GetMajorVersion(t *testing.T) {
+	m := &V35Migration{}
+	assert.Equal(t, 35.0, m.GetMajorVersion())
+}
+
+func TestV35Migration_HasSystemUpdate(t *testing.T) {
+	m := &V35Migration{}
+	assert.False(t, m.HasSystemUpdate())
+}
+
+func TestV35Migration_HasWorkspaceUpdate(t *testing.T) {
+	m := &V35Migration{}
+	assert.True(t, m.HasWorkspaceUpdate())
+}
+
+func TestV35Migration_ShouldRestartServer(t *testing.T) {
+	m := &V35Migration{}
+	assert.False(t, m.ShouldRestartServer())
+}
+
+func TestV35Migration_UpdateSystem_NoOp(t *testing.T) {
+	m := &V35Migration{}
+	assert.NoError(t, m.UpdateSystem(context.Background(), &config.Config{}, nil))
+}
+
+func TestV35Migration_UpdateWorkspace_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`CREATE TABLE IF NOT EXISTS erased_contacts`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE OR REPLACE FUNCTION prevent_erased_contact_insert\(\)(.|\n)*encode\(sha256\(convert_to\(NEW\.email, 'UTF8'\)\), 'hex'\)`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE TRIGGER prevent_erased_contact_insert BEFORE INSERT ON contacts`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	m := &V35Migration{}
+	err = m.UpdateWorkspace(context.Background(), &config.Config{},
+		&domain.Workspace{ID: "ws_test"}, db)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestV35Migration_UpdateWorkspace_Errors(t *testing.T) {
+	steps := []struct {
+		name    string
+		pattern string
+		errMsg  string
+	}{
+		{"create table", `CREATE TABLE IF NOT EXISTS erased_contacts`, "failed to create erased_contacts table"},
+		{"create function", `CREATE OR REPLACE FUNCTION prevent_erased_contact_insert`, "failed to create prevent_erased_contact_insert function"},
+		{"create trigger", `CREATE TRIGGER prevent_erased_contact_insert`, "failed to create prevent_erased_contact_insert trigger"},
+	}
+
+	for failAt, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			for i := 0; i < failAt; i++ {
+				mock.ExpectExec(steps[i].pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+			}
+			mock.ExpectExec(step.pattern).WillReturnError(assert.AnError)
+
+			m := &V35Migration{}
+			err = m.UpdateWorkspace(context.Background(), &config.Config{},
+				&domain.Workspace{ID: "ws_test"}, db)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), step.errMsg)
+		})
+	}
+}
+
+func TestV35Migration_Registered(t *testing.T) {
+	for _, m := range GetRegisteredMigrations() {
+		if m.GetMajorVersion() == 35.0 {
+			return
+		}
+	}
+	t.Fatal("V35Migration not registered")
+}
@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+// V36Migration adds the workspace suppression list.
+//
+// This migration adds:
+//   - Workspace: suppressions table holding email, domain and wildcard
+//     patterns blocked from every send, with an optional expiry
+type V36Migration struct{}
+
+func (m *V36Migration) GetMajorVersion() float64 {
+	return 36.0
+}
+
+func (m *V36Migration) HasSystemUpdate() bool {
+	return false
+}
+
+func (m *V36Migration) HasWorkspaceUpdate() bool {
+	return true
+}
+
+func (m *V36Migration) ShouldRestartServer() bool {
+	return false
+}
+
+func (m *V36Migration) UpdateSystem(ctx context.Context, cfg *config.Config, db DBExecutor) error {
+	return nil
+}
+
+func (m *V36Migration) UpdateWorkspace(ctx context.Context, cfg *config.Config, workspace *domain.Workspace, db DBExecutor) error {
+	_, err := db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS suppressions (
+			pattern VARCHAR(255) PRIMARY KEY,
+			kind VARCHAR(20) NOT NULL,
+			reason VARCHAR(20) NOT NULL,
+			source VARCHAR(50) NOT NULL,
+			details TEXT,
+			expires_at TIMESTAMP WITH TIME ZONE,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create suppressions table: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE INDEX IF NOT EXISTS idx_suppressions_kind ON suppressions(kind)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create suppressions kind index: %w", err)
+	}
+
+	return nil
+}
+
+func init() {
+	Register(&V36Migration{})
+}
@@ -0,0 +1,94 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+func TestV36Migration_GetMajorVersion(t *testing.T) {
+	m := &V36Migration{}
+	assert.Equal(t, 36.0, m.GetMajorVersion())
+}
+
+func TestV36Migration_HasSystemUpdate(t *testing.T) {
+	m := &V36Migration{}
+	assert.False(t, m.HasSystemUpdate())
+}
+
+func TestV36Migration_HasWorkspaceUpdate(t *testing.T) {
+	m := &V36Migration{}
+	assert.True(t, m.HasWorkspaceUpdate())
+}
+
+func TestV36Migration_ShouldRestartServer(t *testing.T) {
+	m := &V36Migration{}
+	assert.False(t, m.ShouldRestartServer())
+}
+
+func TestV36Migration_UpdateSystem_NoOp(t *testing.T) {
+	m := &V36Migration{}
+	assert.NoError(t, m.UpdateSystem(context.Background(), &config.Config{}, nil))
+}
+
+func TestV36Migration_UpdateWorkspace_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`CREATE TABLE IF NOT EXISTS suppressions(.|\n)*expires_at TIMESTAMP WITH TIME ZONE`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`CREATE INDEX IF NOT EXISTS idx_suppressions_kind ON suppressions\(kind\)`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	m := &V36Migration{}
+	err = m.UpdateWorkspace(context.Background(), &config.Config{},
+		&domain.Workspace{ID: "ws_test"}, db)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestV36Migration_UpdateWorkspace_Errors(t *testing.T) {
+	steps := []struct {
+		name    string
+		pattern string
+		errMsg  string
+	}{
+		{"create table", `CREATE TABLE IF NOT EXISTS suppressions`, "failed to create suppressions table"},
+		{"create index", `CREATE INDEX IF NOT EXISTS idx_suppressions_kind`, "failed to create suppressions kind index"},
+	}
+
+	for failAt, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			for i := 0; i < failAt; i++ {
+				mock.ExpectExec(steps[i].pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+			}
+			mock.ExpectExec(step.pattern).WillReturnError(assert.AnError)
+
+			m := &V36Migration{}
+			err = m.UpdateWorkspace(context.Background(), &config.Config{},
+				&domain.Workspace{ID: "ws_test"}, db)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), step.errMsg)
+		})
+	}
+}
+
+func TestV36Migration_Registered(t *testing.T) {
+	for _, m := range GetRegisteredMigrations() {
+		if m.GetMajorVersion() == 36.0 {
+			return
+		}
+	}
+	t.Fatal("V36Migration not registered")
+}
@@ -0,0 +1,131 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+// V37Migration adds email address verification on ingest.
+//
+// This migration adds:
+//   - System: the permanent process_email_verification_queue task for every workspace
+//   - Workspace: contact_email_verifications table storing the last verification result
+//   - Workspace: email_verification_queue table filled by a trigger on contacts
+type V37Migration struct{}
+
+func (m *V37Migration) GetMajorVersion() float64 {
+	return 37.0
+}
+
+func (m *V37Migration) HasSystemUpdate() bool {
+	return true
+}
+
+func (m *V37Migration) HasWorkspaceUpdate() bool {
+	return true
+}
+
+func (m *V37Migration) ShouldRestartServer() bool {
+	return false
+}
+
+func (m *V37Migration) UpdateSystem(ctx context.Context, cfg *config.Config, db DBExecutor) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO tasks (id, workspace_id, type, status, next_run_after, max_runtime, max_retries, retry_interval, progress, state, created_at, updated_at)
+		SELECT
+			gen_random_uuid(),
+			w.id,
+			'process_email_verification_queue',
+			'pending',
+			CURRENT_TIMESTAMP,
+			50,
+			3,
+			60,
+			0,
+			'{"message": "Email verification queue processing task"}'::jsonb,
+			CURRENT_TIMESTAMP,
+			CURRENT_TIMESTAMP
+		FROM workspaces w
+		WHERE NOT EXISTS (
+			SELECT 1 FROM tasks t WHERE t.workspace_id = w.id AND t.type = 'process_email_verification_queue'
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create email verification tasks: %w", err)
+	}
+
+	return nil
+}
+
+func (m *V37Migration) UpdateWorkspace(ctx context.Context, cfg *config.Config, workspace *domain.Workspace, db DBExecutor) error {
+	_, err := db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS contact_email_verifications (
+			email VARCHAR(255) PRIMARY KEY,
+			status VARCHAR(20) NOT NULL,
+			reason VARCHAR(50),
+			suggestion VARCHAR(255),
+			checks JSONB NOT NULL DEFAULT '{}'::jsonb,
+			verified_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create contact_email_verifications table: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS email_verification_queue (
+			email VARCHAR(255) PRIMARY KEY,
+			queued_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create email_verification_queue table: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE INDEX IF NOT EXISTS idx_email_verification_queue_queued_at ON email_verification_queue(queued_at ASC)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create email_verification_queue index: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE OR REPLACE FUNCTION queue_email_verification()
+		RETURNS TRIGGER AS $$
+		BEGIN
+			IF TG_OP = 'UPDATE' AND OLD.email = NEW.email THEN
+				RETURN NEW;
+			END IF;
+			INSERT INTO email_verification_queue (email, queued_at)
+			VALUES (NEW.email, CURRENT_TIMESTAMP)
+			ON CONFLICT (email) DO UPDATE SET queued_at = EXCLUDED.queued_at;
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create queue_email_verification function: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `DROP TRIGGER IF EXISTS contact_email_verification_trigger ON contacts`)
+	if err != nil {
+		return fmt.Errorf("failed to drop contact_email_verification_trigger: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE TRIGGER contact_email_verification_trigger AFTER INSERT OR UPDATE OF email ON contacts
+		FOR EACH ROW EXECUTE FUNCTION queue_email_verification()
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create contact_email_verification_trigger: %w", err)
+	}
+
+	return nil
+}
+
+func init() {
+	Register(&V37Migration{})
+}
@@ -0,0 +1,120 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+func TestV37Migration_GetMajorVersion(t *testing.T) {
+	m := &V37Migration{}
+	assert.Equal(t, 37.0, m.GetMajorVersion())
+}
+
+func TestV37Migration_HasSystemUpdate(t *testing.T) {
+	m := &V37Migration{}
+	assert.True(t, m.HasSystemUpdate())
+}
+
+func TestV37Migration_HasWorkspaceUpdate(t *testing.T) {
+	m := &V37Migration{}
+	assert.True(t, m.HasWorkspaceUpdate())
+}
+
+func TestV37Migration_ShouldRestartServer(t *testing.T) {
+	m := &V37Migration{}
+	assert.False(t, m.ShouldRestartServer())
+}
+
+func TestV37Migration_UpdateSystem(t *testing.T) {
+	t.Run("creates missing tasks", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(`INSERT INTO tasks(.|\n)*'process_email_verification_queue'(.|\n)*WHERE NOT EXISTS`).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		m := &V37Migration{}
+		assert.NoError(t, m.UpdateSystem(context.Background(), &config.Config{}, db))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec(`INSERT INTO tasks`).WillReturnError(assert.AnError)
+
+		m := &V37Migration{}
+		err = m.UpdateSystem(context.Background(), &config.Config{}, db)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to create email verification tasks")
+	})
+}
+
+var v37WorkspaceSteps = []struct {
+	name    string
+	pattern string
+	errMsg  string
+}{
+	{"create verifications table", `CREATE TABLE IF NOT EXISTS contact_email_verifications`, "failed to create contact_email_verifications table"},
+	{"create queue table", `CREATE TABLE IF NOT EXISTS email_verification_queue`, "failed to create email_verification_queue table"},
+	{"create queue index", `CREATE INDEX IF NOT EXISTS idx_email_verification_queue_queued_at`, "failed to create email_verification_queue index"},
+	{"create function", `CREATE OR REPLACE FUNCTION queue_email_verification\(\)`, "failed to create queue_email_verification function"},
+	{"drop trigger", `DROP TRIGGER IF EXISTS contact_email_verification_trigger ON contacts`, "failed to drop contact_email_verification_trigger"},
+	{"create trigger", `CREATE TRIGGER contact_email_verification_trigger AFTER INSERT OR UPDATE OF email ON contacts`, "failed to create contact_email_verification_trigger"},
+}
+
+func TestV37Migration_UpdateWorkspace_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	for _, step := range v37WorkspaceSteps {
+		mock.ExpectExec(step.pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	m := &V37Migration{}
+	err = m.UpdateWorkspace(context.Background(), &config.Config{},
+		&domain.Workspace{ID: "ws_test"}, db)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestV37Migration_UpdateWorkspace_Errors(t *testing.T) {
+	for failAt, step := range v37WorkspaceSteps {
+		t.Run(step.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			for i := 0; i < failAt; i++ {
+				mock.ExpectExec(v37WorkspaceSteps[i].pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+			}
+			mock.ExpectExec(step.pattern).WillReturnError(assert.AnError)
+
+			m := &V37Migration{}
+			err = m.UpdateWorkspace(context.Background(), &config.Config{},
+				&domain.Workspace{ID: "ws_test"}, db)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), step.errMsg)
+		})
+	}
+}
+
+func TestV37Migration_Registered(t *testing.T) {
+	for _, m := range GetRegisteredMigrations() {
+		if m.GetMajorVersion() == 37.0 {
+			return
+		}
+	}
+	t.Fatal("V37Migration not registered")
+}
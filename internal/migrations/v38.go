@@ -0,0 +1,97 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+// V38Migration adds contact tags.
+//
+// This migration adds:
+//   - Workspace: contact_tags table (many-to-many between contacts and free-form tags)
+//   - Workspace: trigger writing contact.tagged / contact.untagged timeline events
+type V38Migration struct{}
+
+func (m *V38Migration) GetMajorVersion() float64 {
+	return 38.0
+}
+
+func (m *V38Migration) HasSystemUpdate() bool {
+	return false
+}
+
+func (m *V38Migration) HasWorkspaceUpdate() bool {
+	return true
+}
+
+func (m *V38Migration) ShouldRestartServer() bool {
+	return false
+}
+
+func (m *V38Migration) UpdateSystem(ctx context.Context, cfg *config.Config, db DBExecutor) error {
+	return nil
+}
+
+func (m *V38Migration) UpdateWorkspace(ctx context.Context, cfg *config.Config, workspace *domain.Workspace, db DBExecutor) error {
+	_, err := db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS contact_tags (
+			email VARCHAR(255) NOT NULL,
+			tag VARCHAR(100) NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (email, tag)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create contact_tags table: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE INDEX IF NOT EXISTS idx_contact_tags_tag ON contact_tags(tag)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create contact_tags index: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE OR REPLACE FUNCTION track_contact_tag_changes()
+		RETURNS TRIGGER AS $$
+		BEGIN
+			IF TG_OP = 'DELETE' THEN
+				INSERT INTO contact_timeline (email, operation, entity_type, kind, entity_id, changes, created_at)
+				VALUES (OLD.email, 'delete', 'contact_tag', 'contact.untagged', OLD.tag,
+					jsonb_build_object('tag', jsonb_build_object('old', OLD.tag)), CURRENT_TIMESTAMP);
+				RETURN OLD;
+			END IF;
+			INSERT INTO contact_timeline (email, operation, entity_type, kind, entity_id, changes, created_at)
+			VALUES (NEW.email, 'insert', 'contact_tag', 'contact.tagged', NEW.tag,
+				jsonb_build_object('tag', jsonb_build_object('new', NEW.tag)), CURRENT_TIMESTAMP);
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create track_contact_tag_changes function: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `DROP TRIGGER IF EXISTS contact_tag_changes_trigger ON contact_tags`)
+	if err != nil {
+		return fmt.Errorf("failed to drop contact_tag_changes_trigger: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE TRIGGER contact_tag_changes_trigger AFTER INSERT OR DELETE ON contact_tags
+		FOR EACH ROW EXECUTE FUNCTION track_contact_tag_changes()
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create contact_tag_changes_trigger: %w", err)
+	}
+
+	return nil
+}
+
+func init() {
+	Register(&V38Migration{})
+}
@@ -0,0 +1,96 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+func TestV38Migration_GetMajorVersion(t *testing.T) {
+	m := &V38Migration{}
+	assert.Equal(t, 38.0, m.GetMajorVersion())
+}
+
+func TestV38Migration_HasSystemUpdate(t *testing.T) {
+	m := &V38Migration{}
+	assert.False(t, m.HasSystemUpdate())
+}
+
+func TestV38Migration_HasWorkspaceUpdate(t *testing.T) {
+	m := &V38Migration{}
+	assert.True(t, m.HasWorkspaceUpdate())
+}
+
+func TestV38Migration_ShouldRestartServer(t *testing.T) {
+	m := &V38Migration{}
+	assert.False(t, m.ShouldRestartServer())
+}
+
+func TestV38Migration_UpdateSystem(t *testing.T) {
+	m := &V38Migration{}
+	assert.NoError(t, m.UpdateSystem(context.Background(), &config.Config{}, nil))
+}
+
+var v38WorkspaceSteps = []struct {
+	name    string
+	pattern string
+	errMsg  string
+}{
+	{"create tags table", `CREATE TABLE IF NOT EXISTS contact_tags`, "failed to create contact_tags table"},
+	{"create tag index", `CREATE INDEX IF NOT EXISTS idx_contact_tags_tag`, "failed to create contact_tags index"},
+	{"create function", `CREATE OR REPLACE FUNCTION track_contact_tag_changes\(\)(.|\n)*'contact.untagged'(.|\n)*'contact.tagged'`, "failed to create track_contact_tag_changes function"},
+	{"drop trigger", `DROP TRIGGER IF EXISTS contact_tag_changes_trigger ON contact_tags`, "failed to drop contact_tag_changes_trigger"},
+	{"create trigger", `CREATE TRIGGER contact_tag_changes_trigger AFTER INSERT OR DELETE ON contact_tags`, "failed to create contact_tag_changes_trigger"},
+}
+
+func TestV38Migration_UpdateWorkspace_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	for _, step := range v38WorkspaceSteps {
+		mock.ExpectExec(step.pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	m := &V38Migration{}
+	err = m.UpdateWorkspace(context.Background(), &config.Config{},
+		&domain.Workspace{ID: "ws_test"}, db)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestV38Migration_UpdateWorkspace_Errors(t *testing.T) {
+	for failAt, step := range v38WorkspaceSteps {
+		t.Run(step.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			for i := 0; i < failAt; i++ {
+				mock.ExpectExec(v38WorkspaceSteps[i].pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+			}
+			mock.ExpectExec(step.pattern).WillReturnError(assert.AnError)
+
+			m := &V38Migration{}
+			err = m.UpdateWorkspace(context.Background(), &config.Config{},
+				&domain.Workspace{ID: "ws_test"}, db)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), step.errMsg)
+		})
+	}
+}
+
+func TestV38Migration_Registered(t *testing.T) {
+	for _, m := range GetRegisteredMigrations() {
+		if m.GetMajorVersion() == 38.0 {
+			return
+		}
+	}
+	t.Fatal("V38Migration not registered")
+}
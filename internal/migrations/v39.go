@@ -0,0 +1,72 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+// V39Migration adds contact bulk operations.
+//
+// This migration adds:
+//   - Workspace: contact_bulk_operations table, the audit record of bulk operations
+type V39Migration struct{}
+
+func (m *V39Migration) GetMajorVersion() float64 {
+	return 39.0
+}
+
+func (m *V39Migration) HasSystemUpdate() bool {
+	return false
+}
+
+func (m *V39Migration) HasWorkspaceUpdate() bool {
+	return true
+}
+
+func (m *V39Migration) ShouldRestartServer() bool {
+	return false
+}
+
+func (m *V39Migration) UpdateSystem(ctx context.Context, cfg *config.Config, db DBExecutor) error {
+	return nil
+}
+
+func (m *V39Migration) UpdateWorkspace(ctx context.Context, cfg *config.Config, workspace *domain.Workspace, db DBExecutor) error {
+	_, err := db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS contact_bulk_operations (
+			id VARCHAR(36) PRIMARY KEY,
+			operation VARCHAR(32) NOT NULL,
+			target JSONB NOT NULL,
+			params JSONB NOT NULL DEFAULT '{}'::jsonb,
+			status VARCHAR(20) NOT NULL,
+			total_count BIGINT NOT NULL DEFAULT 0,
+			processed_count BIGINT NOT NULL DEFAULT 0,
+			affected_count BIGINT NOT NULL DEFAULT 0,
+			task_id VARCHAR(36) NOT NULL,
+			error TEXT,
+			created_by VARCHAR(255) NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			completed_at TIMESTAMP WITH TIME ZONE
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create contact_bulk_operations table: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE INDEX IF NOT EXISTS idx_contact_bulk_operations_created_at ON contact_bulk_operations(created_at DESC)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create contact_bulk_operations index: %w", err)
+	}
+
+	return nil
+}
+
+func init() {
+	Register(&V39Migration{})
+}
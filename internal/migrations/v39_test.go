@@ -0,0 +1,93 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+func TestV39Migration_GetMajorVersion(t *testing.T) {
+	m := &V39Migration{}
+	assert.Equal(t, 39.0, m.GetMajorVersion())
+}
+
+func TestV39Migration_HasSystemUpdate(t *testing.T) {
+	m := &V39Migration{}
+	assert.False(t, m.HasSystemUpdate())
+}
+
+func TestV39Migration_HasWorkspaceUpdate(t *testing.T) {
+	m := &V39Migration{}
+	assert.True(t, m.HasWorkspaceUpdate())
+}
+
+func TestV39Migration_ShouldRestartServer(t *testing.T) {
+	m := &V39Migration{}
+	assert.False(t, m.ShouldRestartServer())
+}
+
+func TestV39Migration_UpdateSystem(t *testing.T) {
+	m := &V39Migration{}
+	assert.NoError(t, m.UpdateSystem(context.Background(), &config.Config{}, nil))
+}
+
+var v39WorkspaceSteps = []struct {
+	name    string
+	pattern string
+	errMsg  string
+}{
+	{"create operations table", `CREATE TABLE IF NOT EXISTS contact_bulk_operations`, "failed to create contact_bulk_operations table"},
+	{"create created_at index", `CREATE INDEX IF NOT EXISTS idx_contact_bulk_operations_created_at`, "failed to create contact_bulk_operations index"},
+}
+
+func TestV39Migration_UpdateWorkspace_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	for _, step := range v39WorkspaceSteps {
+		mock.ExpectExec(step.pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	m := &V39Migration{}
+	err = m.UpdateWorkspace(context.Background(), &config.Config{},
+		&domain.Workspace{ID: "ws_test"}, db)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestV39Migration_UpdateWorkspace_Errors(t *testing.T) {
+	for failAt, step := range v39WorkspaceSteps {
+		t.Run(step.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			for i := 0; i < failAt; i++ {
+				mock.ExpectExec(v39WorkspaceSteps[i].pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+			}
+			mock.ExpectExec(step.pattern).WillReturnError(assert.AnError)
+
+			m := &V39Migration{}
+			err = m.UpdateWorkspace(context.Background(), &config.Config{},
+				&domain.Workspace{ID: "ws_test"}, db)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), step.errMsg)
+		})
+	}
+}
+
+func TestV39Migration_Registered(t *testing.T) {
+	for _, m := range GetRegisteredMigrations() {
+		if m.GetMajorVersion() == 39.0 {
+			return
+		}
+	}
+	t.Fatal("V39Migration not registered")
+}
@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+// V40Migration adds recurring broadcasts.
+//
+// This migration adds:
+//   - Workspace: broadcasts.parent_broadcast_id, linking each instance to the recurring broadcast it was spawned from
+type V40Migration struct{}
+
+func (m *V40Migration) GetMajorVersion() float64 {
+	return 40.0
+}
+
+func (m *V40Migration) HasSystemUpdate() bool {
+	return false
+}
+
+func (m *V40Migration) HasWorkspaceUpdate() bool {
+	return true
+}
+
+func (m *V40Migration) ShouldRestartServer() bool {
+	return false
+}
+
+func (m *V40Migration) UpdateSystem(ctx context.Context, cfg *config.Config, db DBExecutor) error {
+	return nil
+}
+
+func (m *V40Migration) UpdateWorkspace(ctx context.Context, cfg *config.Config, workspace *domain.Workspace, db DBExecutor) error {
+	_, err := db.ExecContext(ctx, `
+		ALTER TABLE broadcasts ADD COLUMN IF NOT EXISTS parent_broadcast_id VARCHAR(255)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to add parent_broadcast_id column: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE INDEX IF NOT EXISTS idx_broadcasts_parent_broadcast_id ON broadcasts(parent_broadcast_id, created_at DESC) WHERE parent_broadcast_id IS NOT NULL
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create parent_broadcast_id index: %w", err)
+	}
+
+	return nil
+}
+
+func init() {
+	Register(&V40Migration{})
+}
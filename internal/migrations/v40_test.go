@@ -0,0 +1,93 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+func TestV40Migration_GetMajorVersion(t *testing.T) {
+	m := &V40Migration{}
+	assert.Equal(t, 40.0, m.GetMajorVersion())
+}
+
+func TestV40Migration_HasSystemUpdate(t *testing.T) {
+	m := &V40Migration{}
+	assert.False(t, m.HasSystemUpdate())
+}
+
+func TestV40Migration_HasWorkspaceUpdate(t *testing.T) {
+	m := &V40Migration{}
+	assert.True(t, m.HasWorkspaceUpdate())
+}
+
+func TestV40Migration_ShouldRestartServer(t *testing.T) {
+	m := &V40Migration{}
+	assert.False(t, m.ShouldRestartServer())
+}
+
+func TestV40Migration_UpdateSystem(t *testing.T) {
+	m := &V40Migration{}
+	assert.NoError(t, m.UpdateSystem(context.Background(), &config.Config{}, nil))
+}
+
+var v40WorkspaceSteps = []struct {
+	name    string
+	pattern string
+	errMsg  string
+}{
+	{"add parent column", `ALTER TABLE broadcasts ADD COLUMN IF NOT EXISTS parent_broadcast_id`, "failed to add parent_broadcast_id column"},
+	{"create parent index", `CREATE INDEX IF NOT EXISTS idx_broadcasts_parent_broadcast_id`, "failed to create parent_broadcast_id index"},
+}
+
+func TestV40Migration_UpdateWorkspace_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	for _, step := range v40WorkspaceSteps {
+		mock.ExpectExec(step.pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	m := &V40Migration{}
+	err = m.UpdateWorkspace(context.Background(), &config.Config{},
+		&domain.Workspace{ID: "ws_test"}, db)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestV40Migration_UpdateWorkspace_Errors(t *testing.T) {
+	for failAt, step := range v40WorkspaceSteps {
+		t.Run(step.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			for i := 0; i < failAt; i++ {
+				mock.ExpectExec(v40WorkspaceSteps[i].pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+			}
+			mock.ExpectExec(step.pattern).WillReturnError(assert.AnError)
+
+			m := &V40Migration{}
+			err = m.UpdateWorkspace(context.Background(), &config.Config{},
+				&domain.Workspace{ID: "ws_test"}, db)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), step.errMsg)
+		})
+	}
+}
+
+func TestV40Migration_Registered(t *testing.T) {
+	for _, m := range GetRegisteredMigrations() {
+		if m.GetMajorVersion() == 40.0 {
+			return
+		}
+	}
+	t.Fatal("V40Migration not registered")
+}
@@ -0,0 +1,63 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+// V41Migration adds holdout control groups.
+//
+// This migration adds:
+//   - Workspace: automations.holdout, the share of enrolled contacts held out of an automation
+//   - Workspace: broadcast_holdout_contacts, the contacts held out of each broadcast
+type V41Migration struct{}
+
+func (m *V41Migration) GetMajorVersion() float64 {
+	return 41.0
+}
+
+func (m *V41Migration) HasSystemUpdate() bool {
+	return false
+}
+
+func (m *V41Migration) HasWorkspaceUpdate() bool {
+	return true
+}
+
+func (m *V41Migration) ShouldRestartServer() bool {
+	return false
+}
+
+func (m *V41Migration) UpdateSystem(ctx context.Context, cfg *config.Config, db DBExecutor) error {
+	return nil
+}
+
+func (m *V41Migration) UpdateWorkspace(ctx context.Context, cfg *config.Config, workspace *domain.Workspace, db DBExecutor) error {
+	_, err := db.ExecContext(ctx, `
+		ALTER TABLE automations ADD COLUMN IF NOT EXISTS holdout JSONB
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to add holdout column: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS broadcast_holdout_contacts (
+			broadcast_id VARCHAR(255) NOT NULL,
+			email VARCHAR(255) NOT NULL,
+			assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (broadcast_id, email)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create broadcast_holdout_contacts table: %w", err)
+	}
+
+	return nil
+}
+
+func init() {
+	Register(&V41Migration{})
+}
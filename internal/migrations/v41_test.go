@@ -0,0 +1,93 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+func TestV41Migration_GetMajorVersion(t *testing.T) {
+	m := &V41Migration{}
+	assert.Equal(t, 41.0, m.GetMajorVersion())
+}
+
+func TestV41Migration_HasSystemUpdate(t *testing.T) {
+	m := &V41Migration{}
+	assert.False(t, m.HasSystemUpdate())
+}
+
+func TestV41Migration_HasWorkspaceUpdate(t *testing.T) {
+	m := &V41Migration{}
+	assert.True(t, m.HasWorkspaceUpdate())
+}
+
+func TestV41Migration_ShouldRestartServer(t *testing.T) {
+	m := &V41Migration{}
+	assert.False(t, m.ShouldRestartServer())
+}
+
+func TestV41Migration_UpdateSystem(t *testing.T) {
+	m := &V41Migration{}
+	assert.NoError(t, m.UpdateSystem(context.Background(), &config.Config{}, nil))
+}
+
+var v41WorkspaceSteps = []struct {
+	name    string
+	pattern string
+	errMsg  string
+}{
+	{"add holdout column", `ALTER TABLE automations ADD COLUMN IF NOT EXISTS holdout JSONB`, "failed to add holdout column"},
+	{"create holdout contacts table", `CREATE TABLE IF NOT EXISTS broadcast_holdout_contacts`, "failed to create broadcast_holdout_contacts table"},
+}
+
+func TestV41Migration_UpdateWorkspace_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	for _, step := range v41WorkspaceSteps {
+		mock.ExpectExec(step.pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	m := &V41Migration{}
+	err = m.UpdateWorkspace(context.Background(), &config.Config{},
+		&domain.Workspace{ID: "ws_test"}, db)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestV41Migration_UpdateWorkspace_Errors(t *testing.T) {
+	for failAt, step := range v41WorkspaceSteps {
+		t.Run(step.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			for i := 0; i < failAt; i++ {
+				mock.ExpectExec(v41WorkspaceSteps[i].pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+			}
+			mock.ExpectExec(step.pattern).WillReturnError(assert.AnError)
+
+			m := &V41Migration{}
+			err = m.UpdateWorkspace(context.Background(), &config.Config{},
+				&domain.Workspace{ID: "ws_test"}, db)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), step.errMsg)
+		})
+	}
+}
+
+func TestV41Migration_Registered(t *testing.T) {
+	for _, m := range GetRegisteredMigrations() {
+		if m.GetMajorVersion() == 41.0 {
+			return
+		}
+	}
+	t.Fatal("V41Migration not registered")
+}
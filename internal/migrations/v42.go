@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+// V42Migration adds the broadcast approval workflow.
+//
+// This migration adds:
+//   - Workspace: broadcasts.approval, the review of a broadcast submitted for approval
+type V42Migration struct{}
+
+func (m *V42Migration) GetMajorVersion() float64 {
+	return 42.0
+}
+
+func (m *V42Migration) HasSystemUpdate() bool {
+	return false
+}
+
+func (m *V42Migration) HasWorkspaceUpdate() bool {
+	return true
+}
+
+func (m *V42Migration) ShouldRestartServer() bool {
+	return false
+}
+
+func (m *V42Migration) UpdateSystem(ctx context.Context, cfg *config.Config, db DBExecutor) error {
+	return nil
+}
+
+func (m *V42Migration) UpdateWorkspace(ctx context.Context, cfg *config.Config, workspace *domain.Workspace, db DBExecutor) error {
+	_, err := db.ExecContext(ctx, `
+		ALTER TABLE broadcasts ADD COLUMN IF NOT EXISTS approval JSONB
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to add approval column: %w", err)
+	}
+
+	return nil
+}
+
+func init() {
+	Register(&V42Migration{})
+}
@@ -0,0 +1,92 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+func TestV42Migration_GetMajorVersion(t *testing.T) {
+	m := &V42Migration{}
+	assert.Equal(t, 42.0, m.GetMajorVersion())
+}
+
+func TestV42Migration_HasSystemUpdate(t *testing.T) {
+	m := &V42Migration{}
+	assert.False(t, m.HasSystemUpdate())
+}
+
+func TestV42Migration_HasWorkspaceUpdate(t *testing.T) {
+	m := &V42Migration{}
+	assert.True(t, m.HasWorkspaceUpdate())
+}
+
+func TestV42Migration_ShouldRestartServer(t *testing.T) {
+	m := &V42Migration{}
+	assert.False(t, m.ShouldRestartServer())
+}
+
+func TestV42Migration_UpdateSystem(t *testing.T) {
+	m := &V42Migration{}
+	assert.NoError(t, m.UpdateSystem(context.Background(), &config.Config{}, nil))
+}
+
+var v42WorkspaceSteps = []struct {
+	name    string
+	pattern string
+	errMsg  string
+}{
+	{"add approval column", `ALTER TABLE broadcasts ADD COLUMN IF NOT EXISTS approval JSONB`, "failed to add approval column"},
+}
+
+func TestV42Migration_UpdateWorkspace_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	for _, step := range v42WorkspaceSteps {
+		mock.ExpectExec(step.pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	m := &V42Migration{}
+	err = m.UpdateWorkspace(context.Background(), &config.Config{},
+		&domain.Workspace{ID: "ws_test"}, db)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestV42Migration_UpdateWorkspace_Errors(t *testing.T) {
+	for failAt, step := range v42WorkspaceSteps {
+		t.Run(step.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			for i := 0; i < failAt; i++ {
+				mock.ExpectExec(v42WorkspaceSteps[i].pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+			}
+			mock.ExpectExec(step.pattern).WillReturnError(assert.AnError)
+
+			m := &V42Migration{}
+			err = m.UpdateWorkspace(context.Background(), &config.Config{},
+				&domain.Workspace{ID: "ws_test"}, db)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), step.errMsg)
+		})
+	}
+}
+
+func TestV42Migration_Registered(t *testing.T) {
+	for _, m := range GetRegisteredMigrations() {
+		if m.GetMajorVersion() == 42.0 {
+			return
+		}
+	}
+	t.Fatal("V42Migration not registered")
+}
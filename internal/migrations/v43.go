@@ -0,0 +1,66 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+// V43Migration adds template draft and published states.
+//
+// This migration adds:
+//   - Workspace: templates.created_by, templates.published_at and templates.published_by
+//   - Workspace: publishes the latest version of existing templates so that sends keep using it
+type V43Migration struct{}
+
+func (m *V43Migration) GetMajorVersion() float64 {
+	return 43.0
+}
+
+func (m *V43Migration) HasSystemUpdate() bool {
+	return false
+}
+
+func (m *V43Migration) HasWorkspaceUpdate() bool {
+	return true
+}
+
+func (m *V43Migration) ShouldRestartServer() bool {
+	return false
+}
+
+func (m *V43Migration) UpdateSystem(ctx context.Context, cfg *config.Config, db DBExecutor) error {
+	return nil
+}
+
+func (m *V43Migration) UpdateWorkspace(ctx context.Context, cfg *config.Config, workspace *domain.Workspace, db DBExecutor) error {
+	_, err := db.ExecContext(ctx, `
+		ALTER TABLE templates
+		ADD COLUMN IF NOT EXISTS created_by VARCHAR(255),
+		ADD COLUMN IF NOT EXISTS published_at TIMESTAMP WITH TIME ZONE,
+		ADD COLUMN IF NOT EXISTS published_by VARCHAR(255)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to add template publishing columns: %w", err)
+	}
+
+	// Templates without any published version were live at their latest version
+	_, err = db.ExecContext(ctx, `
+		UPDATE templates t
+		SET published_at = t.updated_at
+		FROM (SELECT id, MAX(version) AS max_version FROM templates GROUP BY id) lv
+		WHERE t.id = lv.id AND t.version = lv.max_version
+		AND NOT EXISTS (SELECT 1 FROM templates p WHERE p.id = t.id AND p.published_at IS NOT NULL)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to publish latest template versions: %w", err)
+	}
+
+	return nil
+}
+
+func init() {
+	Register(&V43Migration{})
+}
@@ -0,0 +1,93 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+func TestV43Migration_GetMajorVersion(t *testing.T) {
+	m := &V43Migration{}
+	assert.Equal(t, 43.0, m.GetMajorVersion())
+}
+
+func TestV43Migration_HasSystemUpdate(t *testing.T) {
+	m := &V43Migration{}
+	assert.False(t, m.HasSystemUpdate())
+}
+
+func TestV43Migration_HasWorkspaceUpdate(t *testing.T) {
+	m := &V43Migration{}
+	assert.True(t, m.HasWorkspaceUpdate())
+}
+
+func TestV43Migration_ShouldRestartServer(t *testing.T) {
+	m := &V43Migration{}
+	assert.False(t, m.ShouldRestartServer())
+}
+
+func TestV43Migration_UpdateSystem(t *testing.T) {
+	m := &V43Migration{}
+	assert.NoError(t, m.UpdateSystem(context.Background(), &config.Config{}, nil))
+}
+
+var v43WorkspaceSteps = []struct {
+	name    string
+	pattern string
+	errMsg  string
+}{
+	{"add publishing columns", `ALTER TABLE templates`, "failed to add template publishing columns"},
+	{"publish latest versions", `UPDATE templates t`, "failed to publish latest template versions"},
+}
+
+func TestV43Migration_UpdateWorkspace_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	for _, step := range v43WorkspaceSteps {
+		mock.ExpectExec(step.pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	m := &V43Migration{}
+	err = m.UpdateWorkspace(context.Background(), &config.Config{},
+		&domain.Workspace{ID: "ws_test"}, db)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestV43Migration_UpdateWorkspace_Errors(t *testing.T) {
+	for failAt, step := range v43WorkspaceSteps {
+		t.Run(step.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			for i := 0; i < failAt; i++ {
+				mock.ExpectExec(v43WorkspaceSteps[i].pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+			}
+			mock.ExpectExec(step.pattern).WillReturnError(assert.AnError)
+
+			m := &V43Migration{}
+			err = m.UpdateWorkspace(context.Background(), &config.Config{},
+				&domain.Workspace{ID: "ws_test"}, db)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), step.errMsg)
+		})
+	}
+}
+
+func TestV43Migration_Registered(t *testing.T) {
+	for _, m := range GetRegisteredMigrations() {
+		if m.GetMajorVersion() == 43.0 {
+			return
+		}
+	}
+	t.Fatal("V43Migration not registered")
+}
@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+// V44Migration adds workspace translation catalogs.
+//
+// This migration adds:
+//   - Workspace: translations table (one string per key and language, read by the t Liquid filter)
+type V44Migration struct{}
+
+func (m *V44Migration) GetMajorVersion() float64 {
+	return 44.0
+}
+
+func (m *V44Migration) HasSystemUpdate() bool {
+	return false
+}
+
+func (m *V44Migration) HasWorkspaceUpdate() bool {
+	return true
+}
+
+func (m *V44Migration) ShouldRestartServer() bool {
+	return false
+}
+
+func (m *V44Migration) UpdateSystem(ctx context.Context, cfg *config.Config, db DBExecutor) error {
+	return nil
+}
+
+func (m *V44Migration) UpdateWorkspace(ctx context.Context, cfg *config.Config, workspace *domain.Workspace, db DBExecutor) error {
+	_, err := db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS translations (
+			key VARCHAR(255) NOT NULL,
+			language VARCHAR(10) NOT NULL,
+			value TEXT NOT NULL,
+			created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (key, language)
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create translations table: %w", err)
+	}
+
+	_, err = db.ExecContext(ctx, `
+		CREATE INDEX IF NOT EXISTS idx_translations_language ON translations(language)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create translations index: %w", err)
+	}
+
+	return nil
+}
+
+func init() {
+	Register(&V44Migration{})
+}
@@ -0,0 +1,93 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/config"
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+func TestV44Migration_GetMajorVersion(t *testing.T) {
+	m := &V44Migration{}
+	assert.Equal(t, 44.0, m.GetMajorVersion())
+}
+
+func TestV44Migration_HasSystemUpdate(t *testing.T) {
+	m := &V44Migration{}
+	assert.False(t, m.HasSystemUpdate())
+}
+
+func TestV44Migration_HasWorkspaceUpdate(t *testing.T) {
+	m := &V44Migration{}
+	assert.True(t, m.HasWorkspaceUpdate())
+}
+
+func TestV44Migration_ShouldRestartServer(t *testing.T) {
+	m := &V44Migration{}
+	assert.False(t, m.ShouldRestartServer())
+}
+
+func TestV44Migration_UpdateSystem(t *testing.T) {
+	m := &V44Migration{}
+	assert.NoError(t, m.UpdateSystem(context.Background(), &config.Config{}, nil))
+}
+
+var v44WorkspaceSteps = []struct {
+	name    string
+	pattern string
+	errMsg  string
+}{
+	{"create translations table", `CREATE TABLE IF NOT EXISTS translations`, "failed to create translations table"},
+	{"create translations index", `CREATE INDEX IF NOT EXISTS idx_translations_language`, "failed to create translations index"},
+}
+
+func TestV44Migration_UpdateWorkspace_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	for _, step := range v44WorkspaceSteps {
+		mock.ExpectExec(step.pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	m := &V44Migration{}
+	err = m.UpdateWorkspace(context.Background(), &config.Config{},
+		&domain.Workspace{ID: "ws_test"}, db)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestV44Migration_UpdateWorkspace_Errors(t *testing.T) {
+	for failAt, step := range v44WorkspaceSteps {
+		t.Run(step.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			for i := 0; i < failAt; i++ {
+				mock.ExpectExec(v44WorkspaceSteps[i].pattern).WillReturnResult(sqlmock.NewResult(0, 0))
+			}
+			mock.ExpectExec(step.pattern).WillReturnError(assert.AnError)
+
+			m := &V44Migration{}
+			err = m.UpdateWorkspace(context.Background(), &config.Config{},
+				&domain.Workspace{ID: "ws_test"}, db)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), step.errMsg)
+		})
+	}
+}
+
+func TestV44Migration_Registered(t *testing.T) {
+	for _, m := range GetRegisteredMigrations() {
+		if m.GetMajorVersion() == 44.0 {
+			return
+		}
+	}
+	t.Fatal("V44Migration not registered")
+}
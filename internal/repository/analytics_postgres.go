@@ -31,6 +31,10 @@ func (r *analyticsRepository) Query(ctx context.Context, workspaceID string, que
 		return nil, fmt.Errorf("unknown schema: %s", query.Schema)
 	}
 
+	if query.Schema == "contacts" {
+		schema = r.withContactAttributes(ctx, workspaceID, schema)
+	}
+
 	// Get workspace database connection
 	db, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
 	if err != nil {
@@ -57,5 +61,35 @@ func (r *analyticsRepository) GetSchemas(ctx context.Context, workspaceID string
 		schemas[name] = schema
 	}
 
+	if contacts, ok := schemas["contacts"]; ok {
+		schemas["contacts"] = r.withContactAttributes(ctx, workspaceID, contacts)
+	}
+
 	return schemas, nil
 }
+
+// withContactAttributes returns a copy of the contacts schema extended with one
+// dimension per typed contact attribute defined in the workspace settings.
+// Failing to load the workspace only hides the attribute dimensions.
+func (r *analyticsRepository) withContactAttributes(ctx context.Context, workspaceID string, schema analytics.SchemaDefinition) analytics.SchemaDefinition {
+	workspace, err := r.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		r.logger.WithField("workspace_id", workspaceID).WithField("error", err.Error()).Warn("Failed to load contact attributes for analytics schema")
+		return schema
+	}
+
+	attributeDimensions := workspace.Settings.ContactAttributes.AnalyticsDimensions()
+	if len(attributeDimensions) == 0 {
+		return schema
+	}
+
+	dimensions := make(map[string]analytics.DimensionDefinition, len(schema.Dimensions)+len(attributeDimensions))
+	for name, dimension := range schema.Dimensions {
+		dimensions[name] = dimension
+	}
+	for name, dimension := range attributeDimensions {
+		dimensions[name] = dimension
+	}
+	schema.Dimensions = dimensions
+	return schema
+}
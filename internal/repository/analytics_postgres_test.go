@@ -157,3 +157,55 @@ func TestAnalyticsRepository_GetSchemas(t *testing.T) {
 	assert.Contains(t, schemas, "schema1")
 	assert.Contains(t, schemas, "schema2")
 }
+
+func TestAnalyticsRepository_GetSchemas_ContactAttributes(t *testing.T) {
+	originalSchemas := domain.PredefinedSchemas
+	domain.PredefinedSchemas = map[string]analytics.SchemaDefinition{
+		"contacts": {
+			Name: "contacts",
+			Dimensions: map[string]analytics.DimensionDefinition{
+				"country": {Type: "string", SQL: "country"},
+			},
+		},
+	}
+	defer func() { domain.PredefinedSchemas = originalSchemas }()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWorkspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	repo := NewAnalyticsRepository(mockWorkspaceRepo, logger.NewLogger())
+
+	t.Run("adds one dimension per scalar attribute", func(t *testing.T) {
+		mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "workspace-123").Return(&domain.Workspace{
+			ID: "workspace-123",
+			Settings: domain.WorkspaceSettings{
+				ContactAttributes: domain.ContactAttributeDefinitions{
+					{Name: "plan", Type: domain.ContactAttributeTypeString, Label: "Plan"},
+					{Name: "seats", Type: domain.ContactAttributeTypeNumber, Label: "Seats"},
+					{Name: "prefs", Type: domain.ContactAttributeTypeJSON, Label: "Preferences"},
+				},
+			},
+		}, nil)
+
+		schemas, err := repo.GetSchemas(context.Background(), "workspace-123")
+		require.NoError(t, err)
+
+		dimensions := schemas["contacts"].Dimensions
+		assert.Contains(t, dimensions, "country")
+		assert.Equal(t, "(attributes->>'plan')", dimensions["attribute_plan"].SQL)
+		assert.Equal(t, "((attributes->>'seats')::numeric)", dimensions["attribute_seats"].SQL)
+		assert.NotContains(t, dimensions, "attribute_prefs")
+
+		// The predefined schema must not be mutated
+		assert.Len(t, domain.PredefinedSchemas["contacts"].Dimensions, 1)
+	})
+
+	t.Run("falls back to predefined dimensions when workspace cannot be loaded", func(t *testing.T) {
+		mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "workspace-123").Return(nil, assert.AnError)
+
+		schemas, err := repo.GetSchemas(context.Background(), "workspace-123")
+		require.NoError(t, err)
+		assert.Len(t, schemas["contacts"].Dimensions, 1)
+	})
+}
@@ -85,6 +85,11 @@ func (r *AutomationRepository) CreateTx(ctx context.Context, tx *sql.Tx, workspa
 		return fmt.Errorf("failed to marshal stats: %w", err)
 	}
 
+	holdoutJSON, err := marshalAutomationHoldout(automation.Holdout)
+	if err != nil {
+		return err
+	}
+
 	now := time.Now().UTC()
 	automation.CreatedAt = now
 	automation.UpdatedAt = now
@@ -93,12 +98,12 @@ func (r *AutomationRepository) CreateTx(ctx context.Context, tx *sql.Tx, workspa
 		Insert("automations").
 		Columns(
 			"id", "workspace_id", "name", "status", "list_id", "trigger_config",
-			"trigger_sql", "root_node_id", "nodes", "stats", "created_at", "updated_at",
+			"trigger_sql", "root_node_id", "nodes", "stats", "holdout", "created_at", "updated_at",
 		).
 		Values(
 			automation.ID, workspaceID, automation.Name, automation.Status,
 			automation.ListID, triggerJSON, automation.TriggerSQL,
-			automation.RootNodeID, nodesJSON, statsJSON, automation.CreatedAt, automation.UpdatedAt,
+			automation.RootNodeID, nodesJSON, statsJSON, holdoutJSON, automation.CreatedAt, automation.UpdatedAt,
 		).
 		ToSql()
 	if err != nil {
@@ -126,6 +131,18 @@ func (r *AutomationRepository) CreateTx(ctx context.Context, tx *sql.Tx, workspa
 	return nil
 }
 
+// marshalAutomationHoldout stores NULL for automations without holdout
+func marshalAutomationHoldout(holdout *domain.HoldoutSettings) (interface{}, error) {
+	if holdout == nil {
+		return nil, nil
+	}
+	holdoutJSON, err := json.Marshal(holdout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal holdout: %w", err)
+	}
+	return holdoutJSON, nil
+}
+
 // GetByID retrieves an automation by ID
 func (r *AutomationRepository) GetByID(ctx context.Context, workspaceID, id string) (*domain.Automation, error) {
 	return r.GetByIDTx(ctx, nil, workspaceID, id)
@@ -137,7 +154,7 @@ func (r *AutomationRepository) GetByIDTx(ctx context.Context, tx *sql.Tx, worksp
 	query, args, err := automationPsql.
 		Select(
 			"id", "workspace_id", "name", "status", "list_id", "trigger_config",
-			"trigger_sql", "root_node_id", "nodes", "stats", "created_at", "updated_at", "deleted_at",
+			"trigger_sql", "root_node_id", "nodes", "stats", "holdout", "created_at", "updated_at", "deleted_at",
 		).
 		From("automations").
 		Where(sq.Eq{"id": id, "workspace_id": workspaceID, "deleted_at": nil}).
@@ -160,13 +177,13 @@ func (r *AutomationRepository) GetByIDTx(ctx context.Context, tx *sql.Tx, worksp
 	}
 
 	var automation domain.Automation
-	var triggerJSON, nodesJSON, statsJSON []byte
+	var triggerJSON, nodesJSON, statsJSON, holdoutJSON []byte
 	var deletedAt sql.NullTime
 
 	err = queryer.QueryRowContext(ctx, query, args...).Scan(
 		&automation.ID, &automation.WorkspaceID, &automation.Name, &automation.Status,
 		&automation.ListID, &triggerJSON, &automation.TriggerSQL, &automation.RootNodeID,
-		&nodesJSON, &statsJSON, &automation.CreatedAt, &automation.UpdatedAt, &deletedAt,
+		&nodesJSON, &statsJSON, &holdoutJSON, &automation.CreatedAt, &automation.UpdatedAt, &deletedAt,
 	)
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("automation not found: %s", id)
@@ -188,6 +205,11 @@ func (r *AutomationRepository) GetByIDTx(ctx context.Context, tx *sql.Tx, worksp
 			return nil, fmt.Errorf("failed to unmarshal stats: %w", err)
 		}
 	}
+	if len(holdoutJSON) > 0 {
+		if err := json.Unmarshal(holdoutJSON, &automation.Holdout); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal holdout: %w", err)
+		}
+	}
 
 	if deletedAt.Valid {
 		automation.DeletedAt = &deletedAt.Time
@@ -242,7 +264,7 @@ func (r *AutomationRepository) List(ctx context.Context, workspaceID string, fil
 	dataQuery := automationPsql.
 		Select(
 			"id", "workspace_id", "name", "status", "list_id", "trigger_config",
-			"trigger_sql", "root_node_id", "nodes", "stats", "created_at", "updated_at", "deleted_at",
+			"trigger_sql", "root_node_id", "nodes", "stats", "holdout", "created_at", "updated_at", "deleted_at",
 		).
 		From("automations").
 		Where(whereClause).
@@ -269,13 +291,13 @@ func (r *AutomationRepository) List(ctx context.Context, workspaceID string, fil
 	var automations []*domain.Automation
 	for rows.Next() {
 		var automation domain.Automation
-		var triggerJSON, nodesJSON, statsJSON []byte
+		var triggerJSON, nodesJSON, statsJSON, holdoutJSON []byte
 		var deletedAt sql.NullTime
 
 		err := rows.Scan(
 			&automation.ID, &automation.WorkspaceID, &automation.Name, &automation.Status,
 			&automation.ListID, &triggerJSON, &automation.TriggerSQL, &automation.RootNodeID,
-			&nodesJSON, &statsJSON, &automation.CreatedAt, &automation.UpdatedAt, &deletedAt,
+			&nodesJSON, &statsJSON, &holdoutJSON, &automation.CreatedAt, &automation.UpdatedAt, &deletedAt,
 		)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan automation row: %w", err)
@@ -294,6 +316,11 @@ func (r *AutomationRepository) List(ctx context.Context, workspaceID string, fil
 				return nil, 0, fmt.Errorf("failed to unmarshal stats: %w", err)
 			}
 		}
+		if len(holdoutJSON) > 0 {
+			if err := json.Unmarshal(holdoutJSON, &automation.Holdout); err != nil {
+				return nil, 0, fmt.Errorf("failed to unmarshal holdout: %w", err)
+			}
+		}
 
 		if deletedAt.Valid {
 			automation.DeletedAt = &deletedAt.Time
@@ -326,6 +353,11 @@ func (r *AutomationRepository) UpdateTx(ctx context.Context, tx *sql.Tx, workspa
 		return fmt.Errorf("failed to marshal nodes: %w", err)
 	}
 
+	holdoutJSON, err := marshalAutomationHoldout(automation.Holdout)
+	if err != nil {
+		return err
+	}
+
 	// NOTE: Stats are NOT updated here - they should only be modified via atomic methods
 	// like IncrementAutomationStat or UpdateAutomationStats to prevent accidental resets
 
@@ -340,6 +372,7 @@ func (r *AutomationRepository) UpdateTx(ctx context.Context, tx *sql.Tx, workspa
 		Set("trigger_sql", automation.TriggerSQL).
 		Set("root_node_id", automation.RootNodeID).
 		Set("nodes", nodesJSON).
+		Set("holdout", holdoutJSON).
 		Set("updated_at", automation.UpdatedAt).
 		Where(sq.Eq{"id": automation.ID, "workspace_id": workspaceID}).
 		ToSql()
@@ -129,6 +129,7 @@ func TestAutomationRepository_Create(t *testing.T) {
 			automation.RootNodeID,
 			sqlmock.AnyArg(), // nodes JSON
 			sqlmock.AnyArg(), // stats JSON
+			sqlmock.AnyArg(), // holdout JSON
 			sqlmock.AnyArg(), // created_at
 			sqlmock.AnyArg(), // updated_at
 		).
@@ -153,6 +154,7 @@ func TestAutomationRepository_Create(t *testing.T) {
 			sqlmock.AnyArg(),
 			sqlmock.AnyArg(),
 			sqlmock.AnyArg(),
+			sqlmock.AnyArg(),
 		).
 		WillReturnError(fmt.Errorf("database error"))
 
@@ -181,10 +183,10 @@ func TestAutomationRepository_GetByID(t *testing.T) {
 	// Test successful retrieval (includes deleted_at IS NULL filter)
 	rows := sqlmock.NewRows([]string{
 		"id", "workspace_id", "name", "status", "list_id", "trigger_config",
-		"trigger_sql", "root_node_id", "nodes", "stats", "created_at", "updated_at", "deleted_at",
+		"trigger_sql", "root_node_id", "nodes", "stats", "holdout", "created_at", "updated_at", "deleted_at",
 	}).AddRow(
 		automationID, workspaceID, "Test Automation", "draft", "list-123",
-		triggerJSON, nil, "node-root", nodesJSON, statsJSON, now, now, nil,
+		triggerJSON, nil, "node-root", nodesJSON, statsJSON, nil, now, now, nil,
 	)
 
 	mock.ExpectQuery("SELECT .* FROM automations WHERE.*deleted_at IS NULL").
@@ -248,13 +250,13 @@ func TestAutomationRepository_List(t *testing.T) {
 	// Test data query (includes deleted_at IS NULL)
 	rows := sqlmock.NewRows([]string{
 		"id", "workspace_id", "name", "status", "list_id", "trigger_config",
-		"trigger_sql", "root_node_id", "nodes", "stats", "created_at", "updated_at", "deleted_at",
+		"trigger_sql", "root_node_id", "nodes", "stats", "holdout", "created_at", "updated_at", "deleted_at",
 	}).AddRow(
 		"auto-1", workspaceID, "Auto 1", "draft", "list-123",
-		triggerJSON, nil, "node-1", nodesJSON, statsJSON, now, now, nil,
+		triggerJSON, nil, "node-1", nodesJSON, statsJSON, nil, now, now, nil,
 	).AddRow(
 		"auto-2", workspaceID, "Auto 2", "live", "list-123",
-		triggerJSON, nil, "node-2", nodesJSON, statsJSON, now, now, nil,
+		triggerJSON, nil, "node-2", nodesJSON, statsJSON, nil, now, now, nil,
 	)
 
 	mock.ExpectQuery("SELECT .* FROM automations WHERE.*deleted_at IS NULL").
@@ -275,7 +277,7 @@ func TestAutomationRepository_List(t *testing.T) {
 	mock.ExpectQuery("SELECT .* FROM automations WHERE.*deleted_at IS NULL").
 		WillReturnRows(sqlmock.NewRows([]string{
 			"id", "workspace_id", "name", "status", "list_id", "trigger_config",
-			"trigger_sql", "root_node_id", "nodes", "stats", "created_at", "updated_at", "deleted_at",
+			"trigger_sql", "root_node_id", "nodes", "stats", "holdout", "created_at", "updated_at", "deleted_at",
 		}))
 
 	automations, count, err = repo.List(ctx, workspaceID, filter)
@@ -317,6 +319,7 @@ func TestAutomationRepository_Update(t *testing.T) {
 			automation.TriggerSQL,
 			automation.RootNodeID,
 			sqlmock.AnyArg(), // nodes JSON
+			sqlmock.AnyArg(), // holdout JSON
 			sqlmock.AnyArg(), // updated_at
 			automation.ID,
 			workspaceID,
@@ -337,6 +340,7 @@ func TestAutomationRepository_Update(t *testing.T) {
 			automation.TriggerSQL,
 			automation.RootNodeID,
 			sqlmock.AnyArg(), // nodes JSON
+			sqlmock.AnyArg(), // holdout JSON
 			sqlmock.AnyArg(), // updated_at
 			automation.ID,
 			workspaceID,
@@ -358,6 +362,7 @@ func TestAutomationRepository_Update(t *testing.T) {
 			automation.TriggerSQL,
 			automation.RootNodeID,
 			sqlmock.AnyArg(), // nodes JSON
+			sqlmock.AnyArg(), // holdout JSON
 			sqlmock.AnyArg(), // updated_at
 			automation.ID,
 			workspaceID,
@@ -959,6 +964,7 @@ func TestAutomationRepository_CreateTx(t *testing.T) {
 			automation.RootNodeID,
 			sqlmock.AnyArg(), // nodes
 			sqlmock.AnyArg(), // stats
+			sqlmock.AnyArg(), // holdout
 			sqlmock.AnyArg(), // created_at
 			sqlmock.AnyArg(), // updated_at
 		).
@@ -995,10 +1001,10 @@ func TestAutomationRepository_GetByIDTx(t *testing.T) {
 	mock.ExpectBegin()
 	rows := sqlmock.NewRows([]string{
 		"id", "workspace_id", "name", "status", "list_id", "trigger_config",
-		"trigger_sql", "root_node_id", "nodes", "stats", "created_at", "updated_at", "deleted_at",
+		"trigger_sql", "root_node_id", "nodes", "stats", "holdout", "created_at", "updated_at", "deleted_at",
 	}).AddRow(
 		automationID, workspaceID, "Test", "draft", "list-123",
-		triggerJSON, nil, "node-root", nodesJSON, statsJSON, now, now, nil,
+		triggerJSON, nil, "node-root", nodesJSON, statsJSON, nil, now, now, nil,
 	)
 	mock.ExpectQuery("SELECT .* FROM automations WHERE.*deleted_at IS NULL").
 		WillReturnRows(rows)
@@ -1040,10 +1046,10 @@ func TestAutomationRepository_GetByID_JSONUnmarshalError(t *testing.T) {
 	// Invalid JSON for trigger_config
 	rows := sqlmock.NewRows([]string{
 		"id", "workspace_id", "name", "status", "list_id", "trigger_config",
-		"trigger_sql", "root_node_id", "nodes", "stats", "created_at", "updated_at", "deleted_at",
+		"trigger_sql", "root_node_id", "nodes", "stats", "holdout", "created_at", "updated_at", "deleted_at",
 	}).AddRow(
 		automationID, workspaceID, "Test", "draft", "list-123",
-		"invalid json", nil, "node-root", "[]", "{}", now, now, nil,
+		"invalid json", nil, "node-root", "[]", "{}", nil, now, now, nil,
 	)
 
 	mock.ExpectQuery("SELECT .* FROM automations WHERE.*deleted_at IS NULL").
@@ -1077,10 +1083,10 @@ func TestAutomationRepository_List_JSONUnmarshalError(t *testing.T) {
 	// Invalid JSON for trigger_config
 	rows := sqlmock.NewRows([]string{
 		"id", "workspace_id", "name", "status", "list_id", "trigger_config",
-		"trigger_sql", "root_node_id", "nodes", "stats", "created_at", "updated_at", "deleted_at",
+		"trigger_sql", "root_node_id", "nodes", "stats", "holdout", "created_at", "updated_at", "deleted_at",
 	}).AddRow(
 		"auto-1", workspaceID, "Auto 1", "draft", "list-123",
-		"invalid json", nil, "node-1", "[]", "{}", now, now, nil,
+		"invalid json", nil, "node-1", "[]", "{}", nil, now, now, nil,
 	)
 
 	mock.ExpectQuery("SELECT .* FROM automations.*deleted_at IS NULL").
@@ -1218,6 +1224,7 @@ func TestAutomationRepository_Update_RowsAffectedError(t *testing.T) {
 			automation.TriggerSQL,
 			automation.RootNodeID,
 			sqlmock.AnyArg(), // nodes
+			sqlmock.AnyArg(), // holdout
 			sqlmock.AnyArg(), // updated_at
 			automation.ID,
 			workspaceID,
@@ -1405,10 +1412,10 @@ func TestAutomationRepository_List_ExcludesDeleted(t *testing.T) {
 	// Data query should include deleted_at IS NULL
 	rows := sqlmock.NewRows([]string{
 		"id", "workspace_id", "name", "status", "list_id", "trigger_config",
-		"trigger_sql", "root_node_id", "nodes", "stats", "created_at", "updated_at", "deleted_at",
+		"trigger_sql", "root_node_id", "nodes", "stats", "holdout", "created_at", "updated_at", "deleted_at",
 	}).AddRow(
 		"auto-1", workspaceID, "Auto 1", "draft", "list-123",
-		triggerJSON, nil, "node-1", nodesJSON, statsJSON, now, now, nil,
+		triggerJSON, nil, "node-1", nodesJSON, statsJSON, nil, now, now, nil,
 	)
 
 	mock.ExpectQuery("SELECT .* FROM automations WHERE.*deleted_at IS NULL").
@@ -1453,13 +1460,13 @@ func TestAutomationRepository_List_IncludeDeleted(t *testing.T) {
 	// Data query should NOT filter by deleted_at
 	rows := sqlmock.NewRows([]string{
 		"id", "workspace_id", "name", "status", "list_id", "trigger_config",
-		"trigger_sql", "root_node_id", "nodes", "stats", "created_at", "updated_at", "deleted_at",
+		"trigger_sql", "root_node_id", "nodes", "stats", "holdout", "created_at", "updated_at", "deleted_at",
 	}).AddRow(
 		"auto-1", workspaceID, "Auto 1", "draft", "list-123",
-		triggerJSON, nil, "node-1", nodesJSON, statsJSON, now, now, nil,
+		triggerJSON, nil, "node-1", nodesJSON, statsJSON, nil, now, now, nil,
 	).AddRow(
 		"auto-2", workspaceID, "Auto 2 (Deleted)", "draft", "list-123",
-		triggerJSON, nil, "node-2", nodesJSON, statsJSON, now, now, deletedAt,
+		triggerJSON, nil, "node-2", nodesJSON, statsJSON, nil, now, now, deletedAt,
 	)
 
 	mock.ExpectQuery("SELECT .* FROM automations WHERE").
@@ -888,6 +888,69 @@ func (r *blogPostRepository) ListFeedPosts(ctx context.Context, categorySlug *st
 	return posts, nil
 }
 
+// ListPostsPublishedBetween returns the newest `limit` posts published in
+// (since, until], optionally limited to a category.
+func (r *blogPostRepository) ListPostsPublishedBetween(ctx context.Context, categoryID string, since, until time.Time, limit int) ([]*domain.BlogPost, error) {
+	workspaceID, ok := ctx.Value(domain.WorkspaceIDKey).(string)
+	if !ok {
+		return nil, fmt.Errorf("workspace_id not found in context")
+	}
+
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	args := []interface{}{since, until}
+	categoryFilter := ""
+	if categoryID != "" {
+		args = append(args, categoryID)
+		categoryFilter = fmt.Sprintf(" AND p.category_id = $%d", len(args))
+	}
+	args = append(args, limit)
+	limitPlaceholder := fmt.Sprintf("$%d", len(args))
+
+	query := fmt.Sprintf(`
+		SELECT p.id, p.category_id, p.slug, p.settings, p.published_at, p.created_at, p.updated_at, p.deleted_at
+		FROM blog_posts p
+		JOIN blog_categories c ON c.id = p.category_id AND c.deleted_at IS NULL
+		WHERE p.deleted_at IS NULL
+		  AND p.published_at > $1
+		  AND p.published_at <= $2
+		  %s
+		ORDER BY p.published_at DESC
+		LIMIT %s
+	`, categoryFilter, limitPlaceholder)
+
+	rows, err := workspaceDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list posts published between: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var posts []*domain.BlogPost
+	for rows.Next() {
+		var post domain.BlogPost
+		if err := rows.Scan(
+			&post.ID,
+			&post.CategoryID,
+			&post.Slug,
+			&post.Settings,
+			&post.PublishedAt,
+			&post.CreatedAt,
+			&post.UpdatedAt,
+			&post.DeletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan blog post: %w", err)
+		}
+		posts = append(posts, &post)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating blog posts: %w", err)
+	}
+	return posts, nil
+}
+
 // GetFeedFingerprint returns (maxUpdatedAt, idsHash) over the same slice of
 // posts ListFeedPosts would return.
 //
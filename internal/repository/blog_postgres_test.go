@@ -1244,6 +1244,36 @@ func TestBlogPostRepository_ListFeedPosts_CategoryFilter(t *testing.T) {
 	assert.NoError(t, sqlMock.ExpectationsWereMet())
 }
 
+func TestBlogPostRepository_ListPostsPublishedBetween(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockWorkspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	repo := NewBlogPostRepository(mockWorkspaceRepo)
+
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.WithValue(context.Background(), domain.WorkspaceIDKey, "ws1")
+	mockWorkspaceRepo.EXPECT().GetConnection(gomock.Any(), "ws1").Return(db, nil)
+
+	since := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{
+		"id", "category_id", "slug", "settings", "published_at", "created_at", "updated_at", "deleted_at",
+	}).AddRow("p1", "c1", "hello", []byte(`{"title":"Hi","template":{"template_id":"tpl","template_version":1}}`), until, since, since, nil)
+
+	sqlMock.ExpectQuery(`(?s)JOIN blog_categories c.*p\.published_at > \$1.*p\.published_at <= \$2.*p\.category_id = \$3.*ORDER BY p\.published_at DESC.*LIMIT \$4`).
+		WithArgs(since, until, "c1", 10).
+		WillReturnRows(rows)
+
+	posts, err := repo.ListPostsPublishedBetween(ctx, "c1", since, until, 10)
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, "p1", posts[0].ID)
+	assert.NoError(t, sqlMock.ExpectationsWereMet())
+}
+
 func TestBlogPostRepository_GetFeedFingerprint(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -88,9 +88,11 @@ func (r *broadcastRepository) CreateBroadcastTx(ctx context.Context, tx *sql.Tx,
 			cancelled_at,
 			paused_at,
 			pause_reason,
-			data_feed
+			data_feed,
+			parent_broadcast_id,
+			approval
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23
 		)
 	`
 
@@ -116,6 +118,8 @@ func (r *broadcastRepository) CreateBroadcastTx(ctx context.Context, tx *sql.Tx,
 		broadcast.PausedAt,
 		broadcast.PauseReason,
 		broadcast.DataFeed,
+		broadcast.ParentBroadcastID,
+		broadcast.Approval,
 	)
 
 	if err != nil {
@@ -155,7 +159,9 @@ func (r *broadcastRepository) GetBroadcast(ctx context.Context, workspaceID, id
 			cancelled_at,
 			paused_at,
 			pause_reason,
-			data_feed
+			data_feed,
+			parent_broadcast_id,
+			approval
 		FROM broadcasts
 		WHERE id = $1 AND workspace_id = $2
 	`
@@ -197,7 +203,9 @@ func (r *broadcastRepository) GetBroadcastTx(ctx context.Context, tx *sql.Tx, wo
 			cancelled_at,
 			paused_at,
 			pause_reason,
-			data_feed
+			data_feed,
+			parent_broadcast_id,
+			approval
 		FROM broadcasts
 		WHERE id = $1 AND workspace_id = $2
 	`
@@ -246,7 +254,8 @@ func (r *broadcastRepository) UpdateBroadcastTx(ctx context.Context, tx *sql.Tx,
 			paused_at = $17,
 			pause_reason = $18,
 			enqueued_count = $19,
-			data_feed = $20
+			data_feed = $20,
+			approval = $21
 		WHERE id = $1 AND workspace_id = $2
 			AND status != 'cancelled'
 			AND status != 'processed'
@@ -273,6 +282,7 @@ func (r *broadcastRepository) UpdateBroadcastTx(ctx context.Context, tx *sql.Tx,
 		broadcast.PauseReason,
 		broadcast.EnqueuedCount,
 		broadcast.DataFeed,
+		broadcast.Approval,
 	)
 
 	if err != nil {
@@ -338,97 +348,67 @@ func (r *broadcastRepository) UpdateBroadcastStatusTx(ctx context.Context, tx *s
 
 // ListBroadcastsTx retrieves a list of broadcasts within a transaction
 func (r *broadcastRepository) ListBroadcastsTx(ctx context.Context, tx *sql.Tx, params domain.ListBroadcastsParams) (*domain.BroadcastListResponse, error) {
-	// First count total records that match the criteria
-	var countQuery string
-	var countArgs []interface{}
+	// Build the filters shared by the count and data queries
+	conditions := "workspace_id = $1"
+	args := []interface{}{params.WorkspaceID}
 
 	if params.Status != "" {
-		countQuery = `
-			SELECT COUNT(*)
-			FROM broadcasts
-			WHERE workspace_id = $1 AND status = $2
-		`
-		countArgs = []interface{}{params.WorkspaceID, params.Status}
-	} else {
-		countQuery = `
-			SELECT COUNT(*)
-			FROM broadcasts
-			WHERE workspace_id = $1
-		`
-		countArgs = []interface{}{params.WorkspaceID}
+		args = append(args, params.Status)
+		conditions += fmt.Sprintf(" AND status = $%d", len(args))
+	}
+	if params.ParentID != "" {
+		args = append(args, params.ParentID)
+		conditions += fmt.Sprintf(" AND parent_broadcast_id = $%d", len(args))
+	}
+	if params.ListID != "" {
+		args = append(args, params.ListID)
+		conditions += fmt.Sprintf(" AND audience->>'list' = $%d", len(args))
 	}
 
+	// First count total records that match the criteria
+	countQuery := `
+		SELECT COUNT(*)
+		FROM broadcasts
+		WHERE ` + conditions
+
 	var totalCount int
-	err := tx.QueryRowContext(ctx, countQuery, countArgs...).Scan(&totalCount)
+	err := tx.QueryRowContext(ctx, countQuery, args...).Scan(&totalCount)
 	if err != nil {
 		return nil, fmt.Errorf("failed to count broadcasts: %w", err)
 	}
 
 	// Then query paginated data
-	var dataQuery string
-	var dataArgs []interface{}
-
-	if params.Status != "" {
-		dataQuery = `
-			SELECT
-				id,
-				workspace_id,
-				name,
-				status,
-				audience,
-				schedule,
-				test_settings,
-				utm_parameters,
-				metadata,
-				winning_template,
-				test_sent_at,
-				winner_sent_at,
-				enqueued_count,
-				created_at,
-				updated_at,
-				started_at,
-				completed_at,
-				cancelled_at,
-				paused_at,
-				pause_reason,
-				data_feed
-			FROM broadcasts
-			WHERE workspace_id = $1 AND status = $2
-			ORDER BY created_at DESC
-			LIMIT $3 OFFSET $4
-		`
-		dataArgs = []interface{}{params.WorkspaceID, params.Status, params.Limit, params.Offset}
-	} else {
-		dataQuery = `
-			SELECT
-				id,
-				workspace_id,
-				name,
-				status,
-				audience,
-				schedule,
-				test_settings,
-				utm_parameters,
-				metadata,
-				winning_template,
-				test_sent_at,
-				winner_sent_at,
-				enqueued_count,
-				created_at,
-				updated_at,
-				started_at,
-				completed_at,
-				cancelled_at,
-				paused_at,
-				pause_reason,
-				data_feed
-			FROM broadcasts
-			WHERE workspace_id = $1
-			ORDER BY created_at DESC
-			LIMIT $2 OFFSET $3
-		`
-		dataArgs = []interface{}{params.WorkspaceID, params.Limit, params.Offset}
-	}
+	dataQuery := fmt.Sprintf(`
+		SELECT
+			id,
+			workspace_id,
+			name,
+			status,
+			audience,
+			schedule,
+			test_settings,
+			utm_parameters,
+			metadata,
+			winning_template,
+			test_sent_at,
+			winner_sent_at,
+			enqueued_count,
+			created_at,
+			updated_at,
+			started_at,
+			completed_at,
+			cancelled_at,
+			paused_at,
+			pause_reason,
+			data_feed,
+			parent_broadcast_id,
+			approval
+		FROM broadcasts
+		WHERE %s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d
+	`, conditions, len(args)+1, len(args)+2)
+	dataArgs := append(args, params.Limit, params.Offset)
 
 	rows, err := tx.QueryContext(ctx, dataQuery, dataArgs...)
 	if err != nil {
@@ -523,6 +503,8 @@ func scanBroadcast(scanner interface {
 	var winningTemplate sql.NullString
 	var pauseReason sql.NullString
 	var dataFeed domain.DataFeedSettings
+	var parentBroadcastID sql.NullString
+	var approval domain.BroadcastApproval
 
 	err := scanner.Scan(
 		&broadcast.ID,
@@ -546,6 +528,8 @@ func scanBroadcast(scanner interface {
 		&broadcast.PausedAt,
 		&pauseReason,
 		&dataFeed,
+		&parentBroadcastID,
+		&approval,
 	)
 
 	if err != nil {
@@ -559,11 +543,20 @@ func scanBroadcast(scanner interface {
 	if pauseReason.Valid {
 		broadcast.PauseReason = &pauseReason.String
 	}
+	if parentBroadcastID.Valid {
+		broadcast.ParentBroadcastID = &parentBroadcastID.String
+	}
 
 	// Set DataFeed pointer if it has any data
-	if dataFeed.GlobalFeed != nil || dataFeed.RecipientFeed != nil || len(dataFeed.GlobalFeedData) > 0 || dataFeed.GlobalFeedFetchedAt != nil {
+	if dataFeed.GlobalFeed != nil || dataFeed.RecipientFeed != nil || len(dataFeed.GlobalFeedData) > 0 || dataFeed.GlobalFeedFetchedAt != nil ||
+		dataFeed.BlogDigest != nil || len(dataFeed.BlogDigestPosts) > 0 {
 		broadcast.DataFeed = &dataFeed
 	}
 
+	// Set Approval pointer if the broadcast was submitted for review
+	if approval.RequestedBy != "" {
+		broadcast.Approval = &approval
+	}
+
 	return broadcast, nil
 }
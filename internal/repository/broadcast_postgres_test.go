@@ -433,6 +433,8 @@ func TestBroadcastRepository_CreateBroadcast_Success(t *testing.T) {
 			sqlmock.AnyArg(), // paused_at
 			sqlmock.AnyArg(), // pause_reason
 			sqlmock.AnyArg(), // data_feed (consolidated)
+			sqlmock.AnyArg(), // parent_broadcast_id
+			sqlmock.AnyArg(), // approval
 		).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -519,6 +521,8 @@ func TestBroadcastRepository_GetBroadcast_Success(t *testing.T) {
 		"created_at", "updated_at",
 		"started_at", "completed_at", "cancelled_at", "paused_at", "pause_reason",
 		"data_feed",
+		"parent_broadcast_id",
+		"approval",
 	}).
 		AddRow(
 			broadcastID, workspaceID, "Test Broadcast", domain.BroadcastStatusDraft,
@@ -528,6 +532,8 @@ func TestBroadcastRepository_GetBroadcast_Success(t *testing.T) {
 			time.Now(), time.Now(),
 			nil, nil, nil, nil, nil,
 			nil, // data_feed
+			nil, // parent_broadcast_id
+			nil, // approval
 		)
 
 	mock.ExpectQuery("SELECT").
@@ -576,6 +582,8 @@ func TestBroadcastRepository_GetBroadcast_NullPauseReason(t *testing.T) {
 		"created_at", "updated_at",
 		"started_at", "completed_at", "cancelled_at", "paused_at", "pause_reason",
 		"data_feed",
+		"parent_broadcast_id",
+		"approval",
 	}).
 		AddRow(
 			broadcastID, workspaceID, "Test Broadcast", domain.BroadcastStatusDraft,
@@ -585,6 +593,8 @@ func TestBroadcastRepository_GetBroadcast_NullPauseReason(t *testing.T) {
 			time.Now(), time.Now(),
 			nil, nil, nil, nil, nil, // NULL pause_reason
 			nil, // data_feed
+			nil, // parent_broadcast_id
+			nil, // approval
 		)
 
 	mock.ExpectQuery("SELECT").
@@ -632,6 +642,8 @@ func TestBroadcastRepository_GetBroadcast_WithPauseReason(t *testing.T) {
 		"created_at", "updated_at",
 		"started_at", "completed_at", "cancelled_at", "paused_at", "pause_reason",
 		"data_feed",
+		"parent_broadcast_id",
+		"approval",
 	}).
 		AddRow(
 			broadcastID, workspaceID, "Test Broadcast", domain.BroadcastStatusPaused,
@@ -641,6 +653,8 @@ func TestBroadcastRepository_GetBroadcast_WithPauseReason(t *testing.T) {
 			time.Now(), time.Now(),
 			nil, nil, nil, time.Now(), expectedReason, // Non-NULL pause_reason
 			nil, // data_feed
+			nil, // parent_broadcast_id
+			nil, // approval
 		)
 
 	mock.ExpectQuery("SELECT").
@@ -656,6 +670,63 @@ func TestBroadcastRepository_GetBroadcast_WithPauseReason(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+// TestBroadcastRepository_GetBroadcast_WithApproval tests that the review of a broadcast
+// pending approval is scanned.
+func TestBroadcastRepository_GetBroadcast_WithApproval(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWorkspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	repo := NewBroadcastRepository(mockWorkspaceRepo)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	workspaceID := "ws123"
+	broadcastID := "bc123"
+
+	mockWorkspaceRepo.EXPECT().
+		GetConnection(gomock.Any(), workspaceID).
+		Return(db, nil)
+
+	rows := sqlmock.NewRows([]string{
+		"id", "workspace_id", "name", "status", "audience", "schedule",
+		"test_settings", "utm_parameters", "metadata",
+		"winning_template",
+		"test_sent_at", "winner_sent_at", "enqueued_count",
+		"created_at", "updated_at",
+		"started_at", "completed_at", "cancelled_at", "paused_at", "pause_reason",
+		"data_feed",
+		"parent_broadcast_id",
+		"approval",
+	}).
+		AddRow(
+			broadcastID, workspaceID, "Test Broadcast", domain.BroadcastStatusPendingApproval,
+			[]byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"),
+			"",
+			nil, nil, 0, // enqueued_count
+			time.Now(), time.Now(),
+			nil, nil, nil, nil, nil,
+			nil, // data_feed
+			nil, // parent_broadcast_id
+			[]byte(`{"requested_by":"user1","recipient_count":12000,"required_approvals":2,"reviews":[{"user_id":"user2","decision":"approved"}]}`),
+		)
+
+	mock.ExpectQuery("SELECT").
+		WithArgs(broadcastID, workspaceID).
+		WillReturnRows(rows)
+
+	broadcast, err := repo.GetBroadcast(ctx, workspaceID, broadcastID)
+	require.NoError(t, err)
+	require.NotNil(t, broadcast.Approval)
+	assert.Equal(t, "user1", broadcast.Approval.RequestedBy)
+	assert.Equal(t, 2, broadcast.Approval.RequiredApprovals)
+	assert.Equal(t, 1, broadcast.Approval.Approvals())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 // TestBroadcastRepository_GetBroadcast_ScanError tests that the repository
 // handles scanning errors correctly when retrieving a broadcast.
 func TestBroadcastRepository_GetBroadcast_ScanError(t *testing.T) {
@@ -792,6 +863,7 @@ func TestBroadcastRepository_UpdateBroadcast_Success(t *testing.T) {
 			sqlmock.AnyArg(), // pause_reason
 			sqlmock.AnyArg(), // enqueued_count
 			sqlmock.AnyArg(), // data_feed (consolidated)
+			sqlmock.AnyArg(), // approval
 		).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
@@ -1068,11 +1140,15 @@ func TestBroadcastRepository_ListBroadcasts_RowsIterationError(t *testing.T) {
 		"created_at", "updated_at",
 		"started_at", "completed_at", "cancelled_at", "paused_at", "pause_reason",
 		"data_feed",
+		"parent_broadcast_id",
+		"approval",
 	}).
 		AddRow(
 			"bc123", workspaceID, "Broadcast 1", "draft", []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"),
 			"", nil, nil, 0, time.Now(), time.Now(), nil, nil, nil, nil, nil,
 			nil, // data_feed
+			nil, // parent_broadcast_id
+			nil, // approval
 		).
 		RowError(0, iterationErr) // Set error on the first row
 
@@ -1186,16 +1262,22 @@ func TestBroadcastRepository_ListBroadcasts_WithStatus(t *testing.T) {
 		"created_at", "updated_at",
 		"started_at", "completed_at", "cancelled_at", "paused_at", "pause_reason",
 		"data_feed",
+		"parent_broadcast_id",
+		"approval",
 	}).
 		AddRow(
 			"bc123", workspaceID, "Broadcast 1", status, []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"),
 			"", nil, nil, 0, time.Now(), time.Now(), nil, nil, nil, nil, nil,
 			nil, // data_feed
+			nil, // parent_broadcast_id
+			nil, // approval
 		).
 		AddRow(
 			"bc456", workspaceID, "Broadcast 2", status, []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"),
 			"", nil, nil, 0, time.Now(), time.Now(), nil, nil, nil, nil, nil,
 			nil, // data_feed
+			nil, // parent_broadcast_id
+			nil, // approval
 		)
 
 	// Expect query with limit/offset
@@ -1224,6 +1306,89 @@ func TestBroadcastRepository_ListBroadcasts_WithStatus(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestBroadcastRepository_ListBroadcasts_WithStatusAndList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWorkspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	repo := NewBroadcastRepository(mockWorkspaceRepo)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	workspaceID := "ws123"
+	status := domain.BroadcastStatusProcessed
+	listID := "newsletter"
+
+	// Setup mock expectations for workspace DB connection
+	mockWorkspaceRepo.EXPECT().
+		GetConnection(gomock.Any(), workspaceID).
+		Return(db, nil)
+
+	// Expect transaction begin
+	mock.ExpectBegin()
+
+	// Expect count query with status and list filters
+	mock.ExpectQuery(`SELECT COUNT\(\*\)\s+FROM broadcasts\s+WHERE workspace_id = \$1 AND status = \$2 AND audience->>'list' = \$3`).
+		WithArgs(workspaceID, status, listID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	// Setup mock rows
+	rows := sqlmock.NewRows([]string{
+		"id", "workspace_id", "name", "status", "audience", "schedule",
+		"test_settings", "utm_parameters", "metadata",
+		"winning_template",
+		"test_sent_at", "winner_sent_at", "enqueued_count",
+		"created_at", "updated_at",
+		"started_at", "completed_at", "cancelled_at", "paused_at", "pause_reason",
+		"data_feed",
+		"parent_broadcast_id",
+		"approval",
+	}).
+		AddRow(
+			"bc123", workspaceID, "Broadcast 1", status, []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"),
+			"", nil, nil, 0, time.Now(), time.Now(), nil, nil, nil, nil, nil,
+			nil, // data_feed
+			nil, // parent_broadcast_id
+			nil, // approval
+		).
+		AddRow(
+			"bc456", workspaceID, "Broadcast 2", status, []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"),
+			"", nil, nil, 0, time.Now(), time.Now(), nil, nil, nil, nil, nil,
+			nil, // data_feed
+			nil, // parent_broadcast_id
+			nil, // approval
+		)
+
+	// Expect query with limit/offset
+	mock.ExpectQuery("SELECT(.+)FROM broadcasts").
+		WithArgs(workspaceID, status, listID, 10, 0).
+		WillReturnRows(rows)
+
+	// Expect commit
+	mock.ExpectCommit()
+
+	// Execute the method
+	result, err := repo.ListBroadcasts(ctx, domain.ListBroadcastsParams{
+		WorkspaceID: workspaceID,
+		Status:      status,
+		ListID:      listID,
+		Limit:       10,
+		Offset:      0,
+	})
+
+	// Assert expectations
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, 2, result.TotalCount)
+	assert.Equal(t, 2, len(result.Broadcasts))
+	assert.Equal(t, "bc123", result.Broadcasts[0].ID)
+	assert.Equal(t, "bc456", result.Broadcasts[1].ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestBroadcastRepository_GetBroadcastTx(t *testing.T) {
 	// Test broadcastRepository.GetBroadcastTx - this was at 0% coverage
 	ctrl := gomock.NewController(t)
@@ -1253,12 +1418,16 @@ func TestBroadcastRepository_GetBroadcastTx(t *testing.T) {
 				"created_at", "updated_at",
 				"started_at", "completed_at", "cancelled_at", "paused_at", "pause_reason",
 				"data_feed",
+				"parent_broadcast_id",
+				"approval",
 			}).
 				AddRow(
 					broadcastID, workspaceID, "Test Broadcast", "draft",
 					[]byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"),
 					"", nil, nil, 0, time.Now(), time.Now(), nil, nil, nil, nil, nil,
 					nil, // data_feed
+					nil, // parent_broadcast_id
+					nil, // approval
 				))
 		sqlMock.ExpectCommit()
 
@@ -1324,12 +1493,16 @@ func TestBroadcastRepository_GetBroadcast_WithDataFeed(t *testing.T) {
 		"created_at", "updated_at",
 		"started_at", "completed_at", "cancelled_at", "paused_at", "pause_reason",
 		"data_feed",
+		"parent_broadcast_id",
+		"approval",
 	}).
 		AddRow(
 			broadcastID, workspaceID, "Test Broadcast", domain.BroadcastStatusDraft,
 			[]byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"),
 			"", nil, nil, 0, time.Now(), time.Now(), nil, nil, nil, nil, nil,
 			dataFeedJSON,
+			nil, // parent_broadcast_id
+			nil, // approval
 		)
 
 	mock.ExpectQuery("SELECT").
@@ -1425,6 +1598,8 @@ func TestBroadcastRepository_CreateBroadcast_WithDataFeed(t *testing.T) {
 			sqlmock.AnyArg(), // paused_at
 			sqlmock.AnyArg(), // pause_reason
 			sqlmock.AnyArg(), // data_feed (consolidated)
+			sqlmock.AnyArg(), // parent_broadcast_id
+			sqlmock.AnyArg(), // approval
 		).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -1501,6 +1676,7 @@ func TestBroadcastRepository_UpdateBroadcast_WithDataFeed(t *testing.T) {
 			sqlmock.AnyArg(), // pause_reason
 			sqlmock.AnyArg(), // enqueued_count
 			sqlmock.AnyArg(), // data_feed (consolidated)
+			sqlmock.AnyArg(), // approval
 		).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
@@ -0,0 +1,431 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+// redactedEmail replaces the email of deleted contacts in the history they leave behind
+const redactedEmail = "DELETED_EMAIL"
+
+var contactBulkOperationColumns = []string{
+	"id", "operation", "target", "params", "status", "total_count", "processed_count", "affected_count",
+	"task_id", "error", "created_by", "created_at", "updated_at", "completed_at",
+}
+
+type contactBulkOperationRepository struct {
+	workspaceRepo domain.WorkspaceRepository
+}
+
+// NewContactBulkOperationRepository creates a new contact bulk operation repository
+func NewContactBulkOperationRepository(workspaceRepo domain.WorkspaceRepository) domain.ContactBulkOperationRepository {
+	return &contactBulkOperationRepository{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// Create stores a new bulk operation
+func (r *contactBulkOperationRepository) Create(ctx context.Context, workspaceID string, operation *domain.ContactBulkOperation) error {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("contact_bulk_operations").
+		Columns(contactBulkOperationColumns...).
+		Values(
+			operation.ID, operation.Operation, operation.Target, operation.Params, operation.Status,
+			operation.TotalCount, operation.ProcessedCount, operation.AffectedCount,
+			operation.TaskID, operation.Error, operation.CreatedBy,
+			operation.CreatedAt, operation.UpdatedAt, operation.CompletedAt,
+		).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build insert query: %w", err)
+	}
+
+	if _, err := workspaceDB.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to create bulk operation: %w", err)
+	}
+	return nil
+}
+
+// Get returns a bulk operation, or ErrBulkOperationNotFound
+func (r *contactBulkOperationRepository) Get(ctx context.Context, workspaceID string, id string) (*domain.ContactBulkOperation, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(contactBulkOperationColumns...).
+		From("contact_bulk_operations").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	operation, err := scanContactBulkOperation(workspaceDB.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, domain.ErrBulkOperationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bulk operation: %w", err)
+	}
+	return operation, nil
+}
+
+// List returns the latest bulk operations, most recent first
+func (r *contactBulkOperationRepository) List(ctx context.Context, workspaceID string, limit int) ([]*domain.ContactBulkOperation, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(contactBulkOperationColumns...).
+		From("contact_bulk_operations").
+		OrderBy("created_at DESC").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	rows, err := workspaceDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bulk operations: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	operations := []*domain.ContactBulkOperation{}
+	for rows.Next() {
+		operation, err := scanContactBulkOperation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan bulk operation: %w", err)
+		}
+		operations = append(operations, operation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over rows: %w", err)
+	}
+	return operations, nil
+}
+
+// Update saves the status, counters, task and error of an operation
+func (r *contactBulkOperationRepository) Update(ctx context.Context, workspaceID string, operation *domain.ContactBulkOperation) error {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	operation.UpdatedAt = time.Now().UTC()
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("contact_bulk_operations").
+		Set("status", operation.Status).
+		Set("total_count", operation.TotalCount).
+		Set("processed_count", operation.ProcessedCount).
+		Set("affected_count", operation.AffectedCount).
+		Set("task_id", operation.TaskID).
+		Set("error", operation.Error).
+		Set("updated_at", operation.UpdatedAt).
+		Set("completed_at", operation.CompletedAt).
+		Where(sq.Eq{"id": operation.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build update query: %w", err)
+	}
+
+	result, err := workspaceDB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update bulk operation: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrBulkOperationNotFound
+	}
+	return nil
+}
+
+// bulkTargetConditions returns the conditions selecting the targeted contacts, aliased "c"
+func bulkTargetConditions(target *domain.BulkOperationTarget) []sq.Sqlizer {
+	if target.SegmentID != "" {
+		// Only the members of the current segment version
+		return []sq.Sqlizer{sq.Expr(
+			"EXISTS (SELECT 1 FROM contact_segments cs JOIN segments s ON s.id = cs.segment_id AND s.version = cs.version WHERE cs.email = c.email AND cs.segment_id = ?)",
+			target.SegmentID,
+		)}
+	}
+	if target.Filter != nil {
+		return contactFilterConditions(target.Filter)
+	}
+	return nil
+}
+
+// CountTargets counts the contacts selected by a target
+func (r *contactBulkOperationRepository) CountTargets(ctx context.Context, workspaceID string, target *domain.BulkOperationTarget) (int64, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	sb := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).Select("COUNT(*)").From("contacts c")
+	for _, condition := range bulkTargetConditions(target) {
+		sb = sb.Where(condition)
+	}
+	query, args, err := sb.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build count query: %w", err)
+	}
+
+	var count int64
+	if err := workspaceDB.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count contacts: %w", err)
+	}
+	return count, nil
+}
+
+// NextBatch returns up to limit emails of targeted contacts sorted after afterEmail.
+// Paging on the email keeps working while the operation changes or deletes contacts.
+func (r *contactBulkOperationRepository) NextBatch(ctx context.Context, workspaceID string, target *domain.BulkOperationTarget, afterEmail string, limit int) ([]string, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	sb := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).Select("c.email").From("contacts c")
+	for _, condition := range bulkTargetConditions(target) {
+		sb = sb.Where(condition)
+	}
+	if afterEmail != "" {
+		sb = sb.Where(sq.Gt{"c.email": afterEmail})
+	}
+	query, args, err := sb.OrderBy("c.email ASC").Limit(uint64(limit)).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build batch query: %w", err)
+	}
+
+	rows, err := workspaceDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select contacts: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var emails []string
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, fmt.Errorf("failed to scan email: %w", err)
+		}
+		emails = append(emails, email)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over rows: %w", err)
+	}
+	return emails, nil
+}
+
+// ApplyBatch applies the operation to the given contacts and returns the number of contacts changed
+func (r *contactBulkOperationRepository) ApplyBatch(ctx context.Context, workspaceID string, operation *domain.ContactBulkOperation, emails []string) (int64, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	now := time.Now().UTC()
+	params := operation.Params
+
+	switch operation.Operation {
+	case domain.BulkOperationAddToList:
+		// Like list imports, unsubscribed, bounced and complained subscriptions are never overwritten
+		return execRowsAffected(ctx, workspaceDB, `
+			INSERT INTO contact_lists (email, list_id, status, created_at, updated_at, deleted_at)
+			SELECT email, $2, $3, $4, $4, NULL FROM unnest($1::text[]) AS email
+			ON CONFLICT (email, list_id) DO UPDATE
+			SET status = EXCLUDED.status, updated_at = EXCLUDED.updated_at, deleted_at = NULL
+			WHERE contact_lists.status NOT IN ('unsubscribed', 'bounced', 'complained')
+			AND (contact_lists.status <> EXCLUDED.status OR contact_lists.deleted_at IS NOT NULL)
+		`, pq.Array(emails), params.ListID, params.Status, now)
+
+	case domain.BulkOperationRemoveFromList:
+		return execRowsAffected(ctx, workspaceDB, `
+			UPDATE contact_lists SET deleted_at = $1
+			WHERE list_id = $2 AND email = ANY($3) AND deleted_at IS NULL
+		`, now, params.ListID, pq.Array(emails))
+
+	case domain.BulkOperationUpdateField:
+		return r.updateField(ctx, workspaceDB, params, emails, now)
+
+	case domain.BulkOperationAddTags:
+		return countChangedEmails(ctx, workspaceDB, `
+			WITH changed AS (
+				INSERT INTO contact_tags (email, tag)
+				SELECT e.email, t.tag FROM unnest($1::text[]) AS e(email) CROSS JOIN unnest($2::text[]) AS t(tag)
+				ON CONFLICT (email, tag) DO NOTHING
+				RETURNING email
+			)
+			SELECT COUNT(DISTINCT email) FROM changed
+		`, pq.Array(emails), pq.Array(params.Tags))
+
+	case domain.BulkOperationRemoveTags:
+		return countChangedEmails(ctx, workspaceDB, `
+			WITH changed AS (
+				DELETE FROM contact_tags WHERE email = ANY($1) AND tag = ANY($2::text[])
+				RETURNING email
+			)
+			SELECT COUNT(DISTINCT email) FROM changed
+		`, pq.Array(emails), pq.Array(params.Tags))
+
+	case domain.BulkOperationDelete:
+		return r.deleteContacts(ctx, workspaceDB, emails)
+	}
+
+	return 0, fmt.Errorf("unsupported bulk operation: %s", operation.Operation)
+}
+
+// updateField sets a contact column or a typed attribute, skipping contacts that already have the value
+func (r *contactBulkOperationRepository) updateField(ctx context.Context, db *sql.DB, params domain.BulkOperationParams, emails []string, now time.Time) (int64, error) {
+	if name, ok := params.AttributeName(); ok {
+		if params.FieldValue == nil {
+			return execRowsAffected(ctx, db, `
+				UPDATE contacts SET attributes = attributes - $1::text, updated_at = $2
+				WHERE email = ANY($3) AND attributes ? $1::text
+			`, name, now, pq.Array(emails))
+		}
+
+		value, err := json.Marshal(params.FieldValue)
+		if err != nil {
+			return 0, fmt.Errorf("failed to marshal attribute value: %w", err)
+		}
+		return execRowsAffected(ctx, db, `
+			UPDATE contacts SET attributes = jsonb_set(COALESCE(attributes, '{}'::jsonb), ARRAY[$1::text], $2::jsonb), updated_at = $3
+			WHERE email = ANY($4) AND (attributes -> $1::text) IS DISTINCT FROM $2::jsonb
+		`, name, string(value), now, pq.Array(emails))
+	}
+
+	// The column comes from the allow list checked by the request validation
+	fieldType, ok := domain.BulkOperationFieldType(params.Field)
+	if !ok {
+		return 0, fmt.Errorf("field %s cannot be updated", params.Field)
+	}
+
+	placeholder := "$1"
+	value := params.FieldValue
+	if fieldType == domain.ContactAttributeTypeJSON {
+		placeholder = "$1::jsonb"
+		if value != nil {
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return 0, fmt.Errorf("failed to marshal field value: %w", err)
+			}
+			value = string(encoded)
+		}
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE contacts SET %[1]s = %[2]s, updated_at = $2
+		WHERE email = ANY($3) AND %[1]s IS DISTINCT FROM %[2]s
+	`, params.Field, placeholder)
+	return execRowsAffected(ctx, db, query, value, now, pq.Array(emails))
+}
+
+// deleteContacts deletes contacts with their list memberships, tags and timeline,
+// and redacts their email in the message history like a single contact deletion
+func (r *contactBulkOperationRepository) deleteContacts(ctx context.Context, db *sql.DB, emails []string) (int64, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	statements := []struct {
+		query string
+		args  []interface{}
+	}{
+		{`UPDATE message_history SET contact_email = $1 WHERE contact_email = ANY($2)`, []interface{}{redactedEmail, pq.Array(emails)}},
+		{`UPDATE inbound_webhook_events SET recipient_email = $1 WHERE recipient_email = ANY($2)`, []interface{}{redactedEmail, pq.Array(emails)}},
+		{`DELETE FROM contact_lists WHERE email = ANY($1)`, []interface{}{pq.Array(emails)}},
+		{`DELETE FROM contact_tags WHERE email = ANY($1)`, []interface{}{pq.Array(emails)}},
+		{`DELETE FROM contact_timeline WHERE email = ANY($1)`, []interface{}{pq.Array(emails)}},
+	}
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement.query, statement.args...); err != nil {
+			return 0, fmt.Errorf("failed to delete contact data: %w", err)
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM contacts WHERE email = ANY($1)`, pq.Array(emails))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete contacts: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return deleted, nil
+}
+
+// execRowsAffected runs a statement and returns the number of rows it changed
+func execRowsAffected(ctx context.Context, db *sql.DB, query string, args ...interface{}) (int64, error) {
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to apply bulk operation: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rows, nil
+}
+
+// countChangedEmails runs a statement returning the number of distinct contacts it changed
+func countChangedEmails(ctx context.Context, db *sql.DB, query string, args ...interface{}) (int64, error) {
+	var count int64
+	if err := db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to apply bulk operation: %w", err)
+	}
+	return count, nil
+}
+
+// scanContactBulkOperation scans a row of contactBulkOperationColumns
+func scanContactBulkOperation(row interface{ Scan(...interface{}) error }) (*domain.ContactBulkOperation, error) {
+	var operation domain.ContactBulkOperation
+	var errorMessage sql.NullString
+	var completedAt sql.NullTime
+
+	err := row.Scan(
+		&operation.ID, &operation.Operation, &operation.Target, &operation.Params, &operation.Status,
+		&operation.TotalCount, &operation.ProcessedCount, &operation.AffectedCount,
+		&operation.TaskID, &errorMessage, &operation.CreatedBy,
+		&operation.CreatedAt, &operation.UpdatedAt, &completedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if errorMessage.Valid {
+		operation.Error = &errorMessage.String
+	}
+	if completedAt.Valid {
+		operation.CompletedAt = &completedAt.Time
+	}
+	return &operation, nil
+}
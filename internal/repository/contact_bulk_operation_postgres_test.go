@@ -0,0 +1,294 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+)
+
+func setupContactBulkOperationRepositoryTest(t *testing.T) (domain.ContactBulkOperationRepository, sqlmock.Sqlmock) {
+	mockDB, mock, cleanup := setupMockDB(t)
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() {
+		cleanup()
+		ctrl.Finish()
+	})
+
+	workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil).AnyTimes()
+	return NewContactBulkOperationRepository(workspaceRepo), mock
+}
+
+func TestContactBulkOperationRepository_Create(t *testing.T) {
+	repo, mock := setupContactBulkOperationRepositoryTest(t)
+	now := time.Now().UTC()
+
+	mock.ExpectExec(`INSERT INTO contact_bulk_operations \(id,operation,target,params,status,total_count,processed_count,affected_count,task_id,error,created_by,created_at,updated_at,completed_at\)`).
+		WithArgs("op1", domain.BulkOperationDelete, sqlmock.AnyArg(), sqlmock.AnyArg(), domain.BulkOperationStatusPending,
+			int64(12), int64(0), int64(0), "task1", nil, "user1", now, now, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.Create(context.Background(), "workspace123", &domain.ContactBulkOperation{
+		ID:         "op1",
+		Operation:  domain.BulkOperationDelete,
+		Target:     domain.BulkOperationTarget{SegmentID: "seg1"},
+		Status:     domain.BulkOperationStatusPending,
+		TotalCount: 12,
+		TaskID:     "task1",
+		CreatedBy:  "user1",
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	})
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactBulkOperationRepository_Get(t *testing.T) {
+	t.Run("returns the operation", func(t *testing.T) {
+		repo, mock := setupContactBulkOperationRepositoryTest(t)
+		now := time.Now().UTC()
+
+		rows := sqlmock.NewRows(contactBulkOperationColumns).AddRow(
+			"op1", "add_to_list", []byte(`{"segment_id":"seg1"}`), []byte(`{"list_id":"news","status":"active"}`), "completed",
+			int64(3), int64(3), int64(2), "task1", nil, "user1", now, now, now,
+		)
+		mock.ExpectQuery(`SELECT id, operation, target, params, status, .* FROM contact_bulk_operations WHERE id = \$1`).
+			WithArgs("op1").
+			WillReturnRows(rows)
+
+		operation, err := repo.Get(context.Background(), "workspace123", "op1")
+		require.NoError(t, err)
+		assert.Equal(t, domain.BulkOperationAddToList, operation.Operation)
+		assert.Equal(t, "seg1", operation.Target.SegmentID)
+		assert.Equal(t, "news", operation.Params.ListID)
+		assert.Equal(t, int64(2), operation.AffectedCount)
+		assert.Nil(t, operation.Error)
+		require.NotNil(t, operation.CompletedAt)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupContactBulkOperationRepositoryTest(t)
+
+		mock.ExpectQuery(`FROM contact_bulk_operations`).WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.Get(context.Background(), "workspace123", "op1")
+		assert.ErrorIs(t, err, domain.ErrBulkOperationNotFound)
+	})
+}
+
+func TestContactBulkOperationRepository_Update(t *testing.T) {
+	t.Run("saves the progress", func(t *testing.T) {
+		repo, mock := setupContactBulkOperationRepositoryTest(t)
+		message := "boom"
+
+		mock.ExpectExec(`UPDATE contact_bulk_operations SET status = \$1, total_count = \$2, processed_count = \$3, affected_count = \$4, task_id = \$5, error = \$6, updated_at = \$7, completed_at = \$8 WHERE id = \$9`).
+			WithArgs(domain.BulkOperationStatusFailed, int64(10), int64(5), int64(4), "task1", &message, sqlmock.AnyArg(), nil, "op1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Update(context.Background(), "workspace123", &domain.ContactBulkOperation{
+			ID: "op1", Status: domain.BulkOperationStatusFailed, TotalCount: 10, ProcessedCount: 5, AffectedCount: 4, TaskID: "task1", Error: &message,
+		})
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupContactBulkOperationRepositoryTest(t)
+
+		mock.ExpectExec(`UPDATE contact_bulk_operations`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Update(context.Background(), "workspace123", &domain.ContactBulkOperation{ID: "op1"})
+		assert.ErrorIs(t, err, domain.ErrBulkOperationNotFound)
+	})
+}
+
+func TestContactBulkOperationRepository_CountTargets(t *testing.T) {
+	t.Run("segment", func(t *testing.T) {
+		repo, mock := setupContactBulkOperationRepositoryTest(t)
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM contacts c WHERE EXISTS \(SELECT 1 FROM contact_segments cs JOIN segments s ON s\.id = cs\.segment_id AND s\.version = cs\.version WHERE cs\.email = c\.email AND cs\.segment_id = \$1\)`).
+			WithArgs("seg1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(42)))
+
+		count, err := repo.CountTargets(context.Background(), "workspace123", &domain.BulkOperationTarget{SegmentID: "seg1"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), count)
+	})
+
+	t.Run("contacts filter", func(t *testing.T) {
+		repo, mock := setupContactBulkOperationRepositoryTest(t)
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM contacts c WHERE c\.country ILIKE \$1 AND EXISTS \(SELECT 1 FROM contact_lists cl WHERE cl\.email = c\.email AND cl\.deleted_at IS NULL AND cl\.list_id = \$2\)`).
+			WithArgs("%FR%", "news").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(7)))
+
+		count, err := repo.CountTargets(context.Background(), "workspace123", &domain.BulkOperationTarget{
+			Filter: &domain.GetContactsRequest{Country: "FR", ListID: "news"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), count)
+	})
+}
+
+func TestContactBulkOperationRepository_NextBatch(t *testing.T) {
+	repo, mock := setupContactBulkOperationRepositoryTest(t)
+
+	mock.ExpectQuery(`SELECT c\.email FROM contacts c WHERE c\.language ILIKE \$1 AND c\.email > \$2 ORDER BY c\.email ASC LIMIT 2`).
+		WithArgs("%fr%", "a@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"email"}).AddRow("b@example.com").AddRow("c@example.com"))
+
+	emails, err := repo.NextBatch(context.Background(), "workspace123",
+		&domain.BulkOperationTarget{Filter: &domain.GetContactsRequest{Language: "fr"}}, "a@example.com", 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b@example.com", "c@example.com"}, emails)
+}
+
+func TestContactBulkOperationRepository_ApplyBatch(t *testing.T) {
+	emails := []string{"a@example.com", "b@example.com"}
+
+	t.Run("add to list", func(t *testing.T) {
+		repo, mock := setupContactBulkOperationRepositoryTest(t)
+
+		mock.ExpectExec(`INSERT INTO contact_lists \(email, list_id, status, created_at, updated_at, deleted_at\)\s+SELECT email, \$2, \$3, \$4, \$4, NULL FROM unnest\(\$1::text\[\]\) AS email\s+ON CONFLICT \(email, list_id\) DO UPDATE(.|\n)*NOT IN \('unsubscribed', 'bounced', 'complained'\)`).
+			WithArgs(pq.Array(emails), "news", domain.ContactListStatusActive, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		affected, err := repo.ApplyBatch(context.Background(), "workspace123", &domain.ContactBulkOperation{
+			Operation: domain.BulkOperationAddToList,
+			Params:    domain.BulkOperationParams{ListID: "news", Status: domain.ContactListStatusActive},
+		}, emails)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), affected)
+	})
+
+	t.Run("remove from list", func(t *testing.T) {
+		repo, mock := setupContactBulkOperationRepositoryTest(t)
+
+		mock.ExpectExec(`UPDATE contact_lists SET deleted_at = \$1\s+WHERE list_id = \$2 AND email = ANY\(\$3\) AND deleted_at IS NULL`).
+			WithArgs(sqlmock.AnyArg(), "news", pq.Array(emails)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		affected, err := repo.ApplyBatch(context.Background(), "workspace123", &domain.ContactBulkOperation{
+			Operation: domain.BulkOperationRemoveFromList,
+			Params:    domain.BulkOperationParams{ListID: "news"},
+		}, emails)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), affected)
+	})
+
+	t.Run("update a column", func(t *testing.T) {
+		repo, mock := setupContactBulkOperationRepositoryTest(t)
+
+		mock.ExpectExec(`UPDATE contacts SET country = \$1, updated_at = \$2\s+WHERE email = ANY\(\$3\) AND country IS DISTINCT FROM \$1`).
+			WithArgs("FR", sqlmock.AnyArg(), pq.Array(emails)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		affected, err := repo.ApplyBatch(context.Background(), "workspace123", &domain.ContactBulkOperation{
+			Operation: domain.BulkOperationUpdateField,
+			Params:    domain.BulkOperationParams{Field: "country", FieldValue: "FR"},
+		}, emails)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), affected)
+	})
+
+	t.Run("update a json column", func(t *testing.T) {
+		repo, mock := setupContactBulkOperationRepositoryTest(t)
+
+		mock.ExpectExec(`UPDATE contacts SET custom_json_1 = \$1::jsonb, updated_at = \$2\s+WHERE email = ANY\(\$3\) AND custom_json_1 IS DISTINCT FROM \$1::jsonb`).
+			WithArgs(`{"plan":"pro"}`, sqlmock.AnyArg(), pq.Array(emails)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		_, err := repo.ApplyBatch(context.Background(), "workspace123", &domain.ContactBulkOperation{
+			Operation: domain.BulkOperationUpdateField,
+			Params:    domain.BulkOperationParams{Field: "custom_json_1", FieldValue: map[string]interface{}{"plan": "pro"}},
+		}, emails)
+		require.NoError(t, err)
+	})
+
+	t.Run("set an attribute", func(t *testing.T) {
+		repo, mock := setupContactBulkOperationRepositoryTest(t)
+
+		mock.ExpectExec(`UPDATE contacts SET attributes = jsonb_set\(COALESCE\(attributes, '\{\}'::jsonb\), ARRAY\[\$1::text\], \$2::jsonb\), updated_at = \$3\s+WHERE email = ANY\(\$4\) AND \(attributes -> \$1::text\) IS DISTINCT FROM \$2::jsonb`).
+			WithArgs("plan", `"pro"`, sqlmock.AnyArg(), pq.Array(emails)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		_, err := repo.ApplyBatch(context.Background(), "workspace123", &domain.ContactBulkOperation{
+			Operation: domain.BulkOperationUpdateField,
+			Params:    domain.BulkOperationParams{Field: "attributes.plan", FieldValue: "pro"},
+		}, emails)
+		require.NoError(t, err)
+	})
+
+	t.Run("clear an attribute", func(t *testing.T) {
+		repo, mock := setupContactBulkOperationRepositoryTest(t)
+
+		mock.ExpectExec(`UPDATE contacts SET attributes = attributes - \$1::text, updated_at = \$2\s+WHERE email = ANY\(\$3\) AND attributes \? \$1::text`).
+			WithArgs("plan", sqlmock.AnyArg(), pq.Array(emails)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		_, err := repo.ApplyBatch(context.Background(), "workspace123", &domain.ContactBulkOperation{
+			Operation: domain.BulkOperationUpdateField,
+			Params:    domain.BulkOperationParams{Field: "attributes.plan"},
+		}, emails)
+		require.NoError(t, err)
+	})
+
+	t.Run("add tags", func(t *testing.T) {
+		repo, mock := setupContactBulkOperationRepositoryTest(t)
+
+		mock.ExpectQuery(`INSERT INTO contact_tags \(email, tag\)(.|\n)*RETURNING email(.|\n)*SELECT COUNT\(DISTINCT email\) FROM changed`).
+			WithArgs(pq.Array(emails), pq.Array([]string{"vip"})).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(int64(2)))
+
+		affected, err := repo.ApplyBatch(context.Background(), "workspace123", &domain.ContactBulkOperation{
+			Operation: domain.BulkOperationAddTags,
+			Params:    domain.BulkOperationParams{Tags: []string{"vip"}},
+		}, emails)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), affected)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		repo, mock := setupContactBulkOperationRepositoryTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE message_history SET contact_email = \$1 WHERE contact_email = ANY\(\$2\)`).
+			WithArgs("DELETED_EMAIL", pq.Array(emails)).WillReturnResult(sqlmock.NewResult(0, 5))
+		mock.ExpectExec(`UPDATE inbound_webhook_events SET recipient_email = \$1 WHERE recipient_email = ANY\(\$2\)`).
+			WithArgs("DELETED_EMAIL", pq.Array(emails)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`DELETE FROM contact_lists WHERE email = ANY\(\$1\)`).WithArgs(pq.Array(emails)).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`DELETE FROM contact_tags WHERE email = ANY\(\$1\)`).WithArgs(pq.Array(emails)).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`DELETE FROM contact_timeline WHERE email = ANY\(\$1\)`).WithArgs(pq.Array(emails)).WillReturnResult(sqlmock.NewResult(0, 8))
+		mock.ExpectExec(`DELETE FROM contacts WHERE email = ANY\(\$1\)`).WithArgs(pq.Array(emails)).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		affected, err := repo.ApplyBatch(context.Background(), "workspace123", &domain.ContactBulkOperation{Operation: domain.BulkOperationDelete}, emails)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), affected)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("delete rolls back on error", func(t *testing.T) {
+		repo, mock := setupContactBulkOperationRepositoryTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE message_history`).WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		_, err := repo.ApplyBatch(context.Background(), "workspace123", &domain.ContactBulkOperation{Operation: domain.BulkOperationDelete}, emails)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to delete contact data")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+// ChangeEmail moves a contact to a new email and keeps the previous one as an alias
+func (r *contactRepository) ChangeEmail(ctx context.Context, workspaceID string, currentEmail, newEmail string) (*domain.Contact, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	tx, err := workspaceDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	selectQuery, selectArgs, err := psql.Select(contactColumnsWithPrefix("c")...).
+		From("contacts c").
+		Where(sq.Eq{"c.email": currentEmail}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	contact, err := domain.ScanContact(tx.QueryRowContext(ctx, selectQuery, selectArgs...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrContactNotFound, currentEmail)
+		}
+		return nil, fmt.Errorf("failed to lock contact: %w", err)
+	}
+
+	var taken bool
+	if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM contacts WHERE email = $1)`, newEmail).Scan(&taken); err != nil {
+		return nil, fmt.Errorf("failed to check new email: %w", err)
+	}
+	if taken {
+		return nil, fmt.Errorf("%w: %s", domain.ErrContactEmailTaken, newEmail)
+	}
+
+	now := time.Now().UTC()
+	_, err = tx.ExecContext(ctx, `UPDATE contacts SET email = $1, updated_at = $3, db_updated_at = $3 WHERE email = $2`,
+		newEmail, currentEmail, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update contact email: %w", err)
+	}
+
+	// None of these tables reference contacts with a foreign key, so each is rewritten explicitly.
+	// The timeline goes first so entries emitted by the custom_events trigger are not moved twice.
+	both := []interface{}{newEmail, currentEmail}
+	statements := []struct {
+		name  string
+		query string
+		args  []interface{}
+	}{
+		{"contact timeline", `UPDATE contact_timeline SET email = $1 WHERE email = $2`, both},
+		{"list memberships", `UPDATE contact_lists SET email = $1 WHERE email = $2`, both},
+		{"segment memberships", `UPDATE contact_segments SET email = $1 WHERE email = $2`, both},
+		{"tags", `UPDATE contact_tags SET email = $1 WHERE email = $2`, both},
+		// The email change timeline entry below queues the new address for recomputation
+		{"segment queue", `DELETE FROM contact_segment_queue WHERE email = $1`, []interface{}{currentEmail}},
+		{"message history", `UPDATE message_history SET contact_email = $1 WHERE contact_email = $2`, both},
+		{"custom events", `UPDATE custom_events SET email = $1 WHERE email = $2`, both},
+		{"automation journeys", `UPDATE contact_automations SET contact_email = $1 WHERE contact_email = $2`, both},
+		{"automation trigger log", `UPDATE automation_trigger_log SET contact_email = $1 WHERE contact_email = $2`, both},
+		{"broadcast holdouts", `UPDATE broadcast_holdout_contacts SET email = $1 WHERE email = $2`, both},
+		// Earlier aliases follow the contact, and the new address stops being an alias
+		{"email aliases", `UPDATE contact_email_aliases SET email = $1 WHERE email = $2`, both},
+		{"email aliases", `DELETE FROM contact_email_aliases WHERE alias = $1`, []interface{}{newEmail}},
+		{"email aliases", `INSERT INTO contact_email_aliases (alias, email, created_at) VALUES ($2, $1, NOW())
+			ON CONFLICT (alias) DO UPDATE SET email = EXCLUDED.email, created_at = EXCLUDED.created_at`, both},
+	}
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement.query, statement.args...); err != nil {
+			return nil, fmt.Errorf("failed to move %s: %w", statement.name, err)
+		}
+	}
+
+	changes, err := json.Marshal(map[string]interface{}{
+		"email": map[string]interface{}{"old": currentEmail, "new": newEmail},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal timeline changes: %w", err)
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO contact_timeline (email, operation, entity_type, kind, changes, created_at)
+		VALUES ($1, 'update', 'contact', 'contact.email_changed', $2, $3)
+	`, newEmail, changes, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create email change timeline entry: %w", err)
+	}
+
+	// webhook_contacts_trigger ignores the primary key, so contact.updated is queued here
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO webhook_deliveries (id, subscription_id, event_type, payload, status, attempts, max_attempts, next_attempt_at)
+		SELECT gen_random_uuid()::text, s.id, 'contact.updated',
+			jsonb_build_object('contact', to_jsonb(c), 'previous_email', $2::text),
+			'pending', 0, 10, NOW()
+		FROM webhook_subscriptions s
+		JOIN contacts c ON c.email = $1
+		WHERE s.enabled = true
+		AND 'contact.updated' = ANY(ARRAY(SELECT jsonb_array_elements_text(s.settings->'event_types')))
+	`, newEmail, currentEmail)
+	if err != nil {
+		return nil, fmt.Errorf("failed to queue contact.updated webhooks: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	contact.Email = newEmail
+	contact.UpdatedAt = now
+	contact.DBUpdatedAt = now
+	return contact, nil
+}
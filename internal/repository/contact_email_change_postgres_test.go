@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+)
+
+func TestContactRepository_ChangeEmail(t *testing.T) {
+	current := "old@example.com"
+	newEmail := "new@example.com"
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	setup := func(t *testing.T) (*contactRepository, sqlmock.Sqlmock, func()) {
+		mockDB, mock, cleanup := setupMockDB(t)
+		ctrl := gomock.NewController(t)
+		workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+		workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil)
+		return NewContactRepository(workspaceRepo).(*contactRepository), mock, func() {
+			cleanup()
+			ctrl.Finish()
+		}
+	}
+
+	expectLock := func(mock sqlmock.Sqlmock, rows *sqlmock.Rows) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT ` + contactColumnsPattern + ` FROM contacts c WHERE c.email = \$1 FOR UPDATE`).
+			WithArgs(current).
+			WillReturnRows(rows)
+	}
+
+	expectTaken := func(mock sqlmock.Sqlmock, taken bool) {
+		mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM contacts WHERE email = \$1\)`).
+			WithArgs(newEmail).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(taken))
+	}
+
+	t.Run("moves the contact and its history to the new email", func(t *testing.T) {
+		repo, mock, cleanup := setup(t)
+		defer cleanup()
+
+		expectLock(mock, sqlmock.NewRows(contactColumns).
+			AddRow(mergeContactRow(current, "user-1", "John", createdAt)...))
+		expectTaken(mock, false)
+
+		mock.ExpectExec(`UPDATE contacts SET email = \$1, updated_at = \$3, db_updated_at = \$3 WHERE email = \$2`).
+			WithArgs(newEmail, current, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE contact_timeline SET email = \$1 WHERE email = \$2`).
+			WithArgs(newEmail, current).WillReturnResult(sqlmock.NewResult(0, 12))
+		mock.ExpectExec(`UPDATE contact_lists SET email = \$1 WHERE email = \$2`).
+			WithArgs(newEmail, current).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`UPDATE contact_segments SET email = \$1 WHERE email = \$2`).
+			WithArgs(newEmail, current).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE contact_tags SET email = \$1 WHERE email = \$2`).
+			WithArgs(newEmail, current).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`DELETE FROM contact_segment_queue WHERE email = \$1`).
+			WithArgs(current).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`UPDATE message_history SET contact_email = \$1 WHERE contact_email = \$2`).
+			WithArgs(newEmail, current).WillReturnResult(sqlmock.NewResult(0, 5))
+		mock.ExpectExec(`UPDATE custom_events SET email = \$1 WHERE email = \$2`).
+			WithArgs(newEmail, current).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`UPDATE contact_automations SET contact_email = \$1 WHERE contact_email = \$2`).
+			WithArgs(newEmail, current).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE automation_trigger_log SET contact_email = \$1 WHERE contact_email = \$2`).
+			WithArgs(newEmail, current).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE broadcast_holdout_contacts SET email = \$1 WHERE email = \$2`).
+			WithArgs(newEmail, current).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE contact_email_aliases SET email = \$1 WHERE email = \$2`).
+			WithArgs(newEmail, current).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`DELETE FROM contact_email_aliases WHERE alias = \$1`).
+			WithArgs(newEmail).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`INSERT INTO contact_email_aliases \(alias, email, created_at\) VALUES \(\$2, \$1, NOW\(\)\)`).
+			WithArgs(newEmail, current).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO contact_timeline .* 'contact.email_changed'`).
+			WithArgs(newEmail, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO webhook_deliveries .* 'previous_email'`).
+			WithArgs(newEmail, current).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		contact, err := repo.ChangeEmail(context.Background(), "workspace123", current, newEmail)
+
+		require.NoError(t, err)
+		assert.Equal(t, newEmail, contact.Email)
+		assert.Equal(t, "user-1", contact.ExternalID.String)
+		assert.Equal(t, createdAt, contact.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found when the contact is missing", func(t *testing.T) {
+		repo, mock, cleanup := setup(t)
+		defer cleanup()
+
+		expectLock(mock, sqlmock.NewRows(contactColumns))
+		mock.ExpectRollback()
+
+		_, err := repo.ChangeEmail(context.Background(), "workspace123", current, newEmail)
+
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, domain.ErrContactNotFound))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("refuses an email used by another contact", func(t *testing.T) {
+		repo, mock, cleanup := setup(t)
+		defer cleanup()
+
+		expectLock(mock, sqlmock.NewRows(contactColumns).
+			AddRow(mergeContactRow(current, nil, nil, createdAt)...))
+		expectTaken(mock, true)
+		mock.ExpectRollback()
+
+		_, err := repo.ChangeEmail(context.Background(), "workspace123", current, newEmail)
+
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, domain.ErrContactEmailTaken))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back when a table fails to update", func(t *testing.T) {
+		repo, mock, cleanup := setup(t)
+		defer cleanup()
+
+		expectLock(mock, sqlmock.NewRows(contactColumns).
+			AddRow(mergeContactRow(current, nil, nil, createdAt)...))
+		expectTaken(mock, false)
+		mock.ExpectExec(`UPDATE contacts SET email`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE contact_timeline`).WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		_, err := repo.ChangeEmail(context.Background(), "workspace123", current, newEmail)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to move contact timeline")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,340 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+// canonicalEmailSQL folds addresses that reach the same mailbox: the +tag suffix is
+// dropped and, for Gmail, dots in the local part are ignored and googlemail.com is aliased.
+const canonicalEmailSQL = `CASE
+	WHEN split_part(email, '@', 2) IN ('gmail.com', 'googlemail.com')
+		THEN replace(regexp_replace(split_part(email, '@', 1), '\+.*$', ''), '.', '') || '@gmail.com'
+	ELSE regexp_replace(split_part(email, '@', 1), '\+.*$', '') || '@' || split_part(email, '@', 2)
+END`
+
+// MergeContacts merges the source contact into the target and deletes the source
+func (r *contactRepository) MergeContacts(ctx context.Context, workspaceID string, sourceEmail, targetEmail string, precedence domain.ContactMergePrecedence) (*domain.Contact, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	tx, err := workspaceDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	// Lock both rows in a stable order so concurrent merges cannot deadlock
+	selectQuery, selectArgs, err := psql.Select(contactColumnsWithPrefix("c")...).
+		From("contacts c").
+		Where(sq.Eq{"c.email": []string{sourceEmail, targetEmail}}).
+		OrderBy("c.email").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select query: %w", err)
+	}
+
+	rows, err := tx.QueryContext(ctx, selectQuery, selectArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock contacts: %w", err)
+	}
+	var source, target *domain.Contact
+	for rows.Next() {
+		contact, err := domain.ScanContact(rows)
+		if err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("failed to scan contact: %w", err)
+		}
+		if contact.Email == sourceEmail {
+			source = contact
+		} else {
+			target = contact
+		}
+	}
+	if err := rows.Err(); err != nil {
+		_ = rows.Close()
+		return nil, fmt.Errorf("error iterating over contact rows: %w", err)
+	}
+	_ = rows.Close()
+
+	if source == nil {
+		return nil, fmt.Errorf("source %w: %s", domain.ErrContactNotFound, sourceEmail)
+	}
+	if target == nil {
+		return nil, fmt.Errorf("target %w: %s", domain.ErrContactNotFound, targetEmail)
+	}
+
+	merged := domain.MergeContactFields(target, source, precedence)
+	merged.DBUpdatedAt = time.Now().UTC()
+
+	updateQuery, updateArgs, err := psql.Update("contacts").
+		SetMap(sq.Eq{
+			"external_id":       contactToNullString(merged.ExternalID),
+			"timezone":          contactToNullString(merged.Timezone),
+			"language":          contactToNullString(merged.Language),
+			"first_name":        contactToNullString(merged.FirstName),
+			"last_name":         contactToNullString(merged.LastName),
+			"full_name":         contactToNullString(merged.FullName),
+			"phone":             contactToNullString(merged.Phone),
+			"address_line_1":    contactToNullString(merged.AddressLine1),
+			"address_line_2":    contactToNullString(merged.AddressLine2),
+			"country":           contactToNullString(merged.Country),
+			"postcode":          contactToNullString(merged.Postcode),
+			"state":             contactToNullString(merged.State),
+			"job_title":         contactToNullString(merged.JobTitle),
+			"custom_string_1":   contactToNullString(merged.CustomString1),
+			"custom_string_2":   contactToNullString(merged.CustomString2),
+			"custom_string_3":   contactToNullString(merged.CustomString3),
+			"custom_string_4":   contactToNullString(merged.CustomString4),
+			"custom_string_5":   contactToNullString(merged.CustomString5),
+			"custom_number_1":   contactToNullFloat64(merged.CustomNumber1),
+			"custom_number_2":   contactToNullFloat64(merged.CustomNumber2),
+			"custom_number_3":   contactToNullFloat64(merged.CustomNumber3),
+			"custom_number_4":   contactToNullFloat64(merged.CustomNumber4),
+			"custom_number_5":   contactToNullFloat64(merged.CustomNumber5),
+			"custom_datetime_1": contactToNullTime(merged.CustomDatetime1),
+			"custom_datetime_2": contactToNullTime(merged.CustomDatetime2),
+			"custom_datetime_3": contactToNullTime(merged.CustomDatetime3),
+			"custom_datetime_4": contactToNullTime(merged.CustomDatetime4),
+			"custom_datetime_5": contactToNullTime(merged.CustomDatetime5),
+			"custom_json_1":     contactToNullJSON(merged.CustomJSON1),
+			"custom_json_2":     contactToNullJSON(merged.CustomJSON2),
+			"custom_json_3":     contactToNullJSON(merged.CustomJSON3),
+			"custom_json_4":     contactToNullJSON(merged.CustomJSON4),
+			"custom_json_5":     contactToNullJSON(merged.CustomJSON5),
+			"attributes":        contactToNullAttributes(merged.Attributes),
+			"created_at":        merged.CreatedAt.UTC(),
+			"updated_at":        merged.DBUpdatedAt,
+			"db_updated_at":     merged.DBUpdatedAt,
+		}).
+		Where(sq.Eq{"email": targetEmail}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build update query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, updateQuery, updateArgs...); err != nil {
+		return nil, fmt.Errorf("failed to update target contact: %w", err)
+	}
+
+	if err := mergeContactLists(ctx, tx, sourceEmail, targetEmail); err != nil {
+		return nil, err
+	}
+
+	// Reassign history. Rows are moved rather than copied so the source can be deleted.
+	both := []interface{}{targetEmail, sourceEmail}
+	sourceOnly := []interface{}{sourceEmail}
+	statements := []struct {
+		name  string
+		query string
+		args  []interface{}
+	}{
+		{"message history", `UPDATE message_history SET contact_email = $1 WHERE contact_email = $2`, both},
+		{"contact timeline", `UPDATE contact_timeline SET email = $1 WHERE email = $2`, both},
+		{"custom events", `UPDATE custom_events SET email = $1, updated_at = NOW() WHERE email = $2`, both},
+		// A journey the target entered at the same instant cannot be moved without
+		// breaking the unique key, so the leftover source rows are dropped
+		{"automation journeys", `UPDATE contact_automations ca SET contact_email = $1
+			WHERE ca.contact_email = $2 AND NOT EXISTS (
+				SELECT 1 FROM contact_automations t
+				WHERE t.contact_email = $1 AND t.automation_id = ca.automation_id AND t.entered_at = ca.entered_at
+			)`, both},
+		{"automation journeys", `DELETE FROM contact_automations WHERE contact_email = $1`, sourceOnly},
+		// Keep the target's own entry when both contacts triggered the same automation
+		{"automation trigger log", `UPDATE automation_trigger_log l SET contact_email = $1
+			WHERE l.contact_email = $2 AND NOT EXISTS (
+				SELECT 1 FROM automation_trigger_log t
+				WHERE t.contact_email = $1 AND t.automation_id = l.automation_id
+			)`, both},
+		{"automation trigger log", `DELETE FROM automation_trigger_log WHERE contact_email = $1`, sourceOnly},
+		// Segment memberships are recomputed for the target from the merge timeline entry
+		{"segment memberships", `DELETE FROM contact_segments WHERE email = $1`, sourceOnly},
+		{"segment queue", `DELETE FROM contact_segment_queue WHERE email = $1`, sourceOnly},
+		// The target gains the source's tags, the delete cannot be an update as both may carry a tag
+		{"tags", `INSERT INTO contact_tags (email, tag, created_at)
+			SELECT $1, tag, created_at FROM contact_tags WHERE email = $2
+			ON CONFLICT (email, tag) DO NOTHING`, both},
+		{"tags", `DELETE FROM contact_tags WHERE email = $1`, sourceOnly},
+		// Keep the target's own assignment when both contacts were held out of the same broadcast
+		{"broadcast holdouts", `INSERT INTO broadcast_holdout_contacts (broadcast_id, email, assigned_at)
+			SELECT broadcast_id, $1, assigned_at FROM broadcast_holdout_contacts WHERE email = $2
+			ON CONFLICT (broadcast_id, email) DO NOTHING`, both},
+		{"broadcast holdouts", `DELETE FROM broadcast_holdout_contacts WHERE email = $1`, sourceOnly},
+		// Drop the segment.left and contact.untagged entries the deletions above emitted for the source
+		{"contact timeline", `DELETE FROM contact_timeline WHERE email = $1`, sourceOnly},
+		// The source address becomes an alias so late provider events still reach the target
+		{"email aliases", `UPDATE contact_email_aliases SET email = $1 WHERE email = $2`, both},
+		{"email aliases", `INSERT INTO contact_email_aliases (alias, email, created_at) VALUES ($2, $1, NOW())
+			ON CONFLICT (alias) DO UPDATE SET email = EXCLUDED.email, created_at = EXCLUDED.created_at`, both},
+		{"source contact", `DELETE FROM contacts WHERE email = $1`, sourceOnly},
+	}
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement.query, statement.args...); err != nil {
+			return nil, fmt.Errorf("failed to merge %s: %w", statement.name, err)
+		}
+	}
+
+	changes, err := json.Marshal(map[string]interface{}{
+		"merged_from": map[string]interface{}{"old": nil, "new": sourceEmail},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal timeline changes: %w", err)
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO contact_timeline (email, operation, entity_type, kind, entity_id, changes, created_at)
+		VALUES ($1, 'update', 'contact', 'contact.merged', $2, $3, $4)
+	`, targetEmail, sourceEmail, changes, merged.DBUpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create merge timeline entry: %w", err)
+	}
+
+	// contact.merged has no row-level trigger, so deliveries are queued here
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO webhook_deliveries (id, subscription_id, event_type, payload, status, attempts, max_attempts, next_attempt_at)
+		SELECT gen_random_uuid()::text, s.id, 'contact.merged',
+			jsonb_build_object('contact', to_jsonb(c), 'merged_from', $2::text),
+			'pending', 0, 10, NOW()
+		FROM webhook_subscriptions s
+		JOIN contacts c ON c.email = $1
+		WHERE s.enabled = true
+		AND 'contact.merged' = ANY(ARRAY(SELECT jsonb_array_elements_text(s.settings->'event_types')))
+	`, targetEmail, sourceEmail)
+	if err != nil {
+		return nil, fmt.Errorf("failed to queue contact.merged webhooks: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return merged, nil
+}
+
+// mergeContactLists copies the source's list memberships onto the target.
+// When both are on the same list the most privileged status wins.
+func mergeContactLists(ctx context.Context, tx *sql.Tx, sourceEmail, targetEmail string) error {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT s.list_id, s.status, t.status, t.deleted_at IS NOT NULL
+		FROM contact_lists s
+		LEFT JOIN contact_lists t ON t.list_id = s.list_id AND t.email = $1
+		WHERE s.email = $2 AND s.deleted_at IS NULL
+		ORDER BY s.list_id
+	`, targetEmail, sourceEmail)
+	if err != nil {
+		return fmt.Errorf("failed to get source list memberships: %w", err)
+	}
+
+	type membership struct {
+		listID string
+		status domain.ContactListStatus
+	}
+	var upserts []membership
+	for rows.Next() {
+		var listID, sourceStatus string
+		var targetStatus sql.NullString
+		var targetDeleted sql.NullBool
+		if err := rows.Scan(&listID, &sourceStatus, &targetStatus, &targetDeleted); err != nil {
+			_ = rows.Close()
+			return fmt.Errorf("failed to scan list membership: %w", err)
+		}
+
+		status := domain.ContactListStatus(sourceStatus)
+		if targetStatus.Valid && !targetDeleted.Bool {
+			status = domain.MostPrivilegedContactListStatus(domain.ContactListStatus(targetStatus.String), status)
+			if status == domain.ContactListStatus(targetStatus.String) {
+				continue
+			}
+		}
+		upserts = append(upserts, membership{listID: listID, status: status})
+	}
+	if err := rows.Err(); err != nil {
+		_ = rows.Close()
+		return fmt.Errorf("error iterating over list memberships: %w", err)
+	}
+	_ = rows.Close()
+
+	for _, m := range upserts {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO contact_lists (email, list_id, status, created_at, updated_at)
+			VALUES ($1, $2, $3, NOW(), NOW())
+			ON CONFLICT (email, list_id) DO UPDATE SET
+				status = EXCLUDED.status,
+				updated_at = EXCLUDED.updated_at,
+				deleted_at = NULL
+		`, targetEmail, m.listID, string(m.status))
+		if err != nil {
+			return fmt.Errorf("failed to merge list %s: %w", m.listID, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM contact_lists WHERE email = $1`, sourceEmail); err != nil {
+		return fmt.Errorf("failed to delete source list memberships: %w", err)
+	}
+
+	return nil
+}
+
+// FindDuplicateContacts groups contacts sharing an external_id or a canonical email
+func (r *contactRepository) FindDuplicateContacts(ctx context.Context, workspaceID string, limit int) ([]*domain.ContactDuplicateGroup, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	query := `
+		SELECT reason, key, emails FROM (
+			SELECT 'external_id' AS reason, external_id AS key,
+				array_agg(email ORDER BY created_at DESC, email) AS emails
+			FROM contacts
+			WHERE external_id IS NOT NULL AND external_id <> ''
+			GROUP BY external_id
+			HAVING COUNT(*) > 1
+			UNION ALL
+			SELECT 'normalized_email' AS reason, canonical AS key,
+				array_agg(email ORDER BY created_at DESC, email) AS emails
+			FROM (SELECT email, created_at, ` + canonicalEmailSQL + ` AS canonical FROM contacts) normalized
+			GROUP BY canonical
+			HAVING COUNT(*) > 1
+		) duplicates
+		ORDER BY reason, key
+		LIMIT $1
+	`
+
+	rows, err := workspaceDB.QueryContext(ctx, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find duplicate contacts: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	groups := []*domain.ContactDuplicateGroup{}
+	for rows.Next() {
+		var reason, key string
+		var emails pq.StringArray
+		if err := rows.Scan(&reason, &key, &emails); err != nil {
+			return nil, fmt.Errorf("failed to scan duplicate group: %w", err)
+		}
+		groups = append(groups, &domain.ContactDuplicateGroup{
+			Reason:          domain.ContactDuplicateReason(reason),
+			Key:             key,
+			Emails:          emails,
+			SuggestedTarget: emails[0],
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over duplicate groups: %w", err)
+	}
+
+	return groups, nil
+}
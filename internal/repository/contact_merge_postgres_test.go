@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+)
+
+// mergeContactRow builds a contacts row with only email, external_id, first_name and timestamps set
+func mergeContactRow(email string, externalID, firstName interface{}, createdAt time.Time) []driver.Value {
+	row := make([]driver.Value, len(contactColumns))
+	row[0] = email
+	row[1] = externalID
+	row[4] = firstName
+	row[34] = createdAt
+	row[35] = createdAt
+	row[36] = createdAt
+	row[37] = createdAt
+	return row
+}
+
+func TestContactRepository_MergeContacts(t *testing.T) {
+	source := "old@example.com"
+	target := "new@example.com"
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	setup := func(t *testing.T) (*contactRepository, sqlmock.Sqlmock, func()) {
+		mockDB, mock, cleanup := setupMockDB(t)
+		ctrl := gomock.NewController(t)
+		workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+		workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil)
+		return NewContactRepository(workspaceRepo).(*contactRepository), mock, func() {
+			cleanup()
+			ctrl.Finish()
+		}
+	}
+
+	expectLock := func(mock sqlmock.Sqlmock, rows *sqlmock.Rows) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT `+contactColumnsPattern+` FROM contacts c WHERE c.email IN \(\$1,\$2\) ORDER BY c.email FOR UPDATE`).
+			WithArgs(source, target).
+			WillReturnRows(rows)
+	}
+
+	t.Run("merges fields, lists and history into the target", func(t *testing.T) {
+		repo, mock, cleanup := setup(t)
+		defer cleanup()
+
+		expectLock(mock, sqlmock.NewRows(contactColumns).
+			AddRow(mergeContactRow(target, nil, "Johnny", newer)...).
+			AddRow(mergeContactRow(source, "user-1", "John", older)...))
+
+		mock.ExpectExec(`UPDATE contacts SET .* WHERE email = \$\d+`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		// Source is active on "news" where the target unsubscribed, and alone on "promo";
+		// the target is already active on "product" so nothing changes there
+		mock.ExpectQuery(`SELECT s.list_id, s.status, t.status, t.deleted_at IS NOT NULL FROM contact_lists s`).
+			WithArgs(target, source).
+			WillReturnRows(sqlmock.NewRows([]string{"list_id", "status", "target_status", "target_deleted"}).
+				AddRow("news", "active", "unsubscribed", false).
+				AddRow("product", "pending", "active", false).
+				AddRow("promo", "unsubscribed", nil, nil))
+		mock.ExpectExec(`INSERT INTO contact_lists .* ON CONFLICT \(email, list_id\) DO UPDATE`).
+			WithArgs(target, "news", "active").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO contact_lists .* ON CONFLICT \(email, list_id\) DO UPDATE`).
+			WithArgs(target, "promo", "unsubscribed").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM contact_lists WHERE email = \$1`).
+			WithArgs(source).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+
+		mock.ExpectExec(`UPDATE message_history SET contact_email = \$1 WHERE contact_email = \$2`).
+			WithArgs(target, source).WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectExec(`UPDATE contact_timeline SET email = \$1 WHERE email = \$2`).
+			WithArgs(target, source).WillReturnResult(sqlmock.NewResult(0, 10))
+		mock.ExpectExec(`UPDATE custom_events SET email = \$1`).
+			WithArgs(target, source).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`UPDATE contact_automations ca SET contact_email = \$1`).
+			WithArgs(target, source).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM contact_automations WHERE contact_email = \$1`).
+			WithArgs(source).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`UPDATE automation_trigger_log l SET contact_email = \$1`).
+			WithArgs(target, source).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM automation_trigger_log WHERE contact_email = \$1`).
+			WithArgs(source).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`DELETE FROM contact_segments WHERE email = \$1`).
+			WithArgs(source).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM contact_segment_queue WHERE email = \$1`).
+			WithArgs(source).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`INSERT INTO contact_tags \(email, tag, created_at\)\s+SELECT \$1, tag, created_at FROM contact_tags WHERE email = \$2`).
+			WithArgs(target, source).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM contact_tags WHERE email = \$1`).
+			WithArgs(source).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`INSERT INTO broadcast_holdout_contacts \(broadcast_id, email, assigned_at\)\s+SELECT broadcast_id, \$1, assigned_at FROM broadcast_holdout_contacts WHERE email = \$2\s+ON CONFLICT \(broadcast_id, email\) DO NOTHING`).
+			WithArgs(target, source).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM broadcast_holdout_contacts WHERE email = \$1`).
+			WithArgs(source).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM contact_timeline WHERE email = \$1`).
+			WithArgs(source).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE contact_email_aliases SET email = \$1 WHERE email = \$2`).
+			WithArgs(target, source).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`INSERT INTO contact_email_aliases`).
+			WithArgs(target, source).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM contacts WHERE email = \$1`).
+			WithArgs(source).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectExec(`INSERT INTO contact_timeline .* 'contact.merged'`).
+			WithArgs(target, source, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO webhook_deliveries .* 'contact.merged'`).
+			WithArgs(target, source).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		merged, err := repo.MergeContacts(context.Background(), "workspace123", source, target, domain.ContactMergePrecedenceTarget)
+
+		require.NoError(t, err)
+		assert.Equal(t, target, merged.Email)
+		assert.Equal(t, "Johnny", merged.FirstName.String)
+		assert.Equal(t, "user-1", merged.ExternalID.String)
+		assert.Equal(t, older, merged.CreatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found when the source is missing", func(t *testing.T) {
+		repo, mock, cleanup := setup(t)
+		defer cleanup()
+
+		expectLock(mock, sqlmock.NewRows(contactColumns).
+			AddRow(mergeContactRow(target, nil, nil, newer)...))
+		mock.ExpectRollback()
+
+		_, err := repo.MergeContacts(context.Background(), "workspace123", source, target, domain.ContactMergePrecedenceTarget)
+
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, domain.ErrContactNotFound))
+		assert.Contains(t, err.Error(), source)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back when a reassignment fails", func(t *testing.T) {
+		repo, mock, cleanup := setup(t)
+		defer cleanup()
+
+		expectLock(mock, sqlmock.NewRows(contactColumns).
+			AddRow(mergeContactRow(target, nil, nil, newer)...).
+			AddRow(mergeContactRow(source, nil, nil, older)...))
+		mock.ExpectExec(`UPDATE contacts SET`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`SELECT s.list_id`).
+			WillReturnRows(sqlmock.NewRows([]string{"list_id", "status", "target_status", "target_deleted"}))
+		mock.ExpectExec(`DELETE FROM contact_lists WHERE email = \$1`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`UPDATE message_history`).WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		_, err := repo.MergeContacts(context.Background(), "workspace123", source, target, domain.ContactMergePrecedenceTarget)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to merge message history")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestContactRepository_FindDuplicateContacts(t *testing.T) {
+	mockDB, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil)
+
+	repo := NewContactRepository(workspaceRepo)
+
+	mock.ExpectQuery(`SELECT reason, key, emails FROM \(.*GROUP BY external_id.*GROUP BY canonical.*\) duplicates ORDER BY reason, key LIMIT \$1`).
+		WithArgs(20).
+		WillReturnRows(sqlmock.NewRows([]string{"reason", "key", "emails"}).
+			AddRow("external_id", "user-1", "{new@example.com,old@example.com}").
+			AddRow("normalized_email", "john@gmail.com", "{john+news@gmail.com,j.o.h.n@gmail.com}"))
+
+	groups, err := repo.FindDuplicateContacts(context.Background(), "workspace123", 20)
+
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, domain.ContactDuplicateReasonExternalID, groups[0].Reason)
+	assert.Equal(t, "user-1", groups[0].Key)
+	assert.Equal(t, []string{"new@example.com", "old@example.com"}, groups[0].Emails)
+	assert.Equal(t, "new@example.com", groups[0].SuggestedTarget)
+	assert.Equal(t, domain.ContactDuplicateReasonNormalizedEmail, groups[1].Reason)
+	assert.Equal(t, "john+news@gmail.com", groups[1].SuggestedTarget)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
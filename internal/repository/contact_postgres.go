@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -34,6 +35,7 @@ var contactColumns = []string{
 	"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 	"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
 	"created_at", "updated_at", "db_created_at", "db_updated_at",
+	"attributes",
 }
 
 // contactColumnsWithPrefix returns contact columns prefixed with a table alias
@@ -172,42 +174,67 @@ func (r *contactRepository) fetchContact(ctx context.Context, workspaceID string
 		return nil, fmt.Errorf("error iterating contact segments: %w", err)
 	}
 
-	return contact, nil
-}
+	// Fetch tags for this contact
+	tagsQuery, tagsArgs, err := psql.Select("ct.tag").
+		From("contact_tags ct").
+		Where(sq.Eq{"ct.email": contact.Email}).
+		OrderBy("ct.tag").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build contact tags query: %w", err)
+	}
 
-func (r *contactRepository) GetContacts(ctx context.Context, req *domain.GetContactsRequest) (*domain.GetContactsResponse, error) {
-	db, err := r.workspaceRepo.GetConnection(ctx, req.WorkspaceID)
+	tagRows, err := db.QueryContext(ctx, tagsQuery, tagsArgs...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+		return nil, fmt.Errorf("failed to fetch contact tags: %w", err)
 	}
+	defer func() {
+		_ = tagRows.Close()
+	}()
 
-	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
-	sb := psql.Select(contactColumnsWithPrefix("c")...).From("contacts c")
+	for tagRows.Next() {
+		var tag string
+		if err := tagRows.Scan(&tag); err != nil {
+			return nil, fmt.Errorf("failed to scan contact tag: %w", err)
+		}
+		contact.Tags = append(contact.Tags, tag)
+	}
+
+	if err = tagRows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating contact tags: %w", err)
+	}
+
+	return contact, nil
+}
+
+// contactFilterConditions returns the conditions of the filters of a contacts.list
+// request, on contacts aliased "c"
+func contactFilterConditions(req *domain.GetContactsRequest) []sq.Sqlizer {
+	var conditions []sq.Sqlizer
 
-	// Add filters using squirrel
 	if req.Email != "" {
-		sb = sb.Where(sq.ILike{"c.email": "%" + req.Email + "%"})
+		conditions = append(conditions, sq.ILike{"c.email": "%" + req.Email + "%"})
 	}
 	if req.ExternalID != "" {
-		sb = sb.Where(sq.ILike{"c.external_id": "%" + req.ExternalID + "%"})
+		conditions = append(conditions, sq.ILike{"c.external_id": "%" + req.ExternalID + "%"})
 	}
 	if req.FirstName != "" {
-		sb = sb.Where(sq.ILike{"c.first_name": "%" + req.FirstName + "%"})
+		conditions = append(conditions, sq.ILike{"c.first_name": "%" + req.FirstName + "%"})
 	}
 	if req.LastName != "" {
-		sb = sb.Where(sq.ILike{"c.last_name": "%" + req.LastName + "%"})
+		conditions = append(conditions, sq.ILike{"c.last_name": "%" + req.LastName + "%"})
 	}
 	if req.FullName != "" {
-		sb = sb.Where(sq.ILike{"c.full_name": "%" + req.FullName + "%"})
+		conditions = append(conditions, sq.ILike{"c.full_name": "%" + req.FullName + "%"})
 	}
 	if req.Phone != "" {
-		sb = sb.Where(sq.ILike{"c.phone": "%" + req.Phone + "%"})
+		conditions = append(conditions, sq.ILike{"c.phone": "%" + req.Phone + "%"})
 	}
 	if req.Country != "" {
-		sb = sb.Where(sq.ILike{"c.country": "%" + req.Country + "%"})
+		conditions = append(conditions, sq.ILike{"c.country": "%" + req.Country + "%"})
 	}
 	if req.Language != "" {
-		sb = sb.Where(sq.ILike{"c.language": "%" + req.Language + "%"})
+		conditions = append(conditions, sq.ILike{"c.language": "%" + req.Language + "%"})
 	}
 
 	// Use EXISTS subquery for list_id and contact_list_status filters instead of JOIN
@@ -231,7 +258,7 @@ func (r *contactRepository) GetContacts(ctx context.Context, req *domain.GetCont
 			args = []interface{}{req.ContactListStatus}
 		}
 
-		sb = sb.Where(sq.Expr(existsClause, args...))
+		conditions = append(conditions, sq.Expr(existsClause, args...))
 	}
 
 	// Use EXISTS subquery for segments filter
@@ -253,7 +280,23 @@ func (r *contactRepository) GetContacts(ctx context.Context, req *domain.GetCont
 			args[i] = seg
 		}
 
-		sb = sb.Where(sq.Expr(existsClause, args...))
+		conditions = append(conditions, sq.Expr(existsClause, args...))
+	}
+
+	return conditions
+}
+
+func (r *contactRepository) GetContacts(ctx context.Context, req *domain.GetContactsRequest) (*domain.GetContactsResponse, error) {
+	db, err := r.workspaceRepo.GetConnection(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	sb := psql.Select(contactColumnsWithPrefix("c")...).From("contacts c")
+
+	for _, condition := range contactFilterConditions(req) {
+		sb = sb.Where(condition)
 	}
 
 	if req.Cursor != "" {
@@ -825,6 +868,7 @@ func (r *contactRepository) UpsertContact(ctx context.Context, workspaceID strin
 				"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 				"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
 				"created_at", "updated_at", "db_created_at", "db_updated_at",
+				"attributes",
 			).
 			Values(
 				contact.Email, externalIDSQL, timezoneSQL, languageSQL,
@@ -835,6 +879,7 @@ func (r *contactRepository) UpsertContact(ctx context.Context, workspaceID strin
 				customDatetime1SQL, customDatetime2SQL, customDatetime3SQL, customDatetime4SQL, customDatetime5SQL,
 				customJSON1SQL, customJSON2SQL, customJSON3SQL, customJSON4SQL, customJSON5SQL,
 				createdAtValue.UTC(), updatedAtValue.UTC(), contact.DBCreatedAt, contact.DBUpdatedAt,
+				contactToNullAttributes(contact.Attributes),
 			)
 
 		insertQuery, insertArgs, err := insertBuilder.ToSql()
@@ -846,7 +891,7 @@ func (r *contactRepository) UpsertContact(ctx context.Context, workspaceID strin
 		_, err = tx.ExecContext(ctx, insertQuery, insertArgs...)
 		if err != nil {
 			// Check if the error is a constraint violation or similar if needed
-			return false, fmt.Errorf("failed to insert contact: %w", err)
+			return false, fmt.Errorf("failed to insert contact: %w", wrapErasedContactError(err))
 		}
 
 	} else {
@@ -1100,6 +1145,7 @@ func (r *contactRepository) UpsertContact(ctx context.Context, workspaceID strin
 			"custom_json_3":     customJSON3SQL,
 			"custom_json_4":     customJSON4SQL,
 			"custom_json_5":     customJSON5SQL,
+			"attributes":        contactToNullAttributes(existingContact.Attributes),
 			"db_updated_at":     existingContact.DBUpdatedAt,
 		}
 
@@ -1127,6 +1173,17 @@ func (r *contactRepository) UpsertContact(ctx context.Context, workspaceID strin
 		}
 	}
 
+	// Tags are only ever added on upsert
+	if len(contact.Tags) > 0 {
+		emails := make([]string, len(contact.Tags))
+		for i := range emails {
+			emails[i] = contact.Email
+		}
+		if err := insertContactTags(ctx, tx, emails, contact.Tags); err != nil {
+			return false, err
+		}
+	}
+
 	// Commit the transaction
 	if err := tx.Commit(); err != nil {
 		return false, fmt.Errorf("failed to commit transaction: %w", err)
@@ -1167,6 +1224,77 @@ func contactToNullJSON(n *domain.NullableJSON) sql.NullString {
 	return sql.NullString{Valid: false}
 }
 
+// contactToNullAttributes serializes attributes for storage, dropping keys
+// whose value is nil since those mean "remove" rather than "store null"
+func contactToNullAttributes(attributes domain.MapOfAny) sql.NullString {
+	if len(attributes) == 0 {
+		return sql.NullString{Valid: false}
+	}
+	cleaned := make(domain.MapOfAny, len(attributes))
+	for name, value := range attributes {
+		if value != nil {
+			cleaned[name] = value
+		}
+	}
+	if len(cleaned) == 0 {
+		return sql.NullString{Valid: false}
+	}
+	jsonBytes, err := json.Marshal(cleaned)
+	if err != nil {
+		return sql.NullString{Valid: false}
+	}
+	return sql.NullString{String: string(jsonBytes), Valid: true}
+}
+
+// contactToNullAttributePatch serializes attributes for a merge, keeping nil values as JSON
+// null so the merge can remove those keys
+func contactToNullAttributePatch(attributes domain.MapOfAny) sql.NullString {
+	if len(attributes) == 0 {
+		return sql.NullString{Valid: false}
+	}
+	jsonBytes, err := json.Marshal(attributes)
+	if err != nil {
+		return sql.NullString{Valid: false}
+	}
+	return sql.NullString{String: string(jsonBytes), Valid: true}
+}
+
+// existingContactsRemovingAttributes returns the emails of the already stored contacts whose
+// import removes at least one attribute. New contacts simply drop their null attributes.
+func existingContactsRemovingAttributes(ctx context.Context, tx *sql.Tx, contacts []*domain.Contact) (map[string]bool, error) {
+	var emails []string
+	for _, contact := range contacts {
+		for _, value := range contact.Attributes {
+			if value == nil {
+				emails = append(emails, contact.Email)
+				break
+			}
+		}
+	}
+	if len(emails) == 0 {
+		return nil, nil
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT email FROM contacts WHERE email = ANY($1)`, pq.Array(emails))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find contacts removing attributes: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	existing := make(map[string]bool, len(emails))
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, fmt.Errorf("failed to scan contact email: %w", err)
+		}
+		existing[email] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to find contacts removing attributes: %w", err)
+	}
+	return existing, nil
+}
+
 // BulkUpsertContacts creates or updates multiple contacts in a single database operation
 // It uses PostgreSQL's INSERT ... ON CONFLICT to efficiently handle both inserts and updates
 // Returns per-contact results indicating whether each was inserted (IsNew=true) or updated (IsNew=false)
@@ -1190,10 +1318,17 @@ func (r *contactRepository) BulkUpsertContacts(ctx context.Context, workspaceID
 
 	now := time.Now().UTC()
 
+	// A null attribute in the import removes the key from an existing contact: those rows
+	// carry their null keys into EXCLUDED so the merge below can remove them
+	removingContacts, err := existingContactsRemovingAttributes(ctx, tx, contacts)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build the multi-row INSERT statement
 	// We'll use a raw SQL query because squirrel doesn't handle complex ON CONFLICT well
 	var queryBuilder strings.Builder
-	args := make([]interface{}, 0, len(contacts)*36) // 36 fields per contact (db_created_at and db_updated_at are managed by DB)
+	args := make([]interface{}, 0, len(contacts)*37) // 37 fields per contact (db_created_at and db_updated_at are managed by DB)
 	argIndex := 1
 
 	queryBuilder.WriteString(`INSERT INTO contacts (
@@ -1204,7 +1339,7 @@ func (r *contactRepository) BulkUpsertContacts(ctx context.Context, workspaceID
 		custom_number_1, custom_number_2, custom_number_3, custom_number_4, custom_number_5,
 		custom_datetime_1, custom_datetime_2, custom_datetime_3, custom_datetime_4, custom_datetime_5,
 		custom_json_1, custom_json_2, custom_json_3, custom_json_4, custom_json_5,
-		created_at, updated_at
+		created_at, updated_at, attributes
 	) VALUES `)
 
 	// Add value placeholders for each contact
@@ -1214,8 +1349,8 @@ func (r *contactRepository) BulkUpsertContacts(ctx context.Context, workspaceID
 		}
 		queryBuilder.WriteString("(")
 
-		// Add 36 placeholders for contact fields (excluding db_created_at and db_updated_at)
-		for j := 0; j < 36; j++ {
+		// Add 37 placeholders for contact fields (excluding db_created_at and db_updated_at)
+		for j := 0; j < 37; j++ {
 			if j > 0 {
 				queryBuilder.WriteString(", ")
 			}
@@ -1238,7 +1373,7 @@ func (r *contactRepository) BulkUpsertContacts(ctx context.Context, workspaceID
 		// Add all field values in the correct order
 		// Note: db_created_at and db_updated_at are NOT included - they have DEFAULT CURRENT_TIMESTAMP in the schema
 		args = append(args,
-			contact.Email,                        // 1
+			contact.Email,                               // 1
 			contactToNullString(contact.ExternalID),     // 2
 			contactToNullString(contact.Timezone),       // 3
 			contactToNullString(contact.Language),       // 4
@@ -1272,9 +1407,14 @@ func (r *contactRepository) BulkUpsertContacts(ctx context.Context, workspaceID
 			contactToNullJSON(contact.CustomJSON3),      // 32
 			contactToNullJSON(contact.CustomJSON4),      // 33
 			contactToNullJSON(contact.CustomJSON5),      // 34
-			createdAt,                            // 35 - application-level timestamp
-			updatedAt,                            // 36 - application-level timestamp
+			createdAt,                                   // 35 - application-level timestamp
+			updatedAt,                                   // 36 - application-level timestamp
 		)
+		if removingContacts[contact.Email] {
+			args = append(args, contactToNullAttributePatch(contact.Attributes)) // 37
+		} else {
+			args = append(args, contactToNullAttributes(contact.Attributes)) // 37
+		}
 	}
 
 	// Add ON CONFLICT clause with merge semantics
@@ -1315,6 +1455,10 @@ func (r *contactRepository) BulkUpsertContacts(ctx context.Context, workspaceID
 		custom_json_3 = CASE WHEN EXCLUDED.custom_json_3 IS NOT NULL THEN EXCLUDED.custom_json_3 ELSE contacts.custom_json_3 END,
 		custom_json_4 = CASE WHEN EXCLUDED.custom_json_4 IS NOT NULL THEN EXCLUDED.custom_json_4 ELSE contacts.custom_json_4 END,
 		custom_json_5 = CASE WHEN EXCLUDED.custom_json_5 IS NOT NULL THEN EXCLUDED.custom_json_5 ELSE contacts.custom_json_5 END,
+		attributes = CASE WHEN EXCLUDED.attributes IS NOT NULL THEN NULLIF(
+			(COALESCE(contacts.attributes, '{}'::jsonb) || EXCLUDED.attributes)
+				- ARRAY(SELECT key FROM jsonb_each(EXCLUDED.attributes) WHERE value = 'null'::jsonb),
+			'{}'::jsonb) ELSE contacts.attributes END,
 		created_at = EXCLUDED.created_at,
 		updated_at = EXCLUDED.updated_at,
 		db_updated_at = NOW()
@@ -1325,7 +1469,7 @@ func (r *contactRepository) BulkUpsertContacts(ctx context.Context, workspaceID
 	// Execute the bulk upsert
 	rows, err := tx.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute bulk upsert: %w", err)
+		return nil, fmt.Errorf("failed to execute bulk upsert: %w", wrapErasedContactError(err))
 	}
 	defer func() { _ = rows.Close() }()
 
@@ -1340,7 +1484,20 @@ func (r *contactRepository) BulkUpsertContacts(ctx context.Context, workspaceID
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating results: %w", err)
+		return nil, fmt.Errorf("error iterating results: %w", wrapErasedContactError(err))
+	}
+	_ = rows.Close()
+
+	// Tags are only ever added on upsert
+	var tagEmails, tags []string
+	for _, contact := range contacts {
+		for _, tag := range contact.Tags {
+			tagEmails = append(tagEmails, contact.Email)
+			tags = append(tags, tag)
+		}
+	}
+	if err := insertContactTags(ctx, tx, tagEmails, tags); err != nil {
+		return nil, err
 	}
 
 	// Commit the transaction
@@ -1351,6 +1508,74 @@ func (r *contactRepository) BulkUpsertContacts(ctx context.Context, workspaceID
 	return results, nil
 }
 
+// nonOpenersOfSQL matches the contacts who received the broadcast without opening or clicking
+// it, and did not bounce, complain or unsubscribe. A contact with several messages for the
+// broadcast (A/B test and winner) matches only if none of them shows engagement.
+func nonOpenersOfSQL(emailExpr string, broadcastID string) sq.Sqlizer {
+	return sq.Expr(fmt.Sprintf(`EXISTS (
+		SELECT 1 FROM message_history mh
+		WHERE mh.contact_email = %[1]s AND mh.broadcast_id = ? AND mh.failed_at IS NULL
+	) AND NOT EXISTS (
+		SELECT 1 FROM message_history mh
+		WHERE mh.contact_email = %[1]s AND mh.broadcast_id = ?
+		AND (mh.opened_at IS NOT NULL OR mh.clicked_at IS NOT NULL OR mh.bounced_at IS NOT NULL
+			OR mh.complained_at IS NOT NULL OR mh.unsubscribed_at IS NOT NULL)
+	)`, emailExpr), broadcastID, broadcastID)
+}
+
+// audienceExclusionsSQL matches the contacts that are not removed by the exclusions of the
+// audience: members of an excluded list (whatever their status), contacts of an excluded
+// segment and contacts sent an email within the look-back window
+func audienceExclusionsSQL(emailExpr string, audience domain.AudienceSettings, now time.Time) sq.Sqlizer {
+	conditions := sq.And{}
+
+	if len(audience.ExcludeLists) > 0 {
+		conditions = append(conditions, sq.Expr(fmt.Sprintf(`NOT EXISTS (
+		SELECT 1 FROM contact_lists xcl
+		WHERE xcl.email = %s AND xcl.list_id = ANY(?) AND xcl.deleted_at IS NULL
+	)`, emailExpr), pq.Array(audience.ExcludeLists)))
+	}
+
+	if len(audience.ExcludeSegments) > 0 {
+		conditions = append(conditions, sq.Expr(fmt.Sprintf(`NOT EXISTS (
+		SELECT 1 FROM contact_segments xcs
+		WHERE xcs.email = %s AND xcs.segment_id = ANY(?)
+	)`, emailExpr), pq.Array(audience.ExcludeSegments)))
+	}
+
+	if audience.ExcludeEmailedWithinDays > 0 {
+		since := now.AddDate(0, 0, -audience.ExcludeEmailedWithinDays)
+		if audience.NonOpenersOf != "" {
+			// Every non-opener got the original broadcast, which must not exclude them from its follow-up
+			conditions = append(conditions, sq.Expr(fmt.Sprintf(`NOT EXISTS (
+		SELECT 1 FROM message_history xmh
+		WHERE xmh.contact_email = %s AND xmh.channel = 'email' AND xmh.sent_at >= ?
+		AND xmh.broadcast_id IS DISTINCT FROM ?
+	)`, emailExpr), since, audience.NonOpenersOf))
+		} else {
+			conditions = append(conditions, sq.Expr(fmt.Sprintf(`NOT EXISTS (
+		SELECT 1 FROM message_history xmh
+		WHERE xmh.contact_email = %s AND xmh.channel = 'email' AND xmh.sent_at >= ?
+	)`, emailExpr), since))
+		}
+	}
+
+	return conditions
+}
+
+// holdoutSQL matches the contacts held out (inHoldout) or not held out of the audience. The
+// bucket expression matches domain.HoldoutBucket so the assignment is the same in SQL and Go.
+func holdoutSQL(emailExpr string, holdout *domain.HoldoutSettings, inHoldout bool) sq.Sqlizer {
+	operator := ">="
+	if inHoldout {
+		operator = "<"
+	}
+	return sq.Expr(fmt.Sprintf(
+		"('x' || substr(md5(? || ':' || %s), 1, 8))::bit(32)::bigint %% 10000 %s ?",
+		emailExpr, operator,
+	), holdout.Seed, holdout.Threshold())
+}
+
 // GetContactsForBroadcast retrieves contacts based on broadcast audience settings
 // It supports filtering by lists, handling unsubscribed contacts, and deduplication
 // Uses cursor-based pagination with afterEmail for deterministic ordering (fixes Issue #157)
@@ -1437,6 +1662,24 @@ func (r *contactRepository) GetContactsForBroadcast(
 		}
 	}
 
+	// Restrict a resend to the recipients who did not open the original broadcast
+	if audience.NonOpenersOf != "" {
+		query = query.Where(nonOpenersOfSQL("c.email", audience.NonOpenersOf))
+	}
+
+	// Remove the contacts of the excluded lists and segments, and those emailed recently
+	if audience.HasExclusions() {
+		query = query.Where(audienceExclusionsSQL("c.email", audience, time.Now().UTC()))
+	}
+
+	// Never target the contacts held out of the broadcast
+	if audience.Holdout.IsEnabled() {
+		query = query.Where(holdoutSQL("c.email", audience.Holdout, false))
+	}
+
+	// Never target addresses on the suppression list
+	query = query.Where(notSuppressedSQL("c.email"))
+
 	// Build the final query
 	sqlQuery, args, err := query.ToSql()
 	if err != nil {
@@ -1470,6 +1713,7 @@ func (r *contactRepository) GetContactsForBroadcast(
 			var customDatetime1, customDatetime2, customDatetime3, customDatetime4, customDatetime5 sql.NullTime
 			var customJSON1, customJSON2, customJSON3, customJSON4, customJSON5 sql.NullString
 			var createdAt, updatedAt, dbCreatedAt, dbUpdatedAt time.Time
+			var attributes []byte
 
 			// Scan all columns including contact fields + list_id + list_name
 			scanErr = rows.Scan(
@@ -1480,7 +1724,7 @@ func (r *contactRepository) GetContactsForBroadcast(
 				&customNumber1, &customNumber2, &customNumber3, &customNumber4, &customNumber5,
 				&customDatetime1, &customDatetime2, &customDatetime3, &customDatetime4, &customDatetime5,
 				&customJSON1, &customJSON2, &customJSON3, &customJSON4, &customJSON5,
-				&createdAt, &updatedAt, &dbCreatedAt, &dbUpdatedAt,
+				&createdAt, &updatedAt, &dbCreatedAt, &dbUpdatedAt, &attributes,
 				&listID, &listName, // Additional columns
 			)
 			if scanErr != nil {
@@ -1612,6 +1856,12 @@ func (r *contactRepository) GetContactsForBroadcast(
 					contact.CustomJSON5 = &domain.NullableJSON{Data: jsonData, IsNull: false}
 				}
 			}
+			if len(attributes) > 0 && string(attributes) != "null" {
+				var attributesData domain.MapOfAny
+				if err := json.Unmarshal(attributes, &attributesData); err == nil && len(attributesData) > 0 {
+					contact.Attributes = attributesData
+				}
+			}
 		} else {
 			// No list ID to scan, just get the contact using the existing ScanContact function
 			contact, scanErr = domain.ScanContact(rows)
@@ -1648,10 +1898,76 @@ func (r *contactRepository) CountContactsForBroadcast(
 		return 0, fmt.Errorf("failed to get workspace connection: %w", err)
 	}
 
+	query := broadcastAudienceQuery(audience, "COUNT(*)")
+
+	// Do not count the contacts held out of the broadcast (matches GetContactsForBroadcast)
+	if audience.Holdout.IsEnabled() {
+		query = query.Where(holdoutSQL("c.email", audience.Holdout, false))
+	}
+
+	// Build and execute the query
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build count query: %w", err)
+	}
+
+	var count int
+	err = db.QueryRowContext(ctx, sqlQuery, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute count query: %w", err)
+	}
+
+	return count, nil
+}
+
+// SaveBroadcastHoldout records the contacts of the audience held out of a broadcast
+func (r *contactRepository) SaveBroadcastHoldout(
+	ctx context.Context,
+	workspaceID string,
+	broadcastID string,
+	audience domain.AudienceSettings,
+) (int, error) {
+	if !audience.Holdout.IsEnabled() {
+		return 0, nil
+	}
+
+	db, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	selectQuery := broadcastAudienceQuery(audience, "CAST(? AS VARCHAR)", "c.email", "NOW()").
+		Where(holdoutSQL("c.email", audience.Holdout, true))
+
+	selectSQL, selectArgs, err := selectQuery.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build holdout query: %w", err)
+	}
+
+	// The broadcast id placeholder of the select list comes first
+	args := append([]interface{}{broadcastID}, selectArgs...)
+	query := `INSERT INTO broadcast_holdout_contacts (broadcast_id, email, assigned_at) ` + selectSQL +
+		` ON CONFLICT (broadcast_id, email) DO NOTHING`
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to save broadcast holdout: %w", err)
+	}
+
+	saved, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(saved), nil
+}
+
+// broadcastAudienceQuery selects the given columns from the contacts matching the audience
+// settings, before the holdout is applied
+func broadcastAudienceQuery(audience domain.AudienceSettings, columns ...string) sq.SelectBuilder {
 	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
-	// Start building the count query
-	query := psql.Select("COUNT(*)").
+	query := psql.Select(columns...).
 		From("contacts c")
 
 	// Handle list filtering
@@ -1684,26 +2000,25 @@ func (r *contactRepository) CountContactsForBroadcast(
 			query = query.Where(sq.Eq{"cs.segment_id": audience.Segments})
 		} else {
 			// No list filtering, so we're filtering by segments only
-			query = psql.Select("COUNT(*)").
+			query = psql.Select(columns...).
 				From("contacts c").
 				Join("contact_segments cs ON c.email = cs.email").
 				Where(sq.Eq{"cs.segment_id": audience.Segments})
 		}
 	}
 
-	// Build and execute the query
-	sqlQuery, args, err := query.ToSql()
-	if err != nil {
-		return 0, fmt.Errorf("failed to build count query: %w", err)
+	// Restrict a resend to the recipients who did not open the original broadcast (matches GetContactsForBroadcast)
+	if audience.NonOpenersOf != "" {
+		query = query.Where(nonOpenersOfSQL("c.email", audience.NonOpenersOf))
 	}
 
-	var count int
-	err = db.QueryRowContext(ctx, sqlQuery, args...).Scan(&count)
-	if err != nil {
-		return 0, fmt.Errorf("failed to execute count query: %w", err)
+	// Remove the excluded contacts (matches GetContactsForBroadcast)
+	if audience.HasExclusions() {
+		query = query.Where(audienceExclusionsSQL("c.email", audience, time.Now().UTC()))
 	}
 
-	return count, nil
+	// Never target addresses on the suppression list (matches GetContactsForBroadcast)
+	return query.Where(notSuppressedSQL("c.email"))
 }
 
 // Count returns the total number of contacts in a workspace
@@ -1772,6 +2087,7 @@ func (r *contactRepository) GetBatchForSegment(ctx context.Context, workspaceID
 // given email is on, except rows already in a terminal status ('complained' or
 // 'bounced') or soft-deleted. The track_contact_list_changes trigger emits the
 // matching list.bounced timeline rows for transitions.
+// An email that is a former address of a contact resolves to its current one.
 func (r *contactRepository) MarkEmailsAsBounced(ctx context.Context, workspaceID string, emails []string, at time.Time) error {
 	if len(emails) == 0 {
 		return nil
@@ -1786,7 +2102,10 @@ func (r *contactRepository) MarkEmailsAsBounced(ctx context.Context, workspaceID
 UPDATE contact_lists
    SET status = 'bounced',
        updated_at = $2
- WHERE email = ANY($1)
+ WHERE (email = ANY($1) OR email IN (
+         SELECT a.email FROM contact_email_aliases a
+          WHERE a.alias = ANY($1)
+            AND NOT EXISTS (SELECT 1 FROM contacts c WHERE c.email = a.alias)))
    AND status NOT IN ('complained', 'bounced')
    AND deleted_at IS NULL`
 
@@ -1796,3 +2115,69 @@ UPDATE contact_lists
 
 	return nil
 }
+
+// MigrateCustomFieldsToAttributes copies legacy custom_* columns into
+// contacts.attributes. Only contacts with at least one mapped column set are
+// touched, and existing attribute values are overwritten by the legacy ones.
+func (r *contactRepository) MigrateCustomFieldsToAttributes(ctx context.Context, workspaceID string, mapping map[string]string, clearLegacyFields bool) (int64, error) {
+	if len(mapping) == 0 {
+		return 0, nil
+	}
+
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	// Sort the legacy columns so the generated SQL is deterministic
+	fields := make([]string, 0, len(mapping))
+	for field := range mapping {
+		if _, ok := domain.LegacyCustomFieldTypes[field]; !ok {
+			return 0, fmt.Errorf("invalid custom field key: %s", field)
+		}
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	// Column names come from the whitelist above; attribute names are bound as parameters
+	pairs := make([]string, 0, len(fields))
+	conditions := make([]string, 0, len(fields))
+	sets := make([]string, 0, len(fields)+1)
+	args := make([]interface{}, 0, len(fields))
+	for i, field := range fields {
+		value := field
+		if domain.LegacyCustomFieldTypes[field] == domain.ContactAttributeTypeDatetime {
+			// Store datetimes the same way ContactAttributeDefinition.ValidateValue does
+			value = fmt.Sprintf(`to_char(%s AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"')`, field)
+		}
+		pairs = append(pairs, fmt.Sprintf("$%d::text, %s", i+1, value))
+		conditions = append(conditions, field+" IS NOT NULL")
+		args = append(args, mapping[field])
+		if clearLegacyFields {
+			sets = append(sets, field+" = NULL")
+		}
+	}
+
+	sets = append([]string{fmt.Sprintf(
+		"attributes = COALESCE(attributes, '{}'::jsonb) || jsonb_strip_nulls(jsonb_build_object(%s))",
+		strings.Join(pairs, ", "),
+	)}, sets...)
+	sets = append(sets, "db_updated_at = NOW()")
+
+	query := fmt.Sprintf("UPDATE contacts SET %s WHERE %s",
+		strings.Join(sets, ", "),
+		strings.Join(conditions, " OR "),
+	)
+
+	result, err := workspaceDB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to migrate custom fields to attributes: %w", err)
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	return updated, nil
+}
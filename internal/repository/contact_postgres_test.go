@@ -14,6 +14,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -23,7 +24,7 @@ import (
 
 // contactColumnsPattern is the regex pattern for matching explicit contact columns in queries.
 // This matches the contactColumnsWithPrefix("c") output in contact_postgres.go.
-const contactColumnsPattern = `c\.email, c\.external_id, c\.timezone, c\.language, c\.first_name, c\.last_name, c\.full_name, c\.phone, c\.address_line_1, c\.address_line_2, c\.country, c\.postcode, c\.state, c\.job_title, c\.custom_string_1, c\.custom_string_2, c\.custom_string_3, c\.custom_string_4, c\.custom_string_5, c\.custom_number_1, c\.custom_number_2, c\.custom_number_3, c\.custom_number_4, c\.custom_number_5, c\.custom_datetime_1, c\.custom_datetime_2, c\.custom_datetime_3, c\.custom_datetime_4, c\.custom_datetime_5, c\.custom_json_1, c\.custom_json_2, c\.custom_json_3, c\.custom_json_4, c\.custom_json_5, c\.created_at, c\.updated_at, c\.db_created_at, c\.db_updated_at`
+const contactColumnsPattern = `c\.email, c\.external_id, c\.timezone, c\.language, c\.first_name, c\.last_name, c\.full_name, c\.phone, c\.address_line_1, c\.address_line_2, c\.country, c\.postcode, c\.state, c\.job_title, c\.custom_string_1, c\.custom_string_2, c\.custom_string_3, c\.custom_string_4, c\.custom_string_5, c\.custom_number_1, c\.custom_number_2, c\.custom_number_3, c\.custom_number_4, c\.custom_number_5, c\.custom_datetime_1, c\.custom_datetime_2, c\.custom_datetime_3, c\.custom_datetime_4, c\.custom_datetime_5, c\.custom_json_1, c\.custom_json_2, c\.custom_json_3, c\.custom_json_4, c\.custom_json_5, c\.created_at, c\.updated_at, c\.db_created_at, c\.db_updated_at, c\.attributes`
 
 // setupMockDB creates a mock database and sqlmock for testing
 func setupMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, func()) {
@@ -60,7 +61,7 @@ func TestGetContactByEmail(t *testing.T) {
 		"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 		"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 		"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-		"created_at", "updated_at", "db_created_at", "db_updated_at",
+		"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 	}).
 		AddRow(
 			email, "ext123", "Europe/Paris", "en-US",
@@ -70,7 +71,7 @@ func TestGetContactByEmail(t *testing.T) {
 			42.0, 43.0, 44.0, 45.0, 46.0,
 			now, now, now, now, now,
 			[]byte(`{"key": "value1"}`), []byte(`{"key": "value2"}`), []byte(`{"key": "value3"}`), []byte(`{"key": "value4"}`), []byte(`{"key": "value5"}`),
-			now, now, now, now,
+			now, now, now, now, nil,
 		)
 
 	mock.ExpectQuery(`SELECT ` + contactColumnsPattern + ` FROM contacts c WHERE c.email = \$1`).
@@ -99,9 +100,14 @@ func TestGetContactByEmail(t *testing.T) {
 		WithArgs(email).
 		WillReturnRows(segmentRows)
 
+	mock.ExpectQuery(`SELECT ct\.tag FROM contact_tags ct WHERE ct\.email = \$1 ORDER BY ct\.tag`).
+		WithArgs(email).
+		WillReturnRows(sqlmock.NewRows([]string{"tag"}).AddRow("beta").AddRow("vip"))
+
 	contact, err := repo.GetContactByEmail(context.Background(), "workspace123", email)
 	require.NoError(t, err)
 	assert.Equal(t, email, contact.Email)
+	assert.Equal(t, []string{"beta", "vip"}, contact.Tags)
 	assert.Len(t, contact.ContactLists, 1)
 	assert.Equal(t, "list1", contact.ContactLists[0].ListID)
 	assert.Equal(t, "Marketing List", contact.ContactLists[0].ListName)
@@ -143,7 +149,7 @@ func TestGetContactByExternalID(t *testing.T) {
 		"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 		"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 		"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-		"created_at", "updated_at", "db_created_at", "db_updated_at",
+		"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 	}).
 		AddRow(
 			email, externalID, "Europe/Paris", "en-US",
@@ -153,7 +159,7 @@ func TestGetContactByExternalID(t *testing.T) {
 			42.0, 43.0, 44.0, 45.0, 46.0,
 			now, now, now, now, now,
 			[]byte(`{"key": "value1"}`), []byte(`{"key": "value2"}`), []byte(`{"key": "value3"}`), []byte(`{"key": "value4"}`), []byte(`{"key": "value5"}`),
-			now, now, now, now,
+			now, now, now, now, nil,
 		)
 
 	mock.ExpectQuery(`SELECT ` + contactColumnsPattern + ` FROM contacts c WHERE c.external_id = \$1`).
@@ -182,6 +188,10 @@ func TestGetContactByExternalID(t *testing.T) {
 		WithArgs(email).
 		WillReturnRows(segmentRows)
 
+	mock.ExpectQuery(`SELECT ct\.tag FROM contact_tags ct WHERE ct\.email = \$1 ORDER BY ct\.tag`).
+		WithArgs(email).
+		WillReturnRows(sqlmock.NewRows([]string{"tag"}))
+
 	contact, err := repo.GetContactByExternalID(context.Background(), "workspace123", externalID)
 	require.NoError(t, err)
 	assert.Equal(t, email, contact.Email)
@@ -215,12 +225,12 @@ func TestGetContactByExternalID(t *testing.T) {
 			"custom_number_4", "custom_number_5", "custom_datetime_1", "custom_datetime_2",
 			"custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-			"created_at", "updated_at", "db_created_at", "db_updated_at",
+			"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).AddRow(
 			email, "e-123", "Europe/Paris", "en-US", "John", "Doe", "John Doe", "", "", "", "", "", "", "",
 			"", "", "", "", "", 0, 0, 0, 0, 0, time.Time{}, time.Time{}, time.Time{}, time.Time{}, time.Time{},
 			[]byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"), []byte("{}"),
-			time.Now(), time.Now(), time.Now(), time.Now(),
+			time.Now(), time.Now(), time.Now(), time.Now(), nil,
 		)
 
 		mock.ExpectQuery(`SELECT ` + contactColumnsPattern + ` FROM contacts c WHERE c.external_id = \$1`).
@@ -245,6 +255,10 @@ func TestGetContactByExternalID(t *testing.T) {
 			WithArgs(email).
 			WillReturnRows(segmentRows)
 
+		mock.ExpectQuery(`SELECT ct\.tag FROM contact_tags ct WHERE ct\.email = \$1 ORDER BY ct\.tag`).
+			WithArgs(email).
+			WillReturnRows(sqlmock.NewRows([]string{"tag"}))
+
 		// Act
 		contact, err := repo.GetContactByExternalID(context.Background(), "workspace123", externalID)
 
@@ -284,7 +298,7 @@ func TestFetchContact(t *testing.T) {
 			"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 			"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-			"created_at", "updated_at", "db_created_at", "db_updated_at",
+			"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).
 			AddRow(
 				email, "ext123", "Europe/Paris", "en-US",
@@ -294,7 +308,7 @@ func TestFetchContact(t *testing.T) {
 				42.0, 43.0, 44.0, 45.0, 46.0,
 				now, now, now, now, now,
 				[]byte(`{"key": "value1"}`), []byte(`{"key": "value2"}`), []byte(`{"key": "value3"}`), []byte(`{"key": "value4"}`), []byte(`{"key": "value5"}`),
-				now, now, now, now,
+				now, now, now, now, nil,
 			)
 
 		phone := "+1234567890"
@@ -328,6 +342,10 @@ func TestFetchContact(t *testing.T) {
 			WithArgs(email).
 			WillReturnRows(segmentRows)
 
+		mock.ExpectQuery(`SELECT ct\.tag FROM contact_tags ct WHERE ct\.email = \$1 ORDER BY ct\.tag`).
+			WithArgs(email).
+			WillReturnRows(sqlmock.NewRows([]string{"tag"}))
+
 		// Use the private method directly for testing
 		contact, err := repo.(*contactRepository).fetchContact(context.Background(), "workspace123", sq.Eq{"c.phone": phone})
 		require.NoError(t, err)
@@ -352,7 +370,7 @@ func TestFetchContact(t *testing.T) {
 			"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 			"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-			"created_at", "updated_at", "db_created_at", "db_updated_at",
+			"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).
 			AddRow(
 				email, "ext123", "Europe/Paris", "en-US",
@@ -362,7 +380,7 @@ func TestFetchContact(t *testing.T) {
 				42.0, 43.0, 44.0, 45.0, 46.0,
 				now, now, now, now, now,
 				[]byte(`{"key": "value1"}`), []byte(`{"key": "value2"}`), []byte(`{"key": "value3"}`), []byte(`{"key": "value4"}`), []byte(`{"key": "value5"}`),
-				now, now, now, now,
+				now, now, now, now, nil,
 			)
 
 		mock.ExpectQuery(`SELECT ` + contactColumnsPattern + ` FROM contacts c WHERE c.email = \$1`).
@@ -405,7 +423,7 @@ func TestGetContacts(t *testing.T) {
 			"custom_number_4", "custom_number_5", "custom_datetime_1", "custom_datetime_2",
 			"custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4",
-			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at",
+			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).AddRow(
 			"test@example.com", "ext123", "UTC", "en", "John", "Doe", "John Doe",
 			"+1234567890", "123 Main St", "Apt 4B", "US", "12345", "CA",
@@ -415,7 +433,7 @@ func TestGetContacts(t *testing.T) {
 			time.Now(), time.Now(), time.Now(), time.Now(), time.Now(),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
-			time.Now(), time.Now(), time.Now(), time.Now(),
+			time.Now(), time.Now(), time.Now(), time.Now(), nil,
 		)
 
 		mock.ExpectQuery(`SELECT ` + contactColumnsPattern + ` FROM contacts c ORDER BY c\.created_at DESC, c\.email ASC LIMIT 11`).
@@ -486,7 +504,7 @@ func TestGetContacts(t *testing.T) {
 			"custom_number_4", "custom_number_5", "custom_datetime_1", "custom_datetime_2",
 			"custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4",
-			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at",
+			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).AddRow(
 			"test@example.com", "ext123", "UTC", "en", "John", "Doe", "John Doe",
 			"+1234567890", "123 Main St", "Apt 4B", "US", "12345", "CA",
@@ -496,7 +514,7 @@ func TestGetContacts(t *testing.T) {
 			time.Now(), time.Now(), time.Now(), time.Now(), time.Now(),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
-			time.Now(), time.Now(), time.Now(), time.Now(),
+			time.Now(), time.Now(), time.Now(), time.Now(), nil,
 		)
 
 		mock.ExpectQuery(`SELECT `+contactColumnsPattern+` FROM contacts c WHERE c\.email ILIKE \$1 AND c\.first_name ILIKE \$2 AND c\.country ILIKE \$3 ORDER BY c\.created_at DESC, c\.email ASC LIMIT 11`).
@@ -568,7 +586,7 @@ func TestGetContacts(t *testing.T) {
 			"custom_number_4", "custom_number_5", "custom_datetime_1", "custom_datetime_2",
 			"custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4",
-			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at",
+			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		})
 
 		// Add multiple contacts to ensure pagination works
@@ -583,7 +601,7 @@ func TestGetContacts(t *testing.T) {
 				now, now, now, now, now,
 				[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
 				[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
-				now.Add(time.Duration(-i)*time.Hour), now, now.Add(time.Duration(-i)*time.Hour), now, // Use decreasing created_at times
+				now.Add(time.Duration(-i)*time.Hour), now, now.Add(time.Duration(-i)*time.Hour), now, nil, // Use decreasing created_at times
 			)
 		}
 
@@ -814,7 +832,7 @@ func TestGetContacts(t *testing.T) {
 			"custom_number_4", "custom_number_5", "custom_datetime_1", "custom_datetime_2",
 			"custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4",
-			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at",
+			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).AddRow(
 			"test@example.com", "ext123", "UTC", "en", "John", "Doe", "John Doe",
 			"+1234567890", "123 Main St", "Apt 4B", "US", "12345", "CA",
@@ -824,7 +842,7 @@ func TestGetContacts(t *testing.T) {
 			time.Now(), time.Now(), time.Now(), time.Now(), time.Now(),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
-			time.Now(), time.Now(), time.Now(), time.Now(),
+			time.Now(), time.Now(), time.Now(), time.Now(), nil,
 		)
 
 		mock.ExpectQuery(`SELECT `+contactColumnsPattern+` FROM contacts c WHERE c\.email ILIKE \$1 AND c\.external_id ILIKE \$2 AND c\.first_name ILIKE \$3 AND c\.last_name ILIKE \$4 AND c\.phone ILIKE \$5 AND c\.country ILIKE \$6 ORDER BY c\.created_at DESC, c\.email ASC LIMIT 11`).
@@ -928,7 +946,7 @@ func TestGetContacts(t *testing.T) {
 			"custom_number_4", "custom_number_5", "custom_datetime_1", "custom_datetime_2",
 			"custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4",
-			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at",
+			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).AddRow(
 			"test@example.com", "ext123", "UTC", "en", "John", "Doe", "John Doe",
 			"+1234567890", "123 Main St", "Apt 4B", "US", "12345", "CA",
@@ -938,7 +956,7 @@ func TestGetContacts(t *testing.T) {
 			time.Now(), time.Now(), time.Now(), time.Now(), time.Now(),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
-			time.Now(), time.Now(), time.Now(), time.Now(),
+			time.Now(), time.Now(), time.Now(), time.Now(), nil,
 		)
 
 		// Match the query using a regex pattern that includes the EXISTS subquery
@@ -1008,7 +1026,7 @@ func TestGetContacts(t *testing.T) {
 			"custom_number_4", "custom_number_5", "custom_datetime_1", "custom_datetime_2",
 			"custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4",
-			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at",
+			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).AddRow(
 			"test@example.com", "ext123", "UTC", "en", "John", "Doe", "John Doe",
 			"+1234567890", "123 Main St", "Apt 4B", "US", "12345", "CA",
@@ -1018,7 +1036,7 @@ func TestGetContacts(t *testing.T) {
 			time.Now(), time.Now(), time.Now(), time.Now(), time.Now(),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
-			time.Now(), time.Now(), time.Now(), time.Now(),
+			time.Now(), time.Now(), time.Now(), time.Now(), nil,
 		)
 
 		// Match the query using a regex pattern that includes the EXISTS subquery
@@ -1088,7 +1106,7 @@ func TestGetContacts(t *testing.T) {
 			"custom_number_4", "custom_number_5", "custom_datetime_1", "custom_datetime_2",
 			"custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4",
-			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at",
+			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).AddRow(
 			"test@example.com", "ext123", "UTC", "en", "John", "Doe", "John Doe",
 			"+1234567890", "123 Main St", "Apt 4B", "US", "12345", "CA",
@@ -1098,7 +1116,7 @@ func TestGetContacts(t *testing.T) {
 			time.Now(), time.Now(), time.Now(), time.Now(), time.Now(),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
-			time.Now(), time.Now(), time.Now(), time.Now(),
+			time.Now(), time.Now(), time.Now(), time.Now(), nil,
 		)
 
 		// Match the query using a regex pattern that includes the EXISTS subquery with both list_id and status filters
@@ -1167,7 +1185,7 @@ func TestGetContacts(t *testing.T) {
 			"custom_number_4", "custom_number_5", "custom_datetime_1", "custom_datetime_2",
 			"custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4",
-			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at",
+			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).AddRow(
 			"test@example.com", "ext123", "UTC", "en", "John", "Doe", "John Doe",
 			"+1234567890", "123 Main St", "Apt 4B", "US", "12345", "CA",
@@ -1177,7 +1195,7 @@ func TestGetContacts(t *testing.T) {
 			time.Now(), time.Now(), time.Now(), time.Now(), time.Now(),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
-			time.Now(), time.Now(), time.Now(), time.Now(),
+			time.Now(), time.Now(), time.Now(), time.Now(), nil,
 		)
 
 		// Match the query using a regex pattern that includes the EXISTS subquery for segments
@@ -1257,7 +1275,7 @@ func TestGetContacts(t *testing.T) {
 			"custom_number_4", "custom_number_5", "custom_datetime_1", "custom_datetime_2",
 			"custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4",
-			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at",
+			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).AddRow(
 			"test@example.com", "ext123", "UTC", "en", "John", "Doe", "John Doe",
 			"+1234567890", "123 Main St", "Apt 4B", "US", "12345", "CA",
@@ -1267,7 +1285,7 @@ func TestGetContacts(t *testing.T) {
 			time.Now(), time.Now(), time.Now(), time.Now(), time.Now(),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
 			[]byte(`{"key": "value"}`), []byte(`{"key": "value"}`),
-			time.Now(), time.Now(), time.Now(), time.Now(),
+			time.Now(), time.Now(), time.Now(), time.Now(), nil,
 		)
 
 		// Match the query using a regex pattern that includes the EXISTS subquery for a single segment
@@ -1343,7 +1361,7 @@ func TestGetContactsForBroadcast(t *testing.T) {
 			"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 			"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4",
-			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at",
+			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 			"list_id", "list_name", // Additional columns for list filtering (makes it 42 total)
 		}).
 			AddRow(
@@ -1354,7 +1372,7 @@ func TestGetContactsForBroadcast(t *testing.T) {
 				42.0, 43.0, 44.0, 45.0, 46.0,
 				now, now, now, now, now,
 				[]byte(`{"key": "value1"}`), []byte(`{"key": "value2"}`), []byte(`{"key": "value3"}`), []byte(`{"key": "value4"}`), []byte(`{"key": "value5"}`),
-				now, now, now, now,
+				now, now, now, now, nil,
 				"list1", "Marketing List", // Additional values for list filtering
 			).
 			AddRow(
@@ -1365,12 +1383,12 @@ func TestGetContactsForBroadcast(t *testing.T) {
 				52.0, 53.0, 54.0, 55.0, 56.0,
 				now, now, now, now, now,
 				[]byte(`{"key": "value1-2"}`), []byte(`{"key": "value2-2"}`), []byte(`{"key": "value3-2"}`), []byte(`{"key": "value4-2"}`), []byte(`{"key": "value5-2"}`),
-				now, now, now, now,
+				now, now, now, now, nil,
 				"list1", "Marketing List", // Additional values for list filtering - same list
 			)
 
 			// Expect query with JOINS for list filtering and excludeUnsubscribed (cursor-based pagination)
-		mock.ExpectQuery(`SELECT `+contactColumnsPattern+`, cl\.list_id, l\.name as list_name FROM contacts c JOIN contact_lists cl ON c\.email = cl\.email JOIN lists l ON cl\.list_id = l\.id WHERE cl\.list_id = \$1 AND l\.deleted_at IS NULL AND cl\.status <> \$2 AND cl\.status <> \$3 AND cl\.status <> \$4 ORDER BY c\.email ASC LIMIT 10`).
+		mock.ExpectQuery(`SELECT `+contactColumnsPattern+`, cl\.list_id, l\.name as list_name FROM contacts c JOIN contact_lists cl ON c\.email = cl\.email JOIN lists l ON cl\.list_id = l\.id WHERE cl\.list_id = \$1 AND l\.deleted_at IS NULL AND cl\.status <> \$2 AND cl\.status <> \$3 AND cl\.status <> \$4 AND NOT EXISTS \(SELECT 1 FROM suppressions s WHERE .*\) ORDER BY c\.email ASC LIMIT 10`).
 			WithArgs("list1",
 				domain.ContactListStatusUnsubscribed,
 				domain.ContactListStatusBounced,
@@ -1425,7 +1443,7 @@ func TestGetContactsForBroadcast(t *testing.T) {
 			"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 			"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4",
-			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at",
+			"custom_json_5", "created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).
 			AddRow(
 				"test1@example.com", "ext123", "Europe/Paris", "en-US",
@@ -1435,7 +1453,7 @@ func TestGetContactsForBroadcast(t *testing.T) {
 				42.0, 43.0, 44.0, 45.0, 46.0,
 				now, now, now, now, now,
 				[]byte(`{"key": "value1"}`), []byte(`{"key": "value2"}`), []byte(`{"key": "value3"}`), []byte(`{"key": "value4"}`), []byte(`{"key": "value5"}`),
-				now, now, now, now,
+				now, now, now, now, nil,
 			).
 			AddRow(
 				"test2@example.com", "ext456", "America/New_York", "en-US",
@@ -1445,11 +1463,11 @@ func TestGetContactsForBroadcast(t *testing.T) {
 				52.0, 53.0, 54.0, 55.0, 56.0,
 				now, now, now, now, now,
 				[]byte(`{"key": "value1-2"}`), []byte(`{"key": "value2-2"}`), []byte(`{"key": "value3-2"}`), []byte(`{"key": "value4-2"}`), []byte(`{"key": "value5-2"}`),
-				now, now, now, now,
+				now, now, now, now, nil,
 			)
 
 		// Expect query without JOINS for all contacts (cursor-based pagination)
-		mock.ExpectQuery(`SELECT ` + contactColumnsPattern + ` FROM contacts c ORDER BY c\.email ASC LIMIT 10`).
+		mock.ExpectQuery(`SELECT ` + contactColumnsPattern + ` FROM contacts c WHERE NOT EXISTS \(SELECT 1 FROM suppressions s WHERE .*\) ORDER BY c\.email ASC LIMIT 10`).
 			WillReturnRows(rows)
 
 		// Call the method being tested (empty string for first batch cursor)
@@ -1517,7 +1535,7 @@ func TestGetContactsForBroadcast(t *testing.T) {
 		}
 
 		// Expect query with error (cursor-based pagination)
-		mock.ExpectQuery(`SELECT `+contactColumnsPattern+`, cl\.list_id, l\.name as list_name FROM contacts c JOIN contact_lists cl ON c\.email = cl\.email JOIN lists l ON cl\.list_id = l\.id WHERE cl\.list_id = \$1 AND l\.deleted_at IS NULL AND cl\.status <> \$2 AND cl\.status <> \$3 AND cl\.status <> \$4 ORDER BY c\.email ASC LIMIT 10`).
+		mock.ExpectQuery(`SELECT `+contactColumnsPattern+`, cl\.list_id, l\.name as list_name FROM contacts c JOIN contact_lists cl ON c\.email = cl\.email JOIN lists l ON cl\.list_id = l\.id WHERE cl\.list_id = \$1 AND l\.deleted_at IS NULL AND cl\.status <> \$2 AND cl\.status <> \$3 AND cl\.status <> \$4 AND NOT EXISTS \(SELECT 1 FROM suppressions s WHERE .*\) ORDER BY c\.email ASC LIMIT 10`).
 			WithArgs("list1",
 				domain.ContactListStatusUnsubscribed,
 				domain.ContactListStatusBounced,
@@ -1564,17 +1582,17 @@ func TestGetContactsForBroadcast(t *testing.T) {
 			"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 			"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-			"created_at", "updated_at", "db_created_at", "db_updated_at",
+			"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).
 			AddRow("test1@example.com", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
-				nil, nil, nil, nil, nil, createdAt1, createdAt1, createdAt1, createdAt1).
+				nil, nil, nil, nil, nil, createdAt1, createdAt1, createdAt1, createdAt1, nil).
 			AddRow("test2@example.com", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
-				nil, nil, nil, nil, nil, createdAt2, createdAt2, createdAt2, createdAt2)
+				nil, nil, nil, nil, nil, createdAt2, createdAt2, createdAt2, createdAt2, nil)
 
 		// Expect the query to join contacts with contact_segments (cursor-based pagination)
-		mock.ExpectQuery(`SELECT ` + contactColumnsPattern + ` FROM contacts c JOIN contact_segments cs ON c\.email = cs\.email WHERE cs\.segment_id IN \(\$1\) ORDER BY c\.email ASC LIMIT 10`).
+		mock.ExpectQuery(`SELECT ` + contactColumnsPattern + ` FROM contacts c JOIN contact_segments cs ON c\.email = cs\.email WHERE cs\.segment_id IN \(\$1\) AND NOT EXISTS \(SELECT 1 FROM suppressions s WHERE .*\) ORDER BY c\.email ASC LIMIT 10`).
 			WithArgs("segment1").
 			WillReturnRows(rows)
 
@@ -1618,7 +1636,7 @@ func TestCountContactsForBroadcast(t *testing.T) {
 
 		// Expect query with JOINS for list filtering, soft-deleted lists filtering, and excludeUnsubscribed
 		// Note: SkipDuplicateEmails is false, so we expect COUNT(*) not COUNT(DISTINCT)
-		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM contacts c JOIN contact_lists cl ON c\.email = cl\.email JOIN lists l ON cl\.list_id = l\.id WHERE cl\.list_id = \$1 AND l\.deleted_at IS NULL AND cl\.status <> \$2 AND cl\.status <> \$3 AND cl\.status <> \$4`).
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM contacts c JOIN contact_lists cl ON c\.email = cl\.email JOIN lists l ON cl\.list_id = l\.id WHERE cl\.list_id = \$1 AND l\.deleted_at IS NULL AND cl\.status <> \$2 AND cl\.status <> \$3 AND cl\.status <> \$4 AND NOT EXISTS \(SELECT 1 FROM suppressions s WHERE .*\)`).
 			WithArgs("list1",
 				domain.ContactListStatusUnsubscribed,
 				domain.ContactListStatusBounced,
@@ -1633,6 +1651,118 @@ func TestCountContactsForBroadcast(t *testing.T) {
 		assert.Equal(t, 25, count)
 	})
 
+	t.Run("should count the non-openers of a broadcast", func(t *testing.T) {
+		mockDB, mock, cleanup := setupMockDB(t)
+		defer cleanup()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+		workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil)
+
+		repo := NewContactRepository(workspaceRepo)
+
+		audience := domain.AudienceSettings{
+			List:         "list1",
+			NonOpenersOf: "broadcast1",
+		}
+
+		mock.ExpectQuery(`(?s)SELECT COUNT\(\*\) FROM contacts c .*WHERE cl\.list_id = \$1 AND l\.deleted_at IS NULL AND EXISTS \(.*mh\.broadcast_id = \$2 AND mh\.failed_at IS NULL.*NOT EXISTS \(.*mh\.broadcast_id = \$3.*mh\.opened_at IS NOT NULL OR mh\.clicked_at IS NOT NULL.*AND NOT EXISTS \(SELECT 1 FROM suppressions s`).
+			WithArgs("list1", "broadcast1", "broadcast1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+		count, err := repo.CountContactsForBroadcast(context.Background(), "workspace123", audience)
+
+		require.NoError(t, err)
+		assert.Equal(t, 7, count)
+	})
+
+	t.Run("should not count the contacts held out of the broadcast", func(t *testing.T) {
+		mockDB, mock, cleanup := setupMockDB(t)
+		defer cleanup()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+		workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil)
+
+		repo := NewContactRepository(workspaceRepo)
+
+		audience := domain.AudienceSettings{
+			List:    "newsletter",
+			Holdout: &domain.HoldoutSettings{Enabled: true, Percentage: 10, Seed: "broadcast1"},
+		}
+
+		mock.ExpectQuery(`(?s)SELECT COUNT\(\*\) FROM contacts c .*NOT EXISTS \(SELECT 1 FROM suppressions s.*AND \('x' \|\| substr\(md5\(\$2 \|\| ':' \|\| c\.email\), 1, 8\)\)::bit\(32\)::bigint % 10000 >= \$3`).
+			WithArgs("newsletter", "broadcast1", 1000).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(90))
+
+		count, err := repo.CountContactsForBroadcast(context.Background(), "workspace123", audience)
+
+		require.NoError(t, err)
+		assert.Equal(t, 90, count)
+	})
+
+	t.Run("should exclude lists, segments and recently emailed contacts", func(t *testing.T) {
+		mockDB, mock, cleanup := setupMockDB(t)
+		defer cleanup()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+		workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil)
+
+		repo := NewContactRepository(workspaceRepo)
+
+		audience := domain.AudienceSettings{
+			List:                     "newsletter",
+			ExcludeLists:             []string{"customers"},
+			ExcludeSegments:          []string{"churned"},
+			ExcludeEmailedWithinDays: 3,
+		}
+
+		mock.ExpectQuery(`(?s)SELECT COUNT\(\*\) FROM contacts c .*WHERE cl\.list_id = \$1 AND l\.deleted_at IS NULL AND \(NOT EXISTS \(.*xcl\.list_id = ANY\(\$2\) AND xcl\.deleted_at IS NULL.*NOT EXISTS \(.*xcs\.segment_id = ANY\(\$3\).*NOT EXISTS \(.*xmh\.channel = 'email' AND xmh\.sent_at >= \$4.*\) AND NOT EXISTS \(SELECT 1 FROM suppressions s`).
+			WithArgs("newsletter", pq.Array([]string{"customers"}), pq.Array([]string{"churned"}), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(42))
+
+		count, err := repo.CountContactsForBroadcast(context.Background(), "workspace123", audience)
+
+		require.NoError(t, err)
+		assert.Equal(t, 42, count)
+	})
+
+	t.Run("should not exclude the non-openers for having received the original broadcast", func(t *testing.T) {
+		mockDB, mock, cleanup := setupMockDB(t)
+		defer cleanup()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+		workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil)
+
+		repo := NewContactRepository(workspaceRepo)
+
+		// The follow-up is sent a day after the original, within the look-back
+		audience := domain.AudienceSettings{
+			List:                     "newsletter",
+			NonOpenersOf:             "broadcast1",
+			ExcludeEmailedWithinDays: 3,
+		}
+
+		mock.ExpectQuery(`(?s)SELECT COUNT\(\*\) FROM contacts c .*mh\.broadcast_id = \$2 .*mh\.broadcast_id = \$3.*NOT EXISTS \(.*xmh\.sent_at >= \$4\s+AND xmh\.broadcast_id IS DISTINCT FROM \$5.*\) AND NOT EXISTS \(SELECT 1 FROM suppressions s`).
+			WithArgs("newsletter", "broadcast1", "broadcast1", sqlmock.AnyArg(), "broadcast1").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+		count, err := repo.CountContactsForBroadcast(context.Background(), "workspace123", audience)
+
+		require.NoError(t, err)
+		assert.Equal(t, 7, count)
+	})
+
 	t.Run("should count all contacts without filtering", func(t *testing.T) {
 		// Create a mock workspace database
 		mockDB, mock, cleanup := setupMockDB(t)
@@ -1657,7 +1787,7 @@ func TestCountContactsForBroadcast(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"count"}).AddRow(100)
 
 		// Expect simple count query without filtering
-		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM contacts c`).
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM contacts c WHERE NOT EXISTS \(SELECT 1 FROM suppressions s WHERE .*\)`).
 			WillReturnRows(rows)
 
 		// Call the method being tested
@@ -2096,6 +2226,72 @@ func TestContactRepository_BulkUpsertContacts(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("null attribute removes the key from an existing contact", func(t *testing.T) {
+		contacts := []*domain.Contact{
+			{Email: "existing@example.com", CreatedAt: now, UpdatedAt: now, Attributes: domain.MapOfAny{"plan": nil, "seats": float64(3)}},
+			{Email: "new@example.com", CreatedAt: now, UpdatedAt: now, Attributes: domain.MapOfAny{"plan": nil}},
+		}
+
+		mock.ExpectBegin()
+
+		mock.ExpectQuery(`SELECT email FROM contacts WHERE email = ANY\(\$1\)`).
+			WithArgs(pq.Array([]string{"existing@example.com", "new@example.com"})).
+			WillReturnRows(sqlmock.NewRows([]string{"email"}).AddRow("existing@example.com"))
+
+		args := make([]driver.Value, 74)
+		for i := range args {
+			args[i] = sqlmock.AnyArg()
+		}
+		args[36] = `{"plan":null,"seats":3}`
+		args[73] = nil
+
+		mock.ExpectQuery(`INSERT INTO contacts .+ - ARRAY\(SELECT key FROM jsonb_each\(EXCLUDED\.attributes\) WHERE value = 'null'::jsonb\)`).
+			WithArgs(args...).
+			WillReturnRows(
+				sqlmock.NewRows([]string{"email", "is_new"}).
+					AddRow("existing@example.com", false).
+					AddRow("new@example.com", true),
+			)
+
+		mock.ExpectCommit()
+
+		results, err := repo.BulkUpsertContacts(ctx, workspaceID, contacts)
+
+		require.NoError(t, err)
+		assert.Len(t, results, 2)
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err)
+	})
+
+	t.Run("adds tags in the same transaction", func(t *testing.T) {
+		contacts := []*domain.Contact{
+			{Email: "tagged@example.com", Tags: []string{"vip", "beta"}, CreatedAt: now, UpdatedAt: now},
+			{Email: "untagged@example.com", CreatedAt: now, UpdatedAt: now},
+		}
+
+		mock.ExpectBegin()
+
+		mock.ExpectQuery(`INSERT INTO contacts`).
+			WillReturnRows(
+				sqlmock.NewRows([]string{"email", "is_new"}).
+					AddRow("tagged@example.com", true).
+					AddRow("untagged@example.com", true),
+			)
+
+		mock.ExpectExec(`INSERT INTO contact_tags \(email, tag\)\s+SELECT \* FROM unnest\(\$1::text\[\], \$2::text\[\]\)\s+ON CONFLICT \(email, tag\) DO NOTHING`).
+			WithArgs(pq.Array([]string{"tagged@example.com", "tagged@example.com"}), pq.Array([]string{"vip", "beta"})).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		mock.ExpectCommit()
+
+		_, err := repo.BulkUpsertContacts(ctx, workspaceID, contacts)
+		require.NoError(t, err)
+
+		err = mock.ExpectationsWereMet()
+		assert.NoError(t, err)
+	})
+
 	t.Run("empty contacts slice", func(t *testing.T) {
 		contacts := []*domain.Contact{}
 
@@ -2332,7 +2528,7 @@ func TestMarkEmailsAsBounced_FlipsActiveLists(t *testing.T) {
 	emails := []string{"a@example.com", "b@example.com"}
 
 	// 4 list rows flipped from active → bounced across the two emails.
-	mock.ExpectExec(`UPDATE contact_lists\s+SET status = 'bounced',\s+updated_at = \$2\s+WHERE email = ANY\(\$1\)\s+AND status NOT IN \('complained', 'bounced'\)\s+AND deleted_at IS NULL`).
+	mock.ExpectExec(`UPDATE contact_lists\s+SET status = 'bounced',\s+updated_at = \$2\s+WHERE \(email = ANY\(\$1\) OR email IN \(.*\)\)\s+AND status NOT IN \('complained', 'bounced'\)\s+AND deleted_at IS NULL`).
 		WithArgs(sqlmock.AnyArg(), at).
 		WillReturnResult(sqlmock.NewResult(0, 4))
 
@@ -2367,6 +2563,30 @@ func TestMarkEmailsAsBounced_PreservesComplained(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestMarkEmailsAsBounced_ResolvesEmailAliases(t *testing.T) {
+	// A bounce on a contact's former address must flip the lists of the
+	// contact that now owns it, unless a contact was created with that address.
+	db, mock, cleanup := setupMockDB(t)
+	defer cleanup()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	workspaceRepo.EXPECT().GetConnection(gomock.Any(), "ws-123").Return(db, nil)
+
+	repo := NewContactRepository(workspaceRepo)
+	at := time.Now().UTC()
+
+	mock.ExpectExec(`SELECT a\.email FROM contact_email_aliases a\s+WHERE a\.alias = ANY\(\$1\)\s+AND NOT EXISTS \(SELECT 1 FROM contacts c WHERE c\.email = a\.alias\)`).
+		WithArgs(sqlmock.AnyArg(), at).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.MarkEmailsAsBounced(context.Background(), "ws-123", []string{"old@example.com"}, at)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestMarkEmailsAsBounced_Idempotent(t *testing.T) {
 	db, mock, cleanup := setupMockDB(t)
 	defer cleanup()
@@ -2438,3 +2658,114 @@ func TestMarkEmailsAsBounced_ExecError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to mark emails as bounced")
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestContactRepository_MigrateCustomFieldsToAttributes(t *testing.T) {
+	t.Run("copies mapped fields and clears legacy columns", func(t *testing.T) {
+		mockDB, mock, cleanup := setupMockDB(t)
+		defer cleanup()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+		workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil)
+
+		repo := NewContactRepository(workspaceRepo)
+
+		mock.ExpectExec(`UPDATE contacts SET attributes = COALESCE\(attributes, '\{\}'::jsonb\) \|\| jsonb_strip_nulls\(jsonb_build_object\(\$1::text, to_char\(custom_datetime_1 AT TIME ZONE 'UTC', .+\), \$2::text, custom_string_1\)\), custom_datetime_1 = NULL, custom_string_1 = NULL, db_updated_at = NOW\(\) WHERE custom_datetime_1 IS NOT NULL OR custom_string_1 IS NOT NULL`).
+			WithArgs("renewal", "plan").
+			WillReturnResult(sqlmock.NewResult(0, 7))
+
+		updated, err := repo.MigrateCustomFieldsToAttributes(context.Background(), "workspace123", map[string]string{
+			"custom_string_1":   "plan",
+			"custom_datetime_1": "renewal",
+		}, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), updated)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rejects unknown columns", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockDB, _, cleanup := setupMockDB(t)
+		defer cleanup()
+
+		workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+		workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil)
+
+		repo := NewContactRepository(workspaceRepo)
+
+		_, err := repo.MigrateCustomFieldsToAttributes(context.Background(), "workspace123", map[string]string{
+			"email; DROP TABLE contacts": "plan",
+		}, false)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid custom field key")
+	})
+
+	t.Run("returns exec errors", func(t *testing.T) {
+		mockDB, mock, cleanup := setupMockDB(t)
+		defer cleanup()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+		workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil)
+
+		repo := NewContactRepository(workspaceRepo)
+
+		mock.ExpectExec(`UPDATE contacts SET`).WillReturnError(errors.New("db error"))
+
+		_, err := repo.MigrateCustomFieldsToAttributes(context.Background(), "workspace123", map[string]string{
+			"custom_number_1": "seats",
+		}, false)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to migrate custom fields to attributes")
+	})
+}
+
+func TestSaveBroadcastHoldout(t *testing.T) {
+	audience := domain.AudienceSettings{
+		List:    "newsletter",
+		Holdout: &domain.HoldoutSettings{Enabled: true, Percentage: 5, Seed: "broadcast1"},
+	}
+
+	t.Run("records the held out contacts of the audience", func(t *testing.T) {
+		mockDB, mock, cleanup := setupMockDB(t)
+		defer cleanup()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+		workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil)
+
+		repo := NewContactRepository(workspaceRepo)
+
+		mock.ExpectExec(`(?s)INSERT INTO broadcast_holdout_contacts \(broadcast_id, email, assigned_at\) SELECT CAST\(\$1 AS VARCHAR\), c\.email, NOW\(\) FROM contacts c .*cl\.list_id = \$2.*md5\(\$3 \|\| ':' \|\| c\.email\).* < \$4 ON CONFLICT \(broadcast_id, email\) DO NOTHING`).
+			WithArgs("broadcast1", "newsletter", "broadcast1", 500).
+			WillReturnResult(sqlmock.NewResult(0, 12))
+
+		saved, err := repo.SaveBroadcastHoldout(context.Background(), "workspace123", "broadcast1", audience)
+
+		require.NoError(t, err)
+		assert.Equal(t, 12, saved)
+	})
+
+	t.Run("does nothing without holdout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		repo := NewContactRepository(mocks.NewMockWorkspaceRepository(ctrl))
+
+		saved, err := repo.SaveBroadcastHoldout(context.Background(), "workspace123", "broadcast1", domain.AudienceSettings{List: "newsletter"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, saved)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/Notifuse/notifuse/internal/domain/mocks"
 	"github.com/Notifuse/notifuse/internal/repository/testutil"
 	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -89,6 +90,38 @@ func TestUpsertContact(t *testing.T) {
 		assert.NoError(t, newMock.ExpectationsWereMet())
 	})
 
+	t.Run("insert new contact with tags", func(t *testing.T) {
+		newDb, newMock, newCleanup := testutil.SetupMockDB(t)
+		defer newCleanup()
+
+		newCtrl := gomock.NewController(t)
+		defer newCtrl.Finish()
+
+		newWorkspaceRepo := mocks.NewMockWorkspaceRepository(newCtrl)
+		newWorkspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(newDb, nil).AnyTimes()
+
+		newRepo := NewContactRepository(newWorkspaceRepo)
+
+		newMock.ExpectBegin()
+		newMock.ExpectQuery(`SELECT ` + contactColumnsPattern + ` FROM contacts c WHERE c\.email = \$1 FOR UPDATE`).
+			WithArgs(email).
+			WillReturnError(sql.ErrNoRows)
+		newMock.ExpectExec(`INSERT INTO contacts`).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		newMock.ExpectExec(`INSERT INTO contact_tags`).
+			WithArgs(pq.Array([]string{email, email}), pq.Array([]string{"vip", "beta"})).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		newMock.ExpectCommit()
+
+		isNew, err := newRepo.UpsertContact(context.Background(), workspaceID, &domain.Contact{
+			Email: email,
+			Tags:  []string{"vip", "beta"},
+		})
+		require.NoError(t, err)
+		assert.True(t, isNew)
+		assert.NoError(t, newMock.ExpectationsWereMet())
+	})
+
 	t.Run("update existing contact", func(t *testing.T) {
 		// Setup new mock DB for this test
 		newDb, newMock, newCleanup := testutil.SetupMockDB(t)
@@ -131,7 +164,7 @@ func TestUpsertContact(t *testing.T) {
 			"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 			"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-			"created_at", "updated_at", "db_created_at", "db_updated_at",
+			"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).
 			AddRow(
 				existingContact.Email, "old-ext", nil, nil, "Old", "Name", nil, nil,
@@ -140,7 +173,7 @@ func TestUpsertContact(t *testing.T) {
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
-				existingContact.CreatedAt, existingContact.UpdatedAt, existingContact.CreatedAt, existingContact.UpdatedAt,
+				existingContact.CreatedAt, existingContact.UpdatedAt, existingContact.CreatedAt, existingContact.UpdatedAt, nil,
 			)
 
 		// New contact data with updates
@@ -364,7 +397,7 @@ func TestUpsertContact(t *testing.T) {
 			"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 			"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-			"created_at", "updated_at", "db_created_at", "db_updated_at",
+			"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).
 			AddRow(
 				email, "old-ext", nil, nil, "Old", "Name", nil, nil,
@@ -373,7 +406,7 @@ func TestUpsertContact(t *testing.T) {
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
-				now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour),
+				now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), nil,
 			)
 
 		// Expect transaction begin
@@ -537,7 +570,7 @@ func TestUpsertContact(t *testing.T) {
 			"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 			"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-			"created_at", "updated_at", "db_created_at", "db_updated_at",
+			"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).
 			AddRow(
 				email, "ext123", nil, nil, "John", "Doe", nil, nil,
@@ -546,7 +579,7 @@ func TestUpsertContact(t *testing.T) {
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
-				time.Now(), time.Now(), time.Now(), time.Now(),
+				time.Now(), time.Now(), time.Now(), time.Now(), nil,
 			)
 
 		// Create an update with unmarshalable JSON
@@ -768,7 +801,7 @@ func TestUpsertContact(t *testing.T) {
 			"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 			"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-			"created_at", "updated_at", "db_created_at", "db_updated_at",
+			"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).
 			AddRow(
 				email, "old-ext", "UTC", "en-US", "Old", "Name", nil, nil,
@@ -777,7 +810,7 @@ func TestUpsertContact(t *testing.T) {
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
-				now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour),
+				now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), nil,
 			)
 
 		// Update with mixed null and non-null fields
@@ -849,7 +882,7 @@ func TestUpsertContact(t *testing.T) {
 			"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 			"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-			"created_at", "updated_at", "db_created_at", "db_updated_at",
+			"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).
 			AddRow(
 				email, "old-ext", "UTC", "en-US", "Old", "Name", "Old Name", "+1234567000",
@@ -858,7 +891,7 @@ func TestUpsertContact(t *testing.T) {
 				1.1, 2.2, 3.3, 4.4, 5.5,
 				now.Add(-10*time.Hour), now.Add(-20*time.Hour), now.Add(-30*time.Hour), now.Add(-40*time.Hour), now.Add(-50*time.Hour),
 				[]byte(`{"old":"json1"}`), []byte(`{"old":"json2"}`), []byte(`{"old":"json3"}`), []byte(`{"old":"json4"}`), []byte(`{"old":"json5"}`),
-				now.Add(-24*time.Hour), now.Add(-12*time.Hour), now.Add(-24*time.Hour), now.Add(-12*time.Hour),
+				now.Add(-24*time.Hour), now.Add(-12*time.Hour), now.Add(-24*time.Hour), now.Add(-12*time.Hour), nil,
 			)
 
 		// Create update contact with ALL fields populated with new values
@@ -963,7 +996,7 @@ func TestUpsertContact(t *testing.T) {
 			"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 			"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-			"created_at", "updated_at", "db_created_at", "db_updated_at",
+			"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).
 			AddRow(
 				email, "ext123", "UTC", "en-US", "John", "Doe", "John Doe", "+1234567890",
@@ -972,7 +1005,7 @@ func TestUpsertContact(t *testing.T) {
 				1.1, 2.2, 3.3, 4.4, 5.5,
 				now.Add(-1*time.Hour), now.Add(-2*time.Hour), now.Add(-3*time.Hour), now.Add(-4*time.Hour), now.Add(-5*time.Hour),
 				[]byte(`{"key1":"value1"}`), []byte(`{"key2":"value2"}`), []byte(`{"key3":"value3"}`), []byte(`{"key4":"value4"}`), []byte(`{"key5":"value5"}`),
-				now.Add(-24*time.Hour), now.Add(-12*time.Hour), now.Add(-24*time.Hour), now.Add(-12*time.Hour),
+				now.Add(-24*time.Hour), now.Add(-12*time.Hour), now.Add(-24*time.Hour), now.Add(-12*time.Hour), nil,
 			)
 
 		// Create update with explicit NULL values for fields
@@ -1064,7 +1097,7 @@ func TestUpsertContact(t *testing.T) {
 			"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 			"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-			"created_at", "updated_at", "db_created_at", "db_updated_at",
+			"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).
 			AddRow(
 				email, "old-ext", "UTC", "en-US", "Old", "Name", nil, nil,
@@ -1073,7 +1106,7 @@ func TestUpsertContact(t *testing.T) {
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
-				now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour),
+				now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), nil,
 			)
 
 		// Create update with unmarshalable JSON
@@ -1139,7 +1172,7 @@ func TestUpsertContact(t *testing.T) {
 			"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 			"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-			"created_at", "updated_at", "db_created_at", "db_updated_at",
+			"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).
 			AddRow(
 				email, "old-ext", "UTC", "en-US", "Old", "Name", nil, nil,
@@ -1148,7 +1181,7 @@ func TestUpsertContact(t *testing.T) {
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
-				now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour),
+				now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), nil,
 			)
 
 		// Update with unmarshalable JSON for CustomJSON3
@@ -1213,7 +1246,7 @@ func TestUpsertContact(t *testing.T) {
 			"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 			"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-			"created_at", "updated_at", "db_created_at", "db_updated_at",
+			"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).
 			AddRow(
 				email, "old-ext", "UTC", "en-US", "Old", "Name", nil, nil,
@@ -1222,7 +1255,7 @@ func TestUpsertContact(t *testing.T) {
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
-				now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour),
+				now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), nil,
 			)
 
 		// Update with unmarshalable JSON for CustomJSON4
@@ -1287,7 +1320,7 @@ func TestUpsertContact(t *testing.T) {
 			"custom_number_1", "custom_number_2", "custom_number_3", "custom_number_4", "custom_number_5",
 			"custom_datetime_1", "custom_datetime_2", "custom_datetime_3", "custom_datetime_4", "custom_datetime_5",
 			"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
-			"created_at", "updated_at", "db_created_at", "db_updated_at",
+			"created_at", "updated_at", "db_created_at", "db_updated_at", "attributes",
 		}).
 			AddRow(
 				email, "old-ext", "UTC", "en-US", "Old", "Name", nil, nil,
@@ -1296,7 +1329,7 @@ func TestUpsertContact(t *testing.T) {
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
 				nil, nil, nil, nil, nil,
-				now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour),
+				now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), now.Add(-24*time.Hour), nil,
 			)
 
 		// Update with unmarshalable JSON for CustomJSON5
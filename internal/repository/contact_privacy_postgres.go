@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+// erasedContactErrorCode is the SQLSTATE raised by the prevent_erased_contact_insert trigger
+const erasedContactErrorCode = "NTF01"
+
+// wrapErasedContactError maps the prevent_erased_contact_insert trigger error to domain.ErrContactErased
+func wrapErasedContactError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && string(pqErr.Code) == erasedContactErrorCode {
+		return domain.ErrContactErased
+	}
+	return err
+}
+
+// ExportContactData returns every row stored about a contact, grouped by table
+func (r *contactRepository) ExportContactData(ctx context.Context, workspaceID string, email string) (*domain.ContactDataExport, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	// A single statement gives a consistent snapshot of all sections
+	query := `
+		SELECT
+			(SELECT to_jsonb(c) FROM contacts c WHERE c.email = $1),
+			(SELECT COALESCE(jsonb_agg(to_jsonb(cl) ORDER BY cl.created_at), '[]'::jsonb) FROM contact_lists cl WHERE cl.email = $1),
+			(SELECT COALESCE(jsonb_agg(to_jsonb(cs) ORDER BY cs.matched_at), '[]'::jsonb) FROM contact_segments cs WHERE cs.email = $1),
+			(SELECT COALESCE(jsonb_agg(to_jsonb(tg) ORDER BY tg.tag), '[]'::jsonb) FROM contact_tags tg WHERE tg.email = $1),
+			(SELECT COALESCE(jsonb_agg(to_jsonb(ct) ORDER BY ct.created_at), '[]'::jsonb) FROM contact_timeline ct WHERE ct.email = $1),
+			(SELECT COALESCE(jsonb_agg(to_jsonb(mh) ORDER BY mh.sent_at), '[]'::jsonb) FROM message_history mh WHERE mh.contact_email = $1),
+			(SELECT COALESCE(jsonb_agg(to_jsonb(ce) ORDER BY ce.occurred_at), '[]'::jsonb) FROM custom_events ce WHERE ce.email = $1),
+			(SELECT COALESCE(jsonb_agg(to_jsonb(ca) ORDER BY ca.entered_at), '[]'::jsonb) FROM contact_automations ca WHERE ca.contact_email = $1),
+			(SELECT COALESCE(jsonb_agg(to_jsonb(ie) ORDER BY ie.timestamp), '[]'::jsonb) FROM inbound_webhook_events ie WHERE ie.recipient_email = $1),
+			(SELECT COALESCE(jsonb_agg(to_jsonb(bh) ORDER BY bh.assigned_at), '[]'::jsonb) FROM broadcast_holdout_contacts bh WHERE bh.email = $1)
+	`
+
+	var contact, lists, segments, tags, timeline, messages, events, journeys, providerEvents, holdouts []byte
+	err = workspaceDB.QueryRowContext(ctx, query, email).Scan(
+		&contact, &lists, &segments, &tags, &timeline, &messages, &events, &journeys, &providerEvents, &holdouts,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export contact data: %w", err)
+	}
+	if contact == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrContactNotFound, email)
+	}
+
+	export := &domain.ContactDataExport{
+		Email:      email,
+		ExportedAt: time.Now().UTC(),
+	}
+	export.Contact = json.RawMessage(contact)
+	export.ContactLists = json.RawMessage(lists)
+	export.ContactSegments = json.RawMessage(segments)
+	export.ContactTags = json.RawMessage(tags)
+	export.Timeline = json.RawMessage(timeline)
+	export.MessageHistory = json.RawMessage(messages)
+	export.CustomEvents = json.RawMessage(events)
+	export.AutomationJourneys = json.RawMessage(journeys)
+	export.ProviderEvents = json.RawMessage(providerEvents)
+	export.BroadcastHoldouts = json.RawMessage(holdouts)
+
+	return export, nil
+}
+
+// EraseContact hard-deletes a contact, all its history and its email suppression
+// entry, then records the hash of its email so it cannot be recreated.
+// Returns whether a contact existed.
+func (r *contactRepository) EraseContact(ctx context.Context, workspaceID string, email string) (bool, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	tx, err := workspaceDB.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Segment and tag deletions emit timeline rows, so the timeline is purged after them
+	statements := []struct {
+		name  string
+		query string
+	}{
+		{"segment memberships", `DELETE FROM contact_segments WHERE email = $1`},
+		{"segment queue", `DELETE FROM contact_segment_queue WHERE email = $1`},
+		{"tags", `DELETE FROM contact_tags WHERE email = $1`},
+		{"list memberships", `DELETE FROM contact_lists WHERE email = $1`},
+		{"message history", `DELETE FROM message_history WHERE contact_email = $1`},
+		{"provider events", `DELETE FROM inbound_webhook_events WHERE recipient_email = $1`},
+		{"custom events", `DELETE FROM custom_events WHERE email = $1`},
+		{"automation journeys", `DELETE FROM contact_automations WHERE contact_email = $1`},
+		{"automation trigger log", `DELETE FROM automation_trigger_log WHERE contact_email = $1`},
+		{"email queue", `DELETE FROM email_queue WHERE contact_email = $1`},
+		{"email aliases", `DELETE FROM contact_email_aliases WHERE email = $1 OR alias = $1`},
+		{"email verification", `DELETE FROM contact_email_verifications WHERE email = $1`},
+		{"email verification queue", `DELETE FROM email_verification_queue WHERE email = $1`},
+		{"suppression entry", `DELETE FROM suppressions WHERE kind = 'email' AND pattern = LOWER($1)`},
+		{"broadcast holdouts", `DELETE FROM broadcast_holdout_contacts WHERE email = $1`},
+		{"contact timeline", `DELETE FROM contact_timeline WHERE email = $1`},
+	}
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement.query, email); err != nil {
+			return false, fmt.Errorf("failed to erase %s: %w", statement.name, err)
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM contacts WHERE email = $1`, email)
+	if err != nil {
+		return false, fmt.Errorf("failed to erase contact: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO erased_contacts (email_hash, erased_at) VALUES ($1, NOW())
+		ON CONFLICT (email_hash) DO NOTHING
+	`, domain.HashErasedEmail(email))
+	if err != nil {
+		return false, fmt.Errorf("failed to record erased contact: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return deleted > 0, nil
+}
+
+// FilterErasedEmails returns the given emails that belong to erased contacts
+func (r *contactRepository) FilterErasedEmails(ctx context.Context, workspaceID string, emails []string) ([]string, error) {
+	if len(emails) == 0 {
+		return []string{}, nil
+	}
+
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	hashes := make([]string, len(emails))
+	byHash := make(map[string]string, len(emails))
+	for i, email := range emails {
+		hashes[i] = domain.HashErasedEmail(email)
+		byHash[hashes[i]] = email
+	}
+
+	rows, err := workspaceDB.QueryContext(ctx, `SELECT email_hash FROM erased_contacts WHERE email_hash = ANY($1)`, pq.Array(hashes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query erased contacts: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	erased := []string{}
+	for rows.Next() {
+		var hash string
+		if err := rows.Scan(&hash); err != nil {
+			return nil, fmt.Errorf("failed to scan erased contact: %w", err)
+		}
+		erased = append(erased, byHash[hash])
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over erased contacts: %w", err)
+	}
+
+	return erased, nil
+}
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+)
+
+func setupContactPrivacyRepo(t *testing.T) (*contactRepository, sqlmock.Sqlmock, func()) {
+	mockDB, mock, cleanup := setupMockDB(t)
+	ctrl := gomock.NewController(t)
+	workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil)
+	return NewContactRepository(workspaceRepo).(*contactRepository), mock, func() {
+		cleanup()
+		ctrl.Finish()
+	}
+}
+
+func TestWrapErasedContactError(t *testing.T) {
+	erased := &pq.Error{Code: "NTF01", Message: "contact was erased and cannot be recreated"}
+	assert.Equal(t, domain.ErrContactErased, wrapErasedContactError(erased))
+	assert.True(t, errors.Is(fmt.Errorf("failed to insert contact: %w", wrapErasedContactError(erased)), domain.ErrContactErased))
+
+	other := &pq.Error{Code: "23505"}
+	assert.Equal(t, other, wrapErasedContactError(other))
+}
+
+func TestContactRepository_ExportContactData(t *testing.T) {
+	columns := []string{"contact", "lists", "segments", "tags", "timeline", "messages", "events", "journeys", "provider_events", "broadcast_holdouts"}
+
+	t.Run("returns every section", func(t *testing.T) {
+		repo, mock, cleanup := setupContactPrivacyRepo(t)
+		defer cleanup()
+
+		mock.ExpectQuery(`SELECT\s+\(SELECT to_jsonb\(c\) FROM contacts c WHERE c.email = \$1\).*FROM message_history mh WHERE mh.contact_email = \$1.*FROM inbound_webhook_events ie WHERE ie.recipient_email = \$1\).*FROM broadcast_holdout_contacts bh WHERE bh.email = \$1\)`).
+			WithArgs("john@example.com").
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(
+				[]byte(`{"email":"john@example.com"}`),
+				[]byte(`[{"list_id":"news","status":"active"}]`),
+				[]byte(`[]`),
+				[]byte(`[{"tag":"vip"}]`),
+				[]byte(`[{"kind":"contact.created"}]`),
+				[]byte(`[{"id":"msg1"}]`),
+				[]byte(`[]`),
+				[]byte(`[]`),
+				[]byte(`[]`),
+				[]byte(`[{"broadcast_id":"b1"}]`),
+			))
+
+		export, err := repo.ExportContactData(context.Background(), "workspace123", "john@example.com")
+
+		require.NoError(t, err)
+		assert.Equal(t, "john@example.com", export.Email)
+		assert.False(t, export.ExportedAt.IsZero())
+		assert.JSONEq(t, `{"email":"john@example.com"}`, string(export.Contact))
+		assert.JSONEq(t, `[{"list_id":"news","status":"active"}]`, string(export.ContactLists))
+		assert.JSONEq(t, `[{"id":"msg1"}]`, string(export.MessageHistory))
+		assert.JSONEq(t, `[{"tag":"vip"}]`, string(export.ContactTags))
+		assert.JSONEq(t, `[{"broadcast_id":"b1"}]`, string(export.BroadcastHoldouts))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("returns not found without a contact row", func(t *testing.T) {
+		repo, mock, cleanup := setupContactPrivacyRepo(t)
+		defer cleanup()
+
+		mock.ExpectQuery(`SELECT`).
+			WithArgs("john@example.com").
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(nil, []byte(`[]`), []byte(`[]`), []byte(`[]`), []byte(`[]`), []byte(`[]`), []byte(`[]`), []byte(`[]`), []byte(`[]`), []byte(`[]`)))
+
+		_, err := repo.ExportContactData(context.Background(), "workspace123", "john@example.com")
+
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, domain.ErrContactNotFound))
+	})
+}
+
+func TestContactRepository_EraseContact(t *testing.T) {
+	email := "john@example.com"
+
+	t.Run("deletes all history and records the hash", func(t *testing.T) {
+		repo, mock, cleanup := setupContactPrivacyRepo(t)
+		defer cleanup()
+
+		mock.ExpectBegin()
+		for _, pattern := range []string{
+			`DELETE FROM contact_segments WHERE email = \$1`,
+			`DELETE FROM contact_segment_queue WHERE email = \$1`,
+			`DELETE FROM contact_tags WHERE email = \$1`,
+			`DELETE FROM contact_lists WHERE email = \$1`,
+			`DELETE FROM message_history WHERE contact_email = \$1`,
+			`DELETE FROM inbound_webhook_events WHERE recipient_email = \$1`,
+			`DELETE FROM custom_events WHERE email = \$1`,
+			`DELETE FROM contact_automations WHERE contact_email = \$1`,
+			`DELETE FROM automation_trigger_log WHERE contact_email = \$1`,
+			`DELETE FROM email_queue WHERE contact_email = \$1`,
+			`DELETE FROM contact_email_aliases WHERE email = \$1 OR alias = \$1`,
+			`DELETE FROM contact_email_verifications WHERE email = \$1`,
+			`DELETE FROM email_verification_queue WHERE email = \$1`,
+			`DELETE FROM suppressions WHERE kind = 'email' AND pattern = LOWER\(\$1\)`,
+			`DELETE FROM broadcast_holdout_contacts WHERE email = \$1`,
+			`DELETE FROM contact_timeline WHERE email = \$1`,
+		} {
+			mock.ExpectExec(pattern).WithArgs(email).WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectExec(`DELETE FROM contacts WHERE email = \$1`).
+			WithArgs(email).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO erased_contacts \(email_hash, erased_at\)`).
+			WithArgs(domain.HashErasedEmail(email)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		found, err := repo.EraseContact(context.Background(), "workspace123", email)
+
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("tombstones unknown emails", func(t *testing.T) {
+		repo, mock, cleanup := setupContactPrivacyRepo(t)
+		defer cleanup()
+
+		mock.ExpectBegin()
+		for i := 0; i < 16; i++ {
+			mock.ExpectExec(`DELETE FROM`).WithArgs(email).WillReturnResult(sqlmock.NewResult(0, 0))
+		}
+		mock.ExpectExec(`DELETE FROM contacts WHERE email = \$1`).
+			WithArgs(email).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`INSERT INTO erased_contacts`).
+			WithArgs(domain.HashErasedEmail(email)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		found, err := repo.EraseContact(context.Background(), "workspace123", email)
+
+		require.NoError(t, err)
+		assert.False(t, found)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("stops when the suppression entry cannot be removed", func(t *testing.T) {
+		repo, mock, cleanup := setupContactPrivacyRepo(t)
+		defer cleanup()
+
+		mock.ExpectBegin()
+		for i := 0; i < 13; i++ {
+			mock.ExpectExec(`DELETE FROM`).WithArgs(email).WillReturnResult(sqlmock.NewResult(0, 0))
+		}
+		mock.ExpectExec(`DELETE FROM suppressions WHERE kind = 'email'`).
+			WithArgs(email).WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		_, err := repo.EraseContact(context.Background(), "workspace123", email)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to erase suppression entry")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back on failure", func(t *testing.T) {
+		repo, mock, cleanup := setupContactPrivacyRepo(t)
+		defer cleanup()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM contact_segments`).WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		_, err := repo.EraseContact(context.Background(), "workspace123", email)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to erase segment memberships")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestContactRepository_FilterErasedEmails(t *testing.T) {
+	t.Run("returns emails whose hash is recorded", func(t *testing.T) {
+		repo, mock, cleanup := setupContactPrivacyRepo(t)
+		defer cleanup()
+
+		mock.ExpectQuery(`SELECT email_hash FROM erased_contacts WHERE email_hash = ANY\(\$1\)`).
+			WithArgs(sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"email_hash"}).AddRow(domain.HashErasedEmail("gone@example.com")))
+
+		erased, err := repo.FilterErasedEmails(context.Background(), "workspace123", []string{"kept@example.com", "gone@example.com"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gone@example.com"}, erased)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("empty input skips the query", func(t *testing.T) {
+		repo := NewContactRepository(nil).(*contactRepository)
+
+		erased, err := repo.FilterErasedEmails(context.Background(), "workspace123", nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, erased)
+	})
+}
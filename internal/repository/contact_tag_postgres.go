@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+type contactTagRepository struct {
+	workspaceRepo domain.WorkspaceRepository
+}
+
+// NewContactTagRepository creates a new contact tag repository
+func NewContactTagRepository(workspaceRepo domain.WorkspaceRepository) domain.ContactTagRepository {
+	return &contactTagRepository{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// insertContactTags adds (email, tag) pairs given as two parallel slices,
+// ignoring the pairs that already exist. It runs inside contact upserts.
+func insertContactTags(ctx context.Context, tx *sql.Tx, emails []string, tags []string) error {
+	if len(emails) == 0 {
+		return nil
+	}
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO contact_tags (email, tag)
+		SELECT * FROM unnest($1::text[], $2::text[])
+		ON CONFLICT (email, tag) DO NOTHING
+	`, pq.Array(emails), pq.Array(tags))
+	if err != nil {
+		return fmt.Errorf("failed to insert contact tags: %w", err)
+	}
+	return nil
+}
+
+// AddTags tags the contacts returned by emailsQuery
+func (r *contactTagRepository) AddTags(ctx context.Context, workspaceID string, tags []string, emailsQuery string, args []interface{}) (int64, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	// The tags are appended after the arguments of emailsQuery
+	query := fmt.Sprintf(`
+		INSERT INTO contact_tags (email, tag)
+		SELECT c.email, t.tag FROM (%s) c CROSS JOIN unnest($%d::text[]) AS t(tag)
+		ON CONFLICT (email, tag) DO NOTHING
+	`, emailsQuery, len(args)+1)
+
+	result, err := workspaceDB.ExecContext(ctx, query, append(args, pq.Array(tags))...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add tags: %w", err)
+	}
+
+	added, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return added, nil
+}
+
+// RemoveTags untags the contacts returned by emailsQuery
+func (r *contactTagRepository) RemoveTags(ctx context.Context, workspaceID string, tags []string, emailsQuery string, args []interface{}) (int64, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		DELETE FROM contact_tags
+		WHERE tag = ANY($%d::text[]) AND email IN (%s)
+	`, len(args)+1, emailsQuery)
+
+	result, err := workspaceDB.ExecContext(ctx, query, append(args, pq.Array(tags))...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to remove tags: %w", err)
+	}
+
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return removed, nil
+}
+
+// ListTags returns every tag of the workspace with its contact count
+func (r *contactTagRepository) ListTags(ctx context.Context, workspaceID string) ([]domain.TagCount, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	rows, err := workspaceDB.QueryContext(ctx, `SELECT tag, COUNT(*) FROM contact_tags GROUP BY tag ORDER BY tag`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	tags := []domain.TagCount{}
+	for rows.Next() {
+		var tag domain.TagCount
+		if err := rows.Scan(&tag.Tag, &tag.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// DeleteTag removes a tag from every contact
+func (r *contactTagRepository) DeleteTag(ctx context.Context, workspaceID string, tag string) (int64, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	result, err := workspaceDB.ExecContext(ctx, `DELETE FROM contact_tags WHERE tag = $1`, tag)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if removed == 0 {
+		return 0, fmt.Errorf("%w: %s", domain.ErrTagNotFound, tag)
+	}
+	return removed, nil
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+)
+
+func setupContactTagRepositoryTest(t *testing.T) (domain.ContactTagRepository, sqlmock.Sqlmock) {
+	mockDB, mock, cleanup := setupMockDB(t)
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() {
+		cleanup()
+		ctrl.Finish()
+	})
+
+	workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil).AnyTimes()
+	return NewContactTagRepository(workspaceRepo), mock
+}
+
+func TestContactTagRepository_AddTags(t *testing.T) {
+	t.Run("tags the selected contacts", func(t *testing.T) {
+		repo, mock := setupContactTagRepositoryTest(t)
+
+		mock.ExpectExec(`INSERT INTO contact_tags \(email, tag\)\s+SELECT c\.email, t\.tag FROM \(SELECT email FROM contacts WHERE email = ANY\(\$1\)\) c CROSS JOIN unnest\(\$2::text\[\]\) AS t\(tag\)\s+ON CONFLICT \(email, tag\) DO NOTHING`).
+			WithArgs(pq.Array([]string{"a@example.com"}), pq.Array([]string{"vip", "beta"})).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		added, err := repo.AddTags(context.Background(), "workspace123", []string{"vip", "beta"},
+			"SELECT email FROM contacts WHERE email = ANY($1)", []interface{}{pq.Array([]string{"a@example.com"})})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), added)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		repo, mock := setupContactTagRepositoryTest(t)
+
+		mock.ExpectExec(`INSERT INTO contact_tags`).WillReturnError(errors.New("db error"))
+
+		_, err := repo.AddTags(context.Background(), "workspace123", []string{"vip"}, "SELECT email FROM contacts", nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to add tags")
+	})
+}
+
+func TestContactTagRepository_RemoveTags(t *testing.T) {
+	repo, mock := setupContactTagRepositoryTest(t)
+
+	mock.ExpectExec(`DELETE FROM contact_tags\s+WHERE tag = ANY\(\$2::text\[\]\) AND email IN \(SELECT email FROM contact_segments WHERE segment_id = \$1\)`).
+		WithArgs("seg1", pq.Array([]string{"vip"})).
+		WillReturnResult(sqlmock.NewResult(0, 5))
+
+	removed, err := repo.RemoveTags(context.Background(), "workspace123", []string{"vip"},
+		"SELECT email FROM contact_segments WHERE segment_id = $1", []interface{}{"seg1"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), removed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestContactTagRepository_ListTags(t *testing.T) {
+	t.Run("returns tags with counts", func(t *testing.T) {
+		repo, mock := setupContactTagRepositoryTest(t)
+
+		mock.ExpectQuery(`SELECT tag, COUNT\(\*\) FROM contact_tags GROUP BY tag ORDER BY tag`).
+			WillReturnRows(sqlmock.NewRows([]string{"tag", "count"}).AddRow("beta", 3).AddRow("vip", 12))
+
+		tags, err := repo.ListTags(context.Background(), "workspace123")
+		require.NoError(t, err)
+		assert.Equal(t, []domain.TagCount{{Tag: "beta", Count: 3}, {Tag: "vip", Count: 12}}, tags)
+	})
+
+	t.Run("no tags", func(t *testing.T) {
+		repo, mock := setupContactTagRepositoryTest(t)
+
+		mock.ExpectQuery(`SELECT tag, COUNT`).WillReturnRows(sqlmock.NewRows([]string{"tag", "count"}))
+
+		tags, err := repo.ListTags(context.Background(), "workspace123")
+		require.NoError(t, err)
+		assert.NotNil(t, tags)
+		assert.Empty(t, tags)
+	})
+}
+
+func TestContactTagRepository_DeleteTag(t *testing.T) {
+	t.Run("deletes the tag", func(t *testing.T) {
+		repo, mock := setupContactTagRepositoryTest(t)
+
+		mock.ExpectExec(`DELETE FROM contact_tags WHERE tag = \$1`).
+			WithArgs("vip").
+			WillReturnResult(sqlmock.NewResult(0, 12))
+
+		removed, err := repo.DeleteTag(context.Background(), "workspace123", "vip")
+		require.NoError(t, err)
+		assert.Equal(t, int64(12), removed)
+	})
+
+	t.Run("unknown tag", func(t *testing.T) {
+		repo, mock := setupContactTagRepositoryTest(t)
+
+		mock.ExpectExec(`DELETE FROM contact_tags WHERE tag = \$1`).
+			WithArgs("vip").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		_, err := repo.DeleteTag(context.Background(), "workspace123", "vip")
+		assert.ErrorIs(t, err, domain.ErrTagNotFound)
+	})
+}
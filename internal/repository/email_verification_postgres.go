@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+type emailVerificationRepository struct {
+	workspaceRepo domain.WorkspaceRepository
+}
+
+// NewEmailVerificationRepository creates a new email verification repository
+func NewEmailVerificationRepository(workspaceRepo domain.WorkspaceRepository) domain.EmailVerificationRepository {
+	return &emailVerificationRepository{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// Get returns the last verification of an address
+func (r *emailVerificationRepository) Get(ctx context.Context, workspaceID string, email string) (*domain.EmailVerification, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	var (
+		verification domain.EmailVerification
+		reason       sql.NullString
+		suggestion   sql.NullString
+		checks       []byte
+	)
+	err = workspaceDB.QueryRowContext(ctx, `
+		SELECT email, status, reason, suggestion, checks, verified_at
+		FROM contact_email_verifications
+		WHERE email = $1
+	`, email).Scan(&verification.Email, &verification.Status, &reason, &suggestion, &checks, &verification.VerifiedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrEmailVerificationNotFound, email)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get email verification: %w", err)
+	}
+
+	verification.Reason = reason.String
+	verification.Suggestion = suggestion.String
+	if err := json.Unmarshal(checks, &verification.Checks); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal verification checks: %w", err)
+	}
+
+	return &verification, nil
+}
+
+// Upsert stores a verification, replacing the previous one for the same address
+func (r *emailVerificationRepository) Upsert(ctx context.Context, workspaceID string, verification *domain.EmailVerification) error {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	checks, err := json.Marshal(verification.Checks)
+	if err != nil {
+		return fmt.Errorf("failed to marshal verification checks: %w", err)
+	}
+
+	_, err = workspaceDB.ExecContext(ctx, `
+		INSERT INTO contact_email_verifications (email, status, reason, suggestion, checks, verified_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (email) DO UPDATE SET
+			status = EXCLUDED.status,
+			reason = EXCLUDED.reason,
+			suggestion = EXCLUDED.suggestion,
+			checks = EXCLUDED.checks,
+			verified_at = EXCLUDED.verified_at
+	`,
+		verification.Email,
+		verification.Status,
+		sql.NullString{String: verification.Reason, Valid: verification.Reason != ""},
+		sql.NullString{String: verification.Suggestion, Valid: verification.Suggestion != ""},
+		checks,
+		verification.VerifiedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert email verification: %w", err)
+	}
+
+	return nil
+}
+
+// Enqueue queues addresses for verification
+func (r *emailVerificationRepository) Enqueue(ctx context.Context, workspaceID string, emails []string) error {
+	if len(emails) == 0 {
+		return nil
+	}
+
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	_, err = workspaceDB.ExecContext(ctx, `
+		INSERT INTO email_verification_queue (email, queued_at)
+		SELECT unnest($1::text[]), CURRENT_TIMESTAMP
+		ON CONFLICT (email) DO UPDATE SET queued_at = EXCLUDED.queued_at
+	`, pq.Array(emails))
+	if err != nil {
+		return fmt.Errorf("failed to enqueue emails for verification: %w", err)
+	}
+
+	return nil
+}
+
+// Dequeue removes and returns the oldest queued addresses.
+// SKIP LOCKED lets concurrent workers claim distinct batches.
+func (r *emailVerificationRepository) Dequeue(ctx context.Context, workspaceID string, limit int) ([]string, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	rows, err := workspaceDB.QueryContext(ctx, `
+		DELETE FROM email_verification_queue
+		WHERE email IN (
+			SELECT email FROM email_verification_queue
+			ORDER BY queued_at ASC
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING email
+	`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dequeue emails for verification: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var emails []string
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, fmt.Errorf("failed to scan queued email: %w", err)
+		}
+		emails = append(emails, email)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating queued emails: %w", err)
+	}
+
+	return emails, nil
+}
+
+// ClearQueue drops every queued address
+func (r *emailVerificationRepository) ClearQueue(ctx context.Context, workspaceID string) error {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	if _, err := workspaceDB.ExecContext(ctx, `DELETE FROM email_verification_queue`); err != nil {
+		return fmt.Errorf("failed to clear email verification queue: %w", err)
+	}
+
+	return nil
+}
+
+// QueueSize returns the number of queued addresses
+func (r *emailVerificationRepository) QueueSize(ctx context.Context, workspaceID string) (int, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	var count int
+	if err := workspaceDB.QueryRowContext(ctx, `SELECT COUNT(*) FROM email_verification_queue`).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count email verification queue: %w", err)
+	}
+
+	return count, nil
+}
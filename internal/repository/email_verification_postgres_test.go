@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	"github.com/Notifuse/notifuse/pkg/emailverifier"
+)
+
+func setupEmailVerificationRepositoryTest(t *testing.T) (domain.EmailVerificationRepository, sqlmock.Sqlmock) {
+	mockDB, mock, cleanup := setupMockDB(t)
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() {
+		cleanup()
+		ctrl.Finish()
+	})
+
+	workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil).AnyTimes()
+	return NewEmailVerificationRepository(workspaceRepo), mock
+}
+
+func TestEmailVerificationRepository_Get(t *testing.T) {
+	verifiedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	columns := []string{"email", "status", "reason", "suggestion", "checks", "verified_at"}
+
+	t.Run("returns the verification", func(t *testing.T) {
+		repo, mock := setupEmailVerificationRepositoryTest(t)
+		mock.ExpectQuery(`SELECT email, status, reason, suggestion, checks, verified_at\s+FROM contact_email_verifications\s+WHERE email = \$1`).
+			WithArgs("john@gmial.com").
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow("john@gmial.com", "risky", "possible_typo", "john@gmail.com", []byte(`{"syntax":true,"mx":true,"disposable":false,"role":false}`), verifiedAt))
+
+		verification, err := repo.Get(context.Background(), "workspace123", "john@gmial.com")
+
+		require.NoError(t, err)
+		assert.Equal(t, emailverifier.StatusRisky, verification.Status)
+		assert.Equal(t, "possible_typo", verification.Reason)
+		assert.Equal(t, "john@gmail.com", verification.Suggestion)
+		assert.True(t, verification.Checks.Syntax)
+		require.NotNil(t, verification.Checks.MX)
+		assert.True(t, *verification.Checks.MX)
+		assert.Nil(t, verification.Checks.SMTP)
+		assert.Equal(t, verifiedAt, verification.VerifiedAt)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo, mock := setupEmailVerificationRepositoryTest(t)
+		mock.ExpectQuery(`FROM contact_email_verifications`).WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.Get(context.Background(), "workspace123", "john@example.com")
+
+		assert.True(t, errors.Is(err, domain.ErrEmailVerificationNotFound))
+	})
+}
+
+func TestEmailVerificationRepository_Upsert(t *testing.T) {
+	repo, mock := setupEmailVerificationRepositoryTest(t)
+	verifiedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(`INSERT INTO contact_email_verifications (.|\n)*ON CONFLICT \(email\) DO UPDATE`).
+		WithArgs("john@example.com", emailverifier.StatusValid, sql.NullString{}, sql.NullString{},
+			[]byte(`{"syntax":true,"disposable":false,"role":false}`), verifiedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.Upsert(context.Background(), "workspace123", &domain.EmailVerification{
+		Email:      "john@example.com",
+		Status:     emailverifier.StatusValid,
+		Checks:     emailverifier.Checks{Syntax: true},
+		VerifiedAt: verifiedAt,
+	})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEmailVerificationRepository_Queue(t *testing.T) {
+	t.Run("enqueue", func(t *testing.T) {
+		repo, mock := setupEmailVerificationRepositoryTest(t)
+		mock.ExpectExec(`INSERT INTO email_verification_queue (.|\n)*unnest\(\$1::text\[\]\)`).
+			WithArgs(pq.Array([]string{"a@example.com", "b@example.com"})).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		require.NoError(t, repo.Enqueue(context.Background(), "workspace123", []string{"a@example.com", "b@example.com"}))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("enqueue nothing", func(t *testing.T) {
+		repo, mock := setupEmailVerificationRepositoryTest(t)
+		require.NoError(t, repo.Enqueue(context.Background(), "workspace123", nil))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("dequeue", func(t *testing.T) {
+		repo, mock := setupEmailVerificationRepositoryTest(t)
+		mock.ExpectQuery(`DELETE FROM email_verification_queue(.|\n)*FOR UPDATE SKIP LOCKED(.|\n)*RETURNING email`).
+			WithArgs(50).
+			WillReturnRows(sqlmock.NewRows([]string{"email"}).AddRow("a@example.com").AddRow("b@example.com"))
+
+		emails, err := repo.Dequeue(context.Background(), "workspace123", 50)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a@example.com", "b@example.com"}, emails)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		repo, mock := setupEmailVerificationRepositoryTest(t)
+		mock.ExpectExec(`DELETE FROM email_verification_queue`).WillReturnResult(sqlmock.NewResult(0, 3))
+
+		require.NoError(t, repo.ClearQueue(context.Background(), "workspace123"))
+	})
+
+	t.Run("size", func(t *testing.T) {
+		repo, mock := setupEmailVerificationRepositoryTest(t)
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM email_verification_queue`).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+		size, err := repo.QueueSize(context.Background(), "workspace123")
+
+		require.NoError(t, err)
+		assert.Equal(t, 7, size)
+	})
+
+	t.Run("size error", func(t *testing.T) {
+		repo, mock := setupEmailVerificationRepositoryTest(t)
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM email_verification_queue`).WillReturnError(errors.New("db error"))
+
+		_, err := repo.QueueSize(context.Background(), "workspace123")
+
+		assert.ErrorContains(t, err, "failed to count email verification queue")
+	})
+}
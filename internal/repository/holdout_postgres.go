@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+type holdoutRepository struct {
+	workspaceRepo domain.WorkspaceRepository
+}
+
+// NewHoldoutRepository creates a new holdout repository
+func NewHoldoutRepository(workspaceRepo domain.WorkspaceRepository) domain.HoldoutRepository {
+	return &holdoutRepository{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// holdoutGroupQueries returns the queries listing the contacts of the treated and holdout
+// groups with the time they entered the experiment, both taking the source id as $1
+func holdoutGroupQueries(sourceType domain.HoldoutSourceType) (treated string, holdout string, err error) {
+	switch sourceType {
+	case domain.HoldoutSourceBroadcast:
+		treated = `SELECT contact_email AS email, MIN(sent_at) AS entered_at FROM message_history
+			WHERE broadcast_id = $1 AND failed_at IS NULL GROUP BY contact_email`
+		holdout = `SELECT email, assigned_at AS entered_at FROM broadcast_holdout_contacts
+			WHERE broadcast_id = $1`
+	case domain.HoldoutSourceAutomation:
+		treated = `SELECT contact_email AS email, MIN(entered_at) AS entered_at FROM contact_automations
+			WHERE automation_id = $1 AND exit_reason IS DISTINCT FROM '` + domain.HoldoutExitReason + `'
+			GROUP BY contact_email`
+		holdout = `SELECT contact_email AS email, MIN(entered_at) AS entered_at FROM contact_automations
+			WHERE automation_id = $1 AND exit_reason = '` + domain.HoldoutExitReason + `'
+			GROUP BY contact_email`
+	default:
+		return "", "", fmt.Errorf("invalid holdout source type: %s", sourceType)
+	}
+	return treated, holdout, nil
+}
+
+// GetGroupConversions returns the goal conversions of the treated and holdout groups
+func (r *holdoutRepository) GetGroupConversions(
+	ctx context.Context,
+	workspaceID string,
+	sourceType domain.HoldoutSourceType,
+	sourceID string,
+	windowDays int,
+	goalType string,
+) (*domain.HoldoutGroupStats, *domain.HoldoutGroupStats, error) {
+	treatedQuery, holdoutQuery, err := holdoutGroupQueries(sourceType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	treated, err := queryGroupConversions(ctx, db, treatedQuery, sourceID, windowDays, goalType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get treated group conversions: %w", err)
+	}
+
+	holdout, err := queryGroupConversions(ctx, db, holdoutQuery, sourceID, windowDays, goalType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get holdout group conversions: %w", err)
+	}
+
+	return treated, holdout, nil
+}
+
+// queryGroupConversions counts the contacts of a group, those who reached a goal within the
+// window after entering the experiment, and the value of those goals
+func queryGroupConversions(ctx context.Context, db *sql.DB, groupQuery string, sourceID string, windowDays int, goalType string) (*domain.HoldoutGroupStats, error) {
+	query := `
+		WITH grp AS (` + groupQuery + `)
+		SELECT COUNT(*), COUNT(conv.email), COALESCE(SUM(conv.revenue), 0)
+		FROM grp
+		LEFT JOIN LATERAL (
+			SELECT ce.email, SUM(COALESCE(ce.goal_value, 0)) AS revenue
+			FROM custom_events ce
+			WHERE ce.email = grp.email
+			AND ce.goal_type IS NOT NULL
+			AND ($3 = '' OR ce.goal_type = $3)
+			AND ce.deleted_at IS NULL
+			AND ce.occurred_at >= grp.entered_at
+			AND ce.occurred_at < grp.entered_at + make_interval(days => $2)
+			GROUP BY ce.email
+		) conv ON true`
+
+	var stats domain.HoldoutGroupStats
+	err := db.QueryRowContext(ctx, query, sourceID, windowDays, goalType).Scan(
+		&stats.Contacts,
+		&stats.Converted,
+		&stats.Revenue,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+)
+
+func setupHoldoutRepositoryTest(t *testing.T) (domain.HoldoutRepository, sqlmock.Sqlmock) {
+	mockDB, mock, cleanup := setupMockDB(t)
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() {
+		cleanup()
+		ctrl.Finish()
+	})
+
+	workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil).AnyTimes()
+	return NewHoldoutRepository(workspaceRepo), mock
+}
+
+func TestHoldoutRepository_GetGroupConversions(t *testing.T) {
+	conversionColumns := []string{"count", "converted", "revenue"}
+
+	t.Run("broadcast groups", func(t *testing.T) {
+		repo, mock := setupHoldoutRepositoryTest(t)
+
+		mock.ExpectQuery(`(?s)WITH grp AS \(SELECT contact_email AS email, MIN\(sent_at\) AS entered_at FROM message_history.*broadcast_id = \$1 AND failed_at IS NULL.*ce\.goal_type IS NOT NULL.*make_interval\(days => \$2\)`).
+			WithArgs("b1", 7, "").
+			WillReturnRows(sqlmock.NewRows(conversionColumns).AddRow(900, 45, 1250.5))
+		mock.ExpectQuery(`(?s)WITH grp AS \(SELECT email, assigned_at AS entered_at FROM broadcast_holdout_contacts.*broadcast_id = \$1\)`).
+			WithArgs("b1", 7, "").
+			WillReturnRows(sqlmock.NewRows(conversionColumns).AddRow(100, 3, 80.0))
+
+		treated, holdout, err := repo.GetGroupConversions(context.Background(), "workspace123", domain.HoldoutSourceBroadcast, "b1", 7, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.HoldoutGroupStats{Contacts: 900, Converted: 45, Revenue: 1250.5}, treated)
+		assert.Equal(t, &domain.HoldoutGroupStats{Contacts: 100, Converted: 3, Revenue: 80}, holdout)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("automation groups split on the exit reason", func(t *testing.T) {
+		repo, mock := setupHoldoutRepositoryTest(t)
+
+		mock.ExpectQuery(`(?s)FROM contact_automations.*automation_id = \$1 AND exit_reason IS DISTINCT FROM 'holdout'`).
+			WithArgs("a1", 14, domain.GoalTypePurchase).
+			WillReturnRows(sqlmock.NewRows(conversionColumns).AddRow(50, 5, 500.0))
+		mock.ExpectQuery(`(?s)FROM contact_automations.*automation_id = \$1 AND exit_reason = 'holdout'`).
+			WithArgs("a1", 14, domain.GoalTypePurchase).
+			WillReturnRows(sqlmock.NewRows(conversionColumns).AddRow(10, 0, 0.0))
+
+		treated, holdout, err := repo.GetGroupConversions(context.Background(), "workspace123", domain.HoldoutSourceAutomation, "a1", 14, domain.GoalTypePurchase)
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, treated.Converted)
+		assert.Equal(t, 10, holdout.Contacts)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		repo, mock := setupHoldoutRepositoryTest(t)
+
+		mock.ExpectQuery(`WITH grp AS`).WillReturnError(errors.New("db down"))
+
+		_, _, err := repo.GetGroupConversions(context.Background(), "workspace123", domain.HoldoutSourceBroadcast, "b1", 7, "")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get treated group conversions")
+	})
+
+	t.Run("invalid source type", func(t *testing.T) {
+		repo, _ := setupHoldoutRepositoryTest(t)
+
+		_, _, err := repo.GetGroupConversions(context.Background(), "workspace123", "list", "l1", 7, "")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid holdout source type")
+	})
+}
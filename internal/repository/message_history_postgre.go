@@ -1056,6 +1056,49 @@ func (r *MessageHistoryRepository) GetBroadcastStats(ctx context.Context, worksp
 		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
 	}
 
+	stats, err := queryBroadcastStats(ctx, workspaceDB, "broadcast_id = $1", id)
+	if err != nil {
+		// codecov:ignore:start
+		tracing.MarkSpanError(ctx, err)
+		// codecov:ignore:end
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// GetCombinedBroadcastStats retrieves statistics for a broadcast together with the
+// broadcasts linked to it: its resends to non-openers and its recurring occurrences
+func (r *MessageHistoryRepository) GetCombinedBroadcastStats(ctx context.Context, workspaceID string, id string) (*domain.MessageHistoryStatusSum, error) {
+	// codecov:ignore:start
+	ctx, span := tracing.StartServiceSpan(ctx, "MessageHistoryRepository", "GetCombinedBroadcastStats")
+	defer tracing.EndSpan(span, nil)
+	tracing.AddAttribute(ctx, "workspaceID", workspaceID)
+	tracing.AddAttribute(ctx, "broadcastID", id)
+	// codecov:ignore:end
+
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		// codecov:ignore:start
+		tracing.MarkSpanError(ctx, err)
+		// codecov:ignore:end
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	stats, err := queryBroadcastStats(ctx, workspaceDB,
+		"(broadcast_id = $1 OR broadcast_id IN (SELECT id FROM broadcasts WHERE parent_broadcast_id = $1))", id)
+	if err != nil {
+		// codecov:ignore:start
+		tracing.MarkSpanError(ctx, err)
+		// codecov:ignore:end
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// queryBroadcastStats sums the events of the broadcast messages matching the condition
+func queryBroadcastStats(ctx context.Context, workspaceDB *sql.DB, condition string, args ...interface{}) (*domain.MessageHistoryStatusSum, error) {
 	// MessageEventSent         MessageEvent = "sent"
 	// MessageEventDelivered    MessageEvent = "delivered"
 	// MessageEventFailed       MessageEvent = "failed"
@@ -1076,17 +1119,16 @@ func (r *MessageHistoryRepository) GetBroadcastStats(ctx context.Context, worksp
 			SUM(CASE WHEN complained_at IS NOT NULL THEN 1 ELSE 0 END) as total_complained,
 			SUM(CASE WHEN unsubscribed_at IS NOT NULL THEN 1 ELSE 0 END) as total_unsubscribed
 		FROM message_history
-		WHERE broadcast_id = $1
-	`
+		WHERE ` + condition
 
-	row := workspaceDB.QueryRowContext(ctx, query, id)
+	row := workspaceDB.QueryRowContext(ctx, query, args...)
 	stats := &domain.MessageHistoryStatusSum{}
 
 	// Use NullInt64 to handle NULL values from database
 	var totalSent, totalDelivered, totalFailed, totalOpened sql.NullInt64
 	var totalClicked, totalBounced, totalComplained, totalUnsubscribed sql.NullInt64
 
-	err = row.Scan(
+	err := row.Scan(
 		&totalSent,
 		&totalDelivered,
 		&totalFailed,
@@ -1101,9 +1143,6 @@ func (r *MessageHistoryRepository) GetBroadcastStats(ctx context.Context, worksp
 		if err == sql.ErrNoRows {
 			return stats, nil // Return empty stats (all zeros)
 		}
-		// codecov:ignore:start
-		tracing.MarkSpanError(ctx, err)
-		// codecov:ignore:end
 		return nil, fmt.Errorf("failed to get broadcast stats: %w", err)
 	}
 
@@ -1226,6 +1265,90 @@ func (r *MessageHistoryRepository) GetBroadcastVariationStats(ctx context.Contex
 	return stats, nil
 }
 
+// GetBroadcastVariationGoalRevenue sums the goal values reached by the recipients of a variation after their send
+func (r *MessageHistoryRepository) GetBroadcastVariationGoalRevenue(ctx context.Context, workspaceID string, broadcastID, templateID string) (*domain.GoalRevenueSum, error) {
+	// codecov:ignore:start
+	ctx, span := tracing.StartServiceSpan(ctx, "MessageHistoryRepository", "GetBroadcastVariationGoalRevenue")
+	defer tracing.EndSpan(span, nil)
+	tracing.AddAttribute(ctx, "workspaceID", workspaceID)
+	tracing.AddAttribute(ctx, "broadcastID", broadcastID)
+	tracing.AddAttribute(ctx, "templateID", templateID)
+	// codecov:ignore:end
+
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		// codecov:ignore:start
+		tracing.MarkSpanError(ctx, err)
+		// codecov:ignore:end
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	// Revenue of each converted recipient, the squares give the variance of the revenue per recipient
+	query := `
+		SELECT COUNT(*), COALESCE(SUM(revenue), 0), COALESCE(SUM(revenue * revenue), 0)
+		FROM (
+			SELECT mh.contact_email, SUM(COALESCE(ce.goal_value, 0)) AS revenue
+			FROM message_history mh
+			JOIN custom_events ce ON ce.email = mh.contact_email
+				AND ce.goal_type IS NOT NULL
+				AND ce.deleted_at IS NULL
+				AND ce.occurred_at >= mh.sent_at
+			WHERE mh.broadcast_id = $1 AND mh.template_id = $2 AND mh.failed_at IS NULL
+			GROUP BY mh.contact_email
+		) conversions
+	`
+
+	revenue := &domain.GoalRevenueSum{}
+	err = workspaceDB.QueryRowContext(ctx, query, broadcastID, templateID).Scan(
+		&revenue.Conversions,
+		&revenue.Revenue,
+		&revenue.RevenueSquares,
+	)
+	if err != nil {
+		// codecov:ignore:start
+		tracing.MarkSpanError(ctx, err)
+		// codecov:ignore:end
+		return nil, fmt.Errorf("failed to get broadcast variation goal revenue: %w", err)
+	}
+
+	return revenue, nil
+}
+
+// GetBroadcastTemplateVersion returns the template version a variation of a broadcast was sent
+// with, the latest one if the template was published again during the send
+func (r *MessageHistoryRepository) GetBroadcastTemplateVersion(ctx context.Context, workspaceID string, broadcastID, templateID string) (int64, error) {
+	// codecov:ignore:start
+	ctx, span := tracing.StartServiceSpan(ctx, "MessageHistoryRepository", "GetBroadcastTemplateVersion")
+	defer tracing.EndSpan(span, nil)
+	tracing.AddAttribute(ctx, "workspaceID", workspaceID)
+	tracing.AddAttribute(ctx, "broadcastID", broadcastID)
+	tracing.AddAttribute(ctx, "templateID", templateID)
+	// codecov:ignore:end
+
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		// codecov:ignore:start
+		tracing.MarkSpanError(ctx, err)
+		// codecov:ignore:end
+		return 0, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	query := `SELECT MAX(template_version) FROM message_history WHERE broadcast_id = $1 AND template_id = $2`
+
+	var version sql.NullInt64
+	if err := workspaceDB.QueryRowContext(ctx, query, broadcastID, templateID).Scan(&version); err != nil {
+		// codecov:ignore:start
+		tracing.MarkSpanError(ctx, err)
+		// codecov:ignore:end
+		return 0, fmt.Errorf("failed to get broadcast template version: %w", err)
+	}
+	if !version.Valid {
+		return 0, fmt.Errorf("no message of broadcast %s sent with template %s", broadcastID, templateID)
+	}
+
+	return version.Int64, nil
+}
+
 // DeleteForEmail redacts the email address in all message history records for a specific email
 func (r *MessageHistoryRepository) DeleteForEmail(ctx context.Context, workspaceID, email string) error {
 	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
@@ -1164,6 +1164,47 @@ func TestMessageHistoryRepository_GetBroadcastStats(t *testing.T) {
 	})
 }
 
+func TestMessageHistoryRepository_GetCombinedBroadcastStats(t *testing.T) {
+	mockWorkspaceRepo, repo, mock, db, cleanup := setupMessageHistoryTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	workspaceID := "workspace-123"
+	broadcastID := "broadcast-123"
+
+	t.Run("includes linked broadcasts", func(t *testing.T) {
+		mockWorkspaceRepo.EXPECT().
+			GetConnection(gomock.Any(), workspaceID).
+			Return(db, nil)
+
+		rows := sqlmock.NewRows([]string{
+			"total_sent", "total_delivered", "total_failed", "total_opened",
+			"total_clicked", "total_bounced", "total_complained", "total_unsubscribed",
+		}).AddRow(15, 12, 3, 7, 4, 1, 0, 1)
+
+		mock.ExpectQuery(`SELECT .* FROM message_history WHERE \(broadcast_id = \$1 OR broadcast_id IN \(SELECT id FROM broadcasts WHERE parent_broadcast_id = \$1\)\)`).
+			WithArgs(broadcastID).
+			WillReturnRows(rows)
+
+		stats, err := repo.GetCombinedBroadcastStats(ctx, workspaceID, broadcastID)
+
+		require.NoError(t, err)
+		require.NotNil(t, stats)
+		assert.Equal(t, 15, stats.TotalSent)
+		assert.Equal(t, 7, stats.TotalOpened)
+	})
+
+	t.Run("workspace connection error", func(t *testing.T) {
+		mockWorkspaceRepo.EXPECT().
+			GetConnection(gomock.Any(), workspaceID).
+			Return(nil, errors.New("connection error"))
+
+		stats, err := repo.GetCombinedBroadcastStats(ctx, workspaceID, broadcastID)
+		require.Error(t, err)
+		require.Nil(t, stats)
+	})
+}
+
 func TestMessageHistoryRepository_GetBroadcastVariationStats(t *testing.T) {
 	mockWorkspaceRepo, repo, mock, db, cleanup := setupMessageHistoryTest(t)
 	defer cleanup()
@@ -1278,6 +1319,99 @@ func TestMessageHistoryRepository_GetBroadcastVariationStats(t *testing.T) {
 	})
 }
 
+func TestMessageHistoryRepository_GetBroadcastVariationGoalRevenue(t *testing.T) {
+	mockWorkspaceRepo, repo, mock, db, cleanup := setupMessageHistoryTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	workspaceID := "workspace-123"
+	broadcastID := "broadcast-123"
+	templateID := "template-123"
+
+	t.Run("successful retrieval", func(t *testing.T) {
+		mockWorkspaceRepo.EXPECT().
+			GetConnection(gomock.Any(), workspaceID).
+			Return(db, nil)
+
+		rows := sqlmock.NewRows([]string{"count", "revenue", "revenue_squares"}).AddRow(3, 150.0, 8500.0)
+
+		mock.ExpectQuery(`(?s)FROM message_history mh\s+JOIN custom_events ce ON ce.email = mh.contact_email.*ce.occurred_at >= mh.sent_at\s+WHERE mh.broadcast_id = \$1 AND mh.template_id = \$2`).
+			WithArgs(broadcastID, templateID).
+			WillReturnRows(rows)
+
+		revenue, err := repo.GetBroadcastVariationGoalRevenue(ctx, workspaceID, broadcastID, templateID)
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.GoalRevenueSum{Conversions: 3, Revenue: 150, RevenueSquares: 8500}, revenue)
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		mockWorkspaceRepo.EXPECT().
+			GetConnection(gomock.Any(), workspaceID).
+			Return(db, nil)
+
+		mock.ExpectQuery(`FROM message_history mh`).
+			WithArgs(broadcastID, templateID).
+			WillReturnError(errors.New("query error"))
+
+		revenue, err := repo.GetBroadcastVariationGoalRevenue(ctx, workspaceID, broadcastID, templateID)
+
+		require.Error(t, err)
+		assert.Nil(t, revenue)
+	})
+}
+
+func TestMessageHistoryRepository_GetBroadcastTemplateVersion(t *testing.T) {
+	mockWorkspaceRepo, repo, mock, db, cleanup := setupMessageHistoryTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	workspaceID := "workspace-123"
+	broadcastID := "broadcast-123"
+	templateID := "template-123"
+
+	t.Run("successful retrieval", func(t *testing.T) {
+		mockWorkspaceRepo.EXPECT().
+			GetConnection(gomock.Any(), workspaceID).
+			Return(db, nil)
+
+		mock.ExpectQuery(`SELECT MAX\(template_version\) FROM message_history WHERE broadcast_id = \$1 AND template_id = \$2`).
+			WithArgs(broadcastID, templateID).
+			WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(3))
+
+		version, err := repo.GetBroadcastTemplateVersion(ctx, workspaceID, broadcastID, templateID)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), version)
+	})
+
+	t.Run("no message sent", func(t *testing.T) {
+		mockWorkspaceRepo.EXPECT().
+			GetConnection(gomock.Any(), workspaceID).
+			Return(db, nil)
+
+		mock.ExpectQuery(`SELECT MAX\(template_version\) FROM message_history`).
+			WithArgs(broadcastID, templateID).
+			WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(nil))
+
+		_, err := repo.GetBroadcastTemplateVersion(ctx, workspaceID, broadcastID, templateID)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no message of broadcast")
+	})
+
+	t.Run("connection error", func(t *testing.T) {
+		mockWorkspaceRepo.EXPECT().
+			GetConnection(gomock.Any(), workspaceID).
+			Return(nil, errors.New("connection error"))
+
+		_, err := repo.GetBroadcastTemplateVersion(ctx, workspaceID, broadcastID, templateID)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get workspace connection")
+	})
+}
+
 func TestMessageHistoryRepository_SetStatusesIfNotSet(t *testing.T) {
 	mockWorkspaceRepo, repo, mock, db, cleanup := setupMessageHistoryTest(t)
 	defer cleanup()
@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+type suppressionRepository struct {
+	workspaceRepo domain.WorkspaceRepository
+}
+
+// NewSuppressionRepository creates a new suppression repository
+func NewSuppressionRepository(workspaceRepo domain.WorkspaceRepository) domain.SuppressionRepository {
+	return &suppressionRepository{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// suppressionMatchSQL returns a condition on the suppressions row aliased "s" that
+// holds when the entry is active and blocks the address in emailExpr.
+// Wildcard patterns are turned into LIKE patterns after escaping LIKE metacharacters.
+func suppressionMatchSQL(emailExpr string) string {
+	return fmt.Sprintf(`(
+		(s.kind = 'email' AND s.pattern = %[1]s)
+		OR (s.kind = 'domain' AND s.pattern = split_part(%[1]s, '@', 2))
+		OR (s.kind = 'wildcard' AND %[1]s LIKE replace(replace(replace(replace(s.pattern, '\', '\\'), '_', '\_'), '%%', '\%%'), '*', '%%') ESCAPE '\')
+	) AND (s.expires_at IS NULL OR s.expires_at > NOW())`, emailExpr)
+}
+
+// notSuppressedSQL returns a condition excluding addresses blocked by the suppression list
+func notSuppressedSQL(emailExpr string) string {
+	return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM suppressions s WHERE %s)", suppressionMatchSQL(emailExpr))
+}
+
+// Upsert adds entries or replaces entries with the same pattern
+func (r *suppressionRepository) Upsert(ctx context.Context, workspaceID string, suppressions []*domain.Suppression) error {
+	if len(suppressions) == 0 {
+		return nil
+	}
+
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	tx, err := workspaceDB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// The WHERE clause keeps a permanent entry when a temporary one is added for the same pattern
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO suppressions (pattern, kind, reason, source, details, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		ON CONFLICT (pattern) DO UPDATE SET
+			kind = EXCLUDED.kind,
+			reason = EXCLUDED.reason,
+			source = EXCLUDED.source,
+			details = EXCLUDED.details,
+			expires_at = EXCLUDED.expires_at,
+			updated_at = NOW()
+		WHERE suppressions.expires_at IS NOT NULL OR EXCLUDED.expires_at IS NULL
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare suppression upsert: %w", err)
+	}
+	defer func() { _ = stmt.Close() }()
+
+	for _, s := range suppressions {
+		var details sql.NullString
+		if s.Details != "" {
+			details = sql.NullString{String: s.Details, Valid: true}
+		}
+		var expiresAt sql.NullTime
+		if s.ExpiresAt != nil {
+			expiresAt = sql.NullTime{Time: *s.ExpiresAt, Valid: true}
+		}
+
+		if _, err := stmt.ExecContext(ctx, s.Pattern, s.Kind, s.Reason, s.Source, details, expiresAt); err != nil {
+			return fmt.Errorf("failed to upsert suppression %s: %w", s.Pattern, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes a pattern from the suppression list
+func (r *suppressionRepository) Delete(ctx context.Context, workspaceID string, pattern string) error {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	result, err := workspaceDB.ExecContext(ctx, `DELETE FROM suppressions WHERE pattern = $1`, pattern)
+	if err != nil {
+		return fmt.Errorf("failed to delete suppression: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrSuppressionNotFound, pattern)
+	}
+
+	return nil
+}
+
+// List returns a page of the suppression list and its total count
+func (r *suppressionRepository) List(ctx context.Context, req *domain.ListSuppressionsRequest) ([]*domain.Suppression, int, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	filters := sq.And{}
+	if req.Kind != "" {
+		filters = append(filters, sq.Eq{"kind": req.Kind})
+	}
+	if req.Reason != "" {
+		filters = append(filters, sq.Eq{"reason": req.Reason})
+	}
+	if req.Search != "" {
+		filters = append(filters, sq.ILike{"pattern": "%" + strings.ToLower(req.Search) + "%"})
+	}
+
+	countBuilder := psql.Select("COUNT(*)").From("suppressions")
+	builder := psql.Select("pattern", "kind", "reason", "source", "details", "expires_at", "created_at", "updated_at").
+		From("suppressions").
+		OrderBy("created_at DESC", "pattern ASC")
+	if len(filters) > 0 {
+		countBuilder = countBuilder.Where(filters)
+		builder = builder.Where(filters)
+	}
+	if req.Limit > 0 {
+		builder = builder.Limit(uint64(req.Limit)).Offset(uint64(req.Offset))
+	}
+
+	countQuery, countArgs, err := countBuilder.ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to build count query: %w", err)
+	}
+
+	var total int
+	if err := workspaceDB.QueryRowContext(ctx, countQuery, countArgs...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count suppressions: %w", err)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to build query: %w", err)
+	}
+
+	rows, err := workspaceDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list suppressions: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	suppressions := []*domain.Suppression{}
+	for rows.Next() {
+		s, err := scanSuppression(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		suppressions = append(suppressions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating suppressions: %w", err)
+	}
+
+	return suppressions, total, nil
+}
+
+// FindMatches returns the active entries blocking each of the given emails
+func (r *suppressionRepository) FindMatches(ctx context.Context, workspaceID string, emails []string) (map[string][]*domain.Suppression, error) {
+	matches := make(map[string][]*domain.Suppression)
+	if len(emails) == 0 {
+		return matches, nil
+	}
+
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT e.email, s.pattern, s.kind, s.reason, s.source, s.details, s.expires_at, s.created_at, s.updated_at
+		FROM unnest($1::text[]) AS e(email)
+		JOIN suppressions s ON %s
+		ORDER BY e.email, s.created_at
+	`, suppressionMatchSQL("e.email"))
+
+	rows, err := workspaceDB.QueryContext(ctx, query, pq.Array(emails))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find suppressions: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var email string
+		s, err := scanSuppression(rows, &email)
+		if err != nil {
+			return nil, err
+		}
+		matches[email] = append(matches[email], s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating suppressions: %w", err)
+	}
+
+	return matches, nil
+}
+
+// scanSuppression scans a suppressions row, preceded by the optional leading columns
+func scanSuppression(scanner interface{ Scan(...interface{}) error }, leading ...interface{}) (*domain.Suppression, error) {
+	var s domain.Suppression
+	var details sql.NullString
+	var expiresAt sql.NullTime
+	dest := append(leading, &s.Pattern, &s.Kind, &s.Reason, &s.Source, &details, &expiresAt, &s.CreatedAt, &s.UpdatedAt)
+	if err := scanner.Scan(dest...); err != nil {
+		return nil, fmt.Errorf("failed to scan suppression: %w", err)
+	}
+	s.Details = details.String
+	if expiresAt.Valid {
+		s.ExpiresAt = &expiresAt.Time
+	}
+	return &s, nil
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+)
+
+var suppressionColumns = []string{"pattern", "kind", "reason", "source", "details", "expires_at", "created_at", "updated_at"}
+
+func setupSuppressionRepositoryTest(t *testing.T) (domain.SuppressionRepository, sqlmock.Sqlmock) {
+	mockDB, mock, cleanup := setupMockDB(t)
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() {
+		cleanup()
+		ctrl.Finish()
+	})
+
+	workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil).AnyTimes()
+	return NewSuppressionRepository(workspaceRepo), mock
+}
+
+func TestSuppressionRepository_Upsert(t *testing.T) {
+	expiresAt := time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC)
+	suppressions := []*domain.Suppression{
+		{Pattern: "john@example.com", Kind: domain.SuppressionKindEmail, Reason: domain.SuppressionReasonHardBounce, Source: domain.SuppressionSourceProvider, Details: "550 mailbox unavailable"},
+		{Pattern: "example.org", Kind: domain.SuppressionKindDomain, Reason: domain.SuppressionReasonSoftBounce, Source: domain.SuppressionSourceProvider, ExpiresAt: &expiresAt},
+	}
+
+	t.Run("upserts every entry in a transaction", func(t *testing.T) {
+		repo, mock := setupSuppressionRepositoryTest(t)
+
+		mock.ExpectBegin()
+		prepare := mock.ExpectPrepare(`INSERT INTO suppressions (.|\n)*ON CONFLICT \(pattern\) DO UPDATE(.|\n)*WHERE suppressions\.expires_at IS NOT NULL OR EXCLUDED\.expires_at IS NULL`)
+		prepare.ExpectExec().
+			WithArgs("john@example.com", domain.SuppressionKindEmail, domain.SuppressionReasonHardBounce, domain.SuppressionSourceProvider,
+				sql.NullString{String: "550 mailbox unavailable", Valid: true}, sql.NullTime{}).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		prepare.ExpectExec().
+			WithArgs("example.org", domain.SuppressionKindDomain, domain.SuppressionReasonSoftBounce, domain.SuppressionSourceProvider,
+				sql.NullString{}, sql.NullTime{Time: expiresAt, Valid: true}).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.Upsert(context.Background(), "workspace123", suppressions))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back on error", func(t *testing.T) {
+		repo, mock := setupSuppressionRepositoryTest(t)
+
+		mock.ExpectBegin()
+		mock.ExpectPrepare(`INSERT INTO suppressions`).ExpectExec().WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		err := repo.Upsert(context.Background(), "workspace123", suppressions)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to upsert suppression john@example.com")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("does nothing without entries", func(t *testing.T) {
+		repo, mock := setupSuppressionRepositoryTest(t)
+
+		require.NoError(t, repo.Upsert(context.Background(), "workspace123", nil))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSuppressionRepository_Delete(t *testing.T) {
+	t.Run("deletes the pattern", func(t *testing.T) {
+		repo, mock := setupSuppressionRepositoryTest(t)
+		mock.ExpectExec(`DELETE FROM suppressions WHERE pattern = \$1`).
+			WithArgs("example.org").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		require.NoError(t, repo.Delete(context.Background(), "workspace123", "example.org"))
+	})
+
+	t.Run("returns not found", func(t *testing.T) {
+		repo, mock := setupSuppressionRepositoryTest(t)
+		mock.ExpectExec(`DELETE FROM suppressions WHERE pattern = \$1`).
+			WithArgs("example.org").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Delete(context.Background(), "workspace123", "example.org")
+		assert.ErrorIs(t, err, domain.ErrSuppressionNotFound)
+	})
+}
+
+func TestSuppressionRepository_List(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("lists a filtered page", func(t *testing.T) {
+		repo, mock := setupSuppressionRepositoryTest(t)
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM suppressions WHERE \(reason = \$1 AND pattern ILIKE \$2\)`).
+			WithArgs(domain.SuppressionReasonComplaint, "%example%").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+		mock.ExpectQuery(`SELECT pattern, kind, reason, source, details, expires_at, created_at, updated_at FROM suppressions WHERE \(reason = \$1 AND pattern ILIKE \$2\) ORDER BY created_at DESC, pattern ASC LIMIT 2 OFFSET 0`).
+			WithArgs(domain.SuppressionReasonComplaint, "%example%").
+			WillReturnRows(sqlmock.NewRows(suppressionColumns).
+				AddRow("john@example.com", "email", "complaint", "provider", nil, nil, createdAt, createdAt).
+				AddRow("jane@example.com", "email", "complaint", "api", "reported", createdAt.Add(time.Hour), createdAt, createdAt))
+
+		suppressions, total, err := repo.List(context.Background(), &domain.ListSuppressionsRequest{
+			WorkspaceID: "workspace123",
+			Reason:      domain.SuppressionReasonComplaint,
+			Search:      "Example",
+			Limit:       2,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+		require.Len(t, suppressions, 2)
+		assert.Nil(t, suppressions[0].ExpiresAt)
+		assert.Equal(t, "reported", suppressions[1].Details)
+		require.NotNil(t, suppressions[1].ExpiresAt)
+	})
+
+	t.Run("lists everything without a limit", func(t *testing.T) {
+		repo, mock := setupSuppressionRepositoryTest(t)
+
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM suppressions`).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(`FROM suppressions ORDER BY created_at DESC, pattern ASC$`).
+			WillReturnRows(sqlmock.NewRows(suppressionColumns))
+
+		suppressions, total, err := repo.List(context.Background(), &domain.ListSuppressionsRequest{WorkspaceID: "workspace123"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, total)
+		assert.Empty(t, suppressions)
+	})
+}
+
+func TestSuppressionRepository_FindMatches(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("groups active matches by email", func(t *testing.T) {
+		repo, mock := setupSuppressionRepositoryTest(t)
+		emails := []string{"john@example.com", "jane@test.com"}
+
+		mock.ExpectQuery(`FROM unnest\(\$1::text\[\]\) AS e\(email\) JOIN suppressions s ON (.|\n)*s\.pattern = split_part\(e\.email, '@', 2\)(.|\n)*s\.expires_at > NOW\(\)`).
+			WithArgs(pq.Array(emails)).
+			WillReturnRows(sqlmock.NewRows(append([]string{"email"}, suppressionColumns...)).
+				AddRow("john@example.com", "john@example.com", "email", "hard_bounce", "provider", nil, nil, createdAt, createdAt).
+				AddRow("john@example.com", "example.com", "domain", "manual", "api", nil, nil, createdAt, createdAt))
+
+		matches, err := repo.FindMatches(context.Background(), "workspace123", emails)
+
+		require.NoError(t, err)
+		require.Len(t, matches["john@example.com"], 2)
+		assert.Equal(t, domain.SuppressionKindDomain, matches["john@example.com"][1].Kind)
+		assert.NotContains(t, matches, "jane@test.com")
+	})
+
+	t.Run("returns an empty map without emails", func(t *testing.T) {
+		repo, _ := setupSuppressionRepositoryTest(t)
+
+		matches, err := repo.FindMatches(context.Background(), "workspace123", nil)
+
+		require.NoError(t, err)
+		assert.Empty(t, matches)
+	})
+}
@@ -38,6 +38,12 @@ func (r *templateRepository) CreateTemplate(ctx context.Context, workspaceID str
 		template.Version = 1
 	}
 
+	// The first version is live right away, nothing references the template yet
+	if template.PublishedAt == nil {
+		template.PublishedAt = &now
+		template.PublishedBy = template.CreatedBy
+	}
+
 	// Normalize nil translations to empty map for consistent JSONB storage
 	translations := template.Translations
 	if translations == nil {
@@ -65,9 +71,12 @@ func (r *templateRepository) CreateTemplate(ctx context.Context, workspaceID str
 			settings,
 			translations,
 			created_at,
-			updated_at
+			updated_at,
+			created_by,
+			published_at,
+			published_by
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 	`
 	_, err = workspaceDB.ExecContext(ctx, query,
 		template.ID,
@@ -84,6 +93,9 @@ func (r *templateRepository) CreateTemplate(ctx context.Context, workspaceID str
 		translationsJSON,
 		template.CreatedAt,
 		template.UpdatedAt,
+		template.CreatedBy,
+		template.PublishedAt,
+		template.PublishedBy,
 	)
 
 	if err != nil {
@@ -119,7 +131,10 @@ func (r *templateRepository) GetTemplateByID(ctx context.Context, workspaceID st
 				settings,
 				translations,
 				created_at,
-				updated_at
+				updated_at,
+				created_by,
+				published_at,
+				published_by
 			FROM templates
 			WHERE id = $1 AND version = $2
 		`
@@ -141,7 +156,10 @@ func (r *templateRepository) GetTemplateByID(ctx context.Context, workspaceID st
 				settings,
 				translations,
 				created_at,
-				updated_at
+				updated_at,
+				created_by,
+				published_at,
+				published_by
 			FROM templates
 			WHERE id = $1
 			ORDER BY version DESC
@@ -221,6 +239,9 @@ func (r *templateRepository) GetTemplates(ctx context.Context, workspaceID strin
 		"t.translations",
 		"t.created_at",
 		"t.updated_at",
+		"t.created_by",
+		"t.published_at",
+		"t.published_by",
 	).Prefix(latestVersionsCTE).
 		From("templates t").
 		Join("latest_versions lv ON t.id = lv.id AND t.version = lv.max_version").
@@ -291,7 +312,8 @@ func (r *templateRepository) UpdateTemplate(ctx context.Context, workspaceID str
 		return fmt.Errorf("failed to marshal translations: %w", err)
 	}
 
-	// Create a new version instead of updating the existing one
+	// Create a new version instead of updating the existing one. It stays a draft
+	// unless PublishedAt is set, as for a rollback.
 	query := `
 		INSERT INTO templates (
 			id,
@@ -307,9 +329,12 @@ func (r *templateRepository) UpdateTemplate(ctx context.Context, workspaceID str
 			settings,
 			translations,
 			created_at,
-			updated_at
+			updated_at,
+			created_by,
+			published_at,
+			published_by
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 	`
 	_, err = workspaceDB.ExecContext(ctx, query,
 		template.ID,
@@ -326,6 +351,9 @@ func (r *templateRepository) UpdateTemplate(ctx context.Context, workspaceID str
 		translationsJSON,
 		template.CreatedAt,
 		template.UpdatedAt,
+		template.CreatedBy,
+		template.PublishedAt,
+		template.PublishedBy,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to update template: %w", err)
@@ -334,6 +362,138 @@ func (r *templateRepository) UpdateTemplate(ctx context.Context, workspaceID str
 	return nil
 }
 
+func (r *templateRepository) GetPublishedTemplate(ctx context.Context, workspaceID string, id string) (*domain.Template, error) {
+	// Get the workspace database connection
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	// The last version published is live, a rollback publishes an older content as a new version
+	query := `
+		SELECT
+			id,
+			name,
+			version,
+			channel,
+			email,
+			web,
+			category,
+			template_macro_id,
+			integration_id,
+			test_data,
+			settings,
+			translations,
+			created_at,
+			updated_at,
+			created_by,
+			published_at,
+			published_by
+		FROM templates
+		WHERE id = $1 AND published_at IS NOT NULL
+		ORDER BY published_at DESC, version DESC
+		LIMIT 1
+	`
+
+	template, err := scanTemplate(workspaceDB.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, &domain.ErrTemplateNotFound{Message: "template not found or not published"}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get published template: %w", err)
+	}
+
+	return template, nil
+}
+
+func (r *templateRepository) GetTemplateVersions(ctx context.Context, workspaceID string, id string) ([]*domain.TemplateVersion, error) {
+	// Get the workspace database connection
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	query := `
+		SELECT version, name, created_by, updated_at, published_at, published_by
+		FROM templates
+		WHERE id = $1
+		ORDER BY version DESC
+	`
+
+	rows, err := workspaceDB.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get template versions: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var versions []*domain.TemplateVersion
+	var live *domain.TemplateVersion
+	for rows.Next() {
+		var (
+			version     domain.TemplateVersion
+			createdBy   sql.NullString
+			publishedAt sql.NullTime
+			publishedBy sql.NullString
+		)
+		if err := rows.Scan(&version.Version, &version.Name, &createdBy, &version.CreatedAt, &publishedAt, &publishedBy); err != nil {
+			return nil, fmt.Errorf("failed to scan template version: %w", err)
+		}
+		if createdBy.Valid {
+			version.CreatedBy = &createdBy.String
+		}
+		if publishedBy.Valid {
+			version.PublishedBy = &publishedBy.String
+		}
+		if publishedAt.Valid {
+			version.PublishedAt = &publishedAt.Time
+			// Versions are sorted newest first, so the first one on a tie wins
+			if live == nil || publishedAt.Time.After(*live.PublishedAt) {
+				live = &version
+			}
+		}
+		versions = append(versions, &version)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating template version rows: %w", err)
+	}
+
+	if len(versions) == 0 {
+		return nil, &domain.ErrTemplateNotFound{Message: "template not found"}
+	}
+	if live != nil {
+		live.Live = true
+	}
+
+	return versions, nil
+}
+
+func (r *templateRepository) PublishTemplate(ctx context.Context, workspaceID string, id string, version int64, publishedBy string) error {
+	// Get the workspace database connection
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	query := `UPDATE templates SET published_at = $3, published_by = $4 WHERE id = $1 AND version = $2`
+
+	result, err := workspaceDB.ExecContext(ctx, query, id, version, time.Now().UTC(), publishedBy)
+	if err != nil {
+		return fmt.Errorf("failed to publish template: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if rows == 0 {
+		return &domain.ErrTemplateNotFound{Message: "template not found"}
+	}
+
+	return nil
+}
+
 func (r *templateRepository) DeleteTemplate(ctx context.Context, workspaceID string, id string) error {
 	// Get the workspace database connection
 	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
@@ -370,6 +530,9 @@ func scanTemplate(scanner interface {
 		templateMacroID  sql.NullString
 		integrationID    sql.NullString
 		translationsJSON []byte
+		createdBy        sql.NullString
+		publishedAt      sql.NullTime
+		publishedBy      sql.NullString
 	)
 
 	err := scanner.Scan(
@@ -387,6 +550,9 @@ func scanTemplate(scanner interface {
 		&translationsJSON,
 		&template.CreatedAt,
 		&template.UpdatedAt,
+		&createdBy,
+		&publishedAt,
+		&publishedBy,
 	)
 	if err != nil {
 		return nil, err
@@ -399,6 +565,15 @@ func scanTemplate(scanner interface {
 	if integrationID.Valid {
 		template.IntegrationID = &integrationID.String
 	}
+	if createdBy.Valid {
+		template.CreatedBy = &createdBy.String
+	}
+	if publishedAt.Valid {
+		template.PublishedAt = &publishedAt.Time
+	}
+	if publishedBy.Valid {
+		template.PublishedBy = &publishedBy.String
+	}
 
 	// Unmarshal translations JSON, always initialize to empty map for consistency
 	template.Translations = make(map[string]domain.TemplateTranslation)
@@ -263,12 +263,13 @@ func TestTemplateRepository_CreateTemplate(t *testing.T) {
 		INSERT INTO templates (
 			id, name, version, channel, email, web, category, template_macro_id, integration_id,
 			test_data, settings, translations,
-			created_at, updated_at
+			created_at, updated_at, created_by, published_at, published_by
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 	`)).WithArgs(
 		template.ID, template.Name, 1, template.Channel, template.Email, template.Web, template.Category,
 		nil, template.IntegrationID, template.TestData, template.Settings, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), // translations, created_at, updated_at
+		nil, sqlmock.AnyArg(), nil, // created_by, published_at (first version is published), published_by
 	).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err := repo.CreateTemplate(ctx, workspaceID, template)
@@ -298,6 +299,7 @@ func TestTemplateRepository_CreateTemplate(t *testing.T) {
 		WithArgs(
 			template.ID, template.Name, 1, template.Channel, template.Email, template.Web, template.Category,
 			nil, template.IntegrationID, template.TestData, template.Settings, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			nil, sqlmock.AnyArg(), nil,
 		).WillReturnError(fmt.Errorf("db insert error"))
 
 	err = repo.CreateTemplate(ctx, workspaceID, template)
@@ -332,17 +334,17 @@ func TestTemplateRepository_GetTemplateByID(t *testing.T) {
 	templateID := template.ID
 	version := template.Version
 
-	columns := []string{"id", "name", "version", "channel", "email", "web", "category", "template_macro_id", "integration_id", "test_data", "settings", "translations", "created_at", "updated_at"}
+	columns := []string{"id", "name", "version", "channel", "email", "web", "category", "template_macro_id", "integration_id", "test_data", "settings", "translations", "created_at", "updated_at", "created_by", "published_at", "published_by"}
 
 	// === Test Case 1: Get Latest Version (version = 0) ===
 	mockWorkspaceRepo.On("GetConnection", ctx, workspaceID).Return(db, nil).Once()
 	rowsLatest := sqlmock.NewRows(columns).
-		AddRow(templateID, template.Name, version, template.Channel, template.Email, template.Web, template.Category, nil, template.IntegrationID, template.TestData, template.Settings, nil, template.CreatedAt, template.UpdatedAt)
+		AddRow(templateID, template.Name, version, template.Channel, template.Email, template.Web, template.Category, nil, template.IntegrationID, template.TestData, template.Settings, nil, template.CreatedAt, template.UpdatedAt, nil, nil, nil)
 	mockSQL.ExpectQuery(regexp.QuoteMeta(`
 			SELECT
 				id, name, version, channel, email, web, category, template_macro_id, integration_id,
 				test_data, settings, translations,
-				created_at, updated_at
+				created_at, updated_at, created_by, published_at, published_by
 			FROM templates
 			WHERE id = $1
 			ORDER BY version DESC
@@ -368,12 +370,12 @@ func TestTemplateRepository_GetTemplateByID(t *testing.T) {
 	// === Test Case 2: Get Specific Version ===
 	mockWorkspaceRepo.On("GetConnection", ctx, workspaceID).Return(db, nil).Once()
 	rowsSpecific := sqlmock.NewRows(columns).
-		AddRow(templateID, template.Name, version, template.Channel, template.Email, template.Web, template.Category, nil, template.IntegrationID, template.TestData, template.Settings, nil, template.CreatedAt, template.UpdatedAt)
+		AddRow(templateID, template.Name, version, template.Channel, template.Email, template.Web, template.Category, nil, template.IntegrationID, template.TestData, template.Settings, nil, template.CreatedAt, template.UpdatedAt, nil, nil, nil)
 	mockSQL.ExpectQuery(regexp.QuoteMeta(`
 			SELECT
 				id, name, version, channel, email, web, category, template_macro_id, integration_id,
 				test_data, settings, translations,
-				created_at, updated_at
+				created_at, updated_at, created_by, published_at, published_by
 			FROM templates
 			WHERE id = $1 AND version = $2
 		`)).WithArgs(templateID, version).WillReturnRows(rowsSpecific)
@@ -426,7 +428,7 @@ func TestTemplateRepository_GetTemplateByID(t *testing.T) {
 	// === Test Case 6: JSON Unmarshal Error (Simulated by invalid JSON) ===
 	mockWorkspaceRepo.On("GetConnection", ctx, workspaceID).Return(db, nil).Once()
 	rowsInvalidJSON := sqlmock.NewRows(columns).
-		AddRow(templateID, template.Name, version, template.Channel, nil, nil, template.Category, nil, nil, template.TestData, template.Settings, nil, template.CreatedAt, template.UpdatedAt).
+		AddRow(templateID, template.Name, version, template.Channel, nil, nil, template.Category, nil, nil, template.TestData, template.Settings, nil, template.CreatedAt, template.UpdatedAt, nil, nil, nil).
 		RowError(0, fmt.Errorf("scan error"))
 	mockSQL.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, version, channel, email, web, category`)).WithArgs(templateID, version).WillReturnRows(rowsInvalidJSON)
 
@@ -521,14 +523,14 @@ func TestTemplateRepository_GetTemplates(t *testing.T) {
 	tmpl2.Version = 1 // Latest version for tmpl-2
 	tmpl2.UpdatedAt = time.Now().UTC()
 
-	columns := []string{"id", "name", "version", "channel", "email", "web", "category", "template_macro_id", "integration_id", "test_data", "settings", "translations", "created_at", "updated_at"}
+	columns := []string{"id", "name", "version", "channel", "email", "web", "category", "template_macro_id", "integration_id", "test_data", "settings", "translations", "created_at", "updated_at", "created_by", "published_at", "published_by"}
 
 	// === Test Case 1: Success - No Category Filter ===
 	t.Run("Success - No Category Filter", func(t *testing.T) {
 		mockWorkspaceRepo.On("GetConnection", ctx, workspaceID).Return(db, nil).Once()
 		rows := sqlmock.NewRows(columns).
-			AddRow(tmpl2.ID, tmpl2.Name, tmpl2.Version, tmpl2.Channel, tmpl2.Email, tmpl2.Web, tmpl2.Category, nil, tmpl2.IntegrationID, tmpl2.TestData, tmpl2.Settings, nil, tmpl2.CreatedAt, tmpl2.UpdatedAt). // tmpl2 is newer
-			AddRow(tmpl1.ID, tmpl1.Name, tmpl1.Version, tmpl1.Channel, tmpl1.Email, tmpl1.Web, tmpl1.Category, nil, tmpl1.IntegrationID, tmpl1.TestData, tmpl1.Settings, nil, tmpl1.CreatedAt, tmpl1.UpdatedAt)
+			AddRow(tmpl2.ID, tmpl2.Name, tmpl2.Version, tmpl2.Channel, tmpl2.Email, tmpl2.Web, tmpl2.Category, nil, tmpl2.IntegrationID, tmpl2.TestData, tmpl2.Settings, nil, tmpl2.CreatedAt, tmpl2.UpdatedAt, nil, nil, nil). // tmpl2 is newer
+			AddRow(tmpl1.ID, tmpl1.Name, tmpl1.Version, tmpl1.Channel, tmpl1.Email, tmpl1.Web, tmpl1.Category, nil, tmpl1.IntegrationID, tmpl1.TestData, tmpl1.Settings, nil, tmpl1.CreatedAt, tmpl1.UpdatedAt, nil, nil, nil)
 
 		// Expect squirrel generated query
 		expectedQuery := `
@@ -537,7 +539,7 @@ func TestTemplateRepository_GetTemplates(t *testing.T) {
 				FROM templates
 				GROUP BY id
 			)
-			SELECT t.id, t.name, t.version, t.channel, t.email, t.web, t.category, t.template_macro_id, t.integration_id, t.test_data, t.settings, t.translations, t.created_at, t.updated_at
+			SELECT t.id, t.name, t.version, t.channel, t.email, t.web, t.category, t.template_macro_id, t.integration_id, t.test_data, t.settings, t.translations, t.created_at, t.updated_at, t.created_by, t.published_at, t.published_by
 			FROM templates t JOIN latest_versions lv ON t.id = lv.id AND t.version = lv.max_version
 			WHERE t.deleted_at IS NULL
 			ORDER BY t.updated_at DESC
@@ -563,7 +565,7 @@ func TestTemplateRepository_GetTemplates(t *testing.T) {
 		// Only tmpl2 should match if we assume tmpl1 has a different category or filter matches tmpl2's category
 		// Let's assume both have the same category for this test, but only return one for simplicity of setup
 		rowsFiltered := sqlmock.NewRows(columns).
-			AddRow(tmpl2.ID, tmpl2.Name, tmpl2.Version, tmpl2.Channel, tmpl2.Email, tmpl2.Web, filterCategory, nil, tmpl2.IntegrationID, tmpl2.TestData, tmpl2.Settings, nil, tmpl2.CreatedAt, tmpl2.UpdatedAt)
+			AddRow(tmpl2.ID, tmpl2.Name, tmpl2.Version, tmpl2.Channel, tmpl2.Email, tmpl2.Web, filterCategory, nil, tmpl2.IntegrationID, tmpl2.TestData, tmpl2.Settings, nil, tmpl2.CreatedAt, tmpl2.UpdatedAt, nil, nil, nil)
 
 		// Expect squirrel generated query with category filter
 		expectedFilteredQuery := `
@@ -572,7 +574,7 @@ func TestTemplateRepository_GetTemplates(t *testing.T) {
 				FROM templates
 				GROUP BY id
 			)
-			SELECT t.id, t.name, t.version, t.channel, t.email, t.web, t.category, t.template_macro_id, t.integration_id, t.test_data, t.settings, t.translations, t.created_at, t.updated_at
+			SELECT t.id, t.name, t.version, t.channel, t.email, t.web, t.category, t.template_macro_id, t.integration_id, t.test_data, t.settings, t.translations, t.created_at, t.updated_at, t.created_by, t.published_at, t.published_by
 			FROM templates t JOIN latest_versions lv ON t.id = lv.id AND t.version = lv.max_version
 			WHERE t.deleted_at IS NULL AND t.category = $1
 			ORDER BY t.updated_at DESC
@@ -594,7 +596,7 @@ func TestTemplateRepository_GetTemplates(t *testing.T) {
 		mockWorkspaceRepo.On("GetConnection", ctx, workspaceID).Return(db, nil).Once()
 		// Only return email templates
 		rowsFiltered := sqlmock.NewRows(columns).
-			AddRow(tmpl2.ID, tmpl2.Name, tmpl2.Version, tmpl2.Channel, tmpl2.Email, tmpl2.Web, tmpl2.Category, nil, tmpl2.IntegrationID, tmpl2.TestData, tmpl2.Settings, nil, tmpl2.CreatedAt, tmpl2.UpdatedAt)
+			AddRow(tmpl2.ID, tmpl2.Name, tmpl2.Version, tmpl2.Channel, tmpl2.Email, tmpl2.Web, tmpl2.Category, nil, tmpl2.IntegrationID, tmpl2.TestData, tmpl2.Settings, nil, tmpl2.CreatedAt, tmpl2.UpdatedAt, nil, nil, nil)
 
 		// Expect squirrel generated query with channel filter
 		expectedChannelQuery := `
@@ -603,7 +605,7 @@ func TestTemplateRepository_GetTemplates(t *testing.T) {
 				FROM templates
 				GROUP BY id
 			)
-			SELECT t.id, t.name, t.version, t.channel, t.email, t.web, t.category, t.template_macro_id, t.integration_id, t.test_data, t.settings, t.translations, t.created_at, t.updated_at
+			SELECT t.id, t.name, t.version, t.channel, t.email, t.web, t.category, t.template_macro_id, t.integration_id, t.test_data, t.settings, t.translations, t.created_at, t.updated_at, t.created_by, t.published_at, t.published_by
 			FROM templates t JOIN latest_versions lv ON t.id = lv.id AND t.version = lv.max_version
 			WHERE t.deleted_at IS NULL AND t.channel = $1
 			ORDER BY t.updated_at DESC
@@ -625,7 +627,7 @@ func TestTemplateRepository_GetTemplates(t *testing.T) {
 		filterCategory := "Test Category"
 		mockWorkspaceRepo.On("GetConnection", ctx, workspaceID).Return(db, nil).Once()
 		rowsFiltered := sqlmock.NewRows(columns).
-			AddRow(tmpl2.ID, tmpl2.Name, tmpl2.Version, tmpl2.Channel, tmpl2.Email, tmpl2.Web, filterCategory, nil, tmpl2.IntegrationID, tmpl2.TestData, tmpl2.Settings, nil, tmpl2.CreatedAt, tmpl2.UpdatedAt)
+			AddRow(tmpl2.ID, tmpl2.Name, tmpl2.Version, tmpl2.Channel, tmpl2.Email, tmpl2.Web, filterCategory, nil, tmpl2.IntegrationID, tmpl2.TestData, tmpl2.Settings, nil, tmpl2.CreatedAt, tmpl2.UpdatedAt, nil, nil, nil)
 
 		// Expect squirrel generated query with both filters
 		expectedBothQuery := `
@@ -634,7 +636,7 @@ func TestTemplateRepository_GetTemplates(t *testing.T) {
 				FROM templates
 				GROUP BY id
 			)
-			SELECT t.id, t.name, t.version, t.channel, t.email, t.web, t.category, t.template_macro_id, t.integration_id, t.test_data, t.settings, t.translations, t.created_at, t.updated_at
+			SELECT t.id, t.name, t.version, t.channel, t.email, t.web, t.category, t.template_macro_id, t.integration_id, t.test_data, t.settings, t.translations, t.created_at, t.updated_at, t.created_by, t.published_at, t.published_by
 			FROM templates t JOIN latest_versions lv ON t.id = lv.id AND t.version = lv.max_version
 			WHERE t.deleted_at IS NULL AND t.category = $1 AND t.channel = $2
 			ORDER BY t.updated_at DESC
@@ -696,7 +698,7 @@ func TestTemplateRepository_GetTemplates(t *testing.T) {
 	t.Run("Row Scan Error", func(t *testing.T) {
 		mockWorkspaceRepo.On("GetConnection", ctx, workspaceID).Return(db, nil).Once()
 		invalidJSONRows := sqlmock.NewRows(columns).
-			AddRow(tmpl1.ID, tmpl1.Name, tmpl1.Version, tmpl1.Channel, nil, nil, tmpl1.Category, nil, nil, tmpl1.TestData, tmpl1.Settings, nil, tmpl1.CreatedAt, tmpl1.UpdatedAt).
+			AddRow(tmpl1.ID, tmpl1.Name, tmpl1.Version, tmpl1.Channel, nil, nil, tmpl1.Category, nil, nil, tmpl1.TestData, tmpl1.Settings, nil, tmpl1.CreatedAt, tmpl1.UpdatedAt, nil, nil, nil).
 			RowError(0, fmt.Errorf("scan error")) // Simulate scan error on the first row
 		expectedQuery := `
 			WITH latest_versions AS \(.*\)
@@ -764,7 +766,7 @@ func TestTemplateRepository_UpdateTemplate(t *testing.T) {
 		mockSQL.ExpectExec(regexp.QuoteMeta(`INSERT INTO templates`)).WithArgs(
 			updatedTemplate.ID, updatedTemplate.Name, expectedNewVersion, updatedTemplate.Channel, emailJSON, nil,
 			updatedTemplate.Category, nil, updatedTemplate.IntegrationID, testDataJSON, settingsJSON,
-			sqlmock.AnyArg(), updatedTemplate.CreatedAt, sqlmock.AnyArg(),
+			sqlmock.AnyArg(), updatedTemplate.CreatedAt, sqlmock.AnyArg(), nil, nil, nil,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		err := repo.UpdateTemplate(ctx, workspaceID, &updatedTemplate)
@@ -858,7 +860,7 @@ func TestTemplateRepository_UpdateTemplate(t *testing.T) {
 			WithArgs(
 				updatedTemplate.ID, updatedTemplate.Name, expectedNewVersion, updatedTemplate.Channel, emailJSON, nil,
 				updatedTemplate.Category, nil, updatedTemplate.IntegrationID, testDataJSON, settingsJSON,
-				sqlmock.AnyArg(), updatedTemplate.CreatedAt, sqlmock.AnyArg(),
+				sqlmock.AnyArg(), updatedTemplate.CreatedAt, sqlmock.AnyArg(), nil, nil, nil,
 			).WillReturnError(fmt.Errorf("db insert error"))
 
 		err := repo.UpdateTemplate(ctx, workspaceID, &updatedTemplate)
@@ -951,12 +953,12 @@ func TestTemplateRepository_TranslationsNilConsistency(t *testing.T) {
 	workspaceID := "ws-1"
 	template := createTestTemplate()
 
-	columns := []string{"id", "name", "version", "channel", "email", "web", "category", "template_macro_id", "integration_id", "test_data", "settings", "translations", "created_at", "updated_at"}
+	columns := []string{"id", "name", "version", "channel", "email", "web", "category", "template_macro_id", "integration_id", "test_data", "settings", "translations", "created_at", "updated_at", "created_by", "published_at", "published_by"}
 
 	t.Run("nil translations from DB returns empty map not nil", func(t *testing.T) {
 		mockWorkspaceRepo.On("GetConnection", ctx, workspaceID).Return(db, nil).Once()
 		rows := sqlmock.NewRows(columns).
-			AddRow(template.ID, template.Name, template.Version, template.Channel, template.Email, template.Web, template.Category, nil, template.IntegrationID, template.TestData, template.Settings, nil, template.CreatedAt, template.UpdatedAt)
+			AddRow(template.ID, template.Name, template.Version, template.Channel, template.Email, template.Web, template.Category, nil, template.IntegrationID, template.TestData, template.Settings, nil, template.CreatedAt, template.UpdatedAt, nil, nil, nil)
 		mockSQL.ExpectQuery(regexp.QuoteMeta(`SELECT`)).WithArgs(template.ID).WillReturnRows(rows)
 
 		result, err := repo.GetTemplateByID(ctx, workspaceID, template.ID, 0)
@@ -969,7 +971,7 @@ func TestTemplateRepository_TranslationsNilConsistency(t *testing.T) {
 	t.Run("empty JSON object from DB returns empty map", func(t *testing.T) {
 		mockWorkspaceRepo.On("GetConnection", ctx, workspaceID).Return(db, nil).Once()
 		rows := sqlmock.NewRows(columns).
-			AddRow(template.ID, template.Name, template.Version, template.Channel, template.Email, template.Web, template.Category, nil, template.IntegrationID, template.TestData, template.Settings, []byte(`{}`), template.CreatedAt, template.UpdatedAt)
+			AddRow(template.ID, template.Name, template.Version, template.Channel, template.Email, template.Web, template.Category, nil, template.IntegrationID, template.TestData, template.Settings, []byte(`{}`), template.CreatedAt, template.UpdatedAt, nil, nil, nil)
 		mockSQL.ExpectQuery(regexp.QuoteMeta(`SELECT`)).WithArgs(template.ID).WillReturnRows(rows)
 
 		result, err := repo.GetTemplateByID(ctx, workspaceID, template.ID, 0)
@@ -990,9 +992,127 @@ func TestTemplateRepository_TranslationsNilConsistency(t *testing.T) {
 				nil, tpl.IntegrationID, tpl.TestData, tpl.Settings,
 				[]byte(`{}`), // should be empty JSON object, not "null"
 				sqlmock.AnyArg(), sqlmock.AnyArg(),
+				nil, sqlmock.AnyArg(), nil,
 			).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		err := repo.CreateTemplate(ctx, workspaceID, tpl)
 		require.NoError(t, err)
 	})
 }
+
+func TestTemplateRepository_GetPublishedTemplate(t *testing.T) {
+	db, mockSQL, cleanup := testutil.SetupMockDB(t)
+	defer cleanup()
+
+	mockWorkspaceRepo := new(MockWorkspaceRepository)
+	repo := NewTemplateRepository(mockWorkspaceRepo)
+
+	ctx := context.Background()
+	workspaceID := "ws-1"
+	template := createTestTemplate()
+	publishedAt := template.UpdatedAt
+	columns := []string{"id", "name", "version", "channel", "email", "web", "category", "template_macro_id", "integration_id", "test_data", "settings", "translations", "created_at", "updated_at", "created_by", "published_at", "published_by"}
+
+	mockWorkspaceRepo.On("GetConnection", ctx, workspaceID).Return(db, nil)
+
+	t.Run("returns the last published version", func(t *testing.T) {
+		rows := sqlmock.NewRows(columns).
+			AddRow(template.ID, template.Name, 2, template.Channel, template.Email, template.Web, template.Category, nil, nil, template.TestData, template.Settings, nil, template.CreatedAt, template.UpdatedAt, "user-1", publishedAt, "user-2")
+		mockSQL.ExpectQuery(`FROM templates WHERE id = \$1 AND published_at IS NOT NULL ORDER BY published_at DESC, version DESC LIMIT 1`).
+			WithArgs(template.ID).WillReturnRows(rows)
+
+		result, err := repo.GetPublishedTemplate(ctx, workspaceID, template.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result.Version)
+		assert.Equal(t, "user-1", *result.CreatedBy)
+		assert.Equal(t, "user-2", *result.PublishedBy)
+		assert.Equal(t, publishedAt.Unix(), result.PublishedAt.Unix())
+		require.NoError(t, mockSQL.ExpectationsWereMet())
+	})
+
+	t.Run("not found when no version is published", func(t *testing.T) {
+		mockSQL.ExpectQuery(`FROM templates WHERE id = \$1 AND published_at IS NOT NULL`).
+			WithArgs(template.ID).WillReturnError(sql.ErrNoRows)
+
+		result, err := repo.GetPublishedTemplate(ctx, workspaceID, template.ID)
+		assert.Nil(t, result)
+		var notFoundErr *domain.ErrTemplateNotFound
+		require.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestTemplateRepository_GetTemplateVersions(t *testing.T) {
+	db, mockSQL, cleanup := testutil.SetupMockDB(t)
+	defer cleanup()
+
+	mockWorkspaceRepo := new(MockWorkspaceRepository)
+	repo := NewTemplateRepository(mockWorkspaceRepo)
+
+	ctx := context.Background()
+	workspaceID := "ws-1"
+	now := time.Now().UTC()
+	columns := []string{"version", "name", "created_by", "updated_at", "published_at", "published_by"}
+
+	mockWorkspaceRepo.On("GetConnection", ctx, workspaceID).Return(db, nil)
+
+	t.Run("marks the last published version as live", func(t *testing.T) {
+		// Version 4 restored version 2 after version 3 was published
+		rows := sqlmock.NewRows(columns).
+			AddRow(5, "Welcome", "user-1", now, nil, nil).
+			AddRow(4, "Welcome", "user-2", now.Add(-time.Hour), now.Add(-time.Hour), "user-2").
+			AddRow(3, "Welcome", "user-1", now.Add(-2*time.Hour), now.Add(-2*time.Hour), "user-1").
+			AddRow(2, "Welcome", nil, now.Add(-3*time.Hour), nil, nil)
+		mockSQL.ExpectQuery(`SELECT version, name, created_by, updated_at, published_at, published_by FROM templates WHERE id = \$1 ORDER BY version DESC`).
+			WithArgs("welcome").WillReturnRows(rows)
+
+		versions, err := repo.GetTemplateVersions(ctx, workspaceID, "welcome")
+		require.NoError(t, err)
+		require.Len(t, versions, 4)
+		assert.False(t, versions[0].Live)
+		assert.Nil(t, versions[0].PublishedAt)
+		assert.True(t, versions[1].Live)
+		assert.False(t, versions[2].Live)
+		assert.Nil(t, versions[3].CreatedBy)
+		require.NoError(t, mockSQL.ExpectationsWereMet())
+	})
+
+	t.Run("not found without versions", func(t *testing.T) {
+		mockSQL.ExpectQuery(`SELECT version, name`).WithArgs("missing").WillReturnRows(sqlmock.NewRows(columns))
+
+		_, err := repo.GetTemplateVersions(ctx, workspaceID, "missing")
+		var notFoundErr *domain.ErrTemplateNotFound
+		require.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestTemplateRepository_PublishTemplate(t *testing.T) {
+	db, mockSQL, cleanup := testutil.SetupMockDB(t)
+	defer cleanup()
+
+	mockWorkspaceRepo := new(MockWorkspaceRepository)
+	repo := NewTemplateRepository(mockWorkspaceRepo)
+
+	ctx := context.Background()
+	workspaceID := "ws-1"
+
+	mockWorkspaceRepo.On("GetConnection", ctx, workspaceID).Return(db, nil)
+
+	t.Run("publishes the version", func(t *testing.T) {
+		mockSQL.ExpectExec(regexp.QuoteMeta(`UPDATE templates SET published_at = $3, published_by = $4 WHERE id = $1 AND version = $2`)).
+			WithArgs("welcome", int64(3), sqlmock.AnyArg(), "user-1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		require.NoError(t, repo.PublishTemplate(ctx, workspaceID, "welcome", 3, "user-1"))
+		require.NoError(t, mockSQL.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockSQL.ExpectExec(regexp.QuoteMeta(`UPDATE templates SET published_at`)).
+			WithArgs("welcome", int64(9), sqlmock.AnyArg(), "user-1").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.PublishTemplate(ctx, workspaceID, "welcome", 9, "user-1")
+		var notFoundErr *domain.ErrTemplateNotFound
+		require.ErrorAs(t, err, &notFoundErr)
+	})
+}
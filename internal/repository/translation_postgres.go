@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+)
+
+type translationRepository struct {
+	workspaceRepo domain.WorkspaceRepository
+}
+
+// NewTranslationRepository creates a new translation repository
+func NewTranslationRepository(workspaceRepo domain.WorkspaceRepository) domain.TranslationRepository {
+	return &translationRepository{
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// List returns the strings of the given languages and keys ordered by key and language
+func (r *translationRepository) List(ctx context.Context, workspaceID string, languages []string, keys []string) ([]*domain.Translation, error) {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	var conditions []string
+	var args []interface{}
+	if len(languages) > 0 {
+		args = append(args, pq.Array(languages))
+		conditions = append(conditions, fmt.Sprintf("language = ANY($%d)", len(args)))
+	}
+	if len(keys) > 0 {
+		args = append(args, pq.Array(keys))
+		conditions = append(conditions, fmt.Sprintf("key = ANY($%d)", len(args)))
+	}
+
+	query := `SELECT key, language, value, created_at, updated_at FROM translations`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY key, language"
+
+	rows, err := workspaceDB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list translations: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	translations := []*domain.Translation{}
+	for rows.Next() {
+		var translation domain.Translation
+		if err := rows.Scan(&translation.Key, &translation.Language, &translation.Value, &translation.CreatedAt, &translation.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan translation: %w", err)
+		}
+		translations = append(translations, &translation)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating translations: %w", err)
+	}
+
+	return translations, nil
+}
+
+// Upsert creates or replaces strings of one language in a single statement
+func (r *translationRepository) Upsert(ctx context.Context, workspaceID string, language string, translations map[string]string) error {
+	if len(translations) == 0 {
+		return nil
+	}
+
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	keys := make([]string, 0, len(translations))
+	values := make([]string, 0, len(translations))
+	for key, value := range translations {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	_, err = workspaceDB.ExecContext(ctx, `
+		INSERT INTO translations (key, language, value, created_at, updated_at)
+		SELECT t.key, $1, t.value, NOW(), NOW() FROM unnest($2::text[], $3::text[]) AS t(key, value)
+		ON CONFLICT (key, language) DO UPDATE SET value = EXCLUDED.value, updated_at = NOW()
+		WHERE translations.value IS DISTINCT FROM EXCLUDED.value
+	`, language, pq.Array(keys), pq.Array(values))
+	if err != nil {
+		return fmt.Errorf("failed to upsert translations: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a key in one language, or in every language when language is empty
+func (r *translationRepository) Delete(ctx context.Context, workspaceID string, key string, language string) error {
+	workspaceDB, err := r.workspaceRepo.GetConnection(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace connection: %w", err)
+	}
+
+	query := `DELETE FROM translations WHERE key = $1`
+	args := []interface{}{key}
+	if language != "" {
+		query += ` AND language = $2`
+		args = append(args, language)
+	}
+
+	result, err := workspaceDB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to delete translation: %w", err)
+	}
+
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if removed == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrTranslationNotFound, key)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+)
+
+func setupTranslationRepositoryTest(t *testing.T) (domain.TranslationRepository, sqlmock.Sqlmock) {
+	mockDB, mock, cleanup := setupMockDB(t)
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() {
+		cleanup()
+		ctrl.Finish()
+	})
+
+	workspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	workspaceRepo.EXPECT().GetConnection(gomock.Any(), "workspace123").Return(mockDB, nil).AnyTimes()
+	return NewTranslationRepository(workspaceRepo), mock
+}
+
+func TestTranslationRepository_List(t *testing.T) {
+	now := time.Now()
+	columns := []string{"key", "language", "value", "created_at", "updated_at"}
+
+	t.Run("lists every string", func(t *testing.T) {
+		repo, mock := setupTranslationRepositoryTest(t)
+
+		mock.ExpectQuery(`SELECT key, language, value, created_at, updated_at FROM translations ORDER BY key, language`).
+			WithoutArgs().
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow("welcome", "en", "Welcome", now, now).
+				AddRow("welcome", "fr", "Bienvenue", now, now))
+
+		translations, err := repo.List(context.Background(), "workspace123", nil, nil)
+		require.NoError(t, err)
+		require.Len(t, translations, 2)
+		assert.Equal(t, &domain.Translation{Key: "welcome", Language: "fr", Value: "Bienvenue", CreatedAt: now, UpdatedAt: now}, translations[1])
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("filters by languages and keys", func(t *testing.T) {
+		repo, mock := setupTranslationRepositoryTest(t)
+
+		mock.ExpectQuery(`FROM translations WHERE language = ANY\(\$1\) AND key = ANY\(\$2\) ORDER BY key, language`).
+			WithArgs(pq.Array([]string{"fr", "en"}), pq.Array([]string{"welcome"})).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		translations, err := repo.List(context.Background(), "workspace123", []string{"fr", "en"}, []string{"welcome"})
+		require.NoError(t, err)
+		assert.Empty(t, translations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("filters by keys only", func(t *testing.T) {
+		repo, mock := setupTranslationRepositoryTest(t)
+
+		mock.ExpectQuery(`FROM translations WHERE key = ANY\(\$1\) ORDER BY key, language`).
+			WithArgs(pq.Array([]string{"welcome"})).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		_, err := repo.List(context.Background(), "workspace123", nil, []string{"welcome"})
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		repo, mock := setupTranslationRepositoryTest(t)
+
+		mock.ExpectQuery(`FROM translations`).WillReturnError(errors.New("db error"))
+
+		_, err := repo.List(context.Background(), "workspace123", nil, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to list translations")
+	})
+}
+
+func TestTranslationRepository_Upsert(t *testing.T) {
+	t.Run("upserts the strings of a language", func(t *testing.T) {
+		repo, mock := setupTranslationRepositoryTest(t)
+
+		mock.ExpectExec(`INSERT INTO translations \(key, language, value, created_at, updated_at\)\s+SELECT t\.key, \$1, t\.value, NOW\(\), NOW\(\) FROM unnest\(\$2::text\[\], \$3::text\[\]\) AS t\(key, value\)\s+ON CONFLICT \(key, language\) DO UPDATE`).
+			WithArgs("fr", pq.Array([]string{"welcome"}), pq.Array([]string{"Bienvenue"})).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Upsert(context.Background(), "workspace123", "fr", map[string]string{"welcome": "Bienvenue"})
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("nothing to upsert", func(t *testing.T) {
+		repo, mock := setupTranslationRepositoryTest(t)
+
+		require.NoError(t, repo.Upsert(context.Background(), "workspace123", "fr", nil))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		repo, mock := setupTranslationRepositoryTest(t)
+
+		mock.ExpectExec(`INSERT INTO translations`).WillReturnError(errors.New("db error"))
+
+		err := repo.Upsert(context.Background(), "workspace123", "fr", map[string]string{"welcome": "Bienvenue"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to upsert translations")
+	})
+}
+
+func TestTranslationRepository_Delete(t *testing.T) {
+	t.Run("deletes a key in one language", func(t *testing.T) {
+		repo, mock := setupTranslationRepositoryTest(t)
+
+		mock.ExpectExec(`DELETE FROM translations WHERE key = \$1 AND language = \$2`).
+			WithArgs("welcome", "fr").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		require.NoError(t, repo.Delete(context.Background(), "workspace123", "welcome", "fr"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("deletes a key in every language", func(t *testing.T) {
+		repo, mock := setupTranslationRepositoryTest(t)
+
+		mock.ExpectExec(`DELETE FROM translations WHERE key = \$1$`).
+			WithArgs("welcome").
+			WillReturnResult(sqlmock.NewResult(0, 3))
+
+		require.NoError(t, repo.Delete(context.Background(), "workspace123", "welcome", ""))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		repo, mock := setupTranslationRepositoryTest(t)
+
+		mock.ExpectExec(`DELETE FROM translations`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Delete(context.Background(), "workspace123", "missing", "")
+		assert.ErrorIs(t, err, domain.ErrTranslationNotFound)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		repo, mock := setupTranslationRepositoryTest(t)
+
+		mock.ExpectExec(`DELETE FROM translations`).WillReturnError(errors.New("db error"))
+
+		err := repo.Delete(context.Background(), "workspace123", "welcome", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to delete translation")
+	})
+}
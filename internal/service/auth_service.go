@@ -146,6 +146,24 @@ func (s *AuthService) AuthenticateUserForWorkspace(ctx context.Context, workspac
 	return newCtx, user, userWorkspace, nil
 }
 
+// authorizeWorkspace authenticates the user for the workspace and checks the given access to a resource
+func authorizeWorkspace(ctx context.Context, authService domain.AuthService, workspaceID string, resource domain.PermissionResource, permissionType domain.PermissionType) (context.Context, *domain.User, error) {
+	ctx, user, userWorkspace, err := authService.AuthenticateUserForWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	if !userWorkspace.HasPermission(resource, permissionType) {
+		return nil, nil, domain.NewPermissionError(
+			resource,
+			permissionType,
+			fmt.Sprintf("Insufficient permissions: %s access to %s required", permissionType, resource),
+		)
+	}
+
+	return ctx, user, nil
+}
+
 // VerifyUserSession checks if the user exists and the session is valid
 func (s *AuthService) VerifyUserSession(ctx context.Context, userID, sessionID string) (*domain.User, error) {
 	// First check if the session is valid and not expired
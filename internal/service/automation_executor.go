@@ -36,15 +36,21 @@ func NewAutomationExecutor(
 	emailQueueRepo domain.EmailQueueRepository,
 	messageRepo domain.MessageHistoryRepository,
 	timelineRepo domain.ContactTimelineRepository,
+	suppressionRepo domain.SuppressionRepository,
+	translationRepo domain.TranslationRepository,
 	log logger.Logger,
 	apiEndpoint string,
 ) *AutomationExecutor {
 	qb := NewQueryBuilder()
 
+	emailExecutor := NewEmailNodeExecutor(emailQueueRepo, templateRepo, workspaceRepo, listRepo, contactListRepo, apiEndpoint, log)
+	emailExecutor.suppressionRepo = suppressionRepo
+	emailExecutor.translationRepo = translationRepo
+
 	executors := map[domain.NodeType]NodeExecutor{
 		domain.NodeTypeTrigger:          NewTriggerNodeExecutor(),
 		domain.NodeTypeDelay:            NewDelayNodeExecutor(),
-		domain.NodeTypeEmail:            NewEmailNodeExecutor(emailQueueRepo, templateRepo, workspaceRepo, listRepo, contactListRepo, apiEndpoint, log),
+		domain.NodeTypeEmail:            emailExecutor,
 		domain.NodeTypeBranch:           NewBranchNodeExecutor(qb, workspaceRepo),
 		domain.NodeTypeFilter:           NewFilterNodeExecutor(qb, workspaceRepo),
 		domain.NodeTypeAddToList:        NewAddToListNodeExecutor(contactListRepo),
@@ -89,6 +95,14 @@ func (e *AutomationExecutor) Execute(ctx context.Context, workspaceID string, co
 		return e.markAsCompleted(ctx, workspaceID, contactAutomation, "completed")
 	}
 
+	// Holdout: the contact exits on entry, without any action, so that its conversions can be
+	// compared with the contacts going through the automation. Contacts already past the
+	// root node when the holdout is enabled are left untouched.
+	if *contactAutomation.CurrentNodeID == automation.RootNodeID &&
+		automation.Holdout.Includes(automation.ID, contactAutomation.ContactEmail) {
+		return e.markAsExited(ctx, workspaceID, contactAutomation, domain.HoldoutExitReason)
+	}
+
 	// Get contact data once (outside loop) - only if we have nodes to process
 	contactData, err := e.contactRepo.GetContactByEmail(ctx, workspaceID, contactAutomation.ContactEmail)
 	if err != nil {
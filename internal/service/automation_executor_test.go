@@ -2042,3 +2042,105 @@ func TestAutomationExecutor_Execute_ExitReasonPropagation(t *testing.T) {
 	require.NotNil(t, contactAutomation.ExitReason)
 	assert.Equal(t, "unsubscribed", *contactAutomation.ExitReason)
 }
+
+func TestAutomationExecutor_Execute_Holdout(t *testing.T) {
+	holdout := &domain.HoldoutSettings{Enabled: true, Percentage: 50}
+
+	// Pick one contact of each group, the assignment is deterministic
+	var heldOutEmail string
+	for i := 0; heldOutEmail == ""; i++ {
+		email := fmt.Sprintf("contact%d@example.com", i)
+		if holdout.Includes("auto1", email) {
+			heldOutEmail = email
+		}
+	}
+
+	rootID := "root"
+	nextID := "next"
+
+	t.Run("held out contact exits at the root node", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAutomationRepo := mocks.NewMockAutomationRepository(ctrl)
+		mockTimelineRepo := mocks.NewMockContactTimelineRepository(ctrl)
+
+		executor := &AutomationExecutor{
+			automationRepo: mockAutomationRepo,
+			timelineRepo:   mockTimelineRepo,
+			nodeExecutors:  map[domain.NodeType]NodeExecutor{},
+			logger:         setupMockLogger(ctrl),
+		}
+
+		automation := &domain.Automation{
+			ID:         "auto1",
+			Status:     domain.AutomationStatusLive,
+			RootNodeID: rootID,
+			Holdout:    holdout,
+			Nodes:      []*domain.AutomationNode{{ID: rootID, Type: domain.NodeTypeTrigger}},
+		}
+		contactAutomation := &domain.ContactAutomation{
+			ID:            "ca1",
+			AutomationID:  "auto1",
+			ContactEmail:  heldOutEmail,
+			CurrentNodeID: &rootID,
+			Status:        domain.ContactAutomationStatusActive,
+		}
+
+		// No contact fetch and no node execution
+		mockAutomationRepo.EXPECT().GetByID(gomock.Any(), "ws1", "auto1").Return(automation, nil)
+		mockAutomationRepo.EXPECT().IncrementAutomationStat(gomock.Any(), "ws1", "auto1", "exited").Return(nil)
+		mockAutomationRepo.EXPECT().UpdateContactAutomation(gomock.Any(), "ws1", contactAutomation).Return(nil)
+		mockTimelineRepo.EXPECT().Create(gomock.Any(), "ws1", gomock.Any()).Return(nil)
+
+		err := executor.Execute(context.Background(), "ws1", contactAutomation)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.ContactAutomationStatusExited, contactAutomation.Status)
+		require.NotNil(t, contactAutomation.ExitReason)
+		assert.Equal(t, domain.HoldoutExitReason, *contactAutomation.ExitReason)
+	})
+
+	t.Run("contact past the root node is not held out", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockAutomationRepo := mocks.NewMockAutomationRepository(ctrl)
+		mockContactRepo := mocks.NewMockContactRepository(ctrl)
+
+		executor := &AutomationExecutor{
+			automationRepo: mockAutomationRepo,
+			contactRepo:    mockContactRepo,
+			nodeExecutors:  map[domain.NodeType]NodeExecutor{},
+			logger:         setupMockLogger(ctrl),
+		}
+
+		automation := &domain.Automation{
+			ID:         "auto1",
+			Status:     domain.AutomationStatusLive,
+			RootNodeID: rootID,
+			Holdout:    holdout,
+			Nodes: []*domain.AutomationNode{
+				{ID: rootID, Type: domain.NodeTypeTrigger, NextNodeID: &nextID},
+				{ID: nextID, Type: domain.NodeTypeDelay},
+			},
+		}
+		contactAutomation := &domain.ContactAutomation{
+			ID:            "ca1",
+			AutomationID:  "auto1",
+			ContactEmail:  heldOutEmail,
+			CurrentNodeID: &nextID,
+			Status:        domain.ContactAutomationStatusActive,
+			MaxRetries:    3,
+		}
+
+		mockAutomationRepo.EXPECT().GetByID(gomock.Any(), "ws1", "auto1").Return(automation, nil)
+		mockContactRepo.EXPECT().GetContactByEmail(gomock.Any(), "ws1", heldOutEmail).Return(nil, errors.New("stop here"))
+		mockAutomationRepo.EXPECT().CreateNodeExecution(gomock.Any(), "ws1", gomock.Any()).Return(nil)
+		mockAutomationRepo.EXPECT().UpdateContactAutomation(gomock.Any(), "ws1", gomock.Any()).Return(nil)
+
+		_ = executor.Execute(context.Background(), "ws1", contactAutomation)
+
+		assert.Nil(t, contactAutomation.ExitReason)
+	})
+}
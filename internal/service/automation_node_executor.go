@@ -152,6 +152,10 @@ type EmailNodeExecutor struct {
 	contactListRepo domain.ContactListRepository
 	apiEndpoint     string
 	logger          logger.Logger
+	// suppressionRepo is optional; when set, suppressed addresses exit the automation
+	suppressionRepo domain.SuppressionRepository
+	// translationRepo is optional; when set, catalog strings are provided to the t filter
+	translationRepo domain.TranslationRepository
 }
 
 // NewEmailNodeExecutor creates a new email node executor
@@ -207,6 +211,37 @@ func (e *EmailNodeExecutor) Execute(ctx context.Context, params NodeExecutionPar
 		return nil, fmt.Errorf("invalid email node config: %w", err)
 	}
 
+	// 1b. Skip addresses on the suppression list
+	if e.suppressionRepo != nil {
+		email := domain.NormalizeEmail(params.ContactData.Email)
+		matches, err := e.suppressionRepo.FindMatches(ctx, params.WorkspaceID, []string{email})
+		if err != nil {
+			return nil, fmt.Errorf("failed to check suppressions: %w", err)
+		}
+		if len(matches[email]) > 0 {
+			exitReason := "suppressed"
+			e.logger.WithFields(map[string]interface{}{
+				"workspace_id":  params.WorkspaceID,
+				"automation_id": params.Automation.ID,
+				"contact_email": params.ContactData.Email,
+				"template_id":   config.TemplateID,
+				"suppressed_by": domain.DescribeSuppressions(matches[email]),
+			}).Info("Email node skipped - contact is suppressed")
+
+			return &NodeExecutionResult{
+				NextNodeID: nil,
+				Status:     domain.ContactAutomationStatusExited,
+				ExitReason: &exitReason,
+				Output: buildNodeOutput(domain.NodeTypeEmail, map[string]interface{}{
+					"template_id": config.TemplateID,
+					"skipped":     true,
+					"skip_reason": exitReason,
+					"to":          params.ContactData.Email,
+				}),
+			}, nil
+		}
+	}
+
 	// 2. Get workspace for email provider
 	workspace, err := e.workspaceRepo.GetByID(ctx, params.WorkspaceID)
 	if err != nil {
@@ -238,8 +273,8 @@ func (e *EmailNodeExecutor) Execute(ctx context.Context, params NodeExecutionPar
 		return nil, fmt.Errorf("no email provider configured for workspace")
 	}
 
-	// 4. Get template (version 0 means latest version)
-	template, err := e.templateRepo.GetTemplateByID(ctx, params.WorkspaceID, config.TemplateID, 0)
+	// 4. Get the published version of the template
+	template, err := e.templateRepo.GetPublishedTemplate(ctx, params.WorkspaceID, config.TemplateID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get template: %w", err)
 	}
@@ -324,6 +359,7 @@ func (e *EmailNodeExecutor) Execute(ctx context.Context, params NodeExecutionPar
 	templateData, err := domain.BuildTemplateData(domain.TemplateDataRequest{
 		WorkspaceID:         params.WorkspaceID,
 		WorkspaceSecretKey:  workspace.Settings.SecretKey,
+		TemplateSecrets:     workspace.Settings.TemplateSecrets,
 		WorkspaceWebsiteURL: workspace.Settings.WebsiteURL,
 		ContactWithList:     domain.ContactWithList{Contact: params.ContactData, ListID: listID, ListName: listName},
 		MessageID:           messageID,
@@ -343,6 +379,10 @@ func (e *EmailNodeExecutor) Execute(ctx context.Context, params NodeExecutionPar
 		contactLang = params.ContactData.Language.String
 	}
 	emailContent := template.ResolveEmailContent(contactLang, workspace.Settings.DefaultLanguage)
+	if err := domain.ApplyTranslations(ctx, e.translationRepo, params.WorkspaceID, workspace.Settings.DefaultLanguage,
+		contactLang, emailContent, templateData); err != nil {
+		return nil, err
+	}
 
 	// 9. Compile template
 	compileReq := notifuse_mjml.CompileTemplateRequest{
@@ -351,6 +391,7 @@ func (e *EmailNodeExecutor) Execute(ctx context.Context, params NodeExecutionPar
 		VisualEditorTree: emailContent.VisualEditorTree,
 		TemplateData:     notifuse_mjml.MapOfAny(templateData),
 		TrackingSettings: trackingSettings,
+		Text:             emailContent.Text,
 	}
 	compileReq.MjmlSource = emailContent.GetCodeModeMjmlSource()
 	compiledTemplate, err := notifuse_mjml.CompileTemplate(compileReq)
@@ -399,6 +440,7 @@ func (e *EmailNodeExecutor) Execute(ctx context.Context, params NodeExecutionPar
 			FromName:           sender.Name,
 			Subject:            subject,
 			HTMLContent:        htmlContent,
+			TextContent:        compiledTemplate.GetText(),
 			RateLimitPerMinute: emailProvider.RateLimitPerMinute,
 			EmailOptions: domain.EmailOptions{
 				ReplyTo: emailContent.ReplyTo,
@@ -489,7 +489,7 @@ func TestEmailNodeExecutor_Execute_Success(t *testing.T) {
 		Return(workspace, nil)
 
 	mockTemplateRepo.EXPECT().
-		GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).
+		GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").
 		Return(template, nil)
 
 	mockListRepo.EXPECT().
@@ -773,7 +773,7 @@ func TestEmailNodeExecutor_Execute_EnqueueFailed(t *testing.T) {
 		Return(workspace, nil)
 
 	mockTemplateRepo.EXPECT().
-		GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).
+		GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").
 		Return(template, nil)
 
 	mockListRepo.EXPECT().
@@ -836,7 +836,7 @@ func TestEmailNodeExecutor_Execute_WithCustomEndpoint(t *testing.T) {
 		Return(workspace, nil)
 
 	mockTemplateRepo.EXPECT().
-		GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).
+		GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").
 		Return(template, nil)
 
 	mockListRepo.EXPECT().
@@ -923,7 +923,7 @@ func TestEmailNodeExecutor_Execute_WithIntegrationOverride(t *testing.T) {
 		Return(workspace, nil)
 
 	mockTemplateRepo.EXPECT().
-		GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).
+		GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").
 		Return(template, nil)
 
 	mockListRepo.EXPECT().
@@ -1122,7 +1122,7 @@ func TestEmailNodeExecutor_Execute_PreservesReplyTo(t *testing.T) {
 		Return(workspace, nil)
 
 	mockTemplateRepo.EXPECT().
-		GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).
+		GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").
 		Return(templateWithReplyTo, nil)
 
 	mockListRepo.EXPECT().
@@ -1193,7 +1193,7 @@ func TestEmailNodeExecutor_Execute_GeneratesTemplateURLs(t *testing.T) {
 		Return(workspace, nil)
 
 	mockTemplateRepo.EXPECT().
-		GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).
+		GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").
 		Return(template, nil)
 
 	mockListRepo.EXPECT().
@@ -1267,7 +1267,7 @@ func TestEmailNodeExecutor_Execute_NoListID_StillWorks(t *testing.T) {
 		Return(workspace, nil)
 
 	mockTemplateRepo.EXPECT().
-		GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).
+		GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").
 		Return(template, nil)
 
 	// No GetListByID call expected since ListID is empty
@@ -1327,7 +1327,7 @@ func TestEmailNodeExecutor_Execute_ListRepoError(t *testing.T) {
 		Return(workspace, nil)
 
 	mockTemplateRepo.EXPECT().
-		GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).
+		GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").
 		Return(template, nil)
 
 	mockListRepo.EXPECT().
@@ -1412,7 +1412,7 @@ func TestEmailNodeExecutor_Execute_MarketingEmail_UnsubscribedContact(t *testing
 	template := createTestTemplateWithCategory("marketing")
 
 	mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "ws1").Return(workspace, nil)
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).Return(template, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").Return(template, nil)
 	mockContactListRepo.EXPECT().
 		GetContactListByIDs(gomock.Any(), "ws1", "recipient@example.com", "list1").
 		Return(&domain.ContactList{
@@ -1462,7 +1462,7 @@ func TestEmailNodeExecutor_Execute_MarketingEmail_BouncedContact(t *testing.T) {
 	template := createTestTemplateWithCategory("marketing")
 
 	mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "ws1").Return(workspace, nil)
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).Return(template, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").Return(template, nil)
 	mockContactListRepo.EXPECT().
 		GetContactListByIDs(gomock.Any(), "ws1", "recipient@example.com", "list1").
 		Return(&domain.ContactList{
@@ -1509,7 +1509,7 @@ func TestEmailNodeExecutor_Execute_MarketingEmail_ComplainedContact(t *testing.T
 	template := createTestTemplateWithCategory("marketing")
 
 	mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "ws1").Return(workspace, nil)
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).Return(template, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").Return(template, nil)
 	mockContactListRepo.EXPECT().
 		GetContactListByIDs(gomock.Any(), "ws1", "recipient@example.com", "list1").
 		Return(&domain.ContactList{
@@ -1556,7 +1556,7 @@ func TestEmailNodeExecutor_Execute_MarketingEmail_ActiveContact(t *testing.T) {
 	template := createTestTemplateWithCategory("marketing")
 
 	mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "ws1").Return(workspace, nil)
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).Return(template, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").Return(template, nil)
 	mockContactListRepo.EXPECT().
 		GetContactListByIDs(gomock.Any(), "ws1", "recipient@example.com", "list1").
 		Return(&domain.ContactList{
@@ -1607,7 +1607,7 @@ func TestEmailNodeExecutor_Execute_MarketingEmail_ContactNotInList(t *testing.T)
 	template := createTestTemplateWithCategory("marketing")
 
 	mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "ws1").Return(workspace, nil)
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).Return(template, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").Return(template, nil)
 	mockContactListRepo.EXPECT().
 		GetContactListByIDs(gomock.Any(), "ws1", "recipient@example.com", "list1").
 		Return(nil, &domain.ErrContactListNotFound{Message: "not found"})
@@ -1653,7 +1653,7 @@ func TestEmailNodeExecutor_Execute_MarketingEmail_NoListID(t *testing.T) {
 	template := createTestTemplateWithCategory("marketing")
 
 	mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "ws1").Return(workspace, nil)
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).Return(template, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").Return(template, nil)
 	// No contactListRepo expectation — check should be skipped when ListID is empty
 	mockEmailQueueRepo.EXPECT().Enqueue(gomock.Any(), "ws1", gomock.Any()).Return(nil)
 
@@ -1694,7 +1694,7 @@ func TestEmailNodeExecutor_Execute_TransactionalEmail_UnsubscribedContact(t *tes
 	template := createTestTemplateWithCategory("transactional")
 
 	mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "ws1").Return(workspace, nil)
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).Return(template, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").Return(template, nil)
 	// No contactListRepo expectation — transactional emails bypass subscription check
 	mockListRepo.EXPECT().GetListByID(gomock.Any(), "ws1", "list1").
 		Return(&domain.List{ID: "list1", Name: "Test List"}, nil)
@@ -1738,7 +1738,7 @@ func TestEmailNodeExecutor_Execute_UnsubscribeCategoryEmail_UnsubscribedContact(
 	template := createTestTemplateWithCategory("unsubscribe")
 
 	mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "ws1").Return(workspace, nil)
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).Return(template, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").Return(template, nil)
 	// No contactListRepo expectation — unsubscribe category bypasses check
 	mockListRepo.EXPECT().GetListByID(gomock.Any(), "ws1", "list1").
 		Return(&domain.List{ID: "list1", Name: "Test List"}, nil)
@@ -1781,7 +1781,7 @@ func TestEmailNodeExecutor_Execute_BlogEmail_UnsubscribedContact(t *testing.T) {
 	template := createTestTemplateWithCategory("blog")
 
 	mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "ws1").Return(workspace, nil)
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).Return(template, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").Return(template, nil)
 	mockContactListRepo.EXPECT().
 		GetContactListByIDs(gomock.Any(), "ws1", "recipient@example.com", "list1").
 		Return(&domain.ContactList{
@@ -1828,7 +1828,7 @@ func TestEmailNodeExecutor_Execute_MarketingEmail_ContactListRepoError(t *testin
 	template := createTestTemplateWithCategory("marketing")
 
 	mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "ws1").Return(workspace, nil)
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "ws1", "tpl123", int64(0)).Return(template, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "ws1", "tpl123").Return(template, nil)
 	mockContactListRepo.EXPECT().
 		GetContactListByIDs(gomock.Any(), "ws1", "recipient@example.com", "list1").
 		Return(nil, errors.New("database connection error"))
@@ -1852,6 +1852,76 @@ func TestEmailNodeExecutor_Execute_MarketingEmail_ContactListRepoError(t *testin
 	assert.Contains(t, err.Error(), "failed to check contact subscription status")
 }
 
+func TestEmailNodeExecutor_Execute_SuppressedContact(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEmailQueueRepo := mocks.NewMockEmailQueueRepository(ctrl)
+	mockTemplateRepo := mocks.NewMockTemplateRepository(ctrl)
+	mockWorkspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	mockListRepo := mocks.NewMockListRepository(ctrl)
+	mockContactListRepo := mocks.NewMockContactListRepository(ctrl)
+	mockSuppressionRepo := mocks.NewMockSuppressionRepository(ctrl)
+	mockLogger := setupMockLoggerForNodeExecutor(ctrl)
+
+	executor := NewEmailNodeExecutor(mockEmailQueueRepo, mockTemplateRepo, mockWorkspaceRepo, mockListRepo, mockContactListRepo, "https://api.example.com", mockLogger)
+	executor.suppressionRepo = mockSuppressionRepo
+
+	// Suppressed contacts exit before the workspace or template are loaded, whatever the category
+	mockSuppressionRepo.EXPECT().
+		FindMatches(gomock.Any(), "ws1", []string{"recipient@example.com"}).
+		Return(map[string][]*domain.Suppression{
+			"recipient@example.com": {{Pattern: "example.com", Kind: domain.SuppressionKindDomain, Reason: domain.SuppressionReasonManual}},
+		}, nil)
+
+	params := NodeExecutionParams{
+		WorkspaceID: "ws1",
+		Node: &domain.AutomationNode{
+			ID:         "email_node1",
+			Type:       domain.NodeTypeEmail,
+			NextNodeID: strPtr("next_node"),
+			Config:     map[string]interface{}{"template_id": "tpl123"},
+		},
+		Contact:     &domain.ContactAutomation{ID: "ca1", ContactEmail: "recipient@example.com"},
+		ContactData: &domain.Contact{Email: "Recipient@example.com"},
+		Automation:  &domain.Automation{ID: "auto1", Name: "Test Automation"},
+	}
+
+	result, err := executor.Execute(context.Background(), params)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Nil(t, result.NextNodeID)
+	assert.Equal(t, domain.ContactAutomationStatusExited, result.Status)
+	require.NotNil(t, result.ExitReason)
+	assert.Equal(t, "suppressed", *result.ExitReason)
+	assert.Equal(t, true, result.Output["skipped"])
+}
+
+func TestEmailNodeExecutor_Execute_SuppressionCheckError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSuppressionRepo := mocks.NewMockSuppressionRepository(ctrl)
+	mockLogger := setupMockLoggerForNodeExecutor(ctrl)
+
+	executor := NewEmailNodeExecutor(nil, nil, nil, nil, nil, "https://api.example.com", mockLogger)
+	executor.suppressionRepo = mockSuppressionRepo
+
+	mockSuppressionRepo.EXPECT().FindMatches(gomock.Any(), "ws1", gomock.Any()).Return(nil, errors.New("db error"))
+
+	params := NodeExecutionParams{
+		WorkspaceID: "ws1",
+		Node:        &domain.AutomationNode{ID: "email_node1", Type: domain.NodeTypeEmail, Config: map[string]interface{}{"template_id": "tpl123"}},
+		Contact:     &domain.ContactAutomation{ID: "ca1", ContactEmail: "recipient@example.com"},
+		ContactData: &domain.Contact{Email: "recipient@example.com"},
+		Automation:  &domain.Automation{ID: "auto1"},
+	}
+
+	result, err := executor.Execute(context.Background(), params)
+	assert.Nil(t, result)
+	assert.ErrorContains(t, err, "failed to check suppressions")
+}
+
 // buildSimpleCondition creates a simple TreeNode condition for testing
 func buildSimpleCondition() *domain.TreeNode {
 	return &domain.TreeNode{
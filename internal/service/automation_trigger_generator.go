@@ -122,7 +122,12 @@ func (g *AutomationTriggerGenerator) buildWHENClause(automation *domain.Automati
 		conditions = append(conditions, fmt.Sprintf("NEW.entity_id = '%s'", escapeString(*trigger.SegmentID)))
 	}
 
-	// 4. Updated fields filter (for contact.updated events) - checks if specific fields were changed
+	// 4. Tag filter (for contact.tagged/untagged events) - entity_id stores the tag
+	if trigger.Tag != nil && *trigger.Tag != "" && (trigger.EventKind == "contact.tagged" || trigger.EventKind == "contact.untagged") {
+		conditions = append(conditions, fmt.Sprintf("NEW.entity_id = '%s'", escapeString(*trigger.Tag)))
+	}
+
+	// 5. Updated fields filter (for contact.updated events) - checks if specific fields were changed
 	if trigger.EventKind == "contact.updated" && len(trigger.UpdatedFields) > 0 {
 		fieldChecks := make([]string, 0, len(trigger.UpdatedFields))
 		for _, field := range trigger.UpdatedFields {
@@ -137,7 +142,7 @@ func (g *AutomationTriggerGenerator) buildWHENClause(automation *domain.Automati
 		}
 	}
 
-	// 5. TreeNode conditions (optional)
+	// 6. TreeNode conditions (optional)
 	if trigger.Conditions != nil {
 		// Get SQL with placeholders and args
 		conditionSQL, args, err := g.queryBuilder.BuildTriggerCondition(trigger.Conditions, "NEW.email")
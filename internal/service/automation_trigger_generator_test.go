@@ -481,6 +481,44 @@ func TestAutomationTriggerGenerator_Generate(t *testing.T) {
 		assert.Contains(t, result.WHENClause, "NEW.entity_id = 'segment456'")
 	})
 
+	t.Run("tag event with tag filter", func(t *testing.T) {
+		tag := "vip"
+		automation := &domain.Automation{
+			ID:         "testtag",
+			ListID:     "list1",
+			RootNodeID: "node1",
+			Trigger: &domain.TimelineTriggerConfig{
+				EventKind: "contact.tagged",
+				Tag:       &tag,
+				Frequency: domain.TriggerFrequencyOnce,
+			},
+		}
+
+		result, err := gen.Generate(automation)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+
+		assert.Equal(t, "NEW.kind = 'contact.tagged' AND NEW.entity_id = 'vip'", result.WHENClause)
+	})
+
+	t.Run("tag event without tag filter fires on any tag", func(t *testing.T) {
+		automation := &domain.Automation{
+			ID:         "testanytag",
+			ListID:     "list1",
+			RootNodeID: "node1",
+			Trigger: &domain.TimelineTriggerConfig{
+				EventKind: "contact.untagged",
+				Frequency: domain.TriggerFrequencyOnce,
+			},
+		}
+
+		result, err := gen.Generate(automation)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+
+		assert.Equal(t, "NEW.kind = 'contact.untagged'", result.WHENClause)
+	})
+
 	t.Run("custom_event with custom_event_name filter", func(t *testing.T) {
 		customEventName := "purchase"
 		automation := &domain.Automation{
@@ -0,0 +1,514 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/pkg/liquid"
+	"github.com/Notifuse/notifuse/pkg/notifuse_mjml"
+)
+
+// ====================
+// Newsletter Archive
+// ====================
+
+// RenderArchivePage renders the archive of a list: its past broadcasts, newest first, with the
+// category template of the theme
+func (s *BlogService) RenderArchivePage(ctx context.Context, workspaceID, listID string, page int, themeVersion *int) (string, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	workspace, list, theme, err := s.getArchiveEntities(ctx, workspaceID, listID, themeVersion)
+	if err != nil {
+		return "", err
+	}
+
+	pageSize := workspace.Settings.BlogSettings.GetCategoryPageSize()
+	broadcasts, err := s.broadcastRepo.ListBroadcasts(ctx, domain.ListBroadcastsParams{
+		WorkspaceID: workspaceID,
+		Status:      domain.BroadcastStatusProcessed,
+		ListID:      listID,
+		Limit:       pageSize,
+		Offset:      (page - 1) * pageSize,
+	})
+	if err != nil {
+		s.logger.WithField("error", err.Error()).Warn("Failed to get broadcasts for newsletter archive page")
+		broadcasts = &domain.BroadcastListResponse{Broadcasts: []*domain.Broadcast{}}
+	}
+
+	totalPages := (broadcasts.TotalCount + pageSize - 1) / pageSize
+	if page > 1 && page > totalPages {
+		return "", &domain.BlogRenderError{
+			Code:    domain.ErrCodePostNotFound, // Reuse for page not found
+			Message: fmt.Sprintf("Page %d does not exist (total pages: %d)", page, totalPages),
+		}
+	}
+
+	templates := map[string]*domain.Template{}
+	posts := make([]*domain.BlogPost, 0, len(broadcasts.Broadcasts))
+	for _, broadcast := range broadcasts.Broadcasts {
+		title, excerpt := s.archiveIssueSummary(ctx, workspaceID, list, broadcast, templates)
+		posts = append(posts, domain.NewNewsletterArchivePost(listID, broadcast, title, excerpt))
+	}
+
+	publicLists, err := s.getPublicListsForWorkspace(ctx, workspaceID)
+	if err != nil {
+		s.logger.WithField("error", err.Error()).Warn("Failed to get public lists for newsletter archive page")
+		publicLists = []*domain.List{}
+	}
+
+	categories, err := s.categoryRepo.ListCategories(ctx)
+	if err != nil {
+		s.logger.WithField("error", err.Error()).Warn("Failed to get categories for newsletter archive page")
+		categories = []*domain.BlogCategory{}
+	}
+
+	templateData, err := domain.BuildBlogTemplateData(domain.BlogTemplateDataRequest{
+		Workspace:    workspace,
+		Category:     domain.NewNewsletterArchiveCategory(list),
+		PublicLists:  publicLists,
+		Posts:        posts,
+		Categories:   categories,
+		ThemeVersion: theme.Version,
+		PaginationData: &domain.BlogPostListResponse{
+			Posts:           posts,
+			TotalCount:      broadcasts.TotalCount,
+			CurrentPage:     page,
+			TotalPages:      totalPages,
+			HasNextPage:     page < totalPages,
+			HasPreviousPage: page > 1,
+		},
+	})
+	if err != nil {
+		return "", &domain.BlogRenderError{
+			Code:    domain.ErrCodeRenderFailed,
+			Message: "Failed to build template data",
+			Details: err,
+		}
+	}
+	setArchiveTemplateData(templateData, workspace, list)
+	if pagination, ok := templateData["pagination"].(map[string]interface{}); ok {
+		pagination["per_page"] = pageSize
+	}
+
+	html, err := liquid.RenderBlogTemplate(theme.Files.CategoryLiquid, templateData, themePartials(theme))
+	if err != nil {
+		s.logger.WithFields(map[string]interface{}{
+			"error":         err.Error(),
+			"workspace_id":  workspaceID,
+			"theme_version": theme.Version,
+			"list_id":       listID,
+		}).Error("Failed to render newsletter archive with the category template")
+
+		return "", &domain.BlogRenderError{
+			Code:    domain.ErrCodeInvalidLiquidSyntax,
+			Message: fmt.Sprintf("Failed to render category template: %v", err),
+			Details: err,
+		}
+	}
+
+	return liquid.InjectFeedDiscoveryTags(html, blogTitleForDiscovery(workspace), domain.NewsletterArchiveSlug(listID)), nil
+}
+
+// RenderArchiveIssuePage renders a broadcast of the archive of a list with the post template of
+// the theme. The email is rendered without personal data and only its body is kept.
+func (s *BlogService) RenderArchiveIssuePage(ctx context.Context, workspaceID, listID, broadcastID string, themeVersion *int) (string, error) {
+	workspace, list, theme, err := s.getArchiveEntities(ctx, workspaceID, listID, themeVersion)
+	if err != nil {
+		return "", err
+	}
+
+	broadcast, view, err := s.renderArchiveIssue(ctx, workspace, listID, broadcastID)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := liquid.EmailBodyHTML(view.HTML)
+	if err != nil {
+		return "", &domain.BlogRenderError{
+			Code:    domain.ErrCodeRenderFailed,
+			Message: "Failed to extract the email body",
+			Details: err,
+		}
+	}
+
+	_, excerpt := s.archiveIssueSummary(ctx, workspaceID, list, broadcast, map[string]*domain.Template{})
+	post := domain.NewNewsletterArchivePost(listID, broadcast, view.Subject, excerpt)
+
+	publicLists, err := s.getPublicListsForWorkspace(ctx, workspaceID)
+	if err != nil {
+		s.logger.WithField("error", err.Error()).Warn("Failed to get public lists for newsletter archive issue page")
+		publicLists = []*domain.List{}
+	}
+
+	categories, err := s.categoryRepo.ListCategories(ctx)
+	if err != nil {
+		s.logger.WithField("error", err.Error()).Warn("Failed to get categories for newsletter archive issue page")
+		categories = []*domain.BlogCategory{}
+	}
+
+	templateData, err := domain.BuildBlogTemplateData(domain.BlogTemplateDataRequest{
+		Workspace:    workspace,
+		Post:         post,
+		Category:     domain.NewNewsletterArchiveCategory(list),
+		PublicLists:  publicLists,
+		Categories:   categories,
+		ThemeVersion: theme.Version,
+	})
+	if err != nil {
+		return "", &domain.BlogRenderError{
+			Code:    domain.ErrCodeRenderFailed,
+			Message: "Failed to build template data",
+			Details: err,
+		}
+	}
+	setArchiveTemplateData(templateData, workspace, list)
+
+	if postData, ok := templateData["post"].(domain.MapOfAny); ok {
+		tocItems, modifiedHTML, err := ExtractTableOfContents(body)
+		if err != nil {
+			s.logger.WithField("error", err.Error()).Warn("Failed to extract table of contents")
+			tocItems = []domain.TOCItem{}
+			modifiedHTML = body
+		}
+		postData["content"] = modifiedHTML
+		postData["category_slug"] = domain.NewsletterArchiveSlug(listID)
+
+		tocData := make([]map[string]interface{}, len(tocItems))
+		for i, item := range tocItems {
+			tocData[i] = map[string]interface{}{
+				"id":    item.ID,
+				"level": item.Level,
+				"text":  item.Text,
+			}
+		}
+		postData["table_of_contents"] = tocData
+	}
+
+	html, err := liquid.RenderBlogTemplate(theme.Files.PostLiquid, templateData, themePartials(theme))
+	if err != nil {
+		s.logger.WithFields(map[string]interface{}{
+			"error":         err.Error(),
+			"workspace_id":  workspaceID,
+			"theme_version": theme.Version,
+			"list_id":       listID,
+			"broadcast_id":  broadcastID,
+		}).Error("Failed to render newsletter archive issue with the post template")
+
+		return "", &domain.BlogRenderError{
+			Code:    domain.ErrCodeInvalidLiquidSyntax,
+			Message: fmt.Sprintf("Failed to render post template: %v", err),
+			Details: err,
+		}
+	}
+
+	return liquid.InjectFeedDiscoveryTags(html, blogTitleForDiscovery(workspace), domain.NewsletterArchiveSlug(listID)), nil
+}
+
+// BuildArchiveFeed returns the RSS / JSON Feed payload of the archive of a list, with the newest
+// broadcasts rendered without personal data. Broadcasts that cannot be rendered are left out.
+func (s *BlogService) BuildArchiveFeed(ctx context.Context, workspaceID, listID string) (*domain.BlogFeed, error) {
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+	if !workspace.Settings.BlogSettings.IsListArchived(listID) {
+		return nil, &domain.BlogRenderError{Code: domain.ErrCodeCategoryNotFound, Message: "List is not archived"}
+	}
+
+	origin := workspaceBlogOrigin(workspace)
+	if origin == "" {
+		return nil, fmt.Errorf("feed unavailable: workspace has no website URL configured")
+	}
+
+	list, err := s.listRepo.GetListByID(ctx, workspaceID, listID)
+	if err != nil || list.DeletedAt != nil {
+		return nil, &domain.BlogRenderError{Code: domain.ErrCodeCategoryNotFound, Message: "List not found", Details: err}
+	}
+
+	broadcasts, err := s.broadcastRepo.ListBroadcasts(ctx, domain.ListBroadcastsParams{
+		WorkspaceID: workspaceID,
+		Status:      domain.BroadcastStatusProcessed,
+		ListID:      listID,
+		Limit:       workspace.Settings.BlogSettings.GetFeedMaxItems(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list archived broadcasts: %w", err)
+	}
+
+	summaryOnly := workspace.Settings.BlogSettings.FeedSummaryOnly
+	archiveSlug := domain.NewsletterArchiveSlug(listID)
+	templates := map[string]*domain.Template{}
+	maxUpdatedAt := list.UpdatedAt.UTC()
+	fingerprint := sha256.New()
+
+	items := make([]domain.BlogFeedItem, 0, len(broadcasts.Broadcasts))
+	for _, broadcast := range broadcasts.Broadcasts {
+		title, excerpt := s.archiveIssueSummary(ctx, workspaceID, list, broadcast, templates)
+		sentAt := domain.BroadcastSentAt(broadcast)
+
+		item := domain.BlogFeedItem{
+			GUID:         broadcast.ID,
+			Title:        title,
+			URL:          buildPostURL(origin, archiveSlug, broadcast.ID),
+			CategorySlug: archiveSlug,
+			CategoryName: list.Name,
+			Excerpt:      excerpt,
+			PublishedAt:  sentAt,
+			UpdatedAt:    broadcast.UpdatedAt,
+		}
+
+		if summaryOnly {
+			item.ContentHTML = excerpt
+		} else {
+			view, err := s.broadcastRenderer.RenderBroadcastWebView(ctx, workspace, broadcast.ID)
+			if err != nil {
+				s.logger.WithFields(map[string]interface{}{
+					"workspace_id": workspaceID,
+					"broadcast_id": broadcast.ID,
+					"error":        err.Error(),
+				}).Warn("Archive feed: dropping item — broadcast render failed")
+				continue
+			}
+			body, err := liquid.EmailBodyHTML(view.HTML)
+			if err == nil {
+				body, err = liquid.SanitizeFeedHTML(body, origin)
+			}
+			if err != nil {
+				s.logger.WithFields(map[string]interface{}{
+					"workspace_id": workspaceID,
+					"broadcast_id": broadcast.ID,
+					"error":        err.Error(),
+				}).Warn("Archive feed: dropping item — body sanitization failed")
+				continue
+			}
+			item.Title = view.Subject
+			item.ContentHTML = body
+		}
+
+		if broadcast.UpdatedAt.After(maxUpdatedAt) {
+			maxUpdatedAt = broadcast.UpdatedAt.UTC()
+		}
+		fingerprint.Write([]byte(broadcast.ID + "@" + broadcast.UpdatedAt.UTC().Format(time.RFC3339Nano) + "\n"))
+		items = append(items, item)
+	}
+
+	language := workspace.Settings.DefaultLanguage
+	if language == "" {
+		language = "en"
+	}
+	title := blogTitleForDiscovery(workspace) + " — " + list.Name
+	description := list.Description
+	if description == "" {
+		description = title
+	}
+	var logoURL, iconURL string
+	if bs := workspace.Settings.BlogSettings; bs.LogoURL != nil {
+		logoURL = *bs.LogoURL
+	}
+	if bs := workspace.Settings.BlogSettings; bs.IconURL != nil {
+		iconURL = *bs.IconURL
+	}
+
+	selfURL := joinURL(origin, "/"+archiveSlug+"/feed.xml")
+	meta := domain.BlogFeedMeta{
+		Title:       title,
+		Description: description,
+		SiteURL:     joinURL(origin, "/"+archiveSlug),
+		FeedURL:     selfURL,
+		SelfURL:     selfURL,
+		Language:    language,
+		IconURL:     iconURL,
+		LogoURL:     logoURL,
+		UpdatedAt:   maxUpdatedAt,
+		ETag:        computeFeedETag(workspace, &archiveSlug, maxUpdatedAt, hex.EncodeToString(fingerprint.Sum(nil))),
+	}
+	return &domain.BlogFeed{Meta: meta, Items: items}, nil
+}
+
+// getArchiveEntities loads what every archive page needs, the list being unknown unless the
+// workspace archives it
+func (s *BlogService) getArchiveEntities(ctx context.Context, workspaceID, listID string, themeVersion *int) (*domain.Workspace, *domain.List, *domain.BlogTheme, error) {
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return nil, nil, nil, &domain.BlogRenderError{
+			Code:    domain.ErrCodeRenderFailed,
+			Message: "Failed to get workspace",
+			Details: err,
+		}
+	}
+	if !workspace.Settings.BlogSettings.IsListArchived(listID) {
+		return nil, nil, nil, &domain.BlogRenderError{
+			Code:    domain.ErrCodeCategoryNotFound,
+			Message: "List is not archived",
+		}
+	}
+
+	var theme *domain.BlogTheme
+	if themeVersion != nil {
+		theme, err = s.themeRepo.GetTheme(ctx, *themeVersion)
+	} else {
+		theme, err = s.themeRepo.GetPublishedTheme(ctx)
+	}
+	if err != nil {
+		if err.Error() == "no published theme found" || err.Error() == "sql: no rows in result set" {
+			return nil, nil, nil, &domain.BlogRenderError{
+				Code:    domain.ErrCodeThemeNotPublished,
+				Message: "No published theme available",
+				Details: err,
+			}
+		}
+		return nil, nil, nil, &domain.BlogRenderError{
+			Code:    domain.ErrCodeThemeNotFound,
+			Message: "Failed to get theme",
+			Details: err,
+		}
+	}
+
+	list, err := s.listRepo.GetListByID(ctx, workspaceID, listID)
+	if err != nil || list.DeletedAt != nil {
+		return nil, nil, nil, &domain.BlogRenderError{
+			Code:    domain.ErrCodeCategoryNotFound,
+			Message: "List not found",
+			Details: err,
+		}
+	}
+
+	return workspace, list, theme, nil
+}
+
+// renderArchiveIssue checks that a broadcast was sent to the list and renders it
+func (s *BlogService) renderArchiveIssue(ctx context.Context, workspace *domain.Workspace, listID, broadcastID string) (*domain.Broadcast, *domain.WebView, error) {
+	broadcast, err := s.broadcastRepo.GetBroadcast(ctx, workspace.ID, broadcastID)
+	if err != nil {
+		return nil, nil, &domain.BlogRenderError{
+			Code:    domain.ErrCodePostNotFound,
+			Message: "Broadcast not found",
+			Details: err,
+		}
+	}
+	if broadcast.Status != domain.BroadcastStatusProcessed || broadcast.Audience.List != listID {
+		return nil, nil, &domain.BlogRenderError{
+			Code:    domain.ErrCodePostNotFound,
+			Message: "Broadcast is not archived in this list",
+		}
+	}
+
+	view, err := s.broadcastRenderer.RenderBroadcastWebView(ctx, workspace, broadcastID)
+	if err != nil {
+		code := domain.ErrCodeRenderFailed
+		if errors.Is(err, domain.ErrWebViewNotFound) {
+			code = domain.ErrCodePostNotFound
+		}
+		return nil, nil, &domain.BlogRenderError{
+			Code:    code,
+			Message: "Failed to render broadcast",
+			Details: err,
+		}
+	}
+	return broadcast, view, nil
+}
+
+// archiveIssueSummary returns the title and excerpt of an archived broadcast: the subject and
+// preview of the variation most recipients received, in the template version it was sent with,
+// rendered without personal data. The title falls back to the name of the broadcast. Templates
+// are cached by ID and version across calls.
+func (s *BlogService) archiveIssueSummary(ctx context.Context, workspaceID string, list *domain.List, broadcast *domain.Broadcast, templates map[string]*domain.Template) (string, string) {
+	variation := domain.BroadcastArchiveVariation(broadcast)
+	if variation == nil {
+		return broadcast.Name, ""
+	}
+
+	template := s.archiveIssueTemplate(ctx, workspaceID, broadcast.ID, variation.TemplateID, templates)
+
+	subject := variation.Subject
+	preview := ""
+	if template != nil && template.Email != nil {
+		if subject == "" {
+			subject = template.Email.Subject
+		}
+		if template.Email.SubjectPreview != nil {
+			preview = *template.Email.SubjectPreview
+		}
+	}
+
+	data := domain.PublicTemplateData(domain.MapOfAny{
+		"broadcast": domain.MapOfAny{"id": broadcast.ID, "name": broadcast.Name},
+		"list":      domain.MapOfAny{"id": list.ID, "name": list.Name},
+	})
+	title := broadcast.Name
+	if rendered, err := notifuse_mjml.ProcessLiquidTemplate(subject, data, "email_subject"); err == nil && strings.TrimSpace(rendered) != "" {
+		title = strings.TrimSpace(rendered)
+	}
+	excerpt := ""
+	if rendered, err := notifuse_mjml.ProcessLiquidTemplate(preview, data, "email_preview"); err == nil {
+		excerpt = strings.TrimSpace(rendered)
+	}
+	return title, excerpt
+}
+
+// archiveIssueTemplate returns the template version recorded in the message history of a sent
+// broadcast, like its web view, or nil when it cannot be loaded
+func (s *BlogService) archiveIssueTemplate(ctx context.Context, workspaceID, broadcastID, templateID string, templates map[string]*domain.Template) *domain.Template {
+	logger := s.logger.WithFields(map[string]interface{}{
+		"workspace_id": workspaceID,
+		"broadcast_id": broadcastID,
+		"template_id":  templateID,
+	})
+
+	version, err := s.messageRepo.GetBroadcastTemplateVersion(ctx, workspaceID, broadcastID, templateID)
+	if err != nil {
+		logger.WithField("error", err.Error()).Warn("Failed to get template version of archived broadcast")
+		return nil
+	}
+
+	key := fmt.Sprintf("%s:%d", templateID, version)
+	template, cached := templates[key]
+	if !cached {
+		template, err = s.templateRepo.GetTemplateByID(ctx, workspaceID, templateID, version)
+		if err != nil {
+			logger.WithField("error", err.Error()).Warn("Failed to get template of archived broadcast")
+			template = nil
+		}
+		templates[key] = template
+	}
+	return template
+}
+
+// setArchiveTemplateData points the posts of archive pages to the archive and tells themes which
+// list the page belongs to
+func setArchiveTemplateData(templateData domain.MapOfAny, workspace *domain.Workspace, list *domain.List) {
+	archiveSlug := domain.NewsletterArchiveSlug(list.ID)
+	if posts, ok := templateData["posts"].([]map[string]interface{}); ok {
+		for _, post := range posts {
+			post["category_slug"] = archiveSlug
+		}
+	}
+
+	origin := workspaceBlogOrigin(workspace)
+	templateData["archive"] = domain.MapOfAny{
+		"list_id":       list.ID,
+		"list_name":     list.Name,
+		"url":           joinURL(origin, "/"+archiveSlug),
+		"rss_url":       joinURL(origin, "/"+archiveSlug+"/feed.xml"),
+		"json_feed_url": joinURL(origin, "/"+archiveSlug+"/feed.json"),
+	}
+}
+
+// themePartials returns the partials of a theme for the template engine
+func themePartials(theme *domain.BlogTheme) map[string]string {
+	return map[string]string{
+		"shared":  theme.Files.SharedLiquid,
+		"header":  theme.Files.HeaderLiquid,
+		"footer":  theme.Files.FooterLiquid,
+		"styles":  theme.Files.StylesCSS,
+		"scripts": theme.Files.ScriptsJS,
+	}
+}
@@ -0,0 +1,324 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	"github.com/Notifuse/notifuse/pkg/cache"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+type blogArchiveTestMocks struct {
+	categoryRepo  *mocks.MockBlogCategoryRepository
+	themeRepo     *mocks.MockBlogThemeRepository
+	workspaceRepo *mocks.MockWorkspaceRepository
+	listRepo      *mocks.MockListRepository
+	templateRepo  *mocks.MockTemplateRepository
+	broadcastRepo *mocks.MockBroadcastRepository
+	messageRepo   *mocks.MockMessageHistoryRepository
+	renderer      *mocks.MockBroadcastWebViewRenderer
+}
+
+func setupBlogArchiveTest(t *testing.T) (*BlogService, *blogArchiveTestMocks) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	m := &blogArchiveTestMocks{
+		categoryRepo:  mocks.NewMockBlogCategoryRepository(ctrl),
+		themeRepo:     mocks.NewMockBlogThemeRepository(ctrl),
+		workspaceRepo: mocks.NewMockWorkspaceRepository(ctrl),
+		listRepo:      mocks.NewMockListRepository(ctrl),
+		templateRepo:  mocks.NewMockTemplateRepository(ctrl),
+		broadcastRepo: mocks.NewMockBroadcastRepository(ctrl),
+		messageRepo:   mocks.NewMockMessageHistoryRepository(ctrl),
+		renderer:      mocks.NewMockBroadcastWebViewRenderer(ctrl),
+	}
+
+	service := NewBlogService(
+		logger.NewLoggerWithLevel("disabled"),
+		m.categoryRepo,
+		mocks.NewMockBlogPostRepository(ctrl),
+		m.themeRepo,
+		m.workspaceRepo,
+		m.listRepo,
+		m.templateRepo,
+		m.broadcastRepo,
+		m.messageRepo,
+		m.renderer,
+		mocks.NewMockAuthService(ctrl),
+		cache.NewInMemoryCache(30*time.Second),
+	)
+	return service, m
+}
+
+func archiveTestWorkspace() *domain.Workspace {
+	websiteURL := "https://news.example.com"
+	return &domain.Workspace{
+		ID:   "ws1",
+		Name: "Acme",
+		Settings: domain.WorkspaceSettings{
+			WebsiteURL:        websiteURL,
+			CustomEndpointURL: &websiteURL,
+			DefaultLanguage:   "en",
+			BlogSettings:      &domain.BlogSettings{Title: "Acme Blog", ArchiveListIDs: []string{"newsletter"}},
+		},
+	}
+}
+
+func archiveTestTheme() *domain.BlogTheme {
+	return &domain.BlogTheme{
+		Version: 1,
+		Files: domain.BlogThemeFiles{
+			CategoryLiquid: `<html><head></head><body><h1>{{ category.name }}</h1>` +
+				`{% for post in posts %}<a href="/{{ post.category_slug }}/{{ post.slug }}">{{ post.title }}</a><p>{{ post.excerpt }}</p>{% endfor %}` +
+				`<span>{{ pagination.total_count }}</span><a href="{{ archive.rss_url }}">RSS</a></body></html>`,
+			PostLiquid: `<html><head></head><body><h1>{{ post.title }}</h1><a href="/{{ post.category_slug }}">{{ category.name }}</a>` +
+				`<article>{{ post.content }}</article></body></html>`,
+		},
+	}
+}
+
+func archiveTestBroadcast(id, listID string) *domain.Broadcast {
+	completedAt := time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)
+	return &domain.Broadcast{
+		ID:          id,
+		Name:        "Internal name " + id,
+		Status:      domain.BroadcastStatusProcessed,
+		Audience:    domain.AudienceSettings{List: listID},
+		CreatedAt:   completedAt.Add(-time.Hour),
+		UpdatedAt:   completedAt,
+		CompletedAt: &completedAt,
+		TestSettings: domain.BroadcastTestSettings{
+			Variations: []domain.BroadcastVariation{{VariationName: "A", TemplateID: "tpl1"}},
+		},
+	}
+}
+
+func archiveTestTemplate() *domain.Template {
+	preview := "News of {{ list.name }}"
+	return &domain.Template{
+		ID: "tpl1",
+		Email: &domain.EmailTemplate{
+			Subject:        "Hi {{ contact.first_name }}, October news",
+			SubjectPreview: &preview,
+		},
+	}
+}
+
+func TestBlogService_RenderArchivePage(t *testing.T) {
+	ctx := context.Background()
+	list := &domain.List{ID: "newsletter", Name: "Weekly"}
+
+	t.Run("lists the broadcasts of the list", func(t *testing.T) {
+		service, m := setupBlogArchiveTest(t)
+
+		m.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(archiveTestWorkspace(), nil)
+		m.themeRepo.EXPECT().GetPublishedTheme(ctx).Return(archiveTestTheme(), nil)
+		m.listRepo.EXPECT().GetListByID(ctx, "ws1", "newsletter").Return(list, nil)
+		m.broadcastRepo.EXPECT().ListBroadcasts(ctx, domain.ListBroadcastsParams{
+			WorkspaceID: "ws1",
+			Status:      domain.BroadcastStatusProcessed,
+			ListID:      "newsletter",
+			Limit:       20,
+			Offset:      0,
+		}).Return(&domain.BroadcastListResponse{
+			Broadcasts: []*domain.Broadcast{archiveTestBroadcast("bc1", "newsletter"), archiveTestBroadcast("bc2", "newsletter")},
+			TotalCount: 2,
+		}, nil)
+		// Both broadcasts were sent with the same version, it is loaded once
+		m.messageRepo.EXPECT().GetBroadcastTemplateVersion(ctx, "ws1", "bc1", "tpl1").Return(int64(3), nil)
+		m.messageRepo.EXPECT().GetBroadcastTemplateVersion(ctx, "ws1", "bc2", "tpl1").Return(int64(3), nil)
+		m.templateRepo.EXPECT().GetTemplateByID(ctx, "ws1", "tpl1", int64(3)).Return(archiveTestTemplate(), nil)
+		m.listRepo.EXPECT().GetLists(ctx, "ws1").Return([]*domain.List{}, nil)
+		m.categoryRepo.EXPECT().ListCategories(ctx).Return([]*domain.BlogCategory{}, nil)
+
+		html, err := service.RenderArchivePage(ctx, "ws1", "newsletter", 1, nil)
+		require.NoError(t, err)
+
+		assert.Contains(t, html, "<h1>Weekly</h1>")
+		assert.Contains(t, html, `<a href="/archive/newsletter/bc1">Hi , October news</a>`)
+		assert.Contains(t, html, `<a href="/archive/newsletter/bc2">`)
+		assert.Contains(t, html, "<p>News of Weekly</p>")
+		assert.Contains(t, html, "<span>2</span>")
+		assert.Contains(t, html, `href="https://news.example.com/archive/newsletter/feed.xml"`)
+		assert.Contains(t, html, `href="/archive/newsletter/feed.json"`)
+		assert.NotContains(t, html, "Internal name")
+	})
+
+	t.Run("summarizes each broadcast from the template version it was sent with", func(t *testing.T) {
+		service, m := setupBlogArchiveTest(t)
+
+		m.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(archiveTestWorkspace(), nil)
+		m.themeRepo.EXPECT().GetPublishedTheme(ctx).Return(archiveTestTheme(), nil)
+		m.listRepo.EXPECT().GetListByID(ctx, "ws1", "newsletter").Return(list, nil)
+		m.broadcastRepo.EXPECT().ListBroadcasts(ctx, gomock.Any()).Return(&domain.BroadcastListResponse{
+			Broadcasts: []*domain.Broadcast{archiveTestBroadcast("bc1", "newsletter"), archiveTestBroadcast("bc2", "newsletter")},
+			TotalCount: 2,
+		}, nil)
+		september := archiveTestTemplate()
+		september.Email.Subject = "September news"
+		m.messageRepo.EXPECT().GetBroadcastTemplateVersion(ctx, "ws1", "bc1", "tpl1").Return(int64(2), nil)
+		m.templateRepo.EXPECT().GetTemplateByID(ctx, "ws1", "tpl1", int64(2)).Return(september, nil)
+		// Without a sent message the internal name is shown
+		m.messageRepo.EXPECT().GetBroadcastTemplateVersion(ctx, "ws1", "bc2", "tpl1").Return(int64(0), errors.New("no message"))
+		m.listRepo.EXPECT().GetLists(ctx, "ws1").Return([]*domain.List{}, nil)
+		m.categoryRepo.EXPECT().ListCategories(ctx).Return([]*domain.BlogCategory{}, nil)
+
+		html, err := service.RenderArchivePage(ctx, "ws1", "newsletter", 1, nil)
+		require.NoError(t, err)
+
+		assert.Contains(t, html, `<a href="/archive/newsletter/bc1">September news</a>`)
+		assert.Contains(t, html, `<a href="/archive/newsletter/bc2">Internal name bc2</a>`)
+	})
+
+	t.Run("lists that are not archived are not found", func(t *testing.T) {
+		service, m := setupBlogArchiveTest(t)
+		m.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(archiveTestWorkspace(), nil)
+
+		_, err := service.RenderArchivePage(ctx, "ws1", "customers", 1, nil)
+		var blogErr *domain.BlogRenderError
+		require.ErrorAs(t, err, &blogErr)
+		assert.Equal(t, domain.ErrCodeCategoryNotFound, blogErr.Code)
+	})
+
+	t.Run("pages past the last one are not found", func(t *testing.T) {
+		service, m := setupBlogArchiveTest(t)
+		m.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(archiveTestWorkspace(), nil)
+		m.themeRepo.EXPECT().GetPublishedTheme(ctx).Return(archiveTestTheme(), nil)
+		m.listRepo.EXPECT().GetListByID(ctx, "ws1", "newsletter").Return(list, nil)
+		m.broadcastRepo.EXPECT().ListBroadcasts(ctx, gomock.Any()).Return(&domain.BroadcastListResponse{TotalCount: 2}, nil)
+
+		_, err := service.RenderArchivePage(ctx, "ws1", "newsletter", 2, nil)
+		var blogErr *domain.BlogRenderError
+		require.ErrorAs(t, err, &blogErr)
+		assert.Equal(t, domain.ErrCodePostNotFound, blogErr.Code)
+	})
+}
+
+func TestBlogService_RenderArchiveIssuePage(t *testing.T) {
+	ctx := context.Background()
+	list := &domain.List{ID: "newsletter", Name: "Weekly"}
+
+	setup := func(t *testing.T) (*BlogService, *blogArchiveTestMocks) {
+		service, m := setupBlogArchiveTest(t)
+		m.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(archiveTestWorkspace(), nil)
+		m.themeRepo.EXPECT().GetPublishedTheme(ctx).Return(archiveTestTheme(), nil)
+		m.listRepo.EXPECT().GetListByID(ctx, "ws1", "newsletter").Return(list, nil)
+		return service, m
+	}
+
+	t.Run("renders the body of the email in the post template", func(t *testing.T) {
+		service, m := setup(t)
+		m.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", "bc1").Return(archiveTestBroadcast("bc1", "newsletter"), nil)
+		m.renderer.EXPECT().RenderBroadcastWebView(ctx, gomock.Any(), "bc1").Return(&domain.WebView{
+			Subject: "Hi , October news",
+			HTML:    `<!doctype html><html><head><style>body{margin:0}</style></head><body><h2>Highlights</h2><p>Hello</p></body></html>`,
+		}, nil)
+		m.messageRepo.EXPECT().GetBroadcastTemplateVersion(ctx, "ws1", "bc1", "tpl1").Return(int64(3), nil)
+		m.templateRepo.EXPECT().GetTemplateByID(ctx, "ws1", "tpl1", int64(3)).Return(archiveTestTemplate(), nil)
+		m.listRepo.EXPECT().GetLists(ctx, "ws1").Return([]*domain.List{}, nil)
+		m.categoryRepo.EXPECT().ListCategories(ctx).Return([]*domain.BlogCategory{}, nil)
+
+		html, err := service.RenderArchiveIssuePage(ctx, "ws1", "newsletter", "bc1", nil)
+		require.NoError(t, err)
+
+		assert.Contains(t, html, "<h1>Hi , October news</h1>")
+		assert.Contains(t, html, `<a href="/archive/newsletter">Weekly</a>`)
+		assert.Contains(t, html, "<p>Hello</p></article>")
+		assert.Contains(t, html, `<h2 id="highlights">Highlights</h2>`)
+		assert.NotContains(t, html, "body{margin:0}")
+	})
+
+	t.Run("broadcasts of other lists are not found", func(t *testing.T) {
+		service, m := setup(t)
+		m.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", "bc1").Return(archiveTestBroadcast("bc1", "customers"), nil)
+
+		_, err := service.RenderArchiveIssuePage(ctx, "ws1", "newsletter", "bc1", nil)
+		var blogErr *domain.BlogRenderError
+		require.ErrorAs(t, err, &blogErr)
+		assert.Equal(t, domain.ErrCodePostNotFound, blogErr.Code)
+	})
+
+	t.Run("broadcasts without messages are not found", func(t *testing.T) {
+		service, m := setup(t)
+		m.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", "bc1").Return(archiveTestBroadcast("bc1", "newsletter"), nil)
+		m.renderer.EXPECT().RenderBroadcastWebView(ctx, gomock.Any(), "bc1").Return(nil, domain.ErrWebViewNotFound)
+
+		_, err := service.RenderArchiveIssuePage(ctx, "ws1", "newsletter", "bc1", nil)
+		var blogErr *domain.BlogRenderError
+		require.ErrorAs(t, err, &blogErr)
+		assert.Equal(t, domain.ErrCodePostNotFound, blogErr.Code)
+	})
+}
+
+func TestBlogService_BuildArchiveFeed(t *testing.T) {
+	ctx := context.Background()
+	list := &domain.List{ID: "newsletter", Name: "Weekly", Description: "Our weekly news"}
+
+	setup := func(t *testing.T, workspace *domain.Workspace) (*BlogService, *blogArchiveTestMocks) {
+		service, m := setupBlogArchiveTest(t)
+		m.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(workspace, nil)
+		m.listRepo.EXPECT().GetListByID(ctx, "ws1", "newsletter").Return(list, nil)
+		m.broadcastRepo.EXPECT().ListBroadcasts(ctx, domain.ListBroadcastsParams{
+			WorkspaceID: "ws1",
+			Status:      domain.BroadcastStatusProcessed,
+			ListID:      "newsletter",
+			Limit:       20,
+		}).Return(&domain.BroadcastListResponse{
+			Broadcasts: []*domain.Broadcast{archiveTestBroadcast("bc1", "newsletter"), archiveTestBroadcast("bc2", "newsletter")},
+			TotalCount: 2,
+		}, nil)
+		m.messageRepo.EXPECT().GetBroadcastTemplateVersion(ctx, "ws1", "bc1", "tpl1").Return(int64(3), nil)
+		m.messageRepo.EXPECT().GetBroadcastTemplateVersion(ctx, "ws1", "bc2", "tpl1").Return(int64(3), nil)
+		m.templateRepo.EXPECT().GetTemplateByID(ctx, "ws1", "tpl1", int64(3)).Return(archiveTestTemplate(), nil)
+		return service, m
+	}
+
+	t.Run("renders the broadcasts and drops those that fail", func(t *testing.T) {
+		service, m := setup(t, archiveTestWorkspace())
+		m.renderer.EXPECT().RenderBroadcastWebView(ctx, gomock.Any(), "bc1").Return(&domain.WebView{
+			Subject: "October news",
+			HTML:    `<html><body><p onclick="x()">Hello</p><script>alert(1)</script></body></html>`,
+		}, nil)
+		m.renderer.EXPECT().RenderBroadcastWebView(ctx, gomock.Any(), "bc2").Return(nil, errors.New("compilation failed"))
+
+		feed, err := service.BuildArchiveFeed(ctx, "ws1", "newsletter")
+		require.NoError(t, err)
+
+		assert.Equal(t, "Acme Blog — Weekly", feed.Meta.Title)
+		assert.Equal(t, "Our weekly news", feed.Meta.Description)
+		assert.Equal(t, "https://news.example.com/archive/newsletter", feed.Meta.SiteURL)
+		assert.Equal(t, "https://news.example.com/archive/newsletter/feed.xml", feed.Meta.SelfURL)
+		assert.NotEmpty(t, feed.Meta.ETag)
+
+		require.Len(t, feed.Items, 1)
+		item := feed.Items[0]
+		assert.Equal(t, "bc1", item.GUID)
+		assert.Equal(t, "October news", item.Title)
+		assert.Equal(t, "https://news.example.com/archive/newsletter/bc1", item.URL)
+		assert.Equal(t, "Weekly", item.CategoryName)
+		assert.Equal(t, "News of Weekly", item.Excerpt)
+		assert.Equal(t, "<p>Hello</p>", item.ContentHTML)
+	})
+
+	t.Run("summary only feeds do not render the broadcasts", func(t *testing.T) {
+		workspace := archiveTestWorkspace()
+		workspace.Settings.BlogSettings.FeedSummaryOnly = true
+		service, _ := setup(t, workspace)
+
+		feed, err := service.BuildArchiveFeed(ctx, "ws1", "newsletter")
+		require.NoError(t, err)
+
+		require.Len(t, feed.Items, 2)
+		assert.Equal(t, "Hi , October news", feed.Items[0].Title)
+		assert.Equal(t, "News of Weekly", feed.Items[0].ContentHTML)
+	})
+}
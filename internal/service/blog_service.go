@@ -20,15 +20,18 @@ import (
 
 // BlogService handles all blog-related operations
 type BlogService struct {
-	logger        logger.Logger
-	categoryRepo  domain.BlogCategoryRepository
-	postRepo      domain.BlogPostRepository
-	themeRepo     domain.BlogThemeRepository
-	workspaceRepo domain.WorkspaceRepository
-	listRepo      domain.ListRepository
-	templateRepo  domain.TemplateRepository
-	authService   domain.AuthService
-	cache         cache.Cache
+	logger            logger.Logger
+	categoryRepo      domain.BlogCategoryRepository
+	postRepo          domain.BlogPostRepository
+	themeRepo         domain.BlogThemeRepository
+	workspaceRepo     domain.WorkspaceRepository
+	listRepo          domain.ListRepository
+	templateRepo      domain.TemplateRepository
+	broadcastRepo     domain.BroadcastRepository
+	messageRepo       domain.MessageHistoryRepository
+	broadcastRenderer domain.BroadcastWebViewRenderer
+	authService       domain.AuthService
+	cache             cache.Cache
 }
 
 // NewBlogService creates a new blog service
@@ -40,19 +43,25 @@ func NewBlogService(
 	workspaceRepository domain.WorkspaceRepository,
 	listRepository domain.ListRepository,
 	templateRepository domain.TemplateRepository,
+	broadcastRepository domain.BroadcastRepository,
+	messageHistoryRepository domain.MessageHistoryRepository,
+	broadcastRenderer domain.BroadcastWebViewRenderer,
 	authService domain.AuthService,
 	cache cache.Cache,
 ) *BlogService {
 	return &BlogService{
-		logger:        logger,
-		categoryRepo:  categoryRepository,
-		postRepo:      postRepository,
-		themeRepo:     themeRepository,
-		workspaceRepo: workspaceRepository,
-		listRepo:      listRepository,
-		templateRepo:  templateRepository,
-		authService:   authService,
-		cache:         cache,
+		logger:            logger,
+		categoryRepo:      categoryRepository,
+		postRepo:          postRepository,
+		themeRepo:         themeRepository,
+		workspaceRepo:     workspaceRepository,
+		listRepo:          listRepository,
+		templateRepo:      templateRepository,
+		broadcastRepo:     broadcastRepository,
+		messageRepo:       messageHistoryRepository,
+		broadcastRenderer: broadcastRenderer,
+		authService:       authService,
+		cache:             cache,
 	}
 }
 
@@ -1755,6 +1764,70 @@ func (s *BlogService) GetFeedFingerprint(ctx context.Context, workspaceID string
 	return maxUpdatedAt, computeFeedETag(workspace, categorySlug, maxUpdatedAt, idsHash), nil
 }
 
+// ListDigestPosts returns the posts published in (since, until] as feed items
+// without body, newest first. Orphan posts are dropped like in BuildFeed.
+func (s *BlogService) ListDigestPosts(ctx context.Context, workspaceID string, settings *domain.BlogDigestSettings, since, until time.Time) ([]domain.BlogFeedItem, error) {
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+	origin := workspaceBlogOrigin(workspace)
+
+	// The repo methods read workspaceID from ctx (like ListPosts).
+	digestCtx := context.WithValue(ctx, domain.WorkspaceIDKey, workspaceID)
+
+	posts, err := s.postRepo.ListPostsPublishedBetween(digestCtx, settings.CategoryID, since, until, settings.GetMaxPosts())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list digest posts: %w", err)
+	}
+	if len(posts) == 0 {
+		return []domain.BlogFeedItem{}, nil
+	}
+
+	categoryIDs := make([]string, 0, len(posts))
+	seen := map[string]struct{}{}
+	for _, p := range posts {
+		if _, ok := seen[p.CategoryID]; ok {
+			continue
+		}
+		seen[p.CategoryID] = struct{}{}
+		categoryIDs = append(categoryIDs, p.CategoryID)
+	}
+	cats, err := s.categoryRepo.GetCategoriesByIDs(digestCtx, categoryIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get digest categories: %w", err)
+	}
+	categoriesByID := make(map[string]*domain.BlogCategory, len(cats))
+	for _, c := range cats {
+		categoriesByID[c.ID] = c
+	}
+
+	items := make([]domain.BlogFeedItem, 0, len(posts))
+	for _, post := range posts {
+		cat := categoriesByID[post.CategoryID]
+		if cat == nil {
+			continue
+		}
+
+		item := domain.BlogFeedItem{
+			GUID:             post.ID,
+			Title:            post.Settings.Title,
+			URL:              buildPostURL(origin, cat.Slug, post.Slug),
+			CategorySlug:     cat.Slug,
+			CategoryName:     cat.Settings.Name,
+			Excerpt:          post.Settings.Excerpt,
+			Authors:          post.Settings.Authors,
+			FeaturedImageURL: post.Settings.FeaturedImageURL,
+			UpdatedAt:        post.UpdatedAt,
+		}
+		if post.PublishedAt != nil {
+			item.PublishedAt = *post.PublishedAt
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
 // computeFeedETag hashes the fingerprint inputs to a short hex ETag.
 // Inputs: maxUpdatedAt, idsHash, categorySlug, settings fingerprint (blog
 // title/logos/feed toggles/default language). Any one changing invalidates.
@@ -48,6 +48,9 @@ func setupBlogServiceTest(t *testing.T) (
 		mockWorkspaceRepo,
 		mockListRepo,
 		mockTemplateRepo,
+		nil,
+		nil,
+		nil,
 		mockAuthService,
 		testCache,
 	)
@@ -2856,3 +2859,53 @@ func TestBlogService_GetFeedFingerprint(t *testing.T) {
 		assert.NotEqual(t, etagBefore, etagAfter)
 	})
 }
+
+func TestBlogService_ListDigestPosts(t *testing.T) {
+	workspaceID := "ws-digest"
+	since := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	published := time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC)
+	workspace := &domain.Workspace{
+		ID:       workspaceID,
+		Settings: domain.WorkspaceSettings{WebsiteURL: "https://blog.example.com"},
+	}
+
+	t.Run("returns the posts with their URL and category", func(t *testing.T) {
+		service, mockCategoryRepo, mockPostRepo, _, mockWorkspaceRepo, _, _, _ := setupBlogServiceTest(t)
+		ctx := context.Background()
+		settings := &domain.BlogDigestSettings{Enabled: true, CategoryID: "cat-1"}
+
+		mockWorkspaceRepo.EXPECT().GetByID(ctx, workspaceID).Return(workspace, nil)
+		mockPostRepo.EXPECT().
+			ListPostsPublishedBetween(gomock.Any(), "cat-1", since, until, domain.DefaultBlogDigestMaxPosts).
+			Return([]*domain.BlogPost{
+				{ID: "p1", Slug: "hello", CategoryID: "cat-1", PublishedAt: &published, Settings: domain.BlogPostSettings{Title: "Hello", Excerpt: "Hi"}},
+				{ID: "p2", Slug: "orphan", CategoryID: "cat-deleted", PublishedAt: &published},
+			}, nil)
+		mockCategoryRepo.EXPECT().
+			GetCategoriesByIDs(gomock.Any(), []string{"cat-1", "cat-deleted"}).
+			Return([]*domain.BlogCategory{{ID: "cat-1", Slug: "tech", Settings: domain.BlogCategorySettings{Name: "Tech"}}}, nil)
+
+		items, err := service.ListDigestPosts(ctx, workspaceID, settings, since, until)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, "p1", items[0].GUID)
+		assert.Equal(t, "https://blog.example.com/tech/hello", items[0].URL)
+		assert.Equal(t, "Tech", items[0].CategoryName)
+		assert.True(t, published.Equal(items[0].PublishedAt))
+	})
+
+	t.Run("no new posts", func(t *testing.T) {
+		service, _, mockPostRepo, _, mockWorkspaceRepo, _, _, _ := setupBlogServiceTest(t)
+		ctx := context.Background()
+
+		mockWorkspaceRepo.EXPECT().GetByID(ctx, workspaceID).Return(workspace, nil)
+		mockPostRepo.EXPECT().
+			ListPostsPublishedBetween(gomock.Any(), "", since, until, 3).
+			Return(nil, nil)
+
+		items, err := service.ListDigestPosts(ctx, workspaceID, &domain.BlogDigestSettings{Enabled: true, MaxPosts: 3}, since, until)
+		require.NoError(t, err)
+		assert.Empty(t, items)
+	})
+}
@@ -60,9 +60,12 @@ func (e *ABTestEvaluator) EvaluateAndSelectWinner(ctx context.Context, workspace
 }
 
 func (e *ABTestEvaluator) selectBestVariation(ctx context.Context, workspaceID string, broadcast *domain.Broadcast) (string, error) {
-	bestTemplateID := ""
-	bestScore := -1.0
+	metric := broadcast.TestSettings.WinnerMetric()
+	if !metric.IsValid() {
+		return "", fmt.Errorf("invalid winner metric: %s", metric)
+	}
 
+	samples := make([]domain.ABTestSample, 0, len(broadcast.TestSettings.Variations))
 	for _, variation := range broadcast.TestSettings.Variations {
 		stats, err := e.messageHistoryRepo.GetBroadcastVariationStats(ctx, workspaceID, broadcast.ID, variation.TemplateID)
 		if err != nil {
@@ -73,42 +76,44 @@ func (e *ABTestEvaluator) selectBestVariation(ctx context.Context, workspaceID s
 			continue
 		}
 
-		var score float64
-		if stats.TotalDelivered > 0 {
-			switch broadcast.TestSettings.AutoSendWinnerMetric {
-			case domain.TestWinnerMetricOpenRate:
-				score = float64(stats.TotalOpened) / float64(stats.TotalDelivered)
-			case domain.TestWinnerMetricClickRate:
-				score = float64(stats.TotalClicked) / float64(stats.TotalDelivered)
-			default:
-				return "", fmt.Errorf("invalid winner metric: %s", broadcast.TestSettings.AutoSendWinnerMetric)
+		var revenue *domain.GoalRevenueSum
+		if metric == domain.TestWinnerMetricGoalRevenue {
+			revenue, err = e.messageHistoryRepo.GetBroadcastVariationGoalRevenue(ctx, workspaceID, broadcast.ID, variation.TemplateID)
+			if err != nil {
+				e.logger.WithFields(map[string]interface{}{
+					"template_id": variation.TemplateID,
+					"error":       err.Error(),
+				}).Warn("Failed to get variation goal revenue")
+				continue
 			}
 		}
 
-		if score > bestScore {
-			bestScore = score
-			bestTemplateID = variation.TemplateID
-		}
+		samples = append(samples, domain.NewABTestSample(variation.TemplateID, metric, stats, revenue))
+	}
 
+	result := domain.EvaluateABTest(&broadcast.TestSettings, samples)
+	for templateID, variation := range result.Variations {
 		e.logger.WithFields(map[string]interface{}{
-			"template_id": variation.TemplateID,
-			"metric":      broadcast.TestSettings.AutoSendWinnerMetric,
-			"score":       score,
-			"is_best":     score == bestScore,
+			"template_id": templateID,
+			"metric":      metric,
+			"score":       variation.Value,
+			"is_best":     templateID == result.BestTemplateID,
 		}).Info("Variation evaluation result")
 	}
 
-	if bestTemplateID == "" {
+	if result.WinnerTemplateID == "" {
 		return "", fmt.Errorf("no winner could be determined")
 	}
 
 	e.logger.WithFields(map[string]interface{}{
-		"broadcast_id":    broadcast.ID,
-		"winner_template": bestTemplateID,
-		"winning_score":   bestScore,
+		"broadcast_id":        broadcast.ID,
+		"winner_template":     result.WinnerTemplateID,
+		"best_template":       result.BestTemplateID,
+		"conclusive":          result.Conclusive,
+		"inconclusive_reason": result.InconclusiveReason,
 	}).Info("Auto winner selected")
 
-	return bestTemplateID, nil
+	return result.WinnerTemplateID, nil
 }
 
 func (e *ABTestEvaluator) updateBroadcastWithWinner(ctx context.Context, workspaceID string, broadcast *domain.Broadcast, winnerTemplateID string) error {
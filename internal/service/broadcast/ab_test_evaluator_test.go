@@ -126,6 +126,33 @@ func TestABTestEvaluator_EvaluateAndSelectWinner_ClickRate_Success(t *testing.T)
 	assert.Equal(t, "tplB", winner)
 }
 
+func TestABTestEvaluator_EvaluateAndSelectWinner_EmptyMetricDefaultsToClickRate(t *testing.T) {
+	ctrl, msgRepo, bcRepo, _, evaluator := setupEvaluator(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	workspaceID := "w1"
+	broadcastID := "b1"
+
+	b := newTestBroadcast(workspaceID, broadcastID)
+	b.TestSettings.AutoSendWinnerMetric = ""
+
+	bcRepo.EXPECT().GetBroadcast(ctx, workspaceID, broadcastID).Return(b, nil)
+
+	// A opens more but B clicks more, so B wins on the default click rate
+	msgRepo.EXPECT().GetBroadcastVariationStats(ctx, workspaceID, broadcastID, "tplA").Return(&domain.MessageHistoryStatusSum{TotalDelivered: 100, TotalOpened: 60, TotalClicked: 5}, nil)
+	msgRepo.EXPECT().GetBroadcastVariationStats(ctx, workspaceID, broadcastID, "tplB").Return(&domain.MessageHistoryStatusSum{TotalDelivered: 100, TotalOpened: 30, TotalClicked: 10}, nil)
+
+	bcRepo.EXPECT().WithTransaction(ctx, workspaceID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, fn func(*sql.Tx) error) error { return fn(nil) },
+	)
+	bcRepo.EXPECT().UpdateBroadcastTx(ctx, gomock.Any(), gomock.Any()).Return(nil)
+
+	winner, err := evaluator.EvaluateAndSelectWinner(ctx, workspaceID, broadcastID)
+	require.NoError(t, err)
+	assert.Equal(t, "tplB", winner)
+}
+
 func TestABTestEvaluator_EvaluateAndSelectWinner_GetBroadcastError(t *testing.T) {
 	ctrl, _, bcRepo, _, evaluator := setupEvaluator(t)
 	defer ctrl.Finish()
@@ -191,8 +218,8 @@ func TestABTestEvaluator_EvaluateAndSelectWinner_InvalidMetric(t *testing.T) {
 
 	bcRepo.EXPECT().GetBroadcast(ctx, workspaceID, broadcastID).Return(b, nil)
 
-	// Provide stats so selection reaches metric switch; evaluator will error on first variation
-	msgRepo.EXPECT().GetBroadcastVariationStats(ctx, workspaceID, broadcastID, "tplA").Return(&domain.MessageHistoryStatusSum{TotalDelivered: 100, TotalOpened: 40, TotalClicked: 5}, nil)
+	// The metric is checked before any stats are fetched
+	msgRepo.EXPECT().GetBroadcastVariationStats(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 	_, err := evaluator.EvaluateAndSelectWinner(ctx, workspaceID, broadcastID)
 	require.Error(t, err)
@@ -298,3 +325,75 @@ func TestABTestEvaluator_EvaluateAndSelectWinner_UpdateBroadcastError(t *testing
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to update broadcast with winner")
 }
+
+func expectWinnerUpdate(t *testing.T, ctx context.Context, bcRepo *domainmocks.MockBroadcastRepository, workspaceID, expected string) {
+	bcRepo.EXPECT().WithTransaction(ctx, workspaceID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, fn func(*sql.Tx) error) error { return fn(nil) },
+	)
+	bcRepo.EXPECT().UpdateBroadcastTx(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *sql.Tx, updated *domain.Broadcast) error {
+			require.NotNil(t, updated.WinningTemplate)
+			assert.Equal(t, expected, *updated.WinningTemplate)
+			return nil
+		},
+	)
+}
+
+func TestABTestEvaluator_EvaluateAndSelectWinner_Significance(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := "w1"
+	broadcastID := "b1"
+
+	t.Run("significant difference sends the best variation", func(t *testing.T) {
+		ctrl, msgRepo, bcRepo, _, evaluator := setupEvaluator(t)
+		defer ctrl.Finish()
+
+		b := newTestBroadcast(workspaceID, broadcastID)
+		b.TestSettings.SignificanceMethod = domain.TestSignificanceChiSquared
+		bcRepo.EXPECT().GetBroadcast(ctx, workspaceID, broadcastID).Return(b, nil)
+
+		msgRepo.EXPECT().GetBroadcastVariationStats(ctx, workspaceID, broadcastID, "tplA").Return(&domain.MessageHistoryStatusSum{TotalDelivered: 1000, TotalOpened: 200}, nil)
+		msgRepo.EXPECT().GetBroadcastVariationStats(ctx, workspaceID, broadcastID, "tplB").Return(&domain.MessageHistoryStatusSum{TotalDelivered: 1000, TotalOpened: 300}, nil)
+		expectWinnerUpdate(t, ctx, bcRepo, workspaceID, "tplB")
+
+		winner, err := evaluator.EvaluateAndSelectWinner(ctx, workspaceID, broadcastID)
+		require.NoError(t, err)
+		assert.Equal(t, "tplB", winner)
+	})
+
+	t.Run("inconclusive test sends the default variation", func(t *testing.T) {
+		ctrl, msgRepo, bcRepo, _, evaluator := setupEvaluator(t)
+		defer ctrl.Finish()
+
+		b := newTestBroadcast(workspaceID, broadcastID)
+		b.TestSettings.SignificanceMethod = domain.TestSignificanceBayesian
+		bcRepo.EXPECT().GetBroadcast(ctx, workspaceID, broadcastID).Return(b, nil)
+
+		msgRepo.EXPECT().GetBroadcastVariationStats(ctx, workspaceID, broadcastID, "tplA").Return(&domain.MessageHistoryStatusSum{TotalDelivered: 100, TotalOpened: 30}, nil)
+		msgRepo.EXPECT().GetBroadcastVariationStats(ctx, workspaceID, broadcastID, "tplB").Return(&domain.MessageHistoryStatusSum{TotalDelivered: 100, TotalOpened: 32}, nil)
+		expectWinnerUpdate(t, ctx, bcRepo, workspaceID, "tplA")
+
+		winner, err := evaluator.EvaluateAndSelectWinner(ctx, workspaceID, broadcastID)
+		require.NoError(t, err)
+		assert.Equal(t, "tplA", winner)
+	})
+
+	t.Run("goal revenue metric", func(t *testing.T) {
+		ctrl, msgRepo, bcRepo, _, evaluator := setupEvaluator(t)
+		defer ctrl.Finish()
+
+		b := newTestBroadcast(workspaceID, broadcastID)
+		b.TestSettings.AutoSendWinnerMetric = domain.TestWinnerMetricGoalRevenue
+		bcRepo.EXPECT().GetBroadcast(ctx, workspaceID, broadcastID).Return(b, nil)
+
+		msgRepo.EXPECT().GetBroadcastVariationStats(ctx, workspaceID, broadcastID, "tplA").Return(&domain.MessageHistoryStatusSum{TotalDelivered: 100, TotalOpened: 50}, nil)
+		msgRepo.EXPECT().GetBroadcastVariationGoalRevenue(ctx, workspaceID, broadcastID, "tplA").Return(&domain.GoalRevenueSum{Conversions: 5, Revenue: 500}, nil)
+		msgRepo.EXPECT().GetBroadcastVariationStats(ctx, workspaceID, broadcastID, "tplB").Return(&domain.MessageHistoryStatusSum{TotalDelivered: 100, TotalOpened: 20}, nil)
+		msgRepo.EXPECT().GetBroadcastVariationGoalRevenue(ctx, workspaceID, broadcastID, "tplB").Return(&domain.GoalRevenueSum{Conversions: 2, Revenue: 100}, nil)
+		expectWinnerUpdate(t, ctx, bcRepo, workspaceID, "tplA")
+
+		winner, err := evaluator.EvaluateAndSelectWinner(ctx, workspaceID, broadcastID)
+		require.NoError(t, err)
+		assert.Equal(t, "tplA", winner)
+	})
+}
@@ -15,6 +15,7 @@ type Factory struct {
 	taskRepo           domain.TaskRepository
 	workspaceRepo      domain.WorkspaceRepository
 	emailQueueRepo     domain.EmailQueueRepository
+	translationRepo    domain.TranslationRepository
 	dataFeedFetcher    DataFeedFetcher
 	logger             logger.Logger
 	config             *Config
@@ -33,6 +34,7 @@ func NewFactory(
 	taskRepo domain.TaskRepository,
 	workspaceRepo domain.WorkspaceRepository,
 	emailQueueRepo domain.EmailQueueRepository,
+	translationRepo domain.TranslationRepository,
 	dataFeedFetcher DataFeedFetcher,
 	logger logger.Logger,
 	config *Config,
@@ -53,6 +55,7 @@ func NewFactory(
 		taskRepo:           taskRepo,
 		workspaceRepo:      workspaceRepo,
 		emailQueueRepo:     emailQueueRepo,
+		translationRepo:    translationRepo,
 		dataFeedFetcher:    dataFeedFetcher,
 		logger:             logger,
 		config:             config,
@@ -72,6 +75,7 @@ func (f *Factory) CreateMessageSender() MessageSender {
 			f.broadcastRepo,
 			f.messageHistoryRepo,
 			f.templateRepo,
+			f.translationRepo,
 			f.dataFeedFetcher,
 			f.logger,
 			f.config,
@@ -83,6 +87,7 @@ func (f *Factory) CreateMessageSender() MessageSender {
 		f.broadcastRepo,
 		f.messageHistoryRepo,
 		f.templateRepo,
+		f.translationRepo,
 		f.emailService,
 		f.dataFeedFetcher,
 		f.logger,
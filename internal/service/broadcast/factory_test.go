@@ -54,6 +54,7 @@ func TestNewFactory(t *testing.T) {
 				mockTaskRepo,
 				mockWorkspaceRepo,
 				mockEmailQueueRepo,
+				nil, // translationRepo
 				mockDataFeedFetcher,
 				mockLogger,
 				tt.config,
@@ -108,6 +109,7 @@ func TestFactory_CreateMessageSender(t *testing.T) {
 		mockTaskRepo,
 		mockWorkspaceRepo,
 		mockEmailQueueRepo,
+		nil, // translationRepo
 		mockDataFeedFetcher,
 		mockLogger,
 		config,
@@ -152,6 +154,7 @@ func TestFactory_CreateOrchestrator(t *testing.T) {
 		mockTaskRepo,
 		mockWorkspaceRepo,
 		mockEmailQueueRepo,
+		nil, // translationRepo
 		mockDataFeedFetcher,
 		mockLogger,
 		config,
@@ -201,6 +204,7 @@ func TestFactory_RegisterWithTaskService(t *testing.T) {
 		mockTaskRepo,
 		mockWorkspaceRepo,
 		mockEmailQueueRepo,
+		nil, // translationRepo
 		mockDataFeedFetcher,
 		mockLogger,
 		config,
@@ -25,7 +25,7 @@ type MessageSender interface {
 		template *domain.Template, data map[string]interface{}, emailProvider *domain.EmailProvider, timeoutAt time.Time, contactLanguage string, workspaceDefaultLanguage string) error
 
 	// SendBatch sends messages to a batch of recipients
-	SendBatch(ctx context.Context, workspaceID string, integrationID string, workspaceSecretKey string, endpoint string, websiteURL string, trackingEnabled bool, broadcastID string, recipients []*domain.ContactWithList,
+	SendBatch(ctx context.Context, workspaceID string, integrationID string, workspaceSecretKey string, templateSecrets domain.TemplateSecrets, endpoint string, websiteURL string, trackingEnabled bool, broadcastID string, recipients []*domain.ContactWithList,
 		templates map[string]*domain.Template, emailProvider *domain.EmailProvider, timeoutAt time.Time, workspaceDefaultLanguage string) (sent int, failed int, err error)
 }
 
@@ -106,6 +106,7 @@ type messageSender struct {
 	broadcastRepo      domain.BroadcastRepository
 	messageHistoryRepo domain.MessageHistoryRepository
 	templateRepo       domain.TemplateRepository
+	translationRepo    domain.TranslationRepository
 	emailService       domain.EmailServiceInterface
 	dataFeedFetcher    DataFeedFetcher
 	logger             logger.Logger
@@ -119,7 +120,7 @@ type messageSender struct {
 
 // NewMessageSender creates a new message sender
 func NewMessageSender(broadcastRepo domain.BroadcastRepository, messageHistoryRepo domain.MessageHistoryRepository, templateRepo domain.TemplateRepository,
-	emailService domain.EmailServiceInterface, dataFeedFetcher DataFeedFetcher, logger logger.Logger, config *Config, apiEndpoint string) MessageSender {
+	translationRepo domain.TranslationRepository, emailService domain.EmailServiceInterface, dataFeedFetcher DataFeedFetcher, logger logger.Logger, config *Config, apiEndpoint string) MessageSender {
 	if config == nil {
 		config = DefaultConfig()
 	}
@@ -139,6 +140,7 @@ func NewMessageSender(broadcastRepo domain.BroadcastRepository, messageHistoryRe
 		broadcastRepo:      broadcastRepo,
 		messageHistoryRepo: messageHistoryRepo,
 		templateRepo:       templateRepo,
+		translationRepo:    translationRepo,
 		emailService:       emailService,
 		dataFeedFetcher:    dataFeedFetcher,
 		logger:             logger,
@@ -150,6 +152,21 @@ func NewMessageSender(broadcastRepo domain.BroadcastRepository, messageHistoryRe
 	}
 }
 
+// loadTranslationCatalog loads, in every language, the catalog strings the templates of a
+// broadcast and the subject overrides of its variations translate
+func loadTranslationCatalog(ctx context.Context, translationRepo domain.TranslationRepository, workspaceID string,
+	workspaceDefaultLanguage string, broadcast *domain.Broadcast, templates map[string]*domain.Template) (*domain.TranslationCatalog, error) {
+	var keys []string
+	for templateID, template := range templates {
+		if template == nil {
+			continue
+		}
+		keys = append(keys, template.TranslationKeys()...)
+		keys = append(keys, domain.TranslationKeys(broadcast.GetSubjectForTemplate(templateID))...)
+	}
+	return domain.LoadTranslationCatalog(ctx, translationRepo, workspaceID, workspaceDefaultLanguage, nil, keys)
+}
+
 // enforceRateLimit applies rate limiting to message sending
 func (s *messageSender) enforceRateLimit(ctx context.Context, integrationRateLimit int) error {
 	// Use integration rate limit (required field, should always be > 0)
@@ -264,6 +281,7 @@ func (s *messageSender) SendToRecipient(ctx context.Context, workspaceID string,
 		VisualEditorTree: emailContent.VisualEditorTree,
 		TemplateData:     data,
 		TrackingSettings: trackingSettings,
+		Text:             emailContent.Text,
 	}
 	compileReq.MjmlSource = emailContent.GetCodeModeMjmlSource()
 	compiledTemplate, err := notifuse_mjml.CompileTemplate(compileReq)
@@ -306,9 +324,15 @@ func (s *messageSender) SendToRecipient(ctx context.Context, workspaceID string,
 		return NewBroadcastError(ErrCodeSenderNotFound, "sender not found", true, nil)
 	}
 
+	// The variation may override the subject of the template
+	subject := emailContent.Subject
+	if override := broadcast.GetSubjectForTemplate(template.ID); override != "" {
+		subject = override
+	}
+
 	// Process subject line through Liquid templating if it contains Liquid tags
 	processedSubject, err := notifuse_mjml.ProcessLiquidTemplate(
-		emailContent.Subject,
+		subject,
 		data,
 		"email_subject",
 	)
@@ -317,7 +341,7 @@ func (s *messageSender) SendToRecipient(ctx context.Context, workspaceID string,
 			"broadcast_id": broadcast.ID,
 			"workspace_id": workspaceID,
 			"recipient":    email,
-			"subject":      emailContent.Subject,
+			"subject":      subject,
 			"error":        err.Error(),
 		}).Error("Failed to process subject line with Liquid templating")
 		return NewBroadcastError(ErrCodeTemplateCompile, "failed to process subject with Liquid", true, err)
@@ -333,6 +357,7 @@ func (s *messageSender) SendToRecipient(ctx context.Context, workspaceID string,
 		To:            email,
 		Subject:       processedSubject,
 		Content:       *compiledTemplate.HTML,
+		Text:          compiledTemplate.GetText(),
 		Provider:      emailProvider,
 		EmailOptions: domain.EmailOptions{
 			ReplyTo: emailContent.ReplyTo,
@@ -380,7 +405,7 @@ func (s *messageSender) SendToRecipient(ctx context.Context, workspaceID string,
 }
 
 // SendBatch sends messages to a batch of recipients
-func (s *messageSender) SendBatch(ctx context.Context, workspaceID string, integrationID string, workspaceSecretKey string, endpoint string, websiteURL string, trackingEnabled bool, broadcastID string, recipients []*domain.ContactWithList,
+func (s *messageSender) SendBatch(ctx context.Context, workspaceID string, integrationID string, workspaceSecretKey string, templateSecrets domain.TemplateSecrets, endpoint string, websiteURL string, trackingEnabled bool, broadcastID string, recipients []*domain.ContactWithList,
 	templates map[string]*domain.Template, emailProvider *domain.EmailProvider, timeoutAt time.Time, workspaceDefaultLanguage string) (sent int, failed int, err error) {
 
 	// Track specific error types for better reporting
@@ -449,6 +474,17 @@ func (s *messageSender) SendBatch(ctx context.Context, workspaceID string, integ
 		broadcast.UTMParameters = &domain.UTMParameters{}
 	}
 
+	// Strings are loaded once per batch in every language, each recipient picking theirs
+	catalog, err := loadTranslationCatalog(ctx, s.translationRepo, workspaceID, workspaceDefaultLanguage, broadcast, templates)
+	if err != nil {
+		s.logger.WithFields(map[string]interface{}{
+			"broadcast_id": broadcastID,
+			"workspace_id": workspaceID,
+			"error":        err.Error(),
+		}).Error("Failed to load translations for sending")
+		return 0, 0, NewBroadcastError(ErrCodeSendFailed, "failed to load translations", true, err)
+	}
+
 	// Log rate limiting configuration for this broadcast
 	integrationRateLimit := emailProvider.RateLimitPerMinute
 	if integrationRateLimit <= 0 {
@@ -543,6 +579,7 @@ func (s *messageSender) SendBatch(ctx context.Context, workspaceID string, integ
 		req := domain.TemplateDataRequest{
 			WorkspaceID:         workspaceID,
 			WorkspaceSecretKey:  workspaceSecretKey,
+			TemplateSecrets:     templateSecrets,
 			WorkspaceWebsiteURL: websiteURL,
 			ContactWithList:     *contactWithList,
 			MessageID:           messageID,
@@ -609,6 +646,7 @@ func (s *messageSender) SendBatch(ctx context.Context, workspaceID string, integ
 		if contact.Language != nil && !contact.Language.IsNull {
 			contactLanguage = contact.Language.String
 		}
+		catalog.Apply(recipientData, contactLanguage)
 
 		// Send to the recipient
 		err = s.SendToRecipient(ctx, workspaceID, integrationID, endpoint, trackingEnabled, broadcast, messageID, contact.Email, templates[templateID], recipientData, emailProvider, timeoutAt, contactLanguage, workspaceDefaultLanguage)
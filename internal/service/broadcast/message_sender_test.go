@@ -35,6 +35,7 @@ func TestMessageSenderCreation(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil, // dataFeedFetcher
 		mockLogger,
@@ -60,6 +61,7 @@ func TestMessageSenderCreation(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil, // dataFeedFetcher
 		mockLogger,
@@ -166,6 +168,7 @@ func TestSendToRecipientSuccess(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil, // dataFeedFetcher
 		mockLogger,
@@ -240,6 +243,7 @@ func TestSendToRecipientCompileFailure(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil, // dataFeedFetcher
 		mockLogger,
@@ -317,11 +321,11 @@ func TestWithMockMessageSender(t *testing.T) {
 	// Set up expectations with specific return values
 	timeoutAt = time.Now().Add(30 * time.Second)
 	mockSender.EXPECT().
-		SendBatch(ctx, workspaceID, "test-integration-id", workspaceSecretKey, "https://api.example.com", "", trackingEnabled, broadcast.ID, mockContacts, mockTemplates, nil, timeoutAt, "").
+		SendBatch(ctx, workspaceID, "test-integration-id", workspaceSecretKey, gomock.Any(), "https://api.example.com", "", trackingEnabled, broadcast.ID, mockContacts, mockTemplates, nil, timeoutAt, "").
 		Return(1, 0, nil)
 
 	// Use the mock
-	sent, failed, err := mockSender.SendBatch(ctx, workspaceID, "test-integration-id", workspaceSecretKey, "https://api.example.com", "", trackingEnabled, broadcast.ID, mockContacts, mockTemplates, nil, timeoutAt, "")
+	sent, failed, err := mockSender.SendBatch(ctx, workspaceID, "test-integration-id", workspaceSecretKey, nil, "https://api.example.com", "", trackingEnabled, broadcast.ID, mockContacts, mockTemplates, nil, timeoutAt, "")
 
 	// Verify results
 	assert.NoError(t, err)
@@ -392,10 +396,10 @@ func TestErrorHandlingWithMock(t *testing.T) {
 	batchError := errors.New("batch processing failed")
 
 	mockSender.EXPECT().
-		SendBatch(ctx, workspaceID, "test-integration-id", workspaceSecretKey, "https://api.example.com", "", trackingEnabled, broadcast.ID, mockContacts, mockTemplates, nil, timeoutAt, "").
+		SendBatch(ctx, workspaceID, "test-integration-id", workspaceSecretKey, gomock.Any(), "https://api.example.com", "", trackingEnabled, broadcast.ID, mockContacts, mockTemplates, nil, timeoutAt, "").
 		Return(0, 0, batchError)
 
-	sent, failed, err := mockSender.SendBatch(ctx, workspaceID, "test-integration-id", workspaceSecretKey, "https://api.example.com", "", trackingEnabled, broadcast.ID, mockContacts, mockTemplates, nil, timeoutAt, "")
+	sent, failed, err := mockSender.SendBatch(ctx, workspaceID, "test-integration-id", workspaceSecretKey, nil, "https://api.example.com", "", trackingEnabled, broadcast.ID, mockContacts, mockTemplates, nil, timeoutAt, "")
 	assert.Error(t, err)
 	assert.Equal(t, batchError, err)
 	assert.Equal(t, 0, sent)
@@ -497,6 +501,7 @@ func TestSendBatch(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil, // dataFeedFetcher
 		mockLogger,
@@ -522,7 +527,7 @@ func TestSendBatch(t *testing.T) {
 		},
 	}
 	templates := map[string]*domain.Template{"template-123": template}
-	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
+	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", nil, "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
 	assert.NoError(t, err)
 	assert.Equal(t, 2, sent)
 	assert.Equal(t, 0, failed)
@@ -564,6 +569,7 @@ func TestSendBatch_EmptyRecipients(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil, // dataFeedFetcher
 		mockLogger,
@@ -572,7 +578,7 @@ func TestSendBatch_EmptyRecipients(t *testing.T) {
 	)
 
 	// Call the method being tested with empty recipients
-	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", workspaceSecretKey, "https://api.example.com", "", trackingEnabled, broadcastID, []*domain.ContactWithList{},
+	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", workspaceSecretKey, nil, "https://api.example.com", "", trackingEnabled, broadcastID, []*domain.ContactWithList{},
 		map[string]*domain.Template{}, emailProvider, timeoutAt, "")
 
 	// Verify results
@@ -628,6 +634,7 @@ func TestSendBatch_CircuitBreakerOpen(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil, // dataFeedFetcher
 		mockLogger,
@@ -640,7 +647,7 @@ func TestSendBatch_CircuitBreakerOpen(t *testing.T) {
 	messageSenderImpl.circuitBreaker.RecordFailure(fmt.Errorf("test error"))
 
 	// Call the method being tested
-	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", workspaceSecretKey, "https://api.example.com", "", trackingEnabled, broadcastID, recipients,
+	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", workspaceSecretKey, nil, "https://api.example.com", "", trackingEnabled, broadcastID, recipients,
 		map[string]*domain.Template{}, emailProvider, timeoutAt, "")
 
 	// Verify results
@@ -769,6 +776,7 @@ func TestSendBatch_WithFailure(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil, // dataFeedFetcher
 		mockLogger,
@@ -787,7 +795,7 @@ func TestSendBatch_WithFailure(t *testing.T) {
 		},
 	}
 	templates := map[string]*domain.Template{"template-123": template}
-	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
+	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", nil, "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
 	assert.NoError(t, err)
 	assert.Equal(t, 0, sent)
 	assert.Equal(t, 1, failed)
@@ -888,6 +896,7 @@ func TestSendBatch_RecordMessageFails(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil, // dataFeedFetcher
 		mockLogger,
@@ -906,7 +915,7 @@ func TestSendBatch_RecordMessageFails(t *testing.T) {
 		},
 	}
 	templates := map[string]*domain.Template{"template-123": template}
-	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
+	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", nil, "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
 	assert.NoError(t, err)
 	assert.Equal(t, 1, sent)
 	assert.Equal(t, 0, failed)
@@ -938,6 +947,7 @@ func TestSendToRecipientWithLiquidSubject(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil, // dataFeedFetcher
 		mockLogger,
@@ -1038,6 +1048,7 @@ func TestEnforceRateLimit(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -1067,6 +1078,7 @@ func TestEnforceRateLimit(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -1101,6 +1113,7 @@ func TestEnforceRateLimit(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -1154,6 +1167,7 @@ func TestSendToRecipient_ErrorCases(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -1214,6 +1228,7 @@ func TestSendToRecipient_ErrorCases(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -1276,6 +1291,7 @@ func TestSendToRecipient_ErrorCases(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -1360,6 +1376,7 @@ func TestSendToRecipient_ErrorCases(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -1498,6 +1515,7 @@ func TestSendToRecipient_LanguageResolution(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil,
 		mockLogger,
@@ -1585,6 +1603,7 @@ func TestSendBatch_AdvancedScenarios(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -1601,7 +1620,7 @@ func TestSendBatch_AdvancedScenarios(t *testing.T) {
 			GetBroadcast(ctx, workspaceID, broadcastID).
 			Return(nil, nil)
 
-		sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", "https://api.example.com", "", true, broadcastID, recipients, map[string]*domain.Template{}, nil, timeoutAt, "")
+		sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", nil, "https://api.example.com", "", true, broadcastID, recipients, map[string]*domain.Template{}, nil, timeoutAt, "")
 
 		assert.Error(t, err)
 		assert.Equal(t, 0, sent)
@@ -1616,6 +1635,7 @@ func TestSendBatch_AdvancedScenarios(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -1668,7 +1688,7 @@ func TestSendBatch_AdvancedScenarios(t *testing.T) {
 		// Use a timeout that's already passed
 		pastTimeout := time.Now().Add(-1 * time.Second)
 
-		sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", "https://api.example.com", "", true, broadcastID, recipients, templates, emailProvider, pastTimeout, "")
+		sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", nil, "https://api.example.com", "", true, broadcastID, recipients, templates, emailProvider, pastTimeout, "")
 
 		// Should return immediately without processing any recipients
 		assert.NoError(t, err)
@@ -1681,6 +1701,7 @@ func TestSendBatch_AdvancedScenarios(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -1759,7 +1780,7 @@ func TestSendBatch_AdvancedScenarios(t *testing.T) {
 			}).
 			Return(nil).Times(2)
 
-		sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", "https://api.example.com", "", true, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
+		sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", nil, "https://api.example.com", "", true, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
 
 		assert.NoError(t, err)
 		assert.Equal(t, 2, sent)
@@ -1771,6 +1792,7 @@ func TestSendBatch_AdvancedScenarios(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -1840,7 +1862,7 @@ func TestSendBatch_AdvancedScenarios(t *testing.T) {
 			}).
 			Return(nil)
 
-		sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", "https://api.example.com", "", true, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
+		sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", nil, "https://api.example.com", "", true, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
 
 		assert.NoError(t, err)
 		assert.Equal(t, 1, sent)
@@ -1856,6 +1878,7 @@ func TestSendBatch_AdvancedScenarios(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -1924,7 +1947,7 @@ func TestSendBatch_AdvancedScenarios(t *testing.T) {
 			}).
 			Return(nil).Times(3)
 
-		sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", "https://api.example.com", "", true, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
+		sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", nil, "https://api.example.com", "", true, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
 
 		assert.NoError(t, err) // SendBatch itself doesn't return error, just counts
 		assert.Equal(t, 0, sent)
@@ -1957,6 +1980,7 @@ func TestNewMessageSender_EdgeCases(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -1982,6 +2006,7 @@ func TestNewMessageSender_EdgeCases(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -2088,6 +2113,7 @@ func TestSendBatch_TemplateDataBuildFailure(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil, // dataFeedFetcher
 		mockLogger,
@@ -2154,7 +2180,7 @@ func TestSendBatch_TemplateDataBuildFailure(t *testing.T) {
 		}).
 		Return(nil).AnyTimes()
 
-	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", "https://api.example.com", "", true, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
+	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", nil, "https://api.example.com", "", true, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
 
 	// Should handle the case gracefully
 	assert.NoError(t, err)
@@ -2190,6 +2216,7 @@ func TestSendBatch_EmptyEmailContact(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil, // dataFeedFetcher
 		mockLogger,
@@ -2258,7 +2285,7 @@ func TestSendBatch_EmptyEmailContact(t *testing.T) {
 		}).
 		Return(nil).Times(1)
 
-	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", "https://api.example.com", "", true, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
+	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", nil, "https://api.example.com", "", true, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
 
 	assert.NoError(t, err)
 	assert.Equal(t, 1, sent)
@@ -2293,6 +2320,7 @@ func TestSendBatch_NoVariations(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil, // dataFeedFetcher
 		mockLogger,
@@ -2332,7 +2360,7 @@ func TestSendBatch_NoVariations(t *testing.T) {
 		GetBroadcast(ctx, workspaceID, broadcastID).
 		Return(broadcast, nil)
 
-	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", "https://api.example.com", "", true, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
+	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key", nil, "https://api.example.com", "", true, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
 
 	assert.NoError(t, err)
 	assert.Equal(t, 0, sent)
@@ -2367,6 +2395,7 @@ func TestSendToRecipient_CompilationFailsWithNilHTML(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil, // dataFeedFetcher
 		mockLogger,
@@ -2436,6 +2465,7 @@ func TestSendToRecipient_CircuitBreakerSuccessRecording(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		nil, // dataFeedFetcher
 		mockLogger,
@@ -2520,6 +2550,7 @@ func TestPerBroadcastRateLimit(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -2555,6 +2586,7 @@ func TestPerBroadcastRateLimit(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -2590,6 +2622,7 @@ func TestPerBroadcastRateLimit(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -2621,6 +2654,7 @@ func TestPerBroadcastRateLimit(t *testing.T) {
 			mockBroadcastRepository,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockEmailService,
 			nil, // dataFeedFetcher
 			mockLogger,
@@ -2785,6 +2819,7 @@ func TestSendBatch_WithRecipientFeed_Success(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		mockDataFeedFetcher,
 		mockLogger,
@@ -2801,7 +2836,7 @@ func TestSendBatch_WithRecipientFeed_Success(t *testing.T) {
 	}
 	templates := map[string]*domain.Template{"template-123": template}
 
-	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
+	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", nil, "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
 	assert.NoError(t, err)
 	assert.Equal(t, 1, sent)
 	assert.Equal(t, 0, failed)
@@ -2899,6 +2934,7 @@ func TestSendBatch_WithRecipientFeed_PauseOnFailure(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		mockDataFeedFetcher,
 		mockLogger,
@@ -2915,7 +2951,7 @@ func TestSendBatch_WithRecipientFeed_PauseOnFailure(t *testing.T) {
 	}
 	templates := map[string]*domain.Template{"template-123": template}
 
-	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
+	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", nil, "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
 	// Broadcast should pause on first feed failure
 	assert.Error(t, err)
 	assert.True(t, errors.Is(err, ErrBroadcastShouldPause), "Expected ErrBroadcastShouldPause")
@@ -3047,6 +3083,7 @@ func TestSendBatch_WithBothFeeds(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		mockDataFeedFetcher,
 		mockLogger,
@@ -3063,7 +3100,7 @@ func TestSendBatch_WithBothFeeds(t *testing.T) {
 	}
 	templates := map[string]*domain.Template{"template-123": template}
 
-	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
+	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", nil, "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
 	assert.NoError(t, err)
 	assert.Equal(t, 1, sent)
 	assert.Equal(t, 0, failed)
@@ -3163,6 +3200,7 @@ func TestSendBatch_WithRecipientFeed_Disabled(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		mockDataFeedFetcher,
 		mockLogger,
@@ -3179,7 +3217,7 @@ func TestSendBatch_WithRecipientFeed_Disabled(t *testing.T) {
 	}
 	templates := map[string]*domain.Template{"template-123": template}
 
-	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
+	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", nil, "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
 	assert.NoError(t, err)
 	assert.Equal(t, 1, sent)
 	assert.Equal(t, 0, failed)
@@ -3274,6 +3312,7 @@ func TestSendBatch_WithRecipientFeed_NilSettings(t *testing.T) {
 		mockBroadcastRepository,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockEmailService,
 		mockDataFeedFetcher,
 		mockLogger,
@@ -3290,7 +3329,7 @@ func TestSendBatch_WithRecipientFeed_NilSettings(t *testing.T) {
 	}
 	templates := map[string]*domain.Template{"template-123": template}
 
-	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
+	sent, failed, err := sender.SendBatch(ctx, workspaceID, "test-integration-id", "secret-key-123", nil, "https://api.example.com", "", tracking, broadcastID, recipients, templates, emailProvider, timeoutAt, "")
 	assert.NoError(t, err)
 	assert.Equal(t, 1, sent)
 	assert.Equal(t, 0, failed)
@@ -81,18 +81,18 @@ func (mr *MockBroadcastOrchestratorInterfaceMockRecorder) GetTotalRecipientCount
 }
 
 // LoadTemplates mocks base method.
-func (m *MockBroadcastOrchestratorInterface) LoadTemplates(arg0 context.Context, arg1 string, arg2 []string) (map[string]*domain.Template, error) {
+func (m *MockBroadcastOrchestratorInterface) LoadTemplates(arg0 context.Context, arg1 string, arg2 []string, arg3 map[string]int64) (map[string]*domain.Template, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LoadTemplates", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "LoadTemplates", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(map[string]*domain.Template)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // LoadTemplates indicates an expected call of LoadTemplates.
-func (mr *MockBroadcastOrchestratorInterfaceMockRecorder) LoadTemplates(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockBroadcastOrchestratorInterfaceMockRecorder) LoadTemplates(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadTemplates", reflect.TypeOf((*MockBroadcastOrchestratorInterface)(nil).LoadTemplates), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadTemplates", reflect.TypeOf((*MockBroadcastOrchestratorInterface)(nil).LoadTemplates), arg0, arg1, arg2, arg3)
 }
 
 // Process mocks base method.
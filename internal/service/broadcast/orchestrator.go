@@ -20,8 +20,8 @@ type BroadcastOrchestratorInterface interface {
 	// Process executes or continues a broadcast sending task
 	Process(ctx context.Context, task *domain.Task, timeoutAt time.Time) (bool, error)
 
-	// LoadTemplates loads all templates for a broadcast's variations
-	LoadTemplates(ctx context.Context, workspaceID string, templateIDs []string) (map[string]*domain.Template, error)
+	// LoadTemplates loads all templates for a broadcast's variations, at the given versions when set
+	LoadTemplates(ctx context.Context, workspaceID string, templateIDs []string, versions map[string]int64) (map[string]*domain.Template, error)
 
 	// ValidateTemplates validates that the required templates are loaded and valid
 	ValidateTemplates(templates map[string]*domain.Template) error
@@ -144,13 +144,20 @@ func (o *BroadcastOrchestrator) deleteQueueEntries(ctx context.Context, workspac
 	}
 }
 
-// LoadTemplates loads all templates for a broadcast's variations
-func (o *BroadcastOrchestrator) LoadTemplates(ctx context.Context, workspaceID string, templateIDs []string) (map[string]*domain.Template, error) {
+// LoadTemplates loads all templates for a broadcast's variations. Templates without a version
+// in versions are loaded at their published version.
+func (o *BroadcastOrchestrator) LoadTemplates(ctx context.Context, workspaceID string, templateIDs []string, versions map[string]int64) (map[string]*domain.Template, error) {
 
 	// Load all templates
 	templates := make(map[string]*domain.Template)
 	for _, templateID := range templateIDs {
-		template, err := o.templateRepo.GetTemplateByID(ctx, workspaceID, templateID, 0) // Always use version 0
+		var template *domain.Template
+		var err error
+		if version := versions[templateID]; version > 0 {
+			template, err = o.templateRepo.GetTemplateByID(ctx, workspaceID, templateID, version)
+		} else {
+			template, err = o.templateRepo.GetPublishedTemplate(ctx, workspaceID, templateID) // Drafts are never sent
+		}
 		if err != nil {
 			// codecov:ignore:start
 			o.logger.WithFields(map[string]interface{}{
@@ -250,7 +257,8 @@ func (o *BroadcastOrchestrator) GetTotalRecipientCount(ctx context.Context, work
 	}
 
 	// Use the contact repository to count recipients
-	count, err := o.contactRepo.CountContactsForBroadcast(ctx, workspaceID, broadcast.Audience)
+	audience := broadcast.RecipientAudience()
+	count, err := o.contactRepo.CountContactsForBroadcast(ctx, workspaceID, audience)
 	if err != nil {
 		// codecov:ignore:start
 		o.logger.WithFields(map[string]interface{}{
@@ -262,6 +270,30 @@ func (o *BroadcastOrchestrator) GetTotalRecipientCount(ctx context.Context, work
 		return 0, NewBroadcastError(ErrCodeRecipientFetch, "failed to count recipients", true, err)
 	}
 
+	// Record the held out contacts along with the count, so that both groups are taken
+	// from the audience as it is when the broadcast starts
+	if audience.Holdout.IsEnabled() {
+		heldOut, holdoutErr := o.contactRepo.SaveBroadcastHoldout(ctx, workspaceID, broadcastID, audience)
+		if holdoutErr != nil {
+			// codecov:ignore:start
+			o.logger.WithFields(map[string]interface{}{
+				"broadcast_id": broadcastID,
+				"workspace_id": workspaceID,
+				"error":        holdoutErr.Error(),
+			}).Error("Failed to save broadcast holdout")
+			// codecov:ignore:end
+			return 0, NewBroadcastError(ErrCodeRecipientFetch, "failed to save holdout", true, holdoutErr)
+		}
+
+		// codecov:ignore:start
+		o.logger.WithFields(map[string]interface{}{
+			"broadcast_id": broadcastID,
+			"workspace_id": workspaceID,
+			"held_out":     heldOut,
+		}).Info("Saved broadcast holdout")
+		// codecov:ignore:end
+	}
+
 	// codecov:ignore:start
 	o.logger.WithFields(map[string]interface{}{
 		"broadcast_id":      broadcastID,
@@ -315,7 +347,7 @@ func (o *BroadcastOrchestrator) FetchBatch(ctx context.Context, workspaceID, bro
 	}
 
 	// Fetch contacts based on broadcast audience using cursor-based pagination
-	contactsWithList, err := o.contactRepo.GetContactsForBroadcast(ctx, workspaceID, broadcast.Audience, limit, afterEmail)
+	contactsWithList, err := o.contactRepo.GetContactsForBroadcast(ctx, workspaceID, broadcast.RecipientAudience(), limit, afterEmail)
 	if err != nil {
 		// codecov:ignore:start
 		o.logger.WithFields(map[string]interface{}{
@@ -803,9 +835,15 @@ func (o *BroadcastOrchestrator) Process(ctx context.Context, task *domain.Task,
 		}
 	}
 
-	// Phase 2: Load templates
+	// Phase 2: Load templates, an approved broadcast sends the versions that were reviewed
+	templateVersions := make(map[string]int64, len(templateIDs))
+	for _, templateID := range templateIDs {
+		if version := broadcast.Approval.TemplateVersion(templateID); version > 0 {
+			templateVersions[templateID] = version
+		}
+	}
 
-	templates, templatesErr := o.LoadTemplates(ctx, task.WorkspaceID, templateIDs)
+	templates, templatesErr := o.LoadTemplates(ctx, task.WorkspaceID, templateIDs, templateVersions)
 	if templatesErr != nil {
 		// codecov:ignore:start
 		o.logger.WithFields(map[string]interface{}{
@@ -901,6 +939,19 @@ func (o *BroadcastOrchestrator) Process(ctx context.Context, task *domain.Task,
 				break
 			}
 
+			// An edit sent the broadcast back for review, nothing is sent until it is scheduled again
+			if broadcast.Status == domain.BroadcastStatusDraft ||
+				broadcast.Status == domain.BroadcastStatusPendingApproval ||
+				broadcast.Status == domain.BroadcastStatusApproved {
+				o.logger.WithFields(map[string]interface{}{
+					"broadcast_id": broadcast.ID,
+					"task_id":      task.ID,
+					"status":       string(broadcast.Status),
+				}).Info("Broadcast returned to review during processing - stopping task")
+				allDone = false
+				break
+			}
+
 			// If currently in test phase and a winner was selected meanwhile, transition to winner phase
 			if broadcastState.Phase == "test" && (broadcast.WinningTemplate != nil || broadcast.Status == domain.BroadcastStatusWinnerSelected) {
 				broadcastState.Phase = "winner"
@@ -1070,6 +1121,7 @@ func (o *BroadcastOrchestrator) Process(ctx context.Context, task *domain.Task,
 			task.WorkspaceID,
 			integrationID,
 			workspace.Settings.SecretKey,
+			workspace.Settings.TemplateSecrets,
 			endpoint,
 			workspace.Settings.WebsiteURL,
 			workspace.Settings.EmailTrackingEnabled,
@@ -159,7 +159,7 @@ func TestBroadcastOrchestrator_Process_CancelledBroadcast(t *testing.T) {
 		},
 	}
 	mockTemplateRepo.EXPECT().
-		GetTemplateByID(gomock.Any(), workspaceID, "template-1", int64(0)).
+		GetPublishedTemplate(gomock.Any(), workspaceID, "template-1").
 		Return(mockTemplate, nil).
 		AnyTimes()
 
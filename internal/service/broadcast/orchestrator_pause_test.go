@@ -158,7 +158,7 @@ func TestBroadcastOrchestrator_Process_PausedBroadcast(t *testing.T) {
 		},
 	}
 	mockTemplateRepo.EXPECT().
-		GetTemplateByID(gomock.Any(), workspaceID, "template-1", int64(0)).
+		GetPublishedTemplate(gomock.Any(), workspaceID, "template-1").
 		Return(mockTemplate, nil).
 		AnyTimes()
 
@@ -340,7 +340,7 @@ func TestBroadcastOrchestrator_Process_PausedDuringProcessing(t *testing.T) {
 		},
 	}
 	mockTemplateRepo.EXPECT().
-		GetTemplateByID(gomock.Any(), workspaceID, "template-1", int64(0)).
+		GetPublishedTemplate(gomock.Any(), workspaceID, "template-1").
 		Return(mockTemplate, nil).
 		AnyTimes()
 
@@ -356,7 +356,7 @@ func TestBroadcastOrchestrator_Process_PausedDuringProcessing(t *testing.T) {
 
 	// Mock message sender - may or may not be called before pause is detected
 	mockMessageSender.EXPECT().
-		SendBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		SendBatch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(len(mockContacts), 0, nil).
 		MaxTimes(1)
 
@@ -386,6 +386,13 @@ func TestBroadcastOrchestrator_Process_PausedVsCancelled(t *testing.T) {
 			expectedError:   false,
 			testDescription: "Paused broadcasts should return allDone=false so they can be resumed",
 		},
+		{
+			name:            "broadcast_back_in_review_returns_false",
+			broadcastStatus: domain.BroadcastStatusDraft,
+			expectedAllDone: false,
+			expectedError:   false,
+			testDescription: "Broadcasts sent back for review by an edit should stop without sending until scheduled again",
+		},
 		{
 			name:            "cancelled_broadcast_returns_true",
 			broadcastStatus: domain.BroadcastStatusCancelled,
@@ -528,7 +535,7 @@ func TestBroadcastOrchestrator_Process_PausedVsCancelled(t *testing.T) {
 				},
 			}
 			mockTemplateRepo.EXPECT().
-				GetTemplateByID(gomock.Any(), workspaceID, "template-1", int64(0)).
+				GetPublishedTemplate(gomock.Any(), workspaceID, "template-1").
 				Return(mockTemplate, nil).
 				AnyTimes()
 
@@ -150,15 +150,16 @@ func TestBroadcastOrchestrator_LoadTemplates(t *testing.T) {
 
 	// Setup expectations
 	mockTemplateRepo.EXPECT().
-		GetTemplateByID(ctx, workspaceID, "template-1", int64(0)).
+		GetPublishedTemplate(ctx, workspaceID, "template-1").
 		Return(template1, nil)
 
+	// The approved version is loaded instead of the published one
 	mockTemplateRepo.EXPECT().
-		GetTemplateByID(ctx, workspaceID, "template-2", int64(0)).
+		GetTemplateByID(ctx, workspaceID, "template-2", int64(2)).
 		Return(template2, nil)
 
 	// Execute
-	templates, err := orchestrator.LoadTemplates(ctx, workspaceID, templateIDs)
+	templates, err := orchestrator.LoadTemplates(ctx, workspaceID, templateIDs, map[string]int64{"template-2": 2})
 
 	// Verify
 	require.NoError(t, err)
@@ -334,6 +335,71 @@ func TestBroadcastOrchestrator_GetTotalRecipientCount(t *testing.T) {
 	assert.Equal(t, 100, count)
 }
 
+func TestBroadcastOrchestrator_GetTotalRecipientCount_Holdout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBroadcastRepository := domainmocks.NewMockBroadcastRepository(ctrl)
+	mockContactRepo := domainmocks.NewMockContactRepository(ctrl)
+	mockLogger := pkgmocks.NewMockLogger(ctrl)
+
+	mockLogger.EXPECT().WithFields(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any()).AnyTimes()
+
+	orchestrator := broadcast.NewBroadcastOrchestrator(
+		mocks.NewMockMessageSender(ctrl),
+		mockBroadcastRepository,
+		domainmocks.NewMockTemplateRepository(ctrl),
+		mockContactRepo,
+		domainmocks.NewMockTaskRepository(ctrl),
+		domainmocks.NewMockWorkspaceRepository(ctrl),
+		nil,
+		nil,
+		mockLogger,
+		nil,
+		mocks.NewMockTimeProvider(ctrl),
+		"https://api.example.com",
+		domainmocks.NewMockEventBus(ctrl),
+	)
+
+	ctx := context.Background()
+	testBroadcast := &domain.Broadcast{
+		ID: "broadcast-123",
+		Audience: domain.AudienceSettings{
+			List:    "list-1",
+			Holdout: &domain.HoldoutSettings{Enabled: true, Percentage: 10},
+		},
+	}
+
+	// The holdout of the recipient audience is seeded by the broadcast id
+	seeded := testBroadcast.RecipientAudience()
+	assert.Equal(t, "broadcast-123", seeded.Holdout.Seed)
+	assert.Empty(t, testBroadcast.Audience.Holdout.Seed)
+
+	mockBroadcastRepository.EXPECT().GetBroadcast(ctx, "workspace-123", "broadcast-123").Return(testBroadcast, nil).Times(2)
+	mockContactRepo.EXPECT().CountContactsForBroadcast(ctx, "workspace-123", seeded).Return(90, nil).Times(2)
+
+	t.Run("saves the holdout with the count", func(t *testing.T) {
+		mockContactRepo.EXPECT().SaveBroadcastHoldout(ctx, "workspace-123", "broadcast-123", seeded).Return(10, nil)
+
+		count, err := orchestrator.GetTotalRecipientCount(ctx, "workspace-123", "broadcast-123")
+
+		require.NoError(t, err)
+		assert.Equal(t, 90, count)
+	})
+
+	t.Run("fails when the holdout cannot be saved", func(t *testing.T) {
+		mockContactRepo.EXPECT().SaveBroadcastHoldout(ctx, "workspace-123", "broadcast-123", seeded).Return(0, errors.New("db down"))
+
+		_, err := orchestrator.GetTotalRecipientCount(ctx, "workspace-123", "broadcast-123")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to save holdout")
+	})
+}
+
 func TestBroadcastOrchestrator_FetchBatch(t *testing.T) {
 	// Setup
 	ctrl := gomock.NewController(t)
@@ -735,7 +801,7 @@ func TestBroadcastOrchestrator_Process(t *testing.T) {
 						},
 					},
 				}
-				mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "workspace-123", "template-1", int64(0)).Return(template, nil)
+				mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "workspace-123", "template-1").Return(template, nil)
 
 				// Mock recipients - return fewer than batch size to indicate completion
 				recipients := []*domain.ContactWithList{
@@ -753,6 +819,7 @@ func TestBroadcastOrchestrator_Process(t *testing.T) {
 					"secret-key",
 					gomock.Any(),
 					gomock.Any(),
+					gomock.Any(),
 					true,
 					"broadcast-123",
 					recipients,
@@ -869,7 +936,7 @@ func TestBroadcastOrchestrator_Process(t *testing.T) {
 						},
 					},
 				}
-				mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "workspace-123", "template-1", int64(0)).Return(template, nil)
+				mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "workspace-123", "template-1").Return(template, nil)
 
 				// Mock recipients - return fewer than batch size to indicate completion
 				recipients := []*domain.ContactWithList{
@@ -885,6 +952,7 @@ func TestBroadcastOrchestrator_Process(t *testing.T) {
 					"marketing-provider-id", "secret-key",
 					gomock.Any(),
 					gomock.Any(),
+					gomock.Any(),
 					true,
 					"broadcast-123",
 					recipients,
@@ -1096,7 +1164,7 @@ func TestBroadcastOrchestrator_Process(t *testing.T) {
 				mockBroadcastRepo.EXPECT().GetBroadcast(gomock.Any(), "workspace-123", "broadcast-123").Return(broadcast, nil)
 
 				// Template loading failure
-				mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "workspace-123", "template-1", int64(0)).Return(nil, fmt.Errorf("template not found"))
+				mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "workspace-123", "template-1").Return(nil, fmt.Errorf("template not found"))
 
 				return mockMessageSender, mockBroadcastRepo, mockTemplateRepo, mockContactRepo, mockTaskRepo, mockWorkspaceRepo, mockLogger, mockTimeProvider
 			},
@@ -1197,7 +1265,7 @@ func TestBroadcastOrchestrator_Process(t *testing.T) {
 						},
 					},
 				}
-				mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "workspace-123", "template-1", int64(0)).Return(template, nil)
+				mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "workspace-123", "template-1").Return(template, nil)
 
 				// Recipient fetch failure - expect batch size of 2 because remainingInPhase (2) < FetchBatchSize (50)
 				mockContactRepo.EXPECT().GetContactsForBroadcast(gomock.Any(), "workspace-123", broadcast.Audience, 2, "").Return(nil, fmt.Errorf("database error"))
@@ -1312,7 +1380,7 @@ func TestBroadcastOrchestrator_Process(t *testing.T) {
 						},
 					},
 				}
-				mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "workspace-123", "template-1", int64(0)).Return(template, nil)
+				mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "workspace-123", "template-1").Return(template, nil)
 
 				// Mock recipients - return fewer than batch size to indicate completion
 				recipients := []*domain.ContactWithList{
@@ -1328,6 +1396,7 @@ func TestBroadcastOrchestrator_Process(t *testing.T) {
 					"marketing-provider-id", "secret-key",
 					gomock.Any(),
 					gomock.Any(),
+					gomock.Any(),
 					true,
 					"broadcast-456",
 					recipients,
@@ -1483,14 +1552,14 @@ func TestBroadcastOrchestrator_Process_ABTestStartSetsTestingAndCompletesTestPha
 
 	// Template
 	tpl := &domain.Template{ID: "template-1", Email: &domain.EmailTemplate{Subject: "S", SenderID: "s", VisualEditorTree: &notifuse_mjml.MJMLBlock{BaseBlock: notifuse_mjml.NewBaseBlock("root", notifuse_mjml.MJMLComponentMjml)}}}
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "workspace-123", "template-1", int64(0)).Return(tpl, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "workspace-123", "template-1").Return(tpl, nil)
 
 	// Contacts - since sample 100% and totalRecipients preset below = 1, expect limit 1
 	recipients := []*domain.ContactWithList{{Contact: &domain.Contact{Email: "a@b.com"}, ListID: "list-1"}}
 	mockContactRepo.EXPECT().GetContactsForBroadcast(gomock.Any(), "workspace-123", bcast.Audience, 1, "").Return(recipients, nil)
 
 	// Send batch
-	mockMessageSender.EXPECT().SendBatch(gomock.Any(), "workspace-123", "marketing-provider-id", "secret-key", gomock.Any(), gomock.Any(), true, "broadcast-123", recipients, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(1, 0, nil)
+	mockMessageSender.EXPECT().SendBatch(gomock.Any(), "workspace-123", "marketing-provider-id", "secret-key", gomock.Any(), gomock.Any(), gomock.Any(), true, "broadcast-123", recipients, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(1, 0, nil)
 
 	// Save state
 	mockTaskRepo.EXPECT().SaveState(gomock.Any(), "workspace-123", "task-123", gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -1608,7 +1677,7 @@ func TestBroadcastOrchestrator_Process_ValidateTemplatesFailure(t *testing.T) {
 
 	// Load template that will fail validation (missing subject)
 	badTpl := &domain.Template{ID: "tpl1", Email: &domain.EmailTemplate{SenderID: "s", VisualEditorTree: &notifuse_mjml.MJMLBlock{BaseBlock: notifuse_mjml.NewBaseBlock("root", notifuse_mjml.MJMLComponentMjml)}}}
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "workspace-123", "tpl1", int64(0)).Return(badTpl, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "workspace-123", "tpl1").Return(badTpl, nil)
 
 	config := &broadcast.Config{FetchBatchSize: 50, MaxProcessTime: 30 * time.Second}
 	orchestrator := broadcast.NewBroadcastOrchestrator(mockMessageSender, mockBroadcastRepo, mockTemplateRepo, mockContactRepo, mockTaskRepo, mockWorkspaceRepo, nil, nil, mockLogger, config, mockTimeProvider, "https://api.example.com", mockEventBus)
@@ -1652,7 +1721,7 @@ func TestBroadcastOrchestrator_Process_BatchSizeZeroTriggersPhaseCompletion(t *t
 	mockBroadcastRepo.EXPECT().UpdateBroadcast(gomock.Any(), gomock.Any()).Return(nil).Times(2)
 
 	tpl := &domain.Template{ID: "tpl", Email: &domain.EmailTemplate{Subject: "s", SenderID: "x", VisualEditorTree: &notifuse_mjml.MJMLBlock{BaseBlock: notifuse_mjml.NewBaseBlock("root", notifuse_mjml.MJMLComponentMjml)}}}
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "w", "tpl", int64(0)).Return(tpl, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "w", "tpl").Return(tpl, nil)
 
 	// No SaveState expectations needed; allow any
 	mockTaskRepo.EXPECT().SaveState(gomock.Any(), "w", "t", gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -1701,7 +1770,7 @@ func TestBroadcastOrchestrator_Process_EmptyRecipientsTriggersTestCompletion(t *
 	mockBroadcastRepo.EXPECT().UpdateBroadcast(gomock.Any(), gomock.Any()).Return(nil).Times(2)
 
 	tpl := &domain.Template{ID: "tpl", Email: &domain.EmailTemplate{Subject: "s", SenderID: "x", VisualEditorTree: &notifuse_mjml.MJMLBlock{BaseBlock: notifuse_mjml.NewBaseBlock("root", notifuse_mjml.MJMLComponentMjml)}}}
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "w", "tpl", int64(0)).Return(tpl, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "w", "tpl").Return(tpl, nil)
 
 	// Return empty recipients
 	mockContactRepo.EXPECT().GetContactsForBroadcast(gomock.Any(), "w", bcast.Audience, 1, "").Return([]*domain.ContactWithList{}, nil)
@@ -1797,13 +1866,13 @@ func TestBroadcastOrchestrator_Process_AutoWinnerEvaluationPath(t *testing.T) {
 
 	// Template load for tplB
 	tplB := &domain.Template{ID: "tplB", Email: &domain.EmailTemplate{Subject: "s", SenderID: "x", VisualEditorTree: &notifuse_mjml.MJMLBlock{BaseBlock: notifuse_mjml.NewBaseBlock("root", notifuse_mjml.MJMLComponentMjml)}}}
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "w", "tplB", int64(0)).Return(tplB, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "w", "tplB").Return(tplB, nil)
 
 	// Recipient batch for winner phase (totalRecipients preset to 1 in task below)
 	mockContactRepo.EXPECT().GetContactsForBroadcast(gomock.Any(), "w", bcast.Audience, 1, "").Return([]*domain.ContactWithList{{Contact: &domain.Contact{Email: "w@x.com"}}}, nil)
 
 	// Send
-	mockMessageSender.EXPECT().SendBatch(gomock.Any(), "w", "pid", "k", gomock.Any(), gomock.Any(), true, "b", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(1, 0, nil)
+	mockMessageSender.EXPECT().SendBatch(gomock.Any(), "w", "pid", "k", gomock.Any(), gomock.Any(), gomock.Any(), true, "b", gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(1, 0, nil)
 
 	// Save state
 	mockTaskRepo.EXPECT().SaveState(gomock.Any(), "w", "t", gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -1913,8 +1982,8 @@ func TestBroadcastOrchestrator_Process_ABTestWinnerPhaseProcessesRemainingRecipi
 	}
 
 	// Mock template loading - might load all variations first, then just winner
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "workspace-123", "template-A", int64(0)).Return(templateA, nil).AnyTimes()
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "workspace-123", "template-B", int64(0)).Return(templateB, nil).AnyTimes()
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "workspace-123", "template-A").Return(templateA, nil).AnyTimes()
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "workspace-123", "template-B").Return(templateB, nil).AnyTimes()
 
 	// Setup recipients: winner phase should fetch using cursor (after test phase processed 1 recipient)
 	// This is the key part of the test - ensuring the winner phase processes the remaining recipient
@@ -1938,6 +2007,7 @@ func TestBroadcastOrchestrator_Process_ABTestWinnerPhaseProcessesRemainingRecipi
 		gomock.Any(),
 		"workspace-123",
 		"marketing-provider-id", "secret-key",
+		gomock.Any(),
 		gomock.Any(), // custom endpoint
 		gomock.Any(),
 		true,
@@ -2867,7 +2937,7 @@ func TestBroadcastOrchestrator_Process_PartialBatchCursorUpdate(t *testing.T) {
 			},
 		},
 	}
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "workspace-123", "template-1", int64(0)).Return(template, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "workspace-123", "template-1").Return(template, nil)
 
 	// Mock recipients - first batch: 5 contacts fetched
 	recipients1 := []*domain.ContactWithList{
@@ -2896,6 +2966,7 @@ func TestBroadcastOrchestrator_Process_PartialBatchCursorUpdate(t *testing.T) {
 		"secret-key",
 		gomock.Any(),
 		gomock.Any(),
+		gomock.Any(),
 		true,
 		"broadcast-123",
 		recipients1,
@@ -2903,7 +2974,7 @@ func TestBroadcastOrchestrator_Process_PartialBatchCursorUpdate(t *testing.T) {
 		gomock.Any(),
 		gomock.Any(),
 		gomock.Any(),
-	).DoAndReturn(func(_ context.Context, _, _, _, _, _ interface{}, _ bool, _ string, _ []*domain.ContactWithList, _, _, _, _ interface{}) (int, int, error) {
+	).DoAndReturn(func(_ context.Context, _, _, _, _, _, _ interface{}, _ bool, _ string, _ []*domain.ContactWithList, _, _, _, _ interface{}) (int, int, error) {
 		sendBatchCalled = true
 		return 3, 0, nil // Only 3 sent due to internal timeout
 	})
@@ -2915,6 +2986,7 @@ func TestBroadcastOrchestrator_Process_PartialBatchCursorUpdate(t *testing.T) {
 		"secret-key",
 		gomock.Any(),
 		gomock.Any(),
+		gomock.Any(),
 		true,
 		"broadcast-123",
 		recipients2,
@@ -3056,7 +3128,7 @@ func TestProcessBroadcastTask_RecipientFeedFailure_PausesBroadcast(t *testing.T)
 
 	// Template
 	tpl := &domain.Template{ID: "template-1", Email: &domain.EmailTemplate{Subject: "S", SenderID: "s", VisualEditorTree: &notifuse_mjml.MJMLBlock{BaseBlock: notifuse_mjml.NewBaseBlock("root", notifuse_mjml.MJMLComponentMjml)}}}
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "workspace-123", "template-1", int64(0)).Return(tpl, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "workspace-123", "template-1").Return(tpl, nil)
 
 	// Contacts
 	recipients := []*domain.ContactWithList{
@@ -3067,7 +3139,7 @@ func TestProcessBroadcastTask_RecipientFeedFailure_PausesBroadcast(t *testing.T)
 
 	// SendBatch returns ErrBroadcastShouldPause
 	mockMessageSender.EXPECT().SendBatch(
-		gomock.Any(), "workspace-123", "marketing-provider-id", "secret-key", gomock.Any(), gomock.Any(), true, "broadcast-123",
+		gomock.Any(), "workspace-123", "marketing-provider-id", "secret-key", gomock.Any(), gomock.Any(), gomock.Any(), true, "broadcast-123",
 		recipients, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 	).Return(0, 0, fmt.Errorf("%w: recipient feed failed for user1@test.com: server error", broadcast.ErrBroadcastShouldPause))
 
@@ -3167,7 +3239,7 @@ func TestProcessBroadcastTask_RecipientFeedFailure_NotMarkedAsFailed(t *testing.
 
 	// Template
 	tpl := &domain.Template{ID: "template-1", Email: &domain.EmailTemplate{Subject: "S", SenderID: "s", VisualEditorTree: &notifuse_mjml.MJMLBlock{BaseBlock: notifuse_mjml.NewBaseBlock("root", notifuse_mjml.MJMLComponentMjml)}}}
-	mockTemplateRepo.EXPECT().GetTemplateByID(gomock.Any(), "workspace-123", "template-1", int64(0)).Return(tpl, nil)
+	mockTemplateRepo.EXPECT().GetPublishedTemplate(gomock.Any(), "workspace-123", "template-1").Return(tpl, nil)
 
 	// Contacts
 	recipients := []*domain.ContactWithList{
@@ -3177,7 +3249,7 @@ func TestProcessBroadcastTask_RecipientFeedFailure_NotMarkedAsFailed(t *testing.
 
 	// SendBatch returns ErrBroadcastShouldPause
 	mockMessageSender.EXPECT().SendBatch(
-		gomock.Any(), "workspace-123", "marketing-provider-id", "secret-key", gomock.Any(), gomock.Any(), true, "broadcast-123",
+		gomock.Any(), "workspace-123", "marketing-provider-id", "secret-key", gomock.Any(), gomock.Any(), gomock.Any(), true, "broadcast-123",
 		recipients, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 	).Return(0, 0, fmt.Errorf("%w: recipient feed failed for user1@test.com: server error", broadcast.ErrBroadcastShouldPause))
 
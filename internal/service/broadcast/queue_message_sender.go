@@ -21,6 +21,7 @@ type queueMessageSender struct {
 	broadcastRepo      domain.BroadcastRepository
 	messageHistoryRepo domain.MessageHistoryRepository
 	templateRepo       domain.TemplateRepository
+	translationRepo    domain.TranslationRepository
 	dataFeedFetcher    DataFeedFetcher
 	logger             logger.Logger
 	config             *Config
@@ -33,6 +34,7 @@ func NewQueueMessageSender(
 	broadcastRepo domain.BroadcastRepository,
 	messageHistoryRepo domain.MessageHistoryRepository,
 	templateRepo domain.TemplateRepository,
+	translationRepo domain.TranslationRepository,
 	dataFeedFetcher DataFeedFetcher,
 	logger logger.Logger,
 	config *Config,
@@ -47,6 +49,7 @@ func NewQueueMessageSender(
 		broadcastRepo:      broadcastRepo,
 		messageHistoryRepo: messageHistoryRepo,
 		templateRepo:       templateRepo,
+		translationRepo:    translationRepo,
 		dataFeedFetcher:    dataFeedFetcher,
 		logger:             logger,
 		config:             config,
@@ -97,6 +100,7 @@ func (s *queueMessageSender) SendBatch(
 	workspaceID string,
 	integrationID string,
 	workspaceSecretKey string,
+	templateSecrets domain.TemplateSecrets,
 	endpoint string,
 	websiteURL string,
 	trackingEnabled bool,
@@ -117,6 +121,12 @@ func (s *queueMessageSender) SendBatch(
 		return 0, len(recipients), fmt.Errorf("failed to get broadcast: %w", err)
 	}
 
+	// Strings are loaded once per batch in every language, each recipient picking theirs
+	catalog, err := loadTranslationCatalog(ctx, s.translationRepo, workspaceID, workspaceDefaultLanguage, broadcast, templates)
+	if err != nil {
+		return 0, len(recipients), NewBroadcastError(ErrCodeSendFailed, "failed to load translations", true, err)
+	}
+
 	// Build queue entries
 	var entries []*domain.EmailQueueEntry
 	var buildErrors int
@@ -167,6 +177,7 @@ func (s *queueMessageSender) SendBatch(
 		req := domain.TemplateDataRequest{
 			WorkspaceID:         workspaceID,
 			WorkspaceSecretKey:  workspaceSecretKey,
+			TemplateSecrets:     templateSecrets,
 			WorkspaceWebsiteURL: websiteURL,
 			ContactWithList:     *recipient,
 			MessageID:           messageID,
@@ -218,6 +229,7 @@ func (s *queueMessageSender) SendBatch(
 		if recipient.Contact.Language != nil && !recipient.Contact.Language.IsNull {
 			contactLanguage = recipient.Contact.Language.String
 		}
+		catalog.Apply(data, contactLanguage)
 
 		// Build queue entry
 		entry, err := s.buildQueueEntry(ctx, workspaceID, integrationID, endpoint, trackingEnabled, broadcast, messageID, recipient.Contact.Email, template, data, emailProvider, contactLanguage, workspaceDefaultLanguage)
@@ -318,6 +330,7 @@ func (s *queueMessageSender) buildQueueEntry(
 		VisualEditorTree: emailContent.VisualEditorTree,
 		TemplateData:     data,
 		TrackingSettings: trackingSettings,
+		Text:             emailContent.Text,
 	}
 	compileReq.MjmlSource = emailContent.GetCodeModeMjmlSource()
 	compiledTemplate, err := notifuse_mjml.CompileTemplate(compileReq)
@@ -333,9 +346,15 @@ func (s *queueMessageSender) buildQueueEntry(
 	}
 	htmlContent := *compiledTemplate.HTML
 
+	// The variation may override the subject of the template
+	subjectTemplate := emailContent.Subject
+	if override := broadcast.GetSubjectForTemplate(template.ID); override != "" {
+		subjectTemplate = override
+	}
+
 	// Process subject line through Liquid templating
 	subject, err := notifuse_mjml.ProcessLiquidTemplate(
-		emailContent.Subject,
+		subjectTemplate,
 		data,
 		"email_subject",
 	)
@@ -360,6 +379,7 @@ func (s *queueMessageSender) buildQueueEntry(
 			FromName:           sender.Name,
 			Subject:            subject,
 			HTMLContent:        htmlContent,
+			TextContent:        compiledTemplate.GetText(),
 			RateLimitPerMinute: emailProvider.RateLimitPerMinute,
 			EmailOptions: domain.EmailOptions{
 				ReplyTo: emailContent.ReplyTo,
@@ -33,6 +33,7 @@ func TestNewQueueMessageSender(t *testing.T) {
 			mockBroadcastRepo,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			nil,
 			mockLogger,
 			nil,
@@ -58,6 +59,7 @@ func TestNewQueueMessageSender(t *testing.T) {
 			mockBroadcastRepo,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			nil,
 			mockLogger,
 			nil, // nil config
@@ -88,6 +90,7 @@ func TestNewQueueMessageSender(t *testing.T) {
 			mockBroadcastRepo,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			nil,
 			mockLogger,
 			config,
@@ -113,6 +116,7 @@ func TestNewQueueMessageSender(t *testing.T) {
 			mockBroadcastRepo,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			mockDataFeedFetcher,
 			mockLogger,
 			nil,
@@ -206,6 +210,7 @@ func TestQueueMessageSender_SendToRecipient(t *testing.T) {
 			mockBroadcastRepo,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			nil,
 			mockLogger,
 			nil,
@@ -273,6 +278,7 @@ func TestQueueMessageSender_SendToRecipient(t *testing.T) {
 			mockBroadcastRepo,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			nil,
 			mockLogger,
 			nil,
@@ -333,6 +339,7 @@ func TestQueueMessageSender_SendToRecipient(t *testing.T) {
 			mockBroadcastRepo,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			nil,
 			mockLogger,
 			nil,
@@ -421,6 +428,7 @@ func TestQueueMessageSender_SendBatch(t *testing.T) {
 			mockBroadcastRepo,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			nil,
 			mockLogger,
 			nil,
@@ -432,6 +440,7 @@ func TestQueueMessageSender_SendBatch(t *testing.T) {
 			"workspace-1",
 			"integration-1",
 			"secret-key",
+			nil,
 			"https://api.example.com",
 			"",
 			true,
@@ -463,6 +472,7 @@ func TestQueueMessageSender_SendBatch(t *testing.T) {
 			mockBroadcastRepo,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			nil,
 			mockLogger,
 			nil,
@@ -474,6 +484,7 @@ func TestQueueMessageSender_SendBatch(t *testing.T) {
 			"workspace-1",
 			"integration-1",
 			"secret-key",
+			nil,
 			"https://api.example.com",
 			"",
 			true,
@@ -540,6 +551,7 @@ func TestQueueMessageSender_SendBatch(t *testing.T) {
 			mockBroadcastRepo,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			nil,
 			mockLogger,
 			nil,
@@ -551,6 +563,7 @@ func TestQueueMessageSender_SendBatch(t *testing.T) {
 			"workspace-1",
 			"integration-1",
 			"secret-key",
+			nil,
 			"https://api.example.com",
 			"",
 			true,
@@ -652,6 +665,7 @@ func TestQueueMessageSender_SendBatch(t *testing.T) {
 			mockBroadcastRepo,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			nil,
 			mockLogger,
 			nil,
@@ -663,6 +677,7 @@ func TestQueueMessageSender_SendBatch(t *testing.T) {
 			"workspace-1",
 			"integration-1",
 			"test-secret-key",
+			nil,
 			"https://api.example.com",
 			"",
 			true,
@@ -747,6 +762,7 @@ func TestQueueMessageSender_SendBatch(t *testing.T) {
 			mockBroadcastRepo,
 			mockMessageHistoryRepo,
 			mockTemplateRepo,
+			nil, // translationRepo
 			nil,
 			mockLogger,
 			nil,
@@ -758,6 +774,7 @@ func TestQueueMessageSender_SendBatch(t *testing.T) {
 			"workspace-1",
 			"integration-1",
 			"test-secret-key",
+			nil,
 			"https://api.example.com",
 			"",
 			true,
@@ -864,6 +881,7 @@ func TestQueueSendBatch_WithRecipientFeed_Success(t *testing.T) {
 		mockBroadcastRepo,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockDataFeedFetcher,
 		mockLogger,
 		nil,
@@ -875,6 +893,7 @@ func TestQueueSendBatch_WithRecipientFeed_Success(t *testing.T) {
 		"workspace-1",
 		"integration-1",
 		"secret-key",
+		nil,
 		"https://api.example.com",
 		"",
 		true,
@@ -960,6 +979,7 @@ func TestQueueSendBatch_WithRecipientFeed_FetchError_PausesBroadcast(t *testing.
 		mockBroadcastRepo,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockDataFeedFetcher,
 		mockLogger,
 		nil,
@@ -971,6 +991,7 @@ func TestQueueSendBatch_WithRecipientFeed_FetchError_PausesBroadcast(t *testing.
 		"workspace-1",
 		"integration-1",
 		"secret-key",
+		nil,
 		"https://api.example.com",
 		"",
 		true,
@@ -1057,6 +1078,7 @@ func TestQueueSendBatch_WithRecipientFeed_Disabled(t *testing.T) {
 		mockBroadcastRepo,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		mockDataFeedFetcher,
 		mockLogger,
 		nil,
@@ -1068,6 +1090,7 @@ func TestQueueSendBatch_WithRecipientFeed_Disabled(t *testing.T) {
 		"workspace-1",
 		"integration-1",
 		"secret-key",
+		nil,
 		"https://api.example.com",
 		"",
 		true,
@@ -1084,6 +1107,100 @@ func TestQueueSendBatch_WithRecipientFeed_Disabled(t *testing.T) {
 	assert.Equal(t, 0, failed)
 }
 
+func TestQueueSendBatch_WithTranslations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockQueueRepo := mocks.NewMockEmailQueueRepository(ctrl)
+	mockBroadcastRepo := mocks.NewMockBroadcastRepository(ctrl)
+	mockMessageHistoryRepo := mocks.NewMockMessageHistoryRepository(ctrl)
+	mockTemplateRepo := mocks.NewMockTemplateRepository(ctrl)
+	mockTranslationRepo := mocks.NewMockTranslationRepository(ctrl)
+	mockLogger := pkgmocks.NewMockLogger(ctrl)
+
+	mockLogger.EXPECT().WithFields(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any()).AnyTimes()
+
+	emailSender := domain.NewEmailSender("sender@example.com", "Test Sender")
+	emailProvider := &domain.EmailProvider{
+		Kind:    domain.EmailProviderKindSMTP,
+		Senders: []domain.EmailSender{emailSender},
+	}
+
+	broadcast := &domain.Broadcast{
+		ID:            "broadcast-1",
+		WorkspaceID:   "workspace-1",
+		Name:          "Translated Broadcast",
+		UTMParameters: &domain.UTMParameters{Source: "test", Medium: "email"},
+	}
+
+	template := &domain.Template{
+		ID: "template-1",
+		Email: &domain.EmailTemplate{
+			SenderID:         emailSender.ID,
+			Subject:          `{{ "welcome" | t }}`,
+			VisualEditorTree: createQueueValidTestTree(createQueueTestTextBlock("txt1", "Hello")),
+		},
+	}
+
+	french := &domain.Contact{Email: "fr@example.com", Language: &domain.NullableString{String: "fr", IsNull: false}}
+	recipients := []*domain.ContactWithList{
+		{Contact: french, ListID: "list-1"},
+		{Contact: &domain.Contact{Email: "en@example.com"}, ListID: "list-1"},
+	}
+
+	mockBroadcastRepo.EXPECT().GetBroadcast(gomock.Any(), "workspace-1", "broadcast-1").
+		Return(broadcast, nil)
+
+	// The catalog is loaded once for the batch, in every language
+	mockTranslationRepo.EXPECT().List(gomock.Any(), "workspace-1", nil, domain.TranslationLookupKeys([]string{"welcome"})).
+		Return([]*domain.Translation{
+			{Key: "welcome", Language: "en", Value: "Welcome"},
+			{Key: "welcome", Language: "fr", Value: "Bienvenue"},
+		}, nil)
+
+	mockQueueRepo.EXPECT().Enqueue(gomock.Any(), "workspace-1", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, workspaceID string, entries []*domain.EmailQueueEntry) error {
+			require.Len(t, entries, 2)
+			assert.Equal(t, "Bienvenue", entries[0].Payload.Subject)
+			assert.Equal(t, "Welcome", entries[1].Payload.Subject)
+			return nil
+		})
+
+	sender := NewQueueMessageSender(
+		mockQueueRepo,
+		mockBroadcastRepo,
+		mockMessageHistoryRepo,
+		mockTemplateRepo,
+		mockTranslationRepo,
+		nil, // dataFeedFetcher
+		mockLogger,
+		nil,
+		"https://api.example.com",
+	)
+
+	sent, failed, err := sender.SendBatch(
+		context.Background(),
+		"workspace-1",
+		"integration-1",
+		"secret-key",
+		nil,
+		"https://api.example.com",
+		"",
+		true,
+		"broadcast-1",
+		recipients,
+		map[string]*domain.Template{"template-1": template},
+		emailProvider,
+		time.Now().Add(5*time.Minute),
+		"en",
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	assert.Equal(t, 0, failed)
+}
+
 func TestQueueSendBatch_WithRecipientFeed_NilFetcher(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1148,6 +1265,7 @@ func TestQueueSendBatch_WithRecipientFeed_NilFetcher(t *testing.T) {
 		mockBroadcastRepo,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		nil, // nil dataFeedFetcher
 		mockLogger,
 		nil,
@@ -1159,6 +1277,7 @@ func TestQueueSendBatch_WithRecipientFeed_NilFetcher(t *testing.T) {
 		"workspace-1",
 		"integration-1",
 		"secret-key",
+		nil,
 		"https://api.example.com",
 		"",
 		true,
@@ -1190,6 +1309,7 @@ func TestQueueMessageSender_SelectTemplate(t *testing.T) {
 		mockBroadcastRepo,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		nil,
 		mockLogger,
 		nil,
@@ -1251,6 +1371,7 @@ func TestQueueMessageSender_BuildQueueEntry(t *testing.T) {
 		mockBroadcastRepo,
 		mockMessageHistoryRepo,
 		mockTemplateRepo,
+		nil, // translationRepo
 		nil,
 		mockLogger,
 		nil,
@@ -1458,4 +1579,50 @@ func TestQueueMessageSender_BuildQueueEntry(t *testing.T) {
 		assert.Equal(t, "support@example.com", entry.Payload.EmailOptions.ReplyTo,
 			"ReplyTo from template should be preserved in queue entry")
 	})
+
+	t.Run("uses the subject override of the variation", func(t *testing.T) {
+		emailSender := domain.NewEmailSender("sender@example.com", "Test Sender")
+		emailProvider := &domain.EmailProvider{
+			Kind:    domain.EmailProviderKindSMTP,
+			Senders: []domain.EmailSender{emailSender},
+		}
+
+		broadcast := &domain.Broadcast{
+			ID:            "broadcast-1",
+			UTMParameters: &domain.UTMParameters{},
+			TestSettings: domain.BroadcastTestSettings{
+				Variations: []domain.BroadcastVariation{
+					{TemplateID: "template-1", Subject: "In case you missed it, {{ contact.name }}"},
+				},
+			},
+		}
+
+		template := &domain.Template{
+			ID: "template-1",
+			Email: &domain.EmailTemplate{
+				SenderID:         emailSender.ID,
+				Subject:          "Test Subject",
+				VisualEditorTree: createQueueValidTestTree(createQueueTestTextBlock("txt1", "Hello")),
+			},
+		}
+
+		entry, err := qms.buildQueueEntry(
+			context.Background(),
+			"workspace-1",
+			"integration-1",
+			"https://api.test.com",
+			true,
+			broadcast,
+			"msg-123",
+			"test@example.com",
+			template,
+			map[string]interface{}{"contact": map[string]interface{}{"name": "John"}},
+			emailProvider,
+			"",
+			"",
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "In case you missed it, John", entry.Payload.Subject)
+	})
 }
@@ -28,6 +28,7 @@ type BroadcastService struct {
 	eventBus           domain.EventBus
 	messageHistoryRepo domain.MessageHistoryRepository
 	emailQueueRepo     domain.EmailQueueRepository
+	translationRepo    domain.TranslationRepository
 	listService        domain.ListService
 	dataFeedFetcher    broadcast.DataFeedFetcher
 	apiEndpoint        string
@@ -47,6 +48,7 @@ func NewBroadcastService(
 	eventBus domain.EventBus,
 	messageHistoryRepository domain.MessageHistoryRepository,
 	emailQueueRepository domain.EmailQueueRepository,
+	translationRepository domain.TranslationRepository,
 	listService domain.ListService,
 	dataFeedFetcher broadcast.DataFeedFetcher,
 	apiEndpoint string,
@@ -64,6 +66,7 @@ func NewBroadcastService(
 		eventBus:           eventBus,
 		messageHistoryRepo: messageHistoryRepository,
 		emailQueueRepo:     emailQueueRepository,
+		translationRepo:    translationRepository,
 		listService:        listService,
 		dataFeedFetcher:    dataFeedFetcher,
 		apiEndpoint:        apiEndpoint,
@@ -176,6 +179,8 @@ func (s *BroadcastService) UpdateBroadcast(ctx context.Context, request *domain.
 		return nil, err
 	}
 
+	previousStatus := existingBroadcast.Status
+
 	// Validate and update broadcast fields
 	updatedBroadcast, err := request.Validate(existingBroadcast)
 	if err != nil {
@@ -193,11 +198,228 @@ func (s *BroadcastService) UpdateBroadcast(ctx context.Context, request *domain.
 		return nil, err
 	}
 
+	// An approved broadcast that was scheduled or paused goes back to review when edited,
+	// its send task is paused until the broadcast is approved and scheduled again
+	if updatedBroadcast.Status == domain.BroadcastStatusDraft &&
+		(previousStatus == domain.BroadcastStatusScheduled || previousStatus == domain.BroadcastStatusPaused) {
+		s.eventBus.Publish(ctx, domain.EventPayload{
+			Type:        domain.EventBroadcastPaused,
+			WorkspaceID: request.WorkspaceID,
+			EntityID:    updatedBroadcast.ID,
+			Data: map[string]interface{}{
+				"broadcast_id": updatedBroadcast.ID,
+			},
+		})
+	}
+
 	s.logger.Info("Broadcast updated successfully")
 
 	return updatedBroadcast, nil
 }
 
+// RequestApproval submits a draft broadcast for review. Broadcasts to more contacts than the
+// dual approval threshold of the workspace need two approvers.
+func (s *BroadcastService) RequestApproval(ctx context.Context, request *domain.RequestBroadcastApprovalRequest) (*domain.Broadcast, error) {
+	// Authenticate user for workspace
+	var err error
+	ctx, user, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, request.WorkspaceID)
+	if err != nil {
+		s.logger.WithField("broadcast_id", request.ID).Error("Failed to authenticate user for workspace")
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Check permission for writing broadcasts
+	if !userWorkspace.HasPermission(domain.PermissionResourceBroadcasts, domain.PermissionTypeWrite) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceBroadcasts,
+			domain.PermissionTypeWrite,
+			"Insufficient permissions: write access to broadcasts required",
+		)
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	workspace, err := s.workspaceRepo.GetByID(ctx, request.WorkspaceID)
+	if err != nil {
+		s.logger.Error("Failed to get workspace for broadcast approval request")
+		return nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+
+	bcast, err := s.repo.GetBroadcast(ctx, request.WorkspaceID, request.ID)
+	if err != nil {
+		s.logger.Error("Failed to get broadcast for approval request")
+		return nil, err
+	}
+
+	if bcast.Status != domain.BroadcastStatusDraft {
+		return nil, domain.NewValidationError(fmt.Sprintf("only draft broadcasts can be submitted for approval, current status: %s", bcast.Status))
+	}
+
+	recipientCount, err := s.contactRepo.CountContactsForBroadcast(ctx, request.WorkspaceID, bcast.RecipientAudience())
+	if err != nil {
+		s.logger.WithField("error", err.Error()).Error("Failed to count broadcast recipients")
+		return nil, fmt.Errorf("failed to count recipients: %w", err)
+	}
+
+	// Reviewers see the published templates, the approved broadcast sends these versions
+	templateVersions := make(map[string]int64, len(bcast.TestSettings.Variations))
+	for _, variation := range bcast.TestSettings.Variations {
+		template, err := s.templateSvc.GetPublishedTemplate(ctx, request.WorkspaceID, variation.TemplateID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get template %s: %w", variation.TemplateID, err)
+		}
+		templateVersions[variation.TemplateID] = template.Version
+	}
+
+	now := time.Now().UTC()
+	bcast.Approval = &domain.BroadcastApproval{
+		RequestedBy:       user.ID,
+		RequestedAt:       now,
+		Comment:           request.Comment,
+		RecipientCount:    recipientCount,
+		RequiredApprovals: workspace.Settings.BroadcastApproval.RequiredApprovals(recipientCount),
+		Reviews:           []domain.BroadcastReview{},
+		TemplateVersions:  templateVersions,
+	}
+	bcast.Status = domain.BroadcastStatusPendingApproval
+	bcast.UpdatedAt = now
+
+	if err := s.repo.UpdateBroadcast(ctx, bcast); err != nil {
+		s.logger.Error("Failed to update broadcast in repository")
+		return nil, err
+	}
+
+	s.logger.WithFields(map[string]interface{}{
+		"broadcast_id":       bcast.ID,
+		"workspace_id":       request.WorkspaceID,
+		"required_approvals": bcast.Approval.RequiredApprovals,
+	}).Info("Broadcast approval requested")
+
+	return bcast, nil
+}
+
+// ReviewBroadcast records the decision of a reviewer on a broadcast pending approval.
+// A rejection sends the broadcast back to draft, it is approved once it has enough approvals.
+func (s *BroadcastService) ReviewBroadcast(ctx context.Context, request *domain.ReviewBroadcastRequest) (*domain.Broadcast, error) {
+	// Authenticate user for workspace
+	var err error
+	ctx, user, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, request.WorkspaceID)
+	if err != nil {
+		s.logger.WithField("broadcast_id", request.ID).Error("Failed to authenticate user for workspace")
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Only designated members review broadcasts
+	if !userWorkspace.HasPermission(domain.PermissionResourceBroadcastApprovals, domain.PermissionTypeWrite) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceBroadcastApprovals,
+			domain.PermissionTypeWrite,
+			"Insufficient permissions: write access to broadcast approvals required",
+		)
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	bcast, err := s.repo.GetBroadcast(ctx, request.WorkspaceID, request.ID)
+	if err != nil {
+		s.logger.Error("Failed to get broadcast for review")
+		return nil, err
+	}
+
+	if bcast.Status != domain.BroadcastStatusPendingApproval || bcast.Approval == nil {
+		return nil, domain.NewValidationError(fmt.Sprintf("only broadcasts pending approval can be reviewed, current status: %s", bcast.Status))
+	}
+
+	if err := bcast.Approval.CanReview(user.ID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	bcast.Approval.Reviews = append(bcast.Approval.Reviews, domain.BroadcastReview{
+		UserID:    user.ID,
+		Decision:  request.Decision,
+		Comment:   request.Comment,
+		CreatedAt: now,
+	})
+
+	switch {
+	case request.Decision == domain.BroadcastReviewRejected:
+		// The reviews are kept so that the author sees what to change
+		bcast.Status = domain.BroadcastStatusDraft
+	case bcast.Approval.Approvals() >= bcast.Approval.RequiredApprovals:
+		bcast.Status = domain.BroadcastStatusApproved
+	}
+	bcast.UpdatedAt = now
+
+	if err := s.repo.UpdateBroadcast(ctx, bcast); err != nil {
+		s.logger.Error("Failed to update broadcast in repository")
+		return nil, err
+	}
+
+	s.logger.WithFields(map[string]interface{}{
+		"broadcast_id": bcast.ID,
+		"workspace_id": request.WorkspaceID,
+		"decision":     string(request.Decision),
+		"status":       string(bcast.Status),
+	}).Info("Broadcast reviewed")
+
+	return bcast, nil
+}
+
+// LintBroadcast runs the spam and deliverability checks on the published template of every
+// variation, or the approved version, with the subject override of the variation applied
+func (s *BroadcastService) LintBroadcast(ctx context.Context, workspaceID, broadcastID string) (*domain.BroadcastLintReport, error) {
+	broadcast, err := s.GetBroadcast(ctx, workspaceID, broadcastID)
+	if err != nil {
+		return nil, err
+	}
+
+	return lintBroadcast(ctx, s.templateSvc, workspaceID, broadcast)
+}
+
+// lintBroadcast lints the templates the variations of a broadcast send. It is shared
+// with the recurring broadcast processor, which checks each occurrence before sending it.
+func lintBroadcast(ctx context.Context, templateSvc domain.TemplateService, workspaceID string, broadcast *domain.Broadcast) (*domain.BroadcastLintReport, error) {
+	report := &domain.BroadcastLintReport{
+		BroadcastID: broadcast.ID,
+		Passed:      true,
+		Templates:   make([]*domain.TemplateLintReport, 0, len(broadcast.TestSettings.Variations)),
+	}
+
+	for _, variation := range broadcast.TestSettings.Variations {
+		var template *domain.Template
+		var err error
+		if version := broadcast.Approval.TemplateVersion(variation.TemplateID); version > 0 {
+			template, err = templateSvc.GetTemplateByID(ctx, workspaceID, variation.TemplateID, version)
+		} else {
+			template, err = templateSvc.GetPublishedTemplate(ctx, workspaceID, variation.TemplateID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get template %s: %w", variation.TemplateID, err)
+		}
+
+		if variation.Subject != "" && template.Email != nil {
+			templateCopy := *template
+			emailCopy := *template.Email
+			emailCopy.Subject = variation.Subject
+			templateCopy.Email = &emailCopy
+			template = &templateCopy
+		}
+
+		templateReport := lintEmailTemplate(workspaceID, template)
+		if !templateReport.Passed {
+			report.Passed = false
+		}
+		report.Templates = append(report.Templates, templateReport)
+	}
+
+	return report, nil
+}
+
 // ListBroadcasts retrieves a list of broadcasts with pagination
 func (s *BroadcastService) ListBroadcasts(ctx context.Context, params domain.ListBroadcastsParams) (*domain.BroadcastListResponse, error) {
 	// Authenticate user for workspace
@@ -250,7 +472,9 @@ func (s *BroadcastService) ListBroadcasts(ctx context.Context, params domain.Lis
 	return response, nil
 }
 
-// ScheduleBroadcast schedules a broadcast for sending
+// ScheduleBroadcast schedules a broadcast for sending once its templates pass the pre-send checks.
+// When approvals are required, an approved broadcast whose audience now needs more approvers goes
+// back to pending approval instead.
 func (s *BroadcastService) ScheduleBroadcast(ctx context.Context, request *domain.ScheduleBroadcastRequest) error {
 	// Authenticate user for workspace
 	var err error
@@ -273,20 +497,12 @@ func (s *BroadcastService) ScheduleBroadcast(ctx context.Context, request *domai
 		return fmt.Errorf("failed to get workspace: %w", err)
 	}
 
-	// Check if workspace has a marketing email provider configured
-	emailProvider, err := workspace.GetEmailProvider(true) // true for marketing emails
-	if err != nil {
-		s.logger.Error("Failed to get email provider configuration")
-		return fmt.Errorf("failed to get email provider: %w", err)
-	}
-
-	if emailProvider == nil {
-		s.logger.Error("Cannot schedule broadcast: no marketing email provider configured for workspace")
-		return fmt.Errorf("no marketing email provider configured for this workspace")
+	if err := s.requireMarketingProvider(workspace); err != nil {
+		return err
 	}
 
-	// Using a channel to wait for the event callback
-	done := make(chan error, 1)
+	// Set when the audience grew past the dual approval threshold since the review
+	var approvalsMissing error
 
 	// Use transaction to retrieve, update the broadcast, and publish the event
 	err = s.repo.WithTransaction(ctx, request.WorkspaceID, func(tx *sql.Tx) error {
@@ -297,147 +513,267 @@ func (s *BroadcastService) ScheduleBroadcast(ctx context.Context, request *domai
 			return err
 		}
 
-		// Only draft broadcasts can be scheduled
-		if bcast.Status != domain.BroadcastStatusDraft {
-			err := fmt.Errorf("only broadcasts with draft status can be scheduled, current status: %s", bcast.Status)
-			s.logger.Error("Cannot schedule broadcast with non-draft status")
+		sentBack, err := s.scheduleBroadcastTx(ctx, tx, workspace, bcast, request)
+		if err != nil {
 			return err
 		}
+		if sentBack {
+			approvalsMissing = fmt.Errorf("%w: the audience grew to %d contacts and needs %d approvals",
+				domain.ErrBroadcastApprovalRequired, bcast.Approval.RecipientCount, bcast.Approval.RequiredApprovals)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 
-		// Fetch global feed if configured
-		if bcast.DataFeed != nil && bcast.DataFeed.GlobalFeed != nil && bcast.DataFeed.GlobalFeed.Enabled {
-			// Get list information for the payload
-			var listName string
-			if bcast.Audience.List != "" {
-				list, listErr := s.listService.GetListByID(ctx, request.WorkspaceID, bcast.Audience.List)
-				if listErr != nil {
-					s.logger.WithField("list_id", bcast.Audience.List).Warn("Failed to get list for global feed payload")
-				} else if list != nil {
-					listName = list.Name
-				}
-			}
+	return approvalsMissing
+}
 
-			payload := &domain.GlobalFeedRequestPayload{
-				Broadcast: domain.GlobalFeedBroadcast{
-					ID:   bcast.ID,
-					Name: bcast.Name,
-				},
-				List: domain.GlobalFeedList{
-					ID:   bcast.Audience.List,
-					Name: listName,
-				},
-				Workspace: domain.GlobalFeedWorkspace{
-					ID:   workspace.ID,
-					Name: workspace.Name,
-				},
-			}
+// requireMarketingProvider returns an error when the workspace has no marketing email provider
+// to send broadcasts with
+func (s *BroadcastService) requireMarketingProvider(workspace *domain.Workspace) error {
+	emailProvider, err := workspace.GetEmailProvider(true) // true for marketing emails
+	if err != nil {
+		s.logger.Error("Failed to get email provider configuration")
+		return fmt.Errorf("failed to get email provider: %w", err)
+	}
 
-			feedData, fetchErr := s.dataFeedFetcher.FetchGlobal(ctx, bcast.DataFeed.GlobalFeed, payload)
-			if fetchErr != nil {
-				s.logger.WithFields(map[string]interface{}{
-					"broadcast_id": bcast.ID,
-					"url":          bcast.DataFeed.GlobalFeed.URL,
-					"error":        fetchErr.Error(),
-				}).Error("Failed to fetch global feed")
-				return fmt.Errorf("failed to fetch global feed: %w", fetchErr)
-			}
+	if emailProvider == nil {
+		s.logger.Error("Cannot schedule broadcast: no marketing email provider configured for workspace")
+		return fmt.Errorf("no marketing email provider configured for this workspace")
+	}
+	return nil
+}
 
-			// If feedData is nil, the feed was disabled or not configured
-			if feedData != nil {
-				now := time.Now().UTC()
-				bcast.DataFeed.GlobalFeedData = feedData
-				bcast.DataFeed.GlobalFeedFetchedAt = &now
+// scheduleBroadcastTx schedules a broadcast loaded in the transaction once its templates pass the
+// pre-send checks, and waits for its send task to be created. It returns true when the broadcast
+// was sent back for review instead, because its audience now needs more approvers.
+func (s *BroadcastService) scheduleBroadcastTx(ctx context.Context, tx *sql.Tx, workspace *domain.Workspace, bcast *domain.Broadcast, request *domain.ScheduleBroadcastRequest) (bool, error) {
+	// With the review gate enabled, only approved broadcasts can be scheduled
+	if workspace.Settings.BroadcastApproval.IsRequired() {
+		if bcast.Status != domain.BroadcastStatusApproved || bcast.Approval == nil {
+			s.logger.WithField("broadcast_id", request.ID).Error("Cannot schedule broadcast that was not approved")
+			return false, domain.ErrBroadcastApprovalRequired
+		}
 
-				s.logger.WithFields(map[string]interface{}{
-					"broadcast_id": bcast.ID,
-					"data_keys":    len(feedData),
-				}).Info("Global feed data fetched successfully")
+		// The audience is counted again, it may now need more approvers than it got
+		recipientCount, err := s.contactRepo.CountContactsForBroadcast(ctx, request.WorkspaceID, bcast.RecipientAudience())
+		if err != nil {
+			s.logger.WithField("error", err.Error()).Error("Failed to count broadcast recipients")
+			return false, fmt.Errorf("failed to count recipients: %w", err)
+		}
+		requiredApprovals := workspace.Settings.BroadcastApproval.RequiredApprovals(recipientCount)
+		if requiredApprovals > bcast.Approval.Approvals() {
+			bcast.Approval.RecipientCount = recipientCount
+			bcast.Approval.RequiredApprovals = requiredApprovals
+			bcast.Status = domain.BroadcastStatusPendingApproval
+			bcast.UpdatedAt = time.Now().UTC()
+			if err := s.repo.UpdateBroadcastTx(ctx, tx, bcast); err != nil {
+				s.logger.Error("Failed to update broadcast in repository")
+				return false, err
 			}
+			s.logger.WithFields(map[string]interface{}{
+				"broadcast_id":       request.ID,
+				"recipient_count":    recipientCount,
+				"required_approvals": requiredApprovals,
+			}).Info("Broadcast audience grew past the dual approval threshold, sent back for review")
+			return true, nil
 		}
+	}
 
-		// Update broadcast status and scheduling info
-		bcast.Status = domain.BroadcastStatusScheduled
-		bcast.UpdatedAt = time.Now().UTC()
+	// Only draft or approved broadcasts can be scheduled
+	if bcast.Status != domain.BroadcastStatusDraft && bcast.Status != domain.BroadcastStatusApproved {
+		err := fmt.Errorf("only broadcasts with draft status can be scheduled, current status: %s", bcast.Status)
+		s.logger.Error("Cannot schedule broadcast with non-draft status")
+		return false, err
+	}
 
-		if request.SendNow {
-			// If sending immediately, set status to sending
-			bcast.Status = domain.BroadcastStatusProcessing
-			now := time.Now().UTC()
-			bcast.StartedAt = &now
-		} else {
-			// Update the schedule settings with the requested settings
-			bcast.Schedule.IsScheduled = true
-			bcast.Schedule.ScheduledDate = request.ScheduledDate
-			bcast.Schedule.ScheduledTime = request.ScheduledTime
-			bcast.Schedule.Timezone = request.Timezone
-			bcast.Schedule.UseRecipientTimezone = request.UseRecipientTimezone
+	// A blog digest collects the posts published between two occurrences
+	if bcast.DataFeed.IsBlogDigest() && request.Recurrence == "" {
+		return false, fmt.Errorf("blog digest broadcasts must be scheduled with a recurrence")
+	}
+
+	// The pre-send checks block the templates with errors or a spam score at the threshold
+	report, err := lintBroadcast(ctx, s.templateSvc, request.WorkspaceID, bcast)
+	if err != nil {
+		s.logger.WithField("broadcast_id", request.ID).Error(fmt.Sprintf("Failed to lint broadcast: %v", err))
+		return false, err
+	}
+	if !report.Passed {
+		s.logger.WithField("broadcast_id", request.ID).Error("Cannot schedule broadcast that did not pass the pre-send checks")
+		return false, &domain.ErrBroadcastLintFailed{Report: report}
+	}
+
+	// A recurring broadcast fetches its global feed for each instance it spawns, and a follow-up
+	// to non-openers keeps the content of the original so that both sends match
+	if request.Recurrence == "" && bcast.Audience.NonOpenersOf == "" {
+		if err := fetchGlobalFeed(ctx, s.dataFeedFetcher, s.listService, s.logger, workspace, bcast); err != nil {
+			return false, err
 		}
+	}
 
-		// Persist the changes
-		err = s.repo.UpdateBroadcastTx(ctx, tx, bcast)
+	// Update broadcast status and scheduling info
+	bcast.Status = domain.BroadcastStatusScheduled
+	bcast.UpdatedAt = time.Now().UTC()
+
+	if request.SendNow {
+		// If sending immediately, set status to sending
+		bcast.Status = domain.BroadcastStatusProcessing
+		now := time.Now().UTC()
+		bcast.StartedAt = &now
+	} else {
+		// Update the schedule settings with the requested settings
+		bcast.Schedule.IsScheduled = true
+		bcast.Schedule.ScheduledDate = request.ScheduledDate
+		bcast.Schedule.ScheduledTime = request.ScheduledTime
+		bcast.Schedule.Timezone = request.Timezone
+		bcast.Schedule.UseRecipientTimezone = request.UseRecipientTimezone
+		bcast.Schedule.Recurrence = request.Recurrence
+	}
+
+	// A recurring broadcast is never sent itself: a task spawns a new broadcast at each occurrence
+	var firstOccurrence time.Time
+	if bcast.Schedule.IsRecurring() {
+		bcast.Status = domain.BroadcastStatusRecurring
+		firstOccurrence, err = bcast.Schedule.NextOccurrence(time.Now())
 		if err != nil {
-			s.logger.Error("Failed to update broadcast in repository")
-			return err
+			return false, err
 		}
-
-		// Create event payload with schedule information
-		payloadData := map[string]interface{}{
-			"broadcast_id": request.ID,
-			"send_now":     request.SendNow,
-			"status":       string(bcast.Status),
+		if firstOccurrence.IsZero() {
+			return false, fmt.Errorf("recurrence %q has no occurrence in the future", bcast.Schedule.Recurrence)
 		}
+	}
 
-		// Include actual scheduled time if broadcast is scheduled
-		if !request.SendNow && bcast.Schedule.IsScheduled {
-			scheduledTime, parseErr := bcast.Schedule.ParseScheduledDateTime()
-			if parseErr == nil && !scheduledTime.IsZero() {
-				payloadData["scheduled_time"] = scheduledTime.Format(time.RFC3339)
-			}
+	// Persist the changes
+	err = s.repo.UpdateBroadcastTx(ctx, tx, bcast)
+	if err != nil {
+		s.logger.Error("Failed to update broadcast in repository")
+		return false, err
+	}
+
+	if bcast.Schedule.IsRecurring() {
+		task := domain.NewRecurringBroadcastTask(request.WorkspaceID, bcast.ID, firstOccurrence)
+		if err := s.taskService.CreateTask(ctx, request.WorkspaceID, task); err != nil {
+			s.logger.WithField("broadcast_id", bcast.ID).Error(fmt.Sprintf("Failed to create recurring broadcast task: %v", err))
+			return false, fmt.Errorf("failed to create recurring broadcast task: %w", err)
 		}
+		return false, nil
+	}
 
-		eventPayload := domain.EventPayload{
-			Type:        domain.EventBroadcastScheduled,
-			WorkspaceID: request.WorkspaceID,
-			EntityID:    request.ID,
-			Data:        payloadData,
+	// Using a channel to wait for the event callback
+	done := make(chan error, 1)
+
+	// Create event payload with schedule information
+	payloadData := map[string]interface{}{
+		"broadcast_id": request.ID,
+		"send_now":     request.SendNow,
+		"status":       string(bcast.Status),
+	}
+
+	// Include actual scheduled time if broadcast is scheduled
+	if !request.SendNow && bcast.Schedule.IsScheduled {
+		scheduledTime, parseErr := bcast.Schedule.ParseScheduledDateTime()
+		if parseErr == nil && !scheduledTime.IsZero() {
+			payloadData["scheduled_time"] = scheduledTime.Format(time.RFC3339)
 		}
+	}
 
-		// Publish the event with callback within the transaction
-		s.eventBus.PublishWithAck(ctx, eventPayload, func(eventErr error) {
-			if eventErr != nil {
-				// Event processing failed, log the error
-				s.logger.WithFields(map[string]interface{}{
-					"broadcast_id": request.ID,
-					"workspace_id": request.WorkspaceID,
-					"error":        eventErr.Error(),
-				}).Error("Failed to process schedule broadcast event")
+	eventPayload := domain.EventPayload{
+		Type:        domain.EventBroadcastScheduled,
+		WorkspaceID: request.WorkspaceID,
+		EntityID:    request.ID,
+		Data:        payloadData,
+	}
 
-				// Since we're still in the same transaction, we don't need to rollback explicitly
-				// The outer transaction will be rolled back when we return an error
+	// Publish the event with callback within the transaction
+	s.eventBus.PublishWithAck(ctx, eventPayload, func(eventErr error) {
+		if eventErr != nil {
+			// Event processing failed, log the error
+			s.logger.WithFields(map[string]interface{}{
+				"broadcast_id": request.ID,
+				"workspace_id": request.WorkspaceID,
+				"error":        eventErr.Error(),
+			}).Error("Failed to process schedule broadcast event")
 
-				done <- fmt.Errorf("failed to process schedule event: %w", eventErr)
-			} else {
-				s.logger.WithField("broadcast_id", request.ID).Info("Schedule broadcast event processed successfully")
-				done <- nil
-			}
-		})
+			// Since we're still in the same transaction, we don't need to rollback explicitly
+			// The outer transaction will be rolled back when we return an error
 
-		// Wait for the event processing to complete
-		select {
-		case eventErr := <-done:
-			if eventErr != nil {
-				// If the event processing failed, roll back the transaction by returning an error
-				return eventErr
-			}
-			// If event processing succeeded, commit the transaction
-			return nil
-		case <-ctx.Done():
-			// If context is cancelled, roll back transaction by returning an error
-			return ctx.Err()
+			done <- fmt.Errorf("failed to process schedule event: %w", eventErr)
+		} else {
+			s.logger.WithField("broadcast_id", request.ID).Info("Schedule broadcast event processed successfully")
+			done <- nil
 		}
 	})
 
-	return err
+	// Wait for the event processing to complete
+	select {
+	case eventErr := <-done:
+		if eventErr != nil {
+			// If the event processing failed, roll back the transaction by returning an error
+			return false, eventErr
+		}
+		// If event processing succeeded, commit the transaction
+		return false, nil
+	case <-ctx.Done():
+		// If context is cancelled, roll back transaction by returning an error
+		return false, ctx.Err()
+	}
+}
+
+// fetchGlobalFeed fetches the global feed data of the broadcast, when configured, and stores it on the broadcast
+func fetchGlobalFeed(ctx context.Context, fetcher broadcast.DataFeedFetcher, listService domain.ListService, log logger.Logger, workspace *domain.Workspace, bcast *domain.Broadcast) error {
+	if bcast.DataFeed != nil && bcast.DataFeed.GlobalFeed != nil && bcast.DataFeed.GlobalFeed.Enabled {
+		// Get list information for the payload
+		var listName string
+		if bcast.Audience.List != "" {
+			list, listErr := listService.GetListByID(ctx, workspace.ID, bcast.Audience.List)
+			if listErr != nil {
+				log.WithField("list_id", bcast.Audience.List).Warn("Failed to get list for global feed payload")
+			} else if list != nil {
+				listName = list.Name
+			}
+		}
+
+		payload := &domain.GlobalFeedRequestPayload{
+			Broadcast: domain.GlobalFeedBroadcast{
+				ID:   bcast.ID,
+				Name: bcast.Name,
+			},
+			List: domain.GlobalFeedList{
+				ID:   bcast.Audience.List,
+				Name: listName,
+			},
+			Workspace: domain.GlobalFeedWorkspace{
+				ID:   workspace.ID,
+				Name: workspace.Name,
+			},
+		}
+
+		feedData, fetchErr := fetcher.FetchGlobal(ctx, bcast.DataFeed.GlobalFeed, payload)
+		if fetchErr != nil {
+			log.WithFields(map[string]interface{}{
+				"broadcast_id": bcast.ID,
+				"url":          bcast.DataFeed.GlobalFeed.URL,
+				"error":        fetchErr.Error(),
+			}).Error("Failed to fetch global feed")
+			return fmt.Errorf("failed to fetch global feed: %w", fetchErr)
+		}
+
+		// If feedData is nil, the feed was disabled or not configured
+		if feedData != nil {
+			now := time.Now().UTC()
+			bcast.DataFeed.GlobalFeedData = feedData
+			bcast.DataFeed.GlobalFeedFetchedAt = &now
+
+			log.WithFields(map[string]interface{}{
+				"broadcast_id": bcast.ID,
+				"data_keys":    len(feedData),
+			}).Info("Global feed data fetched successfully")
+		}
+	}
+
+	return nil
 }
 
 // PauseBroadcast pauses a sending broadcast
@@ -727,14 +1063,16 @@ func (s *BroadcastService) CancelBroadcast(ctx context.Context, request *domain.
 
 		// Cancel is allowed from Scheduled, Paused, Processing (mid-enqueue),
 		// or Processed (mid-drain). A/B intermediate states are out of scope.
+		// Cancelling a Recurring broadcast stops spawning new instances.
 		switch broadcast.Status {
 		case domain.BroadcastStatusScheduled,
 			domain.BroadcastStatusPaused,
 			domain.BroadcastStatusProcessing,
-			domain.BroadcastStatusProcessed:
+			domain.BroadcastStatusProcessed,
+			domain.BroadcastStatusRecurring:
 			// Allowed.
 		default:
-			err := fmt.Errorf("only broadcasts with scheduled, paused, processing, or processed status can be cancelled, current status: %s", broadcast.Status)
+			err := fmt.Errorf("only broadcasts with scheduled, paused, processing, processed, or recurring status can be cancelled, current status: %s", broadcast.Status)
 			s.logger.Error("Cannot cancel broadcast with invalid status")
 			return err
 		}
@@ -813,6 +1151,248 @@ func (s *BroadcastService) CancelBroadcast(ctx context.Context, request *domain.
 	return err
 }
 
+// ResendToNonOpeners schedules a follow-up of a processed broadcast to its recipients who neither
+// opened nor clicked it. The audience is evaluated when the follow-up is sent, so opens recorded
+// during the delay are taken into account. The follow-up is scheduled like any other broadcast,
+// after the same pre-send checks. When the workspace requires approvals, it is created as a draft
+// that goes through its own review.
+func (s *BroadcastService) ResendToNonOpeners(ctx context.Context, request *domain.ResendToNonOpenersRequest) (*domain.Broadcast, error) {
+	// Authenticate user for workspace
+	var err error
+	ctx, _, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, request.WorkspaceID)
+	if err != nil {
+		s.logger.WithField("broadcast_id", request.ID).Error("Failed to authenticate user for workspace")
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Check permission for writing broadcasts
+	if !userWorkspace.HasPermission(domain.PermissionResourceBroadcasts, domain.PermissionTypeWrite) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceBroadcasts,
+			domain.PermissionTypeWrite,
+			"Insufficient permissions: write access to broadcasts required",
+		)
+	}
+
+	// Validate the request
+	if err := request.Validate(); err != nil {
+		s.logger.Error("Failed to validate resend to non-openers request")
+		return nil, err
+	}
+
+	workspace, err := s.workspaceRepo.GetByID(ctx, request.WorkspaceID)
+	if err != nil {
+		s.logger.Error("Failed to get workspace for resend to non-openers")
+		return nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+
+	// Without the review gate the follow-up is scheduled right away
+	approvalRequired := workspace.Settings.BroadcastApproval.IsRequired()
+	if !approvalRequired {
+		if err := s.requireMarketingProvider(workspace); err != nil {
+			return nil, err
+		}
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("failed to generate ID: %w", err)
+	}
+
+	var followUp *domain.Broadcast
+
+	err = s.repo.WithTransaction(ctx, request.WorkspaceID, func(tx *sql.Tx) error {
+		original, err := s.repo.GetBroadcastTx(ctx, tx, request.WorkspaceID, request.ID)
+		if err != nil {
+			s.logger.Error("Failed to get broadcast for resend to non-openers")
+			return err
+		}
+
+		if original.Status != domain.BroadcastStatusProcessed {
+			return fmt.Errorf("only processed broadcasts can be resent to non-openers, current status: %s", original.Status)
+		}
+		// The messages of a recurring broadcast belong to its occurrences
+		if original.Schedule.IsRecurring() {
+			return fmt.Errorf("a recurring broadcast cannot be resent, resend one of its occurrences instead")
+		}
+
+		followUp, err = newNonOpenersFollowUp(original, request, fmt.Sprintf("%x", id)[:32], time.Now().UTC())
+		if err != nil {
+			return err
+		}
+
+		if err := s.repo.CreateBroadcastTx(ctx, tx, followUp); err != nil {
+			s.logger.Error("Failed to create resend to non-openers in repository")
+			return err
+		}
+
+		// The follow-up can use another template and subject, so it is reviewed on its own.
+		// It keeps its proposed send time and is scheduled once approved.
+		if approvalRequired {
+			return nil
+		}
+
+		// Otherwise it goes through the same checks as any scheduled broadcast
+		_, err = s.scheduleBroadcastTx(ctx, tx, workspace, followUp, &domain.ScheduleBroadcastRequest{
+			WorkspaceID:   request.WorkspaceID,
+			ID:            followUp.ID,
+			SendNow:       !followUp.Schedule.IsScheduled,
+			ScheduledDate: followUp.Schedule.ScheduledDate,
+			ScheduledTime: followUp.Schedule.ScheduledTime,
+			Timezone:      followUp.Schedule.Timezone,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.WithFields(map[string]interface{}{
+		"broadcast_id": request.ID,
+		"follow_up_id": followUp.ID,
+		"workspace_id": request.WorkspaceID,
+	}).Info("Resend to non-openers scheduled")
+
+	return followUp, nil
+}
+
+// newNonOpenersFollowUp builds the draft follow-up of a broadcast sent to its non-openers, with a
+// single variation, to be sent the given delay after the broadcast was completed
+func newNonOpenersFollowUp(original *domain.Broadcast, request *domain.ResendToNonOpenersRequest, id string, now time.Time) (*domain.Broadcast, error) {
+	templateID := request.TemplateID
+	if templateID == "" && original.WinningTemplate != nil {
+		templateID = *original.WinningTemplate
+	}
+	if templateID == "" && len(original.TestSettings.Variations) > 0 {
+		templateID = original.TestSettings.Variations[0].TemplateID
+	}
+	if templateID == "" {
+		return nil, fmt.Errorf("template_id is required as the broadcast has no template")
+	}
+
+	name := request.Name
+	if name == "" {
+		name = domain.SuffixBroadcastName(original.Name, " (resend)")
+	}
+
+	audience := original.Audience
+	audience.Segments = append([]string(nil), original.Audience.Segments...)
+	audience.NonOpenersOf = original.ID
+	// The held out contacts of the original never received it, so they are not non-openers
+	// and the follow-up does not hold out anyone else
+	audience.Holdout = nil
+
+	completedAt := original.UpdatedAt
+	if original.CompletedAt != nil {
+		completedAt = *original.CompletedAt
+	}
+	sendAt := completedAt.Add(time.Duration(request.DelayHours) * time.Hour)
+
+	var dataFeed *domain.DataFeedSettings
+	if original.DataFeed != nil {
+		// Reuse the global feed content of the original so that both sends match
+		feedCopy := *original.DataFeed
+		dataFeed = &feedCopy
+	}
+
+	originalID := original.ID
+	followUp := &domain.Broadcast{
+		ID:          id,
+		WorkspaceID: original.WorkspaceID,
+		Name:        name,
+		ChannelType: original.ChannelType,
+		Status:      domain.BroadcastStatusDraft,
+		Audience:    audience,
+		TestSettings: domain.BroadcastTestSettings{
+			Variations: []domain.BroadcastVariation{{
+				VariationName: "Resend",
+				TemplateID:    templateID,
+				Subject:       request.Subject,
+			}},
+		},
+		UTMParameters:     original.UTMParameters,
+		Metadata:          original.Metadata,
+		DataFeed:          dataFeed,
+		ParentBroadcastID: &originalID,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+
+	// Sent right away when the delay has already passed
+	if sendAt.After(now) {
+		followUp.Schedule = domain.ScheduleSettings{IsScheduled: true}
+		if err := followUp.Schedule.SetScheduledDateTime(sendAt, "UTC"); err != nil {
+			return nil, err
+		}
+	}
+
+	return followUp, nil
+}
+
+// PreviewAudience counts the recipients of an audience and the contacts removed by its exclusions
+func (s *BroadcastService) PreviewAudience(ctx context.Context, request *domain.PreviewAudienceRequest) (*domain.AudiencePreview, error) {
+	// Authenticate user for workspace
+	var err error
+	ctx, _, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, request.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Check permission for reading broadcasts
+	if !userWorkspace.HasPermission(domain.PermissionResourceBroadcasts, domain.PermissionTypeRead) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceBroadcasts,
+			domain.PermissionTypeRead,
+			"Insufficient permissions: read access to broadcasts required",
+		)
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	recipientCount, err := s.contactRepo.CountContactsForBroadcast(ctx, request.WorkspaceID, request.Audience)
+	if err != nil {
+		s.logger.WithField("error", err.Error()).Error("Failed to count audience recipients")
+		return nil, fmt.Errorf("failed to count recipients: %w", err)
+	}
+
+	preview := &domain.AudiencePreview{
+		TotalCount:     recipientCount,
+		RecipientCount: recipientCount,
+	}
+
+	// Contacts left once the exclusions are applied, before the holdout
+	eligibleCount := recipientCount
+	if request.Audience.Holdout.IsEnabled() {
+		withoutHoldout := request.Audience
+		withoutHoldout.Holdout = nil
+		eligibleCount, err = s.contactRepo.CountContactsForBroadcast(ctx, request.WorkspaceID, withoutHoldout)
+		if err != nil {
+			s.logger.WithField("error", err.Error()).Error("Failed to count audience contacts")
+			return nil, fmt.Errorf("failed to count contacts: %w", err)
+		}
+		preview.TotalCount = eligibleCount
+		// Counts are read separately, never report a negative group
+		preview.HoldoutCount = max(eligibleCount-recipientCount, 0)
+	}
+
+	if request.Audience.HasExclusions() {
+		withoutExclusions := request.Audience.WithoutExclusions()
+		withoutExclusions.Holdout = nil
+		totalCount, err := s.contactRepo.CountContactsForBroadcast(ctx, request.WorkspaceID, withoutExclusions)
+		if err != nil {
+			s.logger.WithField("error", err.Error()).Error("Failed to count audience contacts")
+			return nil, fmt.Errorf("failed to count contacts: %w", err)
+		}
+
+		preview.TotalCount = totalCount
+		preview.ExcludedCount = max(totalCount-eligibleCount, 0)
+	}
+
+	return preview, nil
+}
+
 // DeleteBroadcast deletes a broadcast
 func (s *BroadcastService) DeleteBroadcast(ctx context.Context, request *domain.DeleteBroadcastRequest) error {
 	// Authenticate user for workspace
@@ -982,6 +1562,7 @@ func (s *BroadcastService) SendToIndividual(ctx context.Context, request *domain
 	req := domain.TemplateDataRequest{
 		WorkspaceID:         request.WorkspaceID,
 		WorkspaceSecretKey:  workspace.Settings.SecretKey,
+		TemplateSecrets:     workspace.Settings.TemplateSecrets,
 		WorkspaceWebsiteURL: workspace.Settings.WebsiteURL,
 		ContactWithList: domain.ContactWithList{
 			Contact:  contact,
@@ -1006,6 +1587,12 @@ func (s *BroadcastService) SendToIndividual(ctx context.Context, request *domain
 		}
 	}
 
+	if err := domain.ApplyTranslations(ctx, s.translationRepo, request.WorkspaceID, workspace.Settings.DefaultLanguage,
+		contactLanguage, emailContent, templateData); err != nil {
+		s.logger.Error("Failed to load translations for broadcast")
+		return err
+	}
+
 	// Compile the template
 	compileReq := domain.CompileTemplateRequest{
 		WorkspaceID:      request.WorkspaceID,
@@ -1013,6 +1600,7 @@ func (s *BroadcastService) SendToIndividual(ctx context.Context, request *domain
 		VisualEditorTree: emailContent.VisualEditorTree,
 		TemplateData:     notifuse_mjml.MapOfAny(templateData),
 		TrackingSettings: trackingSettings,
+		Text:             emailContent.Text,
 	}
 	compileReq.MjmlSource = emailContent.GetCodeModeMjmlSource()
 	compiledTemplate, err := s.templateSvc.CompileTemplate(ctx, compileReq)
@@ -1040,6 +1628,7 @@ func (s *BroadcastService) SendToIndividual(ctx context.Context, request *domain
 		To:            request.RecipientEmail,
 		Subject:       emailContent.Subject,
 		Content:       *compiledTemplate.HTML,
+		Text:          compiledTemplate.GetText(),
 		Provider:      emailProvider,
 		EmailOptions: domain.EmailOptions{
 			ReplyTo: emailContent.ReplyTo,
@@ -1107,6 +1696,8 @@ func (s *BroadcastService) GetTestResults(ctx context.Context, workspaceID, broa
 	variationResults := make(map[string]*domain.VariationResult)
 	var recommendedWinner string
 	bestScore := -1.0
+	metric := broadcast.TestSettings.WinnerMetric()
+	samples := make([]domain.ABTestSample, 0, len(broadcast.TestSettings.Variations))
 
 	for _, variation := range broadcast.TestSettings.Variations {
 		// Use existing message history repository method with TemplateID
@@ -1119,6 +1710,19 @@ func (s *BroadcastService) GetTestResults(ctx context.Context, workspaceID, broa
 			continue // Skip failed variations
 		}
 
+		var revenue *domain.GoalRevenueSum
+		if metric == domain.TestWinnerMetricGoalRevenue {
+			revenue, err = s.messageHistoryRepo.GetBroadcastVariationGoalRevenue(ctx, workspaceID, broadcastID, variation.TemplateID)
+			if err != nil {
+				s.logger.WithFields(map[string]interface{}{
+					"template_id": variation.TemplateID,
+					"error":       err.Error(),
+				}).Warn("Failed to get variation goal revenue")
+				continue
+			}
+		}
+		samples = append(samples, domain.NewABTestSample(variation.TemplateID, metric, stats, revenue))
+
 		// Calculate rates (avoid division by zero)
 		openRate := 0.0
 		clickRate := 0.0
@@ -1148,6 +1752,12 @@ func (s *BroadcastService) GetTestResults(ctx context.Context, workspaceID, broa
 		}
 	}
 
+	// Statistics of the winner metric, they drive the recommendation once a metric is chosen
+	statistics := domain.EvaluateABTest(&broadcast.TestSettings, samples)
+	if broadcast.TestSettings.AutoSendWinnerMetric != "" && recommendedWinner != "" {
+		recommendedWinner = statistics.WinnerTemplateID
+	}
+
 	// Get winning template as string for response
 	winningTemplate := ""
 	if broadcast.WinningTemplate != nil {
@@ -1163,6 +1773,7 @@ func (s *BroadcastService) GetTestResults(ctx context.Context, workspaceID, broa
 		RecommendedWinner: recommendedWinner,
 		WinningTemplate:   winningTemplate, // Include actual winner if selected
 		IsAutoSendWinner:  broadcast.TestSettings.AutoSendWinner,
+		Statistics:        statistics,
 	}, nil
 }
 
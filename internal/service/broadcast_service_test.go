@@ -44,6 +44,31 @@ func testBroadcast(workspaceID, id string) *domain.Broadcast {
 	}
 }
 
+// publishedTemplate returns a marketing template that passes the pre-send checks
+func publishedTemplate(id string) *domain.Template {
+	source := `<mjml><mj-body><mj-section><mj-column><mj-text>Our spring collection is here. ` +
+		`<a href="{{ unsubscribe_url }}">Unsubscribe</a></mj-text></mj-column></mj-section></mj-body></mjml>`
+	return &domain.Template{
+		ID:       id,
+		Version:  1,
+		Category: string(domain.TemplateCategoryMarketing),
+		Email: &domain.EmailTemplate{
+			EditorMode: domain.EditorModeCode,
+			MjmlSource: &source,
+			Subject:    "Spring collection",
+		},
+	}
+}
+
+// expectPublishedTemplates serves templates that pass the pre-send checks run when scheduling
+func expectPublishedTemplates(templateSvc *domainmocks.MockTemplateService) {
+	templateSvc.EXPECT().GetPublishedTemplate(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, id string) (*domain.Template, error) {
+			return publishedTemplate(id), nil
+		},
+	).AnyTimes()
+}
+
 // helper to create a minimal MJML root block
 func createMJMLRootBlock() notifusemjml.EmailBlock {
 	base := notifusemjml.NewBaseBlock("root", notifusemjml.MJMLComponentMjml)
@@ -103,6 +128,7 @@ func setupBroadcastSvc(t *testing.T) *broadcastSvcDeps {
 		eventBus,
 		messageHistoryRepo,
 		emailQueueRepo,
+		nil,
 		listService,
 		dataFeedFetcher,
 		"https://api.example.test",
@@ -165,6 +191,7 @@ func TestBroadcastService_CreateBroadcast_Success(t *testing.T) {
 func TestBroadcastService_ScheduleBroadcast_SendNow_Success(t *testing.T) {
 	d := setupBroadcastSvc(t)
 	defer d.ctrl.Finish()
+	expectPublishedTemplates(d.templateSvc)
 
 	ctx := context.Background()
 	req := &domain.ScheduleBroadcastRequest{WorkspaceID: "w1", ID: "b1", SendNow: true}
@@ -347,6 +374,43 @@ func TestBroadcastService_GetTestResults_ComputesRecommendation(t *testing.T) {
 	assert.Equal(t, b.Status, domain.BroadcastStatus(res.Status))
 }
 
+func TestBroadcastService_GetTestResults_Statistics(t *testing.T) {
+	d := setupBroadcastSvc(t)
+	defer d.ctrl.Finish()
+
+	ctx := context.Background()
+	workspaceID := "w1"
+	broadcastID := "b1"
+	authOK(d.authService, ctx, workspaceID)
+
+	b := testBroadcast(workspaceID, broadcastID)
+	b.Status = domain.BroadcastStatusTesting
+	b.TestSettings.Enabled = true
+	b.TestSettings.AutoSendWinnerMetric = domain.TestWinnerMetricOpenRate
+	b.TestSettings.SignificanceMethod = domain.TestSignificanceChiSquared
+	b.TestSettings.MinSampleSize = 500
+	b.TestSettings.Variations = []domain.BroadcastVariation{
+		{VariationName: "A", TemplateID: "tplA"},
+		{VariationName: "B", TemplateID: "tplB"},
+	}
+	d.repo.EXPECT().GetBroadcast(ctx, workspaceID, broadcastID).Return(b, nil)
+
+	d.messageHistoryRepo.EXPECT().GetBroadcastVariationStats(ctx, workspaceID, broadcastID, "tplA").Return(&domain.MessageHistoryStatusSum{TotalSent: 200, TotalDelivered: 200, TotalOpened: 40}, nil)
+	d.messageHistoryRepo.EXPECT().GetBroadcastVariationStats(ctx, workspaceID, broadcastID, "tplB").Return(&domain.MessageHistoryStatusSum{TotalSent: 200, TotalDelivered: 200, TotalOpened: 80}, nil)
+
+	res, err := d.svc.GetTestResults(ctx, workspaceID, broadcastID)
+	require.NoError(t, err)
+	require.NotNil(t, res.Statistics)
+
+	// B is far ahead but the variations are below the minimum sample size: send the default
+	assert.Equal(t, "tplB", res.Statistics.BestTemplateID)
+	assert.False(t, res.Statistics.Conclusive)
+	assert.Equal(t, domain.TestInconclusiveInsufficientSample, res.Statistics.InconclusiveReason)
+	require.NotNil(t, res.Statistics.PValue)
+	assert.Less(t, *res.Statistics.PValue, 0.05)
+	assert.Equal(t, "tplA", res.RecommendedWinner)
+}
+
 func TestBroadcastService_SelectWinner_SetsWinnerAndResumesTask(t *testing.T) {
 	d := setupBroadcastSvc(t)
 	defer d.ctrl.Finish()
@@ -446,6 +510,46 @@ func TestBroadcastService_UpdateBroadcast_Success(t *testing.T) {
 	assert.Equal(t, req.Name, updated.Name)
 }
 
+func TestBroadcastService_UpdateBroadcast_ApprovedBroadcastGoesBackToReview(t *testing.T) {
+	for _, status := range []domain.BroadcastStatus{domain.BroadcastStatusScheduled, domain.BroadcastStatusPaused} {
+		t.Run(string(status), func(t *testing.T) {
+			d := setupBroadcastSvc(t)
+			defer d.ctrl.Finish()
+
+			ctx := context.Background()
+			req := &domain.UpdateBroadcastRequest{
+				WorkspaceID: "w1",
+				ID:          "b1",
+				Name:        "Edited after approval",
+				Audience:    domain.AudienceSettings{List: "list1"},
+				TestSettings: domain.BroadcastTestSettings{
+					Variations: []domain.BroadcastVariation{{VariationName: "A", TemplateID: "tplB"}},
+				},
+			}
+			authOK(d.authService, ctx, req.WorkspaceID)
+
+			existing := testBroadcast(req.WorkspaceID, req.ID)
+			existing.Status = status
+			existing.Approval = &domain.BroadcastApproval{RequestedBy: "author", RequiredApprovals: 1}
+
+			d.repo.EXPECT().GetBroadcast(ctx, req.WorkspaceID, req.ID).Return(existing, nil)
+			d.repo.EXPECT().UpdateBroadcast(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, b *domain.Broadcast) error {
+				assert.Equal(t, domain.BroadcastStatusDraft, b.Status)
+				assert.Nil(t, b.Approval)
+				return nil
+			})
+			d.eventBus.EXPECT().Publish(ctx, gomock.Any()).Do(func(_ context.Context, event domain.EventPayload) {
+				assert.Equal(t, domain.EventBroadcastPaused, event.Type)
+				assert.Equal(t, "b1", event.EntityID)
+			})
+
+			updated, err := d.svc.UpdateBroadcast(ctx, req)
+			require.NoError(t, err)
+			assert.Equal(t, domain.BroadcastStatusDraft, updated.Status)
+		})
+	}
+}
+
 func TestBroadcastService_ListBroadcasts_WithTemplates(t *testing.T) {
 	d := setupBroadcastSvc(t)
 	defer d.ctrl.Finish()
@@ -918,6 +1022,7 @@ func TestBroadcastService_ScheduleBroadcast_InvalidStatus(t *testing.T) {
 func TestBroadcastService_ScheduleBroadcast_EventProcessingFailure(t *testing.T) {
 	d := setupBroadcastSvc(t)
 	defer d.ctrl.Finish()
+	expectPublishedTemplates(d.templateSvc)
 
 	ctx := context.Background()
 	req := &domain.ScheduleBroadcastRequest{WorkspaceID: "w1", ID: "b1", SendNow: true}
@@ -958,6 +1063,7 @@ func TestBroadcastService_ScheduleBroadcast_EventProcessingFailure(t *testing.T)
 func TestBroadcastService_ScheduleWithGlobalFeed_Success(t *testing.T) {
 	d := setupBroadcastSvc(t)
 	defer d.ctrl.Finish()
+	expectPublishedTemplates(d.templateSvc)
 
 	ctx := context.Background()
 	req := &domain.ScheduleBroadcastRequest{WorkspaceID: "w1", ID: "b1", SendNow: true}
@@ -1041,6 +1147,7 @@ func TestBroadcastService_ScheduleWithGlobalFeed_Success(t *testing.T) {
 func TestBroadcastService_ScheduleWithGlobalFeed_FetchError(t *testing.T) {
 	d := setupBroadcastSvc(t)
 	defer d.ctrl.Finish()
+	expectPublishedTemplates(d.templateSvc)
 
 	ctx := context.Background()
 	req := &domain.ScheduleBroadcastRequest{WorkspaceID: "w1", ID: "b1", SendNow: true}
@@ -1088,6 +1195,7 @@ func TestBroadcastService_ScheduleWithGlobalFeed_FetchError(t *testing.T) {
 func TestBroadcastService_ScheduleWithoutGlobalFeed(t *testing.T) {
 	d := setupBroadcastSvc(t)
 	defer d.ctrl.Finish()
+	expectPublishedTemplates(d.templateSvc)
 
 	ctx := context.Background()
 	req := &domain.ScheduleBroadcastRequest{WorkspaceID: "w1", ID: "b1", SendNow: true}
@@ -1134,6 +1242,7 @@ func TestBroadcastService_ScheduleWithoutGlobalFeed(t *testing.T) {
 func TestBroadcastService_ScheduleWithGlobalFeed_Disabled(t *testing.T) {
 	d := setupBroadcastSvc(t)
 	defer d.ctrl.Finish()
+	expectPublishedTemplates(d.templateSvc)
 
 	ctx := context.Background()
 	req := &domain.ScheduleBroadcastRequest{WorkspaceID: "w1", ID: "b1", SendNow: true}
@@ -1185,6 +1294,7 @@ func TestBroadcastService_ScheduleWithGlobalFeed_Disabled(t *testing.T) {
 func TestBroadcastService_ScheduleWithGlobalFeed_ListNotFound(t *testing.T) {
 	d := setupBroadcastSvc(t)
 	defer d.ctrl.Finish()
+	expectPublishedTemplates(d.templateSvc)
 
 	ctx := context.Background()
 	req := &domain.ScheduleBroadcastRequest{WorkspaceID: "w1", ID: "b1", SendNow: true}
@@ -1378,7 +1488,7 @@ func TestBroadcastService_CancelBroadcast_InvalidStatus(t *testing.T) {
 
 	err := d.svc.CancelBroadcast(ctx, req)
 	require.Error(t, err)
-	assert.Contains(t, err.Error(), "only broadcasts with scheduled, paused, processing, or processed status can be cancelled")
+	assert.Contains(t, err.Error(), "only broadcasts with scheduled, paused, processing, processed, or recurring status can be cancelled")
 }
 
 // Phase-2 scenarios: pause/resume/cancel work once orchestrator has enqueued
@@ -2230,6 +2340,7 @@ func TestBroadcastService_SelectWinner_NoTask(t *testing.T) {
 func TestBroadcastService_ScheduleBroadcast_ContextCancellation(t *testing.T) {
 	d := setupBroadcastSvc(t)
 	defer d.ctrl.Finish()
+	expectPublishedTemplates(d.templateSvc)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	req := &domain.ScheduleBroadcastRequest{WorkspaceID: "w1", ID: "b1", SendNow: true}
@@ -2634,6 +2745,7 @@ func TestBroadcastService_CreateBroadcast_IDGeneration(t *testing.T) {
 func TestBroadcastService_ScheduleBroadcast_ScheduledTimeInPayload(t *testing.T) {
 	d := setupBroadcastSvc(t)
 	defer d.ctrl.Finish()
+	expectPublishedTemplates(d.templateSvc)
 
 	ctx := context.Background()
 	req := &domain.ScheduleBroadcastRequest{
@@ -3230,3 +3342,851 @@ func TestBroadcastService_TestRecipientFeed_ValidationFailure(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "url is required")
 }
+
+func TestBroadcastService_ScheduleBroadcast_Recurring(t *testing.T) {
+	d := setupBroadcastSvc(t)
+	defer d.ctrl.Finish()
+	expectPublishedTemplates(d.templateSvc)
+
+	ctx := context.Background()
+	req := &domain.ScheduleBroadcastRequest{
+		WorkspaceID:   "w1",
+		ID:            "b1",
+		ScheduledDate: "2999-01-04",
+		ScheduledTime: "09:00",
+		Timezone:      "Europe/Paris",
+		Recurrence:    "FREQ=WEEKLY;BYDAY=MO",
+	}
+	authOK(d.authService, ctx, req.WorkspaceID)
+
+	workspace := &domain.Workspace{
+		ID:       "w1",
+		Settings: domain.WorkspaceSettings{MarketingEmailProviderID: "mkt"},
+		Integrations: domain.Integrations{
+			{ID: "mkt", Type: domain.IntegrationTypeEmail, EmailProvider: domain.EmailProvider{Kind: domain.EmailProviderKindSMTP}},
+		},
+	}
+	d.workspaceRepo.EXPECT().GetByID(ctx, req.WorkspaceID).Return(workspace, nil)
+
+	d.repo.EXPECT().WithTransaction(ctx, req.WorkspaceID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, fn func(*sql.Tx) error) error { return fn(nil) },
+	)
+
+	// The global feed is fetched by each instance, not by the recurring broadcast
+	draft := testBroadcast(req.WorkspaceID, req.ID)
+	draft.DataFeed = &domain.DataFeedSettings{GlobalFeed: &domain.GlobalFeedSettings{Enabled: true, URL: "https://example.test/feed"}}
+	d.repo.EXPECT().GetBroadcastTx(gomock.Any(), gomock.Any(), req.WorkspaceID, req.ID).Return(draft, nil)
+	d.repo.EXPECT().UpdateBroadcastTx(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *sql.Tx, b *domain.Broadcast) error {
+			assert.Equal(t, domain.BroadcastStatusRecurring, b.Status)
+			assert.Equal(t, req.Recurrence, b.Schedule.Recurrence)
+			return nil
+		},
+	)
+
+	// The first Monday on or after the scheduled date, in the schedule timezone
+	paris, _ := time.LoadLocation("Europe/Paris")
+	first := time.Date(2999, 1, 7, 9, 0, 0, 0, paris)
+	d.taskService.EXPECT().CreateTask(ctx, req.WorkspaceID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, task *domain.Task) error {
+			assert.Equal(t, domain.TaskTypeRecurringBroadcast, task.Type)
+			assert.True(t, first.Equal(*task.NextRunAfter), "got %s", task.NextRunAfter)
+			assert.Equal(t, req.ID, task.State.RecurringBroadcast.BroadcastID)
+			return nil
+		},
+	)
+
+	err := d.svc.ScheduleBroadcast(ctx, req)
+	require.NoError(t, err)
+}
+
+func TestBroadcastService_ResendToNonOpeners(t *testing.T) {
+	ctx := context.Background()
+
+	processed := func() *domain.Broadcast {
+		original := testBroadcast("w1", "b1")
+		original.Status = domain.BroadcastStatusProcessed
+		winner := "tplB"
+		original.WinningTemplate = &winner
+		original.TestSettings.Variations = append(original.TestSettings.Variations, domain.BroadcastVariation{VariationName: "B", TemplateID: "tplB"})
+		return original
+	}
+
+	// The follow-up is scheduled with the marketing provider of the workspace
+	workspace := func() *domain.Workspace {
+		return &domain.Workspace{
+			ID:       "w1",
+			Settings: domain.WorkspaceSettings{MarketingEmailProviderID: "mkt"},
+			Integrations: domain.Integrations{
+				{ID: "mkt", Type: domain.IntegrationTypeEmail, EmailProvider: domain.EmailProvider{Kind: domain.EmailProviderKindSMTP, Senders: []domain.EmailSender{domain.NewEmailSender("from@example.com", "From")}}},
+			},
+		}
+	}
+
+	expectTx := func(d *broadcastSvcDeps) {
+		d.repo.EXPECT().WithTransaction(ctx, "w1", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, fn func(*sql.Tx) error) error { return fn(nil) },
+		)
+	}
+
+	t.Run("schedules the follow-up after the delay", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		original := processed()
+		completedAt := time.Now().UTC().Add(-time.Hour)
+		original.CompletedAt = &completedAt
+
+		authOK(d.authService, ctx, "w1")
+		d.workspaceRepo.EXPECT().GetByID(ctx, "w1").Return(workspace(), nil)
+		expectTx(d)
+		d.repo.EXPECT().GetBroadcastTx(ctx, gomock.Any(), "w1", "b1").Return(original, nil)
+
+		var created *domain.Broadcast
+		d.repo.EXPECT().CreateBroadcastTx(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *sql.Tx, b *domain.Broadcast) error {
+				created = b
+				assert.Equal(t, domain.BroadcastStatusDraft, b.Status)
+				return nil
+			})
+		// The follow-up goes through the pre-send checks of any scheduled broadcast
+		d.templateSvc.EXPECT().GetPublishedTemplate(ctx, "w1", "tplB").Return(publishedTemplate("tplB"), nil)
+		d.repo.EXPECT().UpdateBroadcastTx(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		d.eventBus.EXPECT().PublishWithAck(ctx, gomock.Any(), gomock.Any()).Do(
+			func(_ context.Context, payload domain.EventPayload, ack domain.EventAckCallback) {
+				assert.Equal(t, domain.EventBroadcastScheduled, payload.Type)
+				assert.Equal(t, false, payload.Data["send_now"])
+				assert.NotEmpty(t, payload.Data["scheduled_time"])
+				ack(nil)
+			})
+
+		followUp, err := d.svc.ResendToNonOpeners(ctx, &domain.ResendToNonOpenersRequest{
+			WorkspaceID: "w1",
+			ID:          "b1",
+			DelayHours:  24,
+			Subject:     "In case you missed it",
+		})
+
+		require.NoError(t, err)
+		assert.Same(t, created, followUp)
+		assert.Equal(t, "Test Broadcast (resend)", followUp.Name)
+		assert.Equal(t, domain.BroadcastStatusScheduled, followUp.Status)
+		assert.Equal(t, "b1", followUp.Audience.NonOpenersOf)
+		assert.Equal(t, "list1", followUp.Audience.List)
+		require.NotNil(t, followUp.ParentBroadcastID)
+		assert.Equal(t, "b1", *followUp.ParentBroadcastID)
+		require.Len(t, followUp.TestSettings.Variations, 1)
+		assert.Equal(t, "tplB", followUp.TestSettings.Variations[0].TemplateID)
+		assert.Equal(t, "In case you missed it", followUp.TestSettings.Variations[0].Subject)
+
+		sendAt, err := followUp.Schedule.ParseScheduledDateTime()
+		require.NoError(t, err)
+		assert.WithinDuration(t, completedAt.Add(24*time.Hour), sendAt, time.Minute)
+	})
+
+	t.Run("sends now when the delay has passed", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		original := processed()
+		completedAt := time.Now().UTC().Add(-72 * time.Hour)
+		original.CompletedAt = &completedAt
+
+		authOK(d.authService, ctx, "w1")
+		d.workspaceRepo.EXPECT().GetByID(ctx, "w1").Return(workspace(), nil)
+		expectTx(d)
+		d.repo.EXPECT().GetBroadcastTx(ctx, gomock.Any(), "w1", "b1").Return(original, nil)
+		d.repo.EXPECT().CreateBroadcastTx(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		expectPublishedTemplates(d.templateSvc)
+		d.repo.EXPECT().UpdateBroadcastTx(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		d.eventBus.EXPECT().PublishWithAck(ctx, gomock.Any(), gomock.Any()).Do(
+			func(_ context.Context, payload domain.EventPayload, ack domain.EventAckCallback) {
+				assert.Equal(t, true, payload.Data["send_now"])
+				assert.Equal(t, string(domain.BroadcastStatusProcessing), payload.Data["status"])
+				ack(nil)
+			})
+
+		followUp, err := d.svc.ResendToNonOpeners(ctx, &domain.ResendToNonOpenersRequest{
+			WorkspaceID: "w1",
+			ID:          "b1",
+			DelayHours:  24,
+			TemplateID:  "tplC",
+			Name:        "Second chance",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.BroadcastStatusProcessing, followUp.Status)
+		assert.Equal(t, "Second chance", followUp.Name)
+		assert.Equal(t, "tplC", followUp.TestSettings.Variations[0].TemplateID)
+		assert.False(t, followUp.Schedule.IsScheduled)
+	})
+
+	t.Run("rolls back the follow-up when its template fails the pre-send checks", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		original := processed()
+		completedAt := time.Now().UTC().Add(-time.Hour)
+		original.CompletedAt = &completedAt
+
+		authOK(d.authService, ctx, "w1")
+		d.workspaceRepo.EXPECT().GetByID(ctx, "w1").Return(workspace(), nil)
+		expectTx(d)
+		d.repo.EXPECT().GetBroadcastTx(ctx, gomock.Any(), "w1", "b1").Return(original, nil)
+		d.repo.EXPECT().CreateBroadcastTx(ctx, gomock.Any(), gomock.Any()).Return(nil)
+
+		// The template of the follow-up has no unsubscribe link
+		template := publishedTemplate("tplC")
+		source := `<mjml><mj-body><mj-section><mj-column><mj-text>Our spring collection is here.</mj-text></mj-column></mj-section></mj-body></mjml>`
+		template.Email.MjmlSource = &source
+		d.templateSvc.EXPECT().GetPublishedTemplate(ctx, "w1", "tplC").Return(template, nil)
+
+		_, err := d.svc.ResendToNonOpeners(ctx, &domain.ResendToNonOpenersRequest{
+			WorkspaceID: "w1",
+			ID:          "b1",
+			DelayHours:  24,
+			TemplateID:  "tplC",
+		})
+
+		var lintErr *domain.ErrBroadcastLintFailed
+		require.ErrorAs(t, err, &lintErr)
+		assert.Contains(t, err.Error(), "template tplC")
+	})
+
+	t.Run("requires a marketing email provider", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		authOK(d.authService, ctx, "w1")
+		d.workspaceRepo.EXPECT().GetByID(ctx, "w1").Return(&domain.Workspace{ID: "w1"}, nil)
+
+		_, err := d.svc.ResendToNonOpeners(ctx, &domain.ResendToNonOpenersRequest{WorkspaceID: "w1", ID: "b1"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no marketing email provider")
+	})
+
+	t.Run("creates a draft follow-up when approvals are required", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		original := processed()
+		completedAt := time.Now().UTC().Add(-time.Hour)
+		original.CompletedAt = &completedAt
+
+		authOK(d.authService, ctx, "w1")
+		d.workspaceRepo.EXPECT().GetByID(ctx, "w1").Return(&domain.Workspace{
+			ID: "w1",
+			Settings: domain.WorkspaceSettings{
+				BroadcastApproval: &domain.BroadcastApprovalSettings{Enabled: true},
+			},
+		}, nil)
+		expectTx(d)
+		d.repo.EXPECT().GetBroadcastTx(ctx, gomock.Any(), "w1", "b1").Return(original, nil)
+		d.repo.EXPECT().CreateBroadcastTx(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		// No schedule event: the follow-up is sent once approved and scheduled
+
+		followUp, err := d.svc.ResendToNonOpeners(ctx, &domain.ResendToNonOpenersRequest{
+			WorkspaceID: "w1",
+			ID:          "b1",
+			DelayHours:  24,
+			TemplateID:  "tplC",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.BroadcastStatusDraft, followUp.Status)
+		assert.Nil(t, followUp.StartedAt)
+		assert.True(t, followUp.Schedule.IsScheduled)
+	})
+
+	t.Run("rejects broadcasts that are not processed", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		original := processed()
+		original.Status = domain.BroadcastStatusProcessing
+
+		authOK(d.authService, ctx, "w1")
+		d.workspaceRepo.EXPECT().GetByID(ctx, "w1").Return(workspace(), nil)
+		expectTx(d)
+		d.repo.EXPECT().GetBroadcastTx(ctx, gomock.Any(), "w1", "b1").Return(original, nil)
+
+		_, err := d.svc.ResendToNonOpeners(ctx, &domain.ResendToNonOpenersRequest{WorkspaceID: "w1", ID: "b1"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "only processed broadcasts")
+	})
+
+	t.Run("requires write permission", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		userWorkspace := &domain.UserWorkspace{
+			UserID:      "user1",
+			WorkspaceID: "w1",
+			Permissions: domain.UserPermissions{
+				domain.PermissionResourceBroadcasts: {Read: true, Write: false},
+			},
+		}
+		d.authService.EXPECT().AuthenticateUserForWorkspace(ctx, "w1").Return(ctx, &domain.User{ID: "user1"}, userWorkspace, nil)
+
+		_, err := d.svc.ResendToNonOpeners(ctx, &domain.ResendToNonOpenersRequest{WorkspaceID: "w1", ID: "b1"})
+
+		require.Error(t, err)
+		assert.IsType(t, &domain.PermissionError{}, err)
+	})
+}
+
+func TestBroadcastService_PreviewAudience(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reports the contacts removed by the exclusions", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		audience := domain.AudienceSettings{
+			List:                     "newsletter",
+			ExcludeLists:             []string{"customers"},
+			ExcludeEmailedWithinDays: 3,
+		}
+
+		authOK(d.authService, ctx, "w1")
+		d.contactRepo.EXPECT().CountContactsForBroadcast(ctx, "w1", audience).Return(70, nil)
+		d.contactRepo.EXPECT().CountContactsForBroadcast(ctx, "w1", audience.WithoutExclusions()).Return(100, nil)
+
+		preview, err := d.svc.PreviewAudience(ctx, &domain.PreviewAudienceRequest{WorkspaceID: "w1", Audience: audience})
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.AudiencePreview{TotalCount: 100, ExcludedCount: 30, RecipientCount: 70}, preview)
+	})
+
+	t.Run("reports the held out contacts", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		audience := domain.AudienceSettings{
+			List:         "newsletter",
+			ExcludeLists: []string{"customers"},
+			Holdout:      &domain.HoldoutSettings{Enabled: true, Percentage: 10},
+		}
+		withoutHoldout := audience
+		withoutHoldout.Holdout = nil
+
+		authOK(d.authService, ctx, "w1")
+		d.contactRepo.EXPECT().CountContactsForBroadcast(ctx, "w1", audience).Return(63, nil)
+		d.contactRepo.EXPECT().CountContactsForBroadcast(ctx, "w1", withoutHoldout).Return(70, nil)
+		d.contactRepo.EXPECT().CountContactsForBroadcast(ctx, "w1", domain.AudienceSettings{List: "newsletter"}).Return(100, nil)
+
+		preview, err := d.svc.PreviewAudience(ctx, &domain.PreviewAudienceRequest{WorkspaceID: "w1", Audience: audience})
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.AudiencePreview{TotalCount: 100, ExcludedCount: 30, HoldoutCount: 7, RecipientCount: 63}, preview)
+	})
+
+	t.Run("counts once without exclusions", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		audience := domain.AudienceSettings{List: "newsletter"}
+
+		authOK(d.authService, ctx, "w1")
+		d.contactRepo.EXPECT().CountContactsForBroadcast(ctx, "w1", audience).Return(100, nil)
+
+		preview, err := d.svc.PreviewAudience(ctx, &domain.PreviewAudienceRequest{WorkspaceID: "w1", Audience: audience})
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.AudiencePreview{TotalCount: 100, RecipientCount: 100}, preview)
+	})
+
+	t.Run("rejects invalid exclusions", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		authOK(d.authService, ctx, "w1")
+
+		_, err := d.svc.PreviewAudience(ctx, &domain.PreviewAudienceRequest{
+			WorkspaceID: "w1",
+			Audience:    domain.AudienceSettings{List: "newsletter", ExcludeLists: []string{"newsletter"}},
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the audience list cannot be excluded")
+	})
+
+	t.Run("requires read permission", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		userWorkspace := &domain.UserWorkspace{
+			UserID:      "user1",
+			WorkspaceID: "w1",
+			Permissions: domain.UserPermissions{},
+		}
+		d.authService.EXPECT().AuthenticateUserForWorkspace(ctx, "w1").Return(ctx, &domain.User{ID: "user1"}, userWorkspace, nil)
+
+		_, err := d.svc.PreviewAudience(ctx, &domain.PreviewAudienceRequest{WorkspaceID: "w1", Audience: domain.AudienceSettings{List: "newsletter"}})
+
+		require.Error(t, err)
+		assert.IsType(t, &domain.PermissionError{}, err)
+	})
+}
+
+func authWithPermissions(auth *domainmocks.MockAuthService, ctx context.Context, workspaceID, userID string, permissions domain.UserPermissions) {
+	userWorkspace := &domain.UserWorkspace{
+		UserID:      userID,
+		WorkspaceID: workspaceID,
+		Role:        "member",
+		Permissions: permissions,
+	}
+	auth.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{ID: userID}, userWorkspace, nil)
+}
+
+func TestBroadcastService_RequestApproval(t *testing.T) {
+	workspaceWithApproval := &domain.Workspace{
+		ID: "w1",
+		Settings: domain.WorkspaceSettings{
+			BroadcastApproval: &domain.BroadcastApprovalSettings{Enabled: true},
+		},
+	}
+
+	t.Run("requires two approvers above the threshold", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		req := &domain.RequestBroadcastApprovalRequest{WorkspaceID: "w1", ID: "b1", Comment: "Ready for review"}
+		authOK(d.authService, ctx, req.WorkspaceID)
+
+		d.workspaceRepo.EXPECT().GetByID(ctx, req.WorkspaceID).Return(workspaceWithApproval, nil)
+		d.repo.EXPECT().GetBroadcast(ctx, req.WorkspaceID, req.ID).Return(testBroadcast(req.WorkspaceID, req.ID), nil)
+		d.contactRepo.EXPECT().CountContactsForBroadcast(ctx, req.WorkspaceID, gomock.Any()).Return(25000, nil)
+		expectPublishedTemplates(d.templateSvc)
+		d.repo.EXPECT().UpdateBroadcast(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, b *domain.Broadcast) error {
+			assert.Equal(t, domain.BroadcastStatusPendingApproval, b.Status)
+			return nil
+		})
+
+		broadcast, err := d.svc.RequestApproval(ctx, req)
+		require.NoError(t, err)
+		require.NotNil(t, broadcast.Approval)
+		assert.Equal(t, "user1", broadcast.Approval.RequestedBy)
+		assert.Equal(t, "Ready for review", broadcast.Approval.Comment)
+		assert.Equal(t, 25000, broadcast.Approval.RecipientCount)
+		assert.Equal(t, 2, broadcast.Approval.RequiredApprovals)
+		// The published versions the reviewers see are pinned for the send
+		assert.Equal(t, map[string]int64{"tplA": 1}, broadcast.Approval.TemplateVersions)
+	})
+
+	t.Run("requires one approver below the threshold", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		req := &domain.RequestBroadcastApprovalRequest{WorkspaceID: "w1", ID: "b1"}
+		authOK(d.authService, ctx, req.WorkspaceID)
+
+		d.workspaceRepo.EXPECT().GetByID(ctx, req.WorkspaceID).Return(workspaceWithApproval, nil)
+		d.repo.EXPECT().GetBroadcast(ctx, req.WorkspaceID, req.ID).Return(testBroadcast(req.WorkspaceID, req.ID), nil)
+		d.contactRepo.EXPECT().CountContactsForBroadcast(ctx, req.WorkspaceID, gomock.Any()).Return(10000, nil)
+		expectPublishedTemplates(d.templateSvc)
+		d.repo.EXPECT().UpdateBroadcast(ctx, gomock.Any()).Return(nil)
+
+		broadcast, err := d.svc.RequestApproval(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, 1, broadcast.Approval.RequiredApprovals)
+	})
+
+	t.Run("only drafts can be submitted", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		req := &domain.RequestBroadcastApprovalRequest{WorkspaceID: "w1", ID: "b1"}
+		authOK(d.authService, ctx, req.WorkspaceID)
+
+		scheduled := testBroadcast(req.WorkspaceID, req.ID)
+		scheduled.Status = domain.BroadcastStatusScheduled
+		d.workspaceRepo.EXPECT().GetByID(ctx, req.WorkspaceID).Return(workspaceWithApproval, nil)
+		d.repo.EXPECT().GetBroadcast(ctx, req.WorkspaceID, req.ID).Return(scheduled, nil)
+
+		_, err := d.svc.RequestApproval(ctx, req)
+		require.Error(t, err)
+		assert.IsType(t, domain.ValidationError{}, err)
+	})
+
+	t.Run("requires write access to broadcasts", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		req := &domain.RequestBroadcastApprovalRequest{WorkspaceID: "w1", ID: "b1"}
+		authWithPermissions(d.authService, ctx, req.WorkspaceID, "user1", domain.UserPermissions{
+			domain.PermissionResourceBroadcasts: {Read: true},
+		})
+
+		_, err := d.svc.RequestApproval(ctx, req)
+		require.Error(t, err)
+		assert.IsType(t, &domain.PermissionError{}, err)
+	})
+}
+
+func TestBroadcastService_ReviewBroadcast(t *testing.T) {
+	approverPermissions := domain.UserPermissions{
+		domain.PermissionResourceBroadcasts:         {Read: true},
+		domain.PermissionResourceBroadcastApprovals: {Read: true, Write: true},
+	}
+	pendingBroadcast := func(requiredApprovals int, reviews ...domain.BroadcastReview) *domain.Broadcast {
+		b := testBroadcast("w1", "b1")
+		b.Status = domain.BroadcastStatusPendingApproval
+		b.Approval = &domain.BroadcastApproval{
+			RequestedBy:       "author",
+			RequiredApprovals: requiredApprovals,
+			Reviews:           reviews,
+		}
+		return b
+	}
+
+	t.Run("single approval approves the broadcast", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		req := &domain.ReviewBroadcastRequest{WorkspaceID: "w1", ID: "b1", Decision: domain.BroadcastReviewApproved}
+		authWithPermissions(d.authService, ctx, req.WorkspaceID, "reviewer1", approverPermissions)
+
+		d.repo.EXPECT().GetBroadcast(ctx, req.WorkspaceID, req.ID).Return(pendingBroadcast(1), nil)
+		d.repo.EXPECT().UpdateBroadcast(ctx, gomock.Any()).Return(nil)
+
+		broadcast, err := d.svc.ReviewBroadcast(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, domain.BroadcastStatusApproved, broadcast.Status)
+		require.Len(t, broadcast.Approval.Reviews, 1)
+		assert.Equal(t, "reviewer1", broadcast.Approval.Reviews[0].UserID)
+	})
+
+	t.Run("dual approval stays pending after the first approval", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		req := &domain.ReviewBroadcastRequest{WorkspaceID: "w1", ID: "b1", Decision: domain.BroadcastReviewApproved}
+		authWithPermissions(d.authService, ctx, req.WorkspaceID, "reviewer1", approverPermissions)
+
+		d.repo.EXPECT().GetBroadcast(ctx, req.WorkspaceID, req.ID).Return(pendingBroadcast(2), nil)
+		d.repo.EXPECT().UpdateBroadcast(ctx, gomock.Any()).Return(nil)
+
+		broadcast, err := d.svc.ReviewBroadcast(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, domain.BroadcastStatusPendingApproval, broadcast.Status)
+	})
+
+	t.Run("dual approval approves with a second approver", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		req := &domain.ReviewBroadcastRequest{WorkspaceID: "w1", ID: "b1", Decision: domain.BroadcastReviewApproved}
+		authWithPermissions(d.authService, ctx, req.WorkspaceID, "reviewer2", approverPermissions)
+
+		first := domain.BroadcastReview{UserID: "reviewer1", Decision: domain.BroadcastReviewApproved}
+		d.repo.EXPECT().GetBroadcast(ctx, req.WorkspaceID, req.ID).Return(pendingBroadcast(2, first), nil)
+		d.repo.EXPECT().UpdateBroadcast(ctx, gomock.Any()).Return(nil)
+
+		broadcast, err := d.svc.ReviewBroadcast(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, domain.BroadcastStatusApproved, broadcast.Status)
+	})
+
+	t.Run("same approver cannot sign off twice", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		req := &domain.ReviewBroadcastRequest{WorkspaceID: "w1", ID: "b1", Decision: domain.BroadcastReviewApproved}
+		authWithPermissions(d.authService, ctx, req.WorkspaceID, "reviewer1", approverPermissions)
+
+		first := domain.BroadcastReview{UserID: "reviewer1", Decision: domain.BroadcastReviewApproved}
+		d.repo.EXPECT().GetBroadcast(ctx, req.WorkspaceID, req.ID).Return(pendingBroadcast(2, first), nil)
+
+		_, err := d.svc.ReviewBroadcast(ctx, req)
+		require.Error(t, err)
+		assert.IsType(t, domain.ValidationError{}, err)
+	})
+
+	t.Run("requester cannot review their own broadcast", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		req := &domain.ReviewBroadcastRequest{WorkspaceID: "w1", ID: "b1", Decision: domain.BroadcastReviewApproved}
+		authWithPermissions(d.authService, ctx, req.WorkspaceID, "author", approverPermissions)
+
+		d.repo.EXPECT().GetBroadcast(ctx, req.WorkspaceID, req.ID).Return(pendingBroadcast(1), nil)
+
+		_, err := d.svc.ReviewBroadcast(ctx, req)
+		require.Error(t, err)
+		assert.IsType(t, domain.ValidationError{}, err)
+	})
+
+	t.Run("rejection sends the broadcast back to draft", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		req := &domain.ReviewBroadcastRequest{WorkspaceID: "w1", ID: "b1", Decision: domain.BroadcastReviewRejected, Comment: "Wrong unsubscribe link"}
+		authWithPermissions(d.authService, ctx, req.WorkspaceID, "reviewer1", approverPermissions)
+
+		d.repo.EXPECT().GetBroadcast(ctx, req.WorkspaceID, req.ID).Return(pendingBroadcast(1), nil)
+		d.repo.EXPECT().UpdateBroadcast(ctx, gomock.Any()).Return(nil)
+
+		broadcast, err := d.svc.ReviewBroadcast(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, domain.BroadcastStatusDraft, broadcast.Status)
+		require.Len(t, broadcast.Approval.Reviews, 1)
+		assert.Equal(t, "Wrong unsubscribe link", broadcast.Approval.Reviews[0].Comment)
+	})
+
+	t.Run("only broadcasts pending approval can be reviewed", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		req := &domain.ReviewBroadcastRequest{WorkspaceID: "w1", ID: "b1", Decision: domain.BroadcastReviewApproved}
+		authWithPermissions(d.authService, ctx, req.WorkspaceID, "reviewer1", approverPermissions)
+
+		d.repo.EXPECT().GetBroadcast(ctx, req.WorkspaceID, req.ID).Return(testBroadcast(req.WorkspaceID, req.ID), nil)
+
+		_, err := d.svc.ReviewBroadcast(ctx, req)
+		require.Error(t, err)
+		assert.IsType(t, domain.ValidationError{}, err)
+	})
+
+	t.Run("requires the broadcast approvals permission", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		req := &domain.ReviewBroadcastRequest{WorkspaceID: "w1", ID: "b1", Decision: domain.BroadcastReviewApproved}
+		authOK(d.authService, ctx, req.WorkspaceID)
+
+		_, err := d.svc.ReviewBroadcast(ctx, req)
+		require.Error(t, err)
+		assert.IsType(t, &domain.PermissionError{}, err)
+	})
+}
+
+func TestBroadcastService_ScheduleBroadcast_ApprovalGate(t *testing.T) {
+	workspace := &domain.Workspace{
+		ID: "w1",
+		Settings: domain.WorkspaceSettings{
+			MarketingEmailProviderID: "mkt",
+			BroadcastApproval:        &domain.BroadcastApprovalSettings{Enabled: true},
+		},
+		Integrations: domain.Integrations{
+			{ID: "mkt", Type: domain.IntegrationTypeEmail, EmailProvider: domain.EmailProvider{Kind: domain.EmailProviderKindSMTP, Senders: []domain.EmailSender{domain.NewEmailSender("from@example.com", "From")}}},
+		},
+	}
+
+	approvedBroadcast := func() *domain.Broadcast {
+		b := testBroadcast("w1", "b1")
+		b.Status = domain.BroadcastStatusApproved
+		b.Approval = &domain.BroadcastApproval{
+			RequestedBy:       "author",
+			RecipientCount:    5000,
+			RequiredApprovals: 1,
+			Reviews:           []domain.BroadcastReview{{UserID: "reviewer1", Decision: domain.BroadcastReviewApproved}},
+		}
+		return b
+	}
+
+	for _, status := range []domain.BroadcastStatus{domain.BroadcastStatusDraft, domain.BroadcastStatusPendingApproval} {
+		t.Run(string(status)+" broadcast is rejected", func(t *testing.T) {
+			d := setupBroadcastSvc(t)
+			defer d.ctrl.Finish()
+
+			ctx := context.Background()
+			req := &domain.ScheduleBroadcastRequest{WorkspaceID: "w1", ID: "b1", SendNow: true}
+			authOK(d.authService, ctx, req.WorkspaceID)
+			d.workspaceRepo.EXPECT().GetByID(ctx, req.WorkspaceID).Return(workspace, nil)
+
+			d.repo.EXPECT().WithTransaction(ctx, req.WorkspaceID, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, fn func(*sql.Tx) error) error { return fn(nil) },
+			)
+			bcast := testBroadcast(req.WorkspaceID, req.ID)
+			bcast.Status = status
+			d.repo.EXPECT().GetBroadcastTx(gomock.Any(), gomock.Any(), req.WorkspaceID, req.ID).Return(bcast, nil)
+
+			err := d.svc.ScheduleBroadcast(ctx, req)
+			require.ErrorIs(t, err, domain.ErrBroadcastApprovalRequired)
+		})
+	}
+
+	t.Run("approved broadcast is scheduled", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+		expectPublishedTemplates(d.templateSvc)
+
+		ctx := context.Background()
+		req := &domain.ScheduleBroadcastRequest{WorkspaceID: "w1", ID: "b1", SendNow: true}
+		authOK(d.authService, ctx, req.WorkspaceID)
+		d.workspaceRepo.EXPECT().GetByID(ctx, req.WorkspaceID).Return(workspace, nil)
+
+		d.repo.EXPECT().WithTransaction(ctx, req.WorkspaceID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, fn func(*sql.Tx) error) error { return fn(nil) },
+		)
+		d.repo.EXPECT().GetBroadcastTx(gomock.Any(), gomock.Any(), req.WorkspaceID, req.ID).Return(approvedBroadcast(), nil)
+		d.contactRepo.EXPECT().CountContactsForBroadcast(ctx, req.WorkspaceID, gomock.Any()).Return(5000, nil)
+		d.repo.EXPECT().UpdateBroadcastTx(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *sql.Tx, b *domain.Broadcast) error {
+				assert.Equal(t, domain.BroadcastStatusProcessing, b.Status)
+				return nil
+			},
+		)
+		d.eventBus.EXPECT().PublishWithAck(gomock.Any(), gomock.Any(), gomock.Any()).Do(
+			func(_ context.Context, _ domain.EventPayload, ack domain.EventAckCallback) { ack(nil) },
+		)
+
+		err := d.svc.ScheduleBroadcast(ctx, req)
+		require.NoError(t, err)
+	})
+
+	t.Run("approved broadcast checks the reviewed template versions", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		req := &domain.ScheduleBroadcastRequest{WorkspaceID: "w1", ID: "b1", SendNow: true}
+		authOK(d.authService, ctx, req.WorkspaceID)
+		d.workspaceRepo.EXPECT().GetByID(ctx, req.WorkspaceID).Return(workspace, nil)
+
+		d.repo.EXPECT().WithTransaction(ctx, req.WorkspaceID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, fn func(*sql.Tx) error) error { return fn(nil) },
+		)
+		bcast := approvedBroadcast()
+		bcast.Approval.TemplateVersions = map[string]int64{"tplA": 1}
+		d.repo.EXPECT().GetBroadcastTx(gomock.Any(), gomock.Any(), req.WorkspaceID, req.ID).Return(bcast, nil)
+		d.contactRepo.EXPECT().CountContactsForBroadcast(ctx, req.WorkspaceID, gomock.Any()).Return(5000, nil)
+		// A version published after the review is not looked at
+		d.templateSvc.EXPECT().GetTemplateByID(gomock.Any(), req.WorkspaceID, "tplA", int64(1)).Return(publishedTemplate("tplA"), nil)
+		d.repo.EXPECT().UpdateBroadcastTx(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		d.eventBus.EXPECT().PublishWithAck(gomock.Any(), gomock.Any(), gomock.Any()).Do(
+			func(_ context.Context, _ domain.EventPayload, ack domain.EventAckCallback) { ack(nil) },
+		)
+
+		err := d.svc.ScheduleBroadcast(ctx, req)
+		require.NoError(t, err)
+	})
+
+	t.Run("audience grown past the threshold goes back for review", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		req := &domain.ScheduleBroadcastRequest{WorkspaceID: "w1", ID: "b1", SendNow: true}
+		authOK(d.authService, ctx, req.WorkspaceID)
+		d.workspaceRepo.EXPECT().GetByID(ctx, req.WorkspaceID).Return(workspace, nil)
+
+		d.repo.EXPECT().WithTransaction(ctx, req.WorkspaceID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, fn func(*sql.Tx) error) error { return fn(nil) },
+		)
+		d.repo.EXPECT().GetBroadcastTx(gomock.Any(), gomock.Any(), req.WorkspaceID, req.ID).Return(approvedBroadcast(), nil)
+		d.contactRepo.EXPECT().CountContactsForBroadcast(ctx, req.WorkspaceID, gomock.Any()).Return(25000, nil)
+		d.repo.EXPECT().UpdateBroadcastTx(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *sql.Tx, b *domain.Broadcast) error {
+				assert.Equal(t, domain.BroadcastStatusPendingApproval, b.Status)
+				assert.Equal(t, 25000, b.Approval.RecipientCount)
+				assert.Equal(t, 2, b.Approval.RequiredApprovals)
+				require.Len(t, b.Approval.Reviews, 1)
+				return nil
+			},
+		)
+
+		err := d.svc.ScheduleBroadcast(ctx, req)
+		require.ErrorIs(t, err, domain.ErrBroadcastApprovalRequired)
+	})
+}
+
+func TestBroadcastService_ScheduleBroadcast_LintBlocksSend(t *testing.T) {
+	d := setupBroadcastSvc(t)
+	defer d.ctrl.Finish()
+
+	ctx := context.Background()
+	req := &domain.ScheduleBroadcastRequest{WorkspaceID: "w1", ID: "b1", SendNow: true}
+	authOK(d.authService, ctx, req.WorkspaceID)
+
+	workspace := &domain.Workspace{
+		ID:       "w1",
+		Settings: domain.WorkspaceSettings{MarketingEmailProviderID: "mkt"},
+		Integrations: domain.Integrations{
+			{ID: "mkt", Type: domain.IntegrationTypeEmail, EmailProvider: domain.EmailProvider{Kind: domain.EmailProviderKindSMTP, Senders: []domain.EmailSender{domain.NewEmailSender("from@example.com", "From")}}},
+		},
+	}
+	d.workspaceRepo.EXPECT().GetByID(ctx, req.WorkspaceID).Return(workspace, nil)
+	d.repo.EXPECT().WithTransaction(ctx, req.WorkspaceID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, fn func(*sql.Tx) error) error {
+			return fn(nil)
+		},
+	)
+
+	// The template has no unsubscribe link, which is an error for a marketing email
+	draft := testBroadcast(req.WorkspaceID, req.ID)
+	d.repo.EXPECT().GetBroadcastTx(gomock.Any(), gomock.Any(), req.WorkspaceID, req.ID).Return(draft, nil)
+	template := publishedTemplate("tplA")
+	source := `<mjml><mj-body><mj-section><mj-column><mj-text>Our spring collection is here.</mj-text></mj-column></mj-section></mj-body></mjml>`
+	template.Email.MjmlSource = &source
+	d.templateSvc.EXPECT().GetPublishedTemplate(ctx, req.WorkspaceID, "tplA").Return(template, nil)
+
+	err := d.svc.ScheduleBroadcast(ctx, req)
+	var lintErr *domain.ErrBroadcastLintFailed
+	require.ErrorAs(t, err, &lintErr)
+	assert.False(t, lintErr.Report.Passed)
+	assert.Contains(t, err.Error(), "template tplA")
+	assert.Equal(t, domain.BroadcastStatusDraft, draft.Status)
+}
+
+func TestBroadcastService_LintBroadcast(t *testing.T) {
+	t.Run("lints every variation with its subject", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		authOK(d.authService, ctx, "w1")
+
+		broadcast := testBroadcast("w1", "b1")
+		broadcast.TestSettings.Variations = []domain.BroadcastVariation{
+			{VariationName: "A", TemplateID: "tplA"},
+			{VariationName: "B", TemplateID: "tplB", Subject: "FREE MONEY, ACT NOW!!"},
+		}
+		d.repo.EXPECT().GetBroadcast(ctx, "w1", "b1").Return(broadcast, nil)
+		templateB := publishedTemplate("tplB")
+		d.templateSvc.EXPECT().GetPublishedTemplate(ctx, "w1", "tplA").Return(publishedTemplate("tplA"), nil)
+		d.templateSvc.EXPECT().GetPublishedTemplate(ctx, "w1", "tplB").Return(templateB, nil)
+
+		report, err := d.svc.LintBroadcast(ctx, "w1", "b1")
+		require.NoError(t, err)
+		assert.Equal(t, "b1", report.BroadcastID)
+		require.Len(t, report.Templates, 2)
+		assert.Equal(t, "tplA", report.Templates[0].TemplateID)
+		assert.True(t, report.Templates[0].Passed, "findings: %+v", report.Templates[0].Findings)
+		assert.Equal(t, "tplB", report.Templates[1].TemplateID)
+		assert.NotEmpty(t, report.Templates[1].Findings)
+		assert.False(t, report.Passed)
+		// The subject override does not leak into the template
+		assert.Equal(t, "Spring collection", templateB.Email.Subject)
+	})
+
+	t.Run("fails when a template cannot be loaded", func(t *testing.T) {
+		d := setupBroadcastSvc(t)
+		defer d.ctrl.Finish()
+
+		ctx := context.Background()
+		authOK(d.authService, ctx, "w1")
+
+		d.repo.EXPECT().GetBroadcast(ctx, "w1", "b1").Return(testBroadcast("w1", "b1"), nil)
+		d.templateSvc.EXPECT().GetPublishedTemplate(ctx, "w1", "tplA").Return(nil, &domain.ErrTemplateNotFound{Message: "template not found"})
+
+		report, err := d.svc.LintBroadcast(ctx, "w1", "b1")
+		require.Error(t, err)
+		assert.Nil(t, report)
+	})
+}
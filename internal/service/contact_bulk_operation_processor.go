@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// ContactBulkOperationProcessor applies bulk operations to contacts, batch after batch
+type ContactBulkOperationProcessor struct {
+	bulkRepo domain.ContactBulkOperationRepository
+	taskRepo domain.TaskRepository
+	logger   logger.Logger
+}
+
+// NewContactBulkOperationProcessor creates a new contact bulk operation processor
+func NewContactBulkOperationProcessor(
+	bulkRepo domain.ContactBulkOperationRepository,
+	taskRepo domain.TaskRepository,
+	logger logger.Logger,
+) *ContactBulkOperationProcessor {
+	return &ContactBulkOperationProcessor{
+		bulkRepo: bulkRepo,
+		taskRepo: taskRepo,
+		logger:   logger,
+	}
+}
+
+// CanProcess returns whether this processor can handle the given task type
+func (p *ContactBulkOperationProcessor) CanProcess(taskType string) bool {
+	return taskType == domain.TaskTypeBulkContactOperation
+}
+
+// Process applies the operation to the targeted contacts in email order. The last
+// processed email is saved after every batch so that a resumed task carries on
+// where it stopped, while contacts matching the target in the meantime are still included.
+func (p *ContactBulkOperationProcessor) Process(ctx context.Context, task *domain.Task, timeoutAt time.Time) (completed bool, err error) {
+	if task.State == nil || task.State.BulkContactOperation == nil {
+		return false, fmt.Errorf("task state missing BulkContactOperation data - task may not have been properly initialized")
+	}
+	state := task.State.BulkContactOperation
+
+	operation, err := p.bulkRepo.Get(ctx, task.WorkspaceID, state.OperationID)
+	if err != nil {
+		if errors.Is(err, domain.ErrBulkOperationNotFound) {
+			// Nothing left to apply, retrying would not help
+			p.logger.WithField("task_id", task.ID).Warn("Bulk operation not found, completing task")
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get bulk operation: %w", err)
+	}
+
+	if operation.Status == domain.BulkOperationStatusPending {
+		operation.Status = domain.BulkOperationStatusRunning
+		if err := p.bulkRepo.Update(ctx, task.WorkspaceID, operation); err != nil {
+			return false, fmt.Errorf("failed to update bulk operation: %w", err)
+		}
+	}
+
+	for {
+		// Check if we're approaching timeout
+		if time.Now().Add(5 * time.Second).After(timeoutAt) {
+			p.logger.WithField("task_id", task.ID).Info("Approaching timeout, pausing bulk operation")
+			if err := p.saveProgress(ctx, task, state, operation); err != nil {
+				return false, fmt.Errorf("failed to save progress: %w", err)
+			}
+			return false, nil
+		}
+
+		emails, err := p.bulkRepo.NextBatch(ctx, task.WorkspaceID, &operation.Target, state.Cursor, domain.BulkOperationBatchSize)
+		if err == nil && len(emails) == 0 {
+			break
+		}
+
+		var affected int64
+		if err == nil {
+			affected, err = p.bulkRepo.ApplyBatch(ctx, task.WorkspaceID, operation, emails)
+		}
+		if err != nil {
+			message := err.Error()
+			operation.Error = &message
+			if task.RetryCount+1 >= task.MaxRetries {
+				operation.Status = domain.BulkOperationStatusFailed
+			}
+			if saveErr := p.saveProgress(ctx, task, state, operation); saveErr != nil {
+				p.logger.WithField("error", saveErr.Error()).Warn("Failed to save progress (non-fatal)")
+			}
+			return false, fmt.Errorf("failed to apply bulk operation: %w", err)
+		}
+
+		state.Cursor = emails[len(emails)-1]
+		state.ProcessedCount += int64(len(emails))
+		state.AffectedCount += affected
+
+		if len(emails) < domain.BulkOperationBatchSize {
+			break
+		}
+	}
+
+	now := time.Now().UTC()
+	operation.Status = domain.BulkOperationStatusCompleted
+	operation.Error = nil
+	operation.CompletedAt = &now
+	task.Progress = 1
+	if err := p.saveProgress(ctx, task, state, operation); err != nil {
+		p.logger.WithField("error", err.Error()).Warn("Failed to save progress (non-fatal)")
+	}
+
+	p.logger.WithFields(map[string]interface{}{
+		"task_id":         task.ID,
+		"workspace_id":    task.WorkspaceID,
+		"operation_id":    operation.ID,
+		"operation":       operation.Operation,
+		"processed_count": state.ProcessedCount,
+		"affected_count":  state.AffectedCount,
+	}).Info("Contact bulk operation completed")
+
+	return true, nil
+}
+
+// saveProgress persists the cursor and counters in the task state and in the operation record
+func (p *ContactBulkOperationProcessor) saveProgress(ctx context.Context, task *domain.Task, state *domain.BulkContactOperationState, operation *domain.ContactBulkOperation) error {
+	// The total is counted when the operation is created and the target may grow since
+	if state.TotalCount > 0 && task.Progress < 1 {
+		task.Progress = float64(state.ProcessedCount) / float64(state.TotalCount)
+		if task.Progress > 0.99 {
+			task.Progress = 0.99
+		}
+	}
+	task.State.Message = fmt.Sprintf("Processed %d/%d contacts, %d changed", state.ProcessedCount, state.TotalCount, state.AffectedCount)
+
+	operation.ProcessedCount = state.ProcessedCount
+	operation.AffectedCount = state.AffectedCount
+	if err := p.bulkRepo.Update(ctx, task.WorkspaceID, operation); err != nil {
+		return fmt.Errorf("failed to update bulk operation: %w", err)
+	}
+
+	if err := p.taskRepo.SaveState(ctx, task.WorkspaceID, task.ID, task.Progress, task.State); err != nil {
+		return fmt.Errorf("failed to save task state: %w", err)
+	}
+	return nil
+}
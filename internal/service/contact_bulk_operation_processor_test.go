@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+)
+
+func newBulkOperationTask(cursor string, processed int64) *domain.Task {
+	return &domain.Task{
+		ID:          "task1",
+		WorkspaceID: "ws1",
+		Type:        domain.TaskTypeBulkContactOperation,
+		MaxRetries:  3,
+		State: &domain.TaskState{
+			BulkContactOperation: &domain.BulkContactOperationState{
+				OperationID:    "op1",
+				Cursor:         cursor,
+				TotalCount:     4,
+				ProcessedCount: processed,
+			},
+		},
+	}
+}
+
+func setupContactBulkOperationProcessorTest(t *testing.T) (*mocks.MockContactBulkOperationRepository, *mocks.MockTaskRepository, *pkgmocks.MockLogger, *ContactBulkOperationProcessor) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	mockRepo := mocks.NewMockContactBulkOperationRepository(ctrl)
+	mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+	mockLogger := pkgmocks.NewMockLogger(ctrl)
+	return mockRepo, mockTaskRepo, mockLogger, NewContactBulkOperationProcessor(mockRepo, mockTaskRepo, mockLogger)
+}
+
+func TestContactBulkOperationProcessor_CanProcess(t *testing.T) {
+	processor := NewContactBulkOperationProcessor(nil, nil, nil)
+	assert.True(t, processor.CanProcess(domain.TaskTypeBulkContactOperation))
+	assert.False(t, processor.CanProcess(domain.TaskTypeEraseContacts))
+}
+
+func TestContactBulkOperationProcessor_Process(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("resumes after the cursor and completes", func(t *testing.T) {
+		mockRepo, mockTaskRepo, mockLogger, processor := setupContactBulkOperationProcessorTest(t)
+
+		operation := &domain.ContactBulkOperation{
+			ID:        "op1",
+			Operation: domain.BulkOperationAddTags,
+			Target:    domain.BulkOperationTarget{SegmentID: "seg1"},
+			Params:    domain.BulkOperationParams{Tags: []string{"vip"}},
+			Status:    domain.BulkOperationStatusRunning,
+		}
+		task := newBulkOperationTask("b@example.com", 2)
+
+		mockRepo.EXPECT().Get(ctx, "ws1", "op1").Return(operation, nil)
+		mockRepo.EXPECT().NextBatch(ctx, "ws1", &operation.Target, "b@example.com", domain.BulkOperationBatchSize).
+			Return([]string{"c@example.com", "d@example.com"}, nil)
+		mockRepo.EXPECT().ApplyBatch(ctx, "ws1", operation, []string{"c@example.com", "d@example.com"}).Return(int64(1), nil)
+		mockRepo.EXPECT().Update(ctx, "ws1", operation).Return(nil)
+		mockTaskRepo.EXPECT().SaveState(ctx, "ws1", "task1", float64(1), task.State).Return(nil)
+		mockLogger.EXPECT().WithFields(gomock.Any()).Return(mockLogger)
+		mockLogger.EXPECT().Info("Contact bulk operation completed")
+
+		completed, err := processor.Process(ctx, task, time.Now().Add(time.Minute))
+
+		require.NoError(t, err)
+		assert.True(t, completed)
+		assert.Equal(t, domain.BulkOperationStatusCompleted, operation.Status)
+		assert.NotNil(t, operation.CompletedAt)
+		assert.Equal(t, int64(4), operation.ProcessedCount)
+		assert.Equal(t, int64(1), operation.AffectedCount)
+		assert.Equal(t, "d@example.com", task.State.BulkContactOperation.Cursor)
+		assert.Equal(t, "Processed 4/4 contacts, 1 changed", task.State.Message)
+	})
+
+	t.Run("marks a pending operation as running", func(t *testing.T) {
+		mockRepo, mockTaskRepo, mockLogger, processor := setupContactBulkOperationProcessorTest(t)
+
+		operation := &domain.ContactBulkOperation{ID: "op1", Operation: domain.BulkOperationDelete, Status: domain.BulkOperationStatusPending}
+		task := newBulkOperationTask("", 0)
+
+		mockRepo.EXPECT().Get(ctx, "ws1", "op1").Return(operation, nil)
+		mockRepo.EXPECT().Update(ctx, "ws1", operation).DoAndReturn(func(_ context.Context, _ string, op *domain.ContactBulkOperation) error {
+			assert.Equal(t, domain.BulkOperationStatusRunning, op.Status)
+			return nil
+		})
+		mockRepo.EXPECT().NextBatch(ctx, "ws1", gomock.Any(), "", domain.BulkOperationBatchSize).Return(nil, nil)
+		mockRepo.EXPECT().Update(ctx, "ws1", operation).Return(nil)
+		mockTaskRepo.EXPECT().SaveState(ctx, "ws1", "task1", float64(1), gomock.Any()).Return(nil)
+		mockLogger.EXPECT().WithFields(gomock.Any()).Return(mockLogger)
+		mockLogger.EXPECT().Info(gomock.Any())
+
+		completed, err := processor.Process(ctx, task, time.Now().Add(time.Minute))
+
+		require.NoError(t, err)
+		assert.True(t, completed)
+	})
+
+	t.Run("pauses near the timeout", func(t *testing.T) {
+		mockRepo, mockTaskRepo, mockLogger, processor := setupContactBulkOperationProcessorTest(t)
+
+		operation := &domain.ContactBulkOperation{ID: "op1", Operation: domain.BulkOperationDelete, Status: domain.BulkOperationStatusRunning}
+		task := newBulkOperationTask("b@example.com", 2)
+
+		mockRepo.EXPECT().Get(ctx, "ws1", "op1").Return(operation, nil)
+		mockRepo.EXPECT().Update(ctx, "ws1", operation).Return(nil)
+		mockTaskRepo.EXPECT().SaveState(ctx, "ws1", "task1", 0.5, task.State).Return(nil)
+		mockLogger.EXPECT().WithField("task_id", "task1").Return(mockLogger)
+		mockLogger.EXPECT().Info("Approaching timeout, pausing bulk operation")
+
+		completed, err := processor.Process(ctx, task, time.Now())
+
+		require.NoError(t, err)
+		assert.False(t, completed)
+		assert.Equal(t, domain.BulkOperationStatusRunning, operation.Status)
+	})
+
+	t.Run("records the error and keeps the cursor", func(t *testing.T) {
+		mockRepo, mockTaskRepo, _, processor := setupContactBulkOperationProcessorTest(t)
+
+		operation := &domain.ContactBulkOperation{ID: "op1", Operation: domain.BulkOperationDelete, Status: domain.BulkOperationStatusRunning}
+		task := newBulkOperationTask("b@example.com", 2)
+
+		mockRepo.EXPECT().Get(ctx, "ws1", "op1").Return(operation, nil)
+		mockRepo.EXPECT().NextBatch(ctx, "ws1", gomock.Any(), "b@example.com", domain.BulkOperationBatchSize).Return([]string{"c@example.com"}, nil)
+		mockRepo.EXPECT().ApplyBatch(ctx, "ws1", operation, []string{"c@example.com"}).Return(int64(0), errors.New("db error"))
+		mockRepo.EXPECT().Update(ctx, "ws1", operation).Return(nil)
+		mockTaskRepo.EXPECT().SaveState(ctx, "ws1", "task1", 0.5, task.State).Return(nil)
+
+		completed, err := processor.Process(ctx, task, time.Now().Add(time.Minute))
+
+		require.Error(t, err)
+		assert.False(t, completed)
+		assert.Equal(t, "b@example.com", task.State.BulkContactOperation.Cursor)
+		require.NotNil(t, operation.Error)
+		assert.Contains(t, *operation.Error, "db error")
+		assert.Equal(t, domain.BulkOperationStatusRunning, operation.Status)
+	})
+
+	t.Run("fails the operation on the last attempt", func(t *testing.T) {
+		mockRepo, mockTaskRepo, _, processor := setupContactBulkOperationProcessorTest(t)
+
+		operation := &domain.ContactBulkOperation{ID: "op1", Operation: domain.BulkOperationDelete, Status: domain.BulkOperationStatusRunning}
+		task := newBulkOperationTask("", 0)
+		task.RetryCount = 2
+
+		mockRepo.EXPECT().Get(ctx, "ws1", "op1").Return(operation, nil)
+		mockRepo.EXPECT().NextBatch(ctx, "ws1", gomock.Any(), "", domain.BulkOperationBatchSize).Return(nil, errors.New("db error"))
+		mockRepo.EXPECT().Update(ctx, "ws1", operation).Return(nil)
+		mockTaskRepo.EXPECT().SaveState(ctx, "ws1", "task1", gomock.Any(), task.State).Return(nil)
+
+		_, err := processor.Process(ctx, task, time.Now().Add(time.Minute))
+
+		require.Error(t, err)
+		assert.Equal(t, domain.BulkOperationStatusFailed, operation.Status)
+	})
+
+	t.Run("completes when the operation is gone", func(t *testing.T) {
+		mockRepo, _, mockLogger, processor := setupContactBulkOperationProcessorTest(t)
+
+		mockRepo.EXPECT().Get(ctx, "ws1", "op1").Return(nil, domain.ErrBulkOperationNotFound)
+		mockLogger.EXPECT().WithField("task_id", "task1").Return(mockLogger)
+		mockLogger.EXPECT().Warn(gomock.Any())
+
+		completed, err := processor.Process(ctx, newBulkOperationTask("", 0), time.Now().Add(time.Minute))
+
+		require.NoError(t, err)
+		assert.True(t, completed)
+	})
+
+	t.Run("missing state", func(t *testing.T) {
+		_, _, _, processor := setupContactBulkOperationProcessorTest(t)
+
+		_, err := processor.Process(ctx, &domain.Task{ID: "task1", State: &domain.TaskState{}}, time.Now().Add(time.Minute))
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// ContactBulkOperationService schedules bulk operations on contacts selected by a segment or a filter
+type ContactBulkOperationService struct {
+	bulkRepo      domain.ContactBulkOperationRepository
+	segmentRepo   domain.SegmentRepository
+	listRepo      domain.ListRepository
+	workspaceRepo domain.WorkspaceRepository
+	taskService   domain.TaskService
+	authService   domain.AuthService
+	logger        logger.Logger
+}
+
+// NewContactBulkOperationService creates a new contact bulk operation service
+func NewContactBulkOperationService(
+	bulkRepo domain.ContactBulkOperationRepository,
+	segmentRepo domain.SegmentRepository,
+	listRepo domain.ListRepository,
+	workspaceRepo domain.WorkspaceRepository,
+	taskService domain.TaskService,
+	authService domain.AuthService,
+	logger logger.Logger,
+) *ContactBulkOperationService {
+	return &ContactBulkOperationService{
+		bulkRepo:      bulkRepo,
+		segmentRepo:   segmentRepo,
+		listRepo:      listRepo,
+		workspaceRepo: workspaceRepo,
+		taskService:   taskService,
+		authService:   authService,
+		logger:        logger,
+	}
+}
+
+// CreateOperation counts the targeted contacts and, unless the request is a dry run,
+// records the operation and schedules the task applying it
+func (s *ContactBulkOperationService) CreateOperation(ctx context.Context, req *domain.CreateBulkOperationRequest) (*domain.CreateBulkOperationResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, domain.NewValidationError(err.Error())
+	}
+
+	// A dry run only reads contacts
+	permissionType := domain.PermissionTypeWrite
+	if req.DryRun {
+		permissionType = domain.PermissionTypeRead
+	}
+	ctx, user, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceContacts, permissionType)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkReferences(ctx, req); err != nil {
+		return nil, err
+	}
+
+	count, err := s.bulkRepo.CountTargets(ctx, req.WorkspaceID, &req.Target)
+	if err != nil {
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to count bulk operation targets: %v", err))
+		return nil, fmt.Errorf("failed to count contacts: %w", err)
+	}
+
+	if req.DryRun {
+		return &domain.CreateBulkOperationResponse{Count: count}, nil
+	}
+
+	now := time.Now().UTC()
+	operation := &domain.ContactBulkOperation{
+		ID:         uuid.New().String(),
+		Operation:  req.Operation,
+		Target:     req.Target,
+		Params:     req.Params,
+		Status:     domain.BulkOperationStatusPending,
+		TotalCount: count,
+		TaskID:     uuid.New().String(),
+		CreatedBy:  user.ID,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if err := s.bulkRepo.Create(ctx, req.WorkspaceID, operation); err != nil {
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to create bulk operation: %v", err))
+		return nil, fmt.Errorf("failed to create bulk operation: %w", err)
+	}
+
+	task := &domain.Task{
+		ID:          operation.TaskID,
+		WorkspaceID: req.WorkspaceID,
+		Type:        domain.TaskTypeBulkContactOperation,
+		Status:      domain.TaskStatusPending,
+		Progress:    0,
+		State: &domain.TaskState{
+			Message: fmt.Sprintf("Applying %s to %d contacts", req.Operation, count),
+			BulkContactOperation: &domain.BulkContactOperationState{
+				OperationID: operation.ID,
+				TotalCount:  count,
+			},
+		},
+		MaxRuntime: 300, // 5 minutes
+		MaxRetries: 3,
+	}
+
+	if err := s.taskService.CreateTask(ctx, req.WorkspaceID, task); err != nil {
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to create bulk operation task: %v", err))
+
+		message := fmt.Sprintf("failed to create task: %v", err)
+		operation.Status = domain.BulkOperationStatusFailed
+		operation.Error = &message
+		if updateErr := s.bulkRepo.Update(ctx, req.WorkspaceID, operation); updateErr != nil {
+			s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to mark bulk operation as failed: %v", updateErr))
+		}
+		return nil, fmt.Errorf("failed to create bulk operation task: %w", err)
+	}
+
+	s.logger.WithFields(map[string]interface{}{
+		"workspace_id": req.WorkspaceID,
+		"operation_id": operation.ID,
+		"operation":    operation.Operation,
+		"count":        count,
+	}).Info("Contact bulk operation scheduled")
+
+	return &domain.CreateBulkOperationResponse{Count: count, Operation: operation}, nil
+}
+
+// checkReferences checks the segment, list and attribute the request refers to
+func (s *ContactBulkOperationService) checkReferences(ctx context.Context, req *domain.CreateBulkOperationRequest) error {
+	if req.Target.SegmentID != "" {
+		if _, err := s.segmentRepo.GetSegmentByID(ctx, req.WorkspaceID, req.Target.SegmentID); err != nil {
+			return err
+		}
+	}
+
+	if req.Operation == domain.BulkOperationAddToList || req.Operation == domain.BulkOperationRemoveFromList {
+		if _, err := s.listRepo.GetListByID(ctx, req.WorkspaceID, req.Params.ListID); err != nil {
+			return err
+		}
+	}
+
+	if name, ok := req.Params.AttributeName(); ok && req.Operation == domain.BulkOperationUpdateField {
+		workspace, err := s.workspaceRepo.GetByID(ctx, req.WorkspaceID)
+		if err != nil {
+			return fmt.Errorf("failed to get workspace: %w", err)
+		}
+		definition, found := workspace.Settings.ContactAttributes.Get(name)
+		if !found {
+			return domain.NewValidationError(fmt.Sprintf("attribute '%s' is not defined", name))
+		}
+		value, err := definition.ValidateValue(req.Params.FieldValue)
+		if err != nil {
+			return domain.NewValidationError(err.Error())
+		}
+		req.Params.FieldValue = value
+	}
+
+	return nil
+}
+
+// GetOperation returns a bulk operation with its progress
+func (s *ContactBulkOperationService) GetOperation(ctx context.Context, req *domain.GetBulkOperationRequest) (*domain.ContactBulkOperation, error) {
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceContacts, domain.PermissionTypeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	operation, err := s.bulkRepo.Get(ctx, req.WorkspaceID, req.ID)
+	if err != nil {
+		if errors.Is(err, domain.ErrBulkOperationNotFound) {
+			return nil, err
+		}
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to get bulk operation: %v", err))
+		return nil, fmt.Errorf("failed to get bulk operation: %w", err)
+	}
+
+	return operation, nil
+}
+
+// ListOperations returns the latest bulk operations of the workspace
+func (s *ContactBulkOperationService) ListOperations(ctx context.Context, req *domain.ListBulkOperationsRequest) (*domain.ListBulkOperationsResponse, error) {
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceContacts, domain.PermissionTypeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	operations, err := s.bulkRepo.List(ctx, req.WorkspaceID, req.Limit)
+	if err != nil {
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to list bulk operations: %v", err))
+		return nil, fmt.Errorf("failed to list bulk operations: %w", err)
+	}
+
+	return &domain.ListBulkOperationsResponse{Operations: operations}, nil
+}
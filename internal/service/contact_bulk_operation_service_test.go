@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+)
+
+type contactBulkOperationServiceTest struct {
+	repo          *mocks.MockContactBulkOperationRepository
+	segmentRepo   *mocks.MockSegmentRepository
+	listRepo      *mocks.MockListRepository
+	workspaceRepo *mocks.MockWorkspaceRepository
+	taskService   *mocks.MockTaskService
+	authService   *mocks.MockAuthService
+	logger        *pkgmocks.MockLogger
+	service       *ContactBulkOperationService
+}
+
+func setupContactBulkOperationServiceTest(t *testing.T) *contactBulkOperationServiceTest {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	st := &contactBulkOperationServiceTest{
+		repo:          mocks.NewMockContactBulkOperationRepository(ctrl),
+		segmentRepo:   mocks.NewMockSegmentRepository(ctrl),
+		listRepo:      mocks.NewMockListRepository(ctrl),
+		workspaceRepo: mocks.NewMockWorkspaceRepository(ctrl),
+		taskService:   mocks.NewMockTaskService(ctrl),
+		authService:   mocks.NewMockAuthService(ctrl),
+		logger:        pkgmocks.NewMockLogger(ctrl),
+	}
+	st.logger.EXPECT().WithField(gomock.Any(), gomock.Any()).Return(st.logger).AnyTimes()
+	st.logger.EXPECT().WithFields(gomock.Any()).Return(st.logger).AnyTimes()
+	st.logger.EXPECT().Info(gomock.Any()).AnyTimes()
+	st.logger.EXPECT().Error(gomock.Any()).AnyTimes()
+	st.service = NewContactBulkOperationService(st.repo, st.segmentRepo, st.listRepo, st.workspaceRepo, st.taskService, st.authService, st.logger)
+	return st
+}
+
+func (st *contactBulkOperationServiceTest) expectAuth(ctx context.Context, permissions domain.ResourcePermissions) {
+	userWorkspace := &domain.UserWorkspace{
+		UserID:      "user1",
+		WorkspaceID: "ws1",
+		Permissions: domain.UserPermissions{domain.PermissionResourceContacts: permissions},
+	}
+	st.authService.EXPECT().AuthenticateUserForWorkspace(ctx, "ws1").Return(ctx, &domain.User{ID: "user1"}, userWorkspace, nil)
+}
+
+func TestContactBulkOperationService_CreateOperation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("dry run only counts the contacts", func(t *testing.T) {
+		st := setupContactBulkOperationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+		st.segmentRepo.EXPECT().GetSegmentByID(ctx, "ws1", "seg1").Return(&domain.Segment{ID: "seg1"}, nil)
+		st.repo.EXPECT().CountTargets(ctx, "ws1", &domain.BulkOperationTarget{SegmentID: "seg1"}).Return(int64(250000), nil)
+
+		response, err := st.service.CreateOperation(ctx, &domain.CreateBulkOperationRequest{
+			WorkspaceID: "ws1",
+			Operation:   domain.BulkOperationDelete,
+			Target:      domain.BulkOperationTarget{SegmentID: "seg1"},
+			DryRun:      true,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(250000), response.Count)
+		assert.Nil(t, response.Operation)
+	})
+
+	t.Run("records the operation and schedules its task", func(t *testing.T) {
+		st := setupContactBulkOperationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true, Write: true})
+		st.listRepo.EXPECT().GetListByID(ctx, "ws1", "news").Return(&domain.List{ID: "news"}, nil)
+		st.repo.EXPECT().CountTargets(ctx, "ws1", gomock.Any()).Return(int64(12), nil)
+
+		var created *domain.ContactBulkOperation
+		st.repo.EXPECT().Create(ctx, "ws1", gomock.Any()).DoAndReturn(func(_ context.Context, _ string, op *domain.ContactBulkOperation) error {
+			created = op
+			return nil
+		})
+		st.taskService.EXPECT().CreateTask(ctx, "ws1", gomock.Any()).DoAndReturn(func(_ context.Context, _ string, task *domain.Task) error {
+			assert.Equal(t, domain.TaskTypeBulkContactOperation, task.Type)
+			assert.Equal(t, created.TaskID, task.ID)
+			assert.Equal(t, created.ID, task.State.BulkContactOperation.OperationID)
+			assert.Equal(t, int64(12), task.State.BulkContactOperation.TotalCount)
+			return nil
+		})
+
+		response, err := st.service.CreateOperation(ctx, &domain.CreateBulkOperationRequest{
+			WorkspaceID: "ws1",
+			Operation:   domain.BulkOperationAddToList,
+			Target:      domain.BulkOperationTarget{Filter: &domain.GetContactsRequest{Country: "FR", Limit: 10}},
+			Params:      domain.BulkOperationParams{ListID: "news"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(12), response.Count)
+		require.NotNil(t, response.Operation)
+		assert.Equal(t, domain.BulkOperationStatusPending, response.Operation.Status)
+		assert.Equal(t, domain.ContactListStatusActive, response.Operation.Params.Status)
+		assert.Equal(t, "user1", response.Operation.CreatedBy)
+		assert.Equal(t, 0, response.Operation.Target.Filter.Limit)
+	})
+
+	t.Run("marks the operation as failed when the task cannot be created", func(t *testing.T) {
+		st := setupContactBulkOperationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true, Write: true})
+		st.repo.EXPECT().CountTargets(ctx, "ws1", gomock.Any()).Return(int64(3), nil)
+		st.repo.EXPECT().Create(ctx, "ws1", gomock.Any()).Return(nil)
+		st.taskService.EXPECT().CreateTask(ctx, "ws1", gomock.Any()).Return(errors.New("db error"))
+		st.repo.EXPECT().Update(ctx, "ws1", gomock.Any()).DoAndReturn(func(_ context.Context, _ string, op *domain.ContactBulkOperation) error {
+			assert.Equal(t, domain.BulkOperationStatusFailed, op.Status)
+			require.NotNil(t, op.Error)
+			return nil
+		})
+
+		_, err := st.service.CreateOperation(ctx, &domain.CreateBulkOperationRequest{
+			WorkspaceID: "ws1",
+			Operation:   domain.BulkOperationAddTags,
+			Target:      domain.BulkOperationTarget{Filter: &domain.GetContactsRequest{Language: "fr"}},
+			Params:      domain.BulkOperationParams{Tags: []string{"VIP"}},
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to create bulk operation task")
+	})
+
+	t.Run("validates attribute values against their definition", func(t *testing.T) {
+		st := setupContactBulkOperationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true, Write: true})
+		st.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(&domain.Workspace{
+			ID: "ws1",
+			Settings: domain.WorkspaceSettings{
+				ContactAttributes: domain.ContactAttributeDefinitions{
+					{Name: "plan", Type: domain.ContactAttributeTypeString, Label: "Plan", Options: []string{"free", "pro"}},
+				},
+			},
+		}, nil)
+
+		_, err := st.service.CreateOperation(ctx, &domain.CreateBulkOperationRequest{
+			WorkspaceID: "ws1",
+			Operation:   domain.BulkOperationUpdateField,
+			Target:      domain.BulkOperationTarget{Filter: &domain.GetContactsRequest{Country: "FR"}},
+			Params:      domain.BulkOperationParams{Field: "attributes.plan", FieldValue: "enterprise"},
+		})
+
+		var validationErr domain.ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		assert.Contains(t, validationErr.Message, "is not one of")
+	})
+
+	t.Run("unknown segment", func(t *testing.T) {
+		st := setupContactBulkOperationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true, Write: true})
+		st.segmentRepo.EXPECT().GetSegmentByID(ctx, "ws1", "seg1").Return(nil, &domain.ErrSegmentNotFound{Message: "segment not found: seg1"})
+
+		_, err := st.service.CreateOperation(ctx, &domain.CreateBulkOperationRequest{
+			WorkspaceID: "ws1",
+			Operation:   domain.BulkOperationDelete,
+			Target:      domain.BulkOperationTarget{SegmentID: "seg1"},
+		})
+
+		var notFound *domain.ErrSegmentNotFound
+		assert.True(t, errors.As(err, &notFound))
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		st := setupContactBulkOperationServiceTest(t)
+
+		_, err := st.service.CreateOperation(ctx, &domain.CreateBulkOperationRequest{
+			WorkspaceID: "ws1",
+			Operation:   domain.BulkOperationDelete,
+			Target:      domain.BulkOperationTarget{Filter: &domain.GetContactsRequest{}},
+		})
+
+		var validationErr domain.ValidationError
+		assert.True(t, errors.As(err, &validationErr))
+	})
+
+	t.Run("requires write permission", func(t *testing.T) {
+		st := setupContactBulkOperationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+
+		_, err := st.service.CreateOperation(ctx, &domain.CreateBulkOperationRequest{
+			WorkspaceID: "ws1",
+			Operation:   domain.BulkOperationDelete,
+			Target:      domain.BulkOperationTarget{SegmentID: "seg1"},
+		})
+
+		var permErr *domain.PermissionError
+		assert.True(t, errors.As(err, &permErr))
+	})
+}
+
+func TestContactBulkOperationService_GetOperation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns the operation", func(t *testing.T) {
+		st := setupContactBulkOperationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+		st.repo.EXPECT().Get(ctx, "ws1", "op1").Return(&domain.ContactBulkOperation{ID: "op1"}, nil)
+
+		operation, err := st.service.GetOperation(ctx, &domain.GetBulkOperationRequest{WorkspaceID: "ws1", ID: "op1"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "op1", operation.ID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		st := setupContactBulkOperationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+		st.repo.EXPECT().Get(ctx, "ws1", "op1").Return(nil, domain.ErrBulkOperationNotFound)
+
+		_, err := st.service.GetOperation(ctx, &domain.GetBulkOperationRequest{WorkspaceID: "ws1", ID: "op1"})
+
+		assert.ErrorIs(t, err, domain.ErrBulkOperationNotFound)
+	})
+}
+
+func TestContactBulkOperationService_ListOperations(t *testing.T) {
+	ctx := context.Background()
+	st := setupContactBulkOperationServiceTest(t)
+	st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+	st.repo.EXPECT().List(ctx, "ws1", 20).Return([]*domain.ContactBulkOperation{{ID: "op2"}, {ID: "op1"}}, nil)
+
+	response, err := st.service.ListOperations(ctx, &domain.ListBulkOperationsRequest{WorkspaceID: "ws1", Limit: 20})
+
+	require.NoError(t, err)
+	assert.Len(t, response.Operations, 2)
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// ContactErasureProcessor hard-erases contacts listed in erase_contacts tasks
+type ContactErasureProcessor struct {
+	contactRepo domain.ContactRepository
+	taskRepo    domain.TaskRepository
+	logger      logger.Logger
+}
+
+// NewContactErasureProcessor creates a new contact erasure processor
+func NewContactErasureProcessor(
+	contactRepo domain.ContactRepository,
+	taskRepo domain.TaskRepository,
+	logger logger.Logger,
+) *ContactErasureProcessor {
+	return &ContactErasureProcessor{
+		contactRepo: contactRepo,
+		taskRepo:    taskRepo,
+		logger:      logger,
+	}
+}
+
+// CanProcess returns whether this processor can handle the given task type
+func (p *ContactErasureProcessor) CanProcess(taskType string) bool {
+	return taskType == domain.TaskTypeEraseContacts
+}
+
+// Process erases contacts one at a time, saving its position so that a retried
+// or resumed task never erases the same contact twice
+func (p *ContactErasureProcessor) Process(ctx context.Context, task *domain.Task, timeoutAt time.Time) (completed bool, err error) {
+	if task.State == nil || task.State.EraseContacts == nil {
+		return false, fmt.Errorf("task state missing EraseContacts data - task may not have been properly initialized")
+	}
+	state := task.State.EraseContacts
+
+	for state.Position < len(state.Emails) {
+		// Check if we're approaching timeout
+		if time.Now().Add(5 * time.Second).After(timeoutAt) {
+			p.logger.WithField("task_id", task.ID).Info("Approaching timeout, pausing contact erasure")
+			if err := p.saveProgress(ctx, task, state); err != nil {
+				return false, fmt.Errorf("failed to save progress: %w", err)
+			}
+			return false, nil
+		}
+
+		found, err := p.contactRepo.EraseContact(ctx, task.WorkspaceID, state.Emails[state.Position])
+		if err != nil {
+			if saveErr := p.saveProgress(ctx, task, state); saveErr != nil {
+				p.logger.WithField("error", saveErr.Error()).Warn("Failed to save progress (non-fatal)")
+			}
+			return false, fmt.Errorf("failed to erase contact: %w", err)
+		}
+
+		if found {
+			state.ErasedCount++
+		} else {
+			state.NotFoundCount++
+		}
+		state.Position++
+	}
+
+	if err := p.saveProgress(ctx, task, state); err != nil {
+		p.logger.WithField("error", err.Error()).Warn("Failed to save progress (non-fatal)")
+	}
+
+	p.logger.WithFields(map[string]interface{}{
+		"task_id":         task.ID,
+		"workspace_id":    task.WorkspaceID,
+		"erased_count":    state.ErasedCount,
+		"not_found_count": state.NotFoundCount,
+	}).Info("Contact erasure completed")
+
+	return true, nil
+}
+
+// saveProgress persists the position and counters of the erasure
+func (p *ContactErasureProcessor) saveProgress(ctx context.Context, task *domain.Task, state *domain.EraseContactsState) error {
+	if len(state.Emails) > 0 {
+		task.Progress = float64(state.Position) / float64(len(state.Emails))
+	}
+	task.State.Message = fmt.Sprintf("Erased %d/%d contacts", state.Position, len(state.Emails))
+
+	if err := p.taskRepo.SaveState(ctx, task.WorkspaceID, task.ID, task.Progress, task.State); err != nil {
+		return fmt.Errorf("failed to save task state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+)
+
+func newEraseTask(emails []string, position int) *domain.Task {
+	return &domain.Task{
+		ID:          "task1",
+		WorkspaceID: "ws1",
+		Type:        domain.TaskTypeEraseContacts,
+		State: &domain.TaskState{
+			EraseContacts: &domain.EraseContactsState{Emails: emails, Position: position},
+		},
+	}
+}
+
+func TestContactErasureProcessor_CanProcess(t *testing.T) {
+	processor := NewContactErasureProcessor(nil, nil, nil)
+	assert.True(t, processor.CanProcess(domain.TaskTypeEraseContacts))
+	assert.False(t, processor.CanProcess("build_segment"))
+}
+
+func TestContactErasureProcessor_Process(t *testing.T) {
+	t.Run("erases every remaining contact", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockContactRepository(ctrl)
+		mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+		mockLogger := pkgmocks.NewMockLogger(ctrl)
+		processor := NewContactErasureProcessor(mockRepo, mockTaskRepo, mockLogger)
+
+		task := newEraseTask([]string{"done@example.com", "a@example.com", "b@example.com"}, 1)
+		gomock.InOrder(
+			mockRepo.EXPECT().EraseContact(gomock.Any(), "ws1", "a@example.com").Return(true, nil),
+			mockRepo.EXPECT().EraseContact(gomock.Any(), "ws1", "b@example.com").Return(false, nil),
+		)
+		mockTaskRepo.EXPECT().SaveState(gomock.Any(), "ws1", "task1", float64(1), task.State).Return(nil)
+		mockLogger.EXPECT().WithFields(gomock.Any()).Return(mockLogger)
+		mockLogger.EXPECT().Info("Contact erasure completed")
+
+		completed, err := processor.Process(context.Background(), task, time.Now().Add(time.Minute))
+
+		require.NoError(t, err)
+		assert.True(t, completed)
+		assert.Equal(t, 3, task.State.EraseContacts.Position)
+		assert.Equal(t, 1, task.State.EraseContacts.ErasedCount)
+		assert.Equal(t, 1, task.State.EraseContacts.NotFoundCount)
+		assert.Equal(t, "Erased 3/3 contacts", task.State.Message)
+	})
+
+	t.Run("pauses near the timeout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockContactRepository(ctrl)
+		mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+		mockLogger := pkgmocks.NewMockLogger(ctrl)
+		processor := NewContactErasureProcessor(mockRepo, mockTaskRepo, mockLogger)
+
+		task := newEraseTask([]string{"a@example.com", "b@example.com"}, 0)
+		mockLogger.EXPECT().WithField("task_id", "task1").Return(mockLogger)
+		mockLogger.EXPECT().Info("Approaching timeout, pausing contact erasure")
+		mockTaskRepo.EXPECT().SaveState(gomock.Any(), "ws1", "task1", float64(0), task.State).Return(nil)
+
+		completed, err := processor.Process(context.Background(), task, time.Now())
+
+		require.NoError(t, err)
+		assert.False(t, completed)
+		assert.Equal(t, 0, task.State.EraseContacts.Position)
+	})
+
+	t.Run("keeps progress when an erasure fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockContactRepository(ctrl)
+		mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+		mockLogger := pkgmocks.NewMockLogger(ctrl)
+		processor := NewContactErasureProcessor(mockRepo, mockTaskRepo, mockLogger)
+
+		task := newEraseTask([]string{"a@example.com", "b@example.com"}, 0)
+		mockRepo.EXPECT().EraseContact(gomock.Any(), "ws1", "a@example.com").Return(true, nil)
+		mockRepo.EXPECT().EraseContact(gomock.Any(), "ws1", "b@example.com").Return(false, errors.New("db error"))
+		mockTaskRepo.EXPECT().SaveState(gomock.Any(), "ws1", "task1", 0.5, task.State).Return(nil)
+
+		completed, err := processor.Process(context.Background(), task, time.Now().Add(time.Minute))
+
+		require.Error(t, err)
+		assert.False(t, completed)
+		assert.Equal(t, 1, task.State.EraseContacts.Position)
+	})
+
+	t.Run("requires erase state", func(t *testing.T) {
+		processor := NewContactErasureProcessor(nil, nil, nil)
+
+		_, err := processor.Process(context.Background(), &domain.Task{State: &domain.TaskState{}}, time.Now().Add(time.Minute))
+
+		assert.Error(t, err)
+	})
+}
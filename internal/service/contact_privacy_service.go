@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// ContactPrivacyService handles GDPR data subject access and erasure requests
+type ContactPrivacyService struct {
+	contactRepo domain.ContactRepository
+	taskService domain.TaskService
+	authService domain.AuthService
+	logger      logger.Logger
+}
+
+// NewContactPrivacyService creates a new contact privacy service
+func NewContactPrivacyService(
+	contactRepo domain.ContactRepository,
+	taskService domain.TaskService,
+	authService domain.AuthService,
+	logger logger.Logger,
+) *ContactPrivacyService {
+	return &ContactPrivacyService{
+		contactRepo: contactRepo,
+		taskService: taskService,
+		authService: authService,
+		logger:      logger,
+	}
+}
+
+// ExportContactData returns everything stored about a contact
+func (s *ContactPrivacyService) ExportContactData(ctx context.Context, req *domain.ExportContactDataRequest) (*domain.ContactDataExport, error) {
+	ctx, _, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Check permission for reading contacts
+	if !userWorkspace.HasPermission(domain.PermissionResourceContacts, domain.PermissionTypeRead) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceContacts,
+			domain.PermissionTypeRead,
+			"Insufficient permissions: read access to contacts required",
+		)
+	}
+
+	export, err := s.contactRepo.ExportContactData(ctx, req.WorkspaceID, req.Email)
+	if err != nil {
+		if errors.Is(err, domain.ErrContactNotFound) {
+			return nil, err
+		}
+		s.logger.WithField("email", req.Email).Error(fmt.Sprintf("Failed to export contact data: %v", err))
+		return nil, fmt.Errorf("failed to export contact data: %w", err)
+	}
+
+	return export, nil
+}
+
+// EraseContacts schedules a task that hard-erases the given contacts
+func (s *ContactPrivacyService) EraseContacts(ctx context.Context, req *domain.EraseContactsRequest) (*domain.Task, error) {
+	if err := req.Validate(); err != nil {
+		return nil, domain.NewValidationError(err.Error())
+	}
+
+	ctx, _, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Check permission for writing contacts
+	if !userWorkspace.HasPermission(domain.PermissionResourceContacts, domain.PermissionTypeWrite) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceContacts,
+			domain.PermissionTypeWrite,
+			"Insufficient permissions: write access to contacts required",
+		)
+	}
+
+	task := &domain.Task{
+		ID:          uuid.New().String(),
+		WorkspaceID: req.WorkspaceID,
+		Type:        domain.TaskTypeEraseContacts,
+		Status:      domain.TaskStatusPending,
+		Progress:    0,
+		State: &domain.TaskState{
+			Message: fmt.Sprintf("Erasing %d contacts", len(req.Emails)),
+			EraseContacts: &domain.EraseContactsState{
+				Emails: req.Emails,
+			},
+		},
+		MaxRuntime: 300, // 5 minutes
+		MaxRetries: 3,
+	}
+
+	if err := s.taskService.CreateTask(ctx, req.WorkspaceID, task); err != nil {
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to create erase contacts task: %v", err))
+		return nil, fmt.Errorf("failed to create erase contacts task: %w", err)
+	}
+
+	s.logger.WithFields(map[string]interface{}{
+		"workspace_id": req.WorkspaceID,
+		"task_id":      task.ID,
+		"count":        len(req.Emails),
+	}).Info("Contact erasure scheduled")
+
+	return task, nil
+}
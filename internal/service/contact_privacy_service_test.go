@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+)
+
+func TestContactPrivacyService_ExportContactData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockContactRepository(ctrl)
+	mockTaskService := mocks.NewMockTaskService(ctrl)
+	mockAuthService := mocks.NewMockAuthService(ctrl)
+	mockLogger := pkgmocks.NewMockLogger(ctrl)
+	service := NewContactPrivacyService(mockRepo, mockTaskService, mockAuthService, mockLogger)
+
+	ctx := context.Background()
+	req := &domain.ExportContactDataRequest{WorkspaceID: "ws1", Email: "john@example.com", Format: domain.ContactDataExportFormatJSON}
+	reader := &domain.UserWorkspace{
+		UserID:      "user1",
+		WorkspaceID: "ws1",
+		Permissions: domain.UserPermissions{domain.PermissionResourceContacts: {Read: true}},
+	}
+
+	t.Run("returns the export", func(t *testing.T) {
+		export := &domain.ContactDataExport{Email: "john@example.com"}
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, "ws1").Return(ctx, &domain.User{}, reader, nil)
+		mockRepo.EXPECT().ExportContactData(ctx, "ws1", "john@example.com").Return(export, nil)
+
+		result, err := service.ExportContactData(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, export, result)
+	})
+
+	t.Run("requires read permission", func(t *testing.T) {
+		noAccess := &domain.UserWorkspace{UserID: "user1", WorkspaceID: "ws1", Permissions: domain.UserPermissions{}}
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, "ws1").Return(ctx, &domain.User{}, noAccess, nil)
+
+		_, err := service.ExportContactData(ctx, req)
+
+		var permErr *domain.PermissionError
+		assert.True(t, errors.As(err, &permErr))
+	})
+
+	t.Run("passes not found through", func(t *testing.T) {
+		notFound := fmt.Errorf("%w: john@example.com", domain.ErrContactNotFound)
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, "ws1").Return(ctx, &domain.User{}, reader, nil)
+		mockRepo.EXPECT().ExportContactData(ctx, "ws1", "john@example.com").Return(nil, notFound)
+
+		_, err := service.ExportContactData(ctx, req)
+
+		assert.Equal(t, notFound, err)
+	})
+
+	t.Run("logs repository errors", func(t *testing.T) {
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, "ws1").Return(ctx, &domain.User{}, reader, nil)
+		mockRepo.EXPECT().ExportContactData(ctx, "ws1", "john@example.com").Return(nil, errors.New("db error"))
+		mockLogger.EXPECT().WithField("email", "john@example.com").Return(mockLogger)
+		mockLogger.EXPECT().Error("Failed to export contact data: db error")
+
+		_, err := service.ExportContactData(ctx, req)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to export contact data")
+	})
+}
+
+func TestContactPrivacyService_EraseContacts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockContactRepository(ctrl)
+	mockTaskService := mocks.NewMockTaskService(ctrl)
+	mockAuthService := mocks.NewMockAuthService(ctrl)
+	mockLogger := pkgmocks.NewMockLogger(ctrl)
+	service := NewContactPrivacyService(mockRepo, mockTaskService, mockAuthService, mockLogger)
+
+	ctx := context.Background()
+	writer := &domain.UserWorkspace{
+		UserID:      "user1",
+		WorkspaceID: "ws1",
+		Permissions: domain.UserPermissions{domain.PermissionResourceContacts: {Read: true, Write: true}},
+	}
+
+	t.Run("creates an erase task", func(t *testing.T) {
+		req := &domain.EraseContactsRequest{WorkspaceID: "ws1", Emails: []string{"John@example.com", "john@example.com"}}
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, "ws1").Return(ctx, &domain.User{}, writer, nil)
+		mockTaskService.EXPECT().CreateTask(ctx, "ws1", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, task *domain.Task) error {
+				assert.Equal(t, domain.TaskTypeEraseContacts, task.Type)
+				assert.Equal(t, domain.TaskStatusPending, task.Status)
+				require.NotNil(t, task.State.EraseContacts)
+				assert.Equal(t, []string{"john@example.com"}, task.State.EraseContacts.Emails)
+				return nil
+			})
+		mockLogger.EXPECT().WithFields(gomock.Any()).Return(mockLogger)
+		mockLogger.EXPECT().Info("Contact erasure scheduled")
+
+		task, err := service.EraseContacts(ctx, req)
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, task.ID)
+	})
+
+	t.Run("validates the request", func(t *testing.T) {
+		_, err := service.EraseContacts(ctx, &domain.EraseContactsRequest{WorkspaceID: "ws1"})
+
+		var validationErr domain.ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		assert.Equal(t, "emails is required", validationErr.Message)
+	})
+
+	t.Run("requires write permission", func(t *testing.T) {
+		reader := &domain.UserWorkspace{
+			UserID:      "user1",
+			WorkspaceID: "ws1",
+			Permissions: domain.UserPermissions{domain.PermissionResourceContacts: {Read: true}},
+		}
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, "ws1").Return(ctx, &domain.User{}, reader, nil)
+
+		_, err := service.EraseContacts(ctx, &domain.EraseContactsRequest{WorkspaceID: "ws1", Emails: []string{"john@example.com"}})
+
+		var permErr *domain.PermissionError
+		assert.True(t, errors.As(err, &permErr))
+	})
+
+	t.Run("fails when the task cannot be created", func(t *testing.T) {
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, "ws1").Return(ctx, &domain.User{}, writer, nil)
+		mockTaskService.EXPECT().CreateTask(ctx, "ws1", gomock.Any()).Return(errors.New("db error"))
+		mockLogger.EXPECT().WithField("workspace_id", "ws1").Return(mockLogger)
+		mockLogger.EXPECT().Error("Failed to create erase contacts task: db error")
+
+		_, err := service.EraseContacts(ctx, &domain.EraseContactsRequest{WorkspaceID: "ws1", Emails: []string{"john@example.com"}})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to create erase contacts task")
+	})
+}
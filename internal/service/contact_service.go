@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -184,6 +185,40 @@ func (s *ContactService) DeleteContact(ctx context.Context, workspaceID string,
 	return nil
 }
 
+// skipErasedContacts records an error for every erased contact of a chunk and returns the remaining contacts
+func (s *ContactService) skipErasedContacts(ctx context.Context, workspaceID string, chunk []*domain.Contact, indices []int, response *domain.BatchImportContactsResponse) ([]*domain.Contact, []int, error) {
+	emails := make([]string, len(chunk))
+	for i, contact := range chunk {
+		emails[i] = contact.Email
+	}
+
+	erasedEmails, err := s.repo.FilterErasedEmails(ctx, workspaceID, emails)
+	if err != nil {
+		return chunk, indices, err
+	}
+	erased := make(map[string]bool, len(erasedEmails))
+	for _, email := range erasedEmails {
+		erased[email] = true
+	}
+
+	remaining := make([]*domain.Contact, 0, len(chunk))
+	remainingIndices := make([]int, 0, len(chunk))
+	for i, contact := range chunk {
+		if erased[contact.Email] {
+			response.Operations = append(response.Operations, &domain.UpsertContactOperation{
+				Email:  contact.Email,
+				Action: domain.UpsertContactOperationError,
+				Error:  fmt.Sprintf("invalid contact at index %d: %v", indices[i], domain.ErrContactErased),
+			})
+			continue
+		}
+		remaining = append(remaining, contact)
+		remainingIndices = append(remainingIndices, indices[i])
+	}
+
+	return remaining, remainingIndices, nil
+}
+
 func (s *ContactService) BatchImportContacts(ctx context.Context, workspaceID string, contacts []*domain.Contact, listIDs []string) *domain.BatchImportContactsResponse {
 	response := &domain.BatchImportContactsResponse{
 		Operations: make([]*domain.UpsertContactOperation, 0, len(contacts)),
@@ -216,11 +251,28 @@ func (s *ContactService) BatchImportContacts(ctx context.Context, workspaceID st
 	validContacts := make([]*domain.Contact, 0, len(contacts))
 	validContactIndices := make([]int, 0, len(contacts))
 
+	// Only load the attribute schema when the batch actually carries attributes
+	var attributeDefinitions domain.ContactAttributeDefinitions
+	for _, contact := range contacts {
+		if len(contact.Attributes) > 0 {
+			attributeDefinitions, err = s.getAttributeDefinitions(ctx, workspaceID)
+			if err != nil {
+				response.Error = err.Error()
+				return response
+			}
+			break
+		}
+	}
+
 	for i, contact := range contacts {
 		// CreatedAt and UpdatedAt are optional - if not provided, DB will use CURRENT_TIMESTAMP
 		// If provided, the values will be used (allows historical imports)
 
-		if err := contact.Validate(); err != nil {
+		err := contact.Validate()
+		if err == nil && len(contact.Attributes) > 0 {
+			err = attributeDefinitions.ValidateAttributes(contact.Attributes)
+		}
+		if err != nil {
 			// Record validation error
 			operation := &domain.UpsertContactOperation{
 				Email:  contact.Email,
@@ -268,15 +320,24 @@ func (s *ContactService) BatchImportContacts(ctx context.Context, workspaceID st
 				chunkEnd = len(validContacts)
 			}
 			chunk := validContacts[chunkStart:chunkEnd]
+			chunkIndices := validContactIndices[chunkStart:chunkEnd]
 
 			bulkResults, err := s.repo.BulkUpsertContacts(ctx, workspaceID, chunk)
+			if errors.Is(err, domain.ErrContactErased) {
+				// The database refuses erased contacts, so retry the chunk without them
+				chunk, chunkIndices, err = s.skipErasedContacts(ctx, workspaceID, chunk, chunkIndices, response)
+				bulkResults = nil
+				if err == nil && len(chunk) > 0 {
+					bulkResults, err = s.repo.BulkUpsertContacts(ctx, workspaceID, chunk)
+				}
+			}
 			if err != nil {
 				s.logger.Error(fmt.Sprintf("Bulk upsert failed for chunk %d-%d: %v", chunkStart, chunkEnd-1, err))
-				for i := chunkStart; i < chunkEnd; i++ {
+				for i, contact := range chunk {
 					operation := &domain.UpsertContactOperation{
-						Email:  validContacts[i].Email,
+						Email:  contact.Email,
 						Action: domain.UpsertContactOperationError,
-						Error:  fmt.Sprintf("failed to upsert contact at index %d: %v", validContactIndices[i], err),
+						Error:  fmt.Sprintf("failed to upsert contact at index %d: %v", chunkIndices[i], err),
 					}
 					response.Operations = append(response.Operations, operation)
 				}
@@ -351,6 +412,19 @@ func (s *ContactService) UpsertContact(ctx context.Context, workspaceID string,
 		return operation
 	}
 
+	if len(contact.Attributes) > 0 {
+		definitions, err := s.getAttributeDefinitions(ctx, workspaceID)
+		if err == nil {
+			err = definitions.ValidateAttributes(contact.Attributes)
+		}
+		if err != nil {
+			operation.Action = domain.UpsertContactOperationError
+			operation.Error = err.Error()
+			s.logger.WithField("email", contact.Email).Error(fmt.Sprintf("Invalid contact attributes: %v", err))
+			return operation
+		}
+	}
+
 	// CreatedAt and UpdatedAt are optional - if not provided, DB will use CURRENT_TIMESTAMP
 	// If provided, the values will be used (allows historical imports)
 
@@ -393,3 +467,147 @@ func (s *ContactService) CountContacts(ctx context.Context, workspaceID string)
 
 	return count, nil
 }
+
+// getAttributeDefinitions loads the workspace contact attribute schema
+func (s *ContactService) getAttributeDefinitions(ctx context.Context, workspaceID string) (domain.ContactAttributeDefinitions, error) {
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+	return workspace.Settings.ContactAttributes, nil
+}
+
+// MigrateCustomFieldsToAttributes copies legacy custom_* columns into typed attributes
+func (s *ContactService) MigrateCustomFieldsToAttributes(ctx context.Context, req *domain.MigrateCustomFieldsRequest) (*domain.MigrateCustomFieldsResponse, error) {
+	ctx, _, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Check permission for writing contacts
+	if !userWorkspace.HasPermission(domain.PermissionResourceContacts, domain.PermissionTypeWrite) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceContacts,
+			domain.PermissionTypeWrite,
+			"Insufficient permissions: write access to contacts required",
+		)
+	}
+
+	definitions, err := s.getAttributeDefinitions(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(definitions); err != nil {
+		return nil, domain.NewValidationError(err.Error())
+	}
+
+	updated, err := s.repo.MigrateCustomFieldsToAttributes(ctx, req.WorkspaceID, req.Mapping, req.ClearLegacyFields)
+	if err != nil {
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to migrate custom fields: %v", err))
+		return nil, fmt.Errorf("failed to migrate custom fields: %w", err)
+	}
+
+	return &domain.MigrateCustomFieldsResponse{UpdatedContacts: updated}, nil
+}
+
+// MergeContacts merges a source contact into a target contact and deletes the source
+func (s *ContactService) MergeContacts(ctx context.Context, req *domain.MergeContactsRequest) (*domain.MergeContactsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, domain.NewValidationError(err.Error())
+	}
+
+	ctx, _, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Check permission for writing contacts
+	if !userWorkspace.HasPermission(domain.PermissionResourceContacts, domain.PermissionTypeWrite) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceContacts,
+			domain.PermissionTypeWrite,
+			"Insufficient permissions: write access to contacts required",
+		)
+	}
+
+	contact, err := s.repo.MergeContacts(ctx, req.WorkspaceID, req.SourceEmail, req.TargetEmail, req.Precedence)
+	if err != nil {
+		if errors.Is(err, domain.ErrContactNotFound) {
+			return nil, err
+		}
+		s.logger.WithFields(map[string]interface{}{
+			"source_email": req.SourceEmail,
+			"target_email": req.TargetEmail,
+		}).Error(fmt.Sprintf("Failed to merge contacts: %v", err))
+		return nil, fmt.Errorf("failed to merge contacts: %w", err)
+	}
+
+	return &domain.MergeContactsResponse{
+		Contact:    contact,
+		MergedFrom: req.SourceEmail,
+	}, nil
+}
+
+// FindDuplicateContacts suggests merges for contacts sharing an external_id or normalized email
+func (s *ContactService) FindDuplicateContacts(ctx context.Context, req *domain.FindDuplicateContactsRequest) (*domain.FindDuplicateContactsResponse, error) {
+	ctx, _, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Check permission for reading contacts
+	if !userWorkspace.HasPermission(domain.PermissionResourceContacts, domain.PermissionTypeRead) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceContacts,
+			domain.PermissionTypeRead,
+			"Insufficient permissions: read access to contacts required",
+		)
+	}
+
+	groups, err := s.repo.FindDuplicateContacts(ctx, req.WorkspaceID, req.Limit)
+	if err != nil {
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to find duplicate contacts: %v", err))
+		return nil, fmt.Errorf("failed to find duplicate contacts: %w", err)
+	}
+
+	return &domain.FindDuplicateContactsResponse{Duplicates: groups}, nil
+}
+
+// ChangeContactEmail moves a contact and its history to a new email address
+func (s *ContactService) ChangeContactEmail(ctx context.Context, req *domain.ChangeContactEmailRequest) (*domain.ChangeContactEmailResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, domain.NewValidationError(err.Error())
+	}
+
+	ctx, _, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Check permission for writing contacts
+	if !userWorkspace.HasPermission(domain.PermissionResourceContacts, domain.PermissionTypeWrite) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceContacts,
+			domain.PermissionTypeWrite,
+			"Insufficient permissions: write access to contacts required",
+		)
+	}
+
+	contact, err := s.repo.ChangeEmail(ctx, req.WorkspaceID, req.CurrentEmail, req.NewEmail)
+	if err != nil {
+		if errors.Is(err, domain.ErrContactNotFound) || errors.Is(err, domain.ErrContactEmailTaken) {
+			return nil, err
+		}
+		s.logger.WithFields(map[string]interface{}{
+			"current_email": req.CurrentEmail,
+			"new_email":     req.NewEmail,
+		}).Error(fmt.Sprintf("Failed to change contact email: %v", err))
+		return nil, fmt.Errorf("failed to change contact email: %w", err)
+	}
+
+	return &domain.ChangeContactEmailResponse{
+		Contact:       contact,
+		PreviousEmail: req.CurrentEmail,
+	}, nil
+}
@@ -11,6 +11,7 @@ import (
 	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // createContactServiceWithMocks creates a ContactService with all required mocks
@@ -639,6 +640,31 @@ func TestContactService_BatchImportContacts(t *testing.T) {
 		assert.True(t, foundErrorOp, "No error operation found in response")
 	})
 
+	t.Run("skips erased contacts", func(t *testing.T) {
+		contacts := []*domain.Contact{
+			{Email: "kept@example.com"},
+			{Email: "erased@example.com"},
+		}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, userWorkspace, nil)
+		mockRepo.EXPECT().BulkUpsertContacts(ctx, workspaceID, contacts).
+			Return(nil, fmt.Errorf("failed to execute bulk upsert: %w", domain.ErrContactErased))
+		mockRepo.EXPECT().FilterErasedEmails(ctx, workspaceID, []string{"kept@example.com", "erased@example.com"}).
+			Return([]string{"erased@example.com"}, nil)
+		mockRepo.EXPECT().BulkUpsertContacts(ctx, workspaceID, contacts[:1]).Return([]domain.BulkUpsertResult{
+			{Email: "kept@example.com", IsNew: true},
+		}, nil)
+
+		response := service.BatchImportContacts(ctx, workspaceID, contacts, nil)
+
+		require.Len(t, response.Operations, 2)
+		assert.Equal(t, "erased@example.com", response.Operations[0].Email)
+		assert.Equal(t, domain.UpsertContactOperationError, response.Operations[0].Action)
+		assert.Equal(t, "invalid contact at index 1: contact was erased and cannot be recreated", response.Operations[0].Error)
+		assert.Equal(t, "kept@example.com", response.Operations[1].Email)
+		assert.Equal(t, domain.UpsertContactOperationCreate, response.Operations[1].Action)
+	})
+
 	t.Run("successful mixed operations", func(t *testing.T) {
 		contacts := []*domain.Contact{
 			{Email: "new@example.com"},
@@ -1149,3 +1175,370 @@ func TestContactService_CountContacts(t *testing.T) {
 		assert.Contains(t, err.Error(), "failed to count contacts")
 	})
 }
+
+func TestContactService_UpsertContact_Attributes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepo, mockWorkspaceRepo, mockAuthService, _, _, _, _, mockLogger := createContactServiceWithMocks(ctrl)
+
+	ctx := context.Background()
+	workspaceID := "workspace123"
+	userWorkspace := &domain.UserWorkspace{
+		UserID:      "user123",
+		WorkspaceID: workspaceID,
+		Permissions: domain.UserPermissions{
+			domain.PermissionResourceContacts: {Read: true, Write: true},
+		},
+	}
+	workspace := &domain.Workspace{
+		ID: workspaceID,
+		Settings: domain.WorkspaceSettings{
+			ContactAttributes: domain.ContactAttributeDefinitions{
+				{Name: "plan", Type: domain.ContactAttributeTypeString, Label: "Plan", Options: []string{"free", "pro"}},
+			},
+		},
+	}
+
+	t.Run("valid attributes are normalized and saved", func(t *testing.T) {
+		contact := &domain.Contact{Email: "test@example.com", Attributes: domain.MapOfAny{"plan": " pro "}}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, userWorkspace, nil)
+		mockWorkspaceRepo.EXPECT().GetByID(ctx, workspaceID).Return(workspace, nil)
+		mockRepo.EXPECT().UpsertContact(ctx, workspaceID, contact).Return(true, nil)
+
+		result := service.UpsertContact(ctx, workspaceID, contact)
+		assert.Equal(t, domain.UpsertContactOperationCreate, result.Action)
+		assert.Equal(t, "pro", contact.Attributes["plan"])
+	})
+
+	t.Run("invalid attribute value is rejected", func(t *testing.T) {
+		contact := &domain.Contact{Email: "test@example.com", Attributes: domain.MapOfAny{"plan": "enterprise"}}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, userWorkspace, nil)
+		mockWorkspaceRepo.EXPECT().GetByID(ctx, workspaceID).Return(workspace, nil)
+		mockLogger.EXPECT().WithField("email", contact.Email).Return(mockLogger)
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		result := service.UpsertContact(ctx, workspaceID, contact)
+		assert.Equal(t, domain.UpsertContactOperationError, result.Action)
+		assert.Contains(t, result.Error, "is not one of")
+	})
+
+	t.Run("unknown attribute is rejected", func(t *testing.T) {
+		contact := &domain.Contact{Email: "test@example.com", Attributes: domain.MapOfAny{"tier": "gold"}}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, userWorkspace, nil)
+		mockWorkspaceRepo.EXPECT().GetByID(ctx, workspaceID).Return(workspace, nil)
+		mockLogger.EXPECT().WithField("email", contact.Email).Return(mockLogger)
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		result := service.UpsertContact(ctx, workspaceID, contact)
+		assert.Equal(t, domain.UpsertContactOperationError, result.Action)
+		assert.Contains(t, result.Error, "unknown contact attribute")
+	})
+}
+
+func TestContactService_MigrateCustomFieldsToAttributes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepo, mockWorkspaceRepo, mockAuthService, _, _, _, _, mockLogger := createContactServiceWithMocks(ctrl)
+
+	ctx := context.Background()
+	workspaceID := "workspace123"
+	userWorkspace := &domain.UserWorkspace{
+		UserID:      "user123",
+		WorkspaceID: workspaceID,
+		Permissions: domain.UserPermissions{
+			domain.PermissionResourceContacts: {Read: true, Write: true},
+		},
+	}
+	workspace := &domain.Workspace{
+		ID: workspaceID,
+		Settings: domain.WorkspaceSettings{
+			ContactAttributes: domain.ContactAttributeDefinitions{
+				{Name: "plan", Type: domain.ContactAttributeTypeString, Label: "Plan"},
+			},
+		},
+	}
+	mapping := map[string]string{"custom_string_1": "plan"}
+
+	t.Run("success", func(t *testing.T) {
+		req := &domain.MigrateCustomFieldsRequest{WorkspaceID: workspaceID, Mapping: mapping, ClearLegacyFields: true}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, userWorkspace, nil)
+		mockWorkspaceRepo.EXPECT().GetByID(ctx, workspaceID).Return(workspace, nil)
+		mockRepo.EXPECT().MigrateCustomFieldsToAttributes(ctx, workspaceID, mapping, true).Return(int64(12), nil)
+
+		resp, err := service.MigrateCustomFieldsToAttributes(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, int64(12), resp.UpdatedContacts)
+	})
+
+	t.Run("insufficient permissions", func(t *testing.T) {
+		req := &domain.MigrateCustomFieldsRequest{WorkspaceID: workspaceID, Mapping: mapping}
+		readOnly := &domain.UserWorkspace{
+			UserID:      "user123",
+			WorkspaceID: workspaceID,
+			Permissions: domain.UserPermissions{
+				domain.PermissionResourceContacts: {Read: true, Write: false},
+			},
+		}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, readOnly, nil)
+
+		resp, err := service.MigrateCustomFieldsToAttributes(ctx, req)
+		assert.Nil(t, resp)
+		var permErr *domain.PermissionError
+		assert.ErrorAs(t, err, &permErr)
+	})
+
+	t.Run("invalid mapping", func(t *testing.T) {
+		req := &domain.MigrateCustomFieldsRequest{WorkspaceID: workspaceID, Mapping: map[string]string{"custom_number_1": "plan"}}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, userWorkspace, nil)
+		mockWorkspaceRepo.EXPECT().GetByID(ctx, workspaceID).Return(workspace, nil)
+
+		resp, err := service.MigrateCustomFieldsToAttributes(ctx, req)
+		assert.Nil(t, resp)
+		var validationErr domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, err.Error(), "does not match")
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		req := &domain.MigrateCustomFieldsRequest{WorkspaceID: workspaceID, Mapping: mapping}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, userWorkspace, nil)
+		mockWorkspaceRepo.EXPECT().GetByID(ctx, workspaceID).Return(workspace, nil)
+		mockRepo.EXPECT().MigrateCustomFieldsToAttributes(ctx, workspaceID, mapping, false).Return(int64(0), errors.New("db error"))
+		mockLogger.EXPECT().WithField("workspace_id", workspaceID).Return(mockLogger)
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		resp, err := service.MigrateCustomFieldsToAttributes(ctx, req)
+		assert.Nil(t, resp)
+		assert.Contains(t, err.Error(), "db error")
+	})
+}
+
+func TestContactService_MergeContacts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepo, _, mockAuthService, _, _, _, _, mockLogger := createContactServiceWithMocks(ctrl)
+
+	ctx := context.Background()
+	workspaceID := "workspace123"
+	userWorkspace := &domain.UserWorkspace{
+		UserID:      "user123",
+		WorkspaceID: workspaceID,
+		Permissions: domain.UserPermissions{
+			domain.PermissionResourceContacts: {Read: true, Write: true},
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		req := &domain.MergeContactsRequest{WorkspaceID: workspaceID, SourceEmail: "Old@example.com", TargetEmail: "new@example.com"}
+		merged := &domain.Contact{Email: "new@example.com"}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, userWorkspace, nil)
+		mockRepo.EXPECT().MergeContacts(ctx, workspaceID, "old@example.com", "new@example.com", domain.ContactMergePrecedenceTarget).Return(merged, nil)
+
+		resp, err := service.MergeContacts(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, merged, resp.Contact)
+		assert.Equal(t, "old@example.com", resp.MergedFrom)
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		req := &domain.MergeContactsRequest{WorkspaceID: workspaceID, SourceEmail: "a@example.com", TargetEmail: "A@example.com"}
+
+		resp, err := service.MergeContacts(ctx, req)
+		assert.Nil(t, resp)
+		var validationErr domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("insufficient permissions", func(t *testing.T) {
+		req := &domain.MergeContactsRequest{WorkspaceID: workspaceID, SourceEmail: "old@example.com", TargetEmail: "new@example.com"}
+		readOnly := &domain.UserWorkspace{
+			UserID:      "user123",
+			WorkspaceID: workspaceID,
+			Permissions: domain.UserPermissions{
+				domain.PermissionResourceContacts: {Read: true, Write: false},
+			},
+		}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, readOnly, nil)
+
+		resp, err := service.MergeContacts(ctx, req)
+		assert.Nil(t, resp)
+		var permErr *domain.PermissionError
+		assert.ErrorAs(t, err, &permErr)
+	})
+
+	t.Run("contact not found is returned as is", func(t *testing.T) {
+		req := &domain.MergeContactsRequest{WorkspaceID: workspaceID, SourceEmail: "old@example.com", TargetEmail: "new@example.com"}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, userWorkspace, nil)
+		mockRepo.EXPECT().MergeContacts(ctx, workspaceID, "old@example.com", "new@example.com", domain.ContactMergePrecedenceTarget).
+			Return(nil, fmt.Errorf("source %w: old@example.com", domain.ErrContactNotFound))
+
+		resp, err := service.MergeContacts(ctx, req)
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, domain.ErrContactNotFound)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		req := &domain.MergeContactsRequest{WorkspaceID: workspaceID, SourceEmail: "old@example.com", TargetEmail: "new@example.com", Precedence: domain.ContactMergePrecedenceSource}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, userWorkspace, nil)
+		mockRepo.EXPECT().MergeContacts(ctx, workspaceID, "old@example.com", "new@example.com", domain.ContactMergePrecedenceSource).
+			Return(nil, errors.New("db error"))
+		mockLogger.EXPECT().WithFields(gomock.Any()).Return(mockLogger)
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		resp, err := service.MergeContacts(ctx, req)
+		assert.Nil(t, resp)
+		assert.Contains(t, err.Error(), "failed to merge contacts")
+	})
+}
+
+func TestContactService_FindDuplicateContacts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepo, _, mockAuthService, _, _, _, _, mockLogger := createContactServiceWithMocks(ctrl)
+
+	ctx := context.Background()
+	workspaceID := "workspace123"
+	userWorkspace := &domain.UserWorkspace{
+		UserID:      "user123",
+		WorkspaceID: workspaceID,
+		Permissions: domain.UserPermissions{
+			domain.PermissionResourceContacts: {Read: true, Write: false},
+		},
+	}
+	req := &domain.FindDuplicateContactsRequest{WorkspaceID: workspaceID, Limit: 50}
+
+	t.Run("success", func(t *testing.T) {
+		groups := []*domain.ContactDuplicateGroup{
+			{Reason: domain.ContactDuplicateReasonExternalID, Key: "user-1", Emails: []string{"new@example.com", "old@example.com"}, SuggestedTarget: "new@example.com"},
+		}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, userWorkspace, nil)
+		mockRepo.EXPECT().FindDuplicateContacts(ctx, workspaceID, 50).Return(groups, nil)
+
+		resp, err := service.FindDuplicateContacts(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, groups, resp.Duplicates)
+	})
+
+	t.Run("insufficient permissions", func(t *testing.T) {
+		noAccess := &domain.UserWorkspace{
+			UserID:      "user123",
+			WorkspaceID: workspaceID,
+			Permissions: domain.UserPermissions{},
+		}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, noAccess, nil)
+
+		resp, err := service.FindDuplicateContacts(ctx, req)
+		assert.Nil(t, resp)
+		var permErr *domain.PermissionError
+		assert.ErrorAs(t, err, &permErr)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, userWorkspace, nil)
+		mockRepo.EXPECT().FindDuplicateContacts(ctx, workspaceID, 50).Return(nil, errors.New("db error"))
+		mockLogger.EXPECT().WithField("workspace_id", workspaceID).Return(mockLogger)
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		resp, err := service.FindDuplicateContacts(ctx, req)
+		assert.Nil(t, resp)
+		assert.Contains(t, err.Error(), "db error")
+	})
+}
+
+func TestContactService_ChangeContactEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, mockRepo, _, mockAuthService, _, _, _, _, mockLogger := createContactServiceWithMocks(ctrl)
+
+	ctx := context.Background()
+	workspaceID := "workspace123"
+	userWorkspace := &domain.UserWorkspace{
+		UserID:      "user123",
+		WorkspaceID: workspaceID,
+		Permissions: domain.UserPermissions{
+			domain.PermissionResourceContacts: {Read: true, Write: true},
+		},
+	}
+	newRequest := func() *domain.ChangeContactEmailRequest {
+		return &domain.ChangeContactEmailRequest{WorkspaceID: workspaceID, CurrentEmail: "Old@example.com", NewEmail: "new@example.com"}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		contact := &domain.Contact{Email: "new@example.com"}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, userWorkspace, nil)
+		mockRepo.EXPECT().ChangeEmail(ctx, workspaceID, "old@example.com", "new@example.com").Return(contact, nil)
+
+		resp, err := service.ChangeContactEmail(ctx, newRequest())
+		require.NoError(t, err)
+		assert.Equal(t, contact, resp.Contact)
+		assert.Equal(t, "old@example.com", resp.PreviousEmail)
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		req := newRequest()
+		req.NewEmail = "invalid"
+
+		resp, err := service.ChangeContactEmail(ctx, req)
+		assert.Nil(t, resp)
+		var validationErr domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("insufficient permissions", func(t *testing.T) {
+		readOnly := &domain.UserWorkspace{
+			UserID:      "user123",
+			WorkspaceID: workspaceID,
+			Permissions: domain.UserPermissions{
+				domain.PermissionResourceContacts: {Read: true, Write: false},
+			},
+		}
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, readOnly, nil)
+
+		resp, err := service.ChangeContactEmail(ctx, newRequest())
+		assert.Nil(t, resp)
+		var permErr *domain.PermissionError
+		assert.ErrorAs(t, err, &permErr)
+	})
+
+	t.Run("email taken is returned as is", func(t *testing.T) {
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, userWorkspace, nil)
+		mockRepo.EXPECT().ChangeEmail(ctx, workspaceID, "old@example.com", "new@example.com").
+			Return(nil, fmt.Errorf("%w: new@example.com", domain.ErrContactEmailTaken))
+
+		resp, err := service.ChangeContactEmail(ctx, newRequest())
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, domain.ErrContactEmailTaken)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{}, userWorkspace, nil)
+		mockRepo.EXPECT().ChangeEmail(ctx, workspaceID, "old@example.com", "new@example.com").
+			Return(nil, errors.New("db error"))
+		mockLogger.EXPECT().WithFields(gomock.Any()).Return(mockLogger)
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		resp, err := service.ChangeContactEmail(ctx, newRequest())
+		assert.Nil(t, resp)
+		assert.Contains(t, err.Error(), "failed to change contact email")
+	})
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// ContactTagService manages contact tags
+type ContactTagService struct {
+	tagRepo      domain.ContactTagRepository
+	segmentRepo  domain.SegmentRepository
+	authService  domain.AuthService
+	queryBuilder *QueryBuilder
+	logger       logger.Logger
+}
+
+// NewContactTagService creates a new contact tag service
+func NewContactTagService(
+	tagRepo domain.ContactTagRepository,
+	segmentRepo domain.SegmentRepository,
+	authService domain.AuthService,
+	logger logger.Logger,
+) *ContactTagService {
+	return &ContactTagService{
+		tagRepo:      tagRepo,
+		segmentRepo:  segmentRepo,
+		authService:  authService,
+		queryBuilder: NewQueryBuilder(),
+		logger:       logger,
+	}
+}
+
+// ListTags returns every tag of the workspace with its contact count
+func (s *ContactTagService) ListTags(ctx context.Context, req *domain.ListTagsRequest) (*domain.ListTagsResponse, error) {
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceContacts, domain.PermissionTypeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.tagRepo.ListTags(ctx, req.WorkspaceID)
+	if err != nil {
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to list tags: %v", err))
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	return &domain.ListTagsResponse{Tags: tags}, nil
+}
+
+// AddTags tags the contacts selected by the request. Tags are created on the fly.
+func (s *ContactTagService) AddTags(ctx context.Context, req *domain.UpdateContactTagsRequest) (*domain.UpdateContactTagsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, domain.NewValidationError(err.Error())
+	}
+
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceContacts, domain.PermissionTypeWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	emailsQuery, args, err := s.selectContacts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	added, err := s.tagRepo.AddTags(ctx, req.WorkspaceID, req.Tags, emailsQuery, args)
+	if err != nil {
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to add tags: %v", err))
+		return nil, fmt.Errorf("failed to add tags: %w", err)
+	}
+
+	return &domain.UpdateContactTagsResponse{Affected: added}, nil
+}
+
+// RemoveTags untags the contacts selected by the request
+func (s *ContactTagService) RemoveTags(ctx context.Context, req *domain.UpdateContactTagsRequest) (*domain.UpdateContactTagsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, domain.NewValidationError(err.Error())
+	}
+
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceContacts, domain.PermissionTypeWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	emailsQuery, args, err := s.selectContacts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := s.tagRepo.RemoveTags(ctx, req.WorkspaceID, req.Tags, emailsQuery, args)
+	if err != nil {
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to remove tags: %v", err))
+		return nil, fmt.Errorf("failed to remove tags: %w", err)
+	}
+
+	return &domain.UpdateContactTagsResponse{Affected: removed}, nil
+}
+
+// DeleteTag removes a tag from every contact
+func (s *ContactTagService) DeleteTag(ctx context.Context, req *domain.DeleteTagRequest) (*domain.UpdateContactTagsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, domain.NewValidationError(err.Error())
+	}
+
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceContacts, domain.PermissionTypeWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := s.tagRepo.DeleteTag(ctx, req.WorkspaceID, req.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.UpdateContactTagsResponse{Affected: removed}, nil
+}
+
+// selectContacts returns the query selecting the emails of the contacts targeted by a request
+func (s *ContactTagService) selectContacts(ctx context.Context, req *domain.UpdateContactTagsRequest) (string, []interface{}, error) {
+	switch {
+	case len(req.Emails) > 0:
+		// Unknown emails are skipped rather than creating contacts
+		return "SELECT email FROM contacts WHERE email = ANY($1)", []interface{}{pq.Array(req.Emails)}, nil
+
+	case req.SegmentID != "":
+		if _, err := s.segmentRepo.GetSegmentByID(ctx, req.WorkspaceID, req.SegmentID); err != nil {
+			return "", nil, err
+		}
+		// Only the members of the current segment version
+		return "SELECT cs.email FROM contact_segments cs JOIN segments s ON s.id = cs.segment_id AND s.version = cs.version WHERE cs.segment_id = $1",
+			[]interface{}{req.SegmentID}, nil
+
+	default:
+		query, args, err := s.queryBuilder.BuildSQL(req.Filter)
+		if err != nil {
+			return "", nil, domain.NewValidationError(fmt.Sprintf("invalid filter: %v", err))
+		}
+		return query, args, nil
+	}
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+)
+
+type contactTagServiceTest struct {
+	repo        *mocks.MockContactTagRepository
+	segmentRepo *mocks.MockSegmentRepository
+	authService *mocks.MockAuthService
+	logger      *pkgmocks.MockLogger
+	service     *ContactTagService
+}
+
+func setupContactTagServiceTest(t *testing.T) *contactTagServiceTest {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	st := &contactTagServiceTest{
+		repo:        mocks.NewMockContactTagRepository(ctrl),
+		segmentRepo: mocks.NewMockSegmentRepository(ctrl),
+		authService: mocks.NewMockAuthService(ctrl),
+		logger:      pkgmocks.NewMockLogger(ctrl),
+	}
+	st.service = NewContactTagService(st.repo, st.segmentRepo, st.authService, st.logger)
+	return st
+}
+
+func (st *contactTagServiceTest) expectAuth(ctx context.Context, permissions domain.ResourcePermissions) {
+	userWorkspace := &domain.UserWorkspace{
+		UserID:      "user1",
+		WorkspaceID: "ws1",
+		Permissions: domain.UserPermissions{domain.PermissionResourceContacts: permissions},
+	}
+	st.authService.EXPECT().AuthenticateUserForWorkspace(ctx, "ws1").Return(ctx, &domain.User{}, userWorkspace, nil)
+}
+
+func TestContactTagService_ListTags(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns the tags", func(t *testing.T) {
+		st := setupContactTagServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+		st.repo.EXPECT().ListTags(ctx, "ws1").Return([]domain.TagCount{{Tag: "vip", Count: 3}}, nil)
+
+		response, err := st.service.ListTags(ctx, &domain.ListTagsRequest{WorkspaceID: "ws1"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []domain.TagCount{{Tag: "vip", Count: 3}}, response.Tags)
+	})
+
+	t.Run("requires read permission", func(t *testing.T) {
+		st := setupContactTagServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{})
+
+		_, err := st.service.ListTags(ctx, &domain.ListTagsRequest{WorkspaceID: "ws1"})
+
+		var permErr *domain.PermissionError
+		assert.True(t, errors.As(err, &permErr))
+	})
+}
+
+func TestContactTagService_AddTags(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("tags listed emails", func(t *testing.T) {
+		st := setupContactTagServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true, Write: true})
+		st.repo.EXPECT().AddTags(ctx, "ws1", []string{"vip"}, "SELECT email FROM contacts WHERE email = ANY($1)",
+			[]interface{}{pq.Array([]string{"john@example.com"})}).Return(int64(1), nil)
+
+		response, err := st.service.AddTags(ctx, &domain.UpdateContactTagsRequest{
+			WorkspaceID: "ws1",
+			Tags:        []string{"VIP"},
+			Emails:      []string{"John@example.com"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), response.Affected)
+	})
+
+	t.Run("tags segment members", func(t *testing.T) {
+		st := setupContactTagServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true, Write: true})
+		st.segmentRepo.EXPECT().GetSegmentByID(ctx, "ws1", "seg1").Return(&domain.Segment{ID: "seg1"}, nil)
+		st.repo.EXPECT().AddTags(ctx, "ws1", []string{"vip"}, gomock.Any(), []interface{}{"seg1"}).
+			DoAndReturn(func(_ context.Context, _ string, _ []string, query string, _ []interface{}) (int64, error) {
+				assert.Contains(t, query, "FROM contact_segments cs JOIN segments s ON s.id = cs.segment_id AND s.version = cs.version")
+				return 40, nil
+			})
+
+		response, err := st.service.AddTags(ctx, &domain.UpdateContactTagsRequest{WorkspaceID: "ws1", Tags: []string{"vip"}, SegmentID: "seg1"})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(40), response.Affected)
+	})
+
+	t.Run("unknown segment", func(t *testing.T) {
+		st := setupContactTagServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true, Write: true})
+		st.segmentRepo.EXPECT().GetSegmentByID(ctx, "ws1", "seg1").Return(nil, &domain.ErrSegmentNotFound{Message: "segment not found: seg1"})
+
+		_, err := st.service.AddTags(ctx, &domain.UpdateContactTagsRequest{WorkspaceID: "ws1", Tags: []string{"vip"}, SegmentID: "seg1"})
+
+		var notFound *domain.ErrSegmentNotFound
+		assert.True(t, errors.As(err, &notFound))
+	})
+
+	t.Run("tags contacts matching a filter", func(t *testing.T) {
+		st := setupContactTagServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true, Write: true})
+		st.repo.EXPECT().AddTags(ctx, "ws1", []string{"subscriber"},
+			"SELECT email FROM contacts WHERE EXISTS (SELECT 1 FROM contact_lists cl JOIN lists l ON cl.list_id = l.id WHERE cl.email = contacts.email AND cl.list_id = $1 AND l.deleted_at IS NULL)",
+			[]interface{}{"news"}).Return(int64(12), nil)
+
+		_, err := st.service.AddTags(ctx, &domain.UpdateContactTagsRequest{
+			WorkspaceID: "ws1",
+			Tags:        []string{"subscriber"},
+			Filter: &domain.TreeNode{
+				Kind: "leaf",
+				Leaf: &domain.TreeNodeLeaf{
+					Source:      "contact_lists",
+					ContactList: &domain.ContactListCondition{Operator: "in", ListID: "news"},
+				},
+			},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		st := setupContactTagServiceTest(t)
+
+		_, err := st.service.AddTags(ctx, &domain.UpdateContactTagsRequest{WorkspaceID: "ws1", Tags: []string{"vip"}})
+
+		var validationErr domain.ValidationError
+		assert.True(t, errors.As(err, &validationErr))
+	})
+
+	t.Run("requires write permission", func(t *testing.T) {
+		st := setupContactTagServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+
+		_, err := st.service.AddTags(ctx, &domain.UpdateContactTagsRequest{WorkspaceID: "ws1", Tags: []string{"vip"}, Emails: []string{"a@example.com"}})
+
+		var permErr *domain.PermissionError
+		assert.True(t, errors.As(err, &permErr))
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		st := setupContactTagServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true, Write: true})
+		st.repo.EXPECT().AddTags(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), errors.New("db error"))
+		st.logger.EXPECT().WithField("workspace_id", "ws1").Return(st.logger)
+		st.logger.EXPECT().Error(gomock.Any())
+
+		_, err := st.service.AddTags(ctx, &domain.UpdateContactTagsRequest{WorkspaceID: "ws1", Tags: []string{"vip"}, Emails: []string{"a@example.com"}})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to add tags")
+	})
+}
+
+func TestContactTagService_RemoveTags(t *testing.T) {
+	ctx := context.Background()
+	st := setupContactTagServiceTest(t)
+	st.expectAuth(ctx, domain.ResourcePermissions{Read: true, Write: true})
+	st.repo.EXPECT().RemoveTags(ctx, "ws1", []string{"vip", "beta"}, "SELECT email FROM contacts WHERE email = ANY($1)",
+		[]interface{}{pq.Array([]string{"a@example.com"})}).Return(int64(2), nil)
+
+	response, err := st.service.RemoveTags(ctx, &domain.UpdateContactTagsRequest{
+		WorkspaceID: "ws1",
+		Tags:        []string{"vip", "Beta"},
+		Emails:      []string{"a@example.com"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), response.Affected)
+}
+
+func TestContactTagService_DeleteTag(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("deletes the tag", func(t *testing.T) {
+		st := setupContactTagServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true, Write: true})
+		st.repo.EXPECT().DeleteTag(ctx, "ws1", "vip").Return(int64(9), nil)
+
+		response, err := st.service.DeleteTag(ctx, &domain.DeleteTagRequest{WorkspaceID: "ws1", Tag: "VIP"})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(9), response.Affected)
+	})
+
+	t.Run("unknown tag", func(t *testing.T) {
+		st := setupContactTagServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true, Write: true})
+		st.repo.EXPECT().DeleteTag(ctx, "ws1", "vip").Return(int64(0), domain.ErrTagNotFound)
+
+		_, err := st.service.DeleteTag(ctx, &domain.DeleteTagRequest{WorkspaceID: "ws1", Tag: "vip"})
+
+		assert.ErrorIs(t, err, domain.ErrTagNotFound)
+	})
+}
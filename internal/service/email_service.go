@@ -26,6 +26,7 @@ type EmailService struct {
 	templateRepo     domain.TemplateRepository
 	templateService  domain.TemplateService
 	messageRepo      domain.MessageHistoryRepository
+	translationRepo  domain.TranslationRepository
 	httpClient       domain.HTTPClient
 	webhookEndpoint  string
 	apiEndpoint      string
@@ -48,6 +49,7 @@ func NewEmailService(
 	templateRepo domain.TemplateRepository,
 	templateService domain.TemplateService,
 	messageRepo domain.MessageHistoryRepository,
+	translationRepo domain.TranslationRepository,
 	httpClient domain.HTTPClient,
 	webhookEndpoint string,
 	apiEndpoint string,
@@ -73,6 +75,7 @@ func NewEmailService(
 		templateRepo:     templateRepo,
 		templateService:  templateService,
 		messageRepo:      messageRepo,
+		translationRepo:  translationRepo,
 		httpClient:       httpClient,
 		webhookEndpoint:  webhookEndpoint,
 		apiEndpoint:      apiEndpoint,
@@ -253,9 +256,9 @@ func (s *EmailService) SendEmailForTemplate(ctx context.Context, request domain.
 		"template_id": request.TemplateConfig.TemplateID,
 	}).Debug("Preparing to send email notification")
 
-	// Get the template (mark as system call to bypass authentication)
+	// Get the published template (mark as system call to bypass authentication)
 	systemCtx := context.WithValue(ctx, domain.SystemCallKey, true)
-	template, err := s.templateService.GetTemplateByID(systemCtx, request.WorkspaceID, request.TemplateConfig.TemplateID, int64(0))
+	template, err := s.templateService.GetPublishedTemplate(systemCtx, request.WorkspaceID, request.TemplateConfig.TemplateID)
 	if err != nil {
 		s.logger.WithFields(map[string]interface{}{
 			"error":       err.Error(),
@@ -280,6 +283,13 @@ func (s *EmailService) SendEmailForTemplate(ctx context.Context, request domain.
 
 	emailContent := template.ResolveEmailContent(contactLang, workspace.Settings.DefaultLanguage)
 
+	// Provide the catalog strings the template translates in the contact's language
+	if err := domain.ApplyTranslations(ctx, s.translationRepo, request.WorkspaceID, workspace.Settings.DefaultLanguage,
+		contactLang, emailContent, request.MessageData.Data); err != nil {
+		tracing.MarkSpanError(ctx, err)
+		return err
+	}
+
 	// Find the emailSender
 	emailSender := request.EmailProvider.GetSender(emailContent.SenderID)
 
@@ -322,6 +332,7 @@ func (s *EmailService) SendEmailForTemplate(ctx context.Context, request domain.
 		TemplateData:           request.MessageData.Data,
 		TrackingSettings:       trackingSettings,
 		SubjectPreviewOverride: request.EmailOptions.SubjectPreview,
+		Text:                   emailContent.Text,
 	}
 	compileTemplateRequest.MjmlSource = emailContent.GetCodeModeMjmlSource()
 
@@ -468,6 +479,7 @@ func (s *EmailService) SendEmailForTemplate(ctx context.Context, request domain.
 		To:            request.Contact.Email,
 		Subject:       subject,
 		Content:       htmlContent,
+		Text:          compiledTemplate.GetText(),
 		Provider:      request.EmailProvider,
 		EmailOptions:  request.EmailOptions,
 	}
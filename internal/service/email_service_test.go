@@ -46,6 +46,7 @@ func TestEmailService_NewEmailService(t *testing.T) {
 			mockTemplateRepo,
 			mockTemplateService,
 			mockMessageRepo,
+			nil,
 			mockHTTPClient,
 			webhookEndpoint,
 			apiEndpoint,
@@ -84,6 +85,7 @@ func TestEmailService_NewEmailService(t *testing.T) {
 			nil, // nil templateRepo
 			nil, // nil templateService
 			nil, // nil messageRepo
+			nil, // nil translationRepo
 			nil, // nil httpClient
 			"",  // empty webhookEndpoint
 			"",  // empty apiEndpoint
@@ -122,6 +124,7 @@ func TestEmailService_NewEmailService(t *testing.T) {
 			mockTemplateRepo,
 			mockTemplateService,
 			mockMessageRepo,
+			nil,
 			mockHTTPClient,
 			"", // empty webhookEndpoint
 			"", // empty apiEndpoint
@@ -148,6 +151,7 @@ func TestEmailService_NewEmailService(t *testing.T) {
 			mockTemplateRepo,
 			mockTemplateService,
 			mockMessageRepo,
+			nil,
 			mockHTTPClient,
 			webhookEndpoint,
 			apiEndpoint,
@@ -195,6 +199,7 @@ func TestEmailService_NewEmailService(t *testing.T) {
 			mockTemplateRepo,
 			mockTemplateService,
 			mockMessageRepo,
+			nil,
 			mockHTTPClient,
 			webhookEndpoint,
 			apiEndpoint,
@@ -222,6 +227,7 @@ func TestEmailService_NewEmailService(t *testing.T) {
 			mockTemplateRepo,
 			mockTemplateService,
 			mockMessageRepo,
+			nil,
 			mockHTTPClient,
 			specificWebhookEndpoint,
 			specificAPIEndpoint,
@@ -930,7 +936,7 @@ func TestEmailService_SendEmailForTemplate(t *testing.T) {
 
 		// Setup template service mock
 		mockTemplateService.EXPECT().
-			GetTemplateByID(gomock.Any(), workspaceID, templateConfig.TemplateID, int64(0)).
+			GetPublishedTemplate(gomock.Any(), workspaceID, templateConfig.TemplateID).
 			Return(emailTemplate, nil)
 
 		// Setup compile template mock
@@ -994,7 +1000,7 @@ func TestEmailService_SendEmailForTemplate(t *testing.T) {
 
 		// Setup template service mock
 		mockTemplateService.EXPECT().
-			GetTemplateByID(gomock.Any(), workspaceID, templateConfig.TemplateID, int64(0)).
+			GetPublishedTemplate(gomock.Any(), workspaceID, templateConfig.TemplateID).
 			Return(emailTemplate, nil)
 
 		// Setup compile template mock
@@ -1054,7 +1060,7 @@ func TestEmailService_SendEmailForTemplate(t *testing.T) {
 
 		// Setup template service mock
 		mockTemplateService.EXPECT().
-			GetTemplateByID(gomock.Any(), workspaceID, templateConfig.TemplateID, int64(0)).
+			GetPublishedTemplate(gomock.Any(), workspaceID, templateConfig.TemplateID).
 			Return(emailTemplate, nil)
 
 		// Setup compile template mock
@@ -1114,7 +1120,7 @@ func TestEmailService_SendEmailForTemplate(t *testing.T) {
 
 		// Setup template service mock
 		mockTemplateService.EXPECT().
-			GetTemplateByID(gomock.Any(), workspaceID, templateConfig.TemplateID, int64(0)).
+			GetPublishedTemplate(gomock.Any(), workspaceID, templateConfig.TemplateID).
 			Return(emailTemplate, nil)
 
 		// Setup compile template mock - verify SubjectPreviewOverride is set
@@ -1162,7 +1168,7 @@ func TestEmailService_SendEmailForTemplate(t *testing.T) {
 	t.Run("Error getting template", func(t *testing.T) {
 		// Setup template service mock to return an error
 		mockTemplateService.EXPECT().
-			GetTemplateByID(gomock.Any(), workspaceID, templateConfig.TemplateID, int64(0)).
+			GetPublishedTemplate(gomock.Any(), workspaceID, templateConfig.TemplateID).
 			Return(nil, assert.AnError)
 
 		// Logger should log the error
@@ -1202,7 +1208,7 @@ func TestEmailService_SendEmailForTemplate(t *testing.T) {
 
 		// Setup template service mock
 		mockTemplateService.EXPECT().
-			GetTemplateByID(gomock.Any(), workspaceID, templateConfig.TemplateID, int64(0)).
+			GetPublishedTemplate(gomock.Any(), workspaceID, templateConfig.TemplateID).
 			Return(emailTemplate, nil)
 
 		// Setup compile template mock to return an error
@@ -1247,7 +1253,7 @@ func TestEmailService_SendEmailForTemplate(t *testing.T) {
 
 		// Setup template service mock
 		mockTemplateService.EXPECT().
-			GetTemplateByID(gomock.Any(), workspaceID, templateConfig.TemplateID, int64(0)).
+			GetPublishedTemplate(gomock.Any(), workspaceID, templateConfig.TemplateID).
 			Return(emailTemplate, nil)
 
 		// Create unsuccessful compile result
@@ -1300,7 +1306,7 @@ func TestEmailService_SendEmailForTemplate(t *testing.T) {
 
 		// Setup template service mock
 		mockTemplateService.EXPECT().
-			GetTemplateByID(gomock.Any(), workspaceID, templateConfig.TemplateID, int64(0)).
+			GetPublishedTemplate(gomock.Any(), workspaceID, templateConfig.TemplateID).
 			Return(emailTemplate, nil)
 
 		// Setup compile template mock
@@ -1350,7 +1356,7 @@ func TestEmailService_SendEmailForTemplate(t *testing.T) {
 
 		// Setup template service mock
 		mockTemplateService.EXPECT().
-			GetTemplateByID(gomock.Any(), workspaceID, templateConfig.TemplateID, int64(0)).
+			GetPublishedTemplate(gomock.Any(), workspaceID, templateConfig.TemplateID).
 			Return(emailTemplate, nil)
 
 		// Setup compile template mock
@@ -1418,7 +1424,7 @@ func TestEmailService_SendEmailForTemplate(t *testing.T) {
 
 		// Setup template service mock
 		mockTemplateService.EXPECT().
-			GetTemplateByID(gomock.Any(), workspaceID, templateConfig.TemplateID, int64(0)).
+			GetPublishedTemplate(gomock.Any(), workspaceID, templateConfig.TemplateID).
 			Return(emailTemplate, nil)
 
 		// Setup compile template mock
@@ -1483,7 +1489,7 @@ func TestEmailService_SendEmailForTemplate(t *testing.T) {
 
 		// Setup template service mock
 		mockTemplateService.EXPECT().
-			GetTemplateByID(gomock.Any(), workspaceID, templateConfig.TemplateID, int64(0)).
+			GetPublishedTemplate(gomock.Any(), workspaceID, templateConfig.TemplateID).
 			Return(emailTemplate, nil)
 
 		// Setup compile template mock
@@ -1546,7 +1552,7 @@ func TestEmailService_SendEmailForTemplate(t *testing.T) {
 
 		// Setup template service mock
 		mockTemplateService.EXPECT().
-			GetTemplateByID(gomock.Any(), workspaceID, templateConfig.TemplateID, int64(0)).
+			GetPublishedTemplate(gomock.Any(), workspaceID, templateConfig.TemplateID).
 			Return(emailTemplate, nil)
 
 		// Setup compile template mock
@@ -1611,7 +1617,7 @@ func TestEmailService_SendEmailForTemplate(t *testing.T) {
 
 		// Setup template service mock
 		mockTemplateService.EXPECT().
-			GetTemplateByID(gomock.Any(), workspaceID, templateConfig.TemplateID, int64(0)).
+			GetPublishedTemplate(gomock.Any(), workspaceID, templateConfig.TemplateID).
 			Return(emailTemplate, nil)
 
 		// Setup compile template mock
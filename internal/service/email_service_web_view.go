@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/pkg/notifuse_mjml"
+	"github.com/Notifuse/notifuse/pkg/tracing"
+)
+
+// RenderWebView re-renders the email a contact received from the template version and the data
+// recorded in its message history. Links are tracked like in the email when tracking is enabled.
+func (s *EmailService) RenderWebView(ctx context.Context, workspaceID string, messageID string, signature string) (*domain.WebView, error) {
+	ctx, span := tracing.StartServiceSpan(ctx, "EmailService", "RenderWebView")
+	defer span.End()
+
+	workspace, err := s.getWebViewWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.VerifyWebViewSignature(workspaceID, messageID, workspace.Settings.SecretKey, signature) {
+		return nil, domain.ErrWebViewNotFound
+	}
+
+	message, err := s.messageRepo.Get(ctx, workspaceID, workspace.Settings.SecretKey, messageID)
+	if err != nil {
+		// The message was signed by us, it may have been deleted with its contact since
+		s.logger.WithField("message_id", messageID).Warn(fmt.Sprintf("Failed to get message for web view: %v", err))
+		return nil, domain.ErrWebViewNotFound
+	}
+	if message.Channel != "email" {
+		return nil, domain.ErrWebViewNotFound
+	}
+	if time.Since(message.SentAt) > workspace.Settings.WebView.GetRetention() {
+		return nil, domain.ErrWebViewExpired
+	}
+
+	data := domain.MapOfAny(message.MessageData.Data)
+	if data == nil {
+		data = domain.MapOfAny{}
+	}
+
+	trackingSettings := notifuse_mjml.TrackingSettings{
+		Endpoint:       s.workspaceEndpoint(workspace),
+		EnableTracking: workspace.Settings.EmailTrackingEnabled,
+		UTMSource:      stringData(data, "utm_source"),
+		UTMMedium:      stringData(data, "utm_medium"),
+		UTMCampaign:    stringData(data, "utm_campaign"),
+		UTMContent:     stringData(data, "utm_content"),
+		UTMTerm:        stringData(data, "utm_term"),
+		WorkspaceID:    workspaceID,
+		MessageID:      messageID,
+	}
+
+	// The language the contact had when the message was sent
+	language := ""
+	switch contact := data["contact"].(type) {
+	case map[string]interface{}:
+		language, _ = contact["language"].(string)
+	case domain.MapOfAny:
+		language, _ = contact["language"].(string)
+	}
+
+	template, err := s.getWebViewTemplate(ctx, workspaceID, message.TemplateID, message.TemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	// The signing keys are not stored with the message, public views go without them
+	workspace.Settings.TemplateSecrets.Apply(data)
+
+	return s.renderWebView(ctx, workspace, template, language, data, trackingSettings)
+}
+
+// RenderPublicBroadcast renders the web version of a broadcast for anyone with the link, from the
+// template version and the data of one of its messages stripped of personal data
+func (s *EmailService) RenderPublicBroadcast(ctx context.Context, workspaceID string, broadcastID string) (*domain.WebView, error) {
+	ctx, span := tracing.StartServiceSpan(ctx, "EmailService", "RenderPublicBroadcast")
+	defer span.End()
+
+	workspace, err := s.getWebViewWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if !workspace.Settings.WebView.ArePublicBroadcastsEnabled() {
+		return nil, domain.ErrWebViewNotFound
+	}
+	return s.RenderBroadcastWebView(ctx, workspace, broadcastID)
+}
+
+// RenderBroadcastWebView renders a broadcast without personal data, whether public broadcasts
+// are enabled or not. It is used by the newsletter archive of the blog.
+func (s *EmailService) RenderBroadcastWebView(ctx context.Context, workspace *domain.Workspace, broadcastID string) (*domain.WebView, error) {
+	workspaceID := workspace.ID
+	messages, _, err := s.messageRepo.GetByBroadcast(ctx, workspaceID, workspace.Settings.SecretKey, broadcastID, 1, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get broadcast messages: %w", err)
+	}
+	if len(messages) == 0 {
+		return nil, domain.ErrWebViewNotFound
+	}
+	message := messages[0]
+
+	data := domain.PublicTemplateData(message.MessageData.Data)
+
+	// Strings of the catalog are those of the default language, not of the message's contact
+	template, err := s.getWebViewTemplate(ctx, workspaceID, message.TemplateID, message.TemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+	defaultLanguage := workspace.Settings.DefaultLanguage
+	if err := domain.ApplyTranslations(ctx, s.translationRepo, workspaceID, defaultLanguage, defaultLanguage,
+		template.ResolveEmailContent("", defaultLanguage), data); err != nil {
+		return nil, err
+	}
+
+	trackingSettings := notifuse_mjml.TrackingSettings{
+		Endpoint:    s.workspaceEndpoint(workspace),
+		WorkspaceID: workspaceID,
+	}
+	return s.renderWebView(ctx, workspace, template, "", data, trackingSettings)
+}
+
+// getWebViewWorkspace loads the workspace of a web view, unknown workspaces are not found
+func (s *EmailService) getWebViewWorkspace(ctx context.Context, workspaceID string) (*domain.Workspace, error) {
+	if workspaceID == "" {
+		return nil, domain.ErrWebViewNotFound
+	}
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		var notFound *domain.ErrWorkspaceNotFound
+		if errors.As(err, &notFound) {
+			return nil, domain.ErrWebViewNotFound
+		}
+		return nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+	return workspace, nil
+}
+
+func (s *EmailService) getWebViewTemplate(ctx context.Context, workspaceID, templateID string, version int64) (*domain.Template, error) {
+	template, err := s.templateRepo.GetTemplateByID(ctx, workspaceID, templateID, version)
+	if err != nil {
+		if _, ok := err.(*domain.ErrTemplateNotFound); ok {
+			return nil, domain.ErrWebViewNotFound
+		}
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+	return template, nil
+}
+
+// workspaceEndpoint returns the custom endpoint of the workspace, or the API endpoint
+func (s *EmailService) workspaceEndpoint(workspace *domain.Workspace) string {
+	if workspace.Settings.CustomEndpointURL != nil && *workspace.Settings.CustomEndpointURL != "" {
+		return *workspace.Settings.CustomEndpointURL
+	}
+	return s.apiEndpoint
+}
+
+// renderWebView compiles a template version in a language with the given data
+func (s *EmailService) renderWebView(ctx context.Context, workspace *domain.Workspace, template *domain.Template, language string, data domain.MapOfAny, trackingSettings notifuse_mjml.TrackingSettings) (*domain.WebView, error) {
+	emailContent := template.ResolveEmailContent(language, workspace.Settings.DefaultLanguage)
+	if emailContent == nil {
+		return nil, domain.ErrWebViewNotFound
+	}
+
+	compileTemplateRequest := domain.CompileTemplateRequest{
+		WorkspaceID:      workspace.ID,
+		MessageID:        trackingSettings.MessageID,
+		VisualEditorTree: emailContent.VisualEditorTree,
+		TemplateData:     notifuse_mjml.MapOfAny(data),
+		TrackingSettings: trackingSettings,
+	}
+	compileTemplateRequest.MjmlSource = emailContent.GetCodeModeMjmlSource()
+
+	systemCtx := context.WithValue(ctx, domain.SystemCallKey, true)
+	compiledTemplate, err := s.templateService.CompileTemplate(systemCtx, compileTemplateRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile template: %w", err)
+	}
+	if !compiledTemplate.Success || compiledTemplate.HTML == nil {
+		errMsg := "Unknown error"
+		if compiledTemplate.Error != nil {
+			errMsg = compiledTemplate.Error.Message
+		}
+		return nil, fmt.Errorf("template compilation failed: %s", errMsg)
+	}
+
+	subject, err := notifuse_mjml.ProcessLiquidTemplate(emailContent.Subject, data, "email_subject")
+	if err != nil {
+		subject = emailContent.Subject
+	}
+
+	return &domain.WebView{Subject: subject, HTML: *compiledTemplate.HTML}, nil
+}
+
+func stringData(data domain.MapOfAny, key string) string {
+	value, _ := data[key].(string)
+	return value
+}
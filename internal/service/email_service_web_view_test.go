@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+	"github.com/Notifuse/notifuse/pkg/notifuse_mjml"
+)
+
+func setupWebViewTest(t *testing.T) (*EmailService, *mocks.MockWorkspaceRepository, *mocks.MockMessageHistoryRepository, *mocks.MockTemplateRepository, *mocks.MockTemplateService) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockLogger := pkgmocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().WithField(gomock.Any(), gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Warn(gomock.Any()).AnyTimes()
+
+	mockWorkspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	mockMessageRepo := mocks.NewMockMessageHistoryRepository(ctrl)
+	mockTemplateRepo := mocks.NewMockTemplateRepository(ctrl)
+	mockTemplateService := mocks.NewMockTemplateService(ctrl)
+
+	emailService := &EmailService{
+		logger:          mockLogger,
+		workspaceRepo:   mockWorkspaceRepo,
+		messageRepo:     mockMessageRepo,
+		templateRepo:    mockTemplateRepo,
+		templateService: mockTemplateService,
+		apiEndpoint:     "https://api.example.com",
+	}
+	return emailService, mockWorkspaceRepo, mockMessageRepo, mockTemplateRepo, mockTemplateService
+}
+
+func webViewTestWorkspace(settings *domain.WebViewSettings) *domain.Workspace {
+	return &domain.Workspace{
+		ID: "workspace-123",
+		Settings: domain.WorkspaceSettings{
+			SecretKey:            "secret",
+			DefaultLanguage:      "en",
+			EmailTrackingEnabled: true,
+			WebView:              settings,
+		},
+	}
+}
+
+func webViewTestTemplate() *domain.Template {
+	return &domain.Template{
+		ID:      "template-789",
+		Version: 2,
+		Email: &domain.EmailTemplate{
+			Subject:          "Hello {{ contact.first_name }}",
+			VisualEditorTree: &notifuse_mjml.MJMLBlock{BaseBlock: notifuse_mjml.NewBaseBlock("root", notifuse_mjml.MJMLComponentMjml)},
+		},
+	}
+}
+
+func TestEmailService_RenderWebView(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := "workspace-123"
+	messageID := "message-456"
+	signature := domain.ComputeWebViewSignature(workspaceID, messageID, "secret")
+
+	message := func(sentAt time.Time) *domain.MessageHistory {
+		return &domain.MessageHistory{
+			ID:              messageID,
+			TemplateID:      "template-789",
+			TemplateVersion: 2,
+			Channel:         "email",
+			SentAt:          sentAt,
+			MessageData: domain.MessageData{Data: map[string]interface{}{
+				"contact":    map[string]interface{}{"first_name": "John", "language": "fr"},
+				"utm_source": "newsletter",
+			}},
+		}
+	}
+
+	t.Run("Renders the message with its data", func(t *testing.T) {
+		emailService, workspaceRepo, messageRepo, templateRepo, templateService := setupWebViewTest(t)
+		html := "<html>Hello John</html>"
+
+		workspaceRepo.EXPECT().GetByID(gomock.Any(), workspaceID).Return(webViewTestWorkspace(nil), nil)
+		messageRepo.EXPECT().Get(gomock.Any(), workspaceID, "secret", messageID).Return(message(time.Now().Add(-time.Hour)), nil)
+		templateRepo.EXPECT().GetTemplateByID(gomock.Any(), workspaceID, "template-789", int64(2)).Return(webViewTestTemplate(), nil)
+		templateService.EXPECT().CompileTemplate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req domain.CompileTemplateRequest) (*domain.CompileTemplateResponse, error) {
+				assert.Equal(t, messageID, req.MessageID)
+				assert.Equal(t, "https://api.example.com", req.TrackingSettings.Endpoint)
+				assert.True(t, req.TrackingSettings.EnableTracking)
+				assert.Equal(t, "newsletter", req.TrackingSettings.UTMSource)
+				return &domain.CompileTemplateResponse{Success: true, HTML: &html}, nil
+			})
+
+		view, err := emailService.RenderWebView(ctx, workspaceID, messageID, signature)
+		require.NoError(t, err)
+		assert.Equal(t, "Hello John", view.Subject)
+		assert.Equal(t, html, view.HTML)
+	})
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		emailService, workspaceRepo, _, _, _ := setupWebViewTest(t)
+		workspaceRepo.EXPECT().GetByID(gomock.Any(), workspaceID).Return(webViewTestWorkspace(nil), nil)
+
+		_, err := emailService.RenderWebView(ctx, workspaceID, messageID, "invalid")
+		assert.ErrorIs(t, err, domain.ErrWebViewNotFound)
+	})
+
+	t.Run("Unknown workspace", func(t *testing.T) {
+		emailService, workspaceRepo, _, _, _ := setupWebViewTest(t)
+		workspaceRepo.EXPECT().GetByID(gomock.Any(), workspaceID).Return(nil, &domain.ErrWorkspaceNotFound{WorkspaceID: workspaceID})
+
+		_, err := emailService.RenderWebView(ctx, workspaceID, messageID, signature)
+		assert.ErrorIs(t, err, domain.ErrWebViewNotFound)
+	})
+
+	t.Run("Deleted message", func(t *testing.T) {
+		emailService, workspaceRepo, messageRepo, _, _ := setupWebViewTest(t)
+		workspaceRepo.EXPECT().GetByID(gomock.Any(), workspaceID).Return(webViewTestWorkspace(nil), nil)
+		messageRepo.EXPECT().Get(gomock.Any(), workspaceID, "secret", messageID).Return(nil, errors.New("message not found"))
+
+		_, err := emailService.RenderWebView(ctx, workspaceID, messageID, signature)
+		assert.ErrorIs(t, err, domain.ErrWebViewNotFound)
+	})
+
+	t.Run("Message older than the retention window", func(t *testing.T) {
+		emailService, workspaceRepo, messageRepo, _, _ := setupWebViewTest(t)
+		workspaceRepo.EXPECT().GetByID(gomock.Any(), workspaceID).Return(webViewTestWorkspace(&domain.WebViewSettings{RetentionDays: 7}), nil)
+		messageRepo.EXPECT().Get(gomock.Any(), workspaceID, "secret", messageID).Return(message(time.Now().Add(-8*24*time.Hour)), nil)
+
+		_, err := emailService.RenderWebView(ctx, workspaceID, messageID, signature)
+		assert.ErrorIs(t, err, domain.ErrWebViewExpired)
+	})
+}
+
+func TestEmailService_RenderPublicBroadcast(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := "workspace-123"
+	broadcastID := "broadcast-123"
+
+	t.Run("Renders without personal data", func(t *testing.T) {
+		emailService, workspaceRepo, messageRepo, templateRepo, templateService := setupWebViewTest(t)
+		html := "<html>Hello</html>"
+
+		workspaceRepo.EXPECT().GetByID(gomock.Any(), workspaceID).Return(webViewTestWorkspace(&domain.WebViewSettings{PublicBroadcasts: true}), nil)
+		messageRepo.EXPECT().GetByBroadcast(gomock.Any(), workspaceID, "secret", broadcastID, 1, 0).Return([]*domain.MessageHistory{{
+			ID:              "message-456",
+			TemplateID:      "template-789",
+			TemplateVersion: 2,
+			SentAt:          time.Now().Add(-365 * 24 * time.Hour),
+			MessageData: domain.MessageData{Data: map[string]interface{}{
+				"contact":         map[string]interface{}{"first_name": "John", "email": "john@example.com"},
+				"unsubscribe_url": "https://api.example.com/notification-center?email=john%40example.com",
+				"broadcast":       map[string]interface{}{"id": broadcastID},
+			}},
+		}}, 1, nil)
+		templateRepo.EXPECT().GetTemplateByID(gomock.Any(), workspaceID, "template-789", int64(2)).Return(webViewTestTemplate(), nil)
+		templateService.EXPECT().CompileTemplate(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req domain.CompileTemplateRequest) (*domain.CompileTemplateResponse, error) {
+				assert.Empty(t, req.MessageID)
+				assert.False(t, req.TrackingSettings.EnableTracking)
+				assert.Equal(t, domain.MapOfAny{}, req.TemplateData["contact"])
+				assert.Equal(t, "#", req.TemplateData["unsubscribe_url"])
+				assert.NotNil(t, req.TemplateData["broadcast"])
+				return &domain.CompileTemplateResponse{Success: true, HTML: &html}, nil
+			})
+
+		view, err := emailService.RenderPublicBroadcast(ctx, workspaceID, broadcastID)
+		require.NoError(t, err)
+		assert.Equal(t, "Hello ", view.Subject)
+		assert.Equal(t, html, view.HTML)
+	})
+
+	t.Run("Public broadcasts disabled", func(t *testing.T) {
+		emailService, workspaceRepo, _, _, _ := setupWebViewTest(t)
+		workspaceRepo.EXPECT().GetByID(gomock.Any(), workspaceID).Return(webViewTestWorkspace(nil), nil)
+
+		_, err := emailService.RenderPublicBroadcast(ctx, workspaceID, broadcastID)
+		assert.ErrorIs(t, err, domain.ErrWebViewNotFound)
+	})
+
+	t.Run("Broadcast without messages", func(t *testing.T) {
+		emailService, workspaceRepo, messageRepo, _, _ := setupWebViewTest(t)
+		workspaceRepo.EXPECT().GetByID(gomock.Any(), workspaceID).Return(webViewTestWorkspace(&domain.WebViewSettings{PublicBroadcasts: true}), nil)
+		messageRepo.EXPECT().GetByBroadcast(gomock.Any(), workspaceID, "secret", broadcastID, 1, 0).Return(nil, 0, nil)
+
+		_, err := emailService.RenderPublicBroadcast(ctx, workspaceID, broadcastID)
+		assert.ErrorIs(t, err, domain.ErrWebViewNotFound)
+	})
+}
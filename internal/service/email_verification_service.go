@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/pkg/emailverifier"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// EmailVerificationService verifies contact addresses and stores the results
+type EmailVerificationService struct {
+	verificationRepo domain.EmailVerificationRepository
+	suppressionRepo  domain.SuppressionRepository
+	workspaceRepo    domain.WorkspaceRepository
+	authService      domain.AuthService
+	verifier         *emailverifier.Verifier
+	logger           logger.Logger
+	batchSize        int
+}
+
+// NewEmailVerificationService creates a new email verification service
+func NewEmailVerificationService(
+	verificationRepo domain.EmailVerificationRepository,
+	suppressionRepo domain.SuppressionRepository,
+	workspaceRepo domain.WorkspaceRepository,
+	authService domain.AuthService,
+	verifier *emailverifier.Verifier,
+	logger logger.Logger,
+) *EmailVerificationService {
+	return &EmailVerificationService{
+		verificationRepo: verificationRepo,
+		suppressionRepo:  suppressionRepo,
+		workspaceRepo:    workspaceRepo,
+		authService:      authService,
+		verifier:         verifier,
+		logger:           logger,
+		batchSize:        50, // DNS and SMTP lookups are slow, keep batches small
+	}
+}
+
+// GetVerification returns the last verification of an address
+func (s *EmailVerificationService) GetVerification(ctx context.Context, req *domain.GetEmailVerificationRequest) (*domain.EmailVerification, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceContacts, domain.PermissionTypeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := s.verificationRepo.Get(ctx, req.WorkspaceID, req.Email)
+	if err != nil {
+		if errors.Is(err, domain.ErrEmailVerificationNotFound) {
+			return nil, err
+		}
+		s.logger.WithField("email", req.Email).Error(fmt.Sprintf("Failed to get email verification: %v", err))
+		return nil, fmt.Errorf("failed to get email verification: %w", err)
+	}
+
+	return verification, nil
+}
+
+// VerifyEmail verifies an address right away with the workspace settings and stores the result.
+// It works even when verification on ingest is disabled.
+func (s *EmailVerificationService) VerifyEmail(ctx context.Context, req *domain.VerifyEmailRequest) (*domain.EmailVerification, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceContacts, domain.PermissionTypeWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.getSettings(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := s.verify(ctx, req.WorkspaceID, req.Email, settings)
+	if err != nil {
+		s.logger.WithField("email", req.Email).Error(fmt.Sprintf("Failed to verify email: %v", err))
+		return nil, err
+	}
+
+	return verification, nil
+}
+
+// ProcessQueue verifies one batch of queued addresses and returns how many were processed.
+// The queue is dropped when verification is disabled for the workspace.
+func (s *EmailVerificationService) ProcessQueue(ctx context.Context, workspaceID string) (int, error) {
+	settings, err := s.getSettings(ctx, workspaceID)
+	if err != nil {
+		return 0, err
+	}
+
+	if !settings.Enabled {
+		if err := s.verificationRepo.ClearQueue(ctx, workspaceID); err != nil {
+			return 0, err
+		}
+		return 0, nil
+	}
+
+	emails, err := s.verificationRepo.Dequeue(ctx, workspaceID, s.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	var failed []string
+	for _, email := range emails {
+		if _, err := s.verify(ctx, workspaceID, email, settings); err != nil {
+			s.logger.WithFields(map[string]interface{}{
+				"workspace_id": workspaceID,
+				"email":        email,
+				"error":        err.Error(),
+			}).Error("Failed to verify queued email")
+			failed = append(failed, email)
+			continue
+		}
+		processed++
+	}
+
+	// Put failed addresses back so the next run retries them
+	if len(failed) > 0 {
+		if err := s.verificationRepo.Enqueue(ctx, workspaceID, failed); err != nil {
+			return processed, err
+		}
+	}
+
+	return processed, nil
+}
+
+// QueueSize returns the number of addresses waiting for verification
+func (s *EmailVerificationService) QueueSize(ctx context.Context, workspaceID string) (int, error) {
+	return s.verificationRepo.QueueSize(ctx, workspaceID)
+}
+
+// getSettings returns the workspace verification settings, defaulting to disabled
+func (s *EmailVerificationService) getSettings(ctx context.Context, workspaceID string) (domain.EmailVerificationSettings, error) {
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return domain.EmailVerificationSettings{}, fmt.Errorf("failed to get workspace: %w", err)
+	}
+	if workspace.Settings.EmailVerification == nil {
+		return domain.EmailVerificationSettings{}, nil
+	}
+	return *workspace.Settings.EmailVerification, nil
+}
+
+// verify runs the checks, stores the result and suppresses invalid addresses when configured
+func (s *EmailVerificationService) verify(ctx context.Context, workspaceID string, email string, settings domain.EmailVerificationSettings) (*domain.EmailVerification, error) {
+	result := s.verifier.Verify(ctx, email, settings.SMTPProbe)
+	verification := domain.NewEmailVerification(result, time.Now().UTC())
+	// Keep the key the contact is stored under even if the verifier normalized it
+	verification.Email = email
+
+	if err := s.verificationRepo.Upsert(ctx, workspaceID, verification); err != nil {
+		return nil, err
+	}
+
+	if settings.BlockInvalid && verification.Status == emailverifier.StatusInvalid {
+		suppression := &domain.Suppression{
+			Pattern: email,
+			Kind:    domain.SuppressionKindEmail,
+			Reason:  domain.SuppressionReasonInvalidAddress,
+			Source:  domain.SuppressionSourceVerification,
+			Details: verification.Reason,
+		}
+		if err := s.suppressionRepo.Upsert(ctx, workspaceID, []*domain.Suppression{suppression}); err != nil {
+			return nil, fmt.Errorf("failed to suppress invalid email: %w", err)
+		}
+	}
+
+	return verification, nil
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	"github.com/Notifuse/notifuse/pkg/emailverifier"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+)
+
+// staticResolver knows the MX of example.com and nothing else
+type staticResolver struct{}
+
+func (staticResolver) LookupMX(_ context.Context, name string) ([]*net.MX, error) {
+	if name == "example.com" {
+		return []*net.MX{{Host: "mx.example.com.", Pref: 10}}, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (staticResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+type emailVerificationServiceMocks struct {
+	verificationRepo *mocks.MockEmailVerificationRepository
+	suppressionRepo  *mocks.MockSuppressionRepository
+	workspaceRepo    *mocks.MockWorkspaceRepository
+	authService      *mocks.MockAuthService
+	logger           *pkgmocks.MockLogger
+}
+
+func setupEmailVerificationService(t *testing.T) (*EmailVerificationService, emailVerificationServiceMocks) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	m := emailVerificationServiceMocks{
+		verificationRepo: mocks.NewMockEmailVerificationRepository(ctrl),
+		suppressionRepo:  mocks.NewMockSuppressionRepository(ctrl),
+		workspaceRepo:    mocks.NewMockWorkspaceRepository(ctrl),
+		authService:      mocks.NewMockAuthService(ctrl),
+		logger:           pkgmocks.NewMockLogger(ctrl),
+	}
+	service := NewEmailVerificationService(m.verificationRepo, m.suppressionRepo, m.workspaceRepo, m.authService,
+		emailverifier.New(staticResolver{}, nil), m.logger)
+	return service, m
+}
+
+func workspaceWithVerification(settings *domain.EmailVerificationSettings) *domain.Workspace {
+	return &domain.Workspace{ID: "ws1", Settings: domain.WorkspaceSettings{EmailVerification: settings}}
+}
+
+func TestEmailVerificationService_ProcessQueue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("drops the queue when verification is disabled", func(t *testing.T) {
+		service, m := setupEmailVerificationService(t)
+		m.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(workspaceWithVerification(nil), nil)
+		m.verificationRepo.EXPECT().ClearQueue(ctx, "ws1").Return(nil)
+
+		processed, err := service.ProcessQueue(ctx, "ws1")
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, processed)
+	})
+
+	t.Run("stores results and suppresses invalid addresses", func(t *testing.T) {
+		service, m := setupEmailVerificationService(t)
+		settings := &domain.EmailVerificationSettings{Enabled: true, BlockInvalid: true}
+		m.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(workspaceWithVerification(settings), nil)
+		m.verificationRepo.EXPECT().Dequeue(ctx, "ws1", 50).Return([]string{"john@example.com", "jane@nowhere.com"}, nil)
+
+		stored := map[string]*domain.EmailVerification{}
+		m.verificationRepo.EXPECT().Upsert(ctx, "ws1", gomock.Any()).Times(2).DoAndReturn(
+			func(_ context.Context, _ string, v *domain.EmailVerification) error {
+				stored[v.Email] = v
+				return nil
+			})
+		m.suppressionRepo.EXPECT().Upsert(ctx, "ws1", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, suppressions []*domain.Suppression) error {
+				require.Len(t, suppressions, 1)
+				assert.Equal(t, "jane@nowhere.com", suppressions[0].Pattern)
+				assert.Equal(t, domain.SuppressionReasonInvalidAddress, suppressions[0].Reason)
+				assert.Equal(t, domain.SuppressionSourceVerification, suppressions[0].Source)
+				assert.Equal(t, emailverifier.ReasonNoMailServer, suppressions[0].Details)
+				return nil
+			})
+
+		processed, err := service.ProcessQueue(ctx, "ws1")
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, processed)
+		assert.Equal(t, emailverifier.StatusValid, stored["john@example.com"].Status)
+		assert.Equal(t, emailverifier.StatusInvalid, stored["jane@nowhere.com"].Status)
+	})
+
+	t.Run("does not suppress unless configured", func(t *testing.T) {
+		service, m := setupEmailVerificationService(t)
+		settings := &domain.EmailVerificationSettings{Enabled: true}
+		m.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(workspaceWithVerification(settings), nil)
+		m.verificationRepo.EXPECT().Dequeue(ctx, "ws1", 50).Return([]string{"jane@nowhere.com"}, nil)
+		m.verificationRepo.EXPECT().Upsert(ctx, "ws1", gomock.Any()).Return(nil)
+
+		processed, err := service.ProcessQueue(ctx, "ws1")
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+	})
+
+	t.Run("requeues addresses that could not be stored", func(t *testing.T) {
+		service, m := setupEmailVerificationService(t)
+		settings := &domain.EmailVerificationSettings{Enabled: true}
+		m.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(workspaceWithVerification(settings), nil)
+		m.verificationRepo.EXPECT().Dequeue(ctx, "ws1", 50).Return([]string{"john@example.com"}, nil)
+		m.verificationRepo.EXPECT().Upsert(ctx, "ws1", gomock.Any()).Return(errors.New("db error"))
+		m.logger.EXPECT().WithFields(gomock.Any()).Return(m.logger)
+		m.logger.EXPECT().Error("Failed to verify queued email")
+		m.verificationRepo.EXPECT().Enqueue(ctx, "ws1", []string{"john@example.com"}).Return(nil)
+
+		processed, err := service.ProcessQueue(ctx, "ws1")
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, processed)
+	})
+}
+
+func TestEmailVerificationService_VerifyEmail(t *testing.T) {
+	ctx := context.Background()
+	writer := &domain.UserWorkspace{
+		UserID:      "user1",
+		WorkspaceID: "ws1",
+		Permissions: domain.UserPermissions{domain.PermissionResourceContacts: {Read: true, Write: true}},
+	}
+
+	t.Run("verifies even when ingest verification is disabled", func(t *testing.T) {
+		service, m := setupEmailVerificationService(t)
+		m.authService.EXPECT().AuthenticateUserForWorkspace(ctx, "ws1").Return(ctx, &domain.User{}, writer, nil)
+		m.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(workspaceWithVerification(nil), nil)
+		m.verificationRepo.EXPECT().Upsert(ctx, "ws1", gomock.Any()).Return(nil)
+
+		verification, err := service.VerifyEmail(ctx, &domain.VerifyEmailRequest{WorkspaceID: "ws1", Email: " Info@Example.com "})
+
+		require.NoError(t, err)
+		assert.Equal(t, "info@example.com", verification.Email)
+		assert.Equal(t, emailverifier.StatusRisky, verification.Status)
+		assert.Equal(t, emailverifier.ReasonRoleAccount, verification.Reason)
+		assert.WithinDuration(t, time.Now(), verification.VerifiedAt, time.Minute)
+	})
+
+	t.Run("requires write permission", func(t *testing.T) {
+		service, m := setupEmailVerificationService(t)
+		reader := &domain.UserWorkspace{
+			UserID:      "user1",
+			WorkspaceID: "ws1",
+			Permissions: domain.UserPermissions{domain.PermissionResourceContacts: {Read: true}},
+		}
+		m.authService.EXPECT().AuthenticateUserForWorkspace(ctx, "ws1").Return(ctx, &domain.User{}, reader, nil)
+
+		_, err := service.VerifyEmail(ctx, &domain.VerifyEmailRequest{WorkspaceID: "ws1", Email: "john@example.com"})
+
+		var permErr *domain.PermissionError
+		assert.True(t, errors.As(err, &permErr))
+	})
+
+	t.Run("validates the request", func(t *testing.T) {
+		service, _ := setupEmailVerificationService(t)
+
+		_, err := service.VerifyEmail(ctx, &domain.VerifyEmailRequest{WorkspaceID: "ws1"})
+
+		var validationErr domain.ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		assert.Equal(t, "email is required", validationErr.Message)
+	})
+}
+
+func TestEmailVerificationService_GetVerification(t *testing.T) {
+	ctx := context.Background()
+	reader := &domain.UserWorkspace{
+		UserID:      "user1",
+		WorkspaceID: "ws1",
+		Permissions: domain.UserPermissions{domain.PermissionResourceContacts: {Read: true}},
+	}
+
+	t.Run("returns the stored verification", func(t *testing.T) {
+		service, m := setupEmailVerificationService(t)
+		stored := &domain.EmailVerification{Email: "john@example.com", Status: emailverifier.StatusValid}
+		m.authService.EXPECT().AuthenticateUserForWorkspace(ctx, "ws1").Return(ctx, &domain.User{}, reader, nil)
+		m.verificationRepo.EXPECT().Get(ctx, "ws1", "john@example.com").Return(stored, nil)
+
+		verification, err := service.GetVerification(ctx, &domain.GetEmailVerificationRequest{WorkspaceID: "ws1", Email: "John@example.com"})
+
+		require.NoError(t, err)
+		assert.Equal(t, stored, verification)
+	})
+
+	t.Run("passes not found through", func(t *testing.T) {
+		service, m := setupEmailVerificationService(t)
+		m.authService.EXPECT().AuthenticateUserForWorkspace(ctx, "ws1").Return(ctx, &domain.User{}, reader, nil)
+		m.verificationRepo.EXPECT().Get(ctx, "ws1", "john@example.com").Return(nil, domain.ErrEmailVerificationNotFound)
+
+		_, err := service.GetVerification(ctx, &domain.GetEmailVerificationRequest{WorkspaceID: "ws1", Email: "john@example.com"})
+
+		assert.True(t, errors.Is(err, domain.ErrEmailVerificationNotFound))
+	})
+}
+
+func TestEmailVerificationTaskProcessor(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("handles its task type", func(t *testing.T) {
+		processor := NewEmailVerificationTaskProcessor(nil, nil)
+		assert.True(t, processor.CanProcess(domain.TaskTypeProcessEmailVerificationQueue))
+		assert.False(t, processor.CanProcess("process_contact_segment_queue"))
+	})
+
+	t.Run("processes until the queue is empty and stays pending", func(t *testing.T) {
+		service, m := setupEmailVerificationService(t)
+		processor := NewEmailVerificationTaskProcessor(service, m.logger)
+		processor.idleWait = time.Hour // never wait for new entries within the test
+
+		settings := &domain.EmailVerificationSettings{Enabled: true}
+		m.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(workspaceWithVerification(settings), nil)
+		m.verificationRepo.EXPECT().Dequeue(ctx, "ws1", 50).Return([]string{"john@example.com"}, nil)
+		m.verificationRepo.EXPECT().Upsert(ctx, "ws1", gomock.Any()).Return(nil)
+		m.verificationRepo.EXPECT().QueueSize(ctx, "ws1").Return(0, nil)
+		m.logger.EXPECT().WithFields(gomock.Any()).Return(m.logger)
+		m.logger.EXPECT().Info("Verified queued email addresses")
+
+		task := &domain.Task{ID: "task1", WorkspaceID: "ws1", Progress: 10}
+		completed, err := processor.Process(ctx, task, time.Now().Add(50*time.Second))
+
+		require.NoError(t, err)
+		assert.False(t, completed)
+		assert.Equal(t, 0.0, task.Progress)
+	})
+}
+
+func TestEnsureEmailVerificationTask(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("creates the task", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		taskRepo := mocks.NewMockTaskRepository(ctrl)
+		taskRepo.EXPECT().List(ctx, "ws1", gomock.Any()).Return(nil, 0, nil)
+		taskRepo.EXPECT().Create(ctx, "ws1", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, task *domain.Task) error {
+				assert.Equal(t, domain.TaskTypeProcessEmailVerificationQueue, task.Type)
+				assert.Equal(t, domain.TaskStatusPending, task.Status)
+				return nil
+			})
+
+		require.NoError(t, EnsureEmailVerificationTask(ctx, taskRepo, "ws1"))
+	})
+
+	t.Run("reschedules a paused task", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		taskRepo := mocks.NewMockTaskRepository(ctrl)
+		later := time.Now().Add(time.Hour)
+		existing := &domain.Task{ID: "task1", Status: domain.TaskStatusPaused, NextRunAfter: &later}
+		taskRepo.EXPECT().List(ctx, "ws1", gomock.Any()).Return([]*domain.Task{existing}, 1, nil)
+		taskRepo.EXPECT().Update(ctx, "ws1", existing).Return(nil)
+
+		require.NoError(t, EnsureEmailVerificationTask(ctx, taskRepo, "ws1"))
+		assert.Equal(t, domain.TaskStatusPending, existing.Status)
+		assert.False(t, existing.NextRunAfter.After(time.Now()))
+	})
+
+	t.Run("leaves a scheduled task alone", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		taskRepo := mocks.NewMockTaskRepository(ctrl)
+		earlier := time.Now().Add(-time.Minute)
+		existing := &domain.Task{ID: "task1", Status: domain.TaskStatusPending, NextRunAfter: &earlier}
+		taskRepo.EXPECT().List(ctx, "ws1", gomock.Any()).Return([]*domain.Task{existing}, 1, nil)
+
+		require.NoError(t, EnsureEmailVerificationTask(ctx, taskRepo, "ws1"))
+	})
+}
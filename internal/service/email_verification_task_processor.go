@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// EmailVerificationTaskProcessor verifies the addresses queued when contacts are created.
+// This is a permanent, recurring task that runs for each workspace.
+type EmailVerificationTaskProcessor struct {
+	verificationService *EmailVerificationService
+	logger              logger.Logger
+	idleWait            time.Duration
+}
+
+// NewEmailVerificationTaskProcessor creates a new email verification task processor
+func NewEmailVerificationTaskProcessor(verificationService *EmailVerificationService, logger logger.Logger) *EmailVerificationTaskProcessor {
+	return &EmailVerificationTaskProcessor{
+		verificationService: verificationService,
+		logger:              logger,
+		idleWait:            10 * time.Second,
+	}
+}
+
+// CanProcess returns whether this processor can handle the given task type
+func (p *EmailVerificationTaskProcessor) CanProcess(taskType string) bool {
+	return taskType == domain.TaskTypeProcessEmailVerificationQueue
+}
+
+// Process verifies queued addresses in batches until the timeout is near.
+// It always leaves the task pending so that it runs again on the next cron tick.
+func (p *EmailVerificationTaskProcessor) Process(ctx context.Context, task *domain.Task, timeoutAt time.Time) (bool, error) {
+	// Leave a buffer before the timeout, an SMTP probe can take a few seconds
+	bufferDuration := 15 * time.Second
+	totalProcessed := 0
+
+	for time.Now().Add(bufferDuration).Before(timeoutAt) && ctx.Err() == nil {
+		processed, err := p.verificationService.ProcessQueue(ctx, task.WorkspaceID)
+		totalProcessed += processed
+		if err != nil {
+			p.logger.WithFields(map[string]interface{}{
+				"task_id":      task.ID,
+				"workspace_id": task.WorkspaceID,
+				"error":        err.Error(),
+			}).Error("Failed to process email verification queue batch")
+			break
+		}
+
+		queueSize, err := p.verificationService.QueueSize(ctx, task.WorkspaceID)
+		if err != nil {
+			p.logger.WithFields(map[string]interface{}{
+				"task_id":      task.ID,
+				"workspace_id": task.WorkspaceID,
+				"error":        err.Error(),
+			}).Warn("Failed to get email verification queue size")
+			break
+		}
+
+		if queueSize == 0 {
+			if time.Now().Add(p.idleWait + bufferDuration).After(timeoutAt) {
+				break
+			}
+			time.Sleep(p.idleWait)
+		}
+	}
+
+	if totalProcessed > 0 {
+		p.logger.WithFields(map[string]interface{}{
+			"task_id":         task.ID,
+			"workspace_id":    task.WorkspaceID,
+			"total_processed": totalProcessed,
+		}).Info("Verified queued email addresses")
+	}
+
+	task.Progress = 0
+	return false, nil
+}
+
+// EnsureEmailVerificationTask creates the permanent email verification task for a workspace
+// or reschedules the existing one to run now
+func EnsureEmailVerificationTask(ctx context.Context, taskRepo domain.TaskRepository, workspaceID string) error {
+	tasks, _, err := taskRepo.List(ctx, workspaceID, domain.TaskFilter{
+		Type:  []string{domain.TaskTypeProcessEmailVerificationQueue},
+		Limit: 1,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check for existing email verification task: %w", err)
+	}
+
+	now := time.Now().UTC()
+	if len(tasks) > 0 {
+		existingTask := tasks[0]
+		if existingTask.Status == domain.TaskStatusPending && existingTask.NextRunAfter != nil && !existingTask.NextRunAfter.After(now) {
+			return nil
+		}
+		existingTask.Status = domain.TaskStatusPending
+		existingTask.NextRunAfter = &now
+		if err := taskRepo.Update(ctx, workspaceID, existingTask); err != nil {
+			return fmt.Errorf("failed to update email verification task: %w", err)
+		}
+		return nil
+	}
+
+	task := &domain.Task{
+		WorkspaceID:   workspaceID,
+		Type:          domain.TaskTypeProcessEmailVerificationQueue,
+		Status:        domain.TaskStatusPending,
+		NextRunAfter:  &now,
+		MaxRuntime:    50,
+		MaxRetries:    3,
+		RetryInterval: 60,
+		State: &domain.TaskState{
+			Message: "Email verification queue processing task",
+		},
+	}
+	if err := taskRepo.Create(ctx, workspaceID, task); err != nil {
+		return fmt.Errorf("failed to create email verification task: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+type HoldoutService struct {
+	repo           domain.HoldoutRepository
+	broadcastRepo  domain.BroadcastRepository
+	automationRepo domain.AutomationRepository
+	authService    domain.AuthService
+	logger         logger.Logger
+}
+
+func NewHoldoutService(
+	repo domain.HoldoutRepository,
+	broadcastRepo domain.BroadcastRepository,
+	automationRepo domain.AutomationRepository,
+	authService domain.AuthService,
+	logger logger.Logger,
+) *HoldoutService {
+	return &HoldoutService{
+		repo:           repo,
+		broadcastRepo:  broadcastRepo,
+		automationRepo: automationRepo,
+		authService:    authService,
+		logger:         logger,
+	}
+}
+
+// GetReport compares the goal conversions of the treated and holdout groups of a broadcast or automation
+func (s *HoldoutService) GetReport(ctx context.Context, req *domain.GetHoldoutReportRequest) (*domain.HoldoutReport, error) {
+	if err := req.Validate(); err != nil {
+		return nil, domain.NewValidationError(fmt.Sprintf("invalid request: %s", err.Error()))
+	}
+
+	ctx, _, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	var settings *domain.HoldoutSettings
+	switch req.SourceType {
+	case domain.HoldoutSourceBroadcast:
+		if !userWorkspace.HasPermission(domain.PermissionResourceBroadcasts, domain.PermissionTypeRead) {
+			return nil, domain.NewPermissionError(
+				domain.PermissionResourceBroadcasts,
+				domain.PermissionTypeRead,
+				"Insufficient permissions: read access to broadcasts required",
+			)
+		}
+
+		broadcast, err := s.broadcastRepo.GetBroadcast(ctx, req.WorkspaceID, req.SourceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get broadcast: %w", err)
+		}
+		settings = broadcast.Audience.Holdout
+
+	case domain.HoldoutSourceAutomation:
+		if !userWorkspace.HasPermission(domain.PermissionResourceAutomations, domain.PermissionTypeRead) {
+			return nil, domain.NewPermissionError(
+				domain.PermissionResourceAutomations,
+				domain.PermissionTypeRead,
+				"Insufficient permissions: read access to automations required",
+			)
+		}
+
+		automation, err := s.automationRepo.GetByID(ctx, req.WorkspaceID, req.SourceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get automation: %w", err)
+		}
+		settings = automation.Holdout
+	}
+
+	if !settings.IsEnabled() {
+		return nil, domain.NewValidationError(fmt.Sprintf("holdout is not enabled for this %s", req.SourceType))
+	}
+
+	treated, holdout, err := s.repo.GetGroupConversions(ctx, req.WorkspaceID, req.SourceType, req.SourceID, settings.GetConversionWindowDays(), req.GoalType)
+	if err != nil {
+		s.logger.WithFields(map[string]interface{}{
+			"workspace_id": req.WorkspaceID,
+			"source_type":  req.SourceType,
+			"source_id":    req.SourceID,
+			"error":        err.Error(),
+		}).Error("Failed to get holdout group conversions")
+		return nil, fmt.Errorf("failed to get holdout group conversions: %w", err)
+	}
+
+	return domain.NewHoldoutReport(req.SourceType, req.SourceID, settings, req.GoalType, *treated, *holdout), nil
+}
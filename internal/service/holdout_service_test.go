@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupHoldoutServiceTest(t *testing.T) (
+	*mocks.MockHoldoutRepository,
+	*mocks.MockBroadcastRepository,
+	*mocks.MockAutomationRepository,
+	*mocks.MockAuthService,
+	*HoldoutService,
+) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	mockRepo := mocks.NewMockHoldoutRepository(ctrl)
+	mockBroadcastRepo := mocks.NewMockBroadcastRepository(ctrl)
+	mockAutomationRepo := mocks.NewMockAutomationRepository(ctrl)
+	mockAuthService := mocks.NewMockAuthService(ctrl)
+	mockLogger := pkgmocks.NewMockLogger(ctrl)
+
+	mockLogger.EXPECT().WithFields(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Error(gomock.Any()).AnyTimes()
+
+	service := NewHoldoutService(mockRepo, mockBroadcastRepo, mockAutomationRepo, mockAuthService, mockLogger)
+
+	return mockRepo, mockBroadcastRepo, mockAutomationRepo, mockAuthService, service
+}
+
+func holdoutUserWorkspace(resource domain.PermissionResource) *domain.UserWorkspace {
+	return &domain.UserWorkspace{
+		WorkspaceID: "w1",
+		UserID:      "user123",
+		Permissions: domain.UserPermissions{
+			resource: domain.ResourcePermissions{Read: true},
+		},
+	}
+}
+
+func TestHoldoutService_GetReport(t *testing.T) {
+	ctx := context.Background()
+	holdout := &domain.HoldoutSettings{Enabled: true, Percentage: 10, ConversionWindowDays: 14}
+
+	t.Run("broadcast report", func(t *testing.T) {
+		mockRepo, mockBroadcastRepo, _, mockAuthService, service := setupHoldoutServiceTest(t)
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(gomock.Any(), "w1").
+			Return(ctx, &domain.User{ID: "user123"}, holdoutUserWorkspace(domain.PermissionResourceBroadcasts), nil)
+		mockBroadcastRepo.EXPECT().GetBroadcast(gomock.Any(), "w1", "b1").
+			Return(&domain.Broadcast{ID: "b1", Audience: domain.AudienceSettings{Holdout: holdout}}, nil)
+		mockRepo.EXPECT().GetGroupConversions(gomock.Any(), "w1", domain.HoldoutSourceBroadcast, "b1", 14, "").
+			Return(&domain.HoldoutGroupStats{Contacts: 900, Converted: 90}, &domain.HoldoutGroupStats{Contacts: 100, Converted: 5}, nil)
+
+		report, err := service.GetReport(ctx, &domain.GetHoldoutReportRequest{
+			WorkspaceID: "w1",
+			SourceType:  domain.HoldoutSourceBroadcast,
+			SourceID:    "b1",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 10, report.Percentage)
+		assert.Equal(t, 14, report.ConversionWindowDays)
+		assert.InDelta(t, 0.1, report.Treated.ConversionRate, 1e-9)
+		assert.InDelta(t, 0.05, report.Holdout.ConversionRate, 1e-9)
+	})
+
+	t.Run("automation report", func(t *testing.T) {
+		mockRepo, _, mockAutomationRepo, mockAuthService, service := setupHoldoutServiceTest(t)
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(gomock.Any(), "w1").
+			Return(ctx, &domain.User{ID: "user123"}, holdoutUserWorkspace(domain.PermissionResourceAutomations), nil)
+		mockAutomationRepo.EXPECT().GetByID(gomock.Any(), "w1", "a1").
+			Return(&domain.Automation{ID: "a1", Holdout: &domain.HoldoutSettings{Enabled: true, Percentage: 20}}, nil)
+		mockRepo.EXPECT().GetGroupConversions(gomock.Any(), "w1", domain.HoldoutSourceAutomation, "a1", domain.DefaultHoldoutConversionWindowDays, domain.GoalTypePurchase).
+			Return(&domain.HoldoutGroupStats{Contacts: 80}, &domain.HoldoutGroupStats{Contacts: 20}, nil)
+
+		report, err := service.GetReport(ctx, &domain.GetHoldoutReportRequest{
+			WorkspaceID: "w1",
+			SourceType:  domain.HoldoutSourceAutomation,
+			SourceID:    "a1",
+			GoalType:    domain.GoalTypePurchase,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.HoldoutSourceAutomation, report.SourceType)
+		assert.Equal(t, 20, report.Holdout.Contacts)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		_, _, _, _, service := setupHoldoutServiceTest(t)
+
+		_, err := service.GetReport(ctx, &domain.GetHoldoutReportRequest{WorkspaceID: "w1", SourceType: "list", SourceID: "l1"})
+
+		require.Error(t, err)
+		assert.IsType(t, domain.ValidationError{}, err)
+	})
+
+	t.Run("missing permission", func(t *testing.T) {
+		_, _, _, mockAuthService, service := setupHoldoutServiceTest(t)
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(gomock.Any(), "w1").
+			Return(ctx, &domain.User{ID: "user123"}, holdoutUserWorkspace(domain.PermissionResourceBroadcasts), nil)
+
+		_, err := service.GetReport(ctx, &domain.GetHoldoutReportRequest{
+			WorkspaceID: "w1",
+			SourceType:  domain.HoldoutSourceAutomation,
+			SourceID:    "a1",
+		})
+
+		require.Error(t, err)
+		var permErr *domain.PermissionError
+		assert.True(t, errors.As(err, &permErr))
+	})
+
+	t.Run("holdout not enabled", func(t *testing.T) {
+		_, mockBroadcastRepo, _, mockAuthService, service := setupHoldoutServiceTest(t)
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(gomock.Any(), "w1").
+			Return(ctx, &domain.User{ID: "user123"}, holdoutUserWorkspace(domain.PermissionResourceBroadcasts), nil)
+		mockBroadcastRepo.EXPECT().GetBroadcast(gomock.Any(), "w1", "b1").
+			Return(&domain.Broadcast{ID: "b1"}, nil)
+
+		_, err := service.GetReport(ctx, &domain.GetHoldoutReportRequest{
+			WorkspaceID: "w1",
+			SourceType:  domain.HoldoutSourceBroadcast,
+			SourceID:    "b1",
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "holdout is not enabled for this broadcast")
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo, mockBroadcastRepo, _, mockAuthService, service := setupHoldoutServiceTest(t)
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(gomock.Any(), "w1").
+			Return(ctx, &domain.User{ID: "user123"}, holdoutUserWorkspace(domain.PermissionResourceBroadcasts), nil)
+		mockBroadcastRepo.EXPECT().GetBroadcast(gomock.Any(), "w1", "b1").
+			Return(&domain.Broadcast{ID: "b1", Audience: domain.AudienceSettings{Holdout: holdout}}, nil)
+		mockRepo.EXPECT().GetGroupConversions(gomock.Any(), "w1", domain.HoldoutSourceBroadcast, "b1", 14, "").
+			Return(nil, nil, errors.New("db down"))
+
+		_, err := service.GetReport(ctx, &domain.GetHoldoutReportRequest{
+			WorkspaceID: "w1",
+			SourceType:  domain.HoldoutSourceBroadcast,
+			SourceID:    "b1",
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "db down")
+	})
+}
@@ -24,6 +24,7 @@ type InboundWebhookEventService struct {
 	workspaceRepo      domain.WorkspaceRepository
 	messageHistoryRepo domain.MessageHistoryRepository
 	contactRepo        domain.ContactRepository
+	suppressionRepo    domain.SuppressionRepository
 }
 
 // NewInboundWebhookEventService creates a new InboundWebhookEventService
@@ -34,6 +35,7 @@ func NewInboundWebhookEventService(
 	workspaceRepo domain.WorkspaceRepository,
 	messageHistoryRepo domain.MessageHistoryRepository,
 	contactRepo domain.ContactRepository,
+	suppressionRepo domain.SuppressionRepository,
 ) *InboundWebhookEventService {
 	return &InboundWebhookEventService{
 		repo:               repo,
@@ -42,6 +44,7 @@ func NewInboundWebhookEventService(
 		workspaceRepo:      workspaceRepo,
 		messageHistoryRepo: messageHistoryRepo,
 		contactRepo:        contactRepo,
+		suppressionRepo:    suppressionRepo,
 	}
 }
 
@@ -112,6 +115,7 @@ func (s *InboundWebhookEventService) ProcessWebhook(ctx context.Context, workspa
 	updates := []domain.MessageEventUpdate{}
 	var hardEmails []string
 	var softCountEmails []string
+	suppressions := newSuppressionBatch()
 
 	for _, event := range events {
 		switch event.Type {
@@ -134,6 +138,7 @@ func (s *InboundWebhookEventService) ProcessWebhook(ctx context.Context, workspa
 			switch class {
 			case domain.BounceClassificationHard:
 				hardEmails = append(hardEmails, event.RecipientEmail)
+				suppressions.add(event.RecipientEmail, domain.SuppressionReasonHardBounce, event.BounceDiagnostic, nil)
 				if event.MessageID != nil && *event.MessageID != "" {
 					reason := fmt.Sprintf("%s %s %s", event.BounceType, event.BounceCategory, event.BounceDiagnostic)
 					if len(reason) > 255 {
@@ -149,6 +154,8 @@ func (s *InboundWebhookEventService) ProcessWebhook(ctx context.Context, workspa
 
 			case domain.BounceClassificationSoftCount:
 				softCountEmails = append(softCountEmails, event.RecipientEmail)
+				expiresAt := time.Now().UTC().Add(domain.DefaultSoftBounceSuppressionTTL)
+				suppressions.add(event.RecipientEmail, domain.SuppressionReasonSoftBounce, event.BounceDiagnostic, &expiresAt)
 				s.logger.WithField("recipient_email", event.RecipientEmail).
 					WithField("bounce_type", event.BounceType).
 					WithField("bounce_category", event.BounceCategory).
@@ -162,6 +169,7 @@ func (s *InboundWebhookEventService) ProcessWebhook(ctx context.Context, workspa
 			}
 
 		case domain.EmailEventComplaint:
+			suppressions.add(event.RecipientEmail, domain.SuppressionReasonComplaint, event.ComplaintFeedbackType, nil)
 			if event.MessageID != nil && *event.MessageID != "" {
 				reason := event.ComplaintFeedbackType
 				if len(reason) > 255 {
@@ -196,6 +204,7 @@ func (s *InboundWebhookEventService) ProcessWebhook(ctx context.Context, workspa
 		for email, n := range counts {
 			if n >= threshold {
 				hardEmails = append(hardEmails, email)
+				suppressions.add(email, domain.SuppressionReasonHardBounce, fmt.Sprintf("%d consecutive soft bounces", n), nil)
 			}
 		}
 	}
@@ -209,9 +218,57 @@ func (s *InboundWebhookEventService) ProcessWebhook(ctx context.Context, workspa
 		}
 	}
 
+	if s.suppressionRepo != nil && len(suppressions.entries) > 0 {
+		if err := s.suppressionRepo.Upsert(ctx, workspaceID, suppressions.entries); err != nil {
+			// codecov:ignore:start
+			tracing.MarkSpanError(ctx, err)
+			// codecov:ignore:end
+			return fmt.Errorf("failed to update suppression list: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// suppressionBatch collects the suppression entries derived from a webhook batch,
+// one per address, in order of first occurrence
+type suppressionBatch struct {
+	entries   []*domain.Suppression
+	byPattern map[string]*domain.Suppression
+}
+
+func newSuppressionBatch() *suppressionBatch {
+	return &suppressionBatch{byPattern: make(map[string]*domain.Suppression)}
+}
+
+// add records a provider suppression for the address. A permanent entry is
+// never replaced by a temporary one.
+func (b *suppressionBatch) add(email string, reason domain.SuppressionReason, details string, expiresAt *time.Time) {
+	suppression := &domain.Suppression{
+		Pattern:   email,
+		Reason:    reason,
+		Source:    domain.SuppressionSourceProvider,
+		Details:   details,
+		ExpiresAt: expiresAt,
+	}
+	if len(suppression.Details) > 1000 {
+		suppression.Details = suppression.Details[:1000]
+	}
+	if err := suppression.Validate(); err != nil || suppression.Kind != domain.SuppressionKindEmail {
+		return
+	}
+
+	existing, ok := b.byPattern[suppression.Pattern]
+	if !ok {
+		b.byPattern[suppression.Pattern] = suppression
+		b.entries = append(b.entries, suppression)
+		return
+	}
+	if existing.ExpiresAt != nil || expiresAt == nil {
+		*existing = *suppression
+	}
+}
+
 // dedupeStrings returns a new slice with duplicates removed, preserving the
 // order of first occurrence.
 func dedupeStrings(in []string) []string {
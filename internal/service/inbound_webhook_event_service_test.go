@@ -329,7 +329,9 @@ func TestNewInboundWebhookEventService(t *testing.T) {
 	contactRepo := mocks.NewMockContactRepository(ctrl)
 	messageHistoryRepo := mocks.NewMockMessageHistoryRepository(ctrl)
 
-	service := NewInboundWebhookEventService(repo, authService, log, workspaceRepo, messageHistoryRepo, contactRepo)
+	suppressionRepo := mocks.NewMockSuppressionRepository(ctrl)
+
+	service := NewInboundWebhookEventService(repo, authService, log, workspaceRepo, messageHistoryRepo, contactRepo, suppressionRepo)
 
 	assert.NotNil(t, service)
 	assert.Equal(t, repo, service.repo)
@@ -338,6 +340,7 @@ func TestNewInboundWebhookEventService(t *testing.T) {
 	assert.Equal(t, workspaceRepo, service.workspaceRepo)
 	assert.Equal(t, messageHistoryRepo, service.messageHistoryRepo)
 	assert.Equal(t, contactRepo, service.contactRepo)
+	assert.Equal(t, suppressionRepo, service.suppressionRepo)
 }
 
 func TestProcessSESWebhook(t *testing.T) {
@@ -2939,6 +2942,71 @@ func TestProcessWebhook_BatchedMixedClassifications(t *testing.T) {
 	require.NoError(t, service.ProcessWebhook(context.Background(), workspaceID, integrationID, rawPayload))
 }
 
+func TestProcessWebhook_PopulatesSuppressionList(t *testing.T) {
+	service, repo, workspaceRepo, messageHistoryRepo, contactRepo, ctrl := newClassificationTestService(t)
+	defer ctrl.Finish()
+	suppressionRepo := mocks.NewMockSuppressionRepository(ctrl)
+	service.suppressionRepo = suppressionRepo
+
+	workspaceID, integrationID := "ws1", "int1"
+	sp := []*domain.SparkPostWebhookPayload{
+		{MSys: domain.SparkPostMSys{MessageEvent: &domain.SparkPostMessageEvent{
+			Type: "bounce", RecipientTo: "Hard@example.com", MessageID: "m-h",
+			Timestamp: time.Now().Format(time.RFC3339), BounceClass: "10",
+		}}},
+		{MSys: domain.SparkPostMSys{MessageEvent: &domain.SparkPostMessageEvent{
+			Type: "bounce", RecipientTo: "soft1@example.com", MessageID: "m-s1",
+			Timestamp: time.Now().Format(time.RFC3339), BounceClass: "20",
+		}}},
+		{MSys: domain.SparkPostMSys{MessageEvent: &domain.SparkPostMessageEvent{
+			Type: "bounce", RecipientTo: "soft2@example.com", MessageID: "m-s2",
+			Timestamp: time.Now().Format(time.RFC3339), BounceClass: "20",
+		}}},
+	}
+	rawPayload, err := json.Marshal(sp)
+	require.NoError(t, err)
+
+	workspace := &domain.Workspace{
+		ID: workspaceID,
+		Integrations: []domain.Integration{
+			{ID: integrationID, EmailProvider: domain.EmailProvider{Kind: domain.EmailProviderKindSparkPost}},
+		},
+	}
+	workspaceRepo.EXPECT().GetByID(gomock.Any(), workspaceID).Return(workspace, nil)
+	repo.EXPECT().StoreEvents(gomock.Any(), workspaceID, gomock.Any()).Return(nil)
+	messageHistoryRepo.EXPECT().SetStatusesIfNotSet(gomock.Any(), workspaceID, gomock.Any()).Return(nil)
+	repo.EXPECT().
+		CountConsecutiveSoftBounces(gomock.Any(), workspaceID, gomock.Any()).
+		Return(map[string]int{"soft1@example.com": domain.DefaultSoftBounceThreshold, "soft2@example.com": 1}, nil)
+	contactRepo.EXPECT().MarkEmailsAsBounced(gomock.Any(), workspaceID, gomock.Any(), gomock.Any()).Return(nil)
+
+	suppressionRepo.EXPECT().
+		Upsert(gomock.Any(), workspaceID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, suppressions []*domain.Suppression) error {
+			require.Len(t, suppressions, 3)
+
+			// Hard bounces are suppressed permanently
+			assert.Equal(t, "hard@example.com", suppressions[0].Pattern)
+			assert.Equal(t, domain.SuppressionReasonHardBounce, suppressions[0].Reason)
+			assert.Equal(t, domain.SuppressionSourceProvider, suppressions[0].Source)
+			assert.Nil(t, suppressions[0].ExpiresAt)
+
+			// Soft bounces reaching the threshold become permanent hard bounces
+			assert.Equal(t, "soft1@example.com", suppressions[1].Pattern)
+			assert.Equal(t, domain.SuppressionReasonHardBounce, suppressions[1].Reason)
+			assert.Nil(t, suppressions[1].ExpiresAt)
+
+			// Other soft bounces expire
+			assert.Equal(t, "soft2@example.com", suppressions[2].Pattern)
+			assert.Equal(t, domain.SuppressionReasonSoftBounce, suppressions[2].Reason)
+			require.NotNil(t, suppressions[2].ExpiresAt)
+			assert.WithinDuration(t, time.Now().Add(domain.DefaultSoftBounceSuppressionTTL), *suppressions[2].ExpiresAt, time.Minute)
+			return nil
+		})
+
+	require.NoError(t, service.ProcessWebhook(context.Background(), workspaceID, integrationID, rawPayload))
+}
+
 // TestListEvents tests the ListEvents method of WebhookEventService
 func TestListEvents(t *testing.T) {
 	// Setup
@@ -417,6 +417,7 @@ func (s *ListService) SubscribeToLists(ctx context.Context, payload *domain.Subs
 		req := domain.TemplateDataRequest{
 			WorkspaceID:         workspace.ID,
 			WorkspaceSecretKey:  workspace.Settings.SecretKey,
+			TemplateSecrets:     workspace.Settings.TemplateSecrets,
 			WorkspaceWebsiteURL: workspace.Settings.WebsiteURL,
 			ContactWithList: domain.ContactWithList{
 				Contact:  contact,
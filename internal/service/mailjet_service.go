@@ -534,6 +534,7 @@ func (s *MailjetService) SendEmail(ctx context.Context, request domain.SendEmail
 		},
 		Subject:  request.Subject,
 		HTMLPart: request.Content,
+		TextPart: request.Text,
 		CustomID: request.MessageID,
 	}
 
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -77,11 +78,12 @@ func (s *NotificationCenterService) GetContactPreferences(ctx context.Context, w
 	}
 
 	return &domain.ContactPreferencesResponse{
-		Contact:      contact,
-		PublicLists:  publicLists,
-		ContactLists: contact.ContactLists,
-		LogoURL:      workspace.Settings.LogoURL,
-		WebsiteURL:   workspace.Settings.WebsiteURL,
+		Contact:           contact,
+		PublicLists:       publicLists,
+		ContactLists:      contact.ContactLists,
+		LogoURL:           workspace.Settings.LogoURL,
+		WebsiteURL:        workspace.Settings.WebsiteURL,
+		DataExportEnabled: workspace.Settings.NotificationCenterDataExportEnabled,
 	}, nil
 }
 
@@ -113,3 +115,31 @@ func (s *NotificationCenterService) UpdateContactPreferences(ctx context.Context
 
 	return nil
 }
+
+// ExportContactData returns everything stored about a contact for the self-service "download my data" link
+func (s *NotificationCenterService) ExportContactData(ctx context.Context, workspaceID string, email string, emailHMAC string) (*domain.ContactDataExport, error) {
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		s.logger.Error(fmt.Sprintf("Failed to get workspace: %v", err))
+		return nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+
+	if !domain.VerifyEmailHMAC(email, emailHMAC, workspace.Settings.SecretKey) {
+		return nil, fmt.Errorf("invalid email verification")
+	}
+
+	if !workspace.Settings.NotificationCenterDataExportEnabled {
+		return nil, domain.ErrDataExportDisabled
+	}
+
+	export, err := s.contactRepo.ExportContactData(ctx, workspaceID, email)
+	if err != nil {
+		if errors.Is(err, domain.ErrContactNotFound) {
+			return nil, err
+		}
+		s.logger.WithField("email", email).Error(fmt.Sprintf("Failed to export contact data: %v", err))
+		return nil, fmt.Errorf("failed to export contact data: %w", err)
+	}
+
+	return export, nil
+}
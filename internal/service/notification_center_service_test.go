@@ -709,3 +709,101 @@ func TestNotificationCenterService_UpdateContactPreferences(t *testing.T) {
 		})
 	}
 }
+
+func TestNotificationCenterService_ExportContactData(t *testing.T) {
+	secretKey := "test-secret-key"
+	validEmail := "user@example.com"
+	validHMAC := crypto.ComputeHMAC256([]byte(validEmail), secretKey)
+
+	newWorkspace := func(enabled bool) *domain.Workspace {
+		return &domain.Workspace{
+			ID: "workspace-123",
+			Settings: domain.WorkspaceSettings{
+				SecretKey:                           secretKey,
+				NotificationCenterDataExportEnabled: enabled,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		emailHMAC     string
+		setupMocks    func(mockContactRepo *mocks.MockContactRepository, mockWorkspaceRepo *mocks.MockWorkspaceRepository, mockLogger *pkgmocks.MockLogger)
+		expectedError error
+		errorContains string
+	}{
+		{
+			name:      "Success",
+			emailHMAC: validHMAC,
+			setupMocks: func(mockContactRepo *mocks.MockContactRepository, mockWorkspaceRepo *mocks.MockWorkspaceRepository, mockLogger *pkgmocks.MockLogger) {
+				mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "workspace-123").Return(newWorkspace(true), nil)
+				mockContactRepo.EXPECT().ExportContactData(gomock.Any(), "workspace-123", validEmail).
+					Return(&domain.ContactDataExport{Email: validEmail}, nil)
+			},
+		},
+		{
+			name:      "Invalid HMAC",
+			emailHMAC: "invalid",
+			setupMocks: func(mockContactRepo *mocks.MockContactRepository, mockWorkspaceRepo *mocks.MockWorkspaceRepository, mockLogger *pkgmocks.MockLogger) {
+				mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "workspace-123").Return(newWorkspace(true), nil)
+			},
+			errorContains: "invalid email verification",
+		},
+		{
+			name:      "Export disabled",
+			emailHMAC: validHMAC,
+			setupMocks: func(mockContactRepo *mocks.MockContactRepository, mockWorkspaceRepo *mocks.MockWorkspaceRepository, mockLogger *pkgmocks.MockLogger) {
+				mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "workspace-123").Return(newWorkspace(false), nil)
+			},
+			expectedError: domain.ErrDataExportDisabled,
+		},
+		{
+			name:      "Contact not found",
+			emailHMAC: validHMAC,
+			setupMocks: func(mockContactRepo *mocks.MockContactRepository, mockWorkspaceRepo *mocks.MockWorkspaceRepository, mockLogger *pkgmocks.MockLogger) {
+				mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "workspace-123").Return(newWorkspace(true), nil)
+				mockContactRepo.EXPECT().ExportContactData(gomock.Any(), "workspace-123", validEmail).
+					Return(nil, domain.ErrContactNotFound)
+			},
+			expectedError: domain.ErrContactNotFound,
+		},
+		{
+			name:      "Repository error",
+			emailHMAC: validHMAC,
+			setupMocks: func(mockContactRepo *mocks.MockContactRepository, mockWorkspaceRepo *mocks.MockWorkspaceRepository, mockLogger *pkgmocks.MockLogger) {
+				mockWorkspaceRepo.EXPECT().GetByID(gomock.Any(), "workspace-123").Return(newWorkspace(true), nil)
+				mockContactRepo.EXPECT().ExportContactData(gomock.Any(), "workspace-123", validEmail).
+					Return(nil, errors.New("db error"))
+				mockLogger.EXPECT().WithField("email", validEmail).Return(mockLogger)
+				mockLogger.EXPECT().Error(gomock.Any())
+			},
+			errorContains: "failed to export contact data",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockContactRepo := mocks.NewMockContactRepository(ctrl)
+			mockWorkspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+			mockListRepo := mocks.NewMockListRepository(ctrl)
+			mockLogger := pkgmocks.NewMockLogger(ctrl)
+			tc.setupMocks(mockContactRepo, mockWorkspaceRepo, mockLogger)
+
+			service := NewNotificationCenterService(mockContactRepo, mockWorkspaceRepo, mockListRepo, mockLogger)
+			export, err := service.ExportContactData(context.Background(), "workspace-123", validEmail, tc.emailHMAC)
+
+			switch {
+			case tc.expectedError != nil:
+				assert.ErrorIs(t, err, tc.expectedError)
+			case tc.errorContains != "":
+				assert.ErrorContains(t, err, tc.errorContains)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, validEmail, export.Email)
+			}
+		})
+	}
+}
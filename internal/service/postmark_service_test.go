@@ -1612,6 +1612,39 @@ func TestPostmarkService_SendEmail(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("Sends the plain-text alternative", func(t *testing.T) {
+		service, httpClient, _, _ := setupPostmarkTest(t)
+
+		httpClient.EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				body, _ := io.ReadAll(req.Body)
+				var requestBody map[string]interface{}
+				require.NoError(t, json.Unmarshal(body, &requestBody))
+				assert.Equal(t, "<p>Hello</p>", requestBody["HtmlBody"])
+				assert.Equal(t, "Hello", requestBody["TextBody"])
+
+				return createMockResponse(http.StatusOK, `{"MessageID":"12345"}`), nil
+			})
+
+		request := domain.SendEmailProviderRequest{
+			WorkspaceID:   "workspace-123",
+			IntegrationID: "test-integration-id",
+			MessageID:     "test-message-id",
+			FromAddress:   "sender@example.com",
+			FromName:      "Sender Name",
+			To:            "recipient@example.com",
+			Subject:       "Test Email",
+			Content:       "<p>Hello</p>",
+			Text:          "Hello",
+			Provider: &domain.EmailProvider{
+				Kind:     domain.EmailProviderKindPostmark,
+				Postmark: &domain.PostmarkSettings{ServerToken: "test-server-token"},
+			},
+		}
+		assert.NoError(t, service.SendEmail(context.Background(), request))
+	})
+
 	t.Run("Missing Postmark configuration", func(t *testing.T) {
 		// Setup
 		service, _, _, _ := setupPostmarkTest(t)
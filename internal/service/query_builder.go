@@ -83,8 +83,10 @@ func (qb *QueryBuilder) initializeContactFields() {
 	}
 
 	// JSON fields (stored as JSONB in PostgreSQL)
+	// Typed attributes are filtered through json_path: ["<attribute name>"]
 	jsonFields := []string{
 		"custom_json_1", "custom_json_2", "custom_json_3", "custom_json_4", "custom_json_5",
+		"attributes",
 	}
 	for _, field := range jsonFields {
 		qb.allowedFields[field] = fieldConfig{
@@ -92,6 +94,12 @@ func (qb *QueryBuilder) initializeContactFields() {
 			fieldType: "json",
 		}
 	}
+
+	// Email verification status (valid, risky, invalid, unknown), NULL until the address is verified
+	qb.allowedFields["email_verification_status"] = fieldConfig{
+		dbColumn:  "(SELECT v.status FROM contact_email_verifications v WHERE v.email = contacts.email)",
+		fieldType: "string",
+	}
 }
 
 // initializeOperators sets up the whitelist of allowed operators
@@ -229,6 +237,12 @@ func (qb *QueryBuilder) parseLeaf(leaf *domain.TreeNodeLeaf, argIndex int) (stri
 		}
 		return qb.parseContactListConditions(leaf.ContactList, argIndex)
 
+	case "contact_tags":
+		if leaf.ContactTag == nil {
+			return "", nil, argIndex, fmt.Errorf("leaf with source 'contact_tags' must have 'contact_tag' field")
+		}
+		return qb.parseContactTagConditionWithEmailRef(leaf.ContactTag, argIndex, "contacts.email")
+
 	case "contact_timeline":
 		if leaf.ContactTimeline == nil {
 			return "", nil, argIndex, fmt.Errorf("leaf with source 'contact_timeline' must have 'contact_timeline' field")
@@ -242,7 +256,7 @@ func (qb *QueryBuilder) parseLeaf(leaf *domain.TreeNodeLeaf, argIndex int) (stri
 		return qb.parseCustomEventsGoalCondition(leaf.CustomEventsGoal, argIndex)
 
 	default:
-		return "", nil, argIndex, fmt.Errorf("unsupported source: %s (supported: 'contacts', 'contact_lists', 'contact_timeline', 'custom_events_goals')", leaf.Source)
+		return "", nil, argIndex, fmt.Errorf("unsupported source: %s (supported: 'contacts', 'contact_lists', 'contact_tags', 'contact_timeline', 'custom_events_goals')", leaf.Source)
 	}
 }
 
@@ -1172,6 +1186,12 @@ func (qb *QueryBuilder) parseLeafWithEmailRef(leaf *domain.TreeNodeLeaf, argInde
 		}
 		return qb.parseContactListConditionsWithEmailRef(leaf.ContactList, argIndex, emailRef)
 
+	case "contact_tags":
+		if leaf.ContactTag == nil {
+			return "", nil, argIndex, fmt.Errorf("leaf with source 'contact_tags' must have 'contact_tag' field")
+		}
+		return qb.parseContactTagConditionWithEmailRef(leaf.ContactTag, argIndex, emailRef)
+
 	case "contact_timeline":
 		if leaf.ContactTimeline == nil {
 			return "", nil, argIndex, fmt.Errorf("leaf with source 'contact_timeline' must have 'contact_timeline' field")
@@ -1223,6 +1243,36 @@ func (qb *QueryBuilder) parseContactConditionsForTrigger(contact *domain.Contact
 	return existsClause, args, argIndex, nil
 }
 
+// parseContactTagConditionWithEmailRef generates an EXISTS subquery checking
+// whether the contact referenced by emailRef carries a tag
+func (qb *QueryBuilder) parseContactTagConditionWithEmailRef(contactTag *domain.ContactTagCondition, argIndex int, emailRef string) (string, []interface{}, int, error) {
+	if contactTag == nil {
+		return "", nil, argIndex, fmt.Errorf("contact_tag condition cannot be nil")
+	}
+
+	tag := domain.NormalizeTag(contactTag.Tag)
+	if tag == "" {
+		return "", nil, argIndex, fmt.Errorf("contact_tag must have 'tag'")
+	}
+
+	existsClause := fmt.Sprintf(
+		"EXISTS (SELECT 1 FROM contact_tags ct WHERE ct.email = %s AND ct.tag = $%d)",
+		emailRef,
+		argIndex,
+	)
+	argIndex++
+
+	switch contactTag.Operator {
+	case "in", "":
+	case "not_in":
+		existsClause = "NOT " + existsClause
+	default:
+		return "", nil, argIndex, fmt.Errorf("invalid contact_tag operator: %s (must be 'in' or 'not_in')", contactTag.Operator)
+	}
+
+	return existsClause, []interface{}{tag}, argIndex, nil
+}
+
 // parseContactListConditionsWithEmailRef generates SQL for contact_lists filtering with custom email reference
 func (qb *QueryBuilder) parseContactListConditionsWithEmailRef(contactList *domain.ContactListCondition, argIndex int, emailRef string) (string, []interface{}, int, error) {
 	if contactList == nil {
@@ -60,6 +60,30 @@ func TestQueryBuilder_BuildSQL_SimpleConditions(t *testing.T) {
 		assert.Equal(t, []interface{}{5.0}, args)
 	})
 
+	t.Run("email verification status condition", func(t *testing.T) {
+		tree := &domain.TreeNode{
+			Kind: "leaf",
+			Leaf: &domain.TreeNodeLeaf{
+				Source: "contacts",
+				Contact: &domain.ContactCondition{
+					Filters: []*domain.DimensionFilter{
+						{
+							FieldName:    "email_verification_status",
+							FieldType:    "string",
+							Operator:     "not_equals",
+							StringValues: []string{"invalid"},
+						},
+					},
+				},
+			},
+		}
+
+		sql, args, err := qb.BuildSQL(tree)
+		require.NoError(t, err)
+		assert.Equal(t, "SELECT email FROM contacts WHERE ((SELECT v.status FROM contact_email_verifications v WHERE v.email = contacts.email) != $1)", sql)
+		assert.Equal(t, []interface{}{"invalid"}, args)
+	})
+
 	t.Run("is_set condition (no value needed)", func(t *testing.T) {
 		tree := &domain.TreeNode{
 			Kind: "leaf",
@@ -964,6 +988,98 @@ func TestQueryBuilder_ContactLists(t *testing.T) {
 	})
 }
 
+func TestQueryBuilder_ContactTags(t *testing.T) {
+	qb := NewQueryBuilder()
+
+	t.Run("contact has tag", func(t *testing.T) {
+		tree := &domain.TreeNode{
+			Kind: "leaf",
+			Leaf: &domain.TreeNodeLeaf{
+				Source:     "contact_tags",
+				ContactTag: &domain.ContactTagCondition{Operator: "in", Tag: "VIP "},
+			},
+		}
+
+		sql, args, err := qb.BuildSQL(tree)
+		require.NoError(t, err)
+
+		assert.Equal(t, "SELECT email FROM contacts WHERE EXISTS (SELECT 1 FROM contact_tags ct WHERE ct.email = contacts.email AND ct.tag = $1)", sql)
+		assert.Equal(t, []interface{}{"vip"}, args)
+	})
+
+	t.Run("contact does not have tag, combined with another condition", func(t *testing.T) {
+		tree := &domain.TreeNode{
+			Kind: "branch",
+			Branch: &domain.TreeNodeBranch{
+				Operator: "and",
+				Leaves: []*domain.TreeNode{
+					{
+						Kind: "leaf",
+						Leaf: &domain.TreeNodeLeaf{
+							Source:      "contact_lists",
+							ContactList: &domain.ContactListCondition{Operator: "in", ListID: "newsletter"},
+						},
+					},
+					{
+						Kind: "leaf",
+						Leaf: &domain.TreeNodeLeaf{
+							Source:     "contact_tags",
+							ContactTag: &domain.ContactTagCondition{Operator: "not_in", Tag: "churned"},
+						},
+					},
+				},
+			},
+		}
+
+		sql, args, err := qb.BuildSQL(tree)
+		require.NoError(t, err)
+
+		assert.Contains(t, sql, "NOT EXISTS (SELECT 1 FROM contact_tags ct WHERE ct.email = contacts.email AND ct.tag = $2)")
+		assert.Equal(t, []interface{}{"newsletter", "churned"}, args)
+	})
+
+	t.Run("missing condition", func(t *testing.T) {
+		tree := &domain.TreeNode{
+			Kind: "leaf",
+			Leaf: &domain.TreeNodeLeaf{Source: "contact_tags"},
+		}
+
+		_, _, err := qb.BuildSQL(tree)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must have 'contact_tag' field")
+	})
+
+	t.Run("invalid operator", func(t *testing.T) {
+		tree := &domain.TreeNode{
+			Kind: "leaf",
+			Leaf: &domain.TreeNodeLeaf{
+				Source:     "contact_tags",
+				ContactTag: &domain.ContactTagCondition{Operator: "contains", Tag: "vip"},
+			},
+		}
+
+		_, _, err := qb.BuildSQL(tree)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid contact_tag operator")
+	})
+
+	t.Run("trigger condition uses the email reference", func(t *testing.T) {
+		tree := &domain.TreeNode{
+			Kind: "leaf",
+			Leaf: &domain.TreeNodeLeaf{
+				Source:     "contact_tags",
+				ContactTag: &domain.ContactTagCondition{Operator: "in", Tag: "vip"},
+			},
+		}
+
+		sql, args, err := qb.BuildTriggerCondition(tree, "NEW.email")
+		require.NoError(t, err)
+
+		assert.Equal(t, "EXISTS (SELECT 1 FROM contact_tags ct WHERE ct.email = NEW.email AND ct.tag = $1)", sql)
+		assert.Equal(t, []interface{}{"vip"}, args)
+	})
+}
+
 func TestQueryBuilder_ContactTimeline(t *testing.T) {
 	qb := NewQueryBuilder()
 
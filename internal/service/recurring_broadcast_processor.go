@@ -0,0 +1,351 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/service/broadcast"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// RecurringBroadcastProcessor spawns the broadcast due at each occurrence of a recurring broadcast
+type RecurringBroadcastProcessor struct {
+	broadcastRepo   domain.BroadcastRepository
+	workspaceRepo   domain.WorkspaceRepository
+	listService     domain.ListService
+	templateSvc     domain.TemplateService
+	taskRepo        domain.TaskRepository
+	eventBus        domain.EventBus
+	dataFeedFetcher broadcast.DataFeedFetcher
+	blogService     domain.BlogService
+	logger          logger.Logger
+}
+
+// NewRecurringBroadcastProcessor creates a new recurring broadcast processor
+func NewRecurringBroadcastProcessor(
+	broadcastRepo domain.BroadcastRepository,
+	workspaceRepo domain.WorkspaceRepository,
+	listService domain.ListService,
+	templateSvc domain.TemplateService,
+	taskRepo domain.TaskRepository,
+	eventBus domain.EventBus,
+	dataFeedFetcher broadcast.DataFeedFetcher,
+	blogService domain.BlogService,
+	logger logger.Logger,
+) *RecurringBroadcastProcessor {
+	return &RecurringBroadcastProcessor{
+		broadcastRepo:   broadcastRepo,
+		workspaceRepo:   workspaceRepo,
+		listService:     listService,
+		templateSvc:     templateSvc,
+		taskRepo:        taskRepo,
+		eventBus:        eventBus,
+		dataFeedFetcher: dataFeedFetcher,
+		blogService:     blogService,
+		logger:          logger,
+	}
+}
+
+// CanProcess returns whether this processor can handle the given task type
+func (p *RecurringBroadcastProcessor) CanProcess(taskType string) bool {
+	return taskType == domain.TaskTypeRecurringBroadcast
+}
+
+// Process sends the instance due at the task occurrence and schedules the task of the next one.
+// Both the instance and the next task have IDs derived from the occurrence, so a retried task
+// never sends an occurrence twice nor forks the chain of tasks.
+func (p *RecurringBroadcastProcessor) Process(ctx context.Context, task *domain.Task, timeoutAt time.Time) (bool, error) {
+	if task.State == nil || task.State.RecurringBroadcast == nil {
+		return false, fmt.Errorf("task state missing RecurringBroadcast data - task may not have been properly initialized")
+	}
+	state := task.State.RecurringBroadcast
+
+	parent, err := p.broadcastRepo.GetBroadcast(ctx, task.WorkspaceID, state.BroadcastID)
+	if err != nil {
+		var notFound *domain.ErrBroadcastNotFound
+		if errors.As(err, &notFound) {
+			p.logger.WithField("broadcast_id", state.BroadcastID).Warn("Recurring broadcast not found, completing task")
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get recurring broadcast: %w", err)
+	}
+
+	// Cancelled recurring broadcasts stop spawning instances
+	if parent.Status != domain.BroadcastStatusRecurring || !parent.Schedule.IsRecurring() {
+		p.logger.WithFields(map[string]interface{}{
+			"broadcast_id": parent.ID,
+			"status":       parent.Status,
+		}).Info("Broadcast is no longer recurring, completing task")
+		return true, nil
+	}
+
+	message := fmt.Sprintf("Sent occurrence of %s", state.Occurrence.Format(time.RFC3339))
+	instanceID := domain.RecurringInstanceID(parent.ID, state.Occurrence)
+	_, err = p.broadcastRepo.GetBroadcast(ctx, task.WorkspaceID, instanceID)
+	if err != nil {
+		var notFound *domain.ErrBroadcastNotFound
+		if !errors.As(err, &notFound) {
+			return false, fmt.Errorf("failed to get broadcast instance: %w", err)
+		}
+		sent, err := p.spawnInstance(ctx, task.WorkspaceID, parent, instanceID, state.Occurrence)
+		if err != nil {
+			return false, err
+		}
+		if !sent {
+			message = fmt.Sprintf("Skipped occurrence of %s: no new blog posts", state.Occurrence.Format(time.RFC3339))
+		}
+	}
+
+	if err := p.scheduleNextOccurrence(ctx, task.WorkspaceID, parent, state.Occurrence); err != nil {
+		return false, err
+	}
+
+	task.Progress = 1
+	task.State.Message = message
+	return true, nil
+}
+
+// spawnInstance creates the broadcast of an occurrence and starts sending it through the
+// regular broadcast flow, which targets the audience as it is at that time. It returns false
+// when the occurrence is skipped because a blog digest has no new posts.
+func (p *RecurringBroadcastProcessor) spawnInstance(ctx context.Context, workspaceID string, parent *domain.Broadcast, instanceID string, occurrence time.Time) (bool, error) {
+	workspace, err := p.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get workspace: %w", err)
+	}
+
+	instance, err := newRecurringInstance(parent, instanceID, occurrence)
+	if err != nil {
+		return false, err
+	}
+
+	if parent.DataFeed.IsBlogDigest() {
+		hasPosts, err := p.fetchBlogDigest(ctx, workspaceID, parent, instance)
+		if err != nil {
+			return false, err
+		}
+		if !hasPosts {
+			p.logger.WithFields(map[string]interface{}{
+				"workspace_id": workspaceID,
+				"broadcast_id": parent.ID,
+				"occurrence":   occurrence.Format(time.RFC3339),
+			}).Info("No new blog posts, skipping recurring broadcast occurrence")
+			return false, nil
+		}
+	}
+
+	if err := fetchGlobalFeed(ctx, p.dataFeedFetcher, p.listService, p.logger, workspace, instance); err != nil {
+		return false, err
+	}
+
+	// The approval of the parent was cleared by an edit, the occurrence waits for a review.
+	// Otherwise the occurrence carries the approval so that it sends the reviewed templates.
+	if workspace.Settings.BroadcastApproval.IsRequired() {
+		if parent.Approval.IsApproved() {
+			approval := *parent.Approval
+			instance.Approval = &approval
+		} else {
+			instance.Status = domain.BroadcastStatusDraft
+			instance.StartedAt = nil
+		}
+	}
+
+	// Templates published since the recurring broadcast was scheduled go through the same
+	// pre-send checks, an occurrence that fails them is kept as a draft
+	if instance.Status != domain.BroadcastStatusDraft {
+		systemCtx := context.WithValue(ctx, domain.SystemCallKey, true)
+		report, err := lintBroadcast(systemCtx, p.templateSvc, workspaceID, instance)
+		if err != nil {
+			return false, fmt.Errorf("failed to lint broadcast instance: %w", err)
+		}
+		if !report.Passed {
+			p.logger.WithFields(map[string]interface{}{
+				"workspace_id": workspaceID,
+				"broadcast_id": parent.ID,
+				"instance_id":  instance.ID,
+			}).Warn("Recurring broadcast occurrence did not pass the pre-send checks, keeping it as a draft")
+			instance.Status = domain.BroadcastStatusDraft
+			instance.StartedAt = nil
+		}
+	}
+
+	done := make(chan error, 1)
+	err = p.broadcastRepo.WithTransaction(ctx, workspaceID, func(tx *sql.Tx) error {
+		if err := p.broadcastRepo.CreateBroadcastTx(ctx, tx, instance); err != nil {
+			return err
+		}
+		if instance.Status == domain.BroadcastStatusDraft {
+			return nil
+		}
+
+		p.eventBus.PublishWithAck(ctx, domain.EventPayload{
+			Type:        domain.EventBroadcastScheduled,
+			WorkspaceID: workspaceID,
+			EntityID:    instance.ID,
+			Data: map[string]interface{}{
+				"broadcast_id": instance.ID,
+				"send_now":     true,
+				"status":       string(instance.Status),
+			},
+		}, func(eventErr error) {
+			if eventErr != nil {
+				done <- fmt.Errorf("failed to process schedule event: %w", eventErr)
+				return
+			}
+			done <- nil
+		})
+
+		// Roll back the instance if its send task could not be created
+		select {
+		case eventErr := <-done:
+			return eventErr
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to spawn recurring broadcast instance: %w", err)
+	}
+
+	p.logger.WithFields(map[string]interface{}{
+		"workspace_id": workspaceID,
+		"broadcast_id": parent.ID,
+		"instance_id":  instance.ID,
+		"occurrence":   occurrence.Format(time.RFC3339),
+	}).Info("Recurring broadcast instance spawned")
+	return true, nil
+}
+
+// fetchBlogDigest sets the posts published since the previous digest on the instance, and
+// returns false when there is none. The first digest covers the posts published since the
+// recurring broadcast was created.
+func (p *RecurringBroadcastProcessor) fetchBlogDigest(ctx context.Context, workspaceID string, parent, instance *domain.Broadcast) (bool, error) {
+	since := parent.CreatedAt
+	previous, err := p.broadcastRepo.ListBroadcasts(ctx, domain.ListBroadcastsParams{
+		WorkspaceID: workspaceID,
+		ParentID:    parent.ID,
+		Limit:       1,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to get previous blog digest: %w", err)
+	}
+	if len(previous.Broadcasts) > 0 {
+		last := previous.Broadcasts[0]
+		since = last.CreatedAt
+		if last.DataFeed != nil && last.DataFeed.BlogDigestUntil != nil {
+			since = *last.DataFeed.BlogDigestUntil
+		}
+	}
+
+	until := time.Now().UTC()
+	items, err := p.blogService.ListDigestPosts(ctx, workspaceID, parent.DataFeed.BlogDigest, since, until)
+	if err != nil {
+		return false, fmt.Errorf("failed to get blog digest posts: %w", err)
+	}
+	if len(items) == 0 {
+		return false, nil
+	}
+
+	instance.DataFeed.BlogDigestPosts = domain.BuildBlogDigestPosts(items)
+	instance.DataFeed.BlogDigestUntil = &until
+	return true, nil
+}
+
+// scheduleNextOccurrence creates the task of the next occurrence, or marks the recurring
+// broadcast as processed when its recurrence has ended
+func (p *RecurringBroadcastProcessor) scheduleNextOccurrence(ctx context.Context, workspaceID string, parent *domain.Broadcast, occurrence time.Time) error {
+	// Occurrences missed while the server was down are skipped rather than sent late
+	after := occurrence
+	if now := time.Now(); now.After(after) {
+		after = now
+	}
+
+	next, err := parent.Schedule.NextOccurrence(after)
+	if err != nil {
+		return fmt.Errorf("failed to compute next occurrence: %w", err)
+	}
+
+	if next.IsZero() {
+		now := time.Now().UTC()
+		parent.Status = domain.BroadcastStatusProcessed
+		parent.CompletedAt = &now
+		err := p.broadcastRepo.WithTransaction(ctx, workspaceID, func(tx *sql.Tx) error {
+			return p.broadcastRepo.UpdateBroadcastStatusTx(ctx, tx, parent)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to complete recurring broadcast: %w", err)
+		}
+		p.logger.WithField("broadcast_id", parent.ID).Info("Recurring broadcast has no more occurrences")
+		return nil
+	}
+
+	nextTask := domain.NewRecurringBroadcastTask(workspaceID, parent.ID, next)
+	if _, err := p.taskRepo.Get(ctx, workspaceID, nextTask.ID); err == nil {
+		// Already scheduled by a previous attempt
+		return nil
+	} else if !errors.Is(err, domain.ErrTaskNotFound) {
+		return fmt.Errorf("failed to get next recurring broadcast task: %w", err)
+	}
+
+	if err := p.taskRepo.Create(ctx, workspaceID, nextTask); err != nil {
+		return fmt.Errorf("failed to create next recurring broadcast task: %w", err)
+	}
+	return nil
+}
+
+// newRecurringInstance copies the content and audience of a recurring broadcast into the
+// broadcast sent at one of its occurrences
+func newRecurringInstance(parent *domain.Broadcast, instanceID string, occurrence time.Time) (*domain.Broadcast, error) {
+	now := time.Now().UTC()
+	parentID := parent.ID
+
+	schedule := domain.ScheduleSettings{
+		IsScheduled:          true,
+		UseRecipientTimezone: parent.Schedule.UseRecipientTimezone,
+	}
+	if err := schedule.SetScheduledDateTime(occurrence, parent.Schedule.Timezone); err != nil {
+		return nil, err
+	}
+
+	name := domain.SuffixBroadcastName(parent.Name, fmt.Sprintf(" (%s)", schedule.ScheduledDate))
+
+	// Variations keep their subject overrides, each occurrence starts without metrics
+	testSettings := parent.TestSettings
+	testSettings.Variations = make([]domain.BroadcastVariation, len(parent.TestSettings.Variations))
+	for i, variation := range parent.TestSettings.Variations {
+		variation.Metrics = nil
+		variation.Template = nil
+		testSettings.Variations[i] = variation
+	}
+
+	var dataFeed *domain.DataFeedSettings
+	if parent.DataFeed != nil {
+		dataFeed = &domain.DataFeedSettings{
+			GlobalFeed:    parent.DataFeed.GlobalFeed,
+			RecipientFeed: parent.DataFeed.RecipientFeed,
+			BlogDigest:    parent.DataFeed.BlogDigest,
+		}
+	}
+
+	return &domain.Broadcast{
+		ID:                instanceID,
+		WorkspaceID:       parent.WorkspaceID,
+		Name:              name,
+		ChannelType:       parent.ChannelType,
+		Status:            domain.BroadcastStatusProcessing,
+		Audience:          parent.Audience,
+		Schedule:          schedule,
+		TestSettings:      testSettings,
+		UTMParameters:     parent.UTMParameters,
+		Metadata:          parent.Metadata,
+		DataFeed:          dataFeed,
+		ParentBroadcastID: &parentID,
+		StartedAt:         &now,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}, nil
+}
@@ -0,0 +1,382 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	broadcastmocks "github.com/Notifuse/notifuse/internal/service/broadcast/mocks"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+type recurringBroadcastProcessorTest struct {
+	broadcastRepo   *mocks.MockBroadcastRepository
+	workspaceRepo   *mocks.MockWorkspaceRepository
+	listService     *mocks.MockListService
+	templateSvc     *mocks.MockTemplateService
+	taskRepo        *mocks.MockTaskRepository
+	eventBus        *mocks.MockEventBus
+	dataFeedFetcher *broadcastmocks.MockDataFeedFetcher
+	blogService     *mocks.MockBlogService
+	processor       *RecurringBroadcastProcessor
+}
+
+func setupRecurringBroadcastProcessorTest(t *testing.T) *recurringBroadcastProcessorTest {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	pt := &recurringBroadcastProcessorTest{
+		broadcastRepo:   mocks.NewMockBroadcastRepository(ctrl),
+		workspaceRepo:   mocks.NewMockWorkspaceRepository(ctrl),
+		listService:     mocks.NewMockListService(ctrl),
+		templateSvc:     mocks.NewMockTemplateService(ctrl),
+		taskRepo:        mocks.NewMockTaskRepository(ctrl),
+		eventBus:        mocks.NewMockEventBus(ctrl),
+		dataFeedFetcher: broadcastmocks.NewMockDataFeedFetcher(ctrl),
+		blogService:     mocks.NewMockBlogService(ctrl),
+	}
+	pt.processor = NewRecurringBroadcastProcessor(pt.broadcastRepo, pt.workspaceRepo, pt.listService, pt.templateSvc, pt.taskRepo,
+		pt.eventBus, pt.dataFeedFetcher, pt.blogService, logger.NewLoggerWithLevel("disabled"))
+
+	pt.broadcastRepo.EXPECT().WithTransaction(gomock.Any(), "ws1", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, fn func(*sql.Tx) error) error { return fn(nil) },
+	).AnyTimes()
+	return pt
+}
+
+func recurringParent() *domain.Broadcast {
+	parent := testBroadcast("ws1", "parent1")
+	parent.Name = "Weekly digest"
+	parent.Status = domain.BroadcastStatusRecurring
+	parent.Schedule = domain.ScheduleSettings{
+		IsScheduled:   true,
+		ScheduledDate: "2999-01-04", // far enough for no occurrence to be skipped as missed
+		ScheduledTime: "09:00",
+		Timezone:      "UTC",
+		Recurrence:    "FREQ=WEEKLY;COUNT=3",
+	}
+	parent.TestSettings.Variations[0].Subject = "This week at Acme"
+	parent.TestSettings.Variations[0].Metrics = &domain.VariationMetrics{Opens: 12}
+	return parent
+}
+
+func newRecurringTask(occurrence time.Time) *domain.Task {
+	return domain.NewRecurringBroadcastTask("ws1", "parent1", occurrence)
+}
+
+func TestRecurringBroadcastProcessor_CanProcess(t *testing.T) {
+	processor := NewRecurringBroadcastProcessor(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	assert.True(t, processor.CanProcess(domain.TaskTypeRecurringBroadcast))
+	assert.False(t, processor.CanProcess("send_broadcast"))
+}
+
+func TestRecurringBroadcastProcessor_Process(t *testing.T) {
+	ctx := context.Background()
+	first := time.Date(2999, 1, 4, 9, 0, 0, 0, time.UTC)
+	instanceID := domain.RecurringInstanceID("parent1", first)
+
+	t.Run("spawns the instance and schedules the next occurrence", func(t *testing.T) {
+		pt := setupRecurringBroadcastProcessorTest(t)
+		parent := recurringParent()
+
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", "parent1").Return(parent, nil)
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", instanceID).Return(nil, &domain.ErrBroadcastNotFound{ID: instanceID})
+		pt.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(&domain.Workspace{ID: "ws1"}, nil)
+		expectPublishedTemplates(pt.templateSvc)
+
+		var instance *domain.Broadcast
+		pt.broadcastRepo.EXPECT().CreateBroadcastTx(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *sql.Tx, b *domain.Broadcast) error {
+				instance = b
+				return nil
+			})
+		pt.eventBus.EXPECT().PublishWithAck(ctx, gomock.Any(), gomock.Any()).Do(
+			func(_ context.Context, payload domain.EventPayload, ack domain.EventAckCallback) {
+				assert.Equal(t, domain.EventBroadcastScheduled, payload.Type)
+				assert.Equal(t, instanceID, payload.EntityID)
+				assert.Equal(t, true, payload.Data["send_now"])
+				ack(nil)
+			})
+
+		next := domain.NewRecurringBroadcastTask("ws1", "parent1", first.AddDate(0, 0, 7))
+		pt.taskRepo.EXPECT().Get(ctx, "ws1", next.ID).Return(nil, domain.ErrTaskNotFound)
+		pt.taskRepo.EXPECT().Create(ctx, "ws1", gomock.Any()).DoAndReturn(func(_ context.Context, _ string, task *domain.Task) error {
+			assert.Equal(t, next.ID, task.ID)
+			assert.True(t, first.AddDate(0, 0, 7).Equal(*task.NextRunAfter))
+			return nil
+		})
+
+		completed, err := pt.processor.Process(ctx, newRecurringTask(first), time.Now().Add(time.Minute))
+
+		require.NoError(t, err)
+		assert.True(t, completed)
+		require.NotNil(t, instance)
+		assert.Equal(t, "Weekly digest (2999-01-04)", instance.Name)
+		assert.Equal(t, domain.BroadcastStatusProcessing, instance.Status)
+		require.NotNil(t, instance.ParentBroadcastID)
+		assert.Equal(t, "parent1", *instance.ParentBroadcastID)
+		assert.Equal(t, parent.Audience, instance.Audience)
+		assert.False(t, instance.Schedule.IsRecurring())
+		assert.Equal(t, "09:00", instance.Schedule.ScheduledTime)
+		assert.Nil(t, instance.TestSettings.Variations[0].Metrics)
+		assert.Equal(t, "This week at Acme", instance.TestSettings.Variations[0].Subject)
+	})
+
+	t.Run("spawns a draft when the templates fail the pre-send checks", func(t *testing.T) {
+		pt := setupRecurringBroadcastProcessorTest(t)
+
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", "parent1").Return(recurringParent(), nil)
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", instanceID).Return(nil, &domain.ErrBroadcastNotFound{ID: instanceID})
+		pt.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(&domain.Workspace{ID: "ws1"}, nil)
+
+		// A version without unsubscribe link was published since the broadcast was scheduled
+		template := publishedTemplate("tplA")
+		source := `<mjml><mj-body><mj-section><mj-column><mj-text>Our spring collection is here.</mj-text></mj-column></mj-section></mj-body></mjml>`
+		template.Email.MjmlSource = &source
+		pt.templateSvc.EXPECT().GetPublishedTemplate(gomock.Any(), "ws1", "tplA").Return(template, nil)
+
+		var instance *domain.Broadcast
+		pt.broadcastRepo.EXPECT().CreateBroadcastTx(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *sql.Tx, b *domain.Broadcast) error {
+				instance = b
+				return nil
+			})
+		// No schedule event: the occurrence is not sent
+
+		pt.taskRepo.EXPECT().Get(ctx, "ws1", gomock.Any()).Return(nil, domain.ErrTaskNotFound)
+		pt.taskRepo.EXPECT().Create(ctx, "ws1", gomock.Any()).Return(nil)
+
+		completed, err := pt.processor.Process(ctx, newRecurringTask(first), time.Now().Add(time.Minute))
+
+		require.NoError(t, err)
+		assert.True(t, completed)
+		require.NotNil(t, instance)
+		assert.Equal(t, domain.BroadcastStatusDraft, instance.Status)
+		assert.Nil(t, instance.StartedAt)
+	})
+
+	t.Run("spawns a draft when the parent lost its approval", func(t *testing.T) {
+		pt := setupRecurringBroadcastProcessorTest(t)
+		parent := recurringParent()
+
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", "parent1").Return(parent, nil)
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", instanceID).Return(nil, &domain.ErrBroadcastNotFound{ID: instanceID})
+		pt.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(&domain.Workspace{
+			ID: "ws1",
+			Settings: domain.WorkspaceSettings{
+				BroadcastApproval: &domain.BroadcastApprovalSettings{Enabled: true},
+			},
+		}, nil)
+
+		var instance *domain.Broadcast
+		pt.broadcastRepo.EXPECT().CreateBroadcastTx(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *sql.Tx, b *domain.Broadcast) error {
+				instance = b
+				return nil
+			})
+		// No schedule event: the occurrence is sent once approved and scheduled
+
+		next := domain.NewRecurringBroadcastTask("ws1", "parent1", first.AddDate(0, 0, 7))
+		pt.taskRepo.EXPECT().Get(ctx, "ws1", next.ID).Return(nil, domain.ErrTaskNotFound)
+		pt.taskRepo.EXPECT().Create(ctx, "ws1", gomock.Any()).Return(nil)
+
+		completed, err := pt.processor.Process(ctx, newRecurringTask(first), time.Now().Add(time.Minute))
+
+		require.NoError(t, err)
+		assert.True(t, completed)
+		require.NotNil(t, instance)
+		assert.Equal(t, domain.BroadcastStatusDraft, instance.Status)
+		assert.Nil(t, instance.StartedAt)
+	})
+
+	t.Run("sends the reviewed templates of an approved parent", func(t *testing.T) {
+		pt := setupRecurringBroadcastProcessorTest(t)
+		parent := recurringParent()
+		parent.Approval = &domain.BroadcastApproval{
+			RequestedBy:       "author",
+			RequiredApprovals: 1,
+			Reviews:           []domain.BroadcastReview{{UserID: "reviewer1", Decision: domain.BroadcastReviewApproved}},
+			TemplateVersions:  map[string]int64{"tplA": 1},
+		}
+
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", "parent1").Return(parent, nil)
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", instanceID).Return(nil, &domain.ErrBroadcastNotFound{ID: instanceID})
+		pt.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(&domain.Workspace{
+			ID: "ws1",
+			Settings: domain.WorkspaceSettings{
+				BroadcastApproval: &domain.BroadcastApprovalSettings{Enabled: true},
+			},
+		}, nil)
+		pt.templateSvc.EXPECT().GetTemplateByID(gomock.Any(), "ws1", "tplA", int64(1)).Return(publishedTemplate("tplA"), nil)
+
+		var instance *domain.Broadcast
+		pt.broadcastRepo.EXPECT().CreateBroadcastTx(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *sql.Tx, b *domain.Broadcast) error {
+				instance = b
+				return nil
+			})
+		pt.eventBus.EXPECT().PublishWithAck(ctx, gomock.Any(), gomock.Any()).Do(
+			func(_ context.Context, _ domain.EventPayload, ack domain.EventAckCallback) { ack(nil) })
+
+		next := domain.NewRecurringBroadcastTask("ws1", "parent1", first.AddDate(0, 0, 7))
+		pt.taskRepo.EXPECT().Get(ctx, "ws1", next.ID).Return(nil, domain.ErrTaskNotFound)
+		pt.taskRepo.EXPECT().Create(ctx, "ws1", gomock.Any()).Return(nil)
+
+		completed, err := pt.processor.Process(ctx, newRecurringTask(first), time.Now().Add(time.Minute))
+
+		require.NoError(t, err)
+		assert.True(t, completed)
+		require.NotNil(t, instance)
+		assert.Equal(t, domain.BroadcastStatusProcessing, instance.Status)
+		assert.Equal(t, int64(1), instance.Approval.TemplateVersion("tplA"))
+	})
+
+	t.Run("does not spawn an occurrence twice", func(t *testing.T) {
+		pt := setupRecurringBroadcastProcessorTest(t)
+
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", "parent1").Return(recurringParent(), nil)
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", instanceID).Return(&domain.Broadcast{ID: instanceID}, nil)
+		pt.taskRepo.EXPECT().Get(ctx, "ws1", gomock.Any()).Return(&domain.Task{}, nil)
+
+		completed, err := pt.processor.Process(ctx, newRecurringTask(first), time.Now().Add(time.Minute))
+
+		require.NoError(t, err)
+		assert.True(t, completed)
+	})
+
+	t.Run("marks the broadcast as processed after the last occurrence", func(t *testing.T) {
+		pt := setupRecurringBroadcastProcessorTest(t)
+		parent := recurringParent()
+		last := first.AddDate(0, 0, 14)
+
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", "parent1").Return(parent, nil)
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", domain.RecurringInstanceID("parent1", last)).Return(&domain.Broadcast{}, nil)
+		pt.broadcastRepo.EXPECT().UpdateBroadcastStatusTx(ctx, gomock.Any(), parent).Return(nil)
+
+		completed, err := pt.processor.Process(ctx, newRecurringTask(last), time.Now().Add(time.Minute))
+
+		require.NoError(t, err)
+		assert.True(t, completed)
+		assert.Equal(t, domain.BroadcastStatusProcessed, parent.Status)
+		assert.NotNil(t, parent.CompletedAt)
+	})
+
+	t.Run("stops when the broadcast was cancelled", func(t *testing.T) {
+		pt := setupRecurringBroadcastProcessorTest(t)
+		parent := recurringParent()
+		parent.Status = domain.BroadcastStatusCancelled
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", "parent1").Return(parent, nil)
+
+		completed, err := pt.processor.Process(ctx, newRecurringTask(first), time.Now().Add(time.Minute))
+
+		require.NoError(t, err)
+		assert.True(t, completed)
+	})
+
+	t.Run("stops when the broadcast was deleted", func(t *testing.T) {
+		pt := setupRecurringBroadcastProcessorTest(t)
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", "parent1").Return(nil, &domain.ErrBroadcastNotFound{ID: "parent1"})
+
+		completed, err := pt.processor.Process(ctx, newRecurringTask(first), time.Now().Add(time.Minute))
+
+		require.NoError(t, err)
+		assert.True(t, completed)
+	})
+
+	t.Run("retries when the send task cannot be created", func(t *testing.T) {
+		pt := setupRecurringBroadcastProcessorTest(t)
+
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", "parent1").Return(recurringParent(), nil)
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", instanceID).Return(nil, &domain.ErrBroadcastNotFound{ID: instanceID})
+		pt.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(&domain.Workspace{ID: "ws1"}, nil)
+		expectPublishedTemplates(pt.templateSvc)
+		pt.broadcastRepo.EXPECT().CreateBroadcastTx(ctx, gomock.Any(), gomock.Any()).Return(nil)
+		pt.eventBus.EXPECT().PublishWithAck(ctx, gomock.Any(), gomock.Any()).Do(
+			func(_ context.Context, _ domain.EventPayload, ack domain.EventAckCallback) {
+				ack(errors.New("db error"))
+			})
+
+		completed, err := pt.processor.Process(ctx, newRecurringTask(first), time.Now().Add(time.Minute))
+
+		require.Error(t, err)
+		assert.False(t, completed)
+		assert.Contains(t, err.Error(), "failed to spawn recurring broadcast instance")
+	})
+
+	t.Run("sends the blog posts published since the previous digest", func(t *testing.T) {
+		pt := setupRecurringBroadcastProcessorTest(t)
+		parent := recurringParent()
+		parent.DataFeed = &domain.DataFeedSettings{BlogDigest: &domain.BlogDigestSettings{Enabled: true, CategoryID: "news"}}
+		previousUntil := time.Date(2998, 12, 28, 9, 0, 5, 0, time.UTC)
+		published := previousUntil.Add(24 * time.Hour)
+
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", "parent1").Return(parent, nil)
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", instanceID).Return(nil, &domain.ErrBroadcastNotFound{ID: instanceID})
+		pt.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(&domain.Workspace{ID: "ws1"}, nil)
+		expectPublishedTemplates(pt.templateSvc)
+		pt.broadcastRepo.EXPECT().ListBroadcasts(ctx, domain.ListBroadcastsParams{WorkspaceID: "ws1", ParentID: "parent1", Limit: 1}).
+			Return(&domain.BroadcastListResponse{Broadcasts: []*domain.Broadcast{
+				{ID: "previous", DataFeed: &domain.DataFeedSettings{BlogDigestUntil: &previousUntil}},
+			}}, nil)
+		pt.blogService.EXPECT().ListDigestPosts(ctx, "ws1", parent.DataFeed.BlogDigest, previousUntil, gomock.Any()).
+			Return([]domain.BlogFeedItem{{GUID: "p1", Title: "Hello", URL: "https://blog.example.com/news/hello", PublishedAt: published}}, nil)
+
+		var instance *domain.Broadcast
+		pt.broadcastRepo.EXPECT().CreateBroadcastTx(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *sql.Tx, b *domain.Broadcast) error {
+				instance = b
+				return nil
+			})
+		pt.eventBus.EXPECT().PublishWithAck(ctx, gomock.Any(), gomock.Any()).Do(
+			func(_ context.Context, _ domain.EventPayload, ack domain.EventAckCallback) { ack(nil) })
+		pt.taskRepo.EXPECT().Get(ctx, "ws1", gomock.Any()).Return(&domain.Task{}, nil)
+
+		completed, err := pt.processor.Process(ctx, newRecurringTask(first), time.Now().Add(time.Minute))
+
+		require.NoError(t, err)
+		assert.True(t, completed)
+		require.NotNil(t, instance)
+		require.Len(t, instance.DataFeed.BlogDigestPosts, 1)
+		assert.Equal(t, "Hello", instance.DataFeed.BlogDigestPosts[0]["title"])
+		assert.Equal(t, "https://blog.example.com/news/hello", instance.DataFeed.BlogDigestPosts[0]["url"])
+		require.NotNil(t, instance.DataFeed.BlogDigestUntil)
+	})
+
+	t.Run("skips the occurrence when there are no new blog posts", func(t *testing.T) {
+		pt := setupRecurringBroadcastProcessorTest(t)
+		parent := recurringParent()
+		parent.DataFeed = &domain.DataFeedSettings{BlogDigest: &domain.BlogDigestSettings{Enabled: true}}
+
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", "parent1").Return(parent, nil)
+		pt.broadcastRepo.EXPECT().GetBroadcast(ctx, "ws1", instanceID).Return(nil, &domain.ErrBroadcastNotFound{ID: instanceID})
+		pt.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(&domain.Workspace{ID: "ws1"}, nil)
+		pt.broadcastRepo.EXPECT().ListBroadcasts(ctx, gomock.Any()).Return(&domain.BroadcastListResponse{}, nil)
+		// Without a previous digest, posts are collected from the creation of the broadcast
+		pt.blogService.EXPECT().ListDigestPosts(ctx, "ws1", gomock.Any(), parent.CreatedAt, gomock.Any()).Return([]domain.BlogFeedItem{}, nil)
+		pt.taskRepo.EXPECT().Get(ctx, "ws1", gomock.Any()).Return(nil, domain.ErrTaskNotFound)
+		pt.taskRepo.EXPECT().Create(ctx, "ws1", gomock.Any()).Return(nil)
+
+		task := newRecurringTask(first)
+		completed, err := pt.processor.Process(ctx, task, time.Now().Add(time.Minute))
+
+		require.NoError(t, err)
+		assert.True(t, completed)
+		assert.Contains(t, task.State.Message, "no new blog posts")
+	})
+
+	t.Run("missing state", func(t *testing.T) {
+		pt := setupRecurringBroadcastProcessorTest(t)
+
+		_, err := pt.processor.Process(ctx, &domain.Task{ID: "task1", State: &domain.TaskState{}}, time.Now().Add(time.Minute))
+
+		assert.Error(t, err)
+	})
+}
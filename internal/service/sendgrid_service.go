@@ -339,6 +339,11 @@ func (s *SendGridService) SendEmail(ctx context.Context, request domain.SendEmai
 		},
 	}
 
+	// SendGrid requires the text/plain part to come before text/html
+	if request.Text != "" {
+		mailReq.Content = append([]Content{{Type: "text/plain", Value: request.Text}}, mailReq.Content...)
+	}
+
 	// Add reply-to if specified
 	if request.EmailOptions.ReplyTo != "" {
 		mailReq.ReplyTo = &EmailAddress{Email: request.EmailOptions.ReplyTo}
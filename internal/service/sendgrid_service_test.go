@@ -440,6 +440,42 @@ func TestSendGridService_SendEmail(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("Success with plain-text alternative", func(t *testing.T) {
+		ctx := context.Background()
+
+		request := domain.SendEmailProviderRequest{
+			WorkspaceID:   "workspace-123",
+			IntegrationID: "integration-456",
+			MessageID:     "msg-789",
+			FromAddress:   "sender@example.com",
+			FromName:      "Sender Name",
+			To:            "recipient@example.com",
+			Subject:       "Test Subject",
+			Content:       "<p>Test content</p>",
+			Text:          "Test content",
+			Provider: &domain.EmailProvider{
+				Kind: domain.EmailProviderKindSendGrid,
+				SendGrid: &domain.SendGridSettings{
+					APIKey: "SG.test-api-key",
+				},
+			},
+		}
+
+		mockHTTPClient.EXPECT().
+			Do(gomock.Any()).
+			DoAndReturn(func(req *http.Request) (*http.Response, error) {
+				// SendGrid expects text/plain before text/html
+				body, _ := io.ReadAll(req.Body)
+				assert.Contains(t, string(body), `"content":[{"type":"text/plain","value":"Test content"},{"type":"text/html","value":"\u003cp\u003eTest content\u003c/p\u003e"}]`)
+
+				return mockSendGridHTTPResponse(http.StatusAccepted, `{}`), nil
+			})
+
+		err := sendGridService.SendEmail(ctx, request)
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("Success with email options", func(t *testing.T) {
 		ctx := context.Background()
 
@@ -853,6 +853,7 @@ func (s *SESService) SendEmail(ctx context.Context, request domain.SendEmailProv
 					Charset: aws.String("UTF-8"),
 					Data:    aws.String(request.Content),
 				},
+				Text: sesTextContent(request.Text),
 			},
 			Subject: &ses.Content{
 				Charset: aws.String("UTF-8"),
@@ -981,24 +982,34 @@ func (s *SESService) sendRawEmail(ctx context.Context, sesClient domain.SESClien
 	boundary := writer.Boundary()
 	buf.WriteString(fmt.Sprintf("Content-Type: multipart/mixed; boundary=\"%s\"\r\n\r\n", boundary))
 
-	// Add HTML body part
-	htmlPart := textproto.MIMEHeader{}
-	htmlPart.Set("Content-Type", "text/html; charset=UTF-8")
-	htmlPart.Set("Content-Transfer-Encoding", "quoted-printable")
-
-	htmlWriter, err := writer.CreatePart(htmlPart)
-	if err != nil {
-		return fmt.Errorf("failed to create HTML part: %w", err)
-	}
+	// Add the body: the HTML part alone, or nested in a multipart/alternative
+	// after the text part when the email has a plain-text alternative
+	if request.Text == "" {
+		if err := writeSESBodyPart(writer, "text/html", request.Content); err != nil {
+			return fmt.Errorf("failed to write HTML content: %w", err)
+		}
+	} else {
+		var alternative bytes.Buffer
+		alternativeWriter := multipart.NewWriter(&alternative)
+		if err := writeSESBodyPart(alternativeWriter, "text/plain", request.Text); err != nil {
+			return fmt.Errorf("failed to write text content: %w", err)
+		}
+		if err := writeSESBodyPart(alternativeWriter, "text/html", request.Content); err != nil {
+			return fmt.Errorf("failed to write HTML content: %w", err)
+		}
+		if err := alternativeWriter.Close(); err != nil {
+			return fmt.Errorf("failed to close alternative part: %w", err)
+		}
 
-	// Wrap with quoted-printable encoder for RFC 2045 compliance (Issue #230)
-	qpWriter := quotedprintable.NewWriter(htmlWriter)
-	if _, err := qpWriter.Write([]byte(request.Content)); err != nil {
-		qpWriter.Close()
-		return fmt.Errorf("failed to write HTML content: %w", err)
-	}
-	if err := qpWriter.Close(); err != nil {
-		return fmt.Errorf("failed to close quoted-printable writer: %w", err)
+		alternativePart := textproto.MIMEHeader{}
+		alternativePart.Set("Content-Type", fmt.Sprintf("multipart/alternative; boundary=\"%s\"", alternativeWriter.Boundary()))
+		partWriter, err := writer.CreatePart(alternativePart)
+		if err != nil {
+			return fmt.Errorf("failed to create alternative part: %w", err)
+		}
+		if _, err := partWriter.Write(alternative.Bytes()); err != nil {
+			return fmt.Errorf("failed to write alternative part: %w", err)
+		}
 	}
 
 	// Add attachments
@@ -1111,3 +1122,34 @@ func (s *SESService) sendRawEmail(ctx context.Context, sesClient domain.SESClien
 
 	return nil
 }
+
+// writeSESBodyPart writes a quoted-printable body part of the given text type
+func writeSESBodyPart(writer *multipart.Writer, contentType string, content string) error {
+	part := textproto.MIMEHeader{}
+	part.Set("Content-Type", contentType+"; charset=UTF-8")
+	part.Set("Content-Transfer-Encoding", "quoted-printable")
+
+	partWriter, err := writer.CreatePart(part)
+	if err != nil {
+		return err
+	}
+
+	// Wrap with quoted-printable encoder for RFC 2045 compliance (Issue #230)
+	qpWriter := quotedprintable.NewWriter(partWriter)
+	if _, err := qpWriter.Write([]byte(content)); err != nil {
+		qpWriter.Close()
+		return err
+	}
+	return qpWriter.Close()
+}
+
+// sesTextContent returns the text body of a simple SES email, nil without a plain-text alternative
+func sesTextContent(text string) *ses.Content {
+	if text == "" {
+		return nil
+	}
+	return &ses.Content{
+		Charset: aws.String("UTF-8"),
+		Data:    aws.String(text),
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/sns"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Helper function to create a mock SES service for testing
@@ -3470,3 +3471,84 @@ func TestSendEmail_QuotedPrintableRoundTrip(t *testing.T) {
 	decodedContent := string(decodedBytes)
 	assert.Equal(t, originalContent, decodedContent, "Decoded content should match original")
 }
+
+// Test SendEmail - with plain-text alternative
+func TestSendEmail_WithTextAlternative(t *testing.T) {
+	provider := &domain.EmailProvider{
+		SES: &domain.AmazonSESSettings{
+			AccessKey: "test-access-key",
+			SecretKey: "test-secret-key",
+			Region:    "us-east-1",
+		},
+	}
+
+	t.Run("simple email carries the text body", func(t *testing.T) {
+		service, mockSESClient, _, _, _ := createMockSESService(t)
+
+		mockSESClient.EXPECT().
+			ListConfigurationSetsWithContext(gomock.Any(), gomock.Any()).
+			Return(&ses.ListConfigurationSetsOutput{}, nil)
+
+		mockSESClient.EXPECT().
+			SendEmailWithContext(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, input *ses.SendEmailInput, _ ...request.Option) (*ses.SendEmailOutput, error) {
+				require.NotNil(t, input.Message.Body.Text)
+				assert.Equal(t, "Hello", *input.Message.Body.Text.Data)
+				assert.Equal(t, "<p>Hello</p>", *input.Message.Body.Html.Data)
+				return &ses.SendEmailOutput{}, nil
+			})
+
+		err := service.SendEmail(context.Background(), domain.SendEmailProviderRequest{
+			WorkspaceID:   "workspace",
+			IntegrationID: "test-integration-id",
+			MessageID:     "message",
+			FromAddress:   "from@example.com",
+			FromName:      "From",
+			To:            "to@example.com",
+			Subject:       "Subject",
+			Content:       "<p>Hello</p>",
+			Text:          "Hello",
+			Provider:      provider,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("raw email nests the parts in a multipart/alternative", func(t *testing.T) {
+		service, mockSESClient, _, _, _ := createMockSESService(t)
+
+		mockSESClient.EXPECT().
+			ListConfigurationSetsWithContext(gomock.Any(), gomock.Any()).
+			Return(&ses.ListConfigurationSetsOutput{}, nil)
+
+		mockSESClient.EXPECT().
+			SendRawEmailWithContext(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, input *ses.SendRawEmailInput, _ ...request.Option) (*ses.SendRawEmailOutput, error) {
+				rawData := string(input.RawMessage.Data)
+				assert.Contains(t, rawData, "Content-Type: multipart/alternative")
+				textIndex := strings.Index(rawData, "Content-Type: text/plain; charset=UTF-8")
+				htmlIndex := strings.Index(rawData, "Content-Type: text/html; charset=UTF-8")
+				require.NotEqual(t, -1, textIndex)
+				require.NotEqual(t, -1, htmlIndex)
+				assert.Less(t, textIndex, htmlIndex, "the text part must come before the HTML part")
+				assert.Contains(t, rawData, "test.txt")
+				return &ses.SendRawEmailOutput{}, nil
+			})
+
+		err := service.SendEmail(context.Background(), domain.SendEmailProviderRequest{
+			WorkspaceID:   "workspace",
+			IntegrationID: "test-integration-id",
+			MessageID:     "message",
+			FromAddress:   "from@example.com",
+			FromName:      "From",
+			To:            "to@example.com",
+			Subject:       "Subject",
+			Content:       "<p>Hello</p>",
+			Text:          "Hello",
+			Provider:      provider,
+			EmailOptions: domain.EmailOptions{Attachments: []domain.Attachment{
+				{Filename: "test.txt", Content: "SGVsbG8gV29ybGQ=", ContentType: "text/plain", Disposition: "attachment"},
+			}},
+		})
+		assert.NoError(t, err)
+	})
+}
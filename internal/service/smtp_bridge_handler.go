@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"mime"
 	"mime/multipart"
@@ -228,11 +229,17 @@ func (s *SMTPBridgeHandlerService) HandleMessage(userID string, from string, to
 	// Send the transactional notification
 	sentMessageID, err := s.transactionalNotificationService.SendNotification(systemCtx, workspaceID, payload.Notification)
 	if err != nil {
-		s.logger.WithFields(map[string]interface{}{
+		fields := map[string]interface{}{
 			"workspace_id":    workspaceID,
 			"notification_id": payload.Notification.ID,
 			"error":           err.Error(),
-		}).Error("SMTP bridge: Failed to send notification")
+		}
+		// Suppressed recipients are rejected on purpose, not a delivery failure
+		if errors.Is(err, domain.ErrEmailSuppressed) {
+			s.logger.WithFields(fields).Warn("SMTP bridge: Recipient is suppressed")
+			return fmt.Errorf("failed to send notification: %w", err)
+		}
+		s.logger.WithFields(fields).Error("SMTP bridge: Failed to send notification")
 		return fmt.Errorf("failed to send notification: %w", err)
 	}
 
@@ -3,6 +3,7 @@ package service
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"net/mail"
 	"testing"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/Notifuse/notifuse/internal/domain"
 	"github.com/Notifuse/notifuse/internal/domain/mocks"
 	"github.com/Notifuse/notifuse/pkg/logger"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
 	"github.com/Notifuse/notifuse/pkg/ratelimiter"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/golang/mock/gomock"
@@ -196,6 +198,55 @@ Content-Type: text/plain
 	assert.NoError(t, err)
 }
 
+func TestSMTPBridgeHandlerService_HandleMessage_SuppressedRecipient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := "api-user-123"
+	workspaceID := "workspace123"
+
+	emailBody := `From: sender@example.com
+To: test@example.com
+Subject: Test Email
+Content-Type: text/plain
+
+{
+  "workspace_id": "workspace123",
+  "notification": {
+    "id": "password_reset",
+    "contact": {
+      "email": "user@example.com"
+    }
+  }
+}`
+
+	mockRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	mockRepo.EXPECT().
+		GetUserWorkspace(gomock.Any(), userID, workspaceID).
+		Return(&domain.UserWorkspace{UserID: userID, WorkspaceID: workspaceID, Role: "member"}, nil)
+
+	mockTransactionalService := mocks.NewMockTransactionalNotificationService(ctrl)
+	mockTransactionalService.EXPECT().
+		SendNotification(gomock.Any(), workspaceID, gomock.Any()).
+		Return("", fmt.Errorf("%w: user@example.com blocked by example.com (manual)", domain.ErrEmailSuppressed))
+
+	mockLogger := pkgmocks.NewMockLogger(ctrl)
+	mockLogger.EXPECT().WithField(gomock.Any(), gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().WithFields(gomock.Any()).Return(mockLogger).AnyTimes()
+	mockLogger.EXPECT().Debug(gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Info(gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Warn("SMTP bridge: Recipient is suppressed")
+
+	rl := ratelimiter.NewRateLimiter()
+	rl.SetPolicy("smtp", 5, 1*time.Minute)
+	defer rl.Stop()
+	service := NewSMTPBridgeHandlerService(nil, mockTransactionalService, mockRepo, mockLogger, []byte("test-secret-key-for-jwt-signing-minimum-32-chars"), rl)
+
+	err := service.HandleMessage(userID, "sender@example.com", []string{"test@example.com"}, []byte(emailBody))
+
+	assert.ErrorIs(t, err, domain.ErrEmailSuppressed)
+}
+
 func TestSMTPBridgeHandlerService_HandleMessage_InvalidJSON(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
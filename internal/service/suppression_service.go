@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// SuppressionService manages the workspace suppression list
+type SuppressionService struct {
+	suppressionRepo domain.SuppressionRepository
+	contactRepo     domain.ContactRepository
+	authService     domain.AuthService
+	logger          logger.Logger
+}
+
+// NewSuppressionService creates a new suppression service
+func NewSuppressionService(
+	suppressionRepo domain.SuppressionRepository,
+	contactRepo domain.ContactRepository,
+	authService domain.AuthService,
+	logger logger.Logger,
+) *SuppressionService {
+	return &SuppressionService{
+		suppressionRepo: suppressionRepo,
+		contactRepo:     contactRepo,
+		authService:     authService,
+		logger:          logger,
+	}
+}
+
+// ListSuppressions returns a page of the suppression list
+func (s *SuppressionService) ListSuppressions(ctx context.Context, req *domain.ListSuppressionsRequest) (*domain.ListSuppressionsResponse, error) {
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceContacts, domain.PermissionTypeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	suppressions, total, err := s.suppressionRepo.List(ctx, req)
+	if err != nil {
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to list suppressions: %v", err))
+		return nil, fmt.Errorf("failed to list suppressions: %w", err)
+	}
+
+	return &domain.ListSuppressionsResponse{
+		Suppressions: suppressions,
+		TotalCount:   total,
+	}, nil
+}
+
+// ImportSuppressions adds or replaces entries of the suppression list
+func (s *SuppressionService) ImportSuppressions(ctx context.Context, req *domain.ImportSuppressionsRequest) error {
+	if err := req.Validate(); err != nil {
+		return domain.NewValidationError(err.Error())
+	}
+
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceContacts, domain.PermissionTypeWrite)
+	if err != nil {
+		return err
+	}
+
+	if err := s.suppressionRepo.Upsert(ctx, req.WorkspaceID, req.Suppressions); err != nil {
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to import suppressions: %v", err))
+		return fmt.Errorf("failed to import suppressions: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSuppression removes a pattern from the suppression list
+func (s *SuppressionService) DeleteSuppression(ctx context.Context, req *domain.DeleteSuppressionRequest) error {
+	if err := req.Validate(); err != nil {
+		return domain.NewValidationError(err.Error())
+	}
+
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceContacts, domain.PermissionTypeWrite)
+	if err != nil {
+		return err
+	}
+
+	if err := s.suppressionRepo.Delete(ctx, req.WorkspaceID, req.Pattern); err != nil {
+		if errors.Is(err, domain.ErrSuppressionNotFound) {
+			return err
+		}
+		s.logger.WithField("pattern", req.Pattern).Error(fmt.Sprintf("Failed to delete suppression: %v", err))
+		return fmt.Errorf("failed to delete suppression: %w", err)
+	}
+
+	return nil
+}
+
+// ExportSuppressions writes the whole suppression list as CSV
+func (s *SuppressionService) ExportSuppressions(ctx context.Context, workspaceID string, w io.Writer) error {
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, workspaceID, domain.PermissionResourceContacts, domain.PermissionTypeRead)
+	if err != nil {
+		return err
+	}
+
+	suppressions, _, err := s.suppressionRepo.List(ctx, &domain.ListSuppressionsRequest{WorkspaceID: workspaceID})
+	if err != nil {
+		s.logger.WithField("workspace_id", workspaceID).Error(fmt.Sprintf("Failed to export suppressions: %v", err))
+		return fmt.Errorf("failed to export suppressions: %w", err)
+	}
+
+	return domain.WriteSuppressionsCSV(w, suppressions)
+}
+
+// CheckEmail returns the active entries blocking an address, and whether its contact was erased
+func (s *SuppressionService) CheckEmail(ctx context.Context, workspaceID string, email string) (*domain.CheckSuppressionResponse, error) {
+	email = domain.NormalizeEmail(email)
+	if email == "" {
+		return nil, domain.NewValidationError("email is required")
+	}
+
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, workspaceID, domain.PermissionResourceContacts, domain.PermissionTypeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.suppressionRepo.FindMatches(ctx, workspaceID, []string{email})
+	if err != nil {
+		s.logger.WithField("email", email).Error(fmt.Sprintf("Failed to check suppressions: %v", err))
+		return nil, fmt.Errorf("failed to check suppressions: %w", err)
+	}
+
+	erased, err := s.contactRepo.FilterErasedEmails(ctx, workspaceID, []string{email})
+	if err != nil {
+		s.logger.WithField("email", email).Error(fmt.Sprintf("Failed to check erased contacts: %v", err))
+		return nil, fmt.Errorf("failed to check erased contacts: %w", err)
+	}
+
+	response := &domain.CheckSuppressionResponse{
+		Email:   email,
+		Matches: matches[email],
+		Erased:  len(erased) > 0,
+	}
+	if response.Matches == nil {
+		response.Matches = []*domain.Suppression{}
+	}
+	response.Suppressed = len(response.Matches) > 0
+
+	return response, nil
+}
+
+// checkNotSuppressed returns an error wrapping domain.ErrEmailSuppressed when
+// the suppression list blocks the address
+func checkNotSuppressed(ctx context.Context, suppressionRepo domain.SuppressionRepository, workspaceID string, email string) error {
+	email = domain.NormalizeEmail(email)
+	matches, err := suppressionRepo.FindMatches(ctx, workspaceID, []string{email})
+	if err != nil {
+		return fmt.Errorf("failed to check suppressions: %w", err)
+	}
+	if len(matches[email]) > 0 {
+		return fmt.Errorf("%w: %s blocked by %s", domain.ErrEmailSuppressed, email, domain.DescribeSuppressions(matches[email]))
+	}
+	return nil
+}
+
+// withoutSuppressed splits addresses into those the suppression list lets through
+// and those it blocks, keeping them as given
+func withoutSuppressed(ctx context.Context, suppressionRepo domain.SuppressionRepository, workspaceID string, emails []string) ([]string, []string, error) {
+	if len(emails) == 0 {
+		return emails, nil, nil
+	}
+	normalized := make([]string, len(emails))
+	for i, email := range emails {
+		normalized[i] = domain.NormalizeEmail(email)
+	}
+	matches, err := suppressionRepo.FindMatches(ctx, workspaceID, normalized)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to check suppressions: %w", err)
+	}
+	allowed := make([]string, 0, len(emails))
+	var blocked []string
+	for i, email := range emails {
+		if len(matches[normalized[i]]) > 0 {
+			blocked = append(blocked, email)
+			continue
+		}
+		allowed = append(allowed, email)
+	}
+	return allowed, blocked, nil
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+)
+
+type suppressionServiceTest struct {
+	repo        *mocks.MockSuppressionRepository
+	contactRepo *mocks.MockContactRepository
+	authService *mocks.MockAuthService
+	logger      *pkgmocks.MockLogger
+	service     *SuppressionService
+}
+
+func setupSuppressionServiceTest(t *testing.T) *suppressionServiceTest {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	st := &suppressionServiceTest{
+		repo:        mocks.NewMockSuppressionRepository(ctrl),
+		contactRepo: mocks.NewMockContactRepository(ctrl),
+		authService: mocks.NewMockAuthService(ctrl),
+		logger:      pkgmocks.NewMockLogger(ctrl),
+	}
+	st.service = NewSuppressionService(st.repo, st.contactRepo, st.authService, st.logger)
+	return st
+}
+
+func (st *suppressionServiceTest) expectAuth(ctx context.Context, permissions domain.ResourcePermissions) {
+	userWorkspace := &domain.UserWorkspace{
+		UserID:      "user1",
+		WorkspaceID: "ws1",
+		Permissions: domain.UserPermissions{domain.PermissionResourceContacts: permissions},
+	}
+	st.authService.EXPECT().AuthenticateUserForWorkspace(ctx, "ws1").Return(ctx, &domain.User{}, userWorkspace, nil)
+}
+
+func TestSuppressionService_ListSuppressions(t *testing.T) {
+	ctx := context.Background()
+	req := &domain.ListSuppressionsRequest{WorkspaceID: "ws1", Limit: 50}
+
+	t.Run("returns a page", func(t *testing.T) {
+		st := setupSuppressionServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+		suppressions := []*domain.Suppression{{Pattern: "example.com"}}
+		st.repo.EXPECT().List(ctx, req).Return(suppressions, 7, nil)
+
+		response, err := st.service.ListSuppressions(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.ListSuppressionsResponse{Suppressions: suppressions, TotalCount: 7}, response)
+	})
+
+	t.Run("requires read permission", func(t *testing.T) {
+		st := setupSuppressionServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{})
+
+		_, err := st.service.ListSuppressions(ctx, req)
+
+		var permErr *domain.PermissionError
+		assert.True(t, errors.As(err, &permErr))
+	})
+}
+
+func TestSuppressionService_ImportSuppressions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("upserts validated entries", func(t *testing.T) {
+		st := setupSuppressionServiceTest(t)
+		req := &domain.ImportSuppressionsRequest{
+			WorkspaceID:  "ws1",
+			Suppressions: []*domain.Suppression{{Pattern: "*@Example.com", Reason: domain.SuppressionReasonComplaint}},
+		}
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true, Write: true})
+		st.repo.EXPECT().Upsert(ctx, "ws1", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, suppressions []*domain.Suppression) error {
+				assert.Equal(t, "*@example.com", suppressions[0].Pattern)
+				assert.Equal(t, domain.SuppressionKindWildcard, suppressions[0].Kind)
+				assert.Equal(t, domain.SuppressionSourceImport, suppressions[0].Source)
+				return nil
+			})
+
+		require.NoError(t, st.service.ImportSuppressions(ctx, req))
+	})
+
+	t.Run("rejects invalid entries", func(t *testing.T) {
+		st := setupSuppressionServiceTest(t)
+		req := &domain.ImportSuppressionsRequest{WorkspaceID: "ws1", Suppressions: []*domain.Suppression{{Pattern: "*@*"}}}
+
+		err := st.service.ImportSuppressions(ctx, req)
+
+		var validationErr domain.ValidationError
+		assert.True(t, errors.As(err, &validationErr))
+	})
+
+	t.Run("requires write permission", func(t *testing.T) {
+		st := setupSuppressionServiceTest(t)
+		req := &domain.ImportSuppressionsRequest{WorkspaceID: "ws1", Suppressions: []*domain.Suppression{{Pattern: "example.com"}}}
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+
+		err := st.service.ImportSuppressions(ctx, req)
+
+		var permErr *domain.PermissionError
+		assert.True(t, errors.As(err, &permErr))
+	})
+}
+
+func TestSuppressionService_DeleteSuppression(t *testing.T) {
+	ctx := context.Background()
+	req := func() *domain.DeleteSuppressionRequest {
+		return &domain.DeleteSuppressionRequest{WorkspaceID: "ws1", Pattern: " Example.com "}
+	}
+
+	t.Run("deletes the normalized pattern", func(t *testing.T) {
+		st := setupSuppressionServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Write: true})
+		st.repo.EXPECT().Delete(ctx, "ws1", "example.com").Return(nil)
+
+		require.NoError(t, st.service.DeleteSuppression(ctx, req()))
+	})
+
+	t.Run("passes not found through", func(t *testing.T) {
+		st := setupSuppressionServiceTest(t)
+		notFound := fmt.Errorf("%w: example.com", domain.ErrSuppressionNotFound)
+		st.expectAuth(ctx, domain.ResourcePermissions{Write: true})
+		st.repo.EXPECT().Delete(ctx, "ws1", "example.com").Return(notFound)
+
+		assert.Equal(t, notFound, st.service.DeleteSuppression(ctx, req()))
+	})
+
+	t.Run("logs repository errors", func(t *testing.T) {
+		st := setupSuppressionServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Write: true})
+		st.repo.EXPECT().Delete(ctx, "ws1", "example.com").Return(errors.New("db error"))
+		st.logger.EXPECT().WithField("pattern", "example.com").Return(st.logger)
+		st.logger.EXPECT().Error("Failed to delete suppression: db error")
+
+		assert.Error(t, st.service.DeleteSuppression(ctx, req()))
+	})
+}
+
+func TestSuppressionService_ExportSuppressions(t *testing.T) {
+	ctx := context.Background()
+	st := setupSuppressionServiceTest(t)
+	st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+	st.repo.EXPECT().List(ctx, &domain.ListSuppressionsRequest{WorkspaceID: "ws1"}).Return([]*domain.Suppression{
+		{Pattern: "example.com", Kind: domain.SuppressionKindDomain, Reason: domain.SuppressionReasonManual, Source: domain.SuppressionSourceAPI},
+	}, 1, nil)
+
+	var buf bytes.Buffer
+	require.NoError(t, st.service.ExportSuppressions(ctx, "ws1", &buf))
+
+	assert.Contains(t, buf.String(), "example.com,domain,manual,api,")
+}
+
+func TestSuppressionService_CheckEmail(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("explains why the address is blocked", func(t *testing.T) {
+		st := setupSuppressionServiceTest(t)
+		match := &domain.Suppression{Pattern: "example.com", Kind: domain.SuppressionKindDomain}
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+		st.repo.EXPECT().FindMatches(ctx, "ws1", []string{"john@example.com"}).
+			Return(map[string][]*domain.Suppression{"john@example.com": {match}}, nil)
+		st.contactRepo.EXPECT().FilterErasedEmails(ctx, "ws1", []string{"john@example.com"}).Return([]string{}, nil)
+
+		response, err := st.service.CheckEmail(ctx, "ws1", " John@Example.com")
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.CheckSuppressionResponse{
+			Email:      "john@example.com",
+			Suppressed: true,
+			Matches:    []*domain.Suppression{match},
+		}, response)
+	})
+
+	t.Run("reports erased contacts", func(t *testing.T) {
+		st := setupSuppressionServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+		st.repo.EXPECT().FindMatches(ctx, "ws1", []string{"john@example.com"}).Return(map[string][]*domain.Suppression{}, nil)
+		st.contactRepo.EXPECT().FilterErasedEmails(ctx, "ws1", []string{"john@example.com"}).Return([]string{"john@example.com"}, nil)
+
+		response, err := st.service.CheckEmail(ctx, "ws1", "john@example.com")
+
+		require.NoError(t, err)
+		assert.False(t, response.Suppressed)
+		assert.Empty(t, response.Matches)
+		assert.True(t, response.Erased)
+	})
+
+	t.Run("requires an email", func(t *testing.T) {
+		st := setupSuppressionServiceTest(t)
+
+		_, err := st.service.CheckEmail(ctx, "ws1", " ")
+
+		var validationErr domain.ValidationError
+		assert.True(t, errors.As(err, &validationErr))
+	})
+}
+
+func TestCheckNotSuppressed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	repo := mocks.NewMockSuppressionRepository(ctrl)
+
+	repo.EXPECT().FindMatches(ctx, "ws1", []string{"john@example.com"}).Return(map[string][]*domain.Suppression{
+		"john@example.com": {{Pattern: "*@example.com", Reason: domain.SuppressionReasonComplaint}},
+	}, nil)
+	err := checkNotSuppressed(ctx, repo, "ws1", "John@example.com")
+	assert.ErrorIs(t, err, domain.ErrEmailSuppressed)
+	assert.Contains(t, err.Error(), "*@example.com (complaint)")
+
+	repo.EXPECT().FindMatches(ctx, "ws1", []string{"jane@example.org"}).Return(map[string][]*domain.Suppression{}, nil)
+	assert.NoError(t, checkNotSuppressed(ctx, repo, "ws1", "jane@example.org"))
+}
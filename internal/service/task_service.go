@@ -105,6 +105,10 @@ func getTaskTypes() []string {
 		"process_contact_segment_queue",
 		"check_segment_recompute",
 		"sync_integration",
+		domain.TaskTypeEraseContacts,
+		domain.TaskTypeProcessEmailVerificationQueue,
+		domain.TaskTypeBulkContactOperation,
+		domain.TaskTypeRecurringBroadcast,
 	}
 }
 
@@ -824,10 +828,22 @@ func (s *TaskService) handleBroadcastScheduled(ctx context.Context, payload doma
 				"task_id":      existingTask.ID,
 			}).Info("Task already exists for broadcast, updating status")
 
+			// A broadcast sent back for review keeps its paused task, which runs again at the new schedule
+			var nextRunAfter *time.Time
 			if sendNow && status == string(domain.BroadcastStatusProcessing) {
-				// If broadcast is being sent immediately, mark task as pending and set next run to now
-				nextRunAfter := time.Now()
-				existingTask.NextRunAfter = &nextRunAfter
+				now := time.Now()
+				nextRunAfter = &now
+			} else if status == string(domain.BroadcastStatusScheduled) && existingTask.Status == domain.TaskStatusPaused {
+				if scheduledTimeStr, hasTime := payload.Data["scheduled_time"].(string); hasTime {
+					if scheduledTime, parseErr := time.Parse(time.RFC3339, scheduledTimeStr); parseErr == nil {
+						nextRunAfter = &scheduledTime
+					}
+				}
+			}
+
+			if nextRunAfter != nil {
+				// Mark the task as pending and set its next run
+				existingTask.NextRunAfter = nextRunAfter
 				existingTask.Status = domain.TaskStatusPending
 
 				// Ensure BroadcastID is set
@@ -847,7 +863,7 @@ func (s *TaskService) handleBroadcastScheduled(ctx context.Context, payload doma
 				}
 
 				// Flag for immediate execution after transaction commits
-				shouldExecuteImmediately = true
+				shouldExecuteImmediately = sendNow
 			}
 
 			return nil
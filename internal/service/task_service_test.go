@@ -811,6 +811,26 @@ func TestTaskService_RegisterProcessor(t *testing.T) {
 			Return(false).
 			Times(1)
 
+		mockProcessor.EXPECT().
+			CanProcess("erase_contacts").
+			Return(false).
+			Times(1)
+
+		mockProcessor.EXPECT().
+			CanProcess("process_email_verification_queue").
+			Return(false).
+			Times(1)
+
+		mockProcessor.EXPECT().
+			CanProcess("bulk_contact_operation").
+			Return(false).
+			Times(1)
+
+		mockProcessor.EXPECT().
+			CanProcess("recurring_broadcast").
+			Return(false).
+			Times(1)
+
 		// Register the processor
 		taskService.RegisterProcessor(mockProcessor)
 
@@ -2096,6 +2116,52 @@ func TestTaskService_HandleBroadcastScheduledExtended(t *testing.T) {
 		// No assertions needed - if no panic, the test passes
 	})
 
+	t.Run("Reschedules the paused task of a broadcast scheduled again", func(t *testing.T) {
+		ctx := context.Background()
+		workspaceID := "workspace1"
+		broadcastID := "broadcast123"
+		scheduledTime := time.Now().Add(2 * time.Hour).UTC().Truncate(time.Second)
+
+		payload := domain.EventPayload{
+			Type:        domain.EventBroadcastScheduled,
+			WorkspaceID: workspaceID,
+			EntityID:    broadcastID,
+			Data: map[string]interface{}{
+				"send_now":       false,
+				"status":         string(domain.BroadcastStatusScheduled),
+				"scheduled_time": scheduledTime.Format(time.RFC3339),
+			},
+		}
+
+		existingTask := &domain.Task{
+			ID:          "task456",
+			WorkspaceID: workspaceID,
+			Type:        "send_broadcast",
+			Status:      domain.TaskStatusPaused,
+			BroadcastID: &broadcastID,
+		}
+
+		mockRepo.EXPECT().
+			WithTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(*sql.Tx) error) error {
+				return fn(nil)
+			})
+		mockRepo.EXPECT().
+			GetTaskByBroadcastID(gomock.Any(), workspaceID, broadcastID).
+			Return(existingTask, nil)
+		mockRepo.EXPECT().
+			Update(gomock.Any(), workspaceID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, task *domain.Task) error {
+				assert.Equal(t, domain.TaskStatusPending, task.Status)
+				if assert.NotNil(t, task.NextRunAfter) {
+					assert.True(t, task.NextRunAfter.Equal(scheduledTime))
+				}
+				return nil
+			})
+
+		taskService.handleBroadcastScheduled(ctx, payload)
+	})
+
 	t.Run("Handles transaction error", func(t *testing.T) {
 		// Setup
 		ctx := context.Background()
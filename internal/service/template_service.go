@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,6 +20,7 @@ type TemplateService struct {
 	authService   domain.AuthService
 	logger        logger.Logger
 	apiEndpoint   string
+	llmService    domain.LLMService
 }
 
 // updateEmailMetadataBlocks updates mj-title and mj-preview blocks in the email tree
@@ -139,6 +142,11 @@ func NewTemplateService(repo domain.TemplateRepository, workspaceRepo domain.Wor
 	}
 }
 
+// SetLLMService sets the LLM service used to translate templates (it is created after the template service)
+func (s *TemplateService) SetLLMService(llmService domain.LLMService) {
+	s.llmService = llmService
+}
+
 // validateTranslationLanguages checks that all translation language keys are in the workspace's configured languages.
 func (s *TemplateService) validateTranslationLanguages(ctx context.Context, workspaceID string, translations map[string]domain.TemplateTranslation) error {
 	if len(translations) == 0 {
@@ -175,7 +183,7 @@ func (s *TemplateService) validateTranslationLanguages(ctx context.Context, work
 func (s *TemplateService) CreateTemplate(ctx context.Context, workspaceID string, template *domain.Template) error {
 	// Authenticate user for workspace
 	var err error
-	ctx, _, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, workspaceID)
+	ctx, user, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, workspaceID)
 	if err != nil {
 		return fmt.Errorf("failed to authenticate user: %w", err)
 	}
@@ -190,6 +198,7 @@ func (s *TemplateService) CreateTemplate(ctx context.Context, workspaceID string
 	}
 
 	// Set initial version and timestamps
+	template.CreatedBy = &user.ID
 	template.Version = 1
 	now := time.Now().UTC()
 	template.CreatedAt = now
@@ -251,6 +260,182 @@ func (s *TemplateService) GetTemplateByID(ctx context.Context, workspaceID strin
 	return template, nil
 }
 
+// GetPublishedTemplate retrieves the version of a template used for sends
+func (s *TemplateService) GetPublishedTemplate(ctx context.Context, workspaceID string, id string) (*domain.Template, error) {
+	// Check if this is a system call that should bypass authentication
+	if ctx.Value(domain.SystemCallKey) == nil {
+		var userWorkspace *domain.UserWorkspace
+		var err error
+		ctx, _, userWorkspace, err = s.authService.AuthenticateUserForWorkspace(ctx, workspaceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to authenticate user: %w", err)
+		}
+
+		// Check permission for reading templates
+		if !userWorkspace.HasPermission(domain.PermissionResourceTemplates, domain.PermissionTypeRead) {
+			return nil, domain.NewPermissionError(
+				domain.PermissionResourceTemplates,
+				domain.PermissionTypeRead,
+				"Insufficient permissions: read access to templates required",
+			)
+		}
+	}
+
+	template, err := s.repo.GetPublishedTemplate(ctx, workspaceID, id)
+	if err != nil {
+		if _, ok := err.(*domain.ErrTemplateNotFound); ok {
+			return nil, err
+		}
+		s.logger.WithField("template_id", id).Error(fmt.Sprintf("Failed to get published template: %v", err))
+		return nil, fmt.Errorf("failed to get published template: %w", err)
+	}
+
+	return template, nil
+}
+
+// GetTemplateVersions lists the versions of a template with their author and publication
+func (s *TemplateService) GetTemplateVersions(ctx context.Context, workspaceID string, id string) ([]*domain.TemplateVersion, error) {
+	// Authenticate user for workspace
+	var err error
+	ctx, _, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Check permission for reading templates
+	if !userWorkspace.HasPermission(domain.PermissionResourceTemplates, domain.PermissionTypeRead) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceTemplates,
+			domain.PermissionTypeRead,
+			"Insufficient permissions: read access to templates required",
+		)
+	}
+
+	versions, err := s.repo.GetTemplateVersions(ctx, workspaceID, id)
+	if err != nil {
+		if _, ok := err.(*domain.ErrTemplateNotFound); ok {
+			return nil, err
+		}
+		s.logger.WithField("template_id", id).Error(fmt.Sprintf("Failed to get template versions: %v", err))
+		return nil, fmt.Errorf("failed to get template versions: %w", err)
+	}
+
+	return versions, nil
+}
+
+// DiffTemplateVersions compares the subject, settings and block tree or MJML source of two versions
+func (s *TemplateService) DiffTemplateVersions(ctx context.Context, workspaceID string, id string, from int64, to int64) (*domain.TemplateVersionDiff, error) {
+	// Authenticate user for workspace
+	var err error
+	ctx, _, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Check permission for reading templates
+	if !userWorkspace.HasPermission(domain.PermissionResourceTemplates, domain.PermissionTypeRead) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceTemplates,
+			domain.PermissionTypeRead,
+			"Insufficient permissions: read access to templates required",
+		)
+	}
+
+	fromTemplate, err := s.repo.GetTemplateByID(ctx, workspaceID, id, from)
+	if err != nil {
+		return nil, err
+	}
+	toTemplate, err := s.repo.GetTemplateByID(ctx, workspaceID, id, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.DiffTemplates(fromTemplate, toTemplate), nil
+}
+
+// PublishTemplate makes a version live for broadcasts, automations and transactional notifications
+func (s *TemplateService) PublishTemplate(ctx context.Context, workspaceID string, id string, version int64) (*domain.Template, error) {
+	// Authenticate user for workspace
+	var err error
+	ctx, user, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Check permission for writing templates
+	if !userWorkspace.HasPermission(domain.PermissionResourceTemplates, domain.PermissionTypeWrite) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceTemplates,
+			domain.PermissionTypeWrite,
+			"Insufficient permissions: write access to templates required",
+		)
+	}
+
+	// Version 0 publishes the latest draft
+	template, err := s.repo.GetTemplateByID(ctx, workspaceID, id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.PublishTemplate(ctx, workspaceID, id, template.Version, user.ID); err != nil {
+		if _, ok := err.(*domain.ErrTemplateNotFound); ok {
+			return nil, err
+		}
+		s.logger.WithField("template_id", id).Error(fmt.Sprintf("Failed to publish template: %v", err))
+		return nil, fmt.Errorf("failed to publish template: %w", err)
+	}
+
+	now := time.Now().UTC()
+	template.PublishedAt = &now
+	template.PublishedBy = &user.ID
+
+	return template, nil
+}
+
+// RollbackTemplate saves the content of a prior version as a new version and publishes it,
+// so that both the editor and the sends get back to it
+func (s *TemplateService) RollbackTemplate(ctx context.Context, workspaceID string, id string, version int64) (*domain.Template, error) {
+	// Authenticate user for workspace
+	var err error
+	ctx, user, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Check permission for writing templates
+	if !userWorkspace.HasPermission(domain.PermissionResourceTemplates, domain.PermissionTypeWrite) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceTemplates,
+			domain.PermissionTypeWrite,
+			"Insufficient permissions: write access to templates required",
+		)
+	}
+
+	template, err := s.repo.GetTemplateByID(ctx, workspaceID, id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	template.CreatedBy = &user.ID
+	template.PublishedAt = &now
+	template.PublishedBy = &user.ID
+
+	if err := s.repo.UpdateTemplate(ctx, workspaceID, template); err != nil {
+		s.logger.WithField("template_id", id).Error(fmt.Sprintf("Failed to roll back template: %v", err))
+		return nil, fmt.Errorf("failed to roll back template: %w", err)
+	}
+
+	s.logger.WithFields(map[string]interface{}{
+		"template_id":      id,
+		"workspace_id":     workspaceID,
+		"restored_version": version,
+		"version":          template.Version,
+	}).Info("Template rolled back")
+
+	return template, nil
+}
+
 func (s *TemplateService) GetTemplates(ctx context.Context, workspaceID string, category string, channel string) ([]*domain.Template, error) {
 	// Authenticate user for workspace
 	var err error
@@ -281,7 +466,7 @@ func (s *TemplateService) GetTemplates(ctx context.Context, workspaceID string,
 func (s *TemplateService) UpdateTemplate(ctx context.Context, workspaceID string, template *domain.Template) error {
 	// Authenticate user for workspace
 	var err error
-	ctx, _, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, workspaceID)
+	ctx, user, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, workspaceID)
 	if err != nil {
 		return fmt.Errorf("failed to authenticate user: %w", err)
 	}
@@ -340,6 +525,11 @@ func (s *TemplateService) UpdateTemplate(ctx context.Context, workspaceID string
 	template.CreatedAt = existingTemplate.CreatedAt
 	template.UpdatedAt = time.Now().UTC()
 
+	// The new version is a draft, sends keep using the published version
+	template.CreatedBy = &user.ID
+	template.PublishedAt = nil
+	template.PublishedBy = nil
+
 	// Update template (this will create a new version in the repo)
 	if err := s.repo.UpdateTemplate(ctx, workspaceID, template); err != nil {
 		s.logger.WithField("template_id", template.ID).Error(fmt.Sprintf("Failed to update template: %v", err))
@@ -393,6 +583,224 @@ func (s *TemplateService) DeleteTemplate(ctx context.Context, workspaceID string
 	return nil
 }
 
+// LintTemplate runs the spam and deliverability checks over a template version, the latest one when version is 0
+func (s *TemplateService) LintTemplate(ctx context.Context, workspaceID string, id string, version int64) (*domain.TemplateLintReport, error) {
+	// Authenticate user for workspace
+	var err error
+	ctx, _, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Check permission for reading templates
+	if !userWorkspace.HasPermission(domain.PermissionResourceTemplates, domain.PermissionTypeRead) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceTemplates,
+			domain.PermissionTypeRead,
+			"Insufficient permissions: read access to templates required",
+		)
+	}
+
+	template, err := s.repo.GetTemplateByID(ctx, workspaceID, id, version)
+	if err != nil {
+		if _, ok := err.(*domain.ErrTemplateNotFound); ok {
+			return nil, err
+		}
+		s.logger.WithField("template_id", id).Error(fmt.Sprintf("Failed to get template: %v", err))
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+
+	return lintEmailTemplate(workspaceID, template), nil
+}
+
+// TranslateTemplate asks an LLM integration to translate the texts of a template version into other
+// languages. The proposals are returned for review and nothing is saved.
+func (s *TemplateService) TranslateTemplate(ctx context.Context, req *domain.TranslateTemplateRequest) (*domain.TranslateTemplateResponse, error) {
+	// Authenticate user for workspace
+	var err error
+	ctx, _, userWorkspace, err := s.authService.AuthenticateUserForWorkspace(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate user: %w", err)
+	}
+
+	// Check permission for writing templates
+	if !userWorkspace.HasPermission(domain.PermissionResourceTemplates, domain.PermissionTypeWrite) {
+		return nil, domain.NewPermissionError(
+			domain.PermissionResourceTemplates,
+			domain.PermissionTypeWrite,
+			"Insufficient permissions: write access to templates required",
+		)
+	}
+
+	if s.llmService == nil {
+		return nil, fmt.Errorf("LLM service is not configured")
+	}
+
+	template, err := s.repo.GetTemplateByID(ctx, req.WorkspaceID, req.ID, req.Version)
+	if err != nil {
+		if _, ok := err.(*domain.ErrTemplateNotFound); ok {
+			return nil, err
+		}
+		s.logger.WithField("template_id", req.ID).Error(fmt.Sprintf("Failed to get template: %v", err))
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+	if template.Email == nil {
+		return nil, domain.NewValidationError("only email templates can be translated")
+	}
+
+	workspace, err := s.workspaceRepo.GetByID(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+	integrationID := req.IntegrationID
+	if integrationID == "" {
+		integrations := workspace.GetIntegrationsByType(domain.IntegrationTypeLLM)
+		if len(integrations) == 0 {
+			return nil, domain.NewValidationError("no LLM integration is configured for this workspace")
+		}
+		integrationID = integrations[0].ID
+	}
+
+	sourceLanguage := workspace.Settings.DefaultLanguage
+	if sourceLanguage == "" {
+		sourceLanguage = "en"
+	}
+
+	segments := domain.ExtractTranslationSegments(template.Email)
+	response := &domain.TranslateTemplateResponse{
+		TemplateID: template.ID,
+		Version:    template.Version,
+		Proposals:  make([]domain.TemplateTranslationProposal, 0, len(req.Languages)),
+	}
+	for _, language := range req.Languages {
+		translated, issues, err := s.translateSegments(ctx, req.WorkspaceID, integrationID, sourceLanguage, language, segments)
+		if err != nil {
+			return nil, err
+		}
+		email, err := domain.ApplyTranslationSegments(template.Email, translated)
+		if err != nil {
+			return nil, err
+		}
+		response.Proposals = append(response.Proposals, domain.NewTemplateTranslationProposal(template, language, email, issues))
+	}
+
+	return response, nil
+}
+
+// maxTranslationChunkSize bounds the text sent to the LLM in one request, so that the
+// translation fits in the output tokens of the reply
+const maxTranslationChunkSize = 12000
+
+const templateTranslationPrompt = `You translate email templates from %s to %s.
+The user sends a JSON object of texts to translate. Reply with a JSON object with the same keys and the translated texts, and nothing else.
+Placeholders such as [[1]] stand for HTML tags, links and Liquid code: keep every placeholder exactly once, in the position required by the translation, and never translate or change them.
+Keep the tone, the line breaks and the punctuation style of the source.`
+
+// translateSegments translates the segments into a language, in chunks. Segments whose
+// translation is missing or lost placeholders keep their source text and are reported as issues.
+func (s *TemplateService) translateSegments(ctx context.Context, workspaceID, integrationID, sourceLanguage, language string, segments []*domain.TranslationSegment) (map[string]string, []domain.TemplateTranslationIssue, error) {
+	translated := map[string]string{}
+	var issues []domain.TemplateTranslationIssue
+
+	for start := 0; start < len(segments); {
+		end, size := start, 0
+		for end < len(segments) && (end == start || size+len(segments[end].Text) <= maxTranslationChunkSize) {
+			size += len(segments[end].Text)
+			end++
+		}
+		chunk := segments[start:end]
+		start = end
+
+		texts := make(map[string]string, len(chunk))
+		for i, segment := range chunk {
+			texts[strconv.Itoa(i+1)] = segment.Text
+		}
+		reply, err := s.chatTranslation(ctx, workspaceID, integrationID, sourceLanguage, language, texts)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for i, segment := range chunk {
+			text, ok := reply[strconv.Itoa(i+1)]
+			if !ok {
+				issues = append(issues, domain.TemplateTranslationIssue{Path: segment.Path, Message: "missing from the translation, the source text was kept"})
+				continue
+			}
+			text, err := segment.Unmask(text)
+			if err != nil {
+				issues = append(issues, domain.TemplateTranslationIssue{Path: segment.Path, Message: fmt.Sprintf("%v, the source text was kept", err)})
+				continue
+			}
+			translated[segment.Path] = text
+		}
+	}
+
+	return translated, issues, nil
+}
+
+// chatTranslation sends texts keyed by ID to the LLM and parses the JSON object of its reply
+func (s *TemplateService) chatTranslation(ctx context.Context, workspaceID, integrationID, sourceLanguage, language string, texts map[string]string) (map[string]string, error) {
+	payload, err := json.Marshal(texts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode texts: %w", err)
+	}
+
+	var reply strings.Builder
+	chatReq := &domain.LLMChatRequest{
+		WorkspaceID:   workspaceID,
+		IntegrationID: integrationID,
+		Messages:      []domain.LLMMessage{{Role: "user", Content: string(payload)}},
+		MaxTokens:     8192,
+		SystemPrompt: fmt.Sprintf(templateTranslationPrompt,
+			domain.SupportedLanguages[sourceLanguage], domain.SupportedLanguages[language]),
+	}
+	err = s.llmService.StreamChat(ctx, chatReq, func(event domain.LLMChatEvent) error {
+		switch event.Type {
+		case "text":
+			reply.WriteString(event.Content)
+		case "error":
+			return fmt.Errorf("%s", event.Error)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate into %s: %w", language, err)
+	}
+
+	// Models may wrap the JSON in prose or a code fence
+	content := reply.String()
+	first, last := strings.Index(content, "{"), strings.LastIndex(content, "}")
+	if first < 0 || last < first {
+		return nil, fmt.Errorf("failed to translate into %s: the reply holds no JSON object", language)
+	}
+	result := map[string]string{}
+	if err := json.Unmarshal([]byte(content[first:last+1]), &result); err != nil {
+		return nil, fmt.Errorf("failed to translate into %s: invalid reply: %w", language, err)
+	}
+	return result, nil
+}
+
+// lintEmailTemplate compiles a template with its test data, without tracking, and lints the result
+func lintEmailTemplate(workspaceID string, template *domain.Template) *domain.TemplateLintReport {
+	if template.Email == nil {
+		return domain.LintTemplate(template, nil)
+	}
+
+	compileReq := notifuse_mjml.CompileTemplateRequest{
+		WorkspaceID:      workspaceID,
+		MessageID:        "lint",
+		VisualEditorTree: template.Email.VisualEditorTree,
+		TemplateData:     notifuse_mjml.MapOfAny(template.TestData),
+	}
+	compileReq.MjmlSource = template.Email.GetCodeModeMjmlSource()
+	compiled, err := notifuse_mjml.CompileTemplate(compileReq)
+	if err != nil {
+		compiled = &domain.CompileTemplateResponse{Success: false}
+	}
+
+	return domain.LintTemplate(template, compiled)
+}
+
 func (s *TemplateService) CompileTemplate(ctx context.Context, payload domain.CompileTemplateRequest) (*domain.CompileTemplateResponse, error) {
 	// Check if this is a system call that should bypass authentication
 	if ctx.Value(domain.SystemCallKey) == nil {
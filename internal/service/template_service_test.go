@@ -1656,3 +1656,363 @@ func TestTemplateService_UpdateEmailMetadataBlocks_CodeMode(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestTemplateService_PublishTemplate(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := "ws-123"
+	userID := "user-456"
+	templateID := "tmpl-abc"
+	userWorkspace := &domain.UserWorkspace{
+		UserID:      userID,
+		WorkspaceID: workspaceID,
+		Permissions: domain.UserPermissions{
+			domain.PermissionResourceTemplates: {Read: true, Write: true},
+		},
+	}
+
+	t.Run("Publishes the latest draft", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		templateService, mockRepo, _, mockAuthService, _ := setupTemplateServiceTest(ctrl)
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{ID: userID}, userWorkspace, nil)
+		mockRepo.EXPECT().GetTemplateByID(ctx, workspaceID, templateID, int64(0)).Return(&domain.Template{ID: templateID, Version: 3}, nil)
+		mockRepo.EXPECT().PublishTemplate(ctx, workspaceID, templateID, int64(3), userID).Return(nil)
+
+		template, err := templateService.PublishTemplate(ctx, workspaceID, templateID, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), template.Version)
+		require.NotNil(t, template.PublishedAt)
+		assert.Equal(t, userID, *template.PublishedBy)
+	})
+
+	t.Run("Requires write permission", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		templateService, _, _, mockAuthService, _ := setupTemplateServiceTest(ctrl)
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{ID: userID}, &domain.UserWorkspace{
+			UserID:      userID,
+			WorkspaceID: workspaceID,
+			Permissions: domain.UserPermissions{
+				domain.PermissionResourceTemplates: {Read: true, Write: false},
+			},
+		}, nil)
+
+		_, err := templateService.PublishTemplate(ctx, workspaceID, templateID, 2)
+		var permErr *domain.PermissionError
+		assert.ErrorAs(t, err, &permErr)
+	})
+
+	t.Run("Version not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		templateService, mockRepo, _, mockAuthService, _ := setupTemplateServiceTest(ctrl)
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{ID: userID}, userWorkspace, nil)
+		mockRepo.EXPECT().GetTemplateByID(ctx, workspaceID, templateID, int64(9)).Return(nil, &domain.ErrTemplateNotFound{Message: "template not found"})
+
+		_, err := templateService.PublishTemplate(ctx, workspaceID, templateID, 9)
+		var notFound *domain.ErrTemplateNotFound
+		assert.ErrorAs(t, err, &notFound)
+	})
+}
+
+func TestTemplateService_RollbackTemplate(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := "ws-123"
+	userID := "user-456"
+	templateID := "tmpl-abc"
+	userWorkspace := &domain.UserWorkspace{
+		UserID:      userID,
+		WorkspaceID: workspaceID,
+		Permissions: domain.UserPermissions{
+			domain.PermissionResourceTemplates: {Read: true, Write: true},
+		},
+	}
+
+	t.Run("Saves the prior version as a new published version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		templateService, mockRepo, _, mockAuthService, mockLogger := setupTemplateServiceTest(ctrl)
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{ID: userID}, userWorkspace, nil)
+		mockRepo.EXPECT().GetTemplateByID(ctx, workspaceID, templateID, int64(2)).Return(&domain.Template{
+			ID:      templateID,
+			Version: 2,
+			Email:   &domain.EmailTemplate{Subject: "Old subject"},
+		}, nil)
+		mockRepo.EXPECT().UpdateTemplate(ctx, workspaceID, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, tpl *domain.Template) error {
+			assert.Equal(t, "Old subject", tpl.Email.Subject)
+			require.NotNil(t, tpl.PublishedAt)
+			assert.Equal(t, userID, *tpl.PublishedBy)
+			assert.Equal(t, userID, *tpl.CreatedBy)
+			tpl.Version = 5
+			return nil
+		})
+		mockLogger.EXPECT().WithFields(gomock.Any()).Return(mockLogger)
+		mockLogger.EXPECT().Info(gomock.Any())
+
+		template, err := templateService.RollbackTemplate(ctx, workspaceID, templateID, 2)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), template.Version)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		templateService, mockRepo, _, mockAuthService, mockLogger := setupTemplateServiceTest(ctrl)
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{ID: userID}, userWorkspace, nil)
+		mockRepo.EXPECT().GetTemplateByID(ctx, workspaceID, templateID, int64(2)).Return(&domain.Template{ID: templateID, Version: 2}, nil)
+		mockRepo.EXPECT().UpdateTemplate(ctx, workspaceID, gomock.Any()).Return(errors.New("db error"))
+		mockLogger.EXPECT().WithField("template_id", templateID).Return(mockLogger)
+		mockLogger.EXPECT().Error(gomock.Any())
+
+		_, err := templateService.RollbackTemplate(ctx, workspaceID, templateID, 2)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to roll back template")
+	})
+}
+
+func TestTemplateService_GetPublishedTemplate(t *testing.T) {
+	workspaceID := "ws-123"
+	templateID := "tmpl-abc"
+
+	t.Run("System call bypasses authentication", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		templateService, mockRepo, _, _, _ := setupTemplateServiceTest(ctrl)
+
+		systemCtx := context.WithValue(context.Background(), domain.SystemCallKey, true)
+		published := &domain.Template{ID: templateID, Version: 2}
+		mockRepo.EXPECT().GetPublishedTemplate(systemCtx, workspaceID, templateID).Return(published, nil)
+
+		template, err := templateService.GetPublishedTemplate(systemCtx, workspaceID, templateID)
+		require.NoError(t, err)
+		assert.Equal(t, published, template)
+	})
+}
+
+func TestTemplateService_DiffTemplateVersions(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := "ws-123"
+	userID := "user-456"
+	templateID := "tmpl-abc"
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	templateService, mockRepo, _, mockAuthService, _ := setupTemplateServiceTest(ctrl)
+
+	mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{ID: userID}, &domain.UserWorkspace{
+		UserID:      userID,
+		WorkspaceID: workspaceID,
+		Permissions: domain.UserPermissions{
+			domain.PermissionResourceTemplates: {Read: true, Write: false},
+		},
+	}, nil)
+	mockRepo.EXPECT().GetTemplateByID(ctx, workspaceID, templateID, int64(1)).Return(&domain.Template{
+		ID: templateID, Version: 1, Email: &domain.EmailTemplate{Subject: "Hello"},
+	}, nil)
+	mockRepo.EXPECT().GetTemplateByID(ctx, workspaceID, templateID, int64(2)).Return(&domain.Template{
+		ID: templateID, Version: 2, Email: &domain.EmailTemplate{Subject: "Hi"},
+	}, nil)
+
+	diff, err := templateService.DiffTemplateVersions(ctx, workspaceID, templateID, 1, 2)
+	require.NoError(t, err)
+	require.Len(t, diff.Changes, 1)
+	assert.Equal(t, "email.subject", diff.Changes[0].Path)
+}
+
+func TestTemplateService_LintTemplate(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := "ws-123"
+	userID := "user-456"
+	templateID := "tmpl-abc"
+	mjmlSource := `<mjml><mj-body><mj-section><mj-column><mj-text>Hello {{ name }}, ` +
+		`<a href="https://bit.ly/offer">see the offer</a></mj-text></mj-column></mj-section></mj-body></mjml>`
+
+	t.Run("Lints the compiled template", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		templateService, mockRepo, _, mockAuthService, _ := setupTemplateServiceTest(ctrl)
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{ID: userID}, &domain.UserWorkspace{
+			UserID:      userID,
+			WorkspaceID: workspaceID,
+			Permissions: domain.UserPermissions{
+				domain.PermissionResourceTemplates: {Read: true, Write: false},
+			},
+		}, nil)
+		mockRepo.EXPECT().GetTemplateByID(ctx, workspaceID, templateID, int64(0)).Return(&domain.Template{
+			ID:      templateID,
+			Version: 2,
+			Email: &domain.EmailTemplate{
+				EditorMode: domain.EditorModeCode,
+				MjmlSource: &mjmlSource,
+				Subject:    "Hello",
+			},
+		}, nil)
+
+		report, err := templateService.LintTemplate(ctx, workspaceID, templateID, 0)
+		require.NoError(t, err)
+		assert.Equal(t, templateID, report.TemplateID)
+		assert.Equal(t, int64(2), report.Version)
+		assert.Greater(t, report.HTMLSize, 0)
+
+		rules := make([]string, 0, len(report.Findings))
+		for _, finding := range report.Findings {
+			rules = append(rules, finding.Rule)
+		}
+		assert.Contains(t, rules, domain.TemplateLintRuleURLShortener)
+		assert.Contains(t, rules, domain.TemplateLintRuleUndefinedVariable)
+	})
+
+	t.Run("Requires read permission", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		templateService, _, _, mockAuthService, _ := setupTemplateServiceTest(ctrl)
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{ID: userID}, &domain.UserWorkspace{
+			UserID:      userID,
+			WorkspaceID: workspaceID,
+			Permissions: domain.UserPermissions{},
+		}, nil)
+
+		report, err := templateService.LintTemplate(ctx, workspaceID, templateID, 0)
+		assert.Nil(t, report)
+		var permErr *domain.PermissionError
+		assert.ErrorAs(t, err, &permErr)
+	})
+}
+
+func TestTemplateService_TranslateTemplate(t *testing.T) {
+	ctx := context.Background()
+	workspaceID := "ws-123"
+	userID := "user-456"
+	templateID := "tmpl-abc"
+	writer := &domain.UserWorkspace{
+		UserID:      userID,
+		WorkspaceID: workspaceID,
+		Permissions: domain.UserPermissions{
+			domain.PermissionResourceTemplates: {Read: true, Write: true},
+		},
+	}
+	workspace := &domain.Workspace{
+		ID:           workspaceID,
+		Settings:     domain.WorkspaceSettings{DefaultLanguage: "en"},
+		Integrations: []domain.Integration{{ID: "llm-1", Type: domain.IntegrationTypeLLM}},
+	}
+	newTemplate := func() *domain.Template {
+		content := `<p>Welcome <a href="{{ url }}">aboard</a></p>`
+		text := &notifuse_mjml.MJTextBlock{BaseBlock: notifuse_mjml.NewBaseBlock("t1", notifuse_mjml.MJMLComponentMjText)}
+		text.Content = &content
+		root := &notifuse_mjml.MJMLBlock{BaseBlock: notifuse_mjml.NewBaseBlock("root", notifuse_mjml.MJMLComponentMjml)}
+		root.Children = []notifuse_mjml.EmailBlock{text}
+		return &domain.Template{
+			ID:      templateID,
+			Version: 4,
+			Email:   &domain.EmailTemplate{Subject: "Hello {{ contact.first_name }}", VisualEditorTree: root},
+		}
+	}
+	reply := func(content string) func(context.Context, *domain.LLMChatRequest, func(domain.LLMChatEvent) error) error {
+		return func(_ context.Context, _ *domain.LLMChatRequest, onEvent func(domain.LLMChatEvent) error) error {
+			if err := onEvent(domain.LLMChatEvent{Type: "text", Content: content}); err != nil {
+				return err
+			}
+			return onEvent(domain.LLMChatEvent{Type: "done"})
+		}
+	}
+
+	t.Run("Proposes translations for review", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		templateService, mockRepo, mockWorkspaceRepo, mockAuthService, _ := setupTemplateServiceTest(ctrl)
+		mockLLM := domainmocks.NewMockLLMService(ctrl)
+		templateService.SetLLMService(mockLLM)
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{ID: userID}, writer, nil)
+		mockRepo.EXPECT().GetTemplateByID(ctx, workspaceID, templateID, int64(0)).Return(newTemplate(), nil)
+		mockWorkspaceRepo.EXPECT().GetByID(ctx, workspaceID).Return(workspace, nil)
+		mockLLM.EXPECT().StreamChat(ctx, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *domain.LLMChatRequest, onEvent func(domain.LLMChatEvent) error) error {
+				assert.Equal(t, "llm-1", req.IntegrationID)
+				assert.Contains(t, req.SystemPrompt, "from English to French")
+				assert.JSONEq(t, `{"1":"Hello [[1]]","2":"[[1]]Welcome [[2]]aboard[[3]][[4]]"}`, req.Messages[0].Content)
+				return reply("```json\n{\"1\":\"Bonjour [[1]]\",\"2\":\"[[1]]Bienvenue [[2]]à bord[[4]]\"}\n```")(ctx, req, onEvent)
+			})
+
+		response, err := templateService.TranslateTemplate(ctx, &domain.TranslateTemplateRequest{
+			WorkspaceID: workspaceID, ID: templateID, Languages: []string{"fr"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), response.Version)
+		require.Len(t, response.Proposals, 1)
+
+		proposal := response.Proposals[0]
+		assert.Equal(t, "fr", proposal.Language)
+		assert.Equal(t, "Bonjour {{ contact.first_name }}", proposal.Translation.Email.Subject)
+		require.Len(t, proposal.Changes, 1)
+		assert.Equal(t, "email.subject", proposal.Changes[0].Path)
+		assert.Equal(t, domain.TemplateReviewPending, proposal.Changes[0].Review)
+
+		// The block lost a placeholder and keeps its source text
+		require.Len(t, proposal.Issues, 1)
+		assert.Equal(t, "blocks.t1.content", proposal.Issues[0].Path)
+	})
+
+	t.Run("Requires an LLM integration", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		templateService, mockRepo, mockWorkspaceRepo, mockAuthService, _ := setupTemplateServiceTest(ctrl)
+		templateService.SetLLMService(domainmocks.NewMockLLMService(ctrl))
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{ID: userID}, writer, nil)
+		mockRepo.EXPECT().GetTemplateByID(ctx, workspaceID, templateID, int64(0)).Return(newTemplate(), nil)
+		mockWorkspaceRepo.EXPECT().GetByID(ctx, workspaceID).Return(&domain.Workspace{ID: workspaceID}, nil)
+
+		_, err := templateService.TranslateTemplate(ctx, &domain.TranslateTemplateRequest{
+			WorkspaceID: workspaceID, ID: templateID, Languages: []string{"fr"},
+		})
+		var validationErr domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("Fails on replies without JSON", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		templateService, mockRepo, mockWorkspaceRepo, mockAuthService, _ := setupTemplateServiceTest(ctrl)
+		mockLLM := domainmocks.NewMockLLMService(ctrl)
+		templateService.SetLLMService(mockLLM)
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{ID: userID}, writer, nil)
+		mockRepo.EXPECT().GetTemplateByID(ctx, workspaceID, templateID, int64(0)).Return(newTemplate(), nil)
+		mockWorkspaceRepo.EXPECT().GetByID(ctx, workspaceID).Return(workspace, nil)
+		mockLLM.EXPECT().StreamChat(ctx, gomock.Any(), gomock.Any()).DoAndReturn(reply("Sorry, I cannot help."))
+
+		_, err := templateService.TranslateTemplate(ctx, &domain.TranslateTemplateRequest{
+			WorkspaceID: workspaceID, ID: templateID, Languages: []string{"fr"},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("Requires write permission", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		templateService, _, _, mockAuthService, _ := setupTemplateServiceTest(ctrl)
+
+		mockAuthService.EXPECT().AuthenticateUserForWorkspace(ctx, workspaceID).Return(ctx, &domain.User{ID: userID}, &domain.UserWorkspace{
+			UserID:      userID,
+			WorkspaceID: workspaceID,
+			Permissions: domain.UserPermissions{
+				domain.PermissionResourceTemplates: {Read: true, Write: false},
+			},
+		}, nil)
+
+		_, err := templateService.TranslateTemplate(ctx, &domain.TranslateTemplateRequest{
+			WorkspaceID: workspaceID, ID: templateID, Languages: []string{"fr"},
+		})
+		var permErr *domain.PermissionError
+		assert.ErrorAs(t, err, &permErr)
+	})
+}
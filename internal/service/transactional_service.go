@@ -26,6 +26,8 @@ type TransactionalNotificationService struct {
 	authService        domain.AuthService
 	logger             logger.Logger
 	workspaceRepo      domain.WorkspaceRepository
+	suppressionRepo    domain.SuppressionRepository
+	translationRepo    domain.TranslationRepository
 	apiEndpoint        string
 }
 
@@ -39,6 +41,8 @@ func NewTransactionalNotificationService(
 	authService domain.AuthService,
 	logger logger.Logger,
 	workspaceRepo domain.WorkspaceRepository,
+	suppressionRepo domain.SuppressionRepository,
+	translationRepo domain.TranslationRepository,
 	apiEndpoint string,
 ) *TransactionalNotificationService {
 	return &TransactionalNotificationService{
@@ -50,6 +54,8 @@ func NewTransactionalNotificationService(
 		authService:        authService,
 		logger:             logger,
 		workspaceRepo:      workspaceRepo,
+		suppressionRepo:    suppressionRepo,
+		translationRepo:    translationRepo,
 		apiEndpoint:        apiEndpoint,
 	}
 }
@@ -494,6 +500,31 @@ func (s *TransactionalNotificationService) SendNotification(
 		return "", err
 	}
 
+	// Refuse addresses on the suppression list before touching the contact
+	if s.suppressionRepo != nil {
+		if err := checkNotSuppressed(ctx, s.suppressionRepo, workspaceID, params.Contact.Email); err != nil {
+			tracing.MarkSpanError(ctx, err)
+			return "", err
+		}
+
+		// Suppressed copy recipients are dropped, the contact still gets the message
+		for _, recipients := range []*[]string{&params.EmailOptions.CC, &params.EmailOptions.BCC} {
+			allowed, blocked, err := withoutSuppressed(ctx, s.suppressionRepo, workspaceID, *recipients)
+			if err != nil {
+				tracing.MarkSpanError(ctx, err)
+				return "", err
+			}
+			if len(blocked) > 0 {
+				s.logger.WithFields(map[string]interface{}{
+					"workspace_id": workspaceID,
+					"notification": params.ID,
+					"blocked":      blocked,
+				}).Info("Dropped suppressed copy recipients")
+			}
+			*recipients = allowed
+		}
+	}
+
 	contactOperation := s.contactService.UpsertContact(ctx, workspaceID, params.Contact)
 	if contactOperation.Action == domain.UpsertContactOperationError {
 		err := fmt.Errorf("failed to upsert contact: %s", contactOperation.Error)
@@ -597,6 +628,7 @@ func (s *TransactionalNotificationService) SendNotification(
 		req := domain.TemplateDataRequest{
 			WorkspaceID:         workspace.ID,
 			WorkspaceSecretKey:  workspace.Settings.SecretKey,
+			TemplateSecrets:     workspace.Settings.TemplateSecrets,
 			WorkspaceWebsiteURL: workspace.Settings.WebsiteURL,
 			ContactWithList:     contactWithList,
 			MessageID:           messageID,
@@ -786,6 +818,7 @@ func (s *TransactionalNotificationService) TestTemplate(ctx context.Context, wor
 	req := domain.TemplateDataRequest{
 		WorkspaceID:         workspace.ID,
 		WorkspaceSecretKey:  workspace.Settings.SecretKey,
+		TemplateSecrets:     workspace.Settings.TemplateSecrets,
 		WorkspaceWebsiteURL: workspace.Settings.WebsiteURL,
 		ContactWithList:     contactWithList,
 		MessageID:           messageID,
@@ -799,6 +832,11 @@ func (s *TransactionalNotificationService) TestTemplate(ctx context.Context, wor
 		return fmt.Errorf("failed to build template data: %w", err)
 	}
 
+	if err := domain.ApplyTranslations(ctx, s.translationRepo, workspaceID, workspace.Settings.DefaultLanguage,
+		language, emailContent, messageData); err != nil {
+		return err
+	}
+
 	// Compile the template with the test data
 	compileReq := domain.CompileTemplateRequest{
 		WorkspaceID:            workspaceID,
@@ -807,6 +845,7 @@ func (s *TransactionalNotificationService) TestTemplate(ctx context.Context, wor
 		TemplateData:           notifuse_mjml.MapOfAny(messageData),
 		TrackingSettings:       trackingSettings,
 		SubjectPreviewOverride: emailOptions.SubjectPreview,
+		Text:                   emailContent.Text,
 	}
 	compileReq.MjmlSource = emailContent.GetCodeModeMjmlSource()
 	compiledResult, err := s.templateService.CompileTemplate(ctx, compileReq)
@@ -856,6 +895,7 @@ func (s *TransactionalNotificationService) TestTemplate(ctx context.Context, wor
 		To:            recipientEmail,
 		Subject:       processedSubject,
 		Content:       *compiledResult.HTML,
+		Text:          compiledResult.GetText(),
 		Provider:      emailProvider,
 		EmailOptions:  emailOptions,
 	}
@@ -1046,6 +1046,7 @@ func TestNewTransactionalNotificationService(t *testing.T) {
 	mockAuthService := mocks.NewMockAuthService(ctrl)
 	mockLogger := pkgmocks.NewMockLogger(ctrl)
 	mockWorkspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+	mockSuppressionRepo := mocks.NewMockSuppressionRepository(ctrl)
 	apiEndpoint := "https://api.example.com"
 
 	service := NewTransactionalNotificationService(
@@ -1057,6 +1058,8 @@ func TestNewTransactionalNotificationService(t *testing.T) {
 		mockAuthService,
 		mockLogger,
 		mockWorkspaceRepo,
+		mockSuppressionRepo,
+		nil,
 		apiEndpoint,
 	)
 
@@ -1069,6 +1072,7 @@ func TestNewTransactionalNotificationService(t *testing.T) {
 	assert.Equal(t, mockAuthService, service.authService)
 	assert.Equal(t, mockLogger, service.logger)
 	assert.Equal(t, mockWorkspaceRepo, service.workspaceRepo)
+	assert.Equal(t, mockSuppressionRepo, service.suppressionRepo)
 	assert.Equal(t, apiEndpoint, service.apiEndpoint)
 }
 
@@ -1606,6 +1610,124 @@ func TestTransactionalNotificationService_SendNotification(t *testing.T) {
 		assert.Contains(t, err.Error(), "failed to upsert contact")
 	})
 
+	t.Run("Error_EmailSuppressed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockTransactionalNotificationRepository(ctrl)
+		mockContactService := mocks.NewMockContactService(ctrl)
+		mockLogger := pkgmocks.NewMockLogger(ctrl)
+		mockWorkspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+		mockSuppressionRepo := mocks.NewMockSuppressionRepository(ctrl)
+
+		service := &TransactionalNotificationService{
+			transactionalRepo: mockRepo,
+			contactService:    mockContactService,
+			logger:            mockLogger,
+			workspaceRepo:     mockWorkspaceRepo,
+			suppressionRepo:   mockSuppressionRepo,
+			apiEndpoint:       "https://api.example.com",
+		}
+
+		params := domain.TransactionalNotificationSendParams{
+			ID:      notificationID,
+			Contact: contact,
+		}
+
+		mockWorkspaceRepo.EXPECT().
+			GetByID(gomock.Any(), workspace).
+			Return(workspaceObj, nil)
+		mockRepo.EXPECT().
+			Get(gomock.Any(), workspace, notificationID).
+			Return(notification, nil)
+
+		// The address is suppressed, so the contact is never upserted
+		mockSuppressionRepo.EXPECT().
+			FindMatches(gomock.Any(), workspace, []string{contact.Email}).
+			Return(map[string][]*domain.Suppression{
+				contact.Email: {{Pattern: contact.Email, Reason: domain.SuppressionReasonHardBounce}},
+			}, nil)
+
+		messageID, err := service.SendNotification(context.WithValue(ctx, domain.SystemCallKey, true), workspace, params)
+
+		require.ErrorIs(t, err, domain.ErrEmailSuppressed)
+		require.Empty(t, messageID)
+		assert.Contains(t, err.Error(), "test@example.com (hard_bounce)")
+	})
+
+	t.Run("Success_SuppressedCopiesDropped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockTransactionalNotificationRepository(ctrl)
+		mockContactService := mocks.NewMockContactService(ctrl)
+		mockEmailService := mocks.NewMockEmailServiceInterface(ctrl)
+		mockLogger := pkgmocks.NewMockLogger(ctrl)
+		mockWorkspaceRepo := mocks.NewMockWorkspaceRepository(ctrl)
+		mockSuppressionRepo := mocks.NewMockSuppressionRepository(ctrl)
+
+		mockLogger.EXPECT().WithFields(gomock.Any()).Return(mockLogger).AnyTimes()
+		mockLogger.EXPECT().Info(gomock.Any()).AnyTimes()
+
+		service := &TransactionalNotificationService{
+			transactionalRepo: mockRepo,
+			contactService:    mockContactService,
+			emailService:      mockEmailService,
+			logger:            mockLogger,
+			workspaceRepo:     mockWorkspaceRepo,
+			suppressionRepo:   mockSuppressionRepo,
+			apiEndpoint:       "https://api.example.com",
+		}
+
+		params := domain.TransactionalNotificationSendParams{
+			ID:      notificationID,
+			Contact: contact,
+			EmailOptions: domain.EmailOptions{
+				CC:  []string{"cc@example.com", "Bounced@Example.com"},
+				BCC: []string{"bcc@blocked.com"},
+			},
+		}
+
+		mockWorkspaceRepo.EXPECT().
+			GetByID(gomock.Any(), workspace).
+			Return(workspaceObj, nil)
+		mockRepo.EXPECT().
+			Get(gomock.Any(), workspace, notificationID).
+			Return(notification, nil)
+		mockSuppressionRepo.EXPECT().
+			FindMatches(gomock.Any(), workspace, []string{contact.Email}).
+			Return(map[string][]*domain.Suppression{}, nil)
+		mockSuppressionRepo.EXPECT().
+			FindMatches(gomock.Any(), workspace, []string{"cc@example.com", "bounced@example.com"}).
+			Return(map[string][]*domain.Suppression{
+				"bounced@example.com": {{Pattern: "bounced@example.com", Reason: domain.SuppressionReasonHardBounce}},
+			}, nil)
+		mockSuppressionRepo.EXPECT().
+			FindMatches(gomock.Any(), workspace, []string{"bcc@blocked.com"}).
+			Return(map[string][]*domain.Suppression{
+				"bcc@blocked.com": {{Pattern: "@blocked.com", Kind: domain.SuppressionKindDomain}},
+			}, nil)
+		mockContactService.EXPECT().
+			UpsertContact(gomock.Any(), workspace, contact).
+			Return(domain.UpsertContactOperation{Email: contact.Email, Action: domain.UpsertContactOperationUpdate})
+		mockContactService.EXPECT().
+			GetContactByEmail(gomock.Any(), workspace, contact.Email).
+			Return(contact, nil)
+
+		// The contact still gets the message, without the suppressed copies
+		mockEmailService.EXPECT().
+			SendEmailForTemplate(gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, request domain.SendEmailRequest) {
+				assert.Equal(t, []string{"cc@example.com"}, request.EmailOptions.CC)
+				assert.Empty(t, request.EmailOptions.BCC)
+			}).Return(nil)
+
+		messageID, err := service.SendNotification(context.WithValue(ctx, domain.SystemCallKey, true), workspace, params)
+
+		require.NoError(t, err)
+		require.NotEmpty(t, messageID)
+	})
+
 	t.Run("Error_ContactNotFoundAfterUpsert", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
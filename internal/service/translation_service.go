@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/pkg/logger"
+)
+
+// TranslationService manages the translation catalogs of workspaces
+type TranslationService struct {
+	translationRepo domain.TranslationRepository
+	workspaceRepo   domain.WorkspaceRepository
+	authService     domain.AuthService
+	logger          logger.Logger
+}
+
+// NewTranslationService creates a new translation service
+func NewTranslationService(
+	translationRepo domain.TranslationRepository,
+	workspaceRepo domain.WorkspaceRepository,
+	authService domain.AuthService,
+	logger logger.Logger,
+) *TranslationService {
+	return &TranslationService{
+		translationRepo: translationRepo,
+		workspaceRepo:   workspaceRepo,
+		authService:     authService,
+		logger:          logger,
+	}
+}
+
+// defaultLanguage returns the language the workspace falls back to
+func (s *TranslationService) defaultLanguage(ctx context.Context, workspaceID string) (string, error) {
+	workspace, err := s.workspaceRepo.GetByID(ctx, workspaceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get workspace: %w", err)
+	}
+	if workspace.Settings.DefaultLanguage == "" {
+		return domain.DefaultLanguageCode, nil
+	}
+	return workspace.Settings.DefaultLanguage, nil
+}
+
+// ListTranslations returns the strings of a workspace, optionally of a single language
+func (s *TranslationService) ListTranslations(ctx context.Context, req *domain.ListTranslationsRequest) (*domain.ListTranslationsResponse, error) {
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceTemplates, domain.PermissionTypeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultLanguage, err := s.defaultLanguage(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var languages []string
+	if req.Language != "" {
+		languages = []string{req.Language}
+	}
+	translations, err := s.translationRepo.List(ctx, req.WorkspaceID, languages, nil)
+	if err != nil {
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to list translations: %v", err))
+		return nil, fmt.Errorf("failed to list translations: %w", err)
+	}
+
+	return &domain.ListTranslationsResponse{
+		Translations:    translations,
+		DefaultLanguage: defaultLanguage,
+	}, nil
+}
+
+// UpsertTranslations creates or replaces strings of one language
+func (s *TranslationService) UpsertTranslations(ctx context.Context, req *domain.UpsertTranslationsRequest) (*domain.UpsertTranslationsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, domain.NewValidationError(err.Error())
+	}
+
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceTemplates, domain.PermissionTypeWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.translationRepo.Upsert(ctx, req.WorkspaceID, req.Language, req.Translations); err != nil {
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to upsert translations: %v", err))
+		return nil, fmt.Errorf("failed to upsert translations: %w", err)
+	}
+
+	return &domain.UpsertTranslationsResponse{Language: req.Language, Count: len(req.Translations)}, nil
+}
+
+// DeleteTranslation removes a key in one language or in every language
+func (s *TranslationService) DeleteTranslation(ctx context.Context, req *domain.DeleteTranslationRequest) error {
+	if err := req.Validate(); err != nil {
+		return domain.NewValidationError(err.Error())
+	}
+
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceTemplates, domain.PermissionTypeWrite)
+	if err != nil {
+		return err
+	}
+
+	if err := s.translationRepo.Delete(ctx, req.WorkspaceID, req.Key, req.Language); err != nil {
+		if errors.Is(err, domain.ErrTranslationNotFound) {
+			return err
+		}
+		s.logger.WithField("key", req.Key).Error(fmt.Sprintf("Failed to delete translation: %v", err))
+		return fmt.Errorf("failed to delete translation: %w", err)
+	}
+
+	return nil
+}
+
+// ImportTranslations stores the translated strings of a JSON, XLIFF or PO file.
+// Strings already stored for keys missing from the file are kept.
+func (s *TranslationService) ImportTranslations(ctx context.Context, req *domain.ImportTranslationsRequest) (*domain.UpsertTranslationsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, domain.NewValidationError(err.Error())
+	}
+
+	fileLanguage, translations, err := domain.ParseTranslations(req.Format, req.Content)
+	if err != nil {
+		return nil, domain.NewValidationError(err.Error())
+	}
+
+	// PO files commonly write regions with an underscore, as in pt_BR
+	language := req.Language
+	if language == "" {
+		language = strings.ReplaceAll(fileLanguage, "_", "-")
+	}
+	if language == "" {
+		return nil, domain.NewValidationError("language is required when the file does not declare it")
+	}
+	if len(translations) == 0 {
+		return nil, domain.NewValidationError("the file holds no translated strings")
+	}
+
+	upsert := &domain.UpsertTranslationsRequest{
+		WorkspaceID:  req.WorkspaceID,
+		Language:     language,
+		Translations: translations,
+	}
+	return s.UpsertTranslations(ctx, upsert)
+}
+
+// ExportTranslations writes the strings of a language for a localization vendor, paired with
+// the strings of the workspace default language in XLIFF and PO files
+func (s *TranslationService) ExportTranslations(ctx context.Context, req *domain.ExportTranslationsRequest) (*domain.TranslationExport, error) {
+	ctx, _, err := authorizeWorkspace(ctx, s.authService, req.WorkspaceID, domain.PermissionResourceTemplates, domain.PermissionTypeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultLanguage, err := s.defaultLanguage(ctx, req.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	translations, err := s.translationRepo.List(ctx, req.WorkspaceID, []string{defaultLanguage, req.Language}, nil)
+	if err != nil {
+		s.logger.WithField("workspace_id", req.WorkspaceID).Error(fmt.Sprintf("Failed to list translations: %v", err))
+		return nil, fmt.Errorf("failed to list translations: %w", err)
+	}
+
+	source := make(map[string]string)
+	target := make(map[string]string)
+	for _, translation := range translations {
+		if translation.Language == defaultLanguage {
+			source[translation.Key] = translation.Value
+		}
+		if translation.Language == req.Language {
+			target[translation.Key] = translation.Value
+		}
+	}
+
+	content, err := domain.FormatTranslations(req.Format, defaultLanguage, req.Language, source, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TranslationExport{
+		Filename: fmt.Sprintf("translations-%s-%s.%s", req.Language, time.Now().UTC().Format("20060102-150405"),
+			domain.TranslationFileExtension(req.Format)),
+		ContentType: domain.TranslationContentType(req.Format),
+		Content:     content,
+	}, nil
+}
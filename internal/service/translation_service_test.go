@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Notifuse/notifuse/internal/domain"
+	"github.com/Notifuse/notifuse/internal/domain/mocks"
+	pkgmocks "github.com/Notifuse/notifuse/pkg/mocks"
+)
+
+type translationServiceTest struct {
+	repo          *mocks.MockTranslationRepository
+	workspaceRepo *mocks.MockWorkspaceRepository
+	authService   *mocks.MockAuthService
+	logger        *pkgmocks.MockLogger
+	service       *TranslationService
+}
+
+func setupTranslationServiceTest(t *testing.T) *translationServiceTest {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(func() { ctrl.Finish() })
+
+	st := &translationServiceTest{
+		repo:          mocks.NewMockTranslationRepository(ctrl),
+		workspaceRepo: mocks.NewMockWorkspaceRepository(ctrl),
+		authService:   mocks.NewMockAuthService(ctrl),
+		logger:        pkgmocks.NewMockLogger(ctrl),
+	}
+	st.service = NewTranslationService(st.repo, st.workspaceRepo, st.authService, st.logger)
+	return st
+}
+
+func (st *translationServiceTest) expectAuth(ctx context.Context, permissions domain.ResourcePermissions) {
+	userWorkspace := &domain.UserWorkspace{
+		UserID:      "user1",
+		WorkspaceID: "ws1",
+		Permissions: domain.UserPermissions{domain.PermissionResourceTemplates: permissions},
+	}
+	st.authService.EXPECT().AuthenticateUserForWorkspace(ctx, "ws1").Return(ctx, &domain.User{}, userWorkspace, nil)
+}
+
+func (st *translationServiceTest) expectWorkspace(ctx context.Context, defaultLanguage string) {
+	st.workspaceRepo.EXPECT().GetByID(ctx, "ws1").Return(&domain.Workspace{
+		ID:       "ws1",
+		Settings: domain.WorkspaceSettings{DefaultLanguage: defaultLanguage},
+	}, nil)
+}
+
+func TestTranslationService_ListTranslations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("lists the strings of a language", func(t *testing.T) {
+		st := setupTranslationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+		st.expectWorkspace(ctx, "fr")
+		translations := []*domain.Translation{{Key: "welcome", Language: "de", Value: "Willkommen"}}
+		st.repo.EXPECT().List(ctx, "ws1", []string{"de"}, nil).Return(translations, nil)
+
+		response, err := st.service.ListTranslations(ctx, &domain.ListTranslationsRequest{WorkspaceID: "ws1", Language: "de"})
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.ListTranslationsResponse{Translations: translations, DefaultLanguage: "fr"}, response)
+	})
+
+	t.Run("defaults to english", func(t *testing.T) {
+		st := setupTranslationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+		st.expectWorkspace(ctx, "")
+		st.repo.EXPECT().List(ctx, "ws1", nil, nil).Return([]*domain.Translation{}, nil)
+
+		response, err := st.service.ListTranslations(ctx, &domain.ListTranslationsRequest{WorkspaceID: "ws1"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "en", response.DefaultLanguage)
+	})
+
+	t.Run("requires read permission", func(t *testing.T) {
+		st := setupTranslationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{})
+
+		_, err := st.service.ListTranslations(ctx, &domain.ListTranslationsRequest{WorkspaceID: "ws1"})
+
+		var permErr *domain.PermissionError
+		assert.True(t, errors.As(err, &permErr))
+	})
+}
+
+func TestTranslationService_UpsertTranslations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stores the strings", func(t *testing.T) {
+		st := setupTranslationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Write: true})
+		st.repo.EXPECT().Upsert(ctx, "ws1", "fr", map[string]string{"welcome": "Bienvenue"}).Return(nil)
+
+		response, err := st.service.UpsertTranslations(ctx, &domain.UpsertTranslationsRequest{
+			WorkspaceID: "ws1", Language: "fr", Translations: map[string]string{"welcome": "Bienvenue"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.UpsertTranslationsResponse{Language: "fr", Count: 1}, response)
+	})
+
+	t.Run("rejects invalid keys", func(t *testing.T) {
+		st := setupTranslationServiceTest(t)
+
+		_, err := st.service.UpsertTranslations(ctx, &domain.UpsertTranslationsRequest{
+			WorkspaceID: "ws1", Language: "fr", Translations: map[string]string{"bad key": "x"},
+		})
+
+		var validationErr domain.ValidationError
+		assert.True(t, errors.As(err, &validationErr))
+	})
+
+	t.Run("requires write permission", func(t *testing.T) {
+		st := setupTranslationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+
+		_, err := st.service.UpsertTranslations(ctx, &domain.UpsertTranslationsRequest{
+			WorkspaceID: "ws1", Language: "fr", Translations: map[string]string{"welcome": "Bienvenue"},
+		})
+
+		var permErr *domain.PermissionError
+		assert.True(t, errors.As(err, &permErr))
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		st := setupTranslationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Write: true})
+		st.repo.EXPECT().Upsert(ctx, "ws1", "fr", gomock.Any()).Return(errors.New("db error"))
+		st.logger.EXPECT().WithField("workspace_id", "ws1").Return(st.logger)
+		st.logger.EXPECT().Error(gomock.Any())
+
+		_, err := st.service.UpsertTranslations(ctx, &domain.UpsertTranslationsRequest{
+			WorkspaceID: "ws1", Language: "fr", Translations: map[string]string{"welcome": "Bienvenue"},
+		})
+
+		assert.ErrorContains(t, err, "failed to upsert translations")
+	})
+}
+
+func TestTranslationService_DeleteTranslation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("deletes a key", func(t *testing.T) {
+		st := setupTranslationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Write: true})
+		st.repo.EXPECT().Delete(ctx, "ws1", "welcome", "").Return(nil)
+
+		assert.NoError(t, st.service.DeleteTranslation(ctx, &domain.DeleteTranslationRequest{WorkspaceID: "ws1", Key: "welcome"}))
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		st := setupTranslationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Write: true})
+		st.repo.EXPECT().Delete(ctx, "ws1", "missing", "fr").Return(domain.ErrTranslationNotFound)
+
+		err := st.service.DeleteTranslation(ctx, &domain.DeleteTranslationRequest{WorkspaceID: "ws1", Key: "missing", Language: "fr"})
+
+		assert.ErrorIs(t, err, domain.ErrTranslationNotFound)
+	})
+}
+
+func TestTranslationService_ImportTranslations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("imports a PO file in the language it declares", func(t *testing.T) {
+		st := setupTranslationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Write: true})
+		st.repo.EXPECT().Upsert(ctx, "ws1", "pt-BR", map[string]string{"welcome": "Bem-vindo"}).Return(nil)
+
+		response, err := st.service.ImportTranslations(ctx, &domain.ImportTranslationsRequest{
+			WorkspaceID: "ws1",
+			Format:      domain.TranslationFormatPO,
+			Content:     "msgid \"\"\nmsgstr \"Language: pt_BR\\n\"\n\nmsgctxt \"welcome\"\nmsgid \"Welcome\"\nmsgstr \"Bem-vindo\"\n",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.UpsertTranslationsResponse{Language: "pt-BR", Count: 1}, response)
+	})
+
+	t.Run("the requested language wins over the file", func(t *testing.T) {
+		st := setupTranslationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Write: true})
+		st.repo.EXPECT().Upsert(ctx, "ws1", "de", map[string]string{"cart.title": "Warenkorb"}).Return(nil)
+
+		_, err := st.service.ImportTranslations(ctx, &domain.ImportTranslationsRequest{
+			WorkspaceID: "ws1",
+			Language:    "de",
+			Format:      domain.TranslationFormatJSON,
+			Content:     `{"cart": {"title": "Warenkorb"}}`,
+		})
+
+		require.NoError(t, err)
+	})
+
+	tests := []struct {
+		name   string
+		req    *domain.ImportTranslationsRequest
+		errMsg string
+	}{
+		{"malformed file", &domain.ImportTranslationsRequest{WorkspaceID: "ws1", Language: "de", Format: domain.TranslationFormatJSON, Content: "{"}, "invalid JSON"},
+		{"unknown language", &domain.ImportTranslationsRequest{WorkspaceID: "ws1", Format: domain.TranslationFormatJSON, Content: `{"a": "b"}`}, "language is required"},
+		{"no strings", &domain.ImportTranslationsRequest{WorkspaceID: "ws1", Language: "de", Format: domain.TranslationFormatJSON, Content: "{}"}, "no translated strings"},
+		{"invalid key", &domain.ImportTranslationsRequest{WorkspaceID: "ws1", Language: "de", Format: domain.TranslationFormatJSON, Content: `{"a b": "c"}`}, "key \"a b\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := setupTranslationServiceTest(t)
+
+			_, err := st.service.ImportTranslations(ctx, tt.req)
+
+			var validationErr domain.ValidationError
+			require.True(t, errors.As(err, &validationErr), "expected a validation error, got %v", err)
+			assert.Contains(t, validationErr.Message, tt.errMsg)
+		})
+	}
+}
+
+func TestTranslationService_ExportTranslations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pairs the target strings with the default language", func(t *testing.T) {
+		st := setupTranslationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+		st.expectWorkspace(ctx, "en")
+		st.repo.EXPECT().List(ctx, "ws1", []string{"en", "fr"}, nil).Return([]*domain.Translation{
+			{Key: "goodbye", Language: "en", Value: "Goodbye"},
+			{Key: "welcome", Language: "en", Value: "Welcome"},
+			{Key: "welcome", Language: "fr", Value: "Bienvenue"},
+		}, nil)
+
+		export, err := st.service.ExportTranslations(ctx, &domain.ExportTranslationsRequest{WorkspaceID: "ws1", Language: "fr", Format: domain.TranslationFormatPO})
+
+		require.NoError(t, err)
+		assert.Equal(t, "text/x-gettext-translation", export.ContentType)
+		assert.True(t, strings.HasPrefix(export.Filename, "translations-fr-"))
+		assert.True(t, strings.HasSuffix(export.Filename, ".po"))
+		assert.Contains(t, string(export.Content), "msgctxt \"goodbye\"\nmsgid \"Goodbye\"\nmsgstr \"\"\n")
+		assert.Contains(t, string(export.Content), "msgctxt \"welcome\"\nmsgid \"Welcome\"\nmsgstr \"Bienvenue\"\n")
+	})
+
+	t.Run("exports the target strings as JSON", func(t *testing.T) {
+		st := setupTranslationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{Read: true})
+		st.expectWorkspace(ctx, "en")
+		st.repo.EXPECT().List(ctx, "ws1", []string{"en", "fr"}, nil).Return([]*domain.Translation{
+			{Key: "welcome", Language: "en", Value: "Welcome"},
+			{Key: "welcome", Language: "fr", Value: "Bienvenue"},
+		}, nil)
+
+		export, err := st.service.ExportTranslations(ctx, &domain.ExportTranslationsRequest{WorkspaceID: "ws1", Language: "fr", Format: domain.TranslationFormatJSON})
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"welcome": "Bienvenue"}`, string(export.Content))
+	})
+
+	t.Run("requires read permission", func(t *testing.T) {
+		st := setupTranslationServiceTest(t)
+		st.expectAuth(ctx, domain.ResourcePermissions{})
+
+		_, err := st.service.ExportTranslations(ctx, &domain.ExportTranslationsRequest{WorkspaceID: "ws1", Language: "fr", Format: domain.TranslationFormatJSON})
+
+		var permErr *domain.PermissionError
+		assert.True(t, errors.As(err, &permErr))
+	})
+}
@@ -296,6 +296,12 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, id string, name
 		// Don't fail workspace creation if task creation fails - it can be created later
 	}
 
+	// Create permanent email verification task for this workspace
+	if err := EnsureEmailVerificationTask(ctx, s.taskRepo, id); err != nil {
+		s.logger.WithField("workspace_id", id).WithField("error", err.Error()).Error("Failed to create email verification task")
+		// Don't fail workspace creation if task creation fails - it can be created later
+	}
+
 	return workspace, nil
 }
 
@@ -373,8 +379,13 @@ func (s *WorkspaceService) UpdateWorkspace(ctx context.Context, id string, name
 
 	existingWorkspace.Settings.CustomEndpointURL = settings.CustomEndpointURL
 	existingWorkspace.Settings.CustomFieldLabels = settings.CustomFieldLabels
+	existingWorkspace.Settings.ContactAttributes = settings.ContactAttributes
 	existingWorkspace.Settings.BlogEnabled = settings.BlogEnabled
 	existingWorkspace.Settings.BlogSettings = settings.BlogSettings
+	existingWorkspace.Settings.NotificationCenterDataExportEnabled = settings.NotificationCenterDataExportEnabled
+	existingWorkspace.Settings.WebView = settings.WebView
+	settings.TemplateSecrets.KeepValues(existingWorkspace.Settings.TemplateSecrets)
+	existingWorkspace.Settings.TemplateSecrets = settings.TemplateSecrets
 	existingWorkspace.Settings.DefaultLanguage = settings.DefaultLanguage
 	existingWorkspace.Settings.Languages = settings.Languages
 
@@ -2,6 +2,7 @@ import { useState, useEffect, useRef } from 'react'
 import {
   getContactPreferences,
   parseNotificationCenterParams,
+  getDataExportUrl,
   subscribeToLists,
   unsubscribeOneClick,
   updateContactPreferences
@@ -688,6 +689,14 @@ function App() {
         >
           {t('visitWebsite')}
         </a>
+        {notificationData?.data_export_enabled && parseNotificationCenterParams() && (
+          <a
+            href={getDataExportUrl(parseNotificationCenterParams()!)}
+            className="ml-4 hover:text-gray-700 hover:underline"
+          >
+            {t('downloadMyData')}
+          </a>
+        )}
       </div>
     </div>
   )
@@ -54,6 +54,7 @@ export interface ContactPreferencesResponse {
   contact_lists?: ContactList[] | null
   logo_url?: string
   website_url?: string
+  data_export_enabled?: boolean
 }
 
 /**
@@ -158,3 +159,16 @@ export async function unsubscribeOneClick(
 ): Promise<UnsubscribeResponse> {
   return api.post<UnsubscribeResponse>('/unsubscribe-oneclick', request)
 }
+
+/**
+ * Returns the URL of the "download my data" archive for a contact
+ */
+export function getDataExportUrl(params: NotificationCenterParams): string {
+  const apiEndpoint = window.API_ENDPOINT || 'http://localhost:3000'
+  const query = new URLSearchParams({
+    workspace_id: params.wid,
+    email: params.email,
+    email_hmac: params.email_hmac
+  })
+  return `${apiEndpoint}/preferences/data-export?${query.toString()}`
+}
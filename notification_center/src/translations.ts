@@ -124,6 +124,19 @@ export const translations: TranslationObject = {
     ja: '利用可能な購読設定はありません。',
     pl: 'Brak dostępnych ustawień subskrypcji.'
   },
+  downloadMyData: {
+    en: 'Download my data',
+    fr: 'Télécharger mes données',
+    es: 'Descargar mis datos',
+    de: 'Meine Daten herunterladen',
+    zh: '下载我的数据',
+    hi: 'मेरा डेटा डाउनलोड करें',
+    ar: 'تنزيل بياناتي',
+    pt: 'Baixar meus dados',
+    ru: 'Скачать мои данные',
+    ja: 'データをダウンロード',
+    pl: 'Pobierz moje dane'
+  },
   visitWebsite: {
     en: 'Visit our website',
     fr: 'Visitez notre site web',
@@ -0,0 +1,115 @@
+package emailverifier
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// enhancedStatusRegexp matches the RFC 3463 status code leading a reply text
+var enhancedStatusRegexp = regexp.MustCompile(`^([245])\.(\d{1,3})\.(\d{1,3})\b`)
+
+// unknownUserPhrases are reply texts of servers rejecting an unknown mailbox
+// without an enhanced status code
+var unknownUserPhrases = []string{
+	"user unknown",
+	"unknown user",
+	"no such user",
+	"unknown recipient",
+	"recipient unknown",
+	"invalid recipient",
+	"mailbox not found",
+	"no mailbox",
+	"does not exist",
+	"doesn't exist",
+}
+
+// SMTPProber checks recipients by opening an SMTP session with the mail
+// server and issuing RCPT TO, without ever sending DATA
+type SMTPProber struct {
+	// HeloName is announced in EHLO, most servers reject "localhost"
+	HeloName string
+	// Timeout bounds the whole session
+	Timeout time.Duration
+}
+
+// NewSMTPProber creates a prober announcing itself as heloName, or as the
+// machine hostname when heloName is empty
+func NewSMTPProber(heloName string) *SMTPProber {
+	if heloName == "" {
+		heloName, _ = os.Hostname()
+	}
+	return &SMTPProber{HeloName: heloName, Timeout: 10 * time.Second}
+}
+
+// Probe implements Prober. Replies to RCPT TO saying the mailbox doesn't exist
+// reject it; any other failure, including policy rejections such as a
+// blocklisted IP, is returned as an error.
+func (p *SMTPProber) Probe(ctx context.Context, mxHost, email string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.Timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(mxHost, "25"))
+	if err != nil {
+		return false, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, mxHost)
+	if err != nil {
+		_ = conn.Close()
+		return false, err
+	}
+	defer client.Close()
+
+	if err := client.Hello(p.HeloName); err != nil {
+		return false, err
+	}
+	// Null reverse-path, like a bounce, so no sender address is exposed
+	if err := client.Mail(""); err != nil {
+		return false, err
+	}
+	if err := client.Rcpt(email); err != nil {
+		var protoErr *textproto.Error
+		if errors.As(err, &protoErr) && isMailboxRejection(protoErr) {
+			_ = client.Quit()
+			return false, nil
+		}
+		return false, err
+	}
+
+	_ = client.Quit()
+	return true, nil
+}
+
+// isMailboxRejection tells whether a RCPT TO reply rejects the mailbox itself:
+// 550, 551 or 553 with a 5.1.x addressing status, or without an enhanced status
+// and a text naming an unknown user. Other permanent replies are about the
+// sender, its IP or the server policy and say nothing about the mailbox.
+func isMailboxRejection(reply *textproto.Error) bool {
+	switch reply.Code {
+	case 550, 551, 553:
+	default:
+		return false
+	}
+	msg := strings.TrimSpace(reply.Msg)
+	if status := enhancedStatusRegexp.FindStringSubmatch(msg); status != nil {
+		return status[1] == "5" && status[2] == "1"
+	}
+	msg = strings.ToLower(msg)
+	for _, phrase := range unknownUserPhrases {
+		if strings.Contains(msg, phrase) {
+			return true
+		}
+	}
+	return false
+}
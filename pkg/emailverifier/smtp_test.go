@@ -0,0 +1,33 @@
+package emailverifier
+
+import (
+	"net/textproto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMailboxRejection(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     int
+		msg      string
+		rejected bool
+	}{
+		{"unknown mailbox", 550, "5.1.1 <john@example.com>: Recipient address rejected: User unknown", true},
+		{"user not local", 551, "5.1.6 User has moved", true},
+		{"bad mailbox syntax", 553, "5.1.3 Invalid address", true},
+		{"unknown user without enhanced status", 550, "No such user here", true},
+		{"blocklisted ip", 550, "5.7.1 Service unavailable; client host blocked using Spamhaus", false},
+		{"policy rejection naming the user", 550, "5.7.1 User unknown or relaying denied", false},
+		{"mailbox full", 552, "5.2.2 Mailbox full", false},
+		{"generic rejection", 550, "Requested action not taken", false},
+		{"addressing status on another code", 554, "5.1.1 User unknown", false},
+		{"temporary failure", 450, "4.1.1 Try again later", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.rejected, isMailboxRejection(&textproto.Error{Code: tt.code, Msg: tt.msg}))
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package emailverifier
+
+import "strings"
+
+// roleAccounts are local parts that usually reach a team or a system rather than a person
+var roleAccounts = map[string]bool{
+	"abuse": true, "admin": true, "administrator": true, "billing": true,
+	"contact": true, "enquiries": true, "help": true, "hello": true,
+	"hostmaster": true, "info": true, "inquiries": true, "jobs": true,
+	"mail": true, "marketing": true, "no-reply": true, "noreply": true,
+	"office": true, "postmaster": true, "privacy": true, "root": true,
+	"sales": true, "security": true, "support": true, "team": true,
+	"webmaster": true,
+}
+
+// commonDomains are the mailbox providers typos are matched against
+var commonDomains = []string{
+	"gmail.com", "googlemail.com", "yahoo.com", "yahoo.fr", "yahoo.co.uk",
+	"hotmail.com", "hotmail.fr", "hotmail.co.uk", "outlook.com", "live.com",
+	"msn.com", "icloud.com", "me.com", "mac.com", "aol.com", "proton.me",
+	"protonmail.com", "gmx.com", "gmx.de", "web.de", "orange.fr", "free.fr",
+	"comcast.net", "verizon.net", "yandex.ru", "mail.ru",
+}
+
+// tldTypos maps frequently mistyped top-level domains to the intended one
+var tldTypos = map[string]string{
+	"con": "com", "cmo": "com", "ocm": "com", "vom": "com", "xom": "com",
+	"comm": "com", "cpm": "com", "nte": "net", "nett": "net", "ogr": "org",
+}
+
+// IsRoleAccount reports whether the local part is a role account such as info@ or admin@.
+// Sub-addressing tags (admin+alerts@) are ignored.
+func IsRoleAccount(local string) bool {
+	local = strings.ToLower(local)
+	if i := strings.Index(local, "+"); i >= 0 {
+		local = local[:i]
+	}
+	return roleAccounts[local]
+}
+
+// SuggestDomain returns the domain the user most likely meant, or an empty
+// string when the domain doesn't look like a typo of a common provider
+func SuggestDomain(domain string) string {
+	domain = strings.ToLower(domain)
+	for _, known := range commonDomains {
+		if domain == known {
+			return ""
+		}
+	}
+
+	candidate := domain
+	if i := strings.LastIndex(candidate, "."); i >= 0 {
+		if fixed, ok := tldTypos[candidate[i+1:]]; ok {
+			candidate = candidate[:i+1] + fixed
+		}
+	}
+
+	for _, known := range commonDomains {
+		// Short domains are one keystroke away from too many legitimate ones
+		if candidate == known || (len(known) >= 8 && editDistance(candidate, known) == 1) {
+			return known
+		}
+	}
+
+	if candidate != domain {
+		return candidate
+	}
+	return ""
+}
+
+// editDistance computes the optimal string alignment distance, which counts
+// insertions, deletions, substitutions and adjacent transpositions
+func editDistance(a, b string) int {
+	rows := make([][]int, len(a)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(b)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			rows[i][j] = min(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				rows[i][j] = min(rows[i][j], rows[i-2][j-2]+1)
+			}
+		}
+	}
+	return rows[len(a)][len(b)]
+}
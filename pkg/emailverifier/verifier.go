@@ -0,0 +1,202 @@
+package emailverifier
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/mail"
+	"sort"
+	"strings"
+
+	"github.com/Notifuse/notifuse/pkg/disposable_emails"
+)
+
+// Status summarizes the outcome of a verification
+type Status string
+
+const (
+	// StatusValid means every check passed
+	StatusValid Status = "valid"
+	// StatusRisky means the address can receive mail but is disposable,
+	// a role account or likely a typo
+	StatusRisky Status = "risky"
+	// StatusInvalid means the address cannot receive mail
+	StatusInvalid Status = "invalid"
+	// StatusUnknown means a DNS lookup could not complete
+	StatusUnknown Status = "unknown"
+)
+
+// Reasons reported alongside a non-valid status
+const (
+	ReasonInvalidSyntax   = "invalid_syntax"
+	ReasonNoMailServer    = "no_mail_server"
+	ReasonMailboxRejected = "mailbox_rejected"
+	ReasonDNSError        = "dns_error"
+	ReasonDisposable      = "disposable"
+	ReasonRoleAccount     = "role_account"
+	ReasonPossibleTypo    = "possible_typo"
+)
+
+// Checks holds the individual check outcomes. MX and SMTP are nil when the
+// check did not run or could not reach a conclusion.
+type Checks struct {
+	Syntax     bool  `json:"syntax"`
+	MX         *bool `json:"mx,omitempty"`
+	Disposable bool  `json:"disposable"`
+	Role       bool  `json:"role"`
+	SMTP       *bool `json:"smtp,omitempty"`
+}
+
+// Result is the outcome of verifying a single address
+type Result struct {
+	Email      string `json:"email"`
+	Status     Status `json:"status"`
+	Reason     string `json:"reason,omitempty"`
+	Suggestion string `json:"suggestion,omitempty"`
+	Checks     Checks `json:"checks"`
+}
+
+// Resolver looks up mail exchangers for a domain, net.DefaultResolver satisfies it
+type Resolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// Prober asks a mail server whether it accepts a recipient.
+// It returns false with a nil error when the server rejects the mailbox.
+type Prober interface {
+	Probe(ctx context.Context, mxHost, email string) (bool, error)
+}
+
+// Verifier runs the verification checks
+type Verifier struct {
+	resolver Resolver
+	prober   Prober
+}
+
+// New creates a verifier. A nil prober disables the SMTP RCPT check.
+func New(resolver Resolver, prober Prober) *Verifier {
+	return &Verifier{resolver: resolver, prober: prober}
+}
+
+// Verify checks an address. The SMTP probe only runs when probe is true and
+// the verifier has a prober.
+func (v *Verifier) Verify(ctx context.Context, email string, probe bool) *Result {
+	email = strings.ToLower(strings.TrimSpace(email))
+	result := &Result{Email: email}
+
+	local, domain, ok := splitAddress(email)
+	if !ok {
+		result.Status = StatusInvalid
+		result.Reason = ReasonInvalidSyntax
+		return result
+	}
+	result.Checks.Syntax = true
+	result.Checks.Disposable = disposable_emails.IsDisposableEmail(domain)
+	result.Checks.Role = IsRoleAccount(local)
+	if suggestion := SuggestDomain(domain); suggestion != "" {
+		result.Suggestion = local + "@" + suggestion
+	}
+
+	hosts, err := v.lookupMailHosts(ctx, domain)
+	if err != nil {
+		result.Status = StatusUnknown
+		result.Reason = ReasonDNSError
+		return result
+	}
+	hasMX := len(hosts) > 0
+	result.Checks.MX = &hasMX
+	if !hasMX {
+		result.Status = StatusInvalid
+		result.Reason = ReasonNoMailServer
+		return result
+	}
+
+	if probe && v.prober != nil {
+		// Probe errors (greylisting, blocked port 25...) are inconclusive
+		if accepted, err := v.prober.Probe(ctx, hosts[0], email); err == nil {
+			result.Checks.SMTP = &accepted
+			if !accepted {
+				result.Status = StatusInvalid
+				result.Reason = ReasonMailboxRejected
+				return result
+			}
+		}
+	}
+
+	switch {
+	case result.Checks.Disposable:
+		result.Status, result.Reason = StatusRisky, ReasonDisposable
+	case result.Checks.Role:
+		result.Status, result.Reason = StatusRisky, ReasonRoleAccount
+	case result.Suggestion != "":
+		result.Status, result.Reason = StatusRisky, ReasonPossibleTypo
+	default:
+		result.Status = StatusValid
+	}
+	return result
+}
+
+// lookupMailHosts returns the domain's mail hosts ordered by preference.
+// Domains without MX records fall back to their address records (RFC 5321
+// implicit MX), and a null MX (RFC 7505) means the domain accepts no mail.
+func (v *Verifier) lookupMailHosts(ctx context.Context, domain string) ([]string, error) {
+	records, err := v.resolver.LookupMX(ctx, domain)
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		if _, err := v.resolver.LookupHost(ctx, domain); err != nil {
+			if isNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []string{domain}, nil
+	}
+
+	sort.Slice(records, func(i, j int) bool { return records[i].Pref < records[j].Pref })
+	hosts := make([]string, 0, len(records))
+	for _, record := range records {
+		host := strings.TrimSuffix(record.Host, ".")
+		if host == "" {
+			continue
+		}
+		hosts = append(hosts, host)
+	}
+	return hosts, nil
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// splitAddress validates the syntax of a bare address and splits it
+func splitAddress(email string) (string, string, bool) {
+	if email == "" || len(email) > 254 {
+		return "", "", false
+	}
+	parsed, err := mail.ParseAddress(email)
+	if err != nil || parsed.Name != "" || parsed.Address != email {
+		return "", "", false
+	}
+
+	at := strings.LastIndex(email, "@")
+	local, domain := email[:at], email[at+1:]
+	if local == "" || len(local) > 64 || !strings.Contains(domain, ".") {
+		return "", "", false
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return "", "", false
+		}
+		for _, r := range label {
+			if r < 0x80 && r != '-' && (r < 'a' || r > 'z') && (r < '0' || r > '9') {
+				return "", "", false
+			}
+		}
+	}
+	return local, domain, true
+}
@@ -0,0 +1,167 @@
+package emailverifier
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeResolver struct {
+	mx     map[string][]*net.MX
+	hosts  map[string]bool
+	mxErr  error
+	called bool
+}
+
+func (r *fakeResolver) LookupMX(_ context.Context, name string) ([]*net.MX, error) {
+	r.called = true
+	if r.mxErr != nil {
+		return nil, r.mxErr
+	}
+	if records, ok := r.mx[name]; ok {
+		return records, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r *fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	if r.hosts[host] {
+		return []string{"192.0.2.1"}, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+type fakeProber struct {
+	accepted bool
+	err      error
+	host     string
+}
+
+func (p *fakeProber) Probe(_ context.Context, mxHost, _ string) (bool, error) {
+	p.host = mxHost
+	return p.accepted, p.err
+}
+
+func newResolver() *fakeResolver {
+	return &fakeResolver{
+		mx: map[string][]*net.MX{
+			"example.com": {{Host: "mx2.example.com.", Pref: 20}, {Host: "mx1.example.com.", Pref: 10}},
+			"gmial.com":   {{Host: "mx.gmial.com.", Pref: 10}},
+			"nomail.com":  {{Host: ".", Pref: 0}},
+			"0-180.com":   {{Host: "mx.0-180.com.", Pref: 10}},
+		},
+		hosts: map[string]bool{"implicit.com": true},
+	}
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		email      string
+		status     Status
+		reason     string
+		suggestion string
+	}{
+		{"valid address", "John@Example.com", StatusValid, "", ""},
+		{"bad syntax", "john@@example.com", StatusInvalid, ReasonInvalidSyntax, ""},
+		{"display name", "John <john@example.com>", StatusInvalid, ReasonInvalidSyntax, ""},
+		{"no dot in domain", "john@localhost", StatusInvalid, ReasonInvalidSyntax, ""},
+		{"unknown domain", "john@nowhere.com", StatusInvalid, ReasonNoMailServer, ""},
+		{"null MX", "john@nomail.com", StatusInvalid, ReasonNoMailServer, ""},
+		{"implicit MX", "john@implicit.com", StatusValid, "", ""},
+		{"disposable", "john@0-180.com", StatusRisky, ReasonDisposable, ""},
+		{"role account", "info@example.com", StatusRisky, ReasonRoleAccount, ""},
+		{"typo", "john@gmial.com", StatusRisky, ReasonPossibleTypo, "john@gmail.com"},
+	}
+
+	verifier := New(newResolver(), nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := verifier.Verify(ctx, tt.email, false)
+			assert.Equal(t, tt.status, result.Status)
+			assert.Equal(t, tt.reason, result.Reason)
+			assert.Equal(t, tt.suggestion, result.Suggestion)
+		})
+	}
+
+	t.Run("DNS failures are inconclusive", func(t *testing.T) {
+		resolver := newResolver()
+		resolver.mxErr = &net.DNSError{Err: "timeout", Name: "example.com", IsTimeout: true}
+
+		result := New(resolver, nil).Verify(ctx, "john@example.com", false)
+
+		assert.Equal(t, StatusUnknown, result.Status)
+		assert.Equal(t, ReasonDNSError, result.Reason)
+		assert.Nil(t, result.Checks.MX)
+	})
+
+	t.Run("syntax errors skip DNS", func(t *testing.T) {
+		resolver := newResolver()
+		New(resolver, nil).Verify(ctx, "not-an-email", false)
+		assert.False(t, resolver.called)
+	})
+}
+
+func TestVerifier_SMTPProbe(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("probes the preferred MX", func(t *testing.T) {
+		prober := &fakeProber{accepted: true}
+		result := New(newResolver(), prober).Verify(ctx, "john@example.com", true)
+
+		assert.Equal(t, "mx1.example.com", prober.host)
+		assert.Equal(t, StatusValid, result.Status)
+		assert.True(t, *result.Checks.SMTP)
+	})
+
+	t.Run("rejected mailbox is invalid", func(t *testing.T) {
+		result := New(newResolver(), &fakeProber{accepted: false}).Verify(ctx, "john@example.com", true)
+
+		assert.Equal(t, StatusInvalid, result.Status)
+		assert.Equal(t, ReasonMailboxRejected, result.Reason)
+	})
+
+	t.Run("probe errors are ignored", func(t *testing.T) {
+		result := New(newResolver(), &fakeProber{err: errors.New("connection refused")}).Verify(ctx, "john@example.com", true)
+
+		assert.Equal(t, StatusValid, result.Status)
+		assert.Nil(t, result.Checks.SMTP)
+	})
+
+	t.Run("probe disabled", func(t *testing.T) {
+		prober := &fakeProber{accepted: false}
+		result := New(newResolver(), prober).Verify(ctx, "john@example.com", false)
+
+		assert.Equal(t, StatusValid, result.Status)
+		assert.Empty(t, prober.host)
+	})
+}
+
+func TestIsRoleAccount(t *testing.T) {
+	assert.True(t, IsRoleAccount("info"))
+	assert.True(t, IsRoleAccount("Admin+alerts"))
+	assert.False(t, IsRoleAccount("john"))
+}
+
+func TestSuggestDomain(t *testing.T) {
+	tests := map[string]string{
+		"gmail.com":     "",
+		"gmial.com":     "gmail.com",
+		"gmail.con":     "gmail.com",
+		"hotmial.com":   "hotmail.com",
+		"yaho.com":      "yahoo.com",
+		"outlok.com":    "outlook.com",
+		"example.con":   "example.com",
+		"example.com":   "",
+		"ms.com":        "",
+		"company.co.uk": "",
+	}
+	for domain, want := range tests {
+		assert.Equal(t, want, SuggestDomain(domain), domain)
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/Notifuse/liquidgo/liquid"
-	"github.com/Notifuse/liquidgo/liquid/tags"
 )
 
 // Security limits for blog template rendering (matching V8/LiquidJS limits)
@@ -45,26 +44,11 @@ func (m *mapFileSystem) ReadTemplateFile(path string) (string, error) {
 }
 
 // BlogTemplateRenderer renders blog templates using liquidgo (with render tag support)
-type BlogTemplateRenderer struct {
-	env *liquid.Environment
-}
+type BlogTemplateRenderer struct{}
 
 // NewBlogTemplateRenderer creates a new liquidgo renderer for blog templates
 func NewBlogTemplateRenderer() *BlogTemplateRenderer {
-	env := liquid.NewEnvironment()
-
-	// Set error mode to lax (render errors inline, don't fail)
-	env.SetErrorMode("lax")
-
-	// Register standard tags (CRITICAL - required for if, for, assign, etc.)
-	tags.RegisterStandardTags(env)
-
-	// Register any custom filters if needed in the future
-	// env.RegisterFilter(&MyCustomFilters{})
-
-	return &BlogTemplateRenderer{
-		env: env,
-	}
+	return &BlogTemplateRenderer{}
 }
 
 // Render renders a blog template with the provided data and partials
@@ -109,9 +93,15 @@ func (r *BlogTemplateRenderer) Render(
 			}
 		}()
 
+		// The environment holds the standard tags and the custom filters bound to data
+		env := NewEnvironment(data)
+
+		// Set error mode to lax (render errors inline, don't fail)
+		env.SetErrorMode("lax")
+
 		// Parse the template with the environment
 		tmpl, err := liquid.ParseTemplate(template, &liquid.TemplateOptions{
-			Environment: r.env,
+			Environment: env,
 		})
 		if err != nil {
 			resultChan <- result{output: "", err: fmt.Errorf("failed to parse template: %w", err)}
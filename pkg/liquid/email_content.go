@@ -0,0 +1,22 @@
+package liquid
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// EmailBodyHTML returns the content of the <body> of a compiled email, to be embedded in a
+// page of the blog theme. The <head> is dropped: its styles would apply to the whole page,
+// while the layout of MJML emails is inlined and degrades to their mobile version without it.
+func EmailBodyHTML(document string) (string, error) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(document))
+	if err != nil {
+		return "", err
+	}
+	body, err := doc.Find("body").Html()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(body), nil
+}
@@ -0,0 +1,25 @@
+package liquid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailBodyHTML(t *testing.T) {
+	t.Run("keeps the body only", func(t *testing.T) {
+		document := `<!doctype html><html><head><title>News</title><style>p { margin: 0 }</style></head>` +
+			`<body style="margin:0"><div style="color:#333"><p>Hello</p></div></body></html>`
+
+		body, err := EmailBodyHTML(document)
+		require.NoError(t, err)
+		assert.Equal(t, `<div style="color:#333"><p>Hello</p></div>`, body)
+	})
+
+	t.Run("fragments", func(t *testing.T) {
+		body, err := EmailBodyHTML(`<p>Hello</p>`)
+		require.NoError(t, err)
+		assert.Equal(t, `<p>Hello</p>`, body)
+	})
+}
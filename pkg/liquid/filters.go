@@ -0,0 +1,555 @@
+package liquid
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Notifuse/liquidgo/liquid"
+	"github.com/Notifuse/liquidgo/liquid/tags"
+	"github.com/Notifuse/notifuse/pkg/qrcode"
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// TranslationsKey is the template data key holding the translations looked up by the t filter
+const TranslationsKey = "translations"
+
+// DefaultQRCodeSize is the width in pixels of the images made by the qr_code filter
+const DefaultQRCodeSize = 200
+
+// SecretsKey is the template data key holding the workspace secrets the hmac and sign_url
+// filters sign with
+const SecretsKey = "template_secrets"
+
+// Secrets are signing keys referenced by name in templates. They render as an empty string and
+// are never serialized, so a template can only use them through the filters.
+type Secrets struct {
+	keys map[string]string
+}
+
+// NewSecrets wraps signing keys by name
+func NewSecrets(keys map[string]string) *Secrets {
+	return &Secrets{keys: keys}
+}
+
+// String hides the keys when the secrets are rendered
+func (s *Secrets) String() string {
+	return ""
+}
+
+// MarshalJSON keeps the keys out of the template data stored with the messages
+func (s *Secrets) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// lookup returns the key of a secret
+func (s *Secrets) lookup(name string) (string, bool) {
+	if s == nil {
+		return "", false
+	}
+	key, ok := s.keys[name]
+	return key, ok && key != ""
+}
+
+// TemplateFilters are the filters email, subject and blog templates get on top of the
+// standard Liquid filters. A set is bound to the data of each render, from which it reads
+// the contact's language and timezone, the translations and the secrets.
+//
+// Only filter methods are exported: liquidgo exposes every exported method as a filter.
+type TemplateFilters struct {
+	language     string
+	location     *time.Location
+	translations interface{}
+	secrets      *Secrets
+}
+
+// NewTemplateFilters binds the template filters to the data of a render
+func NewTemplateFilters(data map[string]interface{}) *TemplateFilters {
+	f := &TemplateFilters{translations: lookupPath(data, TranslationsKey)}
+	f.secrets, _ = lookupPath(data, SecretsKey).(*Secrets)
+	if lang, ok := lookupPath(data, "contact.language").(string); ok {
+		f.language = lang
+	}
+	if tz, ok := lookupPath(data, "contact.timezone").(string); ok && tz != "" {
+		if location, err := time.LoadLocation(tz); err == nil {
+			f.location = location
+		}
+	}
+	return f
+}
+
+// NewEnvironment creates a liquidgo environment with the standard tags and the template filters
+// bound to data. liquidgo caches strainers by filter type and drops the instances on a cache hit,
+// so filters carrying per-render state need a fresh environment for every render.
+func NewEnvironment(data map[string]interface{}) *liquid.Environment {
+	env := liquid.NewEnvironment()
+	tags.RegisterStandardTags(env)
+	_ = env.RegisterFilter(NewTemplateFilters(data))
+	return env
+}
+
+// Money formats an amount in a currency for the contact's language, or the locale given as
+// second argument: {{ order.total | money: "EUR" }} renders "1 234,50 €" for a French contact
+func (f *TemplateFilters) Money(input interface{}, currencyCode interface{}, locale ...interface{}) interface{} {
+	amount, ok := liquid.ToNumberValue(input)
+	if !ok {
+		return input
+	}
+	unit, err := currency.ParseISO(strings.TrimSpace(liquid.ToS(currencyCode, nil)))
+	if err != nil {
+		return input
+	}
+
+	tag := f.languageTag(locale)
+	printer := message.NewPrinter(tag)
+	scale, _ := currency.Standard.Rounding(unit)
+	// Half away from zero, as currencies are rounded
+	factor := math.Pow10(scale)
+	amount = math.Round(amount*factor) / factor
+	digits := printer.Sprint(number.Decimal(math.Abs(amount), number.Scale(scale)))
+	symbol := printer.Sprint(currency.Symbol(unit))
+
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+	}
+
+	base, _ := tag.Base()
+	switch {
+	case currencySuffixLanguages[base.String()] && tag.String() != "pt-BR":
+		return sign + digits + " " + symbol
+	case base.String() == "nl" || tag.String() == "pt-BR":
+		return sign + symbol + " " + digits
+	default:
+		return sign + symbol + digits
+	}
+}
+
+// currencySuffixLanguages write the currency symbol after the amount
+var currencySuffixLanguages = map[string]bool{
+	"fr": true, "de": true, "es": true, "it": true, "pt": true, "ca": true, "ru": true, "uk": true,
+	"pl": true, "cs": true, "sk": true, "sv": true, "da": true, "fi": true, "nb": true, "no": true,
+	"hu": true, "ro": true, "bg": true, "hr": true, "sl": true, "sr": true, "lt": true, "lv": true,
+	"et": true, "el": true, "is": true,
+}
+
+// Date formats a date like the standard date filter, converted to the contact's timezone,
+// or the one given as second argument, with day and month names in the contact's language
+func (f *TemplateFilters) Date(input interface{}, format interface{}, timezone ...interface{}) interface{} {
+	formatStr := liquid.ToS(format, nil)
+	if formatStr == "" {
+		return input
+	}
+	date := liquid.ToDate(input)
+	if date == nil {
+		return input
+	}
+
+	location := f.location
+	if len(timezone) > 0 {
+		if name, ok := timezone[0].(string); ok && name != "" {
+			if loc, err := time.LoadLocation(name); err == nil {
+				location = loc
+			}
+		}
+	}
+	// Dates without a time stay on their day
+	if s, ok := input.(string); ok && dateOnlyRegexp.MatchString(strings.TrimSpace(s)) {
+		location = nil
+	}
+	converted := *date
+	if location != nil {
+		converted = converted.In(location)
+	}
+
+	return strftime(converted, formatStr, f.language)
+}
+
+var dateOnlyRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+// strftime formats a date with the strftime directives of the standard date filter, the
+// day and month names in the given language. liquidgo's own implementation goes through Go
+// layouts, which would rewrite names such as "Montag" that look like layout elements.
+// A "-" flag removes the padding of numbers: %-d renders 1 rather than 01.
+func strftime(date time.Time, format string, lang string) string {
+	names, ok := dateNames[baseLanguage(lang)]
+	if !ok {
+		names = englishDateNames
+	}
+
+	var out strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 >= len(format) {
+			out.WriteByte(format[i])
+			continue
+		}
+		directive := format[i+1]
+		padded := true
+		if directive == '-' && i+2 < len(format) {
+			padded = false
+			i++
+			directive = format[i+1]
+		}
+		number := func(value, width int) string {
+			if !padded {
+				return strconv.Itoa(value)
+			}
+			return fmt.Sprintf("%0*d", width, value)
+		}
+		hour12 := date.Hour() % 12
+		if hour12 == 0 {
+			hour12 = 12
+		}
+
+		switch directive {
+		case 'A':
+			out.WriteString(names.days[date.Weekday()])
+		case 'a':
+			out.WriteString(names.shortDays[date.Weekday()])
+		case 'B':
+			out.WriteString(names.months[date.Month()-1])
+		case 'b', 'h':
+			out.WriteString(names.shortMonths[date.Month()-1])
+		case 'd':
+			out.WriteString(number(date.Day(), 2))
+		case 'e':
+			out.WriteString(fmt.Sprintf("%2d", date.Day()))
+		case 'm':
+			out.WriteString(number(int(date.Month()), 2))
+		case 'Y':
+			out.WriteString(strconv.Itoa(date.Year()))
+		case 'y':
+			out.WriteString(number(date.Year()%100, 2))
+		case 'H':
+			out.WriteString(number(date.Hour(), 2))
+		case 'I':
+			out.WriteString(number(hour12, 2))
+		case 'l':
+			out.WriteString(fmt.Sprintf("%2d", hour12))
+		case 'M':
+			out.WriteString(number(date.Minute(), 2))
+		case 'S':
+			out.WriteString(number(date.Second(), 2))
+		case 'p':
+			out.WriteString(date.Format("PM"))
+		case 'P':
+			out.WriteString(date.Format("pm"))
+		case 'j':
+			out.WriteString(number(date.YearDay(), 3))
+		case 'w':
+			out.WriteString(strconv.Itoa(int(date.Weekday())))
+		case 'u':
+			out.WriteString(strconv.Itoa((int(date.Weekday())+6)%7 + 1))
+		case 's':
+			out.WriteString(strconv.FormatInt(date.Unix(), 10))
+		case 'Z':
+			out.WriteString(date.Format("MST"))
+		case 'z':
+			out.WriteString(date.Format("-0700"))
+		case 'F':
+			out.WriteString(date.Format("2006-01-02"))
+		case 'T':
+			out.WriteString(date.Format("15:04:05"))
+		case 'D', 'x':
+			out.WriteString(date.Format("01/02/06"))
+		case 'X':
+			out.WriteString(date.Format("15:04:05"))
+		case '%':
+			out.WriteByte('%')
+		default:
+			out.WriteString(format[i : i+2])
+		}
+		i++
+	}
+	return out.String()
+}
+
+type localizedDateNames struct {
+	days, shortDays     [7]string
+	months, shortMonths [12]string
+}
+
+var englishDateNames = localizedDateNames{
+	days:        [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	shortDays:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	months:      [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	shortMonths: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+}
+
+// dateNames holds the day and month names of the languages other than English
+var dateNames = map[string]localizedDateNames{
+	"fr": {
+		days:        [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		shortDays:   [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		months:      [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		shortMonths: [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+	},
+	"de": {
+		days:        [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		shortDays:   [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		months:      [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		shortMonths: [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+	},
+	"es": {
+		days:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		shortDays:   [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		months:      [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		shortMonths: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+	},
+	"it": {
+		days:        [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		shortDays:   [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+		months:      [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		shortMonths: [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+	},
+	"pt": {
+		days:        [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		shortDays:   [7]string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."},
+		months:      [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		shortMonths: [12]string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."},
+	},
+	"nl": {
+		days:        [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+		shortDays:   [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+		months:      [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		shortMonths: [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+	},
+}
+
+// Pluralize returns the singular or plural word for a count: {{ cart.count | pluralize: "item", "items" }}.
+// French and Portuguese use the singular for zero.
+func (f *TemplateFilters) Pluralize(input interface{}, singular interface{}, plural interface{}) interface{} {
+	count, ok := liquid.ToNumberValue(input)
+	if !ok {
+		return liquid.ToS(plural, nil)
+	}
+	if f.isSingular(count) {
+		return liquid.ToS(singular, nil)
+	}
+	return liquid.ToS(plural, nil)
+}
+
+func (f *TemplateFilters) isSingular(count float64) bool {
+	count = math.Abs(count)
+	switch baseLanguage(f.language) {
+	case "fr", "pt":
+		return count < 2
+	default:
+		return count == 1
+	}
+}
+
+// T looks up a key, with dots separating nested keys, in the translations of the template
+// data: {{ "cart.title" | t }}. Arguments come in name and value pairs replacing the
+// {{ name }} placeholders of the translation, and a count picks between its "one" and
+// "other" forms: {{ "cart.items" | t: "count", cart.size }}. Missing keys render as is.
+func (f *TemplateFilters) T(input interface{}, args ...interface{}) interface{} {
+	key := liquid.ToS(input, nil)
+	value := lookupPath(f.translations, key)
+	if value == nil {
+		return key
+	}
+
+	variables := argumentPairs(args)
+	if forms, ok := toStringMap(value); ok {
+		form := "other"
+		if count, ok := liquid.ToNumberValue(variables["count"]); ok {
+			if count == 0 && forms["zero"] != nil {
+				form = "zero"
+			} else if f.isSingular(count) {
+				form = "one"
+			}
+		}
+		value = forms[form]
+		if value == nil {
+			return key
+		}
+	}
+
+	text := liquid.ToS(value, nil)
+	return translationPlaceholderRegexp.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name := translationPlaceholderRegexp.FindStringSubmatch(placeholder)[1]
+		if v, ok := variables[name]; ok {
+			return liquid.ToS(v, nil)
+		}
+		return placeholder
+	})
+}
+
+var translationPlaceholderRegexp = regexp.MustCompile(`\{\{\s*([\w.]+)\s*\}\}`)
+
+// DefaultByLanguage picks the value given for the contact's language, falling back to the
+// input. Arguments come in language and value pairs:
+// {{ "Hello" | default_by_language: "fr", "Bonjour", "de", "Hallo" }}
+func (f *TemplateFilters) DefaultByLanguage(input interface{}, args ...interface{}) interface{} {
+	if f.language == "" {
+		return input
+	}
+	byLanguage := argumentPairs(args)
+	for _, candidate := range []string{f.language, strings.ReplaceAll(f.language, "_", "-"), baseLanguage(f.language)} {
+		if value, ok := byLanguage[candidate]; ok && value != nil {
+			return value
+		}
+	}
+	return input
+}
+
+// argumentPairs reads filter arguments given as name and value pairs. liquidgo does not pass
+// keyword arguments to filters, so they are written as positional ones.
+func argumentPairs(args []interface{}) map[string]interface{} {
+	pairs := map[string]interface{}{}
+	for i := 0; i+1 < len(args); i += 2 {
+		pairs[liquid.ToS(args[i], nil)] = args[i+1]
+	}
+	return pairs
+}
+
+// HMAC returns the hexadecimal HMAC of the input with a workspace secret named by the first
+// argument, using SHA-256 or the algorithm given as second argument (sha1, sha256 or sha512):
+// {{ contact.email | hmac: "app_key" }}. It returns an empty string when the secret is unknown.
+func (f *TemplateFilters) HMAC(input interface{}, secretName interface{}, algorithm ...interface{}) interface{} {
+	key, ok := f.secrets.lookup(liquid.ToS(secretName, nil))
+	if !ok {
+		return ""
+	}
+	newHash := sha256.New
+	if len(algorithm) > 0 {
+		switch strings.ToLower(liquid.ToS(algorithm[0], nil)) {
+		case "sha1":
+			newHash = sha1.New
+		case "sha512":
+			newHash = sha512.New
+		}
+	}
+	return computeHMAC(newHash, key, liquid.ToS(input, nil))
+}
+
+// SignURL adds a signature query parameter to a URL, the hexadecimal HMAC-SHA256 of the URL
+// with a workspace secret named by the first argument, so the application it points to can
+// trust its parameters. When a lifetime in seconds is given, an expires parameter with the Unix
+// time it ends is added before signing:
+// {{ "https://app.example.com/verify?user=42" | sign_url: "app_key", 86400 }}
+// The URL is returned unsigned when the secret is unknown.
+func (f *TemplateFilters) SignURL(input interface{}, secretName interface{}, expiresIn ...interface{}) interface{} {
+	rawURL := liquid.ToS(input, nil)
+	if rawURL == "" {
+		return input
+	}
+	key, ok := f.secrets.lookup(liquid.ToS(secretName, nil))
+	if !ok {
+		return input
+	}
+
+	fragment := ""
+	if i := strings.Index(rawURL, "#"); i >= 0 {
+		rawURL, fragment = rawURL[:i], rawURL[i:]
+	}
+	separator := func() string {
+		if strings.Contains(rawURL, "?") {
+			return "&"
+		}
+		return "?"
+	}
+
+	if len(expiresIn) > 0 {
+		if seconds, ok := liquid.ToNumberValue(expiresIn[0]); ok && seconds > 0 {
+			expires := time.Now().Add(time.Duration(seconds) * time.Second).Unix()
+			rawURL += separator() + "expires=" + strconv.FormatInt(expires, 10)
+		}
+	}
+
+	signature := computeHMAC(sha256.New, key, rawURL)
+	return rawURL + separator() + "signature=" + signature + fragment
+}
+
+func computeHMAC(newHash func() hash.Hash, key, message string) string {
+	mac := hmac.New(newHash, []byte(key))
+	mac.Write([]byte(message))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// QRCode encodes the input in a QR code returned as a PNG data URI, about 200 pixels wide or
+// the size given as argument: <img src="{{ ticket.url | qr_code }}" alt="Ticket">
+func (f *TemplateFilters) QRCode(input interface{}, size ...interface{}) interface{} {
+	content := liquid.ToS(input, nil)
+	if content == "" {
+		return ""
+	}
+	pixels := DefaultQRCodeSize
+	if len(size) > 0 {
+		if value, ok := liquid.ToNumberValue(size[0]); ok && value > 0 {
+			pixels = int(math.Min(value, 1000))
+		}
+	}
+	uri, err := qrcode.DataURI(content, pixels)
+	if err != nil {
+		return ""
+	}
+	return uri
+}
+
+// languageTag returns the locale given as filter argument, or the contact's language
+func (f *TemplateFilters) languageTag(locale []interface{}) language.Tag {
+	lang := f.language
+	if len(locale) > 0 {
+		if value, ok := locale[0].(string); ok && value != "" {
+			lang = value
+		}
+	}
+	tag, err := language.Parse(strings.ReplaceAll(lang, "_", "-"))
+	if err != nil || lang == "" {
+		return language.English
+	}
+	return tag
+}
+
+func baseLanguage(lang string) string {
+	lang = strings.ToLower(lang)
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		return lang[:i]
+	}
+	return lang
+}
+
+// lookupPath follows a dotted path through nested maps of any map type
+func lookupPath(data interface{}, path string) interface{} {
+	current := data
+	for _, key := range strings.Split(path, ".") {
+		values, ok := toStringMap(current)
+		if !ok {
+			return nil
+		}
+		current = values[key]
+	}
+	return current
+}
+
+// toStringMap converts maps with string keys, including named map types, to map[string]interface{}
+func toStringMap(value interface{}) (map[string]interface{}, bool) {
+	if m, ok := value.(map[string]interface{}); ok {
+		return m, true
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+	result := make(map[string]interface{}, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		result[iter.Key().String()] = iter.Value().Interface()
+	}
+	return result, true
+}
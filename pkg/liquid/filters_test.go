@@ -0,0 +1,145 @@
+package liquid
+
+import (
+	"crypto/sha256"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// contactMap is a named map type like domain.MapOfAny
+type contactMap map[string]interface{}
+
+func renderWithFilters(t *testing.T, template string, data map[string]interface{}) string {
+	t.Helper()
+	out, err := RenderBlogTemplateGo(template, data, nil)
+	require.NoError(t, err)
+	return out
+}
+
+func TestTemplateFilters_Money(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		language string
+		expected string
+	}{
+		{"english by default", `{{ 1234.5 | money: "USD" }}`, "", "$1,234.50"},
+		{"contact language", `{{ 1234.5 | money: "EUR" }}`, "fr", "1\u00a0234,50\u00a0€"},
+		{"german", `{{ 1234.5 | money: "EUR" }}`, "de", "1.234,50\u00a0€"},
+		{"explicit locale", `{{ 1234.5 | money: "EUR", "nl" }}`, "fr", "€\u00a01.234,50"},
+		{"brazilian portuguese", `{{ 10 | money: "BRL" }}`, "pt-BR", "R$\u00a010,00"},
+		{"currency without decimals", `{{ 1234.5 | money: "JPY" }}`, "en", "¥1,235"},
+		{"negative amount", `{{ -5 | money: "USD" }}`, "en", "-$5.00"},
+		{"numeric string", `{{ "19.9" | money: "GBP" }}`, "en", "£19.90"},
+		{"unknown currency", `{{ 12 | money: "XXXX" }}`, "en", "12"},
+		{"not a number", `{{ "abc" | money: "USD" }}`, "en", "abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := map[string]interface{}{"contact": contactMap{"language": tt.language}}
+			assert.Equal(t, tt.expected, renderWithFilters(t, tt.template, data))
+		})
+	}
+}
+
+func TestTemplateFilters_Date(t *testing.T) {
+	data := map[string]interface{}{
+		"contact":  contactMap{"language": "fr", "timezone": "Europe/Paris"},
+		"order_at": "2026-03-01T23:30:00Z",
+	}
+
+	// Converted to the contact's timezone, with French names
+	assert.Equal(t, "lundi 2 mars 2026 00:30", renderWithFilters(t, `{{ order_at | date: "%A %-d %B %Y %H:%M" }}`, data))
+	// Explicit timezone
+	assert.Equal(t, "2026-03-01 18:30", renderWithFilters(t, `{{ order_at | date: "%Y-%m-%d %H:%M", "America/New_York" }}`, data))
+	// Names looking like Go layout elements are kept
+	assert.Equal(t, "Montag, 2. März", renderWithFilters(t, `{{ order_at | date: "%A, %-d. %B" }}`,
+		map[string]interface{}{"contact": contactMap{"language": "de", "timezone": "Europe/Berlin"}, "order_at": "2026-03-01T23:30:00Z"}))
+	// Dates without a time are not shifted
+	assert.Equal(t, "1 mars", renderWithFilters(t, `{{ "2026-03-01" | date: "%-d %B" }}`, data))
+	// Without contact settings it behaves like the standard filter
+	assert.Equal(t, "Sunday March 1, 23:30", renderWithFilters(t, `{{ order_at | date: "%A %B %-d, %H:%M" }}`, map[string]interface{}{"order_at": "2026-03-01T23:30:00Z"}))
+	assert.Equal(t, "not a date", renderWithFilters(t, `{{ "not a date" | date: "%Y" }}`, nil))
+}
+
+func TestTemplateFilters_Pluralize(t *testing.T) {
+	assert.Equal(t, "1 item, 2 items, 0 items", renderWithFilters(t,
+		`{% for n in (1..2) %}{{ n }} {{ n | pluralize: "item", "items" }}, {% endfor %}0 {{ 0 | pluralize: "item", "items" }}`, nil))
+	assert.Equal(t, "article", renderWithFilters(t, `{{ 0 | pluralize: "article", "articles" }}`,
+		map[string]interface{}{"contact": contactMap{"language": "fr"}}))
+}
+
+func TestTemplateFilters_T(t *testing.T) {
+	data := map[string]interface{}{
+		"contact": contactMap{"first_name": "Ada"},
+		TranslationsKey: map[string]interface{}{
+			"welcome": "Welcome {{ name }}!",
+			"cart": contactMap{
+				"title": "Your cart",
+				"items": map[string]interface{}{"one": "{{ count }} item", "other": "{{ count }} items", "zero": "No items"},
+			},
+		},
+	}
+
+	assert.Equal(t, "Welcome Ada!", renderWithFilters(t, `{{ "welcome" | t: "name", contact.first_name }}`, data))
+	assert.Equal(t, "Your cart", renderWithFilters(t, `{{ "cart.title" | t }}`, data))
+	assert.Equal(t, "1 item|3 items|No items", renderWithFilters(t,
+		`{{ "cart.items" | t: "count", 1 }}|{{ "cart.items" | t: "count", 3 }}|{{ "cart.items" | t: "count", 0 }}`, data))
+	assert.Equal(t, "cart.missing", renderWithFilters(t, `{{ "cart.missing" | t }}`, data))
+	assert.Equal(t, "welcome", renderWithFilters(t, `{{ "welcome" | t }}`, nil))
+}
+
+func TestTemplateFilters_DefaultByLanguage(t *testing.T) {
+	template := `{{ "Hello" | default_by_language: "fr", "Bonjour", "de", "Hallo" }}`
+
+	assert.Equal(t, "Bonjour", renderWithFilters(t, template, map[string]interface{}{"contact": contactMap{"language": "fr-CA"}}))
+	assert.Equal(t, "Hallo", renderWithFilters(t, template, map[string]interface{}{"contact": contactMap{"language": "de"}}))
+	assert.Equal(t, "Hello", renderWithFilters(t, template, map[string]interface{}{"contact": contactMap{"language": "es"}}))
+	assert.Equal(t, "Hello", renderWithFilters(t, template, nil))
+}
+
+func TestTemplateFilters_HMACAndSignURL(t *testing.T) {
+	data := map[string]interface{}{SecretsKey: NewSecrets(map[string]string{"app_key": "secret"})}
+
+	assert.Equal(t, computeHMAC(sha256.New, "secret", "ada@example.com"),
+		renderWithFilters(t, `{{ "ada@example.com" | hmac: "app_key" }}`, data))
+	assert.Len(t, renderWithFilters(t, `{{ "ada@example.com" | hmac: "app_key", "sha512" }}`, data), 128)
+
+	signed := renderWithFilters(t, `{{ "https://app.example.com/verify?user=42#top" | sign_url: "app_key" }}`, data)
+	expected := "https://app.example.com/verify?user=42&signature=" +
+		computeHMAC(sha256.New, "secret", "https://app.example.com/verify?user=42") + "#top"
+	assert.Equal(t, expected, signed)
+
+	expiring := renderWithFilters(t, `{{ "https://app.example.com/verify" | sign_url: "app_key", 3600 }}`, data)
+	assert.Regexp(t, `^https://app\.example\.com/verify\?expires=\d+&signature=[0-9a-f]{64}$`, expiring)
+	unsigned := expiring[:strings.Index(expiring, "&signature=")]
+	assert.Equal(t, unsigned+"&signature="+computeHMAC(sha256.New, "secret", unsigned), expiring)
+
+	// A key written in the template is not a secret name
+	assert.Equal(t, "", renderWithFilters(t, `{{ "ada@example.com" | hmac: "secret" }}`, data))
+	assert.Equal(t, "https://app.example.com/verify",
+		renderWithFilters(t, `{{ "https://app.example.com/verify" | sign_url: "unknown" }}`, data))
+	assert.Equal(t, "", renderWithFilters(t, `{{ "ada@example.com" | hmac: "app_key" }}`, nil))
+}
+
+func TestTemplateFilters_SecretsAreNotRendered(t *testing.T) {
+	data := map[string]interface{}{SecretsKey: NewSecrets(map[string]string{"app_key": "secret"})}
+
+	out := renderWithFilters(t, `[{{ template_secrets }}][{{ template_secrets.app_key }}][{{ template_secrets.keys }}][{{ template_secrets | json }}]`, data)
+	assert.NotContains(t, out, "secret")
+	assert.NotContains(t, out, "app_key")
+}
+
+func TestTemplateFilters_QRCode(t *testing.T) {
+	out := renderWithFilters(t, `<img src="{{ "https://example.com/ticket/42" | qr_code: 150 }}">`, nil)
+	assert.True(t, strings.HasPrefix(out, `<img src="data:image/png;base64,`))
+	assert.Equal(t, "", renderWithFilters(t, `{{ "" | qr_code }}`, nil))
+}
+
+func TestTemplateFilters_StandardFiltersStillWork(t *testing.T) {
+	assert.Equal(t, "HELLO 2026", renderWithFilters(t, `{{ "hello" | upcase }} {{ "2026-01-01" | date: "%Y" }}`, nil))
+}
@@ -0,0 +1,558 @@
+package notifuse_mjml
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// AccessibilitySeverity tells whether an issue fails the accessibility report
+type AccessibilitySeverity string
+
+const (
+	AccessibilitySeverityError   AccessibilitySeverity = "error"
+	AccessibilitySeverityWarning AccessibilitySeverity = "warning"
+)
+
+// Accessibility rules
+const (
+	AccessibilityRuleColorContrast = "color_contrast"
+	AccessibilityRuleHeadingOrder  = "heading_order"
+	AccessibilityRuleMissingLang   = "missing_lang"
+	AccessibilityRuleMissingAlt    = "missing_alt"
+	AccessibilityRuleLinkText      = "link_text"
+	AccessibilityRuleFontSize      = "font_size"
+)
+
+const (
+	// WCAG 2.1 AA minimum contrast ratios for normal and large text
+	accessibilityMinContrast      = 4.5
+	accessibilityMinContrastLarge = 3.0
+	// Text is large from 24px, or from 18.66px (14pt) when bold
+	accessibilityLargeFontSize     = 24.0
+	accessibilityLargeBoldFontSize = 18.66
+	// iOS Mail enlarges text below 13px, a sign it is too small to read comfortably
+	accessibilityMinFontSize = 13.0
+)
+
+// AccessibilityIssue is a problem found in a block of the visual editor tree
+type AccessibilityIssue struct {
+	Rule     string                `json:"rule"`
+	Severity AccessibilitySeverity `json:"severity"`
+	BlockID  string                `json:"block_id,omitempty"`
+	Message  string                `json:"message"`
+}
+
+// AccessibilityReport lists the accessibility issues of an email. It passes when no issue is an error.
+type AccessibilityReport struct {
+	Passed bool                 `json:"passed"`
+	Issues []AccessibilityIssue `json:"issues"`
+}
+
+// genericLinkTexts do not describe the destination of a link when read out of context
+var genericLinkTexts = map[string]bool{
+	"click here": true, "click": true, "here": true, "read more": true, "more": true, "learn more": true,
+	"link": true, "this link": true, "go": true, "this": true, "click this": true, "more info": true,
+}
+
+var namedColors = map[string]string{
+	"black": "#000000", "white": "#ffffff", "red": "#ff0000", "green": "#008000", "blue": "#0000ff",
+	"gray": "#808080", "grey": "#808080", "silver": "#c0c0c0", "yellow": "#ffff00", "orange": "#ffa500",
+	"purple": "#800080", "navy": "#000080", "maroon": "#800000", "teal": "#008080", "olive": "#808000",
+	"lime": "#00ff00", "aqua": "#00ffff", "cyan": "#00ffff", "fuchsia": "#ff00ff", "magenta": "#ff00ff",
+	"lightgray": "#d3d3d3", "lightgrey": "#d3d3d3", "darkgray": "#a9a9a9", "darkgrey": "#a9a9a9",
+}
+
+var (
+	rgbColorRegexp  = regexp.MustCompile(`^rgba?\(\s*(\d{1,3})\s*,\s*(\d{1,3})\s*,\s*(\d{1,3})\s*(?:,\s*([\d.]+)\s*)?\)$`)
+	pxSizeRegexp    = regexp.MustCompile(`^([\d.]+)px$`)
+	emSizeRegexp    = regexp.MustCompile(`^([\d.]+)(em|rem|%)$`)
+	imageFileRegexp = regexp.MustCompile(`(?i)^[\w-]+\.(png|jpe?g|gif|webp|svg)$`)
+)
+
+// headingSizes are the default font sizes of headings relative to their block
+var headingSizes = map[string]float64{"h1": 2, "h2": 1.5, "h3": 1.17, "h4": 1, "h5": 0.83, "h6": 0.67}
+
+// textStyle is the style inherited by the content of a block
+type textStyle struct {
+	color      string
+	background string
+	fontSize   float64
+	bold       bool
+}
+
+type accessibilityChecker struct {
+	report   *AccessibilityReport
+	defaults map[string]map[string]interface{}
+	// headingLevel is the level of the last heading, the subject acting as the level 1 title
+	headingLevel int
+	seen         map[string]bool
+}
+
+// CheckAccessibility audits a visual editor tree: colour contrast of text against its
+// background, heading order, the lang attribute, image alt text, link text and minimum
+// font sizes. Each issue carries the ID of the block it was found in so the editor can
+// highlight it.
+func CheckAccessibility(tree EmailBlock) *AccessibilityReport {
+	c := &accessibilityChecker{
+		report:       &AccessibilityReport{Issues: []AccessibilityIssue{}},
+		defaults:     map[string]map[string]interface{}{},
+		headingLevel: 1,
+		seen:         map[string]bool{},
+	}
+	if tree == nil {
+		c.report.Passed = true
+		return c.report
+	}
+
+	if tree.GetType() == MJMLComponentMjml {
+		if lang, _ := tree.GetAttributes()["lang"].(string); strings.TrimSpace(lang) == "" {
+			c.add(AccessibilityRuleMissingLang, AccessibilitySeverityError, tree.GetID(),
+				"The email has no lang attribute, screen readers cannot pick the right pronunciation")
+		}
+		for _, child := range tree.GetChildren() {
+			if child != nil && child.GetType() == MJMLComponentMjHead {
+				c.collectDefaults(child)
+			}
+		}
+	}
+
+	c.checkBlock(tree, "#ffffff")
+
+	c.report.Passed = true
+	for _, issue := range c.report.Issues {
+		if issue.Severity == AccessibilitySeverityError {
+			c.report.Passed = false
+		}
+	}
+	return c.report
+}
+
+func (c *accessibilityChecker) add(rule string, severity AccessibilitySeverity, blockID, message string) {
+	key := rule + "|" + blockID + "|" + message
+	if c.seen[key] {
+		return
+	}
+	c.seen[key] = true
+	c.report.Issues = append(c.report.Issues, AccessibilityIssue{
+		Rule:     rule,
+		Severity: severity,
+		BlockID:  blockID,
+		Message:  message,
+	})
+}
+
+// collectDefaults reads the attributes that mj-attributes gives to every component of a type
+func (c *accessibilityChecker) collectDefaults(head EmailBlock) {
+	for _, child := range head.GetChildren() {
+		if child == nil || child.GetType() != MJMLComponentMjAttributes {
+			continue
+		}
+		for _, element := range child.GetChildren() {
+			if element == nil {
+				continue
+			}
+			componentType := string(element.GetType())
+			if c.defaults[componentType] == nil {
+				c.defaults[componentType] = map[string]interface{}{}
+			}
+			for key, value := range element.GetAttributes() {
+				c.defaults[componentType][key] = value
+			}
+		}
+	}
+}
+
+// attribute resolves an attribute of a block from its own attributes, then mj-attributes
+func (c *accessibilityChecker) attribute(block EmailBlock, key string) string {
+	if value, ok := block.GetAttributes()[key].(string); ok && strings.TrimSpace(value) != "" {
+		return strings.TrimSpace(value)
+	}
+	for _, componentType := range []string{string(block.GetType()), string(MJMLComponentMjAll)} {
+		if value, ok := c.defaults[componentType][key].(string); ok && strings.TrimSpace(value) != "" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+// checkBlock walks the tree, keeping track of the background color text is drawn on
+func (c *accessibilityChecker) checkBlock(block EmailBlock, background string) {
+	if block == nil {
+		return
+	}
+
+	switch block.GetType() {
+	case MJMLComponentMjHead:
+		return
+	case MJMLComponentMjBody, MJMLComponentMjWrapper, MJMLComponentMjSection, MJMLComponentMjColumn, MJMLComponentMjGroup:
+		background = c.background(block, "backgroundColor", background)
+	case MJMLComponentMjText:
+		c.checkText(block, c.background(block, "containerBackgroundColor", background))
+	case MJMLComponentMjButton:
+		c.checkButton(block)
+	case MJMLComponentMjImage:
+		c.checkImage(block)
+	case MJMLComponentMjRaw:
+		if content := block.GetContent(); content != nil {
+			c.checkContent(block.GetID(), *content, textStyle{background: background, fontSize: accessibilityMinFontSize})
+		}
+	}
+
+	for _, child := range block.GetChildren() {
+		c.checkBlock(child, background)
+	}
+}
+
+// background returns the background of a block. A background image without a color makes
+// it unknown, and contrast is then not checked.
+func (c *accessibilityChecker) background(block EmailBlock, key, inherited string) string {
+	if color := parseColor(c.attribute(block, key)); color != "" {
+		return color
+	}
+	if c.attribute(block, "backgroundUrl") != "" {
+		return ""
+	}
+	return inherited
+}
+
+func (c *accessibilityChecker) checkText(block EmailBlock, background string) {
+	style := textStyle{
+		color:      parseColor(c.attribute(block, "color")),
+		background: background,
+		fontSize:   13,
+		bold:       isBoldWeight(c.attribute(block, "fontWeight")),
+	}
+	if style.color == "" && c.attribute(block, "color") == "" {
+		style.color = "#000000"
+	}
+	if size, ok := parseFontSize(c.attribute(block, "fontSize"), 16); ok {
+		style.fontSize = size
+	}
+
+	if content := block.GetContent(); content != nil {
+		c.checkContent(block.GetID(), *content, style)
+	}
+}
+
+func (c *accessibilityChecker) checkButton(block EmailBlock) {
+	style := textStyle{
+		color:      parseColor(c.attribute(block, "color")),
+		background: parseColor(c.attribute(block, "backgroundColor")),
+		fontSize:   13,
+		bold:       isBoldWeight(c.attribute(block, "fontWeight")),
+	}
+	if c.attribute(block, "color") == "" {
+		style.color = "#ffffff"
+	}
+	if c.attribute(block, "backgroundColor") == "" {
+		style.background = "#414141"
+	}
+	if size, ok := parseFontSize(c.attribute(block, "fontSize"), 16); ok {
+		style.fontSize = size
+	}
+
+	text := ""
+	if content := block.GetContent(); content != nil {
+		text = visibleText(*content)
+	}
+	if text != "" {
+		c.checkContrast(block.GetID(), style, text)
+		c.checkFontSize(block.GetID(), style.fontSize)
+	}
+	c.checkLinkText(block.GetID(), text)
+}
+
+func (c *accessibilityChecker) checkImage(block EmailBlock) {
+	alt, hasAlt := block.GetAttributes()["alt"].(string)
+	alt = strings.TrimSpace(alt)
+	linked := c.attribute(block, "href") != ""
+
+	switch {
+	case !hasAlt || (alt == "" && linked):
+		message := "Image has no alt text, add a description or an empty alt text if it is decorative"
+		if linked {
+			message = "Linked image has no alt text, screen readers cannot tell where the link goes"
+		}
+		c.add(AccessibilityRuleMissingAlt, AccessibilitySeverityError, block.GetID(), message)
+	case alt == "":
+		c.add(AccessibilityRuleMissingAlt, AccessibilitySeverityWarning, block.GetID(),
+			"Image has an empty alt text and is skipped by screen readers as decorative")
+	case imageFileRegexp.MatchString(alt):
+		c.add(AccessibilityRuleMissingAlt, AccessibilitySeverityWarning, block.GetID(),
+			fmt.Sprintf("Alt text %q is a file name, describe the image instead", alt))
+	}
+}
+
+// checkContent checks the HTML of a block: inline colors and sizes, headings, images and links
+func (c *accessibilityChecker) checkContent(blockID, content string, style textStyle) {
+	nodes, err := html.ParseFragment(strings.NewReader(content), &html.Node{Type: html.ElementNode, Data: "div", DataAtom: atom.Div})
+	if err != nil {
+		return
+	}
+	for _, n := range nodes {
+		c.checkNode(blockID, n, style)
+	}
+}
+
+func (c *accessibilityChecker) checkNode(blockID string, n *html.Node, style textStyle) {
+	switch n.Type {
+	case html.TextNode:
+		text := strings.TrimSpace(n.Data)
+		if text == "" || strings.HasPrefix(text, "{{") || strings.HasPrefix(text, "{%") {
+			return
+		}
+		c.checkContrast(blockID, style, text)
+		c.checkFontSize(blockID, style.fontSize)
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+
+	switch n.Data {
+	case "style", "script":
+		return
+	case "b", "strong", "th":
+		style.bold = true
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		level := int(n.Data[1] - '0')
+		if level > c.headingLevel+1 {
+			c.add(AccessibilityRuleHeadingOrder, AccessibilitySeverityWarning, blockID,
+				fmt.Sprintf("Heading %s follows a level %d heading, heading levels should not be skipped", n.Data, c.headingLevel))
+		}
+		c.headingLevel = level
+		style.fontSize *= headingSizes[n.Data]
+		style.bold = true
+	case "img":
+		alt, hasAlt := nodeAttribute(n, "alt")
+		if !hasAlt {
+			c.add(AccessibilityRuleMissingAlt, AccessibilitySeverityError, blockID,
+				fmt.Sprintf("Image %s has no alt attribute", attributeValue(n, "src")))
+		} else if imageFileRegexp.MatchString(strings.TrimSpace(alt)) {
+			c.add(AccessibilityRuleMissingAlt, AccessibilitySeverityWarning, blockID,
+				fmt.Sprintf("Alt text %q is a file name, describe the image instead", strings.TrimSpace(alt)))
+		}
+	case "a":
+		text := strings.Join(strings.Fields(nodeText(n)), " ")
+		if text == "" {
+			text = imagesAltText(n)
+		}
+		c.checkLinkText(blockID, text)
+	}
+
+	if inline := parseInlineStyle(attributeValue(n, "style")); len(inline) > 0 {
+		if color, ok := inline["color"]; ok {
+			style.color = parseColor(color)
+		}
+		if background, ok := inline["background-color"]; ok {
+			if parsed := parseColor(background); parsed != "" {
+				style.background = parsed
+			}
+		} else if background, ok := inline["background"]; ok {
+			style.background = parseColor(background)
+		}
+		if size, ok := parseFontSize(inline["font-size"], style.fontSize); ok {
+			style.fontSize = size
+		}
+		if weight, ok := inline["font-weight"]; ok {
+			style.bold = isBoldWeight(weight)
+		}
+	}
+
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		c.checkNode(blockID, child, style)
+	}
+}
+
+// checkContrast compares the text color to its background. Colors that cannot be resolved,
+// like Liquid placeholders or background images, are not checked.
+func (c *accessibilityChecker) checkContrast(blockID string, style textStyle, text string) {
+	if style.color == "" || style.background == "" {
+		return
+	}
+	ratio := contrastRatio(style.color, style.background)
+	minimum := accessibilityMinContrast
+	if style.fontSize >= accessibilityLargeFontSize || (style.bold && style.fontSize >= accessibilityLargeBoldFontSize) {
+		minimum = accessibilityMinContrastLarge
+	}
+	if ratio < minimum {
+		c.add(AccessibilityRuleColorContrast, AccessibilitySeverityError, blockID,
+			fmt.Sprintf("Text color %s on %s has a contrast ratio of %.2f:1, below the %.1f:1 minimum", style.color, style.background, ratio, minimum))
+	}
+}
+
+func (c *accessibilityChecker) checkFontSize(blockID string, size float64) {
+	if size > 0 && size < accessibilityMinFontSize {
+		c.add(AccessibilityRuleFontSize, AccessibilitySeverityWarning, blockID,
+			fmt.Sprintf("Text is %gpx, below the %gpx minimum for readable text", math.Round(size*100)/100, accessibilityMinFontSize))
+	}
+}
+
+func (c *accessibilityChecker) checkLinkText(blockID, text string) {
+	normalized := strings.ToLower(strings.Trim(strings.Join(strings.Fields(text), " "), " .!?:>»→"))
+	switch {
+	case normalized == "":
+		c.add(AccessibilityRuleLinkText, AccessibilitySeverityError, blockID,
+			"Link has no text, screen readers cannot tell where it goes")
+	case genericLinkTexts[normalized]:
+		c.add(AccessibilityRuleLinkText, AccessibilitySeverityWarning, blockID,
+			fmt.Sprintf("Link text %q does not describe its destination", strings.TrimSpace(text)))
+	}
+}
+
+// parseColor normalizes a CSS color to #rrggbb, or returns an empty string when it is not
+// a solid color
+func parseColor(value string) string {
+	value = strings.ToLower(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(value), "!important")))
+	if named, ok := namedColors[value]; ok {
+		return named
+	}
+	if strings.HasPrefix(value, "#") {
+		hex := value[1:]
+		if len(hex) == 3 {
+			hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+		}
+		if len(hex) != 6 {
+			return ""
+		}
+		if _, err := strconv.ParseUint(hex, 16, 32); err != nil {
+			return ""
+		}
+		return "#" + hex
+	}
+	if m := rgbColorRegexp.FindStringSubmatch(value); m != nil {
+		if m[4] != "" {
+			if alpha, err := strconv.ParseFloat(m[4], 64); err != nil || alpha < 1 {
+				return ""
+			}
+		}
+		var rgb [3]int
+		for i := range rgb {
+			rgb[i], _ = strconv.Atoi(m[i+1])
+			if rgb[i] > 255 {
+				return ""
+			}
+		}
+		return fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2])
+	}
+	return ""
+}
+
+// contrastRatio computes the WCAG contrast ratio of two #rrggbb colors
+func contrastRatio(foreground, background string) float64 {
+	l1, l2 := relativeLuminance(foreground), relativeLuminance(background)
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+func relativeLuminance(color string) float64 {
+	value, _ := strconv.ParseUint(strings.TrimPrefix(color, "#"), 16, 32)
+	channel := func(shift uint) float64 {
+		c := float64((value>>shift)&0xff) / 255
+		if c <= 0.03928 {
+			return c / 12.92
+		}
+		return math.Pow((c+0.055)/1.055, 2.4)
+	}
+	return 0.2126*channel(16) + 0.7152*channel(8) + 0.0722*channel(0)
+}
+
+// parseFontSize parses a font size in px, or relative to the inherited size
+func parseFontSize(value string, inherited float64) (float64, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if m := pxSizeRegexp.FindStringSubmatch(value); m != nil {
+		size, err := strconv.ParseFloat(m[1], 64)
+		return size, err == nil
+	}
+	if m := emSizeRegexp.FindStringSubmatch(value); m != nil {
+		size, err := strconv.ParseFloat(m[1], 64)
+		if err != nil {
+			return 0, false
+		}
+		if m[2] == "%" {
+			size /= 100
+		}
+		return size * inherited, true
+	}
+	return 0, false
+}
+
+func isBoldWeight(weight string) bool {
+	weight = strings.ToLower(strings.TrimSpace(weight))
+	if weight == "bold" || weight == "bolder" {
+		return true
+	}
+	value, err := strconv.Atoi(weight)
+	return err == nil && value >= 700
+}
+
+func parseInlineStyle(style string) map[string]string {
+	declarations := map[string]string{}
+	for _, declaration := range strings.Split(style, ";") {
+		parts := strings.SplitN(declaration, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		declarations[strings.ToLower(strings.TrimSpace(parts[0]))] = strings.TrimSpace(parts[1])
+	}
+	return declarations
+}
+
+func nodeAttribute(n *html.Node, key string) (string, bool) {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val, true
+		}
+	}
+	return "", false
+}
+
+func attributeValue(n *html.Node, key string) string {
+	value, _ := nodeAttribute(n, key)
+	return value
+}
+
+func nodeText(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var text strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		text.WriteString(nodeText(child))
+	}
+	return text.String()
+}
+
+// imagesAltText is the text of a link made of images
+func imagesAltText(n *html.Node) string {
+	if n.Type == html.ElementNode && n.Data == "img" {
+		return strings.TrimSpace(attributeValue(n, "alt"))
+	}
+	var texts []string
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if text := imagesAltText(child); text != "" {
+			texts = append(texts, text)
+		}
+	}
+	return strings.Join(texts, " ")
+}
+
+func visibleText(content string) string {
+	nodes, err := html.ParseFragment(strings.NewReader(content), &html.Node{Type: html.ElementNode, Data: "div", DataAtom: atom.Div})
+	if err != nil {
+		return strings.TrimSpace(content)
+	}
+	var texts []string
+	for _, n := range nodes {
+		texts = append(texts, nodeText(n))
+	}
+	return strings.Join(strings.Fields(strings.Join(texts, " ")), " ")
+}
@@ -0,0 +1,305 @@
+package notifuse_mjml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// accessibilityTestEmail builds an mjml > mj-body > mj-section > mj-column tree holding the blocks
+func accessibilityTestEmail(lang string, blocks ...EmailBlock) (*MJMLBlock, *BaseBlock) {
+	column := NewBaseBlock("column-1", MJMLComponentMjColumn)
+	column.Children = blocks
+	section := NewBaseBlock("section-1", MJMLComponentMjSection)
+	section.Children = []EmailBlock{&MJColumnBlock{BaseBlock: column}}
+	body := NewBaseBlock("body-1", MJMLComponentMjBody)
+	body.Children = []EmailBlock{&MJSectionBlock{BaseBlock: section}}
+	root := NewBaseBlock("root", MJMLComponentMjml)
+	if lang != "" {
+		root.Attributes["lang"] = lang
+	}
+	root.Children = []EmailBlock{&MJBodyBlock{BaseBlock: body}}
+	return &MJMLBlock{BaseBlock: root}, section
+}
+
+func accessibilityTextBlock(id, content string, attributes map[string]interface{}) *MJTextBlock {
+	base := NewBaseBlock(id, MJMLComponentMjText)
+	for key, value := range attributes {
+		base.Attributes[key] = value
+	}
+	base.Content = &content
+	return &MJTextBlock{BaseBlock: base}
+}
+
+func accessibilityIssues(report *AccessibilityReport, rule string) []AccessibilityIssue {
+	var issues []AccessibilityIssue
+	for _, issue := range report.Issues {
+		if issue.Rule == rule {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+func TestCheckAccessibility_AccessibleEmailPasses(t *testing.T) {
+	image := NewBaseBlock("image-1", MJMLComponentMjImage)
+	image.Attributes["src"] = "https://example.com/logo.png"
+	image.Attributes["alt"] = "Acme"
+	button := NewBaseBlock("button-1", MJMLComponentMjButton)
+	buttonText := "View your order"
+	button.Content = &buttonText
+
+	tree, _ := accessibilityTestEmail("en",
+		&MJImageBlock{BaseBlock: image},
+		accessibilityTextBlock("text-1", `<h1>Your order</h1><h2>Items</h2><p>Read <a href="https://example.com/faq">the shipping FAQ</a>.</p>`, nil),
+		&MJButtonBlock{BaseBlock: button},
+	)
+
+	report := CheckAccessibility(tree)
+
+	assert.Empty(t, report.Issues)
+	assert.True(t, report.Passed)
+}
+
+func TestCheckAccessibility_MissingLang(t *testing.T) {
+	tree, _ := accessibilityTestEmail("")
+
+	report := CheckAccessibility(tree)
+
+	issues := accessibilityIssues(report, AccessibilityRuleMissingLang)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "root", issues[0].BlockID)
+	assert.False(t, report.Passed)
+}
+
+func TestCheckAccessibility_ColorContrast(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes map[string]interface{}
+		content    string
+		background string
+		wantIssue  bool
+	}{
+		{
+			name:       "light grey on white",
+			attributes: map[string]interface{}{"color": "#aaaaaa"},
+			content:    "Hello",
+			wantIssue:  true,
+		},
+		{
+			name:       "dark grey on white",
+			attributes: map[string]interface{}{"color": "#595959"},
+			content:    "Hello",
+		},
+		{
+			name:       "large text needs 3:1",
+			attributes: map[string]interface{}{"color": "#888888", "fontSize": "24px"},
+			content:    "Hello",
+		},
+		{
+			name:       "white on section background",
+			attributes: map[string]interface{}{"color": "#ffffff"},
+			content:    "Hello",
+			background: "#ffd700",
+			wantIssue:  true,
+		},
+		{
+			name:       "inline span color",
+			attributes: map[string]interface{}{"color": "#000000"},
+			content:    `Hello <span style="color: rgb(200, 200, 200)">world</span>`,
+			wantIssue:  true,
+		},
+		{
+			name:       "liquid colors are not checked",
+			attributes: map[string]interface{}{"color": "{{ brand.color }}"},
+			content:    "Hello",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, section := accessibilityTestEmail("en", accessibilityTextBlock("text-1", tt.content, tt.attributes))
+			if tt.background != "" {
+				section.Attributes["backgroundColor"] = tt.background
+			}
+
+			issues := accessibilityIssues(CheckAccessibility(tree), AccessibilityRuleColorContrast)
+
+			if !tt.wantIssue {
+				assert.Empty(t, issues)
+				return
+			}
+			require.Len(t, issues, 1)
+			assert.Equal(t, "text-1", issues[0].BlockID)
+			assert.Equal(t, AccessibilitySeverityError, issues[0].Severity)
+		})
+	}
+}
+
+func TestCheckAccessibility_ButtonContrast(t *testing.T) {
+	button := NewBaseBlock("button-1", MJMLComponentMjButton)
+	button.Attributes["backgroundColor"] = "#f5f5f5"
+	text := "Shop the collection"
+	button.Content = &text
+	tree, _ := accessibilityTestEmail("en", &MJButtonBlock{BaseBlock: button})
+
+	issues := accessibilityIssues(CheckAccessibility(tree), AccessibilityRuleColorContrast)
+
+	require.Len(t, issues, 1)
+	assert.Equal(t, "button-1", issues[0].BlockID)
+	assert.Contains(t, issues[0].Message, "#ffffff on #f5f5f5")
+}
+
+func TestCheckAccessibility_MjAttributesDefaults(t *testing.T) {
+	text := accessibilityTextBlock("text-1", "Hello", nil)
+	delete(text.Attributes, "color")
+	tree, _ := accessibilityTestEmail("en", text)
+
+	textDefaults := NewBaseBlock("attr-text", MJMLComponentMjText)
+	textDefaults.Attributes = map[string]interface{}{"color": "#cccccc"}
+	attributes := NewBaseBlock("attributes", MJMLComponentMjAttributes)
+	attributes.Children = []EmailBlock{textDefaults}
+	head := NewBaseBlock("head", MJMLComponentMjHead)
+	head.Children = []EmailBlock{&MJAttributesBlock{BaseBlock: attributes}}
+	tree.Children = append([]EmailBlock{&MJHeadBlock{BaseBlock: head}}, tree.Children...)
+
+	issues := accessibilityIssues(CheckAccessibility(tree), AccessibilityRuleColorContrast)
+
+	require.Len(t, issues, 1)
+	assert.Contains(t, issues[0].Message, "#cccccc")
+}
+
+func TestCheckAccessibility_HeadingOrder(t *testing.T) {
+	tree, _ := accessibilityTestEmail("en",
+		accessibilityTextBlock("text-1", "<h1>Title</h1><h2>Section</h2>", nil),
+		accessibilityTextBlock("text-2", "<h4>Details</h4>", nil),
+	)
+
+	issues := accessibilityIssues(CheckAccessibility(tree), AccessibilityRuleHeadingOrder)
+
+	require.Len(t, issues, 1)
+	assert.Equal(t, "text-2", issues[0].BlockID)
+	assert.Equal(t, AccessibilitySeverityWarning, issues[0].Severity)
+}
+
+func TestCheckAccessibility_Images(t *testing.T) {
+	noAlt := NewBaseBlock("image-1", MJMLComponentMjImage)
+	noAlt.Attributes["src"] = "https://example.com/a.png"
+	decorative := NewBaseBlock("image-2", MJMLComponentMjImage)
+	decorative.Attributes["alt"] = ""
+	linked := NewBaseBlock("image-3", MJMLComponentMjImage)
+	linked.Attributes["alt"] = ""
+	linked.Attributes["href"] = "https://example.com"
+	fileName := NewBaseBlock("image-4", MJMLComponentMjImage)
+	fileName.Attributes["alt"] = "hero-banner.jpg"
+
+	tree, _ := accessibilityTestEmail("en",
+		&MJImageBlock{BaseBlock: noAlt},
+		&MJImageBlock{BaseBlock: decorative},
+		&MJImageBlock{BaseBlock: linked},
+		&MJImageBlock{BaseBlock: fileName},
+		accessibilityTextBlock("text-1", `<img src="https://example.com/b.png">`, nil),
+	)
+
+	issues := accessibilityIssues(CheckAccessibility(tree), AccessibilityRuleMissingAlt)
+
+	severities := map[string]AccessibilitySeverity{}
+	for _, issue := range issues {
+		severities[issue.BlockID] = issue.Severity
+	}
+	assert.Equal(t, map[string]AccessibilitySeverity{
+		"image-1": AccessibilitySeverityError,
+		"image-2": AccessibilitySeverityWarning,
+		"image-3": AccessibilitySeverityError,
+		"image-4": AccessibilitySeverityWarning,
+		"text-1":  AccessibilitySeverityError,
+	}, severities)
+}
+
+func TestCheckAccessibility_LinkText(t *testing.T) {
+	button := NewBaseBlock("button-1", MJMLComponentMjButton)
+	buttonText := "Click here"
+	button.Content = &buttonText
+
+	tree, _ := accessibilityTestEmail("en",
+		accessibilityTextBlock("text-1", `To confirm, <a href="https://example.com">click here</a>.`, nil),
+		accessibilityTextBlock("text-2", `<a href="https://example.com"></a>`, nil),
+		accessibilityTextBlock("text-3", `<a href="https://example.com"><img src="https://example.com/logo.png" alt="Acme home page"></a>`, nil),
+		&MJButtonBlock{BaseBlock: button},
+	)
+
+	issues := accessibilityIssues(CheckAccessibility(tree), AccessibilityRuleLinkText)
+
+	require.Len(t, issues, 3)
+	assert.Equal(t, "text-1", issues[0].BlockID)
+	assert.Equal(t, AccessibilitySeverityWarning, issues[0].Severity)
+	assert.Equal(t, "text-2", issues[1].BlockID)
+	assert.Equal(t, AccessibilitySeverityError, issues[1].Severity)
+	assert.Equal(t, "button-1", issues[2].BlockID)
+}
+
+func TestCheckAccessibility_FontSize(t *testing.T) {
+	tree, _ := accessibilityTestEmail("en",
+		accessibilityTextBlock("text-1", "Small print", map[string]interface{}{"fontSize": "10px"}),
+		accessibilityTextBlock("text-2", `Body <span style="font-size: 0.75em">footnote</span>`, map[string]interface{}{"fontSize": "16px"}),
+		accessibilityTextBlock("text-3", "Readable", map[string]interface{}{"fontSize": "14px"}),
+	)
+
+	issues := accessibilityIssues(CheckAccessibility(tree), AccessibilityRuleFontSize)
+
+	require.Len(t, issues, 2)
+	assert.Equal(t, "text-1", issues[0].BlockID)
+	assert.Equal(t, "text-2", issues[1].BlockID)
+	assert.Contains(t, issues[1].Message, "12px")
+}
+
+func TestParseColor(t *testing.T) {
+	tests := map[string]string{
+		"#FFF":                   "#ffffff",
+		"#1a2B3c":                "#1a2b3c",
+		"rgb(255, 0, 0)":         "#ff0000",
+		"rgba(0,0,0,1)":          "#000000",
+		"rgba(0,0,0,0.5)":        "",
+		"White":                  "#ffffff",
+		"#ff0000 !important":     "#ff0000",
+		"transparent":            "",
+		"{{ brand.color }}":      "",
+		"#12345":                 "",
+		"url(https://x.test/bg)": "",
+	}
+	for value, want := range tests {
+		assert.Equal(t, want, parseColor(value), value)
+	}
+}
+
+func TestContrastRatio(t *testing.T) {
+	assert.InDelta(t, 21.0, contrastRatio("#000000", "#ffffff"), 0.01)
+	assert.InDelta(t, 1.0, contrastRatio("#777777", "#777777"), 0.01)
+	assert.InDelta(t, 4.48, contrastRatio("#777777", "#ffffff"), 0.01)
+}
+
+func TestCompileTemplate_AccessibilityReport(t *testing.T) {
+	tree, _ := accessibilityTestEmail("", accessibilityTextBlock("text-1", "Hello", map[string]interface{}{"color": "#eeeeee"}))
+
+	resp, err := CompileTemplate(CompileTemplateRequest{
+		WorkspaceID:      "ws1",
+		MessageID:        "msg1",
+		VisualEditorTree: tree,
+		Accessibility:    true,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Success)
+	require.NotNil(t, resp.Accessibility)
+	assert.False(t, resp.Accessibility.Passed)
+	assert.Len(t, accessibilityIssues(resp.Accessibility, AccessibilityRuleMissingLang), 1)
+	assert.Len(t, accessibilityIssues(resp.Accessibility, AccessibilityRuleColorContrast), 1)
+
+	resp, err = CompileTemplate(CompileTemplateRequest{
+		WorkspaceID:      "ws1",
+		MessageID:        "msg1",
+		VisualEditorTree: tree,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, resp.Accessibility)
+}
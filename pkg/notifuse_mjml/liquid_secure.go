@@ -6,7 +6,7 @@ import (
 	"time"
 
 	"github.com/Notifuse/liquidgo/liquid"
-	"github.com/Notifuse/liquidgo/liquid/tags"
+	notifuse_liquid "github.com/Notifuse/notifuse/pkg/liquid"
 )
 
 // Security limits for Liquid template rendering
@@ -20,30 +20,21 @@ const (
 type SecureLiquidEngine struct {
 	timeout time.Duration
 	maxSize int
-	env     *liquid.Environment
 }
 
 // NewSecureLiquidEngine creates a new secure liquidgo engine with default settings
 func NewSecureLiquidEngine() *SecureLiquidEngine {
-	env := liquid.NewEnvironment()
-	tags.RegisterStandardTags(env)
-
 	return &SecureLiquidEngine{
 		timeout: DefaultRenderTimeout,
 		maxSize: DefaultMaxTemplateSize,
-		env:     env,
 	}
 }
 
 // NewSecureLiquidEngineWithOptions creates a new secure liquidgo engine with custom settings
 func NewSecureLiquidEngineWithOptions(timeout time.Duration, maxSize int) *SecureLiquidEngine {
-	env := liquid.NewEnvironment()
-	tags.RegisterStandardTags(env)
-
 	return &SecureLiquidEngine{
 		timeout: timeout,
 		maxSize: maxSize,
-		env:     env,
 	}
 }
 
@@ -70,9 +61,9 @@ func (s *SecureLiquidEngine) RenderWithTimeout(content string, data map[string]i
 			}
 		}()
 
-		// Parse the template
+		// Parse the template with the standard tags and the custom filters bound to data
 		tmpl, err := liquid.ParseTemplate(content, &liquid.TemplateOptions{
-			Environment: s.env,
+			Environment: notifuse_liquid.NewEnvironment(data),
 		})
 		if err != nil {
 			errorChan <- fmt.Errorf("liquid parsing failed: %w", err)
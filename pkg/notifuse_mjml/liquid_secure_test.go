@@ -158,6 +158,15 @@ func TestSecureLiquidEngine_NormalTemplatesWork(t *testing.T) {
 			},
 			expected: "<h1>Jane</h1><p>jane@example.com</p>",
 		},
+		{
+			name:     "template filters",
+			template: `{{ total | money: "EUR" }} {{ 2 | pluralize: "article", "articles" }}`,
+			data: map[string]interface{}{
+				"total":   12.5,
+				"contact": map[string]interface{}{"language": "de"},
+			},
+			expected: "12,50 € articles",
+		},
 	}
 
 	for _, tc := range testCases {
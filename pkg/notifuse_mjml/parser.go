@@ -0,0 +1,878 @@
+package notifuse_mjml
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+)
+
+// mjmlEndingTags are the components whose content is markup or text rather than components
+var mjmlEndingTags = map[MJMLComponentType]bool{
+	MJMLComponentMjText:          true,
+	MJMLComponentMjButton:        true,
+	MJMLComponentMjRaw:           true,
+	MJMLComponentMjStyle:         true,
+	MJMLComponentMjTitle:         true,
+	MJMLComponentMjPreview:       true,
+	MJMLComponentMjSocialElement: true,
+}
+
+// mjmlParsedComponents are the components the visual editor tree can hold
+var mjmlParsedComponents = map[MJMLComponentType]bool{
+	MJMLComponentMjml: true, MJMLComponentMjHead: true, MJMLComponentMjBody: true,
+	MJMLComponentMjWrapper: true, MJMLComponentMjSection: true, MJMLComponentMjColumn: true,
+	MJMLComponentMjGroup: true, MJMLComponentMjText: true, MJMLComponentMjButton: true,
+	MJMLComponentMjImage: true, MJMLComponentMjDivider: true, MJMLComponentMjSpacer: true,
+	MJMLComponentMjSocial: true, MJMLComponentMjSocialElement: true, MJMLComponentMjAttributes: true,
+	MJMLComponentMjBreakpoint: true, MJMLComponentMjFont: true, MJMLComponentMjPreview: true,
+	MJMLComponentMjStyle: true, MJMLComponentMjTitle: true, MJMLComponentMjRaw: true,
+	MJMLComponentMjAll: true, MJMLComponentMjClass: true,
+}
+
+var mjmlRootRegexp = regexp.MustCompile(`(?i)<mjml[\s>/]`)
+
+// ParseEmailSource converts MJML source, or an HTML email when the source has no <mjml>
+// root, into a visual editor tree
+func ParseEmailSource(source string) (EmailBlock, error) {
+	if mjmlRootRegexp.MatchString(source) {
+		return ParseMJML(source)
+	}
+	return ParseHTML(source)
+}
+
+// blockFactory creates blocks with IDs unique within a tree
+type blockFactory struct {
+	counts map[MJMLComponentType]int
+}
+
+func newBlockFactory() *blockFactory {
+	return &blockFactory{counts: map[MJMLComponentType]int{}}
+}
+
+func (f *blockFactory) newBlock(componentType MJMLComponentType, attributes map[string]interface{}) EmailBlock {
+	f.counts[componentType]++
+	if attributes == nil {
+		attributes = map[string]interface{}{}
+	}
+	return createTypedBlock(&BaseBlock{
+		ID:         fmt.Sprintf("%s-%d", componentType, f.counts[componentType]),
+		Type:       componentType,
+		Children:   []EmailBlock{},
+		Attributes: attributes,
+	})
+}
+
+func (f *blockFactory) newContentBlock(componentType MJMLComponentType, attributes map[string]interface{}, content string) EmailBlock {
+	block := f.newBlock(componentType, attributes)
+	block.SetContent(&content)
+	return block
+}
+
+func appendChild(parent, child EmailBlock) {
+	parent.SetChildren(append(parent.GetChildren(), child))
+}
+
+// fallbackType picks the block holding markup the tree cannot represent: mj-liquid keeps
+// MJML and Liquid verbatim where it is allowed, mj-raw passes HTML through otherwise
+func fallbackType(parent EmailBlock, isMJML bool) MJMLComponentType {
+	if isMJML && CanDropCheck(MJMLComponentMjLiquid, parent.GetType()) {
+		return MJMLComponentMjLiquid
+	}
+	return MJMLComponentMjRaw
+}
+
+// ParseMJML converts MJML source into a visual editor tree. Attributes are kept, with their
+// names in camelCase and the padding shorthand split into its sides like the editor does.
+// Components the tree cannot hold, like mj-hero or mj-navbar, are kept verbatim in mj-liquid
+// blocks so they still compile, and stray HTML is wrapped in mj-raw blocks.
+func ParseMJML(source string) (EmailBlock, error) {
+	z := html.NewTokenizer(strings.NewReader(source))
+	f := newBlockFactory()
+
+	var root EmailBlock
+	var stack []EmailBlock
+	for {
+		tokenType := z.Next()
+		if tokenType == html.ErrorToken {
+			if z.Err() != io.EOF {
+				return nil, fmt.Errorf("invalid mjml: %w", z.Err())
+			}
+			break
+		}
+		raw := string(z.Raw())
+
+		if root == nil {
+			// Skip the XML declaration, comments and anything else before the root
+			if tokenType == html.StartTagToken {
+				name, _ := z.TagName()
+				if string(name) == string(MJMLComponentMjml) {
+					root = f.newBlock(MJMLComponentMjml, mjmlAttributes(z))
+					stack = append(stack, root)
+				}
+			}
+			continue
+		}
+		if len(stack) == 0 {
+			break
+		}
+		parent := stack[len(stack)-1]
+
+		switch tokenType {
+		case html.TextToken:
+			if text := strings.TrimSpace(raw); text != "" {
+				isLiquid := strings.Contains(text, "{%") || strings.Contains(text, "{{")
+				appendChild(parent, f.newContentBlock(fallbackType(parent, isLiquid), nil, text))
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			tagName := string(name)
+			componentType := MJMLComponentType(tagName)
+			selfClosing := tokenType == html.SelfClosingTagToken
+
+			if !mjmlParsedComponents[componentType] {
+				markup := raw
+				if !selfClosing {
+					inner, closing, err := readUntilClosingTag(z, tagName)
+					if err != nil {
+						return nil, err
+					}
+					markup += inner + closing
+				}
+				appendChild(parent, f.newContentBlock(fallbackType(parent, strings.HasPrefix(tagName, "mj-")), nil, markup))
+				continue
+			}
+
+			block := f.newBlock(componentType, mjmlAttributes(z))
+			appendChild(parent, block)
+			if selfClosing {
+				continue
+			}
+			if mjmlEndingTags[componentType] {
+				inner, _, err := readUntilClosingTag(z, tagName)
+				if err != nil {
+					return nil, err
+				}
+				content := strings.TrimSpace(inner)
+				switch componentType {
+				case MJMLComponentMjText, MJMLComponentMjButton, MJMLComponentMjRaw:
+				default:
+					// The converter escapes the text of the other components
+					content = html.UnescapeString(content)
+				}
+				if content != "" {
+					block.SetContent(&content)
+				}
+				continue
+			}
+			stack = append(stack, block)
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			tagName := string(name)
+			// Leaf components written without their closing tag are closed implicitly
+			found := false
+			for i := len(stack) - 1; i >= 0; i-- {
+				if string(stack[i].GetType()) == tagName {
+					found = true
+					if err := checkClosed(stack[i+1:]); err != nil {
+						return nil, err
+					}
+					stack = stack[:i]
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("invalid mjml: unexpected closing tag </%s>", tagName)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, fmt.Errorf("invalid mjml: missing <mjml> root element")
+	}
+	if err := checkClosed(stack); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// checkClosed returns an error for the first element of the stack that is not a leaf
+// component, the only ones whose closing tag can be left out
+func checkClosed(stack []EmailBlock) error {
+	for i := len(stack) - 1; i >= 0; i-- {
+		if !IsLeafComponent(stack[i].GetType()) {
+			return fmt.Errorf("invalid mjml: unclosed <%s> element", stack[i].GetType())
+		}
+	}
+	return nil
+}
+
+// readUntilClosingTag returns the raw markup up to the tag closing the current element, and that tag
+func readUntilClosingTag(z *html.Tokenizer, tagName string) (string, string, error) {
+	var inner strings.Builder
+	depth := 0
+	for {
+		tokenType := z.Next()
+		if tokenType == html.ErrorToken {
+			if z.Err() == io.EOF {
+				return "", "", fmt.Errorf("invalid mjml: unclosed <%s> element", tagName)
+			}
+			return "", "", fmt.Errorf("invalid mjml: %w", z.Err())
+		}
+		raw := string(z.Raw())
+		if tokenType == html.StartTagToken || tokenType == html.EndTagToken {
+			name, _ := z.TagName()
+			if string(name) == tagName {
+				if tokenType == html.StartTagToken {
+					depth++
+				} else if depth == 0 {
+					return inner.String(), raw, nil
+				} else {
+					depth--
+				}
+			}
+		}
+		inner.WriteString(raw)
+	}
+}
+
+// mjmlAttributes reads the attributes of the current tag as editor attributes
+func mjmlAttributes(z *html.Tokenizer) map[string]interface{} {
+	attributes := map[string]interface{}{}
+	for {
+		key, value, more := z.TagAttr()
+		if len(key) > 0 {
+			setEditorAttribute(attributes, string(key), string(value))
+		}
+		if !more {
+			return attributes
+		}
+	}
+}
+
+// setEditorAttribute stores an MJML attribute under its camelCase name, splitting the
+// padding shorthand into paddingTop, paddingRight, paddingBottom and paddingLeft
+func setEditorAttribute(attributes map[string]interface{}, name, value string) {
+	if name == "padding" {
+		if sides := expandBoxShorthand(value); sides != nil {
+			attributes["paddingTop"] = sides[0]
+			attributes["paddingRight"] = sides[1]
+			attributes["paddingBottom"] = sides[2]
+			attributes["paddingLeft"] = sides[3]
+			return
+		}
+	}
+	attributes[kebabToCamel(name)] = value
+}
+
+// expandBoxShorthand expands a CSS box shorthand of one to four values into top, right, bottom, left
+func expandBoxShorthand(value string) []string {
+	if strings.ContainsAny(value, "{}") {
+		return nil
+	}
+	parts := strings.Fields(value)
+	switch len(parts) {
+	case 1:
+		return []string{parts[0], parts[0], parts[0], parts[0]}
+	case 2:
+		return []string{parts[0], parts[1], parts[0], parts[1]}
+	case 3:
+		return []string{parts[0], parts[1], parts[2], parts[1]}
+	case 4:
+		return parts
+	}
+	return nil
+}
+
+// kebabToCamel converts kebab-case to camelCase, the inverse of camelToKebab
+func kebabToCamel(str string) string {
+	parts := strings.Split(str, "-")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// htmlSkippedElements never reach the tree
+var htmlSkippedElements = map[string]bool{
+	"head": true, "script": true, "style": true, "meta": true, "link": true, "title": true, "base": true, "noscript": true,
+}
+
+// htmlContainerElements hold blocks of content
+var htmlContainerElements = map[string]bool{
+	"div": true, "center": true, "section": true, "article": true, "header": true, "footer": true,
+	"main": true, "aside": true, "nav": true, "td": true, "th": true, "tr": true, "tbody": true,
+	"thead": true, "tfoot": true, "body": true,
+}
+
+// htmlTextElements are kept as they are in the text blocks
+var htmlTextElements = map[string]bool{
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true, "p": true, "ul": true,
+	"ol": true, "dl": true, "blockquote": true, "pre": true, "address": true,
+}
+
+// htmlInlineElements flow in the text around them
+var htmlInlineElements = map[string]bool{
+	"a": true, "span": true, "strong": true, "b": true, "em": true, "i": true, "u": true, "s": true,
+	"strike": true, "small": true, "big": true, "sup": true, "sub": true, "font": true, "code": true,
+	"br": true, "abbr": true, "mark": true, "label": true, "time": true, "q": true, "cite": true,
+	"del": true, "ins": true, "wbr": true,
+}
+
+var buttonClassRegexp = regexp.MustCompile(`(?i)\b(btn|button|cta)`)
+
+// ParseHTML converts an HTML email, such as one exported from another ESP, into a visual
+// editor tree on a best effort basis. Headings, paragraphs and lists become text blocks,
+// images, bulletproof buttons and rules their own blocks, and layout table rows with several
+// cells become multi-column sections. The hidden preheader becomes the preview text, and
+// markup without an equivalent, like forms or videos, is wrapped in mj-raw blocks.
+func ParseHTML(source string) (EmailBlock, error) {
+	doc, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		return nil, fmt.Errorf("invalid html: %w", err)
+	}
+
+	im := &htmlImporter{factory: newBlockFactory()}
+	htmlNode := findElement(doc, "html")
+	head := findElement(doc, "head")
+	body := findElement(doc, "body")
+
+	rootAttributes := map[string]interface{}{}
+	if htmlNode != nil {
+		if lang := attributeValue(htmlNode, "lang"); lang != "" {
+			rootAttributes["lang"] = lang
+		}
+		if dir := attributeValue(htmlNode, "dir"); dir != "" {
+			rootAttributes["dir"] = dir
+		}
+	}
+	root := im.factory.newBlock(MJMLComponentMjml, rootAttributes)
+
+	mjHead := im.factory.newBlock(MJMLComponentMjHead, nil)
+	if head != nil {
+		if title := findElement(head, "title"); title != nil {
+			if text := strings.TrimSpace(nodeText(title)); text != "" {
+				appendChild(mjHead, im.factory.newContentBlock(MJMLComponentMjTitle, nil, text))
+			}
+		}
+	}
+	if body != nil {
+		if preheader := findPreheader(body); preheader != nil {
+			appendChild(mjHead, im.factory.newContentBlock(MJMLComponentMjPreview, nil, strings.Join(strings.Fields(nodeText(preheader)), " ")))
+		}
+	}
+	var styles []string
+	walkElements(doc, func(n *html.Node) {
+		if n.Data == "style" {
+			if css := strings.TrimSpace(nodeText(n)); css != "" {
+				styles = append(styles, css)
+			}
+		}
+	})
+	if len(styles) > 0 {
+		appendChild(mjHead, im.factory.newContentBlock(MJMLComponentMjStyle, nil, strings.Join(styles, "\n")))
+	}
+	if len(mjHead.GetChildren()) > 0 {
+		appendChild(root, mjHead)
+	}
+
+	bodyAttributes := map[string]interface{}{}
+	if body != nil {
+		if background := nodeBackgroundColor(body); background != "" {
+			bodyAttributes["backgroundColor"] = background
+		}
+	}
+	im.body = im.factory.newBlock(MJMLComponentMjBody, bodyAttributes)
+	appendChild(root, im.body)
+
+	im.pending = im.newColumnBuilder()
+	if body != nil {
+		im.walkSections(body)
+	}
+	im.flushPending()
+
+	return root, nil
+}
+
+type htmlImporter struct {
+	factory *blockFactory
+	body    EmailBlock
+	// pending collects the content of the single column section being built
+	pending *columnBuilder
+}
+
+func (im *htmlImporter) newColumnBuilder() *columnBuilder {
+	return &columnBuilder{factory: im.factory}
+}
+
+// walkSections creates a section per layout row with several columns, and a single column
+// section for the content between them
+func (im *htmlImporter) walkSections(n *html.Node) {
+	if !hasMultiColumnRow(n) {
+		im.pending.addContainer(n)
+		return
+	}
+
+	if isLayoutTable(n) {
+		for _, row := range tableRows(n) {
+			cells := contentCells(row)
+			if len(cells) > 1 {
+				im.addColumnsSection(n, row, cells)
+				continue
+			}
+			for _, cell := range cells {
+				im.walkSections(cell)
+			}
+		}
+		return
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && hasMultiColumnRow(c) {
+			im.walkSections(c)
+		} else {
+			im.pending.add(c)
+		}
+	}
+}
+
+func (im *htmlImporter) flushPending() {
+	blocks := im.pending.finish()
+	im.pending = im.newColumnBuilder()
+	if len(blocks) == 0 {
+		return
+	}
+	column := im.factory.newBlock(MJMLComponentMjColumn, nil)
+	column.SetChildren(blocks)
+	section := im.factory.newBlock(MJMLComponentMjSection, nil)
+	appendChild(section, column)
+	appendChild(im.body, section)
+}
+
+func (im *htmlImporter) addColumnsSection(table, row *html.Node, cells []*html.Node) {
+	im.flushPending()
+
+	sectionAttributes := map[string]interface{}{}
+	if background := nodeBackgroundColor(row); background != "" {
+		sectionAttributes["backgroundColor"] = background
+	} else if background := nodeBackgroundColor(table); background != "" {
+		sectionAttributes["backgroundColor"] = background
+	}
+	section := im.factory.newBlock(MJMLComponentMjSection, sectionAttributes)
+
+	for _, cell := range cells {
+		columnAttributes := map[string]interface{}{}
+		if width := htmlWidth(attributeValue(cell, "width"), true); width != "" {
+			columnAttributes["width"] = width
+		}
+		if background := nodeBackgroundColor(cell); background != "" {
+			columnAttributes["backgroundColor"] = background
+		}
+		if align := attributeValue(cell, "valign"); align != "" {
+			columnAttributes["verticalAlign"] = align
+		}
+		column := im.factory.newBlock(MJMLComponentMjColumn, columnAttributes)
+		builder := im.newColumnBuilder()
+		builder.addContainer(cell)
+		column.SetChildren(builder.finish())
+		appendChild(section, column)
+	}
+	appendChild(im.body, section)
+}
+
+// columnBuilder turns HTML into the blocks of a column, merging consecutive text into one block
+type columnBuilder struct {
+	factory *blockFactory
+	blocks  []EmailBlock
+	text    strings.Builder
+}
+
+func (b *columnBuilder) finish() []EmailBlock {
+	b.flushText()
+	return b.blocks
+}
+
+func (b *columnBuilder) flushText() {
+	content := strings.TrimSpace(b.text.String())
+	b.text.Reset()
+	if content == "" || significantText(content) == "" && !strings.Contains(content, "<") {
+		return
+	}
+	b.blocks = append(b.blocks, b.factory.newContentBlock(MJMLComponentMjText, nil, content))
+}
+
+func (b *columnBuilder) addBlock(block EmailBlock) {
+	b.flushText()
+	b.blocks = append(b.blocks, block)
+}
+
+// addContainer adds a block container: its blocks when it has some, a paragraph keeping its
+// style otherwise, or a button when it only holds a button link
+func (b *columnBuilder) addContainer(n *html.Node) {
+	if link := soleLink(n); link != nil && isButtonLink(link, n) {
+		b.addBlock(b.buttonBlock(link, n))
+		return
+	}
+	if hasBlockContent(n) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			b.add(c)
+		}
+		return
+	}
+
+	inner := strings.TrimSpace(renderChildren(n))
+	if significantText(nodeText(n)) == "" && !strings.Contains(inner, "<br") {
+		return
+	}
+	paragraph := "<p"
+	if style := containerStyle(n); style != "" {
+		paragraph += ` style="` + html.EscapeString(style) + `"`
+	}
+	b.text.WriteString(paragraph + ">" + inner + "</p>")
+}
+
+// add adds a node found in a column
+func (b *columnBuilder) add(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		if significantText(n.Data) != "" {
+			b.text.WriteString(html.EscapeString(n.Data))
+		} else if b.text.Len() > 0 {
+			b.text.WriteString(" ")
+		}
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+
+	tag := n.Data
+	if htmlSkippedElements[tag] || isHiddenElement(n) {
+		return
+	}
+
+	switch {
+	case tag == "img":
+		b.addBlock(b.imageBlock(n, ""))
+	case tag == "a" && soleImage(n) != nil:
+		b.addBlock(b.imageBlock(soleImage(n), attributeValue(n, "href")))
+	case tag == "a" && isButtonLink(n, nil):
+		b.addBlock(b.buttonBlock(n, nil))
+	case tag == "hr":
+		b.addBlock(b.factory.newBlock(MJMLComponentMjDivider, map[string]interface{}{
+			"borderWidth": "1px",
+			"borderColor": "#cccccc",
+		}))
+	case tag == "table" && isLayoutTable(n):
+		for _, row := range tableRows(n) {
+			for _, cell := range contentCells(row) {
+				b.addContainer(cell)
+			}
+		}
+	case tag == "table" || htmlTextElements[tag] || (htmlInlineElements[tag] && !hasBlockContent(n)):
+		b.text.WriteString(renderNode(n))
+	case htmlContainerElements[tag] || htmlInlineElements[tag] || tag == "li":
+		b.addContainer(n)
+	default:
+		// Forms, videos, iframes and other markup without an equivalent block
+		b.addBlock(b.factory.newContentBlock(MJMLComponentMjRaw, nil, renderNode(n)))
+	}
+}
+
+func (b *columnBuilder) imageBlock(img *html.Node, href string) EmailBlock {
+	attributes := map[string]interface{}{"src": attributeValue(img, "src")}
+	if alt, ok := nodeAttribute(img, "alt"); ok {
+		attributes["alt"] = alt
+	}
+	if title := attributeValue(img, "title"); title != "" {
+		attributes["title"] = title
+	}
+	width := htmlWidth(attributeValue(img, "width"), false)
+	if width == "" {
+		width = htmlWidth(parseInlineStyle(attributeValue(img, "style"))["width"], false)
+	}
+	if width != "" {
+		attributes["width"] = width
+	}
+	if href != "" {
+		attributes["href"] = href
+	}
+	return b.factory.newBlock(MJMLComponentMjImage, attributes)
+}
+
+// buttonBlock converts a link styled as a button, its colors taken from the link or the
+// cell holding it
+func (b *columnBuilder) buttonBlock(link, container *html.Node) EmailBlock {
+	style := parseInlineStyle(attributeValue(link, "style"))
+	attributes := map[string]interface{}{"href": attributeValue(link, "href")}
+
+	background := nodeBackgroundColor(link)
+	if background == "" && container != nil {
+		background = nodeBackgroundColor(container)
+	}
+	if background != "" {
+		attributes["backgroundColor"] = background
+	}
+	if color := style["color"]; color != "" {
+		attributes["color"] = color
+	}
+	for cssName, attribute := range map[string]string{
+		"font-size": "fontSize", "font-weight": "fontWeight", "font-family": "fontFamily", "border-radius": "borderRadius",
+	} {
+		if value := style[cssName]; value != "" {
+			attributes[attribute] = value
+		} else if container != nil {
+			if value := parseInlineStyle(attributeValue(container, "style"))[cssName]; value != "" {
+				attributes[attribute] = value
+			}
+		}
+	}
+
+	return b.factory.newContentBlock(MJMLComponentMjButton, attributes, strings.TrimSpace(renderChildren(link)))
+}
+
+// isButtonLink tells whether a link is styled as a button: with a background of its own or
+// from the cell it sits alone in, or with a button class
+func isButtonLink(link, container *html.Node) bool {
+	if attributeValue(link, "href") == "" {
+		return false
+	}
+	if nodeBackgroundColor(link) != "" || buttonClassRegexp.MatchString(attributeValue(link, "class")) {
+		return true
+	}
+	return container != nil && container.Data != "body" && nodeBackgroundColor(container) != ""
+}
+
+// soleLink returns the link a container holds alone
+func soleLink(n *html.Node) *html.Node {
+	var link *html.Node
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		switch {
+		case c.Type == html.TextNode && significantText(c.Data) == "":
+		case c.Type == html.CommentNode:
+		case c.Type == html.ElementNode && c.Data == "a" && link == nil:
+			link = c
+		default:
+			return nil
+		}
+	}
+	return link
+}
+
+// soleImage returns the image a link holds alone
+func soleImage(n *html.Node) *html.Node {
+	var img *html.Node
+	var found bool
+	walkElements(n, func(e *html.Node) {
+		if e.Data == "img" {
+			img = e
+			if found {
+				img = nil
+			}
+			found = true
+		}
+	})
+	if img == nil || significantText(nodeText(n)) != "" {
+		return nil
+	}
+	return img
+}
+
+// hasBlockContent tells whether an element holds blocks rather than only inline text
+func hasBlockContent(n *html.Node) bool {
+	found := false
+	walkElements(n, func(e *html.Node) {
+		if e == n || found {
+			return
+		}
+		if e.Data == "img" || e.Data == "hr" || e.Data == "table" || htmlTextElements[e.Data] ||
+			(htmlContainerElements[e.Data] && e.Data != "body") || (!htmlInlineElements[e.Data] && !htmlSkippedElements[e.Data] && e.Data != "li") {
+			found = true
+		}
+	})
+	return found
+}
+
+// hasMultiColumnRow tells whether an element holds a layout table row with several cells
+func hasMultiColumnRow(n *html.Node) bool {
+	found := false
+	walkElements(n, func(e *html.Node) {
+		if found || e.Data != "table" || !isLayoutTable(e) {
+			return
+		}
+		for _, row := range tableRows(e) {
+			if len(contentCells(row)) > 1 {
+				found = true
+				return
+			}
+		}
+	})
+	return found
+}
+
+// isLayoutTable tells layout tables from data tables, which have header cells or a caption
+func isLayoutTable(table *html.Node) bool {
+	if table.Type != html.ElementNode || table.Data != "table" {
+		return false
+	}
+	if attributeValue(table, "role") == "presentation" {
+		return true
+	}
+	for c := table.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && (c.Data == "caption" || c.Data == "thead") {
+			return false
+		}
+	}
+	for _, row := range tableRows(table) {
+		for c := row.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type == html.ElementNode && c.Data == "th" {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func tableRows(table *html.Node) []*html.Node {
+	var rows []*html.Node
+	for c := table.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode {
+			continue
+		}
+		switch c.Data {
+		case "tr":
+			rows = append(rows, c)
+		case "tbody", "thead", "tfoot":
+			for r := c.FirstChild; r != nil; r = r.NextSibling {
+				if r.Type == html.ElementNode && r.Data == "tr" {
+					rows = append(rows, r)
+				}
+			}
+		}
+	}
+	return rows
+}
+
+// contentCells returns the cells of a row with content, leaving out spacer cells
+func contentCells(row *html.Node) []*html.Node {
+	var cells []*html.Node
+	for c := row.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode || (c.Data != "td" && c.Data != "th") || isHiddenElement(c) {
+			continue
+		}
+		hasImage := false
+		walkElements(c, func(e *html.Node) {
+			if e.Data == "img" || e.Data == "hr" || e.Data == "iframe" || e.Data == "video" || e.Data == "form" {
+				hasImage = true
+			}
+		})
+		if hasImage || significantText(nodeText(c)) != "" {
+			cells = append(cells, c)
+		}
+	}
+	return cells
+}
+
+// findPreheader returns the hidden element at the top of the body holding the inbox preview text
+func findPreheader(body *html.Node) *html.Node {
+	var preheader *html.Node
+	walkElements(body, func(e *html.Node) {
+		if preheader == nil && e != body && isHiddenElement(e) && significantText(nodeText(e)) != "" {
+			preheader = e
+		}
+	})
+	return preheader
+}
+
+// containerStyle keeps the text styles of a container for the paragraph replacing it
+func containerStyle(n *html.Node) string {
+	style := parseInlineStyle(attributeValue(n, "style"))
+	var declarations []string
+	for _, property := range []string{"color", "font-family", "font-size", "font-weight", "font-style", "line-height", "text-align", "letter-spacing", "text-transform"} {
+		if value := style[property]; value != "" {
+			declarations = append(declarations, property+": "+value)
+		}
+	}
+	if _, ok := style["text-align"]; !ok {
+		if align := attributeValue(n, "align"); align != "" {
+			declarations = append(declarations, "text-align: "+align)
+		}
+	}
+	return strings.Join(declarations, "; ")
+}
+
+// nodeBackgroundColor returns the background color of an element from its style or bgcolor attribute
+func nodeBackgroundColor(n *html.Node) string {
+	style := parseInlineStyle(attributeValue(n, "style"))
+	for _, property := range []string{"background-color", "background"} {
+		if value := style[property]; value != "" {
+			if color := parseColor(value); color != "" {
+				return color
+			}
+		}
+	}
+	return parseColor(attributeValue(n, "bgcolor"))
+}
+
+// htmlWidth converts a width attribute to MJML: pixels, or a percentage for columns
+func htmlWidth(value string, allowPercent bool) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+	if strings.HasSuffix(value, "%") {
+		if allowPercent {
+			return value
+		}
+		return ""
+	}
+	number := strings.TrimSuffix(value, "px")
+	if _, err := strconv.ParseFloat(number, 64); err != nil {
+		return ""
+	}
+	return number + "px"
+}
+
+func findElement(n *html.Node, tag string) *html.Node {
+	var found *html.Node
+	walkElements(n, func(e *html.Node) {
+		if found == nil && e.Data == tag {
+			found = e
+		}
+	})
+	return found
+}
+
+// walkElements calls visit on n and its element descendants in document order
+func walkElements(n *html.Node, visit func(*html.Node)) {
+	if n.Type == html.ElementNode {
+		visit(n)
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walkElements(c, visit)
+	}
+}
+
+func significantText(text string) string {
+	return strings.TrimFunc(text, func(r rune) bool { return unicode.IsSpace(r) || r == '\u00a0' || r == '\u200c' })
+}
+
+func renderNode(n *html.Node) string {
+	var out strings.Builder
+	_ = html.Render(&out, n)
+	return out.String()
+}
+
+func renderChildren(n *html.Node) string {
+	var out strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		_ = html.Render(&out, c)
+	}
+	return out.String()
+}
@@ -0,0 +1,246 @@
+package notifuse_mjml
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/preslavrachev/gomjml/mjml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const parserTestMJML = `<?xml version="1.0"?>
+<mjml lang="en">
+  <mj-head>
+    <mj-title>Spring &amp; summer</mj-title>
+    <mj-preview>New arrivals</mj-preview>
+    <mj-attributes>
+      <mj-all font-family="Arial" />
+      <mj-text color="#333333" />
+    </mj-attributes>
+    <mj-style>.red { color: red; }</mj-style>
+  </mj-head>
+  <mj-body background-color="#f4f4f4">
+    <!-- Header -->
+    <mj-section padding="10px 20px" background-color="#ffffff">
+      <mj-column width="50%">
+        <mj-image src="https://example.com/logo.png" alt="Acme" width="120px" />
+        <mj-text font-size="16px"><h1>Hello {{ contact.first_name }}</h1><p>Our <b>new</b> collection.</p></mj-text>
+      </mj-column>
+      <mj-column>
+        <mj-button href="https://example.com/shop" background-color="#ff6600">Shop now</mj-button>
+        <mj-divider border-width="1px" />
+        <mj-spacer height="20px" />
+      </mj-column>
+    </mj-section>
+    <mj-section>
+      <mj-column>
+        <mj-social>
+          <mj-social-element name="facebook" href="https://facebook.com/acme">Facebook</mj-social-element>
+        </mj-social>
+        <mj-raw><div class="legal">Acme Inc.</div></mj-raw>
+      </mj-column>
+    </mj-section>
+  </mj-body>
+</mjml>`
+
+// compileForComparison renders MJML to HTML with whitespace collapsed
+func compileForComparison(t *testing.T, source string) string {
+	t.Helper()
+	out, err := mjml.Render(preprocessMjmlForXML(source))
+	require.NoError(t, err)
+	return strings.Join(strings.Fields(out), " ")
+}
+
+func TestParseMJML_RoundTrip(t *testing.T) {
+	tree, err := ParseMJML(parserTestMJML)
+	require.NoError(t, err)
+	require.NoError(t, ValidateComponentHierarchy(tree))
+
+	// The padding shorthand comes back split into its sides, which renders the same
+	original := strings.TrimPrefix(parserTestMJML, `<?xml version="1.0"?>`)
+	original = strings.Replace(original, `padding="10px 20px"`,
+		`padding-top="10px" padding-right="20px" padding-bottom="10px" padding-left="20px"`, 1)
+	assert.Equal(t, compileForComparison(t, original), compileForComparison(t, ConvertJSONToMJMLRaw(tree)))
+}
+
+func TestParseMJML_Tree(t *testing.T) {
+	tree, err := ParseMJML(parserTestMJML)
+	require.NoError(t, err)
+
+	assert.Equal(t, MJMLComponentMjml, tree.GetType())
+	assert.Equal(t, "en", tree.GetAttributes()["lang"])
+	require.Len(t, tree.GetChildren(), 2)
+
+	head := tree.GetChildren()[0]
+	require.Len(t, head.GetChildren(), 4)
+	title := head.GetChildren()[0]
+	assert.Equal(t, MJMLComponentMjTitle, title.GetType())
+	assert.Equal(t, "Spring & summer", *title.GetContent())
+
+	body := tree.GetChildren()[1]
+	assert.Equal(t, "#f4f4f4", body.GetAttributes()["backgroundColor"])
+	require.Len(t, body.GetChildren(), 2)
+
+	section := body.GetChildren()[0]
+	assert.Equal(t, map[string]interface{}{
+		"paddingTop":      "10px",
+		"paddingRight":    "20px",
+		"paddingBottom":   "10px",
+		"paddingLeft":     "20px",
+		"backgroundColor": "#ffffff",
+	}, section.GetAttributes())
+
+	text := section.GetChildren()[0].GetChildren()[1]
+	assert.Equal(t, MJMLComponentMjText, text.GetType())
+	assert.Equal(t, "<h1>Hello {{ contact.first_name }}</h1><p>Our <b>new</b> collection.</p>", *text.GetContent())
+	assert.Equal(t, "16px", text.GetAttributes()["fontSize"])
+
+	button := section.GetChildren()[1].GetChildren()[0]
+	assert.Equal(t, "Shop now", *button.GetContent())
+	assert.Equal(t, "#ff6600", button.GetAttributes()["backgroundColor"])
+
+	// IDs are unique within the tree
+	ids := map[string]bool{}
+	var collect func(block EmailBlock)
+	collect = func(block EmailBlock) {
+		assert.False(t, ids[block.GetID()], "duplicate id %s", block.GetID())
+		ids[block.GetID()] = true
+		for _, child := range block.GetChildren() {
+			collect(child)
+		}
+	}
+	collect(tree)
+}
+
+func TestParseMJML_UnknownMarkup(t *testing.T) {
+	source := `<mjml><mj-body>
+  <mj-hero background-url="https://example.com/hero.jpg"><mj-text>Hero</mj-text></mj-hero>
+  <mj-section><mj-column>
+    <div class="stray">Stray HTML</div>
+    {% if contact.vip %}
+    <mj-text>VIP</mj-text>
+    {% endif %}
+  </mj-column></mj-section>
+</mj-body></mjml>`
+
+	tree, err := ParseMJML(source)
+	require.NoError(t, err)
+
+	body := tree.GetChildren()[0]
+	hero := body.GetChildren()[0]
+	assert.Equal(t, MJMLComponentMjLiquid, hero.GetType())
+	assert.Equal(t, `<mj-hero background-url="https://example.com/hero.jpg"><mj-text>Hero</mj-text></mj-hero>`, *hero.GetContent())
+
+	column := body.GetChildren()[1].GetChildren()[0]
+	require.Len(t, column.GetChildren(), 4)
+	assert.Equal(t, MJMLComponentMjRaw, column.GetChildren()[0].GetType())
+	assert.Equal(t, `<div class="stray">Stray HTML</div>`, *column.GetChildren()[0].GetContent())
+	assert.Equal(t, MJMLComponentMjLiquid, column.GetChildren()[1].GetType())
+	assert.Equal(t, "{% if contact.vip %}", *column.GetChildren()[1].GetContent())
+	assert.Equal(t, MJMLComponentMjText, column.GetChildren()[2].GetType())
+
+	compiled := compileForComparison(t, ConvertJSONToMJMLRaw(tree))
+	assert.Contains(t, compiled, "hero.jpg")
+	assert.Contains(t, compiled, `<div class="stray">Stray HTML</div>`)
+}
+
+func TestParseMJML_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		err    string
+	}{
+		{"missing root", `<mj-body></mj-body>`, "missing <mjml> root element"},
+		{"unclosed section", `<mjml><mj-body><mj-section><mj-column></mj-column></mj-body></mjml>`, "unclosed <mj-section> element"},
+		{"unclosed text", `<mjml><mj-body><mj-section><mj-column><mj-text>Hi`, "unclosed <mj-text> element"},
+		{"unexpected closing tag", `<mjml><mj-body></mj-column></mj-body></mjml>`, "unexpected closing tag </mj-column>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseMJML(tt.source)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestParseHTML(t *testing.T) {
+	source := `<!DOCTYPE html>
+<html lang="fr">
+<head><title>Newsletter</title><style>p { margin: 0; }</style></head>
+<body bgcolor="#eeeeee">
+  <div style="display:none;max-height:0;">Read our latest news</div>
+  <table role="presentation" width="600">
+    <tr><td align="center"><a href="https://example.com"><img src="https://example.com/logo.png" alt="Logo" width="150"></a></td></tr>
+    <tr><td style="color:#333333;font-size:16px">Hello <strong>there</strong>,</td></tr>
+    <tr>
+      <td width="50%" bgcolor="#ffffff"><h2>Left</h2><p>Left text</p></td>
+      <td width="10">&nbsp;</td>
+      <td width="50%"><p>Right text</p></td>
+    </tr>
+    <tr><td>
+      <table><tr><td bgcolor="#ff6600" style="border-radius:4px"><a href="https://example.com/buy" style="color:#ffffff">Buy now</a></td></tr></table>
+    </td></tr>
+    <tr><td><hr><form action="https://example.com/subscribe"><input name="email"></form></td></tr>
+  </table>
+</body>
+</html>`
+
+	tree, err := ParseHTML(source)
+	require.NoError(t, err)
+	require.NoError(t, ValidateComponentHierarchy(tree))
+	assert.Equal(t, "fr", tree.GetAttributes()["lang"])
+
+	head := tree.GetChildren()[0]
+	require.Len(t, head.GetChildren(), 3)
+	assert.Equal(t, "Newsletter", *head.GetChildren()[0].GetContent())
+	assert.Equal(t, MJMLComponentMjPreview, head.GetChildren()[1].GetType())
+	assert.Equal(t, "Read our latest news", *head.GetChildren()[1].GetContent())
+	assert.Equal(t, "p { margin: 0; }", *head.GetChildren()[2].GetContent())
+
+	body := tree.GetChildren()[1]
+	assert.Equal(t, "#eeeeee", body.GetAttributes()["backgroundColor"])
+	require.Len(t, body.GetChildren(), 3)
+
+	intro := body.GetChildren()[0].GetChildren()[0].GetChildren()
+	require.Len(t, intro, 2)
+	assert.Equal(t, MJMLComponentMjImage, intro[0].GetType())
+	assert.Equal(t, "https://example.com", intro[0].GetAttributes()["href"])
+	assert.Equal(t, "150px", intro[0].GetAttributes()["width"])
+	assert.Equal(t, MJMLComponentMjText, intro[1].GetType())
+	assert.Equal(t, `<p style="color: #333333; font-size: 16px">Hello <strong>there</strong>,</p>`, *intro[1].GetContent())
+
+	columns := body.GetChildren()[1].GetChildren()
+	require.Len(t, columns, 2, "the spacer cell is left out")
+	assert.Equal(t, "50%", columns[0].GetAttributes()["width"])
+	assert.Equal(t, "#ffffff", columns[0].GetAttributes()["backgroundColor"])
+	assert.Equal(t, "<h2>Left</h2><p>Left text</p>", *columns[0].GetChildren()[0].GetContent())
+
+	footer := body.GetChildren()[2].GetChildren()[0].GetChildren()
+	require.Len(t, footer, 3)
+	assert.Equal(t, MJMLComponentMjButton, footer[0].GetType())
+	assert.Equal(t, "Buy now", *footer[0].GetContent())
+	assert.Equal(t, "#ff6600", footer[0].GetAttributes()["backgroundColor"])
+	assert.Equal(t, "#ffffff", footer[0].GetAttributes()["color"])
+	assert.Equal(t, "4px", footer[0].GetAttributes()["borderRadius"])
+	assert.Equal(t, MJMLComponentMjDivider, footer[1].GetType())
+	assert.Equal(t, MJMLComponentMjRaw, footer[2].GetType())
+	assert.Contains(t, *footer[2].GetContent(), `<form action="https://example.com/subscribe">`)
+
+	_, err = mjml.Render(preprocessMjmlForXML(ConvertJSONToMJMLRaw(tree)))
+	assert.NoError(t, err)
+}
+
+func TestParseEmailSource(t *testing.T) {
+	tree, err := ParseEmailSource(`<mjml><mj-body><mj-section><mj-column><mj-text>Hi</mj-text></mj-column></mj-section></mj-body></mjml>`)
+	require.NoError(t, err)
+	text := tree.GetChildren()[0].GetChildren()[0].GetChildren()[0].GetChildren()[0]
+	assert.Equal(t, "mj-text-1", text.GetID())
+
+	tree, err = ParseEmailSource(`<p>Hello</p>`)
+	require.NoError(t, err)
+	text = tree.GetChildren()[0].GetChildren()[0].GetChildren()[0].GetChildren()[0]
+	assert.Equal(t, "<p>Hello</p>", *text.GetContent())
+}
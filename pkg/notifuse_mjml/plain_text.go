@@ -0,0 +1,349 @@
+package notifuse_mjml
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// plainTextSkippedElements are elements whose content never reaches the text part
+var plainTextSkippedElements = map[string]bool{
+	"head": true, "style": true, "script": true, "title": true, "noscript": true, "template": true,
+}
+
+// plainTextBlockElements start and end on their own line
+var plainTextBlockElements = map[string]bool{
+	"div": true, "section": true, "article": true, "header": true, "footer": true, "main": true,
+	"nav": true, "aside": true, "center": true, "pre": true, "address": true, "figure": true,
+	"figcaption": true, "dl": true, "dt": true, "dd": true, "form": true, "fieldset": true,
+	"table": true, "tbody": true, "thead": true, "tfoot": true, "tr": true,
+}
+
+// plainTextParagraphElements are separated from their siblings by a blank line
+var plainTextParagraphElements = map[string]bool{
+	"p": true, "ul": true, "ol": true,
+}
+
+var plainTextBlankLinesRegexp = regexp.MustCompile(`\n{3,}`)
+
+// HTMLToPlainText derives a text/plain alternative from compiled email HTML.
+// Hidden elements such as the inbox preview are dropped, headings are underlined,
+// list items are bulleted or numbered, layout tables are flattened into lines and
+// data table cells are joined with " | ". Links are numbered in the text and
+// listed as footnotes at the end.
+func HTMLToPlainText(htmlString string) string {
+	doc, err := html.Parse(strings.NewReader(htmlString))
+	if err != nil {
+		return ""
+	}
+
+	w := &plainTextWriter{linkIndex: map[string]int{}}
+	w.renderChildren(doc)
+
+	text := w.finish(w.out.String())
+	if len(w.links) > 0 {
+		var footnotes strings.Builder
+		for i, link := range w.links {
+			fmt.Fprintf(&footnotes, "\n[%d] %s", i+1, link)
+		}
+		if text != "" {
+			text += "\n\n"
+		}
+		text += "Links:" + footnotes.String()
+	}
+	return text
+}
+
+// plainTextWriter accumulates the text of a document. Block boundaries are
+// written as newlines and normalized by finish.
+type plainTextWriter struct {
+	out       strings.Builder
+	links     []string
+	linkIndex map[string]int
+	lists     []*plainTextList
+}
+
+type plainTextList struct {
+	ordered bool
+	next    int
+}
+
+// sub renders the children of n into a separate buffer that shares the link footnotes
+func (w *plainTextWriter) sub(n *html.Node) string {
+	child := &plainTextWriter{links: w.links, linkIndex: w.linkIndex, lists: w.lists}
+	child.renderChildren(n)
+	w.links = child.links
+	return child.finish(child.out.String())
+}
+
+func (w *plainTextWriter) finish(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	text = strings.Join(lines, "\n")
+	text = plainTextBlankLinesRegexp.ReplaceAllString(text, "\n\n")
+	return strings.Trim(text, "\n")
+}
+
+func (w *plainTextWriter) lastByte() byte {
+	s := w.out.String()
+	if s == "" {
+		return '\n'
+	}
+	return s[len(s)-1]
+}
+
+// newline ends the current line unless it is empty
+func (w *plainTextWriter) newline() {
+	if w.lastByte() != '\n' {
+		w.out.WriteByte('\n')
+	}
+}
+
+// blankLine separates paragraphs
+func (w *plainTextWriter) blankLine() {
+	w.newline()
+	w.out.WriteByte('\n')
+}
+
+// writeText writes inline text, collapsing whitespace like a browser would
+func (w *plainTextWriter) writeText(text string) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		if text != "" && w.lastByte() != ' ' && w.lastByte() != '\n' {
+			w.out.WriteByte(' ')
+		}
+		return
+	}
+	first, _ := utf8.DecodeRuneInString(text)
+	if unicode.IsSpace(first) && w.lastByte() != ' ' && w.lastByte() != '\n' {
+		w.out.WriteByte(' ')
+	}
+	w.out.WriteString(strings.Join(fields, " "))
+	last, _ := utf8.DecodeLastRuneInString(text)
+	if unicode.IsSpace(last) {
+		w.out.WriteByte(' ')
+	}
+}
+
+// writeLines writes a rendered block, each line prefixed
+func (w *plainTextWriter) writeLines(text string, prefix string) {
+	for _, line := range strings.Split(text, "\n") {
+		w.out.WriteString(strings.TrimRight(prefix+line, " "))
+		w.out.WriteByte('\n')
+	}
+}
+
+func (w *plainTextWriter) renderChildren(n *html.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		w.render(c)
+	}
+}
+
+func (w *plainTextWriter) render(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		w.writeText(n.Data)
+		return
+	case html.DocumentNode:
+		w.renderChildren(n)
+		return
+	case html.ElementNode:
+	default:
+		return
+	}
+
+	tag := n.Data
+	if plainTextSkippedElements[tag] || isHiddenElement(n) {
+		return
+	}
+
+	switch tag {
+	case "br":
+		w.out.WriteByte('\n')
+	case "hr":
+		w.newline()
+		w.out.WriteString("--------\n")
+	case "img":
+		if alt := strings.TrimSpace(htmlAttr(n, "alt")); alt != "" {
+			w.writeText(alt)
+		}
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		heading := strings.Join(strings.Fields(w.sub(n)), " ")
+		if heading == "" {
+			return
+		}
+		w.blankLine()
+		w.out.WriteString(heading)
+		switch tag {
+		case "h1":
+			w.out.WriteString("\n" + strings.Repeat("=", utf8.RuneCountInString(heading)))
+		case "h2":
+			w.out.WriteString("\n" + strings.Repeat("-", utf8.RuneCountInString(heading)))
+		}
+		w.blankLine()
+	case "a":
+		w.renderLink(n)
+	case "ul", "ol":
+		list := &plainTextList{ordered: tag == "ol", next: 1}
+		if start := htmlAttr(n, "start"); list.ordered && start != "" {
+			fmt.Sscanf(start, "%d", &list.next)
+		}
+		if len(w.lists) == 0 {
+			w.blankLine()
+		} else {
+			w.newline()
+		}
+		w.lists = append(w.lists, list)
+		w.renderChildren(n)
+		w.lists = w.lists[:len(w.lists)-1]
+		if len(w.lists) == 0 {
+			w.blankLine()
+		} else {
+			w.newline()
+		}
+	case "li":
+		w.renderListItem(n)
+	case "blockquote":
+		quote := w.sub(n)
+		if quote == "" {
+			return
+		}
+		w.blankLine()
+		w.writeLines(quote, "> ")
+		w.blankLine()
+	case "tr":
+		w.newline()
+		if isDataTableRow(n) {
+			w.renderDataTableRow(n)
+		} else {
+			w.renderChildren(n)
+		}
+		w.newline()
+	case "td", "th":
+		// Cells of layout tables hold the blocks of the email, one after the other
+		w.newline()
+		w.renderChildren(n)
+		w.newline()
+	default:
+		switch {
+		case plainTextParagraphElements[tag]:
+			w.blankLine()
+			w.renderChildren(n)
+			w.blankLine()
+		case plainTextBlockElements[tag]:
+			w.newline()
+			w.renderChildren(n)
+			w.newline()
+		default:
+			w.renderChildren(n)
+		}
+	}
+}
+
+func (w *plainTextWriter) renderLink(n *html.Node) {
+	href := strings.TrimSpace(htmlAttr(n, "href"))
+	text := strings.Join(strings.Fields(w.sub(n)), " ")
+
+	lowerHref := strings.ToLower(href)
+	switch {
+	case strings.HasPrefix(lowerHref, "mailto:") || strings.HasPrefix(lowerHref, "tel:"):
+		address := href[strings.Index(href, ":")+1:]
+		if i := strings.Index(address, "?"); i >= 0 {
+			address = address[:i]
+		}
+		if text == "" {
+			w.writeText(address)
+		} else if text != address {
+			w.writeText(text + " (" + address + ")")
+		} else {
+			w.writeText(text)
+		}
+		return
+	case !strings.HasPrefix(lowerHref, "http://") && !strings.HasPrefix(lowerHref, "https://"):
+		// Anchors, javascript: and unresolved placeholders are not worth a footnote
+		w.writeText(text)
+		return
+	}
+
+	if text == href || strings.TrimSuffix(text, "/") == strings.TrimSuffix(href, "/") {
+		w.writeText(href)
+		return
+	}
+
+	index, ok := w.linkIndex[href]
+	if !ok {
+		w.links = append(w.links, href)
+		index = len(w.links)
+		w.linkIndex[href] = index
+	}
+	if text != "" {
+		w.writeText(text + " ")
+	}
+	w.out.WriteString(fmt.Sprintf("[%d]", index))
+}
+
+func (w *plainTextWriter) renderListItem(n *html.Node) {
+	marker := "- "
+	if len(w.lists) > 0 {
+		if list := w.lists[len(w.lists)-1]; list.ordered {
+			marker = fmt.Sprintf("%d. ", list.next)
+			list.next++
+		}
+	}
+
+	item := w.sub(n)
+	if item == "" {
+		return
+	}
+	w.newline()
+	lines := strings.Split(item, "\n")
+	w.out.WriteString(marker + lines[0] + "\n")
+	if len(lines) > 1 {
+		// Nested lists and continuation lines are aligned under the item text
+		w.writeLines(strings.Join(lines[1:], "\n"), strings.Repeat(" ", len(marker)))
+	}
+}
+
+func (w *plainTextWriter) renderDataTableRow(n *html.Node) {
+	var cells []string
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode || (c.Data != "td" && c.Data != "th") || isHiddenElement(c) {
+			continue
+		}
+		cells = append(cells, strings.Join(strings.Fields(w.sub(c)), " "))
+	}
+	w.out.WriteString(strings.Join(cells, " | "))
+}
+
+// isDataTableRow reports whether a row belongs to a data table. MJML lays the
+// email out with tables marked role="presentation"; other tables carry data.
+func isDataTableRow(n *html.Node) bool {
+	for p := n.Parent; p != nil; p = p.Parent {
+		if p.Type == html.ElementNode && p.Data == "table" {
+			return htmlAttr(p, "role") != "presentation"
+		}
+	}
+	return false
+}
+
+// isHiddenElement reports whether an element is hidden with an inline style,
+// like the inbox preview text that MJML inserts at the top of the body
+func isHiddenElement(n *html.Node) bool {
+	style := strings.ToLower(strings.ReplaceAll(htmlAttr(n, "style"), " ", ""))
+	return strings.Contains(style, "display:none")
+}
+
+func htmlAttr(n *html.Node, key string) string {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
@@ -0,0 +1,143 @@
+package notifuse_mjml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTMLToPlainText(t *testing.T) {
+	tests := []struct {
+		name     string
+		html     string
+		expected string
+	}{
+		{
+			name:     "paragraphs and line breaks",
+			html:     "<p>Hello   world</p><p>Second<br>line</p>",
+			expected: "Hello world\n\nSecond\nline",
+		},
+		{
+			name:     "headings are underlined",
+			html:     "<h1>Welcome</h1><h2>News</h2><h3>Details</h3><p>Body</p>",
+			expected: "Welcome\n=======\n\nNews\n----\n\nDetails\n\nBody",
+		},
+		{
+			name:     "links become footnotes",
+			html:     `<p>Read <a href="https://example.com/a">the post</a> or <a href="https://example.com/b">this one</a>, again <a href="https://example.com/a">here</a>.</p>`,
+			expected: "Read the post [1] or this one [2], again here [1].\n\nLinks:\n[1] https://example.com/a\n[2] https://example.com/b",
+		},
+		{
+			name:     "links showing their URL are kept inline",
+			html:     `<p>Visit <a href="https://example.com">https://example.com</a></p>`,
+			expected: "Visit https://example.com",
+		},
+		{
+			name:     "mailto links show the address",
+			html:     `<p>Write to <a href="mailto:team@example.com?subject=Hi">us</a></p>`,
+			expected: "Write to us (team@example.com)",
+		},
+		{
+			name:     "anchors and placeholders have no footnote",
+			html:     `<p><a href="#top">Top</a> <a href="{{ url }}">Open</a></p>`,
+			expected: "Top Open",
+		},
+		{
+			name:     "lists",
+			html:     `<ul><li>One</li><li>Two<ol start="3"><li>Three</li><li>Four</li></ol></li></ul>`,
+			expected: "- One\n- Two\n  3. Three\n  4. Four",
+		},
+		{
+			name:     "data tables are flattened",
+			html:     `<table><tr><th>Item</th><th>Price</th></tr><tr><td>Hat</td><td>10 &euro;</td></tr></table>`,
+			expected: "Item | Price\nHat | 10 €",
+		},
+		{
+			name:     "layout tables put blocks on their own line",
+			html:     `<table role="presentation"><tr><td>First</td><td>Second</td></tr></table>`,
+			expected: "First\nSecond",
+		},
+		{
+			name:     "hidden elements, styles and comments are dropped",
+			html:     `<html><head><title>T</title><style>p{color:red}</style></head><body><div style="display: none;">Preview</div><!--[if mso]>Outlook<![endif]--><p>Visible</p></body></html>`,
+			expected: "Visible",
+		},
+		{
+			name:     "images use their alt text",
+			html:     `<a href="https://example.com"><img src="logo.png" alt="Acme"></a><img src="pixel.gif" alt="">`,
+			expected: "Acme [1]\n\nLinks:\n[1] https://example.com",
+		},
+		{
+			name:     "blockquotes are quoted",
+			html:     `<blockquote><p>Quoted</p><p>Text</p></blockquote>`,
+			expected: "> Quoted\n>\n> Text",
+		},
+		{
+			name:     "empty document",
+			html:     "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, HTMLToPlainText(tt.html))
+		})
+	}
+}
+
+func TestCompileTemplatePlainText(t *testing.T) {
+	newTree := func() EmailBlock {
+		textBase := NewBaseBlock("text", MJMLComponentMjText)
+		textBase.Content = stringPtr(`<p>Hello {{ name }}, <a href="https://example.com">read more</a></p>`)
+
+		column := &MJColumnBlock{BaseBlock: NewBaseBlock("col", MJMLComponentMjColumn)}
+		column.Children = []EmailBlock{&MJTextBlock{BaseBlock: textBase}}
+		section := &MJSectionBlock{BaseBlock: NewBaseBlock("sec", MJMLComponentMjSection)}
+		section.Children = []EmailBlock{column}
+		body := &MJBodyBlock{BaseBlock: NewBaseBlock("body", MJMLComponentMjBody)}
+		body.Children = []EmailBlock{section}
+		root := &MJMLBlock{BaseBlock: NewBaseBlock("mjml", MJMLComponentMjml)}
+		root.Children = []EmailBlock{body}
+		return root
+	}
+
+	t.Run("derived from the rendered HTML", func(t *testing.T) {
+		resp, err := CompileTemplate(CompileTemplateRequest{
+			WorkspaceID:      "ws",
+			MessageID:        "msg",
+			VisualEditorTree: newTree(),
+			TemplateData:     MapOfAny{"name": "Pierre"},
+			TrackingSettings: TrackingSettings{UTMSource: "newsletter"},
+		})
+		assert.NoError(t, err)
+		assert.True(t, resp.Success, "error: %v", resp.Error)
+		assert.Equal(t, "Hello Pierre, read more [1]\n\nLinks:\n[1] https://example.com?utm_source=newsletter", resp.GetText())
+	})
+
+	t.Run("template text overrides the derived one", func(t *testing.T) {
+		text := "Hi {{ name }}"
+		resp, err := CompileTemplate(CompileTemplateRequest{
+			WorkspaceID:      "ws",
+			MessageID:        "msg",
+			VisualEditorTree: newTree(),
+			TemplateData:     MapOfAny{"name": "Pierre"},
+			Text:             &text,
+		})
+		assert.NoError(t, err)
+		assert.True(t, resp.Success, "error: %v", resp.Error)
+		assert.Equal(t, "Hi Pierre", resp.GetText())
+	})
+
+	t.Run("no text for the web channel", func(t *testing.T) {
+		resp, err := CompileTemplate(CompileTemplateRequest{
+			WorkspaceID:      "ws",
+			MessageID:        "msg",
+			VisualEditorTree: newTree(),
+			Channel:          "web",
+		})
+		assert.NoError(t, err)
+		assert.True(t, resp.Success, "error: %v", resp.Error)
+		assert.Nil(t, resp.Text)
+	})
+}
@@ -249,11 +249,13 @@ type CompileTemplateRequest struct {
 	MjmlSource             *string          `json:"mjml_source,omitempty"`
 	Subject                *string          `json:"subject,omitempty"`                  // Email subject; processed through Liquid using TemplateData
 	SubjectPreview         *string          `json:"subject_preview,omitempty"`          // Email subject preview (inbox preview text); processed through Liquid
+	Text                   *string          `json:"text,omitempty"`                     // Plain-text part overriding the one derived from the HTML; processed through Liquid
 	TemplateData           MapOfAny         `json:"test_data,omitempty"`
 	TrackingSettings       TrackingSettings `json:"tracking_settings,omitempty"`
 	Channel                string           `json:"channel,omitempty"`                  // "email" or "web"
 	PreserveLiquid         bool             `json:"preserve_liquid,omitempty"`          // When true, skip Liquid template processing and preserve raw syntax
 	SubjectPreviewOverride *string          `json:"subject_preview_override,omitempty"` // Override mj-preview content before compilation
+	Accessibility          bool             `json:"accessibility,omitempty"`            // When true, audit the visual editor tree and return an accessibility report
 }
 
 // UnmarshalJSON implements custom JSON unmarshaling for CompileTemplateRequest
@@ -320,7 +322,18 @@ type CompileTemplateResponse struct {
 	HTML           *string     `json:"html,omitempty"`            // Pointer, omit if nil
 	Subject        *string     `json:"subject,omitempty"`         // Rendered email subject (Liquid processed); omit if not provided in request
 	SubjectPreview *string     `json:"subject_preview,omitempty"` // Rendered email subject preview (Liquid processed); omit if not provided in request
+	Text           *string     `json:"text,omitempty"`            // Plain-text alternative of the email; omit for the web channel
 	Error          *mjml.Error `json:"error,omitempty"`           // Pointer, omit if nil
+	// Accessibility issues of the visual editor tree, only when requested
+	Accessibility *AccessibilityReport `json:"accessibility,omitempty"`
+}
+
+// GetText returns the plain-text alternative of the compiled email, empty when there is none
+func (r *CompileTemplateResponse) GetText() string {
+	if r == nil || r.Text == nil {
+		return ""
+	}
+	return *r.Text
 }
 
 // renderSubjectField applies the same Liquid rules used for the body to a header
@@ -385,6 +398,16 @@ func GenerateHTMLOpenTrackingPixel(workspaceID string, messageID string, apiEndp
 func CompileTemplate(req CompileTemplateRequest) (resp *CompileTemplateResponse, err error) {
 	var mjmlString string
 
+	// The accessibility report is computed from the tree as edited, so it is returned
+	// even when the template fails to compile. Code mode has no tree to audit.
+	if req.Accessibility && req.VisualEditorTree != nil && (req.MjmlSource == nil || *req.MjmlSource == "") {
+		defer func() {
+			if resp != nil {
+				resp.Accessibility = CheckAccessibility(req.VisualEditorTree)
+			}
+		}()
+	}
+
 	// Render Subject and SubjectPreview through Liquid before any body work, so
 	// the rendered values can be returned even when the body fails to compile.
 	// A malformed Liquid expression in the subject short-circuits the response.
@@ -559,6 +582,23 @@ func CompileTemplate(req CompileTemplateRequest) (resp *CompileTemplateResponse,
 		}, nil
 	}
 
+	// Derive the plain-text alternative from the final HTML, so that it carries
+	// the contact's data and the tracked links, unless the template provides one
+	text := HTMLToPlainText(trackedHTML)
+	if req.Text != nil && *req.Text != "" {
+		renderedText, textErr := renderSubjectField(req.Text, req.TemplateData, req.Channel, req.PreserveLiquid, "email_text")
+		if textErr != nil {
+			return &CompileTemplateResponse{
+				Success:        false,
+				MJML:           &mjmlString,
+				Subject:        renderedSubject,
+				SubjectPreview: renderedSubjectPreview,
+				Error:          textErr,
+			}, nil
+		}
+		text = *renderedText
+	}
+
 	// Return successful response
 	return &CompileTemplateResponse{
 		Success:        true,
@@ -566,6 +606,7 @@ func CompileTemplate(req CompileTemplateRequest) (resp *CompileTemplateResponse,
 		HTML:           &trackedHTML,
 		Subject:        renderedSubject,
 		SubjectPreview: renderedSubjectPreview,
+		Text:           &text,
 		Error:          nil,
 	}, nil
 }
@@ -0,0 +1,542 @@
+package qrcode
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+// QR codes are encoded in byte mode with the medium error correction level (15% recovery),
+// which is what email QR codes use: a link or a ticket reference scanned from a screen.
+const (
+	minVersion = 1
+	maxVersion = 40
+	// quietZone is the light border the specification requires around the symbol, in modules
+	quietZone = 4
+	// eclMedium is the format information value of the medium error correction level
+	eclMedium = 0
+)
+
+// eccCodewordsPerBlock and numErrorCorrectionBlocks give, for each version, the error
+// correction layout of the medium level (index 0 is unused)
+var (
+	eccCodewordsPerBlock = [maxVersion + 1]int{-1,
+		10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26,
+		26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28}
+	numErrorCorrectionBlocks = [maxVersion + 1]int{-1,
+		1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16,
+		17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49}
+)
+
+// Code is a QR code symbol
+type Code struct {
+	// Size is the width and height of the symbol in modules, without the quiet zone
+	Size       int
+	version    int
+	modules    [][]bool
+	isFunction [][]bool
+}
+
+// Encode returns the smallest QR code holding content
+func Encode(content string) (*Code, error) {
+	data := []byte(content)
+
+	version := minVersion
+	for ; version <= maxVersion; version++ {
+		if dataBitsLength(version, len(data)) <= numDataCodewords(version)*8 {
+			break
+		}
+	}
+	if version > maxVersion {
+		return nil, fmt.Errorf("content of %d bytes is too long for a QR code", len(data))
+	}
+
+	// Mode indicator, character count and data, then the terminator and padding
+	var bits bitBuffer
+	bits.append(0x4, 4)
+	bits.append(len(data), charCountBits(version))
+	for _, b := range data {
+		bits.append(int(b), 8)
+	}
+	capacity := numDataCodewords(version) * 8
+	bits.append(0, min(4, capacity-len(bits)))
+	bits.append(0, (8-len(bits)%8)%8)
+	for pad := 0xEC; len(bits) < capacity; pad ^= 0xEC ^ 0x11 {
+		bits.append(pad, 8)
+	}
+
+	codewords := make([]byte, len(bits)/8)
+	for i, bit := range bits {
+		if bit {
+			codewords[i>>3] |= 1 << (7 - i&7)
+		}
+	}
+
+	code := newCode(version)
+	code.drawFunctionPatterns()
+	code.drawCodewords(addECCAndInterleave(version, codewords))
+	code.applyBestMask()
+	return code, nil
+}
+
+// Dark tells whether the module at column x and row y is dark
+func (c *Code) Dark(x, y int) bool {
+	return x >= 0 && x < c.Size && y >= 0 && y < c.Size && c.modules[y][x]
+}
+
+// PNG renders the symbol with its quiet zone as a PNG image of about pixels wide, never
+// smaller than one pixel per module
+func (c *Code) PNG(pixels int) ([]byte, error) {
+	total := c.Size + 2*quietZone
+	scale := max(pixels/total, 1)
+
+	img := image.NewPaletted(image.Rect(0, 0, total*scale, total*scale), color.Palette{color.White, color.Black})
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if !c.modules[y][x] {
+				continue
+			}
+			for dy := 0; dy < scale; dy++ {
+				for dx := 0; dx < scale; dx++ {
+					img.SetColorIndex((x+quietZone)*scale+dx, (y+quietZone)*scale+dy, 1)
+				}
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	if err := png.Encode(&out, img); err != nil {
+		return nil, fmt.Errorf("failed to encode QR code image: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// DataURI encodes content as a QR code and returns it as a data URI holding a PNG image
+func DataURI(content string, pixels int) (string, error) {
+	code, err := Encode(content)
+	if err != nil {
+		return "", err
+	}
+	pngData, err := code.PNG(pixels)
+	if err != nil {
+		return "", err
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(pngData), nil
+}
+
+type bitBuffer []bool
+
+func (b *bitBuffer) append(value, length int) {
+	for i := length - 1; i >= 0; i-- {
+		*b = append(*b, (value>>i)&1 != 0)
+	}
+}
+
+func charCountBits(version int) int {
+	if version <= 9 {
+		return 8
+	}
+	return 16
+}
+
+func dataBitsLength(version, length int) int {
+	return 4 + charCountBits(version) + 8*length
+}
+
+// numRawDataModules returns the number of modules left for data and error correction once
+// the function patterns are drawn
+func numRawDataModules(version int) int {
+	result := (16*version+128)*version + 64
+	if version >= 2 {
+		numAlign := version/7 + 2
+		result -= (25*numAlign-10)*numAlign - 55
+		if version >= 7 {
+			result -= 36
+		}
+	}
+	return result
+}
+
+func numDataCodewords(version int) int {
+	return numRawDataModules(version)/8 - eccCodewordsPerBlock[version]*numErrorCorrectionBlocks[version]
+}
+
+// addECCAndInterleave splits the data into blocks, appends the Reed-Solomon error correction
+// of each block and interleaves the blocks
+func addECCAndInterleave(version int, data []byte) []byte {
+	numBlocks := numErrorCorrectionBlocks[version]
+	blockECCLength := eccCodewordsPerBlock[version]
+	rawCodewords := numRawDataModules(version) / 8
+	numShortBlocks := numBlocks - rawCodewords%numBlocks
+	shortBlockLength := rawCodewords / numBlocks
+
+	divisor := reedSolomonDivisor(blockECCLength)
+	blocks := make([][]byte, numBlocks)
+	k := 0
+	for i := range blocks {
+		length := shortBlockLength - blockECCLength
+		if i >= numShortBlocks {
+			length++
+		}
+		block := append([]byte{}, data[k:k+length]...)
+		k += length
+		ecc := reedSolomonRemainder(block, divisor)
+		if i < numShortBlocks {
+			// Placeholder keeping the error correction aligned with the long blocks
+			block = append(block, 0)
+		}
+		blocks[i] = append(block, ecc...)
+	}
+
+	result := make([]byte, 0, rawCodewords)
+	for i := range blocks[0] {
+		for j, block := range blocks {
+			if i != shortBlockLength-blockECCLength || j >= numShortBlocks {
+				result = append(result, block[i])
+			}
+		}
+	}
+	return result
+}
+
+// reedSolomonDivisor returns the generator polynomial of the given degree, highest
+// coefficient first and the leading 1 left out
+func reedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = gfMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = gfMultiply(root, 0x02)
+	}
+	return result
+}
+
+func reedSolomonRemainder(data, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, coefficient := range divisor {
+			result[i] ^= gfMultiply(coefficient, factor)
+		}
+	}
+	return result
+}
+
+// gfMultiply multiplies in GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1
+func gfMultiply(x, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int((y>>i)&1) * int(x)
+	}
+	return byte(z)
+}
+
+func newCode(version int) *Code {
+	size := version*4 + 17
+	c := &Code{Size: size, version: version}
+	c.modules = make([][]bool, size)
+	c.isFunction = make([][]bool, size)
+	for i := range c.modules {
+		c.modules[i] = make([]bool, size)
+		c.isFunction[i] = make([]bool, size)
+	}
+	return c
+}
+
+func (c *Code) setFunctionModule(x, y int, dark bool) {
+	c.modules[y][x] = dark
+	c.isFunction[y][x] = true
+}
+
+func (c *Code) drawFunctionPatterns() {
+	for i := 0; i < c.Size; i++ {
+		c.setFunctionModule(6, i, i%2 == 0)
+		c.setFunctionModule(i, 6, i%2 == 0)
+	}
+
+	c.drawFinderPattern(3, 3)
+	c.drawFinderPattern(c.Size-4, 3)
+	c.drawFinderPattern(3, c.Size-4)
+
+	positions := c.alignmentPatternPositions()
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			// The corners taken by the finder patterns have no alignment pattern
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					c.setFunctionModule(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+				}
+			}
+		}
+	}
+
+	// Reserve the format information, drawn again once the mask is chosen
+	c.drawFormatBits(0)
+	c.drawVersion()
+}
+
+// drawFinderPattern draws a finder pattern and its separator centered on x, y
+func (c *Code) drawFinderPattern(x, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			distance := max(abs(dx), abs(dy))
+			if x+dx >= 0 && x+dx < c.Size && y+dy >= 0 && y+dy < c.Size {
+				c.setFunctionModule(x+dx, y+dy, distance != 2 && distance != 4)
+			}
+		}
+	}
+}
+
+func (c *Code) alignmentPatternPositions() []int {
+	if c.version == 1 {
+		return nil
+	}
+	numAlign := c.version/7 + 2
+	step := (c.version*8 + numAlign*3 + 5) / (numAlign*4 - 4) * 2
+	result := make([]int, numAlign)
+	result[0] = 6
+	for i, position := numAlign-1, c.Size-7; i >= 1; i, position = i-1, position-step {
+		result[i] = position
+	}
+	return result
+}
+
+// formatBits returns the 15 bits of format information for an error correction level and mask
+func formatBits(ecl, mask int) int {
+	data := ecl<<3 | mask
+	remainder := data
+	for i := 0; i < 10; i++ {
+		remainder = (remainder << 1) ^ ((remainder >> 9) * 0x537)
+	}
+	return (data<<10 | remainder) ^ 0x5412
+}
+
+func (c *Code) drawFormatBits(mask int) {
+	bits := formatBits(eclMedium, mask)
+	bit := func(i int) bool { return (bits>>i)&1 != 0 }
+
+	// Around the top left finder pattern
+	for i := 0; i <= 5; i++ {
+		c.setFunctionModule(8, i, bit(i))
+	}
+	c.setFunctionModule(8, 7, bit(6))
+	c.setFunctionModule(8, 8, bit(7))
+	c.setFunctionModule(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		c.setFunctionModule(14-i, 8, bit(i))
+	}
+
+	// Split between the other two finder patterns
+	for i := 0; i < 8; i++ {
+		c.setFunctionModule(c.Size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		c.setFunctionModule(8, c.Size-15+i, bit(i))
+	}
+	c.setFunctionModule(8, c.Size-8, true)
+}
+
+// versionBits returns the 18 bits of version information
+func versionBits(version int) int {
+	remainder := version
+	for i := 0; i < 12; i++ {
+		remainder = (remainder << 1) ^ ((remainder >> 11) * 0x1F25)
+	}
+	return version<<12 | remainder
+}
+
+// drawVersion draws the version information of versions 7 and above
+func (c *Code) drawVersion() {
+	if c.version < 7 {
+		return
+	}
+	bits := versionBits(c.version)
+	for i := 0; i < 18; i++ {
+		dark := (bits>>i)&1 != 0
+		a, b := c.Size-11+i%3, i/3
+		c.setFunctionModule(a, b, dark)
+		c.setFunctionModule(b, a, dark)
+	}
+}
+
+// drawCodewords fills the data modules in the zigzag order, two columns at a time from the
+// bottom right corner
+func (c *Code) drawCodewords(data []byte) {
+	i := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			// Skip the vertical timing pattern
+			right = 5
+		}
+		for vertical := 0; vertical < c.Size; vertical++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vertical
+				if (right+1)&2 == 0 {
+					y = c.Size - 1 - vertical
+				}
+				if !c.isFunction[y][x] && i < len(data)*8 {
+					c.modules[y][x] = (data[i>>3]>>(7-i&7))&1 != 0
+					i++
+				}
+			}
+		}
+	}
+}
+
+func maskApplies(mask, x, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+// applyMask flips the data modules selected by a mask; applying it twice undoes it
+func (c *Code) applyMask(mask int) {
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if !c.isFunction[y][x] && maskApplies(mask, x, y) {
+				c.modules[y][x] = !c.modules[y][x]
+			}
+		}
+	}
+}
+
+// applyBestMask applies the mask with the lowest penalty score, as the specification requires
+func (c *Code) applyBestMask() {
+	bestMask, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		c.applyMask(mask)
+		c.drawFormatBits(mask)
+		if penalty := c.penaltyScore(); bestPenalty < 0 || penalty < bestPenalty {
+			bestMask, bestPenalty = mask, penalty
+		}
+		c.applyMask(mask)
+	}
+	c.applyMask(bestMask)
+	c.drawFormatBits(bestMask)
+}
+
+// penaltyScore rates how hard the symbol is to scan: runs of the same color, blocks of the
+// same color, patterns looking like finder patterns and an unbalanced dark proportion
+func (c *Code) penaltyScore() int {
+	penalty := 0
+	line := make([]bool, c.Size)
+	for _, vertical := range []bool{false, true} {
+		for i := 0; i < c.Size; i++ {
+			for j := 0; j < c.Size; j++ {
+				if vertical {
+					line[j] = c.modules[j][i]
+				} else {
+					line[j] = c.modules[i][j]
+				}
+			}
+			penalty += runPenalty(line) + finderLikePenalty(line)
+		}
+	}
+
+	dark := 0
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.modules[y][x] {
+				dark++
+			}
+			if x+1 < c.Size && y+1 < c.Size {
+				color := c.modules[y][x]
+				if color == c.modules[y][x+1] && color == c.modules[y+1][x] && color == c.modules[y+1][x+1] {
+					penalty += 3
+				}
+			}
+		}
+	}
+
+	total := c.Size * c.Size
+	// Ten points for every 5% the dark proportion strays from 50%
+	k := (abs(dark*20-total*10)+total-1)/total - 1
+	return penalty + max(k, 0)*10
+}
+
+func runPenalty(line []bool) int {
+	penalty := 0
+	run := 1
+	for i := 1; i <= len(line); i++ {
+		if i < len(line) && line[i] == line[i-1] {
+			run++
+			continue
+		}
+		if run >= 5 {
+			penalty += run - 2
+		}
+		run = 1
+	}
+	return penalty
+}
+
+// finderLikePenalty counts 1:1:3:1:1 dark patterns with four light modules on either side
+func finderLikePenalty(line []bool) int {
+	pattern := []bool{true, false, true, true, true, false, true}
+	penalty := 0
+	for i := 0; i+len(pattern) <= len(line); i++ {
+		matches := true
+		for j, dark := range pattern {
+			if line[i+j] != dark {
+				matches = false
+				break
+			}
+		}
+		if !matches {
+			continue
+		}
+		if lightRun(line, i-4, i) || lightRun(line, i+len(pattern), i+len(pattern)+4) {
+			penalty += 40
+		}
+	}
+	return penalty
+}
+
+// lightRun tells whether the modules from start to end are light, counting the quiet zone as light
+func lightRun(line []bool, start, end int) bool {
+	for i := start; i < end; i++ {
+		if i >= 0 && i < len(line) && line[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
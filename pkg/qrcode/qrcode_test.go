@@ -0,0 +1,169 @@
+package qrcode
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReedSolomonRemainder(t *testing.T) {
+	// "HELLO WORLD" as version 1-M from the specification examples
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	expected := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	assert.Equal(t, expected, reedSolomonRemainder(data, reedSolomonDivisor(10)))
+}
+
+func TestFormatBits(t *testing.T) {
+	// Low error correction level with mask 4
+	assert.Equal(t, 0b110011000101111, formatBits(1, 4))
+	// Medium error correction level with mask 0
+	assert.Equal(t, 0b101010000010010, formatBits(eclMedium, 0))
+}
+
+func TestVersionBits(t *testing.T) {
+	assert.Equal(t, 0b000111110010010100, versionBits(7))
+	assert.Equal(t, 0b101000110001101001, versionBits(40))
+}
+
+// readCodewords reads the symbol back: the mask from the format information, then the
+// codewords in placement order once unmasked
+func readCodewords(t *testing.T, c *Code) []byte {
+	t.Helper()
+
+	bits := 0
+	for i := 0; i <= 5; i++ {
+		if c.modules[i][8] {
+			bits |= 1 << i
+		}
+	}
+	for i, position := range [][2]int{{8, 7}, {8, 8}, {7, 8}} {
+		if c.modules[position[1]][position[0]] {
+			bits |= 1 << (6 + i)
+		}
+	}
+	for i := 9; i < 15; i++ {
+		if c.modules[8][14-i] {
+			bits |= 1 << i
+		}
+	}
+	mask := -1
+	for candidate := 0; candidate < 8; candidate++ {
+		if formatBits(eclMedium, candidate) == bits {
+			mask = candidate
+		}
+	}
+	require.NotEqual(t, -1, mask, "format information does not match the medium level")
+
+	unmasked := newCode(c.version)
+	for y := range c.modules {
+		copy(unmasked.modules[y], c.modules[y])
+		copy(unmasked.isFunction[y], c.isFunction[y])
+	}
+	unmasked.applyMask(mask)
+
+	var codewords []byte
+	var current byte
+	count := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vertical := 0; vertical < c.Size; vertical++ {
+			for j := 0; j < 2; j++ {
+				x, y := right-j, vertical
+				if (right+1)&2 == 0 {
+					y = c.Size - 1 - vertical
+				}
+				if unmasked.isFunction[y][x] {
+					continue
+				}
+				current <<= 1
+				if unmasked.modules[y][x] {
+					current |= 1
+				}
+				count++
+				if count%8 == 0 {
+					codewords = append(codewords, current)
+					current = 0
+				}
+			}
+		}
+	}
+	return codewords
+}
+
+func TestEncode(t *testing.T) {
+	code, err := Encode("https://notifuse.com")
+	require.NoError(t, err)
+	assert.Equal(t, 2, code.version)
+	assert.Equal(t, 25, code.Size)
+
+	// Finder patterns, timing patterns and the dark module
+	for _, corner := range [][2]int{{0, 0}, {code.Size - 7, 0}, {0, code.Size - 7}} {
+		assert.True(t, code.Dark(corner[0], corner[1]))
+		assert.True(t, code.Dark(corner[0]+3, corner[1]+3))
+		assert.False(t, code.Dark(corner[0]+1, corner[1]+1))
+	}
+	for i := 8; i < code.Size-8; i++ {
+		assert.Equal(t, i%2 == 0, code.Dark(i, 6))
+		assert.Equal(t, i%2 == 0, code.Dark(6, i))
+	}
+	assert.True(t, code.Dark(8, code.Size-8))
+
+	codewords := readCodewords(t, code)
+	dataLength := numDataCodewords(code.version)
+	require.Len(t, codewords, numRawDataModules(code.version)/8)
+
+	// Byte mode, the length and the content
+	data := codewords[:dataLength]
+	assert.Equal(t, byte(0x4), data[0]>>4)
+	assert.Equal(t, byte(len("https://notifuse.com")), data[0]<<4|data[1]>>4)
+	var content []byte
+	for i := 1; i <= len("https://notifuse.com"); i++ {
+		content = append(content, data[i]<<4|data[i+1]>>4)
+	}
+	assert.Equal(t, "https://notifuse.com", string(content))
+
+	// The error correction matches the data
+	assert.Equal(t, codewords[dataLength:], reedSolomonRemainder(data, reedSolomonDivisor(eccCodewordsPerBlock[code.version])))
+}
+
+func TestEncode_Versions(t *testing.T) {
+	code, err := Encode(strings.Repeat("a", 200))
+	require.NoError(t, err)
+	assert.Equal(t, 10, code.version)
+	assert.Equal(t, 57, code.Size)
+	assert.Len(t, readCodewords(t, code), numRawDataModules(10)/8)
+
+	// Version 40-M holds up to 2331 bytes
+	code, err = Encode(strings.Repeat("a", 2331))
+	require.NoError(t, err)
+	assert.Equal(t, 40, code.version)
+
+	_, err = Encode(strings.Repeat("a", 2332))
+	assert.Error(t, err)
+}
+
+func TestDataURI(t *testing.T) {
+	uri, err := DataURI("https://notifuse.com", 200)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(uri, "data:image/png;base64,"))
+
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(uri, "data:image/png;base64,"))
+	require.NoError(t, err)
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	// 25 modules and the quiet zone, 6 pixels each
+	assert.Equal(t, 198, img.Bounds().Dx())
+	r, _, _, _ := img.At(0, 0).RGBA()
+	assert.Equal(t, uint32(0xFFFF), r, "the quiet zone is light")
+	r, _, _, _ = img.At(4*6, 4*6).RGBA()
+	assert.Equal(t, uint32(0), r, "the finder pattern is dark")
+}